@@ -35,12 +35,171 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// IdCase is how the IDs of a system are compared. IDs compared
+// case-insensitively are matched to the mappings and to the current members of
+// target groups regardless of case, so that e.g. "Alice" in the mappings and
+// "alice" in a group are the same user.
+type IdCase int32
+
+const (
+	// The default of the system: case-insensitive for systems identifying
+	// users by login or email, e.g. GitHub, GitLab and Google Groups, and
+	// case-sensitive otherwise.
+	IdCase_ID_CASE_UNSPECIFIED IdCase = 0
+	IdCase_ID_CASE_INSENSITIVE IdCase = 1
+	IdCase_ID_CASE_SENSITIVE   IdCase = 2
+)
+
+// Enum value maps for IdCase.
+var (
+	IdCase_name = map[int32]string{
+		0: "ID_CASE_UNSPECIFIED",
+		1: "ID_CASE_INSENSITIVE",
+		2: "ID_CASE_SENSITIVE",
+	}
+	IdCase_value = map[string]int32{
+		"ID_CASE_UNSPECIFIED": 0,
+		"ID_CASE_INSENSITIVE": 1,
+		"ID_CASE_SENSITIVE":   2,
+	}
+)
+
+func (x IdCase) Enum() *IdCase {
+	p := new(IdCase)
+	*p = x
+	return p
+}
+
+func (x IdCase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IdCase) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_config_proto_enumTypes[0].Descriptor()
+}
+
+func (IdCase) Type() protoreflect.EnumType {
+	return &file_proto_config_proto_enumTypes[0]
+}
+
+func (x IdCase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IdCase.Descriptor instead.
+func (IdCase) EnumDescriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{0}
+}
+
+type GroupSettingsPolicy_Action int32
+
+const (
+	// Log the groups violating the policy and sync them anyway.
+	GroupSettingsPolicy_ACTION_WARN GroupSettingsPolicy_Action = 0
+	// Fail the sync, before any change, if a group violates the policy.
+	GroupSettingsPolicy_ACTION_FAIL GroupSettingsPolicy_Action = 1
+)
+
+// Enum value maps for GroupSettingsPolicy_Action.
+var (
+	GroupSettingsPolicy_Action_name = map[int32]string{
+		0: "ACTION_WARN",
+		1: "ACTION_FAIL",
+	}
+	GroupSettingsPolicy_Action_value = map[string]int32{
+		"ACTION_WARN": 0,
+		"ACTION_FAIL": 1,
+	}
+)
+
+func (x GroupSettingsPolicy_Action) Enum() *GroupSettingsPolicy_Action {
+	p := new(GroupSettingsPolicy_Action)
+	*p = x
+	return p
+}
+
+func (x GroupSettingsPolicy_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupSettingsPolicy_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_config_proto_enumTypes[1].Descriptor()
+}
+
+func (GroupSettingsPolicy_Action) Type() protoreflect.EnumType {
+	return &file_proto_config_proto_enumTypes[1]
+}
+
+func (x GroupSettingsPolicy_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupSettingsPolicy_Action.Descriptor instead.
+func (GroupSettingsPolicy_Action) EnumDescriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type MemberDomainPolicy_Action int32
+
+const (
+	// Log the members outside of the allowed domains and sync them anyway.
+	MemberDomainPolicy_ACTION_FLAG MemberDomainPolicy_Action = 0
+	// Log the members outside of the allowed domains and exclude them
+	// from the target groups.
+	MemberDomainPolicy_ACTION_EXCLUDE MemberDomainPolicy_Action = 1
+)
+
+// Enum value maps for MemberDomainPolicy_Action.
+var (
+	MemberDomainPolicy_Action_name = map[int32]string{
+		0: "ACTION_FLAG",
+		1: "ACTION_EXCLUDE",
+	}
+	MemberDomainPolicy_Action_value = map[string]int32{
+		"ACTION_FLAG":    0,
+		"ACTION_EXCLUDE": 1,
+	}
+)
+
+func (x MemberDomainPolicy_Action) Enum() *MemberDomainPolicy_Action {
+	p := new(MemberDomainPolicy_Action)
+	*p = x
+	return p
+}
+
+func (x MemberDomainPolicy_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemberDomainPolicy_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_config_proto_enumTypes[2].Descriptor()
+}
+
+func (MemberDomainPolicy_Action) Type() protoreflect.EnumType {
+	return &file_proto_config_proto_enumTypes[2]
+}
+
+func (x MemberDomainPolicy_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemberDomainPolicy_Action.Descriptor instead.
+func (MemberDomainPolicy_Action) EnumDescriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{36, 0}
+}
+
 type StaticToken struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// This is the name of an environment variable to read from
 	FromEnvironment string `protobuf:"bytes,1,opt,name=from_environment,json=fromEnvironment,proto3" json:"from_environment,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// The token encrypted with Cloud KMS, so that it can be checked in with
+	// the config. It has the form kms://KEY:CIPHERTEXT, where KEY is the name
+	// of the crypto key, projects/p/locations/l/keyRings/r/cryptoKeys/k, and
+	// CIPHERTEXT is the base64 encoded ciphertext. The token is decrypted when
+	// the config is loaded. Takes precedence over from_environment.
+	Encrypted     string `protobuf:"bytes,2,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StaticToken) Reset() {
@@ -80,18 +239,92 @@ func (x *StaticToken) GetFromEnvironment() string {
 	return ""
 }
 
+func (x *StaticToken) GetEncrypted() string {
+	if x != nil {
+		return x.Encrypted
+	}
+	return ""
+}
+
+// OrgTokensFromEnvironment reads a token for each GitHub org from an
+// environment variable named after the org ID.
+type OrgTokensFromEnvironment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The prefix of the environment variables, followed by the org ID.
+	// Defaults to TEAMLINK_GITHUB_TOKEN_, e.g. TEAMLINK_GITHUB_TOKEN_123.
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// The name of an environment variable holding the token for orgs without
+	// their own variable, and for requests not made on behalf of an org, such
+	// as user lookups. Optional.
+	DefaultFromEnvironment string `protobuf:"bytes,2,opt,name=default_from_environment,json=defaultFromEnvironment,proto3" json:"default_from_environment,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *OrgTokensFromEnvironment) Reset() {
+	*x = OrgTokensFromEnvironment{}
+	mi := &file_proto_config_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrgTokensFromEnvironment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgTokensFromEnvironment) ProtoMessage() {}
+
+func (x *OrgTokensFromEnvironment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgTokensFromEnvironment.ProtoReflect.Descriptor instead.
+func (*OrgTokensFromEnvironment) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrgTokensFromEnvironment) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *OrgTokensFromEnvironment) GetDefaultFromEnvironment() string {
+	if x != nil {
+		return x.DefaultFromEnvironment
+	}
+	return ""
+}
+
 type GitHubApp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	AppId string                 `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
 	// keystore:// or KMS location of the private key
-	KeyLocation   string `protobuf:"bytes,2,opt,name=key_location,json=keyLocation,proto3" json:"key_location,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	KeyLocation string `protobuf:"bytes,2,opt,name=key_location,json=keyLocation,proto3" json:"key_location,omitempty"`
+	// The name of an environment variable holding the PEM encoded private key.
+	PrivateKeyFromEnvironment string `protobuf:"bytes,3,opt,name=private_key_from_environment,json=privateKeyFromEnvironment,proto3" json:"private_key_from_environment,omitempty"`
+	// The PEM encoded private key encrypted with Cloud KMS, of the form
+	// kms://KEY:CIPHERTEXT, see StaticToken.encrypted.
+	EncryptedPrivateKey string `protobuf:"bytes,4,opt,name=encrypted_private_key,json=encryptedPrivateKey,proto3" json:"encrypted_private_key,omitempty"`
+	// The ID of the installation of the app to mint tokens for. By default the
+	// installation on the org being synced is looked up.
+	InstallationId string `protobuf:"bytes,5,opt,name=installation_id,json=installationId,proto3" json:"installation_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *GitHubApp) Reset() {
 	*x = GitHubApp{}
-	mi := &file_proto_config_proto_msgTypes[1]
+	mi := &file_proto_config_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -103,7 +336,7 @@ func (x *GitHubApp) String() string {
 func (*GitHubApp) ProtoMessage() {}
 
 func (x *GitHubApp) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_config_proto_msgTypes[1]
+	mi := &file_proto_config_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -116,7 +349,7 @@ func (x *GitHubApp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitHubApp.ProtoReflect.Descriptor instead.
 func (*GitHubApp) Descriptor() ([]byte, []int) {
-	return file_proto_config_proto_rawDescGZIP(), []int{1}
+	return file_proto_config_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GitHubApp) GetAppId() string {
@@ -133,6 +366,83 @@ func (x *GitHubApp) GetKeyLocation() string {
 	return ""
 }
 
+func (x *GitHubApp) GetPrivateKeyFromEnvironment() string {
+	if x != nil {
+		return x.PrivateKeyFromEnvironment
+	}
+	return ""
+}
+
+func (x *GitHubApp) GetEncryptedPrivateKey() string {
+	if x != nil {
+		return x.EncryptedPrivateKey
+	}
+	return ""
+}
+
+func (x *GitHubApp) GetInstallationId() string {
+	if x != nil {
+		return x.InstallationId
+	}
+	return ""
+}
+
+// GitHubAppsByOrg configures a GitHub App per org, for enterprises where orgs
+// are managed by separate apps.
+type GitHubAppsByOrg struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The apps keyed by org ID.
+	OrgApps map[int64]*GitHubApp `protobuf:"bytes,1,rep,name=org_apps,json=orgApps,proto3" json:"org_apps,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The app of orgs not listed in org_apps. Optional.
+	DefaultApp    *GitHubApp `protobuf:"bytes,2,opt,name=default_app,json=defaultApp,proto3" json:"default_app,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitHubAppsByOrg) Reset() {
+	*x = GitHubAppsByOrg{}
+	mi := &file_proto_config_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubAppsByOrg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubAppsByOrg) ProtoMessage() {}
+
+func (x *GitHubAppsByOrg) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubAppsByOrg.ProtoReflect.Descriptor instead.
+func (*GitHubAppsByOrg) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GitHubAppsByOrg) GetOrgApps() map[int64]*GitHubApp {
+	if x != nil {
+		return x.OrgApps
+	}
+	return nil
+}
+
+func (x *GitHubAppsByOrg) GetDefaultApp() *GitHubApp {
+	if x != nil {
+		return x.DefaultApp
+	}
+	return nil
+}
+
 type GitHubConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EnterpriseUrl string                 `protobuf:"bytes,1,opt,name=enterprise_url,json=enterpriseUrl,proto3" json:"enterprise_url,omitempty"`
@@ -140,14 +450,19 @@ type GitHubConfig struct {
 	//
 	//	*GitHubConfig_StaticAuth
 	//	*GitHubConfig_GhAppAuth
+	//	*GitHubConfig_EnvOrgAuth
+	//	*GitHubConfig_GhAppsByOrgAuth
 	Authentication isGitHubConfig_Authentication `protobuf_oneof:"authentication"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// The number of items requested per page when listing teams, team
+	// members and invitations, at most 100, the default.
+	PageSize      int32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GitHubConfig) Reset() {
 	*x = GitHubConfig{}
-	mi := &file_proto_config_proto_msgTypes[2]
+	mi := &file_proto_config_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -156,10 +471,3029 @@ func (x *GitHubConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitHubConfig) ProtoMessage() {}
+func (*GitHubConfig) ProtoMessage() {}
+
+func (x *GitHubConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubConfig.ProtoReflect.Descriptor instead.
+func (*GitHubConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GitHubConfig) GetEnterpriseUrl() string {
+	if x != nil {
+		return x.EnterpriseUrl
+	}
+	return ""
+}
+
+func (x *GitHubConfig) GetAuthentication() isGitHubConfig_Authentication {
+	if x != nil {
+		return x.Authentication
+	}
+	return nil
+}
+
+func (x *GitHubConfig) GetStaticAuth() *StaticToken {
+	if x != nil {
+		if x, ok := x.Authentication.(*GitHubConfig_StaticAuth); ok {
+			return x.StaticAuth
+		}
+	}
+	return nil
+}
+
+func (x *GitHubConfig) GetGhAppAuth() *GitHubApp {
+	if x != nil {
+		if x, ok := x.Authentication.(*GitHubConfig_GhAppAuth); ok {
+			return x.GhAppAuth
+		}
+	}
+	return nil
+}
+
+func (x *GitHubConfig) GetEnvOrgAuth() *OrgTokensFromEnvironment {
+	if x != nil {
+		if x, ok := x.Authentication.(*GitHubConfig_EnvOrgAuth); ok {
+			return x.EnvOrgAuth
+		}
+	}
+	return nil
+}
+
+func (x *GitHubConfig) GetGhAppsByOrgAuth() *GitHubAppsByOrg {
+	if x != nil {
+		if x, ok := x.Authentication.(*GitHubConfig_GhAppsByOrgAuth); ok {
+			return x.GhAppsByOrgAuth
+		}
+	}
+	return nil
+}
+
+func (x *GitHubConfig) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type isGitHubConfig_Authentication interface {
+	isGitHubConfig_Authentication()
+}
+
+type GitHubConfig_StaticAuth struct {
+	StaticAuth *StaticToken `protobuf:"bytes,2,opt,name=static_auth,json=staticAuth,proto3,oneof"`
+}
+
+type GitHubConfig_GhAppAuth struct {
+	GhAppAuth *GitHubApp `protobuf:"bytes,3,opt,name=gh_app_auth,json=ghAppAuth,proto3,oneof"`
+}
+
+type GitHubConfig_EnvOrgAuth struct {
+	EnvOrgAuth *OrgTokensFromEnvironment `protobuf:"bytes,4,opt,name=env_org_auth,json=envOrgAuth,proto3,oneof"`
+}
+
+type GitHubConfig_GhAppsByOrgAuth struct {
+	GhAppsByOrgAuth *GitHubAppsByOrg `protobuf:"bytes,5,opt,name=gh_apps_by_org_auth,json=ghAppsByOrgAuth,proto3,oneof"`
+}
+
+func (*GitHubConfig_StaticAuth) isGitHubConfig_Authentication() {}
+
+func (*GitHubConfig_GhAppAuth) isGitHubConfig_Authentication() {}
+
+func (*GitHubConfig_EnvOrgAuth) isGitHubConfig_Authentication() {}
+
+func (*GitHubConfig_GhAppsByOrgAuth) isGitHubConfig_Authentication() {}
+
+// For now we only support GoogleGroup to authenticate
+// using default application login.
+type GoogleGroupsConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether syncing to Google Groups may remove and demote group owners.
+	// By default owners are kept. Only used when Google Groups is the target.
+	ManageOwners bool `protobuf:"varint,1,opt,name=manage_owners,json=manageOwners,proto3" json:"manage_owners,omitempty"`
+	// Checks the settings of the mapped groups before syncing. Only used when
+	// Google Groups is the source.
+	SettingsPolicy *GroupSettingsPolicy `protobuf:"bytes,2,opt,name=settings_policy,json=settingsPolicy,proto3" json:"settings_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GoogleGroupsConfig) Reset() {
+	*x = GoogleGroupsConfig{}
+	mi := &file_proto_config_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoogleGroupsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoogleGroupsConfig) ProtoMessage() {}
+
+func (x *GoogleGroupsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoogleGroupsConfig.ProtoReflect.Descriptor instead.
+func (*GoogleGroupsConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GoogleGroupsConfig) GetManageOwners() bool {
+	if x != nil {
+		return x.ManageOwners
+	}
+	return false
+}
+
+func (x *GoogleGroupsConfig) GetSettingsPolicy() *GroupSettingsPolicy {
+	if x != nil {
+		return x.SettingsPolicy
+	}
+	return nil
+}
+
+// GroupSettingsPolicy restricts the settings of source Google Groups, so that
+// nobody can grant themselves access to the target systems by joining one.
+type GroupSettingsPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The allowed values of the whoCanJoin setting. Defaults to
+	// INVITED_CAN_JOIN and CAN_REQUEST_TO_JOIN, i.e. groups which anyone, or
+	// anyone in the domain, can join violate the policy.
+	AllowedWhoCanJoin []string `protobuf:"bytes,1,rep,name=allowed_who_can_join,json=allowedWhoCanJoin,proto3" json:"allowed_who_can_join,omitempty"`
+	// Whether groups may allow members external to the domain.
+	AllowExternalMembers bool                       `protobuf:"varint,2,opt,name=allow_external_members,json=allowExternalMembers,proto3" json:"allow_external_members,omitempty"`
+	Action               GroupSettingsPolicy_Action `protobuf:"varint,3,opt,name=action,proto3,enum=proto.api.GroupSettingsPolicy_Action" json:"action,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GroupSettingsPolicy) Reset() {
+	*x = GroupSettingsPolicy{}
+	mi := &file_proto_config_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupSettingsPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupSettingsPolicy) ProtoMessage() {}
+
+func (x *GroupSettingsPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupSettingsPolicy.ProtoReflect.Descriptor instead.
+func (*GroupSettingsPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GroupSettingsPolicy) GetAllowedWhoCanJoin() []string {
+	if x != nil {
+		return x.AllowedWhoCanJoin
+	}
+	return nil
+}
+
+func (x *GroupSettingsPolicy) GetAllowExternalMembers() bool {
+	if x != nil {
+		return x.AllowExternalMembers
+	}
+	return false
+}
+
+func (x *GroupSettingsPolicy) GetAction() GroupSettingsPolicy_Action {
+	if x != nil {
+		return x.Action
+	}
+	return GroupSettingsPolicy_ACTION_WARN
+}
+
+type GitLabConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnterpriseUrl string                 `protobuf:"bytes,1,opt,name=enterprise_url,json=enterpriseUrl,proto3" json:"enterprise_url,omitempty"`
+	// This will need to be update once we are implementing for gitlab
+	//
+	// Types that are valid to be assigned to Authentication:
+	//
+	//	*GitLabConfig_StaticToken
+	//	*GitLabConfig_RotatingToken
+	Authentication isGitLabConfig_Authentication `protobuf_oneof:"authentication"`
+	// Service accounts which team-link creates, when GitLab is the target
+	// system, unless they exist. They are added to target groups like any
+	// other user, e.g. as static members of group mappings.
+	ServiceAccounts []*GitLabServiceAccount `protobuf:"bytes,4,rep,name=service_accounts,json=serviceAccounts,proto3" json:"service_accounts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GitLabConfig) Reset() {
+	*x = GitLabConfig{}
+	mi := &file_proto_config_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitLabConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitLabConfig) ProtoMessage() {}
+
+func (x *GitLabConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitLabConfig.ProtoReflect.Descriptor instead.
+func (*GitLabConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GitLabConfig) GetEnterpriseUrl() string {
+	if x != nil {
+		return x.EnterpriseUrl
+	}
+	return ""
+}
+
+func (x *GitLabConfig) GetAuthentication() isGitLabConfig_Authentication {
+	if x != nil {
+		return x.Authentication
+	}
+	return nil
+}
+
+func (x *GitLabConfig) GetStaticToken() *StaticToken {
+	if x != nil {
+		if x, ok := x.Authentication.(*GitLabConfig_StaticToken); ok {
+			return x.StaticToken
+		}
+	}
+	return nil
+}
+
+func (x *GitLabConfig) GetRotatingToken() *GitLabRotatingToken {
+	if x != nil {
+		if x, ok := x.Authentication.(*GitLabConfig_RotatingToken); ok {
+			return x.RotatingToken
+		}
+	}
+	return nil
+}
+
+func (x *GitLabConfig) GetServiceAccounts() []*GitLabServiceAccount {
+	if x != nil {
+		return x.ServiceAccounts
+	}
+	return nil
+}
+
+type isGitLabConfig_Authentication interface {
+	isGitLabConfig_Authentication()
+}
+
+type GitLabConfig_StaticToken struct {
+	StaticToken *StaticToken `protobuf:"bytes,2,opt,name=static_token,json=staticToken,proto3,oneof"`
+}
+
+type GitLabConfig_RotatingToken struct {
+	RotatingToken *GitLabRotatingToken `protobuf:"bytes,3,opt,name=rotating_token,json=rotatingToken,proto3,oneof"`
+}
+
+func (*GitLabConfig_StaticToken) isGitLabConfig_Authentication() {}
+
+func (*GitLabConfig_RotatingToken) isGitLabConfig_Authentication() {}
+
+// A GitLab service account, a GitLab Premium and Ultimate feature.
+type GitLabServiceAccount struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Username string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The display name of the account, its username by default.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The ID of the top-level group the account is created in, which is
+	// required on GitLab.com. Without it the account is created for the
+	// instance, which requires an administrator token.
+	GroupId       int64 `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitLabServiceAccount) Reset() {
+	*x = GitLabServiceAccount{}
+	mi := &file_proto_config_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitLabServiceAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitLabServiceAccount) ProtoMessage() {}
+
+func (x *GitLabServiceAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitLabServiceAccount.ProtoReflect.Descriptor instead.
+func (*GitLabServiceAccount) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GitLabServiceAccount) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GitLabServiceAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GitLabServiceAccount) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+// A GitLab group access token which team-link rotates before it expires. The
+// rotated token is kept in the state store, which is required, and replaces
+// the configured token until another token is configured.
+type GitLabRotatingToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token *StaticToken           `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// How many days before its expiry the token is rotated, 7 by default.
+	RotateBeforeDays int32 `protobuf:"varint,2,opt,name=rotate_before_days,json=rotateBeforeDays,proto3" json:"rotate_before_days,omitempty"`
+	// How many days a rotated token is valid, 30 by default.
+	ValidityDays  int32 `protobuf:"varint,3,opt,name=validity_days,json=validityDays,proto3" json:"validity_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitLabRotatingToken) Reset() {
+	*x = GitLabRotatingToken{}
+	mi := &file_proto_config_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitLabRotatingToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitLabRotatingToken) ProtoMessage() {}
+
+func (x *GitLabRotatingToken) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitLabRotatingToken.ProtoReflect.Descriptor instead.
+func (*GitLabRotatingToken) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GitLabRotatingToken) GetToken() *StaticToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *GitLabRotatingToken) GetRotateBeforeDays() int32 {
+	if x != nil {
+		return x.RotateBeforeDays
+	}
+	return 0
+}
+
+func (x *GitLabRotatingToken) GetValidityDays() int32 {
+	if x != nil {
+		return x.ValidityDays
+	}
+	return 0
+}
+
+type GerritConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the Gerrit server, e.g. https://gerrit.example.com.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The username of the account team-link authenticates as. The account
+	// must own the synced groups or be a Gerrit administrator.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The HTTP password of the account.
+	HttpPassword  *StaticToken `protobuf:"bytes,3,opt,name=http_password,json=httpPassword,proto3" json:"http_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GerritConfig) Reset() {
+	*x = GerritConfig{}
+	mi := &file_proto_config_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GerritConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GerritConfig) ProtoMessage() {}
+
+func (x *GerritConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GerritConfig.ProtoReflect.Descriptor instead.
+func (*GerritConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GerritConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GerritConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GerritConfig) GetHttpPassword() *StaticToken {
+	if x != nil {
+		return x.HttpPassword
+	}
+	return nil
+}
+
+type SentryConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of a self-hosted Sentry server. Defaults to https://sentry.io.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// An auth token with the team:write and member:read scopes, e.g. of an
+	// internal integration.
+	AuthToken     *StaticToken `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SentryConfig) Reset() {
+	*x = SentryConfig{}
+	mi := &file_proto_config_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SentryConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentryConfig) ProtoMessage() {}
+
+func (x *SentryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentryConfig.ProtoReflect.Descriptor instead.
+func (*SentryConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SentryConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SentryConfig) GetAuthToken() *StaticToken {
+	if x != nil {
+		return x.AuthToken
+	}
+	return nil
+}
+
+type KubernetesConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The directory the RoleBinding and ClusterRoleBinding manifests are
+	// written to, e.g. a directory applied by a GitOps tool.
+	ManifestDir   string `protobuf:"bytes,1,opt,name=manifest_dir,json=manifestDir,proto3" json:"manifest_dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KubernetesConfig) Reset() {
+	*x = KubernetesConfig{}
+	mi := &file_proto_config_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KubernetesConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesConfig) ProtoMessage() {}
+
+func (x *KubernetesConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesConfig.ProtoReflect.Descriptor instead.
+func (*KubernetesConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *KubernetesConfig) GetManifestDir() string {
+	if x != nil {
+		return x.ManifestDir
+	}
+	return ""
+}
+
+type VaultConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The address of the Vault server, e.g. https://vault.example.com:8200.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The Vault Enterprise namespace of the groups, if any.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// A token with a policy allowing to read identity entities and groups
+	// and to update the synced groups.
+	Token         *StaticToken `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultConfig) Reset() {
+	*x = VaultConfig{}
+	mi := &file_proto_config_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultConfig) ProtoMessage() {}
+
+func (x *VaultConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultConfig.ProtoReflect.Descriptor instead.
+func (*VaultConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VaultConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VaultConfig) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *VaultConfig) GetToken() *StaticToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type Auth0Config struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The domain of the Auth0 tenant, e.g. example.us.auth0.com.
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	// The client ID of a machine to machine application authorized for the
+	// Management API with the read:organizations, read:organization_members,
+	// create:organization_members, read:organization_member_roles,
+	// create:organization_member_roles, delete:organization_member_roles and
+	// read:users scopes.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The client secret of the application.
+	ClientSecret  *StaticToken `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth0Config) Reset() {
+	*x = Auth0Config{}
+	mi := &file_proto_config_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth0Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth0Config) ProtoMessage() {}
+
+func (x *Auth0Config) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth0Config.ProtoReflect.Descriptor instead.
+func (*Auth0Config) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Auth0Config) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Auth0Config) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Auth0Config) GetClientSecret() *StaticToken {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+type MattermostConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the Mattermost server, e.g. https://chat.example.com.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// A personal access token or bot token of an account allowed to manage
+	// the members of the synced teams and channels.
+	Token         *StaticToken `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MattermostConfig) Reset() {
+	*x = MattermostConfig{}
+	mi := &file_proto_config_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MattermostConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MattermostConfig) ProtoMessage() {}
+
+func (x *MattermostConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MattermostConfig.ProtoReflect.Descriptor instead.
+func (*MattermostConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MattermostConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *MattermostConfig) GetToken() *StaticToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type RocketChatConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the Rocket.Chat server, e.g. https://chat.example.com.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The ID of the user the personal access token belongs to.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// A personal access token of a user allowed to manage the members of
+	// the synced rooms.
+	AuthToken     *StaticToken `protobuf:"bytes,3,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RocketChatConfig) Reset() {
+	*x = RocketChatConfig{}
+	mi := &file_proto_config_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RocketChatConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RocketChatConfig) ProtoMessage() {}
+
+func (x *RocketChatConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RocketChatConfig.ProtoReflect.Descriptor instead.
+func (*RocketChatConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RocketChatConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RocketChatConfig) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RocketChatConfig) GetAuthToken() *StaticToken {
+	if x != nil {
+		return x.AuthToken
+	}
+	return nil
+}
+
+type ZendeskConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The subdomain of the Zendesk account, e.g. example for
+	// example.zendesk.com.
+	Subdomain string `protobuf:"bytes,1,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	// The email of the admin the API token is used as.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// A Zendesk API token.
+	ApiToken      *StaticToken `protobuf:"bytes,3,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZendeskConfig) Reset() {
+	*x = ZendeskConfig{}
+	mi := &file_proto_config_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZendeskConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZendeskConfig) ProtoMessage() {}
+
+func (x *ZendeskConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZendeskConfig.ProtoReflect.Descriptor instead.
+func (*ZendeskConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ZendeskConfig) GetSubdomain() string {
+	if x != nil {
+		return x.Subdomain
+	}
+	return ""
+}
+
+func (x *ZendeskConfig) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ZendeskConfig) GetApiToken() *StaticToken {
+	if x != nil {
+		return x.ApiToken
+	}
+	return nil
+}
+
+type ServiceNowConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the instance, e.g. https://example.service-now.com.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The user name of an integration user with the user_admin role, or
+	// read and write access to the sys_user_grmember table.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The password of the integration user.
+	Password      *StaticToken `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceNowConfig) Reset() {
+	*x = ServiceNowConfig{}
+	mi := &file_proto_config_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceNowConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceNowConfig) ProtoMessage() {}
+
+func (x *ServiceNowConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceNowConfig.ProtoReflect.Descriptor instead.
+func (*ServiceNowConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ServiceNowConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ServiceNowConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ServiceNowConfig) GetPassword() *StaticToken {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+type SplunkConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the management port, e.g. https://splunk.example.com:8089.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// A Splunk authentication token of a user with the edit_user capability.
+	Token         *StaticToken `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplunkConfig) Reset() {
+	*x = SplunkConfig{}
+	mi := &file_proto_config_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplunkConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplunkConfig) ProtoMessage() {}
+
+func (x *SplunkConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplunkConfig.ProtoReflect.Descriptor instead.
+func (*SplunkConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SplunkConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SplunkConfig) GetToken() *StaticToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type LookerConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the instance, e.g. https://example.cloud.looker.com.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The API client ID of a user with the manage_groups and see_users
+	// permissions.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The API client secret of the user.
+	ClientSecret  *StaticToken `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookerConfig) Reset() {
+	*x = LookerConfig{}
+	mi := &file_proto_config_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookerConfig) ProtoMessage() {}
+
+func (x *LookerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookerConfig.ProtoReflect.Descriptor instead.
+func (*LookerConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LookerConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LookerConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *LookerConfig) GetClientSecret() *StaticToken {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+type TableauConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the server, e.g. https://prod-useast-a.online.tableau.com.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The content URL of the site, i.e. the site name in its URLs.
+	Site string `protobuf:"bytes,2,opt,name=site,proto3" json:"site,omitempty"`
+	// The name of a personal access token of a site administrator.
+	TokenName string `protobuf:"bytes,3,opt,name=token_name,json=tokenName,proto3" json:"token_name,omitempty"`
+	// The secret of the personal access token.
+	TokenSecret   *StaticToken `protobuf:"bytes,4,opt,name=token_secret,json=tokenSecret,proto3" json:"token_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableauConfig) Reset() {
+	*x = TableauConfig{}
+	mi := &file_proto_config_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableauConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableauConfig) ProtoMessage() {}
+
+func (x *TableauConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableauConfig.ProtoReflect.Descriptor instead.
+func (*TableauConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TableauConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *TableauConfig) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *TableauConfig) GetTokenName() string {
+	if x != nil {
+		return x.TokenName
+	}
+	return ""
+}
+
+func (x *TableauConfig) GetTokenSecret() *StaticToken {
+	if x != nil {
+		return x.TokenSecret
+	}
+	return nil
+}
+
+type ConfluenceConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the site, e.g. https://example.atlassian.net.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The email of a site administrator.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// An API token of the site administrator.
+	ApiToken *StaticToken `protobuf:"bytes,3,opt,name=api_token,json=apiToken,proto3" json:"api_token,omitempty"`
+	// Whether to grant and revoke the space permissions of the mapped groups,
+	// in addition to their members. The administrator must be a space admin
+	// of the spaces.
+	ManageSpacePermissions bool `protobuf:"varint,4,opt,name=manage_space_permissions,json=manageSpacePermissions,proto3" json:"manage_space_permissions,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ConfluenceConfig) Reset() {
+	*x = ConfluenceConfig{}
+	mi := &file_proto_config_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfluenceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfluenceConfig) ProtoMessage() {}
+
+func (x *ConfluenceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfluenceConfig.ProtoReflect.Descriptor instead.
+func (*ConfluenceConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ConfluenceConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ConfluenceConfig) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ConfluenceConfig) GetApiToken() *StaticToken {
+	if x != nil {
+		return x.ApiToken
+	}
+	return nil
+}
+
+func (x *ConfluenceConfig) GetManageSpacePermissions() bool {
+	if x != nil {
+		return x.ManageSpacePermissions
+	}
+	return false
+}
+
+type JumpCloudConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the API, https://console.jumpcloud.com by default.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The ID of the organization, required for the API keys of multi-tenant
+	// portal administrators.
+	OrgId string `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// The API key of an administrator.
+	ApiKey        *StaticToken `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JumpCloudConfig) Reset() {
+	*x = JumpCloudConfig{}
+	mi := &file_proto_config_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JumpCloudConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumpCloudConfig) ProtoMessage() {}
+
+func (x *JumpCloudConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JumpCloudConfig.ProtoReflect.Descriptor instead.
+func (*JumpCloudConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *JumpCloudConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *JumpCloudConfig) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *JumpCloudConfig) GetApiKey() *StaticToken {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+type OneLoginConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the account, e.g. https://example.onelogin.com.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The client ID of API credentials with the "Read users" scope.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The client secret of the API credentials.
+	ClientSecret  *StaticToken `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OneLoginConfig) Reset() {
+	*x = OneLoginConfig{}
+	mi := &file_proto_config_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OneLoginConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneLoginConfig) ProtoMessage() {}
+
+func (x *OneLoginConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneLoginConfig.ProtoReflect.Descriptor instead.
+func (*OneLoginConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OneLoginConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *OneLoginConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OneLoginConfig) GetClientSecret() *StaticToken {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+type PagerDutyConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A REST API key, which may be read-only.
+	ApiKey *StaticToken `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// The URL of the REST API, https://api.pagerduty.com by default, or
+	// https://api.eu.pagerduty.com in the EU service region.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Also makes the users on call within this duration from now members of
+	// a schedule, e.g. "1h", so they get access before their shift starts.
+	Lookahead     string `protobuf:"bytes,3,opt,name=lookahead,proto3" json:"lookahead,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PagerDutyConfig) Reset() {
+	*x = PagerDutyConfig{}
+	mi := &file_proto_config_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PagerDutyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagerDutyConfig) ProtoMessage() {}
+
+func (x *PagerDutyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagerDutyConfig.ProtoReflect.Descriptor instead.
+func (*PagerDutyConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PagerDutyConfig) GetApiKey() *StaticToken {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *PagerDutyConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *PagerDutyConfig) GetLookahead() string {
+	if x != nil {
+		return x.Lookahead
+	}
+	return ""
+}
+
+type OpsgenieConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An API key with the Read access right.
+	ApiKey *StaticToken `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// The URL of the REST API, https://api.opsgenie.com by default, or
+	// https://api.eu.opsgenie.com in the EU instance.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Also makes the users on call within this duration from now members of
+	// a schedule, e.g. "1h", so they get access before their shift starts.
+	Lookahead     string `protobuf:"bytes,3,opt,name=lookahead,proto3" json:"lookahead,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpsgenieConfig) Reset() {
+	*x = OpsgenieConfig{}
+	mi := &file_proto_config_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpsgenieConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpsgenieConfig) ProtoMessage() {}
+
+func (x *OpsgenieConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpsgenieConfig.ProtoReflect.Descriptor instead.
+func (*OpsgenieConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OpsgenieConfig) GetApiKey() *StaticToken {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *OpsgenieConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *OpsgenieConfig) GetLookahead() string {
+	if x != nil {
+		return x.Lookahead
+	}
+	return ""
+}
+
+type PingOneConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the environment.
+	EnvironmentId string `protobuf:"bytes,1,opt,name=environment_id,json=environmentId,proto3" json:"environment_id,omitempty"`
+	// The domain of the region of the environment, pingone.com by default,
+	// e.g. pingone.eu or pingone.asia.
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	// The client ID of a worker application with the Identity Data Read
+	// Only role.
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The client secret of the worker application.
+	ClientSecret  *StaticToken `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingOneConfig) Reset() {
+	*x = PingOneConfig{}
+	mi := &file_proto_config_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingOneConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingOneConfig) ProtoMessage() {}
+
+func (x *PingOneConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingOneConfig.ProtoReflect.Descriptor instead.
+func (*PingOneConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PingOneConfig) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+func (x *PingOneConfig) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *PingOneConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *PingOneConfig) GetClientSecret() *StaticToken {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+// Team-link authenticates to Google Chat with application default
+// credentials, which must be granted the chat.memberships and
+// chat.spaces.readonly scopes (chat.admin.memberships and
+// chat.admin.spaces.readonly with use_admin_access), and the
+// admin.directory.user.readonly scope to resolve members to emails.
+type GoogleChatConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Manage the spaces as a Google Workspace administrator with the Chat
+	// and spaces privilege, who need not be a member of the spaces.
+	UseAdminAccess bool `protobuf:"varint,1,opt,name=use_admin_access,json=useAdminAccess,proto3" json:"use_admin_access,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GoogleChatConfig) Reset() {
+	*x = GoogleChatConfig{}
+	mi := &file_proto_config_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoogleChatConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoogleChatConfig) ProtoMessage() {}
+
+func (x *GoogleChatConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoogleChatConfig.ProtoReflect.Descriptor instead.
+func (*GoogleChatConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GoogleChatConfig) GetUseAdminAccess() bool {
+	if x != nil {
+		return x.UseAdminAccess
+	}
+	return false
+}
+
+// Team-link authenticates to Google Calendar with application default
+// credentials, which must be granted the calendar scope and be allowed to
+// manage the sharing of the synced calendars, e.g. a service account the
+// calendars are shared with.
+type GoogleCalendarConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoogleCalendarConfig) Reset() {
+	*x = GoogleCalendarConfig{}
+	mi := &file_proto_config_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoogleCalendarConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoogleCalendarConfig) ProtoMessage() {}
+
+func (x *GoogleCalendarConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoogleCalendarConfig.ProtoReflect.Descriptor instead.
+func (*GoogleCalendarConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{29}
+}
+
+type DatabricksConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the account console, https://accounts.cloud.databricks.com
+	// by default, e.g. https://accounts.azuredatabricks.net on Azure.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The ID of the account.
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The OAuth client ID of a service principal with the account admin
+	// role.
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The OAuth secret of the service principal.
+	ClientSecret  *StaticToken `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabricksConfig) Reset() {
+	*x = DatabricksConfig{}
+	mi := &file_proto_config_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabricksConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabricksConfig) ProtoMessage() {}
+
+func (x *DatabricksConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabricksConfig.ProtoReflect.Descriptor instead.
+func (*DatabricksConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DatabricksConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DatabricksConfig) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *DatabricksConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *DatabricksConfig) GetClientSecret() *StaticToken {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+type Microsoft365Config struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the Microsoft Entra tenant.
+	TenantId string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// The client ID of an app registration granted the
+	// GroupMember.ReadWrite.All and User.Read.All application permissions,
+	// and Group.ReadWrite.All to manage owners.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// A client secret of the app registration.
+	ClientSecret *StaticToken `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	// The URL of Microsoft Graph, https://graph.microsoft.com by default,
+	// e.g. https://graph.microsoft.us in the US Government cloud.
+	GraphUrl string `protobuf:"bytes,4,opt,name=graph_url,json=graphUrl,proto3" json:"graph_url,omitempty"`
+	// The URL of Microsoft Entra ID, https://login.microsoftonline.com by
+	// default, e.g. https://login.microsoftonline.us in the US Government
+	// cloud.
+	LoginUrl      string `protobuf:"bytes,5,opt,name=login_url,json=loginUrl,proto3" json:"login_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Microsoft365Config) Reset() {
+	*x = Microsoft365Config{}
+	mi := &file_proto_config_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Microsoft365Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Microsoft365Config) ProtoMessage() {}
+
+func (x *Microsoft365Config) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Microsoft365Config.ProtoReflect.Descriptor instead.
+func (*Microsoft365Config) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Microsoft365Config) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Microsoft365Config) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Microsoft365Config) GetClientSecret() *StaticToken {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+func (x *Microsoft365Config) GetGraphUrl() string {
+	if x != nil {
+		return x.GraphUrl
+	}
+	return ""
+}
+
+func (x *Microsoft365Config) GetLoginUrl() string {
+	if x != nil {
+		return x.LoginUrl
+	}
+	return ""
+}
+
+type BoxConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the Box API, https://api.box.com by default.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The ID of the Box enterprise.
+	EnterpriseId string `protobuf:"bytes,2,opt,name=enterprise_id,json=enterpriseId,proto3" json:"enterprise_id,omitempty"`
+	// The client ID of a Box Platform app using client credentials
+	// authentication, with the "Manage users" and "Manage groups" scopes.
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The client secret of the app.
+	ClientSecret  *StaticToken `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoxConfig) Reset() {
+	*x = BoxConfig{}
+	mi := &file_proto_config_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoxConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoxConfig) ProtoMessage() {}
+
+func (x *BoxConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoxConfig.ProtoReflect.Descriptor instead.
+func (*BoxConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BoxConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *BoxConfig) GetEnterpriseId() string {
+	if x != nil {
+		return x.EnterpriseId
+	}
+	return ""
+}
+
+func (x *BoxConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *BoxConfig) GetClientSecret() *StaticToken {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+type DropboxConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the Dropbox API, https://api.dropboxapi.com by default.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The app key of a scoped Dropbox app with the team_data.member and
+	// groups.write scopes.
+	AppKey string `protobuf:"bytes,2,opt,name=app_key,json=appKey,proto3" json:"app_key,omitempty"`
+	// The app secret of the app.
+	AppSecret *StaticToken `protobuf:"bytes,3,opt,name=app_secret,json=appSecret,proto3" json:"app_secret,omitempty"`
+	// The refresh token of a team admin who authorized the app.
+	RefreshToken  *StaticToken `protobuf:"bytes,4,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropboxConfig) Reset() {
+	*x = DropboxConfig{}
+	mi := &file_proto_config_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropboxConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropboxConfig) ProtoMessage() {}
+
+func (x *DropboxConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropboxConfig.ProtoReflect.Descriptor instead.
+func (*DropboxConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DropboxConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DropboxConfig) GetAppKey() string {
+	if x != nil {
+		return x.AppKey
+	}
+	return ""
+}
+
+func (x *DropboxConfig) GetAppSecret() *StaticToken {
+	if x != nil {
+		return x.AppSecret
+	}
+	return nil
+}
+
+func (x *DropboxConfig) GetRefreshToken() *StaticToken {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return nil
+}
+
+type SalesforceConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The My Domain URL of the org, e.g. https://example.my.salesforce.com.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The consumer key of an external client app with the client
+	// credentials flow enabled, whose run-as user can manage users.
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The consumer secret of the app.
+	ClientSecret  *StaticToken `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SalesforceConfig) Reset() {
+	*x = SalesforceConfig{}
+	mi := &file_proto_config_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalesforceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalesforceConfig) ProtoMessage() {}
+
+func (x *SalesforceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalesforceConfig.ProtoReflect.Descriptor instead.
+func (*SalesforceConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SalesforceConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SalesforceConfig) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SalesforceConfig) GetClientSecret() *StaticToken {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return nil
+}
+
+type SourceConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Config:
+	//
+	//	*SourceConfig_GoogleGroupsConfig
+	//	*SourceConfig_GithubConfig
+	//	*SourceConfig_GitlabConfig
+	//	*SourceConfig_JumpcloudConfig
+	//	*SourceConfig_OneloginConfig
+	//	*SourceConfig_PingoneConfig
+	//	*SourceConfig_PagerdutyConfig
+	//	*SourceConfig_OpsgenieConfig
+	Config isSourceConfig_Config `protobuf_oneof:"config"`
+	// How the user IDs of the source system are compared.
+	IdCase IdCase `protobuf:"varint,7,opt,name=id_case,json=idCase,proto3,enum=proto.api.IdCase" json:"id_case,omitempty"`
+	// Checks the email domains of the members of source groups before they
+	// are mapped to target users. Only for source systems identifying users
+	// by email, e.g. Google Groups.
+	MemberDomainPolicy *MemberDomainPolicy `protobuf:"bytes,8,opt,name=member_domain_policy,json=memberDomainPolicy,proto3" json:"member_domain_policy,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SourceConfig) Reset() {
+	*x = SourceConfig{}
+	mi := &file_proto_config_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SourceConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SourceConfig) ProtoMessage() {}
+
+func (x *SourceConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SourceConfig.ProtoReflect.Descriptor instead.
+func (*SourceConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SourceConfig) GetConfig() isSourceConfig_Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *SourceConfig) GetGoogleGroupsConfig() *GoogleGroupsConfig {
+	if x != nil {
+		if x, ok := x.Config.(*SourceConfig_GoogleGroupsConfig); ok {
+			return x.GoogleGroupsConfig
+		}
+	}
+	return nil
+}
+
+func (x *SourceConfig) GetGithubConfig() *GitHubConfig {
+	if x != nil {
+		if x, ok := x.Config.(*SourceConfig_GithubConfig); ok {
+			return x.GithubConfig
+		}
+	}
+	return nil
+}
+
+func (x *SourceConfig) GetGitlabConfig() *GitLabConfig {
+	if x != nil {
+		if x, ok := x.Config.(*SourceConfig_GitlabConfig); ok {
+			return x.GitlabConfig
+		}
+	}
+	return nil
+}
+
+func (x *SourceConfig) GetJumpcloudConfig() *JumpCloudConfig {
+	if x != nil {
+		if x, ok := x.Config.(*SourceConfig_JumpcloudConfig); ok {
+			return x.JumpcloudConfig
+		}
+	}
+	return nil
+}
+
+func (x *SourceConfig) GetOneloginConfig() *OneLoginConfig {
+	if x != nil {
+		if x, ok := x.Config.(*SourceConfig_OneloginConfig); ok {
+			return x.OneloginConfig
+		}
+	}
+	return nil
+}
+
+func (x *SourceConfig) GetPingoneConfig() *PingOneConfig {
+	if x != nil {
+		if x, ok := x.Config.(*SourceConfig_PingoneConfig); ok {
+			return x.PingoneConfig
+		}
+	}
+	return nil
+}
+
+func (x *SourceConfig) GetPagerdutyConfig() *PagerDutyConfig {
+	if x != nil {
+		if x, ok := x.Config.(*SourceConfig_PagerdutyConfig); ok {
+			return x.PagerdutyConfig
+		}
+	}
+	return nil
+}
+
+func (x *SourceConfig) GetOpsgenieConfig() *OpsgenieConfig {
+	if x != nil {
+		if x, ok := x.Config.(*SourceConfig_OpsgenieConfig); ok {
+			return x.OpsgenieConfig
+		}
+	}
+	return nil
+}
+
+func (x *SourceConfig) GetIdCase() IdCase {
+	if x != nil {
+		return x.IdCase
+	}
+	return IdCase_ID_CASE_UNSPECIFIED
+}
+
+func (x *SourceConfig) GetMemberDomainPolicy() *MemberDomainPolicy {
+	if x != nil {
+		return x.MemberDomainPolicy
+	}
+	return nil
+}
+
+type isSourceConfig_Config interface {
+	isSourceConfig_Config()
+}
+
+type SourceConfig_GoogleGroupsConfig struct {
+	GoogleGroupsConfig *GoogleGroupsConfig `protobuf:"bytes,1,opt,name=google_groups_config,json=googleGroupsConfig,proto3,oneof"`
+}
+
+type SourceConfig_GithubConfig struct {
+	GithubConfig *GitHubConfig `protobuf:"bytes,2,opt,name=github_config,json=githubConfig,proto3,oneof"`
+}
+
+type SourceConfig_GitlabConfig struct {
+	GitlabConfig *GitLabConfig `protobuf:"bytes,3,opt,name=gitlab_config,json=gitlabConfig,proto3,oneof"`
+}
+
+type SourceConfig_JumpcloudConfig struct {
+	JumpcloudConfig *JumpCloudConfig `protobuf:"bytes,4,opt,name=jumpcloud_config,json=jumpcloudConfig,proto3,oneof"`
+}
+
+type SourceConfig_OneloginConfig struct {
+	OneloginConfig *OneLoginConfig `protobuf:"bytes,5,opt,name=onelogin_config,json=oneloginConfig,proto3,oneof"`
+}
+
+type SourceConfig_PingoneConfig struct {
+	PingoneConfig *PingOneConfig `protobuf:"bytes,6,opt,name=pingone_config,json=pingoneConfig,proto3,oneof"`
+}
+
+type SourceConfig_PagerdutyConfig struct {
+	PagerdutyConfig *PagerDutyConfig `protobuf:"bytes,9,opt,name=pagerduty_config,json=pagerdutyConfig,proto3,oneof"`
+}
+
+type SourceConfig_OpsgenieConfig struct {
+	OpsgenieConfig *OpsgenieConfig `protobuf:"bytes,10,opt,name=opsgenie_config,json=opsgenieConfig,proto3,oneof"`
+}
+
+func (*SourceConfig_GoogleGroupsConfig) isSourceConfig_Config() {}
+
+func (*SourceConfig_GithubConfig) isSourceConfig_Config() {}
+
+func (*SourceConfig_GitlabConfig) isSourceConfig_Config() {}
+
+func (*SourceConfig_JumpcloudConfig) isSourceConfig_Config() {}
+
+func (*SourceConfig_OneloginConfig) isSourceConfig_Config() {}
+
+func (*SourceConfig_PingoneConfig) isSourceConfig_Config() {}
+
+func (*SourceConfig_PagerdutyConfig) isSourceConfig_Config() {}
+
+func (*SourceConfig_OpsgenieConfig) isSourceConfig_Config() {}
+
+// MemberDomainPolicy flags or excludes the members of source groups whose
+// email domain is not allowed, e.g. contractors added to a Google Group.
+type MemberDomainPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The allowed email domains, e.g. "example.com". Subdomains must be
+	// listed separately.
+	AllowedDomains []string                  `protobuf:"bytes,1,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	Action         MemberDomainPolicy_Action `protobuf:"varint,2,opt,name=action,proto3,enum=proto.api.MemberDomainPolicy_Action" json:"action,omitempty"`
+	// Domains additionally allowed in specific source groups.
+	Exceptions    []*MemberDomainException `protobuf:"bytes,3,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberDomainPolicy) Reset() {
+	*x = MemberDomainPolicy{}
+	mi := &file_proto_config_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberDomainPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberDomainPolicy) ProtoMessage() {}
+
+func (x *MemberDomainPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberDomainPolicy.ProtoReflect.Descriptor instead.
+func (*MemberDomainPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MemberDomainPolicy) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+func (x *MemberDomainPolicy) GetAction() MemberDomainPolicy_Action {
+	if x != nil {
+		return x.Action
+	}
+	return MemberDomainPolicy_ACTION_FLAG
+}
+
+func (x *MemberDomainPolicy) GetExceptions() []*MemberDomainException {
+	if x != nil {
+		return x.Exceptions
+	}
+	return nil
+}
+
+type MemberDomainException struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the source group, as in the group mappings.
+	GroupId        string   `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	AllowedDomains []string `protobuf:"bytes,2,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MemberDomainException) Reset() {
+	*x = MemberDomainException{}
+	mi := &file_proto_config_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberDomainException) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberDomainException) ProtoMessage() {}
+
+func (x *MemberDomainException) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberDomainException.ProtoReflect.Descriptor instead.
+func (*MemberDomainException) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MemberDomainException) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *MemberDomainException) GetAllowedDomains() []string {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+type TargetConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Config:
+	//
+	//	*TargetConfig_GithubConfig
+	//	*TargetConfig_GitlabConfig
+	//	*TargetConfig_GerritConfig
+	//	*TargetConfig_SentryConfig
+	//	*TargetConfig_KubernetesConfig
+	//	*TargetConfig_VaultConfig
+	//	*TargetConfig_Auth0Config
+	//	*TargetConfig_MattermostConfig
+	//	*TargetConfig_RocketChatConfig
+	//	*TargetConfig_ZendeskConfig
+	//	*TargetConfig_ServiceNowConfig
+	//	*TargetConfig_SplunkConfig
+	//	*TargetConfig_LookerConfig
+	//	*TargetConfig_TableauConfig
+	//	*TargetConfig_ConfluenceConfig
+	//	*TargetConfig_JumpcloudConfig
+	//	*TargetConfig_DatabricksConfig
+	//	*TargetConfig_GoogleGroupsConfig
+	//	*TargetConfig_GoogleChatConfig
+	//	*TargetConfig_GoogleCalendarConfig
+	//	*TargetConfig_Microsoft365Config
+	//	*TargetConfig_BoxConfig
+	//	*TargetConfig_DropboxConfig
+	//	*TargetConfig_SalesforceConfig
+	Config isTargetConfig_Config `protobuf_oneof:"config"`
+	// Caps the API calls made to the target system. Target groups reached
+	// once the budget is exhausted are deferred to the next run.
+	ApiBudget *ApiBudget `protobuf:"bytes,20,opt,name=api_budget,json=apiBudget,proto3" json:"api_budget,omitempty"`
+	// How the user and group IDs of the target system are compared.
+	IdCase        IdCase `protobuf:"varint,21,opt,name=id_case,json=idCase,proto3,enum=proto.api.IdCase" json:"id_case,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetConfig) Reset() {
+	*x = TargetConfig{}
+	mi := &file_proto_config_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetConfig) ProtoMessage() {}
+
+func (x *TargetConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetConfig.ProtoReflect.Descriptor instead.
+func (*TargetConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TargetConfig) GetConfig() isTargetConfig_Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetGithubConfig() *GitHubConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_GithubConfig); ok {
+			return x.GithubConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetGitlabConfig() *GitLabConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_GitlabConfig); ok {
+			return x.GitlabConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetGerritConfig() *GerritConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_GerritConfig); ok {
+			return x.GerritConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetSentryConfig() *SentryConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_SentryConfig); ok {
+			return x.SentryConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetKubernetesConfig() *KubernetesConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_KubernetesConfig); ok {
+			return x.KubernetesConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetVaultConfig() *VaultConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_VaultConfig); ok {
+			return x.VaultConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetAuth0Config() *Auth0Config {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_Auth0Config); ok {
+			return x.Auth0Config
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetMattermostConfig() *MattermostConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_MattermostConfig); ok {
+			return x.MattermostConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetRocketChatConfig() *RocketChatConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_RocketChatConfig); ok {
+			return x.RocketChatConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetZendeskConfig() *ZendeskConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_ZendeskConfig); ok {
+			return x.ZendeskConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetServiceNowConfig() *ServiceNowConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_ServiceNowConfig); ok {
+			return x.ServiceNowConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetSplunkConfig() *SplunkConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_SplunkConfig); ok {
+			return x.SplunkConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetLookerConfig() *LookerConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_LookerConfig); ok {
+			return x.LookerConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetTableauConfig() *TableauConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_TableauConfig); ok {
+			return x.TableauConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetConfluenceConfig() *ConfluenceConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_ConfluenceConfig); ok {
+			return x.ConfluenceConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetJumpcloudConfig() *JumpCloudConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_JumpcloudConfig); ok {
+			return x.JumpcloudConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetDatabricksConfig() *DatabricksConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_DatabricksConfig); ok {
+			return x.DatabricksConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetGoogleGroupsConfig() *GoogleGroupsConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_GoogleGroupsConfig); ok {
+			return x.GoogleGroupsConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetGoogleChatConfig() *GoogleChatConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_GoogleChatConfig); ok {
+			return x.GoogleChatConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetGoogleCalendarConfig() *GoogleCalendarConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_GoogleCalendarConfig); ok {
+			return x.GoogleCalendarConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetMicrosoft365Config() *Microsoft365Config {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_Microsoft365Config); ok {
+			return x.Microsoft365Config
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetBoxConfig() *BoxConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_BoxConfig); ok {
+			return x.BoxConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetDropboxConfig() *DropboxConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_DropboxConfig); ok {
+			return x.DropboxConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetSalesforceConfig() *SalesforceConfig {
+	if x != nil {
+		if x, ok := x.Config.(*TargetConfig_SalesforceConfig); ok {
+			return x.SalesforceConfig
+		}
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetApiBudget() *ApiBudget {
+	if x != nil {
+		return x.ApiBudget
+	}
+	return nil
+}
+
+func (x *TargetConfig) GetIdCase() IdCase {
+	if x != nil {
+		return x.IdCase
+	}
+	return IdCase_ID_CASE_UNSPECIFIED
+}
+
+type isTargetConfig_Config interface {
+	isTargetConfig_Config()
+}
+
+type TargetConfig_GithubConfig struct {
+	GithubConfig *GitHubConfig `protobuf:"bytes,2,opt,name=github_config,json=githubConfig,proto3,oneof"`
+}
+
+type TargetConfig_GitlabConfig struct {
+	GitlabConfig *GitLabConfig `protobuf:"bytes,3,opt,name=gitlab_config,json=gitlabConfig,proto3,oneof"`
+}
+
+type TargetConfig_GerritConfig struct {
+	GerritConfig *GerritConfig `protobuf:"bytes,4,opt,name=gerrit_config,json=gerritConfig,proto3,oneof"`
+}
+
+type TargetConfig_SentryConfig struct {
+	SentryConfig *SentryConfig `protobuf:"bytes,5,opt,name=sentry_config,json=sentryConfig,proto3,oneof"`
+}
+
+type TargetConfig_KubernetesConfig struct {
+	KubernetesConfig *KubernetesConfig `protobuf:"bytes,6,opt,name=kubernetes_config,json=kubernetesConfig,proto3,oneof"`
+}
+
+type TargetConfig_VaultConfig struct {
+	VaultConfig *VaultConfig `protobuf:"bytes,7,opt,name=vault_config,json=vaultConfig,proto3,oneof"`
+}
+
+type TargetConfig_Auth0Config struct {
+	Auth0Config *Auth0Config `protobuf:"bytes,8,opt,name=auth0_config,json=auth0Config,proto3,oneof"`
+}
+
+type TargetConfig_MattermostConfig struct {
+	MattermostConfig *MattermostConfig `protobuf:"bytes,9,opt,name=mattermost_config,json=mattermostConfig,proto3,oneof"`
+}
+
+type TargetConfig_RocketChatConfig struct {
+	RocketChatConfig *RocketChatConfig `protobuf:"bytes,10,opt,name=rocket_chat_config,json=rocketChatConfig,proto3,oneof"`
+}
+
+type TargetConfig_ZendeskConfig struct {
+	ZendeskConfig *ZendeskConfig `protobuf:"bytes,11,opt,name=zendesk_config,json=zendeskConfig,proto3,oneof"`
+}
+
+type TargetConfig_ServiceNowConfig struct {
+	ServiceNowConfig *ServiceNowConfig `protobuf:"bytes,12,opt,name=service_now_config,json=serviceNowConfig,proto3,oneof"`
+}
+
+type TargetConfig_SplunkConfig struct {
+	SplunkConfig *SplunkConfig `protobuf:"bytes,13,opt,name=splunk_config,json=splunkConfig,proto3,oneof"`
+}
+
+type TargetConfig_LookerConfig struct {
+	LookerConfig *LookerConfig `protobuf:"bytes,14,opt,name=looker_config,json=lookerConfig,proto3,oneof"`
+}
+
+type TargetConfig_TableauConfig struct {
+	TableauConfig *TableauConfig `protobuf:"bytes,15,opt,name=tableau_config,json=tableauConfig,proto3,oneof"`
+}
+
+type TargetConfig_ConfluenceConfig struct {
+	ConfluenceConfig *ConfluenceConfig `protobuf:"bytes,16,opt,name=confluence_config,json=confluenceConfig,proto3,oneof"`
+}
+
+type TargetConfig_JumpcloudConfig struct {
+	JumpcloudConfig *JumpCloudConfig `protobuf:"bytes,17,opt,name=jumpcloud_config,json=jumpcloudConfig,proto3,oneof"`
+}
+
+type TargetConfig_DatabricksConfig struct {
+	DatabricksConfig *DatabricksConfig `protobuf:"bytes,18,opt,name=databricks_config,json=databricksConfig,proto3,oneof"`
+}
+
+type TargetConfig_GoogleGroupsConfig struct {
+	GoogleGroupsConfig *GoogleGroupsConfig `protobuf:"bytes,19,opt,name=google_groups_config,json=googleGroupsConfig,proto3,oneof"`
+}
+
+type TargetConfig_GoogleChatConfig struct {
+	GoogleChatConfig *GoogleChatConfig `protobuf:"bytes,22,opt,name=google_chat_config,json=googleChatConfig,proto3,oneof"`
+}
+
+type TargetConfig_GoogleCalendarConfig struct {
+	GoogleCalendarConfig *GoogleCalendarConfig `protobuf:"bytes,23,opt,name=google_calendar_config,json=googleCalendarConfig,proto3,oneof"`
+}
+
+type TargetConfig_Microsoft365Config struct {
+	Microsoft365Config *Microsoft365Config `protobuf:"bytes,24,opt,name=microsoft365_config,json=microsoft365Config,proto3,oneof"`
+}
+
+type TargetConfig_BoxConfig struct {
+	BoxConfig *BoxConfig `protobuf:"bytes,25,opt,name=box_config,json=boxConfig,proto3,oneof"`
+}
+
+type TargetConfig_DropboxConfig struct {
+	DropboxConfig *DropboxConfig `protobuf:"bytes,26,opt,name=dropbox_config,json=dropboxConfig,proto3,oneof"`
+}
+
+type TargetConfig_SalesforceConfig struct {
+	SalesforceConfig *SalesforceConfig `protobuf:"bytes,27,opt,name=salesforce_config,json=salesforceConfig,proto3,oneof"`
+}
+
+func (*TargetConfig_GithubConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_GitlabConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_GerritConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_SentryConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_KubernetesConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_VaultConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_Auth0Config) isTargetConfig_Config() {}
+
+func (*TargetConfig_MattermostConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_RocketChatConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_ZendeskConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_ServiceNowConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_SplunkConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_LookerConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_TableauConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_ConfluenceConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_JumpcloudConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_DatabricksConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_GoogleGroupsConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_GoogleChatConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_GoogleCalendarConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_Microsoft365Config) isTargetConfig_Config() {}
+
+func (*TargetConfig_BoxConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_DropboxConfig) isTargetConfig_Config() {}
+
+func (*TargetConfig_SalesforceConfig) isTargetConfig_Config() {}
+
+// ApiBudget caps the API calls made to a system. A limit of 0 is unlimited.
+type ApiBudget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of API calls per sync run.
+	MaxCallsPerRun int64 `protobuf:"varint,1,opt,name=max_calls_per_run,json=maxCallsPerRun,proto3" json:"max_calls_per_run,omitempty"`
+	// The maximum number of API calls per clock hour, across runs. The calls
+	// are only counted across runs with a state store.
+	MaxCallsPerHour int64 `protobuf:"varint,2,opt,name=max_calls_per_hour,json=maxCallsPerHour,proto3" json:"max_calls_per_hour,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApiBudget) Reset() {
+	*x = ApiBudget{}
+	mi := &file_proto_config_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiBudget) ProtoMessage() {}
+
+func (x *ApiBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiBudget.ProtoReflect.Descriptor instead.
+func (*ApiBudget) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ApiBudget) GetMaxCallsPerRun() int64 {
+	if x != nil {
+		return x.MaxCallsPerRun
+	}
+	return 0
+}
+
+func (x *ApiBudget) GetMaxCallsPerHour() int64 {
+	if x != nil {
+		return x.MaxCallsPerHour
+	}
+	return 0
+}
+
+// IdentityConfig enables matching source users to target users without a user
+// mapping, by signals the systems provide. User mappings always take
+// precedence, and users matched ambiguously, e.g. two GitHub users with the
+// same SAML identity, are not matched at all.
+type IdentityConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Match users of two systems which both identify users by email, e.g.
+	// Google Groups and Looker, by their email.
+	MatchEmails bool `protobuf:"varint,1,opt,name=match_emails,json=matchEmails,proto3" json:"match_emails,omitempty"`
+	// Match users identified by email to the GitHub users whose SAML single
+	// sign-on identity in the orgs of the group mappings has that email as
+	// its NameID.
+	GithubSamlIdentities bool `protobuf:"varint,2,opt,name=github_saml_identities,json=githubSamlIdentities,proto3" json:"github_saml_identities,omitempty"`
+	// Match users identified by email to the GitLab users with that primary
+	// email. The emails of users are only visible to admins, so GitLab must
+	// be configured with an admin token.
+	GitlabVerifiedEmails bool `protobuf:"varint,3,opt,name=gitlab_verified_emails,json=gitlabVerifiedEmails,proto3" json:"gitlab_verified_emails,omitempty"`
+	// Match the users of the other system to the GitHub users provisioned by
+	// SCIM whose SCIM externalId is their ID, e.g. when the identity provider
+	// provisions users with their email as externalId. The SCIM users of the
+	// orgs of the group mappings are matched, unless github_scim_enterprise
+	// is set.
+	GithubScimExternalIds bool `protobuf:"varint,4,opt,name=github_scim_external_ids,json=githubScimExternalIds,proto3" json:"github_scim_external_ids,omitempty"`
+	// The slug of the enterprise whose SCIM users github_scim_external_ids
+	// matches, for Enterprise Managed Users. The enterprise is read with the
+	// credentials of the first org of the group mappings.
+	GithubScimEnterprise string `protobuf:"bytes,5,opt,name=github_scim_enterprise,json=githubScimEnterprise,proto3" json:"github_scim_enterprise,omitempty"`
+	// The ID of the top-level GitLab group whose SCIM identities match the
+	// users of the other system to the GitLab users whose SCIM externalId is
+	// their ID. Listing SCIM identities requires the Owner role in the group.
+	GitlabScimGroupId int64 `protobuf:"varint,6,opt,name=gitlab_scim_group_id,json=gitlabScimGroupId,proto3" json:"gitlab_scim_group_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *IdentityConfig) Reset() {
+	*x = IdentityConfig{}
+	mi := &file_proto_config_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityConfig) ProtoMessage() {}
+
+func (x *IdentityConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityConfig.ProtoReflect.Descriptor instead.
+func (*IdentityConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *IdentityConfig) GetMatchEmails() bool {
+	if x != nil {
+		return x.MatchEmails
+	}
+	return false
+}
+
+func (x *IdentityConfig) GetGithubSamlIdentities() bool {
+	if x != nil {
+		return x.GithubSamlIdentities
+	}
+	return false
+}
+
+func (x *IdentityConfig) GetGitlabVerifiedEmails() bool {
+	if x != nil {
+		return x.GitlabVerifiedEmails
+	}
+	return false
+}
+
+func (x *IdentityConfig) GetGithubScimExternalIds() bool {
+	if x != nil {
+		return x.GithubScimExternalIds
+	}
+	return false
+}
+
+func (x *IdentityConfig) GetGithubScimEnterprise() string {
+	if x != nil {
+		return x.GithubScimEnterprise
+	}
+	return ""
+}
+
+func (x *IdentityConfig) GetGitlabScimGroupId() int64 {
+	if x != nil {
+		return x.GitlabScimGroupId
+	}
+	return 0
+}
+
+// ChangeFeedConfig publishes every membership change applied to the target
+// groups as a JSON event with a stable schema, so that downstream systems,
+// e.g. a SIEM, can react to access changes. Changes of read-only runs are not
+// published.
+type ChangeFeedConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Sink:
+	//
+	//	*ChangeFeedConfig_Pubsub
+	//	*ChangeFeedConfig_Kafka
+	//	*ChangeFeedConfig_Webhook
+	Sink          isChangeFeedConfig_Sink `protobuf_oneof:"sink"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeFeedConfig) Reset() {
+	*x = ChangeFeedConfig{}
+	mi := &file_proto_config_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeFeedConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeFeedConfig) ProtoMessage() {}
 
-func (x *GitHubConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_config_proto_msgTypes[2]
+func (x *ChangeFeedConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -170,82 +3504,92 @@ func (x *GitHubConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitHubConfig.ProtoReflect.Descriptor instead.
-func (*GitHubConfig) Descriptor() ([]byte, []int) {
-	return file_proto_config_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ChangeFeedConfig.ProtoReflect.Descriptor instead.
+func (*ChangeFeedConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GitHubConfig) GetEnterpriseUrl() string {
+func (x *ChangeFeedConfig) GetSink() isChangeFeedConfig_Sink {
 	if x != nil {
-		return x.EnterpriseUrl
+		return x.Sink
 	}
-	return ""
+	return nil
 }
 
-func (x *GitHubConfig) GetAuthentication() isGitHubConfig_Authentication {
+func (x *ChangeFeedConfig) GetPubsub() *PubSubSink {
 	if x != nil {
-		return x.Authentication
+		if x, ok := x.Sink.(*ChangeFeedConfig_Pubsub); ok {
+			return x.Pubsub
+		}
 	}
 	return nil
 }
 
-func (x *GitHubConfig) GetStaticAuth() *StaticToken {
+func (x *ChangeFeedConfig) GetKafka() *KafkaSink {
 	if x != nil {
-		if x, ok := x.Authentication.(*GitHubConfig_StaticAuth); ok {
-			return x.StaticAuth
+		if x, ok := x.Sink.(*ChangeFeedConfig_Kafka); ok {
+			return x.Kafka
 		}
 	}
 	return nil
 }
 
-func (x *GitHubConfig) GetGhAppAuth() *GitHubApp {
+func (x *ChangeFeedConfig) GetWebhook() *WebhookSink {
 	if x != nil {
-		if x, ok := x.Authentication.(*GitHubConfig_GhAppAuth); ok {
-			return x.GhAppAuth
+		if x, ok := x.Sink.(*ChangeFeedConfig_Webhook); ok {
+			return x.Webhook
 		}
 	}
 	return nil
 }
 
-type isGitHubConfig_Authentication interface {
-	isGitHubConfig_Authentication()
+type isChangeFeedConfig_Sink interface {
+	isChangeFeedConfig_Sink()
 }
 
-type GitHubConfig_StaticAuth struct {
-	StaticAuth *StaticToken `protobuf:"bytes,2,opt,name=static_auth,json=staticAuth,proto3,oneof"`
+type ChangeFeedConfig_Pubsub struct {
+	Pubsub *PubSubSink `protobuf:"bytes,1,opt,name=pubsub,proto3,oneof"`
 }
 
-type GitHubConfig_GhAppAuth struct {
-	GhAppAuth *GitHubApp `protobuf:"bytes,3,opt,name=gh_app_auth,json=ghAppAuth,proto3,oneof"`
+type ChangeFeedConfig_Kafka struct {
+	Kafka *KafkaSink `protobuf:"bytes,2,opt,name=kafka,proto3,oneof"`
 }
 
-func (*GitHubConfig_StaticAuth) isGitHubConfig_Authentication() {}
+type ChangeFeedConfig_Webhook struct {
+	Webhook *WebhookSink `protobuf:"bytes,3,opt,name=webhook,proto3,oneof"`
+}
 
-func (*GitHubConfig_GhAppAuth) isGitHubConfig_Authentication() {}
+func (*ChangeFeedConfig_Pubsub) isChangeFeedConfig_Sink() {}
 
-// For now we only support GoogleGroup to authenticate
-// using default application login.
-type GoogleGroupsConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (*ChangeFeedConfig_Kafka) isChangeFeedConfig_Sink() {}
+
+func (*ChangeFeedConfig_Webhook) isChangeFeedConfig_Sink() {}
+
+// PubSubSink publishes each event as a message of a Pub/Sub topic, with
+// application default credentials.
+type PubSubSink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The topic, of the form projects/PROJECT/topics/TOPIC.
+	Topic         string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GoogleGroupsConfig) Reset() {
-	*x = GoogleGroupsConfig{}
-	mi := &file_proto_config_proto_msgTypes[3]
+func (x *PubSubSink) Reset() {
+	*x = PubSubSink{}
+	mi := &file_proto_config_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GoogleGroupsConfig) String() string {
+func (x *PubSubSink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GoogleGroupsConfig) ProtoMessage() {}
+func (*PubSubSink) ProtoMessage() {}
 
-func (x *GoogleGroupsConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_config_proto_msgTypes[3]
+func (x *PubSubSink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,39 +3600,48 @@ func (x *GoogleGroupsConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GoogleGroupsConfig.ProtoReflect.Descriptor instead.
-func (*GoogleGroupsConfig) Descriptor() ([]byte, []int) {
-	return file_proto_config_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PubSubSink.ProtoReflect.Descriptor instead.
+func (*PubSubSink) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{42}
 }
 
-type GitLabConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EnterpriseUrl string                 `protobuf:"bytes,1,opt,name=enterprise_url,json=enterpriseUrl,proto3" json:"enterprise_url,omitempty"`
-	// This will need to be update once we are implementing for gitlab
-	//
-	// Types that are valid to be assigned to Authentication:
-	//
-	//	*GitLabConfig_StaticToken
-	Authentication isGitLabConfig_Authentication `protobuf_oneof:"authentication"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *PubSubSink) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
 }
 
-func (x *GitLabConfig) Reset() {
-	*x = GitLabConfig{}
-	mi := &file_proto_config_proto_msgTypes[4]
+// KafkaSink produces each event as a record of a Kafka topic through a Kafka
+// REST Proxy, keyed by the target group ID.
+type KafkaSink struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the REST Proxy, e.g. https://kafka-rest.example.com.
+	RestProxyUrl string `protobuf:"bytes,1,opt,name=rest_proxy_url,json=restProxyUrl,proto3" json:"rest_proxy_url,omitempty"`
+	Topic        string `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// The username and password of the REST Proxy, if it requires basic
+	// authentication. Optional.
+	Username      string       `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password      *StaticToken `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KafkaSink) Reset() {
+	*x = KafkaSink{}
+	mi := &file_proto_config_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitLabConfig) String() string {
+func (x *KafkaSink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitLabConfig) ProtoMessage() {}
+func (*KafkaSink) ProtoMessage() {}
 
-func (x *GitLabConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_config_proto_msgTypes[4]
+func (x *KafkaSink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,69 +3652,67 @@ func (x *GitLabConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitLabConfig.ProtoReflect.Descriptor instead.
-func (*GitLabConfig) Descriptor() ([]byte, []int) {
-	return file_proto_config_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use KafkaSink.ProtoReflect.Descriptor instead.
+func (*KafkaSink) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GitLabConfig) GetEnterpriseUrl() string {
+func (x *KafkaSink) GetRestProxyUrl() string {
 	if x != nil {
-		return x.EnterpriseUrl
+		return x.RestProxyUrl
 	}
 	return ""
 }
 
-func (x *GitLabConfig) GetAuthentication() isGitLabConfig_Authentication {
+func (x *KafkaSink) GetTopic() string {
 	if x != nil {
-		return x.Authentication
+		return x.Topic
 	}
-	return nil
+	return ""
 }
 
-func (x *GitLabConfig) GetStaticToken() *StaticToken {
+func (x *KafkaSink) GetUsername() string {
 	if x != nil {
-		if x, ok := x.Authentication.(*GitLabConfig_StaticToken); ok {
-			return x.StaticToken
-		}
+		return x.Username
 	}
-	return nil
-}
-
-type isGitLabConfig_Authentication interface {
-	isGitLabConfig_Authentication()
+	return ""
 }
 
-type GitLabConfig_StaticToken struct {
-	StaticToken *StaticToken `protobuf:"bytes,2,opt,name=static_token,json=staticToken,proto3,oneof"`
+func (x *KafkaSink) GetPassword() *StaticToken {
+	if x != nil {
+		return x.Password
+	}
+	return nil
 }
 
-func (*GitLabConfig_StaticToken) isGitLabConfig_Authentication() {}
-
-type SourceConfig struct {
+// WebhookSink posts the events of each target group as a JSON object with
+// an "events" list to a URL.
+type WebhookSink struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Config:
-	//
-	//	*SourceConfig_GoogleGroupsConfig
-	Config        isSourceConfig_Config `protobuf_oneof:"config"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// When set, requests carry the hex encoded HMAC-SHA256 of the body with
+	// this secret in the X-Team-Link-Signature header, as sha256=HMAC.
+	// Optional.
+	SigningSecret *StaticToken `protobuf:"bytes,2,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SourceConfig) Reset() {
-	*x = SourceConfig{}
-	mi := &file_proto_config_proto_msgTypes[5]
+func (x *WebhookSink) Reset() {
+	*x = WebhookSink{}
+	mi := &file_proto_config_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SourceConfig) String() string {
+func (x *WebhookSink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SourceConfig) ProtoMessage() {}
+func (*WebhookSink) ProtoMessage() {}
 
-func (x *SourceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_config_proto_msgTypes[5]
+func (x *WebhookSink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,63 +3723,60 @@ func (x *SourceConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SourceConfig.ProtoReflect.Descriptor instead.
-func (*SourceConfig) Descriptor() ([]byte, []int) {
-	return file_proto_config_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use WebhookSink.ProtoReflect.Descriptor instead.
+func (*WebhookSink) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *SourceConfig) GetConfig() isSourceConfig_Config {
+func (x *WebhookSink) GetUrl() string {
 	if x != nil {
-		return x.Config
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *SourceConfig) GetGoogleGroupsConfig() *GoogleGroupsConfig {
+func (x *WebhookSink) GetSigningSecret() *StaticToken {
 	if x != nil {
-		if x, ok := x.Config.(*SourceConfig_GoogleGroupsConfig); ok {
-			return x.GoogleGroupsConfig
-		}
+		return x.SigningSecret
 	}
 	return nil
 }
 
-type isSourceConfig_Config interface {
-	isSourceConfig_Config()
-}
-
-type SourceConfig_GoogleGroupsConfig struct {
-	GoogleGroupsConfig *GoogleGroupsConfig `protobuf:"bytes,1,opt,name=google_groups_config,json=googleGroupsConfig,proto3,oneof"`
-}
-
-func (*SourceConfig_GoogleGroupsConfig) isSourceConfig_Config() {}
-
-type TargetConfig struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Config:
-	//
-	//	*TargetConfig_GithubConfig
-	//	*TargetConfig_GitlabConfig
-	Config        isTargetConfig_Config `protobuf_oneof:"config"`
+type TeamLinkConfig struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SourceConfig *SourceConfig          `protobuf:"bytes,1,opt,name=source_config,json=sourceConfig,proto3" json:"source_config,omitempty"`
+	TargetConfig *TargetConfig          `protobuf:"bytes,2,opt,name=target_config,json=targetConfig,proto3" json:"target_config,omitempty"`
+	// Signals matching users without a user mapping. Optional.
+	Identity *IdentityConfig `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Publishes the applied membership changes. Optional.
+	ChangeFeed *ChangeFeedConfig `protobuf:"bytes,4,opt,name=change_feed,json=changeFeed,proto3" json:"change_feed,omitempty"`
+	// How all target groups are synced. Optional.
+	SyncPolicy *SyncPolicy `protobuf:"bytes,5,opt,name=sync_policy,json=syncPolicy,proto3" json:"sync_policy,omitempty"`
+	// Pipelines syncing several source and target systems with one config.
+	// When set, source_config and target_config are ignored. Optional.
+	Pipelines []*Pipeline `protobuf:"bytes,6,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+	// The sandbox which shadow runs sync into instead of the target
+	// groups. Optional.
+	Shadow        *ShadowConfig `protobuf:"bytes,7,opt,name=shadow,proto3" json:"shadow,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TargetConfig) Reset() {
-	*x = TargetConfig{}
-	mi := &file_proto_config_proto_msgTypes[6]
+func (x *TeamLinkConfig) Reset() {
+	*x = TeamLinkConfig{}
+	mi := &file_proto_config_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TargetConfig) String() string {
+func (x *TeamLinkConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TargetConfig) ProtoMessage() {}
+func (*TeamLinkConfig) ProtoMessage() {}
 
-func (x *TargetConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_config_proto_msgTypes[6]
+func (x *TeamLinkConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -439,75 +3787,157 @@ func (x *TargetConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TargetConfig.ProtoReflect.Descriptor instead.
-func (*TargetConfig) Descriptor() ([]byte, []int) {
-	return file_proto_config_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use TeamLinkConfig.ProtoReflect.Descriptor instead.
+func (*TeamLinkConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *TargetConfig) GetConfig() isTargetConfig_Config {
+func (x *TeamLinkConfig) GetSourceConfig() *SourceConfig {
 	if x != nil {
-		return x.Config
+		return x.SourceConfig
 	}
 	return nil
 }
 
-func (x *TargetConfig) GetGithubConfig() *GitHubConfig {
+func (x *TeamLinkConfig) GetTargetConfig() *TargetConfig {
 	if x != nil {
-		if x, ok := x.Config.(*TargetConfig_GithubConfig); ok {
-			return x.GithubConfig
-		}
+		return x.TargetConfig
 	}
 	return nil
 }
 
-func (x *TargetConfig) GetGitlabConfig() *GitLabConfig {
+func (x *TeamLinkConfig) GetIdentity() *IdentityConfig {
 	if x != nil {
-		if x, ok := x.Config.(*TargetConfig_GitlabConfig); ok {
-			return x.GitlabConfig
-		}
+		return x.Identity
 	}
 	return nil
 }
 
-type isTargetConfig_Config interface {
-	isTargetConfig_Config()
+func (x *TeamLinkConfig) GetChangeFeed() *ChangeFeedConfig {
+	if x != nil {
+		return x.ChangeFeed
+	}
+	return nil
 }
 
-type TargetConfig_GithubConfig struct {
-	GithubConfig *GitHubConfig `protobuf:"bytes,2,opt,name=github_config,json=githubConfig,proto3,oneof"`
+func (x *TeamLinkConfig) GetSyncPolicy() *SyncPolicy {
+	if x != nil {
+		return x.SyncPolicy
+	}
+	return nil
 }
 
-type TargetConfig_GitlabConfig struct {
-	GitlabConfig *GitLabConfig `protobuf:"bytes,3,opt,name=gitlab_config,json=gitlabConfig,proto3,oneof"`
+func (x *TeamLinkConfig) GetPipelines() []*Pipeline {
+	if x != nil {
+		return x.Pipelines
+	}
+	return nil
 }
 
-func (*TargetConfig_GithubConfig) isTargetConfig_Config() {}
+func (x *TeamLinkConfig) GetShadow() *ShadowConfig {
+	if x != nil {
+		return x.Shadow
+	}
+	return nil
+}
 
-func (*TargetConfig_GitlabConfig) isTargetConfig_Config() {}
+// ShadowConfig configures shadow runs, which sync the production source
+// groups into copies of the GitHub target teams in a sandbox org, so that
+// config changes and new versions can be rehearsed without touching the
+// production teams. The copy of a team is the team of the sandbox org whose
+// slug is the slug of the production team with team_slug_prefix prepended.
+// Missing copies are created.
+type ShadowConfig struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GithubOrgId int64                  `protobuf:"varint,1,opt,name=github_org_id,json=githubOrgId,proto3" json:"github_org_id,omitempty"`
+	// Prefix of the slugs of the copies. Defaults to "shadow-".
+	TeamSlugPrefix string `protobuf:"bytes,2,opt,name=team_slug_prefix,json=teamSlugPrefix,proto3" json:"team_slug_prefix,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-type TeamLinkConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SourceConfig  *SourceConfig          `protobuf:"bytes,1,opt,name=source_config,json=sourceConfig,proto3" json:"source_config,omitempty"`
-	TargetConfig  *TargetConfig          `protobuf:"bytes,2,opt,name=target_config,json=targetConfig,proto3" json:"target_config,omitempty"`
+func (x *ShadowConfig) Reset() {
+	*x = ShadowConfig{}
+	mi := &file_proto_config_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShadowConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShadowConfig) ProtoMessage() {}
+
+func (x *ShadowConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShadowConfig.ProtoReflect.Descriptor instead.
+func (*ShadowConfig) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ShadowConfig) GetGithubOrgId() int64 {
+	if x != nil {
+		return x.GithubOrgId
+	}
+	return 0
+}
+
+func (x *ShadowConfig) GetTeamSlugPrefix() string {
+	if x != nil {
+		return x.TeamSlugPrefix
+	}
+	return ""
+}
+
+// Pipeline syncs the groups of one or more source systems to one or more
+// target systems, e.g. Google Groups to both GitHub and GitLab. Every source
+// is synced to every target, one pair after the other, with the identity,
+// change_feed and sync_policy of the config unless the pipeline overrides
+// them.
+type Pipeline struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unique name of the pipeline, used in logs and to select it with
+	// -pipeline.
+	Name    string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Sources []*SourceConfig `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	Targets []*TargetConfig `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
+	// The mapping files of the pipeline, relative to the config file. Their
+	// group and user mappings are concatenated in order. Defaults to the
+	// mapping file given with -mapping.
+	MappingFiles []string `protobuf:"bytes,4,rep,name=mapping_files,json=mappingFiles,proto3" json:"mapping_files,omitempty"`
+	// How the target groups of the pipeline are synced, instead of the
+	// sync_policy of the config. Optional.
+	SyncPolicy    *SyncPolicy `protobuf:"bytes,5,opt,name=sync_policy,json=syncPolicy,proto3" json:"sync_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TeamLinkConfig) Reset() {
-	*x = TeamLinkConfig{}
-	mi := &file_proto_config_proto_msgTypes[7]
+func (x *Pipeline) Reset() {
+	*x = Pipeline{}
+	mi := &file_proto_config_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TeamLinkConfig) String() string {
+func (x *Pipeline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TeamLinkConfig) ProtoMessage() {}
+func (*Pipeline) ProtoMessage() {}
 
-func (x *TeamLinkConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_config_proto_msgTypes[7]
+func (x *Pipeline) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_config_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,21 +3948,42 @@ func (x *TeamLinkConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TeamLinkConfig.ProtoReflect.Descriptor instead.
-func (*TeamLinkConfig) Descriptor() ([]byte, []int) {
-	return file_proto_config_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Pipeline.ProtoReflect.Descriptor instead.
+func (*Pipeline) Descriptor() ([]byte, []int) {
+	return file_proto_config_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *TeamLinkConfig) GetSourceConfig() *SourceConfig {
+func (x *Pipeline) GetName() string {
 	if x != nil {
-		return x.SourceConfig
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Pipeline) GetSources() []*SourceConfig {
+	if x != nil {
+		return x.Sources
 	}
 	return nil
 }
 
-func (x *TeamLinkConfig) GetTargetConfig() *TargetConfig {
+func (x *Pipeline) GetTargets() []*TargetConfig {
 	if x != nil {
-		return x.TargetConfig
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *Pipeline) GetMappingFiles() []string {
+	if x != nil {
+		return x.MappingFiles
+	}
+	return nil
+}
+
+func (x *Pipeline) GetSyncPolicy() *SyncPolicy {
+	if x != nil {
+		return x.SyncPolicy
 	}
 	return nil
 }
@@ -541,54 +3992,553 @@ var File_proto_config_proto protoreflect.FileDescriptor
 
 var file_proto_config_proto_rawDesc = string([]byte{
 	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x22,
-	0x38, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29,
-	0x0a, 0x10, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x6e,
-	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x09, 0x47, 0x69, 0x74,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x1a,
+	0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x56, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x72, 0x6f,
+	0x6d, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x22, 0x6c, 0x0a, 0x18, 0x4f, 0x72,
+	0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x38,
+	0x0a, 0x18, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x16, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xe3, 0x01, 0x0a, 0x09, 0x47, 0x69, 0x74,
 	0x48, 0x75, 0x62, 0x41, 0x70, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x21, 0x0a,
 	0x0c, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0xba, 0x01, 0x0a, 0x0c, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x74, 0x65, 0x72,
-	0x70, 0x72, 0x69, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74,
-	0x69, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x12, 0x3f, 0x0a, 0x1c, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xde,
+	0x01, 0x0a, 0x0f, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x41, 0x70, 0x70, 0x73, 0x42, 0x79, 0x4f,
+	0x72, 0x67, 0x12, 0x42, 0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x61, 0x70, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x41, 0x70, 0x70, 0x73, 0x42, 0x79, 0x4f, 0x72, 0x67,
+	0x2e, 0x4f, 0x72, 0x67, 0x41, 0x70, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f,
+	0x72, 0x67, 0x41, 0x70, 0x70, 0x73, 0x12, 0x35, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x61, 0x70, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x41, 0x70,
+	0x70, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x70, 0x70, 0x1a, 0x50, 0x0a,
+	0x0c, 0x4f, 0x72, 0x67, 0x41, 0x70, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75,
+	0x62, 0x41, 0x70, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xec, 0x02, 0x0a, 0x0c, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x75,
+	0x74, 0x68, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x68, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x41, 0x70, 0x70, 0x48, 0x00, 0x52,
+	0x09, 0x67, 0x68, 0x41, 0x70, 0x70, 0x41, 0x75, 0x74, 0x68, 0x12, 0x47, 0x0a, 0x0c, 0x65, 0x6e,
+	0x76, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x72, 0x67,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6e, 0x76, 0x4f, 0x72, 0x67, 0x41,
+	0x75, 0x74, 0x68, 0x12, 0x4a, 0x0a, 0x13, 0x67, 0x68, 0x5f, 0x61, 0x70, 0x70, 0x73, 0x5f, 0x62,
+	0x79, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74,
+	0x48, 0x75, 0x62, 0x41, 0x70, 0x70, 0x73, 0x42, 0x79, 0x4f, 0x72, 0x67, 0x48, 0x00, 0x52, 0x0f,
+	0x67, 0x68, 0x41, 0x70, 0x70, 0x73, 0x42, 0x79, 0x4f, 0x72, 0x67, 0x41, 0x75, 0x74, 0x68, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x10, 0x0a, 0x0e,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x82,
+	0x01, 0x0a, 0x12, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x0f, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x22, 0xe7, 0x01, 0x0a, 0x13, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x0a, 0x14, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x77, 0x68, 0x6f, 0x5f, 0x63, 0x61, 0x6e, 0x5f, 0x6a,
+	0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x57, 0x68, 0x6f, 0x43, 0x61, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x16,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x3d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x2a, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x22, 0x99, 0x02,
+	0x0a, 0x0c, 0x47, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25,
+	0x0a, 0x0e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x47, 0x0a, 0x0e, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4a, 0x0a, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x14, 0x47, 0x69, 0x74,
+	0x4c, 0x61, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x96, 0x01, 0x0a,
+	0x13, 0x47, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x79, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74,
+	0x79, 0x44, 0x61, 0x79, 0x73, 0x22, 0x79, 0x0a, 0x0c, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x22, 0x57, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x35, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x35, 0x0a, 0x10, 0x4b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x44, 0x69, 0x72,
+	0x22, 0x73, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7f, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x30, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x52, 0x0a, 0x10, 0x4d, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6d, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x74, 0x0a, 0x10, 0x52, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0a, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41,
-	0x75, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x0b, 0x67, 0x68, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x61, 0x75,
-	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x41, 0x70, 0x70, 0x48, 0x00,
-	0x52, 0x09, 0x67, 0x68, 0x41, 0x70, 0x70, 0x41, 0x75, 0x74, 0x68, 0x42, 0x10, 0x0a, 0x0e, 0x61,
-	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a,
-	0x12, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x22, 0x84, 0x01, 0x0a, 0x0c, 0x47, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69,
-	0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e,
-	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x3b, 0x0a, 0x0c, 0x73,
-	0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x78, 0x0a, 0x0d, 0x5a, 0x65, 0x6e, 0x64, 0x65, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x33, 0x0a, 0x09, 0x61, 0x70, 0x69, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x08, 0x61, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x74, 0x0a, 0x10, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x22, 0x4e, 0x0a, 0x0c, 0x53, 0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x61,
-	0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68,
-	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x0c, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x51, 0x0a, 0x14, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x12, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x08, 0x0a,
-	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x98, 0x01, 0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x0d, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x48,
-	0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x0d, 0x67, 0x69, 0x74, 0x6c,
-	0x61, 0x62, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x4c,
-	0x61, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x69, 0x74, 0x6c,
-	0x61, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x22, 0x8c, 0x01, 0x0a, 0x0e, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x6e, 0x6b, 0x43,
+	0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x7a, 0x0a, 0x0c, 0x4c, 0x6f, 0x6f, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x3b, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0c,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x8f, 0x01, 0x0a,
+	0x0d, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x69, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xa9,
+	0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x33, 0x0a, 0x09, 0x61,
+	0x70, 0x69, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x08, 0x61, 0x70, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x38, 0x0a, 0x18, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x16, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6b, 0x0a, 0x0f, 0x4a, 0x75,
+	0x6d, 0x70, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0x7c, 0x0a, 0x0e, 0x4f, 0x6e, 0x65, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x72, 0x0a, 0x0f, 0x50, 0x61, 0x67, 0x65, 0x72, 0x44, 0x75,
+	0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x6f, 0x6f, 0x6b, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x6f, 0x6f, 0x6b, 0x61, 0x68, 0x65, 0x61, 0x64, 0x22, 0x71, 0x0a, 0x0e, 0x4f, 0x70, 0x73,
+	0x67, 0x65, 0x6e, 0x69, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x0a, 0x07, 0x61,
+	0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x6c, 0x6f, 0x6f, 0x6b, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6c, 0x6f, 0x6f, 0x6b, 0x61, 0x68, 0x65, 0x61, 0x64, 0x22, 0xa8, 0x01, 0x0a,
+	0x0d, 0x50, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25,
+	0x0a, 0x0e, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3c, 0x0a, 0x10, 0x47, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x28, 0x0a, 0x10, 0x75,
+	0x73, 0x65, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x9d, 0x01,
+	0x0a, 0x10, 0x44, 0x61, 0x74, 0x61, 0x62, 0x72, 0x69, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xc5, 0x01,
+	0x0a, 0x12, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x6f, 0x66, 0x74, 0x33, 0x36, 0x35, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3b,
+	0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0c, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x55, 0x72, 0x6c, 0x22, 0x9c, 0x01, 0x0a, 0x09, 0x42, 0x6f, 0x78, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x0d, 0x44, 0x72, 0x6f, 0x70, 0x62, 0x6f, 0x78,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x70, 0x4b, 0x65,
+	0x79, 0x12, 0x35, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x61,
+	0x70, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7e, 0x0a, 0x10, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xc9, 0x05, 0x0a, 0x0c, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x51, 0x0a, 0x14, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x12, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x0d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74,
+	0x48, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x0d, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74,
+	0x4c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x47, 0x0a, 0x10, 0x6a, 0x75, 0x6d,
+	0x70, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4a, 0x75, 0x6d, 0x70, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x00, 0x52, 0x0f, 0x6a, 0x75, 0x6d, 0x70, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x44, 0x0a, 0x0f, 0x6f, 0x6e, 0x65, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x6e, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x6e, 0x65, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x0e, 0x70, 0x69, 0x6e, 0x67,
+	0x6f, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x69, 0x6e,
+	0x67, 0x4f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x69,
+	0x6e, 0x67, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x47, 0x0a, 0x10, 0x70,
+	0x61, 0x67, 0x65, 0x72, 0x64, 0x75, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x72, 0x44, 0x75, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x61, 0x67, 0x65, 0x72, 0x64, 0x75, 0x74, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a, 0x0f, 0x6f, 0x70, 0x73, 0x67, 0x65, 0x6e, 0x69, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x70, 0x73, 0x67, 0x65, 0x6e,
+	0x69, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x70, 0x73, 0x67,
+	0x65, 0x6e, 0x69, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x07, 0x69, 0x64,
+	0x5f, 0x63, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x64, 0x43, 0x61, 0x73, 0x65, 0x52, 0x06,
+	0x69, 0x64, 0x43, 0x61, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x14, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x12, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0xec, 0x01, 0x0a, 0x12, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x73, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x40, 0x0a, 0x0a, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x63, 0x65,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x2d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x10, 0x01,
+	0x22, 0x5b, 0x0a, 0x15, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x9d, 0x0e,
+	0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e,
+	0x0a, 0x0d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
+	0x52, 0x0c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e,
+	0x0a, 0x0d, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
+	0x52, 0x0c, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e,
+	0x0a, 0x0d, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
+	0x52, 0x0c, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e,
+	0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
+	0x52, 0x0c, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4a,
+	0x0a, 0x11, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x10, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3b, 0x0a, 0x0c, 0x76, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0b, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3b, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x30, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x4a, 0x0a, 0x11, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6d, 0x6f,
+	0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6d, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x10,
+	0x6d, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6d, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x4b, 0x0a, 0x12, 0x72, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x10, 0x72, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a,
+	0x0e, 0x7a, 0x65, 0x6e, 0x64, 0x65, 0x73, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x5a, 0x65, 0x6e, 0x64, 0x65, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x00, 0x52, 0x0d, 0x7a, 0x65, 0x6e, 0x64, 0x65, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x4b, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x77, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4e, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a,
+	0x0d, 0x73, 0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
+	0x0c, 0x73, 0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a,
+	0x0d, 0x6c, 0x6f, 0x6f, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
+	0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a,
+	0x0e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x00, 0x52, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x4a, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x47, 0x0a, 0x10,
+	0x6a, 0x75, 0x6d, 0x70, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x48, 0x00, 0x52, 0x0f, 0x6a, 0x75, 0x6d, 0x70, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4a, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x62, 0x72, 0x69,
+	0x63, 0x6b, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x72, 0x69, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
+	0x10, 0x64, 0x61, 0x74, 0x61, 0x62, 0x72, 0x69, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x51, 0x0a, 0x14, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
+	0x52, 0x12, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x4b, 0x0a, 0x12, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
+	0x10, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x57, 0x0a, 0x16, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x48, 0x00, 0x52, 0x14, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x50, 0x0a, 0x13, 0x6d, 0x69,
+	0x63, 0x72, 0x6f, 0x73, 0x6f, 0x66, 0x74, 0x33, 0x36, 0x35, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x6f, 0x66, 0x74, 0x33, 0x36, 0x35,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x12, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73,
+	0x6f, 0x66, 0x74, 0x33, 0x36, 0x35, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x0a,
+	0x62, 0x6f, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x6f, 0x78,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x78, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x62, 0x6f, 0x78, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x62, 0x6f, 0x78, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x62, 0x6f, 0x78,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4a, 0x0a, 0x11, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61,
+	0x6c, 0x65, 0x73, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
+	0x52, 0x10, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x70, 0x69, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x09, 0x61, 0x70,
+	0x69, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x07, 0x69, 0x64, 0x5f, 0x63, 0x61,
+	0x73, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x64, 0x43, 0x61, 0x73, 0x65, 0x52, 0x06, 0x69, 0x64, 0x43,
+	0x61, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x63, 0x0a,
+	0x09, 0x41, 0x70, 0x69, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x50,
+	0x65, 0x72, 0x52, 0x75, 0x6e, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x6c,
+	0x6c, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x48, 0x6f,
+	0x75, 0x72, 0x22, 0xbf, 0x02, 0x0a, 0x0e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x5f, 0x73, 0x61, 0x6d, 0x6c, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x53, 0x61, 0x6d, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x34,
+	0x0a, 0x16, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x73,
+	0x63, 0x69, 0x6d, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x53, 0x63,
+	0x69, 0x6d, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x34, 0x0a,
+	0x16, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x73, 0x63, 0x69, 0x6d, 0x5f, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x53, 0x63, 0x69, 0x6d, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x14, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x5f, 0x73, 0x63,
+	0x69, 0x6d, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x53, 0x63, 0x69, 0x6d, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46,
+	0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x73, 0x75, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x53, 0x69, 0x6e, 0x6b,
+	0x48, 0x00, 0x52, 0x06, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x12, 0x2c, 0x0a, 0x05, 0x6b, 0x61,
+	0x66, 0x6b, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x61, 0x66, 0x6b, 0x61, 0x53, 0x69, 0x6e, 0x6b, 0x48,
+	0x00, 0x52, 0x05, 0x6b, 0x61, 0x66, 0x6b, 0x61, 0x12, 0x32, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x69, 0x6e,
+	0x6b, 0x48, 0x00, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x42, 0x06, 0x0a, 0x04,
+	0x73, 0x69, 0x6e, 0x6b, 0x22, 0x22, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x53, 0x69,
+	0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x4b, 0x61, 0x66,
+	0x6b, 0x61, 0x53, 0x69, 0x6e, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x5e, 0x0a, 0x0b, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x69, 0x6e,
+	0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x3d, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x22, 0x9d, 0x03, 0x0a, 0x0e, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x6e, 0x6b, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
 	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43,
@@ -597,16 +4547,59 @@ var file_proto_config_proto_rawDesc = string([]byte{
 	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x42, 0x92, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x61, 0x70, 0x69, 0x42, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61,
-	0x62, 0x63, 0x78, 0x79, 0x7a, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x2d, 0x6c, 0x69, 0x6e, 0x6b, 0x2f,
-	0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x33, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0xa2, 0x02, 0x03, 0x50, 0x41, 0x58, 0xaa, 0x02, 0x09, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x41, 0x70, 0x69, 0xca, 0x02, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x41, 0x70,
-	0x69, 0xe2, 0x02, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x46, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x46, 0x65, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x31,
+	0x0a, 0x09, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x69,
+	0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x09, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x68,
+	0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x73, 0x68, 0x61, 0x64,
+	0x6f, 0x77, 0x22, 0x5c, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x22, 0x0a, 0x0d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x6f, 0x72, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x4f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73,
+	0x6c, 0x75, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x6c, 0x75, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x22, 0xe1, 0x01, 0x0a, 0x08, 0x50, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
+	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0b,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x79,
+	0x6e, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2a, 0x51, 0x0a, 0x06, 0x49, 0x64, 0x43, 0x61, 0x73, 0x65, 0x12, 0x17,
+	0x0a, 0x13, 0x49, 0x44, 0x5f, 0x43, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x5f, 0x43, 0x41,
+	0x53, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x45, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01,
+	0x12, 0x15, 0x0a, 0x11, 0x49, 0x44, 0x5f, 0x43, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x45, 0x4e, 0x53,
+	0x49, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x42, 0x92, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x42, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x62, 0x63, 0x78, 0x79, 0x7a, 0x2f, 0x74, 0x65, 0x61, 0x6d,
+	0x2d, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xa2, 0x02, 0x03, 0x50, 0x41, 0x58, 0xaa,
+	0x02, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x69, 0xca, 0x02, 0x09, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x5c, 0x41, 0x70, 0x69, 0xe2, 0x02, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c,
+	0x41, 0x70, 0x69, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -621,31 +4614,158 @@ func file_proto_config_proto_rawDescGZIP() []byte {
 	return file_proto_config_proto_rawDescData
 }
 
-var file_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_config_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_config_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_proto_config_proto_goTypes = []any{
-	(*StaticToken)(nil),        // 0: proto.api.StaticToken
-	(*GitHubApp)(nil),          // 1: proto.api.GitHubApp
-	(*GitHubConfig)(nil),       // 2: proto.api.GitHubConfig
-	(*GoogleGroupsConfig)(nil), // 3: proto.api.GoogleGroupsConfig
-	(*GitLabConfig)(nil),       // 4: proto.api.GitLabConfig
-	(*SourceConfig)(nil),       // 5: proto.api.SourceConfig
-	(*TargetConfig)(nil),       // 6: proto.api.TargetConfig
-	(*TeamLinkConfig)(nil),     // 7: proto.api.TeamLinkConfig
+	(IdCase)(0),                      // 0: proto.api.IdCase
+	(GroupSettingsPolicy_Action)(0),  // 1: proto.api.GroupSettingsPolicy.Action
+	(MemberDomainPolicy_Action)(0),   // 2: proto.api.MemberDomainPolicy.Action
+	(*StaticToken)(nil),              // 3: proto.api.StaticToken
+	(*OrgTokensFromEnvironment)(nil), // 4: proto.api.OrgTokensFromEnvironment
+	(*GitHubApp)(nil),                // 5: proto.api.GitHubApp
+	(*GitHubAppsByOrg)(nil),          // 6: proto.api.GitHubAppsByOrg
+	(*GitHubConfig)(nil),             // 7: proto.api.GitHubConfig
+	(*GoogleGroupsConfig)(nil),       // 8: proto.api.GoogleGroupsConfig
+	(*GroupSettingsPolicy)(nil),      // 9: proto.api.GroupSettingsPolicy
+	(*GitLabConfig)(nil),             // 10: proto.api.GitLabConfig
+	(*GitLabServiceAccount)(nil),     // 11: proto.api.GitLabServiceAccount
+	(*GitLabRotatingToken)(nil),      // 12: proto.api.GitLabRotatingToken
+	(*GerritConfig)(nil),             // 13: proto.api.GerritConfig
+	(*SentryConfig)(nil),             // 14: proto.api.SentryConfig
+	(*KubernetesConfig)(nil),         // 15: proto.api.KubernetesConfig
+	(*VaultConfig)(nil),              // 16: proto.api.VaultConfig
+	(*Auth0Config)(nil),              // 17: proto.api.Auth0Config
+	(*MattermostConfig)(nil),         // 18: proto.api.MattermostConfig
+	(*RocketChatConfig)(nil),         // 19: proto.api.RocketChatConfig
+	(*ZendeskConfig)(nil),            // 20: proto.api.ZendeskConfig
+	(*ServiceNowConfig)(nil),         // 21: proto.api.ServiceNowConfig
+	(*SplunkConfig)(nil),             // 22: proto.api.SplunkConfig
+	(*LookerConfig)(nil),             // 23: proto.api.LookerConfig
+	(*TableauConfig)(nil),            // 24: proto.api.TableauConfig
+	(*ConfluenceConfig)(nil),         // 25: proto.api.ConfluenceConfig
+	(*JumpCloudConfig)(nil),          // 26: proto.api.JumpCloudConfig
+	(*OneLoginConfig)(nil),           // 27: proto.api.OneLoginConfig
+	(*PagerDutyConfig)(nil),          // 28: proto.api.PagerDutyConfig
+	(*OpsgenieConfig)(nil),           // 29: proto.api.OpsgenieConfig
+	(*PingOneConfig)(nil),            // 30: proto.api.PingOneConfig
+	(*GoogleChatConfig)(nil),         // 31: proto.api.GoogleChatConfig
+	(*GoogleCalendarConfig)(nil),     // 32: proto.api.GoogleCalendarConfig
+	(*DatabricksConfig)(nil),         // 33: proto.api.DatabricksConfig
+	(*Microsoft365Config)(nil),       // 34: proto.api.Microsoft365Config
+	(*BoxConfig)(nil),                // 35: proto.api.BoxConfig
+	(*DropboxConfig)(nil),            // 36: proto.api.DropboxConfig
+	(*SalesforceConfig)(nil),         // 37: proto.api.SalesforceConfig
+	(*SourceConfig)(nil),             // 38: proto.api.SourceConfig
+	(*MemberDomainPolicy)(nil),       // 39: proto.api.MemberDomainPolicy
+	(*MemberDomainException)(nil),    // 40: proto.api.MemberDomainException
+	(*TargetConfig)(nil),             // 41: proto.api.TargetConfig
+	(*ApiBudget)(nil),                // 42: proto.api.ApiBudget
+	(*IdentityConfig)(nil),           // 43: proto.api.IdentityConfig
+	(*ChangeFeedConfig)(nil),         // 44: proto.api.ChangeFeedConfig
+	(*PubSubSink)(nil),               // 45: proto.api.PubSubSink
+	(*KafkaSink)(nil),                // 46: proto.api.KafkaSink
+	(*WebhookSink)(nil),              // 47: proto.api.WebhookSink
+	(*TeamLinkConfig)(nil),           // 48: proto.api.TeamLinkConfig
+	(*ShadowConfig)(nil),             // 49: proto.api.ShadowConfig
+	(*Pipeline)(nil),                 // 50: proto.api.Pipeline
+	nil,                              // 51: proto.api.GitHubAppsByOrg.OrgAppsEntry
+	(*SyncPolicy)(nil),               // 52: proto.api.SyncPolicy
 }
 var file_proto_config_proto_depIdxs = []int32{
-	0, // 0: proto.api.GitHubConfig.static_auth:type_name -> proto.api.StaticToken
-	1, // 1: proto.api.GitHubConfig.gh_app_auth:type_name -> proto.api.GitHubApp
-	0, // 2: proto.api.GitLabConfig.static_token:type_name -> proto.api.StaticToken
-	3, // 3: proto.api.SourceConfig.google_groups_config:type_name -> proto.api.GoogleGroupsConfig
-	2, // 4: proto.api.TargetConfig.github_config:type_name -> proto.api.GitHubConfig
-	4, // 5: proto.api.TargetConfig.gitlab_config:type_name -> proto.api.GitLabConfig
-	5, // 6: proto.api.TeamLinkConfig.source_config:type_name -> proto.api.SourceConfig
-	6, // 7: proto.api.TeamLinkConfig.target_config:type_name -> proto.api.TargetConfig
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	51, // 0: proto.api.GitHubAppsByOrg.org_apps:type_name -> proto.api.GitHubAppsByOrg.OrgAppsEntry
+	5,  // 1: proto.api.GitHubAppsByOrg.default_app:type_name -> proto.api.GitHubApp
+	3,  // 2: proto.api.GitHubConfig.static_auth:type_name -> proto.api.StaticToken
+	5,  // 3: proto.api.GitHubConfig.gh_app_auth:type_name -> proto.api.GitHubApp
+	4,  // 4: proto.api.GitHubConfig.env_org_auth:type_name -> proto.api.OrgTokensFromEnvironment
+	6,  // 5: proto.api.GitHubConfig.gh_apps_by_org_auth:type_name -> proto.api.GitHubAppsByOrg
+	9,  // 6: proto.api.GoogleGroupsConfig.settings_policy:type_name -> proto.api.GroupSettingsPolicy
+	1,  // 7: proto.api.GroupSettingsPolicy.action:type_name -> proto.api.GroupSettingsPolicy.Action
+	3,  // 8: proto.api.GitLabConfig.static_token:type_name -> proto.api.StaticToken
+	12, // 9: proto.api.GitLabConfig.rotating_token:type_name -> proto.api.GitLabRotatingToken
+	11, // 10: proto.api.GitLabConfig.service_accounts:type_name -> proto.api.GitLabServiceAccount
+	3,  // 11: proto.api.GitLabRotatingToken.token:type_name -> proto.api.StaticToken
+	3,  // 12: proto.api.GerritConfig.http_password:type_name -> proto.api.StaticToken
+	3,  // 13: proto.api.SentryConfig.auth_token:type_name -> proto.api.StaticToken
+	3,  // 14: proto.api.VaultConfig.token:type_name -> proto.api.StaticToken
+	3,  // 15: proto.api.Auth0Config.client_secret:type_name -> proto.api.StaticToken
+	3,  // 16: proto.api.MattermostConfig.token:type_name -> proto.api.StaticToken
+	3,  // 17: proto.api.RocketChatConfig.auth_token:type_name -> proto.api.StaticToken
+	3,  // 18: proto.api.ZendeskConfig.api_token:type_name -> proto.api.StaticToken
+	3,  // 19: proto.api.ServiceNowConfig.password:type_name -> proto.api.StaticToken
+	3,  // 20: proto.api.SplunkConfig.token:type_name -> proto.api.StaticToken
+	3,  // 21: proto.api.LookerConfig.client_secret:type_name -> proto.api.StaticToken
+	3,  // 22: proto.api.TableauConfig.token_secret:type_name -> proto.api.StaticToken
+	3,  // 23: proto.api.ConfluenceConfig.api_token:type_name -> proto.api.StaticToken
+	3,  // 24: proto.api.JumpCloudConfig.api_key:type_name -> proto.api.StaticToken
+	3,  // 25: proto.api.OneLoginConfig.client_secret:type_name -> proto.api.StaticToken
+	3,  // 26: proto.api.PagerDutyConfig.api_key:type_name -> proto.api.StaticToken
+	3,  // 27: proto.api.OpsgenieConfig.api_key:type_name -> proto.api.StaticToken
+	3,  // 28: proto.api.PingOneConfig.client_secret:type_name -> proto.api.StaticToken
+	3,  // 29: proto.api.DatabricksConfig.client_secret:type_name -> proto.api.StaticToken
+	3,  // 30: proto.api.Microsoft365Config.client_secret:type_name -> proto.api.StaticToken
+	3,  // 31: proto.api.BoxConfig.client_secret:type_name -> proto.api.StaticToken
+	3,  // 32: proto.api.DropboxConfig.app_secret:type_name -> proto.api.StaticToken
+	3,  // 33: proto.api.DropboxConfig.refresh_token:type_name -> proto.api.StaticToken
+	3,  // 34: proto.api.SalesforceConfig.client_secret:type_name -> proto.api.StaticToken
+	8,  // 35: proto.api.SourceConfig.google_groups_config:type_name -> proto.api.GoogleGroupsConfig
+	7,  // 36: proto.api.SourceConfig.github_config:type_name -> proto.api.GitHubConfig
+	10, // 37: proto.api.SourceConfig.gitlab_config:type_name -> proto.api.GitLabConfig
+	26, // 38: proto.api.SourceConfig.jumpcloud_config:type_name -> proto.api.JumpCloudConfig
+	27, // 39: proto.api.SourceConfig.onelogin_config:type_name -> proto.api.OneLoginConfig
+	30, // 40: proto.api.SourceConfig.pingone_config:type_name -> proto.api.PingOneConfig
+	28, // 41: proto.api.SourceConfig.pagerduty_config:type_name -> proto.api.PagerDutyConfig
+	29, // 42: proto.api.SourceConfig.opsgenie_config:type_name -> proto.api.OpsgenieConfig
+	0,  // 43: proto.api.SourceConfig.id_case:type_name -> proto.api.IdCase
+	39, // 44: proto.api.SourceConfig.member_domain_policy:type_name -> proto.api.MemberDomainPolicy
+	2,  // 45: proto.api.MemberDomainPolicy.action:type_name -> proto.api.MemberDomainPolicy.Action
+	40, // 46: proto.api.MemberDomainPolicy.exceptions:type_name -> proto.api.MemberDomainException
+	7,  // 47: proto.api.TargetConfig.github_config:type_name -> proto.api.GitHubConfig
+	10, // 48: proto.api.TargetConfig.gitlab_config:type_name -> proto.api.GitLabConfig
+	13, // 49: proto.api.TargetConfig.gerrit_config:type_name -> proto.api.GerritConfig
+	14, // 50: proto.api.TargetConfig.sentry_config:type_name -> proto.api.SentryConfig
+	15, // 51: proto.api.TargetConfig.kubernetes_config:type_name -> proto.api.KubernetesConfig
+	16, // 52: proto.api.TargetConfig.vault_config:type_name -> proto.api.VaultConfig
+	17, // 53: proto.api.TargetConfig.auth0_config:type_name -> proto.api.Auth0Config
+	18, // 54: proto.api.TargetConfig.mattermost_config:type_name -> proto.api.MattermostConfig
+	19, // 55: proto.api.TargetConfig.rocket_chat_config:type_name -> proto.api.RocketChatConfig
+	20, // 56: proto.api.TargetConfig.zendesk_config:type_name -> proto.api.ZendeskConfig
+	21, // 57: proto.api.TargetConfig.service_now_config:type_name -> proto.api.ServiceNowConfig
+	22, // 58: proto.api.TargetConfig.splunk_config:type_name -> proto.api.SplunkConfig
+	23, // 59: proto.api.TargetConfig.looker_config:type_name -> proto.api.LookerConfig
+	24, // 60: proto.api.TargetConfig.tableau_config:type_name -> proto.api.TableauConfig
+	25, // 61: proto.api.TargetConfig.confluence_config:type_name -> proto.api.ConfluenceConfig
+	26, // 62: proto.api.TargetConfig.jumpcloud_config:type_name -> proto.api.JumpCloudConfig
+	33, // 63: proto.api.TargetConfig.databricks_config:type_name -> proto.api.DatabricksConfig
+	8,  // 64: proto.api.TargetConfig.google_groups_config:type_name -> proto.api.GoogleGroupsConfig
+	31, // 65: proto.api.TargetConfig.google_chat_config:type_name -> proto.api.GoogleChatConfig
+	32, // 66: proto.api.TargetConfig.google_calendar_config:type_name -> proto.api.GoogleCalendarConfig
+	34, // 67: proto.api.TargetConfig.microsoft365_config:type_name -> proto.api.Microsoft365Config
+	35, // 68: proto.api.TargetConfig.box_config:type_name -> proto.api.BoxConfig
+	36, // 69: proto.api.TargetConfig.dropbox_config:type_name -> proto.api.DropboxConfig
+	37, // 70: proto.api.TargetConfig.salesforce_config:type_name -> proto.api.SalesforceConfig
+	42, // 71: proto.api.TargetConfig.api_budget:type_name -> proto.api.ApiBudget
+	0,  // 72: proto.api.TargetConfig.id_case:type_name -> proto.api.IdCase
+	45, // 73: proto.api.ChangeFeedConfig.pubsub:type_name -> proto.api.PubSubSink
+	46, // 74: proto.api.ChangeFeedConfig.kafka:type_name -> proto.api.KafkaSink
+	47, // 75: proto.api.ChangeFeedConfig.webhook:type_name -> proto.api.WebhookSink
+	3,  // 76: proto.api.KafkaSink.password:type_name -> proto.api.StaticToken
+	3,  // 77: proto.api.WebhookSink.signing_secret:type_name -> proto.api.StaticToken
+	38, // 78: proto.api.TeamLinkConfig.source_config:type_name -> proto.api.SourceConfig
+	41, // 79: proto.api.TeamLinkConfig.target_config:type_name -> proto.api.TargetConfig
+	43, // 80: proto.api.TeamLinkConfig.identity:type_name -> proto.api.IdentityConfig
+	44, // 81: proto.api.TeamLinkConfig.change_feed:type_name -> proto.api.ChangeFeedConfig
+	52, // 82: proto.api.TeamLinkConfig.sync_policy:type_name -> proto.api.SyncPolicy
+	50, // 83: proto.api.TeamLinkConfig.pipelines:type_name -> proto.api.Pipeline
+	49, // 84: proto.api.TeamLinkConfig.shadow:type_name -> proto.api.ShadowConfig
+	38, // 85: proto.api.Pipeline.sources:type_name -> proto.api.SourceConfig
+	41, // 86: proto.api.Pipeline.targets:type_name -> proto.api.TargetConfig
+	52, // 87: proto.api.Pipeline.sync_policy:type_name -> proto.api.SyncPolicy
+	5,  // 88: proto.api.GitHubAppsByOrg.OrgAppsEntry.value:type_name -> proto.api.GitHubApp
+	89, // [89:89] is the sub-list for method output_type
+	89, // [89:89] is the sub-list for method input_type
+	89, // [89:89] is the sub-list for extension type_name
+	89, // [89:89] is the sub-list for extension extendee
+	0,  // [0:89] is the sub-list for field type_name
 }
 
 func init() { file_proto_config_proto_init() }
@@ -653,32 +4773,71 @@ func file_proto_config_proto_init() {
 	if File_proto_config_proto != nil {
 		return
 	}
-	file_proto_config_proto_msgTypes[2].OneofWrappers = []any{
+	file_proto_group_proto_init()
+	file_proto_config_proto_msgTypes[4].OneofWrappers = []any{
 		(*GitHubConfig_StaticAuth)(nil),
 		(*GitHubConfig_GhAppAuth)(nil),
+		(*GitHubConfig_EnvOrgAuth)(nil),
+		(*GitHubConfig_GhAppsByOrgAuth)(nil),
 	}
-	file_proto_config_proto_msgTypes[4].OneofWrappers = []any{
+	file_proto_config_proto_msgTypes[7].OneofWrappers = []any{
 		(*GitLabConfig_StaticToken)(nil),
+		(*GitLabConfig_RotatingToken)(nil),
 	}
-	file_proto_config_proto_msgTypes[5].OneofWrappers = []any{
+	file_proto_config_proto_msgTypes[35].OneofWrappers = []any{
 		(*SourceConfig_GoogleGroupsConfig)(nil),
+		(*SourceConfig_GithubConfig)(nil),
+		(*SourceConfig_GitlabConfig)(nil),
+		(*SourceConfig_JumpcloudConfig)(nil),
+		(*SourceConfig_OneloginConfig)(nil),
+		(*SourceConfig_PingoneConfig)(nil),
+		(*SourceConfig_PagerdutyConfig)(nil),
+		(*SourceConfig_OpsgenieConfig)(nil),
 	}
-	file_proto_config_proto_msgTypes[6].OneofWrappers = []any{
+	file_proto_config_proto_msgTypes[38].OneofWrappers = []any{
 		(*TargetConfig_GithubConfig)(nil),
 		(*TargetConfig_GitlabConfig)(nil),
+		(*TargetConfig_GerritConfig)(nil),
+		(*TargetConfig_SentryConfig)(nil),
+		(*TargetConfig_KubernetesConfig)(nil),
+		(*TargetConfig_VaultConfig)(nil),
+		(*TargetConfig_Auth0Config)(nil),
+		(*TargetConfig_MattermostConfig)(nil),
+		(*TargetConfig_RocketChatConfig)(nil),
+		(*TargetConfig_ZendeskConfig)(nil),
+		(*TargetConfig_ServiceNowConfig)(nil),
+		(*TargetConfig_SplunkConfig)(nil),
+		(*TargetConfig_LookerConfig)(nil),
+		(*TargetConfig_TableauConfig)(nil),
+		(*TargetConfig_ConfluenceConfig)(nil),
+		(*TargetConfig_JumpcloudConfig)(nil),
+		(*TargetConfig_DatabricksConfig)(nil),
+		(*TargetConfig_GoogleGroupsConfig)(nil),
+		(*TargetConfig_GoogleChatConfig)(nil),
+		(*TargetConfig_GoogleCalendarConfig)(nil),
+		(*TargetConfig_Microsoft365Config)(nil),
+		(*TargetConfig_BoxConfig)(nil),
+		(*TargetConfig_DropboxConfig)(nil),
+		(*TargetConfig_SalesforceConfig)(nil),
+	}
+	file_proto_config_proto_msgTypes[41].OneofWrappers = []any{
+		(*ChangeFeedConfig_Pubsub)(nil),
+		(*ChangeFeedConfig_Kafka)(nil),
+		(*ChangeFeedConfig_Webhook)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_config_proto_rawDesc), len(file_proto_config_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      3,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_config_proto_goTypes,
 		DependencyIndexes: file_proto_config_proto_depIdxs,
+		EnumInfos:         file_proto_config_proto_enumTypes,
 		MessageInfos:      file_proto_config_proto_msgTypes,
 	}.Build()
 	File_proto_config_proto = out.File
@@ -40,8 +40,28 @@ type GitHub struct {
 	OrgId                int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
 	TeamId               int64                  `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 	RequireUserEnableSso bool                   `protobuf:"varint,3,opt,name=require_user_enable_sso,json=requireUserEnableSso,proto3" json:"require_user_enable_sso,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// The slug of the team. When team_id is unset the team is resolved by
+	// its slug. Teams are always tracked by their immutable ID, so renaming
+	// a team does not break syncing, but the rename is reported so that the
+	// slug here can be updated.
+	TeamSlug string `protobuf:"bytes,4,opt,name=team_slug,json=teamSlug,proto3" json:"team_slug,omitempty"`
+	// Repository permissions granted to the team. They are applied after the
+	// members of the team are synced, when GitHub is the target. Grants only
+	// add or raise permissions: the team keeps its access to repositories
+	// matching no grant.
+	RepoPermissions []*RepoPermission `protobuf:"bytes,5,rep,name=repo_permissions,json=repoPermissions,proto3" json:"repo_permissions,omitempty"`
+	// Deployment environments of the org's repositories which the team is a
+	// required reviewer of, when GitHub is the target. The required
+	// reviewers of each listed environment are exactly the teams listing it:
+	// other teams and users are removed.
+	ReviewerEnvironments []*GitHubEnvironment `protobuf:"bytes,6,rep,name=reviewer_environments,json=reviewerEnvironments,proto3" json:"reviewer_environments,omitempty"`
+	// Protected branches of the org's repositories which the team may push
+	// to or bypass the required pull requests of, when GitHub is the target.
+	// The teams having an allowance on a listed branch are exactly the teams
+	// listing it with that allowance; users and apps keep theirs.
+	BranchAllowances []*BranchAllowance `protobuf:"bytes,7,rep,name=branch_allowances,json=branchAllowances,proto3" json:"branch_allowances,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GitHub) Reset() {
@@ -95,6 +115,222 @@ func (x *GitHub) GetRequireUserEnableSso() bool {
 	return false
 }
 
+func (x *GitHub) GetTeamSlug() string {
+	if x != nil {
+		return x.TeamSlug
+	}
+	return ""
+}
+
+func (x *GitHub) GetRepoPermissions() []*RepoPermission {
+	if x != nil {
+		return x.RepoPermissions
+	}
+	return nil
+}
+
+func (x *GitHub) GetReviewerEnvironments() []*GitHubEnvironment {
+	if x != nil {
+		return x.ReviewerEnvironments
+	}
+	return nil
+}
+
+func (x *GitHub) GetBranchAllowances() []*BranchAllowance {
+	if x != nil {
+		return x.BranchAllowances
+	}
+	return nil
+}
+
+// BranchAllowance allows a team to bypass the protections of a branch.
+type BranchAllowance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the repository.
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// The name of the protected branch, e.g. "main".
+	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	// Allows the team to push to the branch, which must restrict who can
+	// push.
+	Push bool `protobuf:"varint,3,opt,name=push,proto3" json:"push,omitempty"`
+	// Allows the team to bypass the required pull requests of the branch,
+	// which must require pull requests.
+	BypassPullRequests bool `protobuf:"varint,4,opt,name=bypass_pull_requests,json=bypassPullRequests,proto3" json:"bypass_pull_requests,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BranchAllowance) Reset() {
+	*x = BranchAllowance{}
+	mi := &file_proto_group_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BranchAllowance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchAllowance) ProtoMessage() {}
+
+func (x *BranchAllowance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchAllowance.ProtoReflect.Descriptor instead.
+func (*BranchAllowance) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BranchAllowance) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *BranchAllowance) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *BranchAllowance) GetPush() bool {
+	if x != nil {
+		return x.Push
+	}
+	return false
+}
+
+func (x *BranchAllowance) GetBypassPullRequests() bool {
+	if x != nil {
+		return x.BypassPullRequests
+	}
+	return false
+}
+
+// GitHubEnvironment is a deployment environment of a repository.
+type GitHubEnvironment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the repository.
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// The name of the environment, e.g. "production".
+	Environment   string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GitHubEnvironment) Reset() {
+	*x = GitHubEnvironment{}
+	mi := &file_proto_group_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubEnvironment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubEnvironment) ProtoMessage() {}
+
+func (x *GitHubEnvironment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubEnvironment.ProtoReflect.Descriptor instead.
+func (*GitHubEnvironment) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GitHubEnvironment) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *GitHubEnvironment) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+// RepoPermission grants a team a permission on the repositories of its org
+// matching a pattern.
+type RepoPermission struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An RE2 regular expression matching the whole name of the repositories,
+	// e.g. "svc-.*". Archived repositories are skipped.
+	RepoPattern string `protobuf:"bytes,1,opt,name=repo_pattern,json=repoPattern,proto3" json:"repo_pattern,omitempty"`
+	// The permission: pull, triage, push, maintain or admin. A repository
+	// matching several grants gets the highest permission.
+	Permission    string `protobuf:"bytes,2,opt,name=permission,proto3" json:"permission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepoPermission) Reset() {
+	*x = RepoPermission{}
+	mi := &file_proto_group_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepoPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoPermission) ProtoMessage() {}
+
+func (x *RepoPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoPermission.ProtoReflect.Descriptor instead.
+func (*RepoPermission) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RepoPermission) GetRepoPattern() string {
+	if x != nil {
+		return x.RepoPattern
+	}
+	return ""
+}
+
+func (x *RepoPermission) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
 type GitLab struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
@@ -104,7 +340,7 @@ type GitLab struct {
 
 func (x *GitLab) Reset() {
 	*x = GitLab{}
-	mi := &file_proto_group_proto_msgTypes[1]
+	mi := &file_proto_group_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -116,7 +352,7 @@ func (x *GitLab) String() string {
 func (*GitLab) ProtoMessage() {}
 
 func (x *GitLab) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_proto_msgTypes[1]
+	mi := &file_proto_group_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -129,7 +365,7 @@ func (x *GitLab) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitLab.ProtoReflect.Descriptor instead.
 func (*GitLab) Descriptor() ([]byte, []int) {
-	return file_proto_group_proto_rawDescGZIP(), []int{1}
+	return file_proto_group_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GitLab) GetGroupId() int64 {
@@ -148,7 +384,7 @@ type GoogleGroups struct {
 
 func (x *GoogleGroups) Reset() {
 	*x = GoogleGroups{}
-	mi := &file_proto_group_proto_msgTypes[2]
+	mi := &file_proto_group_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -160,7 +396,7 @@ func (x *GoogleGroups) String() string {
 func (*GoogleGroups) ProtoMessage() {}
 
 func (x *GoogleGroups) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_group_proto_msgTypes[2]
+	mi := &file_proto_group_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -173,7 +409,7 @@ func (x *GoogleGroups) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoogleGroups.ProtoReflect.Descriptor instead.
 func (*GoogleGroups) Descriptor() ([]byte, []int) {
-	return file_proto_group_proto_rawDescGZIP(), []int{2}
+	return file_proto_group_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GoogleGroups) GetGroupId() string {
@@ -183,33 +419,1580 @@ func (x *GoogleGroups) GetGroupId() string {
 	return ""
 }
 
+type Gerrit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UUID of the Gerrit internal group.
+	GroupId       string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Gerrit) Reset() {
+	*x = Gerrit{}
+	mi := &file_proto_group_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Gerrit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gerrit) ProtoMessage() {}
+
+func (x *Gerrit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gerrit.ProtoReflect.Descriptor instead.
+func (*Gerrit) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Gerrit) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type Sentry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The slug of the Sentry organization.
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	// The slug of the team in the organization.
+	Team          string `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sentry) Reset() {
+	*x = Sentry{}
+	mi := &file_proto_group_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sentry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sentry) ProtoMessage() {}
+
+func (x *Sentry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sentry.ProtoReflect.Descriptor instead.
+func (*Sentry) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Sentry) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *Sentry) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+type KubernetesRoleBinding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The namespace of the RoleBinding. When unset the binding is a
+	// ClusterRoleBinding.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The name of the binding.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The kind of the bound role, Role or ClusterRole. Defaults to
+	// ClusterRole.
+	RoleKind string `protobuf:"bytes,3,opt,name=role_kind,json=roleKind,proto3" json:"role_kind,omitempty"`
+	// The name of the bound role.
+	RoleName      string `protobuf:"bytes,4,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KubernetesRoleBinding) Reset() {
+	*x = KubernetesRoleBinding{}
+	mi := &file_proto_group_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KubernetesRoleBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesRoleBinding) ProtoMessage() {}
+
+func (x *KubernetesRoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesRoleBinding.ProtoReflect.Descriptor instead.
+func (*KubernetesRoleBinding) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KubernetesRoleBinding) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *KubernetesRoleBinding) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KubernetesRoleBinding) GetRoleKind() string {
+	if x != nil {
+		return x.RoleKind
+	}
+	return ""
+}
+
+func (x *KubernetesRoleBinding) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+type Vault struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the internal identity group.
+	GroupName     string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vault) Reset() {
+	*x = Vault{}
+	mi := &file_proto_group_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vault) ProtoMessage() {}
+
+func (x *Vault) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vault.ProtoReflect.Descriptor instead.
+func (*Vault) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Vault) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type Auth0 struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the Auth0 organization, e.g. org_abc.
+	OrganizationId string `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	// The ID of the role assigned to the members of the group in the
+	// organization, e.g. rol_abc. When unset the group is the membership of
+	// the organization.
+	RoleId        string `protobuf:"bytes,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth0) Reset() {
+	*x = Auth0{}
+	mi := &file_proto_group_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth0) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth0) ProtoMessage() {}
+
+func (x *Auth0) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth0.ProtoReflect.Descriptor instead.
+func (*Auth0) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Auth0) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Auth0) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type Mattermost struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the team. Exactly one of team_id and channel_id must be set.
+	TeamId string `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// The ID of the channel.
+	ChannelId     string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mattermost) Reset() {
+	*x = Mattermost{}
+	mi := &file_proto_group_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mattermost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mattermost) ProtoMessage() {}
+
+func (x *Mattermost) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mattermost.ProtoReflect.Descriptor instead.
+func (*Mattermost) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Mattermost) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *Mattermost) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type RocketChat struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the room.
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// Whether the room is a private group rather than a public channel.
+	Private       bool `protobuf:"varint,2,opt,name=private,proto3" json:"private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RocketChat) Reset() {
+	*x = RocketChat{}
+	mi := &file_proto_group_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RocketChat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RocketChat) ProtoMessage() {}
+
+func (x *RocketChat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RocketChat.ProtoReflect.Descriptor instead.
+func (*RocketChat) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RocketChat) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *RocketChat) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+type Zendesk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the agent group.
+	GroupId       int64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Zendesk) Reset() {
+	*x = Zendesk{}
+	mi := &file_proto_group_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Zendesk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Zendesk) ProtoMessage() {}
+
+func (x *Zendesk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Zendesk.ProtoReflect.Descriptor instead.
+func (*Zendesk) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Zendesk) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type ServiceNow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The sys_id of the sys_user_group record.
+	GroupSysId    string `protobuf:"bytes,1,opt,name=group_sys_id,json=groupSysId,proto3" json:"group_sys_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceNow) Reset() {
+	*x = ServiceNow{}
+	mi := &file_proto_group_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceNow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceNow) ProtoMessage() {}
+
+func (x *ServiceNow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceNow.ProtoReflect.Descriptor instead.
+func (*ServiceNow) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ServiceNow) GetGroupSysId() string {
+	if x != nil {
+		return x.GroupSysId
+	}
+	return ""
+}
+
+type Splunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the role, e.g. "power".
+	Role          string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Splunk) Reset() {
+	*x = Splunk{}
+	mi := &file_proto_group_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Splunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Splunk) ProtoMessage() {}
+
+func (x *Splunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Splunk.ProtoReflect.Descriptor instead.
+func (*Splunk) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Splunk) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type Looker struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the group.
+	GroupId       string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Looker) Reset() {
+	*x = Looker{}
+	mi := &file_proto_group_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Looker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Looker) ProtoMessage() {}
+
+func (x *Looker) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Looker.ProtoReflect.Descriptor instead.
+func (*Looker) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Looker) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type Tableau struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the group on the site.
+	GroupName     string `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tableau) Reset() {
+	*x = Tableau{}
+	mi := &file_proto_group_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tableau) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tableau) ProtoMessage() {}
+
+func (x *Tableau) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tableau.ProtoReflect.Descriptor instead.
+func (*Tableau) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Tableau) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type OneLogin struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the role.
+	RoleId        string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OneLogin) Reset() {
+	*x = OneLogin{}
+	mi := &file_proto_group_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OneLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneLogin) ProtoMessage() {}
+
+func (x *OneLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneLogin.ProtoReflect.Descriptor instead.
+func (*OneLogin) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OneLogin) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type PingOne struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the group.
+	GroupId       string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingOne) Reset() {
+	*x = PingOne{}
+	mi := &file_proto_group_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingOne) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingOne) ProtoMessage() {}
+
+func (x *PingOne) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingOne.ProtoReflect.Descriptor instead.
+func (*PingOne) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PingOne) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type PagerDuty struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the schedule, whose members are the users on call.
+	ScheduleId    string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PagerDuty) Reset() {
+	*x = PagerDuty{}
+	mi := &file_proto_group_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PagerDuty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PagerDuty) ProtoMessage() {}
+
+func (x *PagerDuty) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PagerDuty.ProtoReflect.Descriptor instead.
+func (*PagerDuty) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PagerDuty) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type Opsgenie struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the schedule, whose members are the users on call.
+	ScheduleId    string `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Opsgenie) Reset() {
+	*x = Opsgenie{}
+	mi := &file_proto_group_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Opsgenie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Opsgenie) ProtoMessage() {}
+
+func (x *Opsgenie) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Opsgenie.ProtoReflect.Descriptor instead.
+func (*Opsgenie) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Opsgenie) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type JumpCloud struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the user group.
+	GroupId       string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JumpCloud) Reset() {
+	*x = JumpCloud{}
+	mi := &file_proto_group_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JumpCloud) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumpCloud) ProtoMessage() {}
+
+func (x *JumpCloud) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JumpCloud.ProtoReflect.Descriptor instead.
+func (*JumpCloud) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *JumpCloud) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type Databricks struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The SCIM ID of the account group.
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The entitlements of the group, e.g. "workspace-access",
+	// "databricks-sql-access" or "allow-cluster-create". When set they
+	// replace the entitlements of the group; when empty the entitlements are
+	// left alone.
+	Entitlements  []string `protobuf:"bytes,2,rep,name=entitlements,proto3" json:"entitlements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Databricks) Reset() {
+	*x = Databricks{}
+	mi := &file_proto_group_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Databricks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Databricks) ProtoMessage() {}
+
+func (x *Databricks) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Databricks.ProtoReflect.Descriptor instead.
+func (*Databricks) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Databricks) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Databricks) GetEntitlements() []string {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+type Confluence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the group.
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The space permissions of the group, only granted and revoked when the
+	// ConfluenceConfig manages space permissions.
+	SpacePermissions []*ConfluenceSpacePermission `protobuf:"bytes,2,rep,name=space_permissions,json=spacePermissions,proto3" json:"space_permissions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Confluence) Reset() {
+	*x = Confluence{}
+	mi := &file_proto_group_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Confluence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Confluence) ProtoMessage() {}
+
+func (x *Confluence) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Confluence.ProtoReflect.Descriptor instead.
+func (*Confluence) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Confluence) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Confluence) GetSpacePermissions() []*ConfluenceSpacePermission {
+	if x != nil {
+		return x.SpacePermissions
+	}
+	return nil
+}
+
+// ConfluenceSpacePermission lists the operations granted in a space. Other
+// operations of the group in the space are revoked.
+type ConfluenceSpacePermission struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The key of the space, e.g. "ENG".
+	SpaceKey string `protobuf:"bytes,1,opt,name=space_key,json=spaceKey,proto3" json:"space_key,omitempty"`
+	// Operations of the form OPERATION:TARGET, e.g. "read:space",
+	// "create:page" or "administer:space".
+	Operations    []string `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfluenceSpacePermission) Reset() {
+	*x = ConfluenceSpacePermission{}
+	mi := &file_proto_group_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfluenceSpacePermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfluenceSpacePermission) ProtoMessage() {}
+
+func (x *ConfluenceSpacePermission) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfluenceSpacePermission.ProtoReflect.Descriptor instead.
+func (*ConfluenceSpacePermission) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ConfluenceSpacePermission) GetSpaceKey() string {
+	if x != nil {
+		return x.SpaceKey
+	}
+	return ""
+}
+
+func (x *ConfluenceSpacePermission) GetOperations() []string {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// SyncPolicy configures how target groups are synced. It can be set for all
+// target groups in the TeamLinkConfig and for the target group of a mapping.
+// The policies of a target group are combined and the most restrictive
+// setting wins: the lowest max_removals, additive_only if any sets it, and
+// every protected user and freeze window.
+type GoogleChat struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the space, e.g. spaces/AAAAxyz.
+	Space         string `protobuf:"bytes,1,opt,name=space,proto3" json:"space,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoogleChat) Reset() {
+	*x = GoogleChat{}
+	mi := &file_proto_group_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoogleChat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoogleChat) ProtoMessage() {}
+
+func (x *GoogleChat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoogleChat.ProtoReflect.Descriptor instead.
+func (*GoogleChat) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GoogleChat) GetSpace() string {
+	if x != nil {
+		return x.Space
+	}
+	return ""
+}
+
+type GoogleCalendar struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the calendar, e.g. c_0123abcd@group.calendar.google.com.
+	CalendarId string `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	// The role granted to the members of the source group, "reader" (the
+	// default) or "writer".
+	Role          string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoogleCalendar) Reset() {
+	*x = GoogleCalendar{}
+	mi := &file_proto_group_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoogleCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoogleCalendar) ProtoMessage() {}
+
+func (x *GoogleCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoogleCalendar.ProtoReflect.Descriptor instead.
+func (*GoogleCalendar) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GoogleCalendar) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
+	}
+	return ""
+}
+
+func (x *GoogleCalendar) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type Microsoft365 struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The object ID of the Microsoft Entra group, e.g. of a Microsoft 365
+	// group or of the group of a team in Microsoft Teams.
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// Whether the members of the source group are the owners of the group
+	// rather than its members.
+	Owners        bool `protobuf:"varint,2,opt,name=owners,proto3" json:"owners,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Microsoft365) Reset() {
+	*x = Microsoft365{}
+	mi := &file_proto_group_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Microsoft365) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Microsoft365) ProtoMessage() {}
+
+func (x *Microsoft365) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Microsoft365.ProtoReflect.Descriptor instead.
+func (*Microsoft365) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Microsoft365) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Microsoft365) GetOwners() bool {
+	if x != nil {
+		return x.Owners
+	}
+	return false
+}
+
+type Box struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the Box group.
+	GroupId       string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Box) Reset() {
+	*x = Box{}
+	mi := &file_proto_group_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Box) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Box) ProtoMessage() {}
+
+func (x *Box) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Box.ProtoReflect.Descriptor instead.
+func (*Box) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Box) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type Dropbox struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the Dropbox team group, e.g. g:1a2b3c.
+	GroupId       string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dropbox) Reset() {
+	*x = Dropbox{}
+	mi := &file_proto_group_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dropbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dropbox) ProtoMessage() {}
+
+func (x *Dropbox) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dropbox.ProtoReflect.Descriptor instead.
+func (*Dropbox) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Dropbox) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type Salesforce struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the public group, e.g. 00G5e000001AbCd.
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The ID of the permission set assigned to the members of the source
+	// group, e.g. 0PS5e000001AbCd, instead of a public group.
+	PermissionSetId string `protobuf:"bytes,2,opt,name=permission_set_id,json=permissionSetId,proto3" json:"permission_set_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Salesforce) Reset() {
+	*x = Salesforce{}
+	mi := &file_proto_group_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Salesforce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Salesforce) ProtoMessage() {}
+
+func (x *Salesforce) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Salesforce.ProtoReflect.Descriptor instead.
+func (*Salesforce) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Salesforce) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *Salesforce) GetPermissionSetId() string {
+	if x != nil {
+		return x.PermissionSetId
+	}
+	return ""
+}
+
+type SyncPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The most members a sync may remove from a target group. A sync which
+	// would remove more fails the group without changing it, e.g. when a
+	// source group is emptied by mistake. 0 means no limit.
+	MaxRemovals int32 `protobuf:"varint,1,opt,name=max_removals,json=maxRemovals,proto3" json:"max_removals,omitempty"`
+	// Only add members to target groups, never remove them.
+	AdditiveOnly bool `protobuf:"varint,2,opt,name=additive_only,json=additiveOnly,proto3" json:"additive_only,omitempty"`
+	// Target user IDs which are never removed from target groups, e.g.
+	// break-glass admins or bots.
+	ProtectedUsers []string `protobuf:"bytes,3,rep,name=protected_users,json=protectedUsers,proto3" json:"protected_users,omitempty"`
+	// Windows during which removals from target groups are deferred, e.g.
+	// "Fri 17:00-Mon 09:00", in the syntax of -freeze-window.
+	FreezeWindows []string `protobuf:"bytes,4,rep,name=freeze_windows,json=freezeWindows,proto3" json:"freeze_windows,omitempty"`
+	// The IANA time zone of freeze_windows, e.g. "Europe/Berlin". Defaults to
+	// UTC.
+	FreezeTimeZone string `protobuf:"bytes,5,opt,name=freeze_time_zone,json=freezeTimeZone,proto3" json:"freeze_time_zone,omitempty"`
+	// The most source groups synced concurrently. 0 means the number of CPUs.
+	// Only used in the TeamLinkConfig.
+	Concurrency   int32 `protobuf:"varint,6,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncPolicy) Reset() {
+	*x = SyncPolicy{}
+	mi := &file_proto_group_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncPolicy) ProtoMessage() {}
+
+func (x *SyncPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_group_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncPolicy.ProtoReflect.Descriptor instead.
+func (*SyncPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_group_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SyncPolicy) GetMaxRemovals() int32 {
+	if x != nil {
+		return x.MaxRemovals
+	}
+	return 0
+}
+
+func (x *SyncPolicy) GetAdditiveOnly() bool {
+	if x != nil {
+		return x.AdditiveOnly
+	}
+	return false
+}
+
+func (x *SyncPolicy) GetProtectedUsers() []string {
+	if x != nil {
+		return x.ProtectedUsers
+	}
+	return nil
+}
+
+func (x *SyncPolicy) GetFreezeWindows() []string {
+	if x != nil {
+		return x.FreezeWindows
+	}
+	return nil
+}
+
+func (x *SyncPolicy) GetFreezeTimeZone() string {
+	if x != nil {
+		return x.FreezeTimeZone
+	}
+	return ""
+}
+
+func (x *SyncPolicy) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
 var File_proto_group_proto protoreflect.FileDescriptor
 
 var file_proto_group_proto_rawDesc = string([]byte{
 	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x22, 0x6f,
-	0x0a, 0x06, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x73, 0x73, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x73, 0x6f, 0x22,
-	0x23, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x0c, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x42,
-	0x91, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
-	0x69, 0x42, 0x0a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x62, 0x63, 0x78,
-	0x79, 0x7a, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x2d, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x61, 0x70, 0x69,
-	0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0xa2, 0x02, 0x03, 0x50, 0x41, 0x58, 0xaa, 0x02, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
-	0x70, 0x69, 0xca, 0x02, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x41, 0x70, 0x69, 0xe2, 0x02,
-	0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x3a, 0x3a,
-	0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x6f, 0x12, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x22, 0xee,
+	0x02, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x73, 0x73, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x73, 0x6f,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x44, 0x0a,
+	0x10, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x15, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x5f,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x69, 0x74, 0x48, 0x75, 0x62, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x14, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x11, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22,
+	0x83, 0x01, 0x0a, 0x0f, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x75, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70,
+	0x75, 0x73, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x70, 0x75,
+	0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x50, 0x75, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x49, 0x0a, 0x11, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x45,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65,
+	0x70, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x23, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x29, 0x0a, 0x0c, 0x47, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x23, 0x0a, 0x06, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x06, 0x53, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x22, 0x83, 0x01, 0x0a,
+	0x15, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c,
+	0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x26, 0x0a, 0x05, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a, 0x05, 0x41, 0x75,
+	0x74, 0x68, 0x30, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x0a, 0x4d, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6d,
+	0x6f, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x0a, 0x52,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x24, 0x0a, 0x07,
+	0x5a, 0x65, 0x6e, 0x64, 0x65, 0x73, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x22, 0x2e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x77,
+	0x12, 0x20, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x79, 0x73, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x79, 0x73,
+	0x49, 0x64, 0x22, 0x1c, 0x0a, 0x06, 0x53, 0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x22, 0x23, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x6b, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x28, 0x0a, 0x07, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75,
+	0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x23, 0x0a, 0x08, 0x4f, 0x6e, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x64, 0x22, 0x24, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x09, 0x50, 0x61,
+	0x67, 0x65, 0x72, 0x44, 0x75, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x08, 0x4f, 0x70, 0x73, 0x67,
+	0x65, 0x6e, 0x69, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x26, 0x0a, 0x09, 0x4a, 0x75, 0x6d, 0x70, 0x43, 0x6c, 0x6f,
+	0x75, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x4b, 0x0a,
+	0x0a, 0x44, 0x61, 0x74, 0x61, 0x62, 0x72, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x7a, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x11, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x58, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x22, 0x0a, 0x0a, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x22, 0x45, 0x0a, 0x0e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x41, 0x0a, 0x0c, 0x4d,
+	0x69, 0x63, 0x72, 0x6f, 0x73, 0x6f, 0x66, 0x74, 0x33, 0x36, 0x35, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x20,
+	0x0a, 0x03, 0x42, 0x6f, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x22, 0x24, 0x0a, 0x07, 0x44, 0x72, 0x6f, 0x70, 0x62, 0x6f, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x0a, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x0a,
+	0x53, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x91,
+	0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
+	0x42, 0x0a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x62, 0x63, 0x78, 0x79,
+	0x7a, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x2d, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x73,
+	0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xa2,
+	0x02, 0x03, 0x50, 0x41, 0x58, 0xaa, 0x02, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70,
+	0x69, 0xca, 0x02, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x41, 0x70, 0x69, 0xe2, 0x02, 0x15,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x3a, 0x3a, 0x41,
+	0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -224,18 +2007,52 @@ func file_proto_group_proto_rawDescGZIP() []byte {
 	return file_proto_group_proto_rawDescData
 }
 
-var file_proto_group_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_group_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_proto_group_proto_goTypes = []any{
-	(*GitHub)(nil),       // 0: proto.api.GitHub
-	(*GitLab)(nil),       // 1: proto.api.GitLab
-	(*GoogleGroups)(nil), // 2: proto.api.GoogleGroups
+	(*GitHub)(nil),                    // 0: proto.api.GitHub
+	(*BranchAllowance)(nil),           // 1: proto.api.BranchAllowance
+	(*GitHubEnvironment)(nil),         // 2: proto.api.GitHubEnvironment
+	(*RepoPermission)(nil),            // 3: proto.api.RepoPermission
+	(*GitLab)(nil),                    // 4: proto.api.GitLab
+	(*GoogleGroups)(nil),              // 5: proto.api.GoogleGroups
+	(*Gerrit)(nil),                    // 6: proto.api.Gerrit
+	(*Sentry)(nil),                    // 7: proto.api.Sentry
+	(*KubernetesRoleBinding)(nil),     // 8: proto.api.KubernetesRoleBinding
+	(*Vault)(nil),                     // 9: proto.api.Vault
+	(*Auth0)(nil),                     // 10: proto.api.Auth0
+	(*Mattermost)(nil),                // 11: proto.api.Mattermost
+	(*RocketChat)(nil),                // 12: proto.api.RocketChat
+	(*Zendesk)(nil),                   // 13: proto.api.Zendesk
+	(*ServiceNow)(nil),                // 14: proto.api.ServiceNow
+	(*Splunk)(nil),                    // 15: proto.api.Splunk
+	(*Looker)(nil),                    // 16: proto.api.Looker
+	(*Tableau)(nil),                   // 17: proto.api.Tableau
+	(*OneLogin)(nil),                  // 18: proto.api.OneLogin
+	(*PingOne)(nil),                   // 19: proto.api.PingOne
+	(*PagerDuty)(nil),                 // 20: proto.api.PagerDuty
+	(*Opsgenie)(nil),                  // 21: proto.api.Opsgenie
+	(*JumpCloud)(nil),                 // 22: proto.api.JumpCloud
+	(*Databricks)(nil),                // 23: proto.api.Databricks
+	(*Confluence)(nil),                // 24: proto.api.Confluence
+	(*ConfluenceSpacePermission)(nil), // 25: proto.api.ConfluenceSpacePermission
+	(*GoogleChat)(nil),                // 26: proto.api.GoogleChat
+	(*GoogleCalendar)(nil),            // 27: proto.api.GoogleCalendar
+	(*Microsoft365)(nil),              // 28: proto.api.Microsoft365
+	(*Box)(nil),                       // 29: proto.api.Box
+	(*Dropbox)(nil),                   // 30: proto.api.Dropbox
+	(*Salesforce)(nil),                // 31: proto.api.Salesforce
+	(*SyncPolicy)(nil),                // 32: proto.api.SyncPolicy
 }
 var file_proto_group_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3,  // 0: proto.api.GitHub.repo_permissions:type_name -> proto.api.RepoPermission
+	2,  // 1: proto.api.GitHub.reviewer_environments:type_name -> proto.api.GitHubEnvironment
+	1,  // 2: proto.api.GitHub.branch_allowances:type_name -> proto.api.BranchAllowance
+	25, // 3: proto.api.Confluence.space_permissions:type_name -> proto.api.ConfluenceSpacePermission
+	4,  // [4:4] is the sub-list for method output_type
+	4,  // [4:4] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_proto_group_proto_init() }
@@ -249,7 +2066,7 @@ func file_proto_group_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_group_proto_rawDesc), len(file_proto_group_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
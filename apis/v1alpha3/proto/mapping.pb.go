@@ -40,12 +40,73 @@ type GroupMapping struct {
 	// Types that are valid to be assigned to Source:
 	//
 	//	*GroupMapping_GoogleGroups
+	//	*GroupMapping_SourceGithub
+	//	*GroupMapping_SourceGitlab
+	//	*GroupMapping_SourceJumpcloud
+	//	*GroupMapping_Onelogin
+	//	*GroupMapping_Pingone
+	//	*GroupMapping_Pagerduty
+	//	*GroupMapping_Opsgenie
 	Source isGroupMapping_Source `protobuf_oneof:"source"`
 	// Types that are valid to be assigned to Target:
 	//
 	//	*GroupMapping_Github
 	//	*GroupMapping_Gitlab
-	Target        isGroupMapping_Target `protobuf_oneof:"target"`
+	//	*GroupMapping_Gerrit
+	//	*GroupMapping_Sentry
+	//	*GroupMapping_KubernetesRoleBinding
+	//	*GroupMapping_Vault
+	//	*GroupMapping_Auth0
+	//	*GroupMapping_Mattermost
+	//	*GroupMapping_RocketChat
+	//	*GroupMapping_Zendesk
+	//	*GroupMapping_ServiceNow
+	//	*GroupMapping_Splunk
+	//	*GroupMapping_Looker
+	//	*GroupMapping_Tableau
+	//	*GroupMapping_Confluence
+	//	*GroupMapping_Jumpcloud
+	//	*GroupMapping_Databricks
+	//	*GroupMapping_TargetGoogleGroups
+	//	*GroupMapping_GoogleChat
+	//	*GroupMapping_GoogleCalendar
+	//	*GroupMapping_Microsoft365
+	//	*GroupMapping_Box
+	//	*GroupMapping_Dropbox
+	//	*GroupMapping_Salesforce
+	Target isGroupMapping_Target `protobuf_oneof:"target"`
+	// Who may change the mappings of the target group, e.g. GitHub usernames
+	// or emails of its maintainers. "tlctl mapping check-owners" rejects
+	// changes to mappings of a target group made by anyone else. Empty means
+	// anyone may change them.
+	Owners []string `protobuf:"bytes,24,rep,name=owners,proto3" json:"owners,omitempty"`
+	// Windows during which the source group grants membership in the target
+	// group, e.g. "Mon 09:00-Fri 17:00" or "2026-12-20/2027-01-04", in the
+	// syntax of -freeze-window. Outside of every window the members of the
+	// source group are removed from the target group, unless another mapping
+	// grants them. Empty means always.
+	ActiveWindows []string `protobuf:"bytes,26,rep,name=active_windows,json=activeWindows,proto3" json:"active_windows,omitempty"`
+	// The IANA time zone of active_windows, e.g. "Europe/Berlin". Defaults
+	// to UTC.
+	ActiveTimeZone string `protobuf:"bytes,27,opt,name=active_time_zone,json=activeTimeZone,proto3" json:"active_time_zone,omitempty"`
+	// How the target group is synced, combined with the sync_policy of the
+	// TeamLinkConfig. Optional.
+	SyncPolicy *SyncPolicy `protobuf:"bytes,30,opt,name=sync_policy,json=syncPolicy,proto3" json:"sync_policy,omitempty"`
+	// Members of the target group which are in no source group, e.g. bot
+	// accounts or emeritus maintainers, kept in the target group along with
+	// the mapped members. Only used when syncing in the direction of the
+	// config.
+	StaticMembers []*TargetUser `protobuf:"bytes,31,rep,name=static_members,json=staticMembers,proto3" json:"static_members,omitempty"`
+	// Maximum number of members of the target group. Syncs which would
+	// exceed it fail for the target group instead of writing it, e.g. when a
+	// company-wide source group was mapped to an admin team by mistake. Zero
+	// means no limit. Only used when syncing in the direction of the config.
+	MaxMembers int32 `protobuf:"varint,32,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
+	// Labels applied to the target group where the target system supports
+	// them, e.g. appended to the description of GitHub teams and GitLab
+	// groups along with a "managed by team-link" marker. Only used when
+	// syncing in the direction of the config.
+	Labels        map[string]string `protobuf:"bytes,33,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -96,6 +157,69 @@ func (x *GroupMapping) GetGoogleGroups() *GoogleGroups {
 	return nil
 }
 
+func (x *GroupMapping) GetSourceGithub() *GitHub {
+	if x != nil {
+		if x, ok := x.Source.(*GroupMapping_SourceGithub); ok {
+			return x.SourceGithub
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetSourceGitlab() *GitLab {
+	if x != nil {
+		if x, ok := x.Source.(*GroupMapping_SourceGitlab); ok {
+			return x.SourceGitlab
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetSourceJumpcloud() *JumpCloud {
+	if x != nil {
+		if x, ok := x.Source.(*GroupMapping_SourceJumpcloud); ok {
+			return x.SourceJumpcloud
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetOnelogin() *OneLogin {
+	if x != nil {
+		if x, ok := x.Source.(*GroupMapping_Onelogin); ok {
+			return x.Onelogin
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetPingone() *PingOne {
+	if x != nil {
+		if x, ok := x.Source.(*GroupMapping_Pingone); ok {
+			return x.Pingone
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetPagerduty() *PagerDuty {
+	if x != nil {
+		if x, ok := x.Source.(*GroupMapping_Pagerduty); ok {
+			return x.Pagerduty
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetOpsgenie() *Opsgenie {
+	if x != nil {
+		if x, ok := x.Source.(*GroupMapping_Opsgenie); ok {
+			return x.Opsgenie
+		}
+	}
+	return nil
+}
+
 func (x *GroupMapping) GetTarget() isGroupMapping_Target {
 	if x != nil {
 		return x.Target
@@ -103,60 +227,566 @@ func (x *GroupMapping) GetTarget() isGroupMapping_Target {
 	return nil
 }
 
-func (x *GroupMapping) GetGithub() *GitHub {
-	if x != nil {
-		if x, ok := x.Target.(*GroupMapping_Github); ok {
-			return x.Github
-		}
-	}
-	return nil
+func (x *GroupMapping) GetGithub() *GitHub {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Github); ok {
+			return x.Github
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetGitlab() *GitLab {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Gitlab); ok {
+			return x.Gitlab
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetGerrit() *Gerrit {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Gerrit); ok {
+			return x.Gerrit
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetSentry() *Sentry {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Sentry); ok {
+			return x.Sentry
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetKubernetesRoleBinding() *KubernetesRoleBinding {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_KubernetesRoleBinding); ok {
+			return x.KubernetesRoleBinding
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetVault() *Vault {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Vault); ok {
+			return x.Vault
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetAuth0() *Auth0 {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Auth0); ok {
+			return x.Auth0
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetMattermost() *Mattermost {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Mattermost); ok {
+			return x.Mattermost
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetRocketChat() *RocketChat {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_RocketChat); ok {
+			return x.RocketChat
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetZendesk() *Zendesk {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Zendesk); ok {
+			return x.Zendesk
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetServiceNow() *ServiceNow {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_ServiceNow); ok {
+			return x.ServiceNow
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetSplunk() *Splunk {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Splunk); ok {
+			return x.Splunk
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetLooker() *Looker {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Looker); ok {
+			return x.Looker
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetTableau() *Tableau {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Tableau); ok {
+			return x.Tableau
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetConfluence() *Confluence {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Confluence); ok {
+			return x.Confluence
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetJumpcloud() *JumpCloud {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Jumpcloud); ok {
+			return x.Jumpcloud
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetDatabricks() *Databricks {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Databricks); ok {
+			return x.Databricks
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetTargetGoogleGroups() *GoogleGroups {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_TargetGoogleGroups); ok {
+			return x.TargetGoogleGroups
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetGoogleChat() *GoogleChat {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_GoogleChat); ok {
+			return x.GoogleChat
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetGoogleCalendar() *GoogleCalendar {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_GoogleCalendar); ok {
+			return x.GoogleCalendar
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetMicrosoft365() *Microsoft365 {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Microsoft365); ok {
+			return x.Microsoft365
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetBox() *Box {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Box); ok {
+			return x.Box
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetDropbox() *Dropbox {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Dropbox); ok {
+			return x.Dropbox
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetSalesforce() *Salesforce {
+	if x != nil {
+		if x, ok := x.Target.(*GroupMapping_Salesforce); ok {
+			return x.Salesforce
+		}
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetActiveWindows() []string {
+	if x != nil {
+		return x.ActiveWindows
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetActiveTimeZone() string {
+	if x != nil {
+		return x.ActiveTimeZone
+	}
+	return ""
+}
+
+func (x *GroupMapping) GetSyncPolicy() *SyncPolicy {
+	if x != nil {
+		return x.SyncPolicy
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetStaticMembers() []*TargetUser {
+	if x != nil {
+		return x.StaticMembers
+	}
+	return nil
+}
+
+func (x *GroupMapping) GetMaxMembers() int32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+func (x *GroupMapping) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type isGroupMapping_Source interface {
+	isGroupMapping_Source()
+}
+
+type GroupMapping_GoogleGroups struct {
+	GoogleGroups *GoogleGroups `protobuf:"bytes,1,opt,name=google_groups,json=googleGroups,proto3,oneof"`
+}
+
+type GroupMapping_SourceGithub struct {
+	// GitHub and GitLab can be either side of a sync. A mapping between a
+	// GitLab group and a GitHub team is used in both directions.
+	SourceGithub *GitHub `protobuf:"bytes,4,opt,name=source_github,json=sourceGithub,proto3,oneof"`
+}
+
+type GroupMapping_SourceGitlab struct {
+	SourceGitlab *GitLab `protobuf:"bytes,5,opt,name=source_gitlab,json=sourceGitlab,proto3,oneof"`
+}
+
+type GroupMapping_SourceJumpcloud struct {
+	SourceJumpcloud *JumpCloud `protobuf:"bytes,19,opt,name=source_jumpcloud,json=sourceJumpcloud,proto3,oneof"`
+}
+
+type GroupMapping_Onelogin struct {
+	Onelogin *OneLogin `protobuf:"bytes,21,opt,name=onelogin,proto3,oneof"`
+}
+
+type GroupMapping_Pingone struct {
+	Pingone *PingOne `protobuf:"bytes,22,opt,name=pingone,proto3,oneof"`
+}
+
+type GroupMapping_Pagerduty struct {
+	Pagerduty *PagerDuty `protobuf:"bytes,28,opt,name=pagerduty,proto3,oneof"`
+}
+
+type GroupMapping_Opsgenie struct {
+	Opsgenie *Opsgenie `protobuf:"bytes,29,opt,name=opsgenie,proto3,oneof"`
+}
+
+func (*GroupMapping_GoogleGroups) isGroupMapping_Source() {}
+
+func (*GroupMapping_SourceGithub) isGroupMapping_Source() {}
+
+func (*GroupMapping_SourceGitlab) isGroupMapping_Source() {}
+
+func (*GroupMapping_SourceJumpcloud) isGroupMapping_Source() {}
+
+func (*GroupMapping_Onelogin) isGroupMapping_Source() {}
+
+func (*GroupMapping_Pingone) isGroupMapping_Source() {}
+
+func (*GroupMapping_Pagerduty) isGroupMapping_Source() {}
+
+func (*GroupMapping_Opsgenie) isGroupMapping_Source() {}
+
+type isGroupMapping_Target interface {
+	isGroupMapping_Target()
+}
+
+type GroupMapping_Github struct {
+	Github *GitHub `protobuf:"bytes,2,opt,name=github,proto3,oneof"`
+}
+
+type GroupMapping_Gitlab struct {
+	Gitlab *GitLab `protobuf:"bytes,3,opt,name=gitlab,proto3,oneof"`
+}
+
+type GroupMapping_Gerrit struct {
+	Gerrit *Gerrit `protobuf:"bytes,6,opt,name=gerrit,proto3,oneof"`
+}
+
+type GroupMapping_Sentry struct {
+	Sentry *Sentry `protobuf:"bytes,7,opt,name=sentry,proto3,oneof"`
+}
+
+type GroupMapping_KubernetesRoleBinding struct {
+	KubernetesRoleBinding *KubernetesRoleBinding `protobuf:"bytes,8,opt,name=kubernetes_role_binding,json=kubernetesRoleBinding,proto3,oneof"`
+}
+
+type GroupMapping_Vault struct {
+	Vault *Vault `protobuf:"bytes,9,opt,name=vault,proto3,oneof"`
+}
+
+type GroupMapping_Auth0 struct {
+	Auth0 *Auth0 `protobuf:"bytes,10,opt,name=auth0,proto3,oneof"`
+}
+
+type GroupMapping_Mattermost struct {
+	Mattermost *Mattermost `protobuf:"bytes,11,opt,name=mattermost,proto3,oneof"`
+}
+
+type GroupMapping_RocketChat struct {
+	RocketChat *RocketChat `protobuf:"bytes,12,opt,name=rocket_chat,json=rocketChat,proto3,oneof"`
+}
+
+type GroupMapping_Zendesk struct {
+	Zendesk *Zendesk `protobuf:"bytes,13,opt,name=zendesk,proto3,oneof"`
+}
+
+type GroupMapping_ServiceNow struct {
+	ServiceNow *ServiceNow `protobuf:"bytes,14,opt,name=service_now,json=serviceNow,proto3,oneof"`
+}
+
+type GroupMapping_Splunk struct {
+	Splunk *Splunk `protobuf:"bytes,15,opt,name=splunk,proto3,oneof"`
+}
+
+type GroupMapping_Looker struct {
+	Looker *Looker `protobuf:"bytes,16,opt,name=looker,proto3,oneof"`
+}
+
+type GroupMapping_Tableau struct {
+	Tableau *Tableau `protobuf:"bytes,17,opt,name=tableau,proto3,oneof"`
+}
+
+type GroupMapping_Confluence struct {
+	Confluence *Confluence `protobuf:"bytes,18,opt,name=confluence,proto3,oneof"`
+}
+
+type GroupMapping_Jumpcloud struct {
+	Jumpcloud *JumpCloud `protobuf:"bytes,20,opt,name=jumpcloud,proto3,oneof"`
+}
+
+type GroupMapping_Databricks struct {
+	Databricks *Databricks `protobuf:"bytes,23,opt,name=databricks,proto3,oneof"`
+}
+
+type GroupMapping_TargetGoogleGroups struct {
+	// The role of a member in the group is the target_role of its user
+	// mapping: "member", "manager" or "owner".
+	TargetGoogleGroups *GoogleGroups `protobuf:"bytes,25,opt,name=target_google_groups,json=targetGoogleGroups,proto3,oneof"`
+}
+
+type GroupMapping_GoogleChat struct {
+	GoogleChat *GoogleChat `protobuf:"bytes,34,opt,name=google_chat,json=googleChat,proto3,oneof"`
+}
+
+type GroupMapping_GoogleCalendar struct {
+	GoogleCalendar *GoogleCalendar `protobuf:"bytes,35,opt,name=google_calendar,json=googleCalendar,proto3,oneof"`
+}
+
+type GroupMapping_Microsoft365 struct {
+	Microsoft365 *Microsoft365 `protobuf:"bytes,36,opt,name=microsoft365,proto3,oneof"`
+}
+
+type GroupMapping_Box struct {
+	Box *Box `protobuf:"bytes,37,opt,name=box,proto3,oneof"`
+}
+
+type GroupMapping_Dropbox struct {
+	Dropbox *Dropbox `protobuf:"bytes,38,opt,name=dropbox,proto3,oneof"`
+}
+
+type GroupMapping_Salesforce struct {
+	Salesforce *Salesforce `protobuf:"bytes,39,opt,name=salesforce,proto3,oneof"`
+}
+
+func (*GroupMapping_Github) isGroupMapping_Target() {}
+
+func (*GroupMapping_Gitlab) isGroupMapping_Target() {}
+
+func (*GroupMapping_Gerrit) isGroupMapping_Target() {}
+
+func (*GroupMapping_Sentry) isGroupMapping_Target() {}
+
+func (*GroupMapping_KubernetesRoleBinding) isGroupMapping_Target() {}
+
+func (*GroupMapping_Vault) isGroupMapping_Target() {}
+
+func (*GroupMapping_Auth0) isGroupMapping_Target() {}
+
+func (*GroupMapping_Mattermost) isGroupMapping_Target() {}
+
+func (*GroupMapping_RocketChat) isGroupMapping_Target() {}
+
+func (*GroupMapping_Zendesk) isGroupMapping_Target() {}
+
+func (*GroupMapping_ServiceNow) isGroupMapping_Target() {}
+
+func (*GroupMapping_Splunk) isGroupMapping_Target() {}
+
+func (*GroupMapping_Looker) isGroupMapping_Target() {}
+
+func (*GroupMapping_Tableau) isGroupMapping_Target() {}
+
+func (*GroupMapping_Confluence) isGroupMapping_Target() {}
+
+func (*GroupMapping_Jumpcloud) isGroupMapping_Target() {}
+
+func (*GroupMapping_Databricks) isGroupMapping_Target() {}
+
+func (*GroupMapping_TargetGoogleGroups) isGroupMapping_Target() {}
+
+func (*GroupMapping_GoogleChat) isGroupMapping_Target() {}
+
+func (*GroupMapping_GoogleCalendar) isGroupMapping_Target() {}
+
+func (*GroupMapping_Microsoft365) isGroupMapping_Target() {}
+
+func (*GroupMapping_Box) isGroupMapping_Target() {}
+
+func (*GroupMapping_Dropbox) isGroupMapping_Target() {}
+
+func (*GroupMapping_Salesforce) isGroupMapping_Target() {}
+
+// GitHubTeamDiscovery pairs every team of a GitHub org whose slug matches a
+// pattern with the Google group of the same name, e.g. team "eng-infra" is
+// paired with eng-infra@<google_groups_domain>. Teams that are already mapped
+// explicitly are left alone.
+type GitHubTeamDiscovery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId int64                  `protobuf:"varint,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// RE2 regular expression which must match the whole team slug.
+	TeamSlugPattern      string `protobuf:"bytes,2,opt,name=team_slug_pattern,json=teamSlugPattern,proto3" json:"team_slug_pattern,omitempty"`
+	GoogleGroupsDomain   string `protobuf:"bytes,3,opt,name=google_groups_domain,json=googleGroupsDomain,proto3" json:"google_groups_domain,omitempty"`
+	RequireUserEnableSso bool   `protobuf:"varint,4,opt,name=require_user_enable_sso,json=requireUserEnableSso,proto3" json:"require_user_enable_sso,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *GroupMapping) GetGitlab() *GitLab {
+func (x *GitHubTeamDiscovery) Reset() {
+	*x = GitHubTeamDiscovery{}
+	mi := &file_proto_mapping_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GitHubTeamDiscovery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubTeamDiscovery) ProtoMessage() {}
+
+func (x *GitHubTeamDiscovery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mapping_proto_msgTypes[1]
 	if x != nil {
-		if x, ok := x.Target.(*GroupMapping_Gitlab); ok {
-			return x.Gitlab
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type isGroupMapping_Source interface {
-	isGroupMapping_Source()
+// Deprecated: Use GitHubTeamDiscovery.ProtoReflect.Descriptor instead.
+func (*GitHubTeamDiscovery) Descriptor() ([]byte, []int) {
+	return file_proto_mapping_proto_rawDescGZIP(), []int{1}
 }
 
-type GroupMapping_GoogleGroups struct {
-	GoogleGroups *GoogleGroups `protobuf:"bytes,1,opt,name=google_groups,json=googleGroups,proto3,oneof"`
+func (x *GitHubTeamDiscovery) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
 }
 
-func (*GroupMapping_GoogleGroups) isGroupMapping_Source() {}
-
-type isGroupMapping_Target interface {
-	isGroupMapping_Target()
+func (x *GitHubTeamDiscovery) GetTeamSlugPattern() string {
+	if x != nil {
+		return x.TeamSlugPattern
+	}
+	return ""
 }
 
-type GroupMapping_Github struct {
-	Github *GitHub `protobuf:"bytes,2,opt,name=github,proto3,oneof"`
+func (x *GitHubTeamDiscovery) GetGoogleGroupsDomain() string {
+	if x != nil {
+		return x.GoogleGroupsDomain
+	}
+	return ""
 }
 
-type GroupMapping_Gitlab struct {
-	Gitlab *GitLab `protobuf:"bytes,3,opt,name=gitlab,proto3,oneof"`
+func (x *GitHubTeamDiscovery) GetRequireUserEnableSso() bool {
+	if x != nil {
+		return x.RequireUserEnableSso
+	}
+	return false
 }
 
-func (*GroupMapping_Github) isGroupMapping_Target() {}
-
-func (*GroupMapping_Gitlab) isGroupMapping_Target() {}
-
 type GroupMappings struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mappings      []*GroupMapping        `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Mappings            []*GroupMapping        `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	GithubTeamDiscovery []*GitHubTeamDiscovery `protobuf:"bytes,2,rep,name=github_team_discovery,json=githubTeamDiscovery,proto3" json:"github_team_discovery,omitempty"`
+	// Source groups derived from other source groups, which mappings refer
+	// to by their ID.
+	DerivedGroups []*DerivedGroup `protobuf:"bytes,3,rep,name=derived_groups,json=derivedGroups,proto3" json:"derived_groups,omitempty"`
+	// IDs of source groups which are never expanded when they are nested in
+	// other source groups, e.g. an "all-employees" group nested in a team
+	// group. Mapped groups are still expanded.
+	ExcludedNestedGroups []string `protobuf:"bytes,4,rep,name=excluded_nested_groups,json=excludedNestedGroups,proto3" json:"excluded_nested_groups,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *GroupMappings) Reset() {
 	*x = GroupMappings{}
-	mi := &file_proto_mapping_proto_msgTypes[1]
+	mi := &file_proto_mapping_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -168,7 +798,7 @@ func (x *GroupMappings) String() string {
 func (*GroupMappings) ProtoMessage() {}
 
 func (x *GroupMappings) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mapping_proto_msgTypes[1]
+	mi := &file_proto_mapping_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -181,7 +811,7 @@ func (x *GroupMappings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupMappings.ProtoReflect.Descriptor instead.
 func (*GroupMappings) Descriptor() ([]byte, []int) {
-	return file_proto_mapping_proto_rawDescGZIP(), []int{1}
+	return file_proto_mapping_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GroupMappings) GetMappings() []*GroupMapping {
@@ -191,17 +821,120 @@ func (x *GroupMappings) GetMappings() []*GroupMapping {
 	return nil
 }
 
+func (x *GroupMappings) GetGithubTeamDiscovery() []*GitHubTeamDiscovery {
+	if x != nil {
+		return x.GithubTeamDiscovery
+	}
+	return nil
+}
+
+func (x *GroupMappings) GetDerivedGroups() []*DerivedGroup {
+	if x != nil {
+		return x.DerivedGroups
+	}
+	return nil
+}
+
+func (x *GroupMappings) GetExcludedNestedGroups() []string {
+	if x != nil {
+		return x.ExcludedNestedGroups
+	}
+	return nil
+}
+
+// DerivedGroup is a source group whose users are given by a set expression
+// over other source groups, evaluated when syncing, e.g.
+// "groups/eng-all minus groups/interns". Operands are source group
+// IDs, or the IDs of other derived groups, and are quoted if they contain
+// spaces or parentheses. Operators are "union", "intersect" and "minus";
+// intersect binds tighter than the others and parentheses group
+// sub-expressions.
+type DerivedGroup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID mappings refer to the group by, e.g. "derived/eng-fulltime". It
+	// must not be the ID of a group of the source system.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Expression    string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DerivedGroup) Reset() {
+	*x = DerivedGroup{}
+	mi := &file_proto_mapping_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DerivedGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DerivedGroup) ProtoMessage() {}
+
+func (x *DerivedGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mapping_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DerivedGroup.ProtoReflect.Descriptor instead.
+func (*DerivedGroup) Descriptor() ([]byte, []int) {
+	return file_proto_mapping_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DerivedGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DerivedGroup) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
 type UserMapping struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Source string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Other identities of the same person in the source system, e.g. alias
+	// emails or a contractor account, which also map to target. Several
+	// mappings may share a target as well; either way the target user is
+	// only added once.
+	SourceAliases []string `protobuf:"bytes,3,rep,name=source_aliases,json=sourceAliases,proto3" json:"source_aliases,omitempty"`
+	// The role of target in the groups it is added to, e.g. "maintainer" for
+	// a GitHub team or "owner" for a GitLab group. Empty means the target
+	// system's default role. Roles only apply when a membership is added.
+	TargetRole string `protobuf:"bytes,4,opt,name=target_role,json=targetRole,proto3" json:"target_role,omitempty"`
+	// Other target accounts of the same person, e.g. an admin or bot
+	// account, which are added to the same groups as target.
+	AdditionalTargets []*TargetUser `protobuf:"bytes,5,rep,name=additional_targets,json=additionalTargets,proto3" json:"additional_targets,omitempty"`
+	// When the mapping expires, as an RFC 3339 timestamp or a date such as
+	// "2025-12-31" (midnight UTC). Expired mappings are ignored when syncing
+	// and listed by "tlctl mapping review". Empty means the mapping never
+	// expires.
+	ExpiresAt string `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Who is accountable for the mapping, e.g. the manager approving access,
+	// contacted when the mapping needs review. When set, "tlctl mapping
+	// check-owners" rejects changes to the mapping made by anyone else.
+	Owner         string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserMapping) Reset() {
 	*x = UserMapping{}
-	mi := &file_proto_mapping_proto_msgTypes[2]
+	mi := &file_proto_mapping_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -213,7 +946,7 @@ func (x *UserMapping) String() string {
 func (*UserMapping) ProtoMessage() {}
 
 func (x *UserMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mapping_proto_msgTypes[2]
+	mi := &file_proto_mapping_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,7 +959,7 @@ func (x *UserMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserMapping.ProtoReflect.Descriptor instead.
 func (*UserMapping) Descriptor() ([]byte, []int) {
-	return file_proto_mapping_proto_rawDescGZIP(), []int{2}
+	return file_proto_mapping_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UserMapping) GetSource() string {
@@ -243,16 +976,110 @@ func (x *UserMapping) GetTarget() string {
 	return ""
 }
 
+func (x *UserMapping) GetSourceAliases() []string {
+	if x != nil {
+		return x.SourceAliases
+	}
+	return nil
+}
+
+func (x *UserMapping) GetTargetRole() string {
+	if x != nil {
+		return x.TargetRole
+	}
+	return ""
+}
+
+func (x *UserMapping) GetAdditionalTargets() []*TargetUser {
+	if x != nil {
+		return x.AdditionalTargets
+	}
+	return nil
+}
+
+func (x *UserMapping) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *UserMapping) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// TargetUser is a user in the target system and its role in target groups.
+type TargetUser struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The role of the user in the groups it is added to, see
+	// UserMapping.target_role.
+	Role          string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetUser) Reset() {
+	*x = TargetUser{}
+	mi := &file_proto_mapping_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetUser) ProtoMessage() {}
+
+func (x *TargetUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mapping_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetUser.ProtoReflect.Descriptor instead.
+func (*TargetUser) Descriptor() ([]byte, []int) {
+	return file_proto_mapping_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TargetUser) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TargetUser) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 type UserMappings struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mappings      []*UserMapping         `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Mappings []*UserMapping         `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	// Map the source users without a user mapping by rewriting their IDs,
+	// tried in order after the user mappings. The first rewrite whose
+	// pattern matches maps the user.
+	IdRewrites    []*UserIDRewrite `protobuf:"bytes,2,rep,name=id_rewrites,json=idRewrites,proto3" json:"id_rewrites,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserMappings) Reset() {
 	*x = UserMappings{}
-	mi := &file_proto_mapping_proto_msgTypes[3]
+	mi := &file_proto_mapping_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -264,7 +1091,7 @@ func (x *UserMappings) String() string {
 func (*UserMappings) ProtoMessage() {}
 
 func (x *UserMappings) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mapping_proto_msgTypes[3]
+	mi := &file_proto_mapping_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -277,7 +1104,7 @@ func (x *UserMappings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserMappings.ProtoReflect.Descriptor instead.
 func (*UserMappings) Descriptor() ([]byte, []int) {
-	return file_proto_mapping_proto_rawDescGZIP(), []int{3}
+	return file_proto_mapping_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UserMappings) GetMappings() []*UserMapping {
@@ -287,6 +1114,71 @@ func (x *UserMappings) GetMappings() []*UserMapping {
 	return nil
 }
 
+func (x *UserMappings) GetIdRewrites() []*UserIDRewrite {
+	if x != nil {
+		return x.IdRewrites
+	}
+	return nil
+}
+
+// UserIDRewrite maps source user IDs to target user IDs with a regular
+// expression, e.g. the pattern "^(.+)@example[.]com$" and the replacement
+// "$1" map emails to usernames.
+type UserIDRewrite struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An RE2 regular expression matching the source user IDs to map.
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// The target user ID, in which $1 or ${name} are replaced by the
+	// submatches of pattern.
+	Replacement   string `protobuf:"bytes,2,opt,name=replacement,proto3" json:"replacement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserIDRewrite) Reset() {
+	*x = UserIDRewrite{}
+	mi := &file_proto_mapping_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserIDRewrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIDRewrite) ProtoMessage() {}
+
+func (x *UserIDRewrite) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mapping_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIDRewrite.ProtoReflect.Descriptor instead.
+func (*UserIDRewrite) Descriptor() ([]byte, []int) {
+	return file_proto_mapping_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UserIDRewrite) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *UserIDRewrite) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
 type TeamLinkMappings struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupMappings *GroupMappings         `protobuf:"bytes,1,opt,name=group_mappings,json=groupMappings,proto3" json:"group_mappings,omitempty"`
@@ -297,7 +1189,7 @@ type TeamLinkMappings struct {
 
 func (x *TeamLinkMappings) Reset() {
 	*x = TeamLinkMappings{}
-	mi := &file_proto_mapping_proto_msgTypes[4]
+	mi := &file_proto_mapping_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -309,7 +1201,7 @@ func (x *TeamLinkMappings) String() string {
 func (*TeamLinkMappings) ProtoMessage() {}
 
 func (x *TeamLinkMappings) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mapping_proto_msgTypes[4]
+	mi := &file_proto_mapping_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,7 +1214,7 @@ func (x *TeamLinkMappings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamLinkMappings.ProtoReflect.Descriptor instead.
 func (*TeamLinkMappings) Descriptor() ([]byte, []int) {
-	return file_proto_mapping_proto_rawDescGZIP(), []int{4}
+	return file_proto_mapping_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TeamLinkMappings) GetGroupMappings() *GroupMappings {
@@ -345,50 +1237,226 @@ var file_proto_mapping_proto_rawDesc = string([]byte{
 	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
 	0x1a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70,
+	0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x10, 0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70,
 	0x70, 0x69, 0x6e, 0x67, 0x12, 0x3e, 0x0a, 0x0d, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x5f, 0x67,
 	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x47, 0x72,
 	0x6f, 0x75, 0x70, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x18, 0x02,
+	0x6f, 0x75, 0x70, 0x73, 0x12, 0x38, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x48, 0x00,
+	0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x12, 0x38,
+	0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x12, 0x41, 0x0a, 0x10, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x6a, 0x75, 0x6d, 0x70, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a,
+	0x75, 0x6d, 0x70, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4a, 0x75, 0x6d, 0x70, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x6f,
+	0x6e, 0x65, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4f, 0x6e, 0x65, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x6f, 0x6e, 0x65, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2e,
+	0x0a, 0x07, 0x70, 0x69, 0x6e, 0x67, 0x6f, 0x6e, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x69, 0x6e, 0x67,
+	0x4f, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x07, 0x70, 0x69, 0x6e, 0x67, 0x6f, 0x6e, 0x65, 0x12, 0x34,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x72, 0x64, 0x75, 0x74, 0x79, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x72, 0x44, 0x75, 0x74, 0x79, 0x48, 0x00, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x72,
+	0x64, 0x75, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x08, 0x6f, 0x70, 0x73, 0x67, 0x65, 0x6e, 0x69, 0x65,
+	0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x4f, 0x70, 0x73, 0x67, 0x65, 0x6e, 0x69, 0x65, 0x48, 0x00, 0x52, 0x08, 0x6f,
+	0x70, 0x73, 0x67, 0x65, 0x6e, 0x69, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x48, 0x01, 0x52, 0x06, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x48, 0x01, 0x52, 0x06, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69,
-	0x74, 0x4c, 0x61, 0x62, 0x48, 0x01, 0x52, 0x06, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x42, 0x08,
-	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x22, 0x44, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x08,
-	0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x3d, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x42, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x10,
-	0x54, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x6e, 0x6b, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73,
-	0x12, 0x3f, 0x0a, 0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x73, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x73, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x42,
-	0x93, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
-	0x69, 0x42, 0x0c, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x62,
-	0x63, 0x78, 0x79, 0x7a, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x2d, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x61,
-	0x70, 0x69, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x33, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0xa2, 0x02, 0x03, 0x50, 0x41, 0x58, 0xaa, 0x02, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x41, 0x70, 0x69, 0xca, 0x02, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x41, 0x70, 0x69,
-	0xe2, 0x02, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x3a, 0x3a, 0x41, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x47, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x48, 0x01, 0x52, 0x06, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
+	0x72, 0x72, 0x69, 0x74, 0x48, 0x01, 0x52, 0x06, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x12, 0x2b,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x48, 0x01, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x5a, 0x0a, 0x17, 0x6b,
+	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x62,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65,
+	0x74, 0x65, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x01,
+	0x52, 0x15, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x6f, 0x6c, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x0a, 0x05, 0x76, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x01, 0x52, 0x05, 0x76, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x28, 0x0a, 0x05, 0x61, 0x75, 0x74, 0x68, 0x30, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x30, 0x48, 0x01, 0x52, 0x05, 0x61, 0x75, 0x74, 0x68, 0x30, 0x12, 0x37, 0x0a, 0x0a, 0x6d,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6d, 0x6f, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6d, 0x6f, 0x73, 0x74, 0x48, 0x01, 0x52, 0x0a, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6d, 0x6f, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x0b, 0x72, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x63,
+	0x68, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74,
+	0x48, 0x01, 0x52, 0x0a, 0x72, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x12, 0x2e,
+	0x0a, 0x07, 0x7a, 0x65, 0x6e, 0x64, 0x65, 0x73, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x5a, 0x65, 0x6e, 0x64,
+	0x65, 0x73, 0x6b, 0x48, 0x01, 0x52, 0x07, 0x7a, 0x65, 0x6e, 0x64, 0x65, 0x73, 0x6b, 0x12, 0x38,
+	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x77, 0x48, 0x01, 0x52, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x77, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x70, 0x6c, 0x75,
+	0x6e, 0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x48, 0x01, 0x52, 0x06, 0x73,
+	0x70, 0x6c, 0x75, 0x6e, 0x6b, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x6f, 0x6f, 0x6b, 0x65, 0x72, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x65, 0x72, 0x48, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x6f, 0x6b,
+	0x65, 0x72, 0x12, 0x2e, 0x0a, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x61, 0x75, 0x48, 0x01, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x61, 0x75, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x01, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x6a,
+	0x75, 0x6d, 0x70, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x48, 0x01, 0x52, 0x09, 0x6a, 0x75, 0x6d, 0x70, 0x63, 0x6c, 0x6f, 0x75,
+	0x64, 0x12, 0x37, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x62, 0x72, 0x69, 0x63, 0x6b, 0x73, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x72, 0x69, 0x63, 0x6b, 0x73, 0x48, 0x01, 0x52, 0x0a,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x72, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x4b, 0x0a, 0x14, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x48, 0x01, 0x52, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x48, 0x01, 0x52, 0x0a, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x68, 0x61,
+	0x74, 0x12, 0x44, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x48, 0x01, 0x52, 0x0e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x3d, 0x0a, 0x0c, 0x6d, 0x69, 0x63, 0x72, 0x6f,
+	0x73, 0x6f, 0x66, 0x74, 0x33, 0x36, 0x35, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73,
+	0x6f, 0x66, 0x74, 0x33, 0x36, 0x35, 0x48, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73,
+	0x6f, 0x66, 0x74, 0x33, 0x36, 0x35, 0x12, 0x22, 0x0a, 0x03, 0x62, 0x6f, 0x78, 0x18, 0x25, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x42, 0x6f, 0x78, 0x48, 0x01, 0x52, 0x03, 0x62, 0x6f, 0x78, 0x12, 0x2e, 0x0a, 0x07, 0x64, 0x72,
+	0x6f, 0x70, 0x62, 0x6f, 0x78, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x62, 0x6f, 0x78, 0x48,
+	0x01, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x62, 0x6f, 0x78, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x61,
+	0x6c, 0x65, 0x73, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x61, 0x6c, 0x65, 0x73,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x48, 0x01, 0x52, 0x0a, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x18, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x1a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x36, 0x0a, 0x0b,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x79,
+	0x6e, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x3c, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x20, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x21, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22,
+	0xc1, 0x01, 0x0a, 0x13, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x73, 0x6c, 0x75, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x65, 0x61, 0x6d, 0x53,
+	0x6c, 0x75, 0x67, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x35, 0x0a, 0x17,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x73, 0x73, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x53, 0x73, 0x6f, 0x22, 0x8e, 0x02, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x52, 0x0a, 0x15, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x54, 0x65, 0x61, 0x6d,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x13, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x54, 0x65, 0x61, 0x6d, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x3e,
+	0x0a, 0x0e, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x0d, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x34,
+	0x0a, 0x16, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0x3e, 0x0a, 0x0c, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x02, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61,
+	0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x44, 0x0a, 0x12,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x11, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x30, 0x0a, 0x0a, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x7d, 0x0a, 0x0c, 0x55, 0x73, 0x65,
+	0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x39, 0x0a,
+	0x0b, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x52, 0x0a, 0x69, 0x64,
+	0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x61, 0x6d, 0x4c, 0x69,
+	0x6e, 0x6b, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x0d, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x0c, 0x75, 0x73, 0x65,
+	0x72, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x93, 0x01, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x61, 0x70, 0x69, 0x42, 0x0c, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x62, 0x63, 0x78, 0x79, 0x7a, 0x2f, 0x74,
+	0x65, 0x61, 0x6d, 0x2d, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xa2, 0x02, 0x03, 0x50,
+	0x41, 0x58, 0xaa, 0x02, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x70, 0x69, 0xca, 0x02,
+	0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x5c, 0x41, 0x70, 0x69, 0xe2, 0x02, 0x15, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -403,30 +1471,97 @@ func file_proto_mapping_proto_rawDescGZIP() []byte {
 	return file_proto_mapping_proto_rawDescData
 }
 
-var file_proto_mapping_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_mapping_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_proto_mapping_proto_goTypes = []any{
-	(*GroupMapping)(nil),     // 0: proto.api.GroupMapping
-	(*GroupMappings)(nil),    // 1: proto.api.GroupMappings
-	(*UserMapping)(nil),      // 2: proto.api.UserMapping
-	(*UserMappings)(nil),     // 3: proto.api.UserMappings
-	(*TeamLinkMappings)(nil), // 4: proto.api.TeamLinkMappings
-	(*GoogleGroups)(nil),     // 5: proto.api.GoogleGroups
-	(*GitHub)(nil),           // 6: proto.api.GitHub
-	(*GitLab)(nil),           // 7: proto.api.GitLab
+	(*GroupMapping)(nil),          // 0: proto.api.GroupMapping
+	(*GitHubTeamDiscovery)(nil),   // 1: proto.api.GitHubTeamDiscovery
+	(*GroupMappings)(nil),         // 2: proto.api.GroupMappings
+	(*DerivedGroup)(nil),          // 3: proto.api.DerivedGroup
+	(*UserMapping)(nil),           // 4: proto.api.UserMapping
+	(*TargetUser)(nil),            // 5: proto.api.TargetUser
+	(*UserMappings)(nil),          // 6: proto.api.UserMappings
+	(*UserIDRewrite)(nil),         // 7: proto.api.UserIDRewrite
+	(*TeamLinkMappings)(nil),      // 8: proto.api.TeamLinkMappings
+	nil,                           // 9: proto.api.GroupMapping.LabelsEntry
+	(*GoogleGroups)(nil),          // 10: proto.api.GoogleGroups
+	(*GitHub)(nil),                // 11: proto.api.GitHub
+	(*GitLab)(nil),                // 12: proto.api.GitLab
+	(*JumpCloud)(nil),             // 13: proto.api.JumpCloud
+	(*OneLogin)(nil),              // 14: proto.api.OneLogin
+	(*PingOne)(nil),               // 15: proto.api.PingOne
+	(*PagerDuty)(nil),             // 16: proto.api.PagerDuty
+	(*Opsgenie)(nil),              // 17: proto.api.Opsgenie
+	(*Gerrit)(nil),                // 18: proto.api.Gerrit
+	(*Sentry)(nil),                // 19: proto.api.Sentry
+	(*KubernetesRoleBinding)(nil), // 20: proto.api.KubernetesRoleBinding
+	(*Vault)(nil),                 // 21: proto.api.Vault
+	(*Auth0)(nil),                 // 22: proto.api.Auth0
+	(*Mattermost)(nil),            // 23: proto.api.Mattermost
+	(*RocketChat)(nil),            // 24: proto.api.RocketChat
+	(*Zendesk)(nil),               // 25: proto.api.Zendesk
+	(*ServiceNow)(nil),            // 26: proto.api.ServiceNow
+	(*Splunk)(nil),                // 27: proto.api.Splunk
+	(*Looker)(nil),                // 28: proto.api.Looker
+	(*Tableau)(nil),               // 29: proto.api.Tableau
+	(*Confluence)(nil),            // 30: proto.api.Confluence
+	(*Databricks)(nil),            // 31: proto.api.Databricks
+	(*GoogleChat)(nil),            // 32: proto.api.GoogleChat
+	(*GoogleCalendar)(nil),        // 33: proto.api.GoogleCalendar
+	(*Microsoft365)(nil),          // 34: proto.api.Microsoft365
+	(*Box)(nil),                   // 35: proto.api.Box
+	(*Dropbox)(nil),               // 36: proto.api.Dropbox
+	(*Salesforce)(nil),            // 37: proto.api.Salesforce
+	(*SyncPolicy)(nil),            // 38: proto.api.SyncPolicy
 }
 var file_proto_mapping_proto_depIdxs = []int32{
-	5, // 0: proto.api.GroupMapping.google_groups:type_name -> proto.api.GoogleGroups
-	6, // 1: proto.api.GroupMapping.github:type_name -> proto.api.GitHub
-	7, // 2: proto.api.GroupMapping.gitlab:type_name -> proto.api.GitLab
-	0, // 3: proto.api.GroupMappings.mappings:type_name -> proto.api.GroupMapping
-	2, // 4: proto.api.UserMappings.mappings:type_name -> proto.api.UserMapping
-	1, // 5: proto.api.TeamLinkMappings.group_mappings:type_name -> proto.api.GroupMappings
-	3, // 6: proto.api.TeamLinkMappings.user_mappings:type_name -> proto.api.UserMappings
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	10, // 0: proto.api.GroupMapping.google_groups:type_name -> proto.api.GoogleGroups
+	11, // 1: proto.api.GroupMapping.source_github:type_name -> proto.api.GitHub
+	12, // 2: proto.api.GroupMapping.source_gitlab:type_name -> proto.api.GitLab
+	13, // 3: proto.api.GroupMapping.source_jumpcloud:type_name -> proto.api.JumpCloud
+	14, // 4: proto.api.GroupMapping.onelogin:type_name -> proto.api.OneLogin
+	15, // 5: proto.api.GroupMapping.pingone:type_name -> proto.api.PingOne
+	16, // 6: proto.api.GroupMapping.pagerduty:type_name -> proto.api.PagerDuty
+	17, // 7: proto.api.GroupMapping.opsgenie:type_name -> proto.api.Opsgenie
+	11, // 8: proto.api.GroupMapping.github:type_name -> proto.api.GitHub
+	12, // 9: proto.api.GroupMapping.gitlab:type_name -> proto.api.GitLab
+	18, // 10: proto.api.GroupMapping.gerrit:type_name -> proto.api.Gerrit
+	19, // 11: proto.api.GroupMapping.sentry:type_name -> proto.api.Sentry
+	20, // 12: proto.api.GroupMapping.kubernetes_role_binding:type_name -> proto.api.KubernetesRoleBinding
+	21, // 13: proto.api.GroupMapping.vault:type_name -> proto.api.Vault
+	22, // 14: proto.api.GroupMapping.auth0:type_name -> proto.api.Auth0
+	23, // 15: proto.api.GroupMapping.mattermost:type_name -> proto.api.Mattermost
+	24, // 16: proto.api.GroupMapping.rocket_chat:type_name -> proto.api.RocketChat
+	25, // 17: proto.api.GroupMapping.zendesk:type_name -> proto.api.Zendesk
+	26, // 18: proto.api.GroupMapping.service_now:type_name -> proto.api.ServiceNow
+	27, // 19: proto.api.GroupMapping.splunk:type_name -> proto.api.Splunk
+	28, // 20: proto.api.GroupMapping.looker:type_name -> proto.api.Looker
+	29, // 21: proto.api.GroupMapping.tableau:type_name -> proto.api.Tableau
+	30, // 22: proto.api.GroupMapping.confluence:type_name -> proto.api.Confluence
+	13, // 23: proto.api.GroupMapping.jumpcloud:type_name -> proto.api.JumpCloud
+	31, // 24: proto.api.GroupMapping.databricks:type_name -> proto.api.Databricks
+	10, // 25: proto.api.GroupMapping.target_google_groups:type_name -> proto.api.GoogleGroups
+	32, // 26: proto.api.GroupMapping.google_chat:type_name -> proto.api.GoogleChat
+	33, // 27: proto.api.GroupMapping.google_calendar:type_name -> proto.api.GoogleCalendar
+	34, // 28: proto.api.GroupMapping.microsoft365:type_name -> proto.api.Microsoft365
+	35, // 29: proto.api.GroupMapping.box:type_name -> proto.api.Box
+	36, // 30: proto.api.GroupMapping.dropbox:type_name -> proto.api.Dropbox
+	37, // 31: proto.api.GroupMapping.salesforce:type_name -> proto.api.Salesforce
+	38, // 32: proto.api.GroupMapping.sync_policy:type_name -> proto.api.SyncPolicy
+	5,  // 33: proto.api.GroupMapping.static_members:type_name -> proto.api.TargetUser
+	9,  // 34: proto.api.GroupMapping.labels:type_name -> proto.api.GroupMapping.LabelsEntry
+	0,  // 35: proto.api.GroupMappings.mappings:type_name -> proto.api.GroupMapping
+	1,  // 36: proto.api.GroupMappings.github_team_discovery:type_name -> proto.api.GitHubTeamDiscovery
+	3,  // 37: proto.api.GroupMappings.derived_groups:type_name -> proto.api.DerivedGroup
+	5,  // 38: proto.api.UserMapping.additional_targets:type_name -> proto.api.TargetUser
+	4,  // 39: proto.api.UserMappings.mappings:type_name -> proto.api.UserMapping
+	7,  // 40: proto.api.UserMappings.id_rewrites:type_name -> proto.api.UserIDRewrite
+	2,  // 41: proto.api.TeamLinkMappings.group_mappings:type_name -> proto.api.GroupMappings
+	6,  // 42: proto.api.TeamLinkMappings.user_mappings:type_name -> proto.api.UserMappings
+	43, // [43:43] is the sub-list for method output_type
+	43, // [43:43] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_proto_mapping_proto_init() }
@@ -437,8 +1572,37 @@ func file_proto_mapping_proto_init() {
 	file_proto_group_proto_init()
 	file_proto_mapping_proto_msgTypes[0].OneofWrappers = []any{
 		(*GroupMapping_GoogleGroups)(nil),
+		(*GroupMapping_SourceGithub)(nil),
+		(*GroupMapping_SourceGitlab)(nil),
+		(*GroupMapping_SourceJumpcloud)(nil),
+		(*GroupMapping_Onelogin)(nil),
+		(*GroupMapping_Pingone)(nil),
+		(*GroupMapping_Pagerduty)(nil),
+		(*GroupMapping_Opsgenie)(nil),
 		(*GroupMapping_Github)(nil),
 		(*GroupMapping_Gitlab)(nil),
+		(*GroupMapping_Gerrit)(nil),
+		(*GroupMapping_Sentry)(nil),
+		(*GroupMapping_KubernetesRoleBinding)(nil),
+		(*GroupMapping_Vault)(nil),
+		(*GroupMapping_Auth0)(nil),
+		(*GroupMapping_Mattermost)(nil),
+		(*GroupMapping_RocketChat)(nil),
+		(*GroupMapping_Zendesk)(nil),
+		(*GroupMapping_ServiceNow)(nil),
+		(*GroupMapping_Splunk)(nil),
+		(*GroupMapping_Looker)(nil),
+		(*GroupMapping_Tableau)(nil),
+		(*GroupMapping_Confluence)(nil),
+		(*GroupMapping_Jumpcloud)(nil),
+		(*GroupMapping_Databricks)(nil),
+		(*GroupMapping_TargetGoogleGroups)(nil),
+		(*GroupMapping_GoogleChat)(nil),
+		(*GroupMapping_GoogleCalendar)(nil),
+		(*GroupMapping_Microsoft365)(nil),
+		(*GroupMapping_Box)(nil),
+		(*GroupMapping_Dropbox)(nil),
+		(*GroupMapping_Salesforce)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -446,7 +1610,7 @@ func file_proto_mapping_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_mapping_proto_rawDesc), len(file_proto_mapping_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
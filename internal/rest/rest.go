@@ -0,0 +1,214 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rest is a minimal JSON REST client for the connectors of group
+// systems which have no Go client library.
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// maxErrorBody bounds how much of an error response is kept in an Error.
+const maxErrorBody = 1024
+
+// Config holds the optional settings of a Client.
+type Config struct {
+	httpClient     *http.Client
+	header         http.Header
+	username       string
+	password       string
+	responsePrefix string
+}
+
+// Opt configures a Client.
+type Opt func(config *Config)
+
+// WithHTTPClient sets the HTTP client, http.DefaultClient by default.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// WithHeader sets a header sent with every request, e.g. an Authorization
+// header carrying a token.
+func WithHeader(key, value string) Opt {
+	return func(config *Config) {
+		config.header.Set(key, value)
+	}
+}
+
+// WithBasicAuth authenticates every request with HTTP basic authentication.
+func WithBasicAuth(username, password string) Opt {
+	return func(config *Config) {
+		config.username = username
+		config.password = password
+	}
+}
+
+// WithResponsePrefix strips the given prefix from response bodies before
+// decoding them, e.g. the XSSI protection prefix of Gerrit.
+func WithResponsePrefix(prefix string) Opt {
+	return func(config *Config) {
+		config.responsePrefix = prefix
+	}
+}
+
+// Client sends JSON requests to a REST API.
+type Client struct {
+	baseURL string
+	config  *Config
+}
+
+// New creates a Client for the API at the given base URL. Request paths are
+// appended to it.
+func New(baseURL string, opts ...Opt) *Client {
+	config := &Config{
+		httpClient: http.DefaultClient,
+		header:     make(http.Header),
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		config:  config,
+	}
+}
+
+// Error is an error response of the API.
+type Error struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s %s: unexpected status %d: %s", e.Method, e.URL, e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err is an Error with status 404.
+func IsNotFound(err error) bool {
+	return HasStatus(err, http.StatusNotFound)
+}
+
+// HasStatus reports whether err is an Error with the given status.
+func HasStatus(err error, status int) bool {
+	var rerr *Error
+	return errors.As(err, &rerr) && rerr.StatusCode == status
+}
+
+// Do sends a request with body encoded as JSON, or as a form if it is
+// url.Values, unless it is nil, and decodes the JSON response into out,
+// unless it is nil. Error responses are returned as an Error, wrapped with
+// groupsync.ErrRateLimited for status 429 and groupsync.ErrTransient for 5xx
+// statuses.
+func (c *Client) Do(ctx context.Context, method, path string, body, out any) error {
+	_, err := c.DoWithHeader(ctx, method, path, body, out)
+	return err
+}
+
+// DoWithHeader is like Do and also returns the response header, e.g. to
+// follow pagination links.
+func (c *Client) DoWithHeader(ctx context.Context, method, path string, body, out any) (http.Header, error) {
+	var reqBody io.Reader
+	contentType := "application/json"
+	switch b := body.(type) {
+	case nil:
+	case url.Values:
+		contentType = "application/x-www-form-urlencoded"
+		reqBody = strings.NewReader(b.Encode())
+	default:
+		encoded, err := json.Marshal(b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request: %w", err)
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+	reqURL := path
+	if !strings.HasPrefix(path, "https://") && !strings.HasPrefix(path, "http://") {
+		reqURL = c.baseURL + path
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for k, v := range c.config.header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.config.username != "" || c.config.password != "" {
+		req.SetBasicAuth(c.config.username, c.config.password)
+	}
+
+	resp, err := c.config.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s %s: %w", groupsync.ErrTransient, method, reqURL, err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read response of %s %s: %w", groupsync.ErrTransient, method, reqURL, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		rerr := &Error{Method: method, URL: reqURL, StatusCode: resp.StatusCode, Body: string(b[:min(len(b), maxErrorBody)])}
+		switch {
+		case resp.StatusCode == http.StatusTooManyRequests:
+			return nil, fmt.Errorf("%w: %w", groupsync.ErrRateLimited, rerr)
+		case resp.StatusCode >= http.StatusInternalServerError:
+			return nil, fmt.Errorf("%w: %w", groupsync.ErrTransient, rerr)
+		}
+		return nil, rerr
+	}
+	b = bytes.TrimPrefix(b, []byte(c.config.responsePrefix))
+	if out != nil && len(bytes.TrimSpace(b)) > 0 {
+		if err := json.Unmarshal(b, out); err != nil {
+			return nil, fmt.Errorf("failed to decode response of %s %s: %w", method, reqURL, err)
+		}
+	}
+	return resp.Header, nil
+}
+
+// linkPattern matches an entry of a Link header and its parameters.
+var linkPattern = regexp.MustCompile(`<([^>]*)>((?:\s*;\s*[^;,]+)*)`)
+
+// NextLink returns the URL of the "next" entry of a Link header, or "" if
+// there is none. Entries with results="false" are ignored, which is how
+// Sentry marks the last page.
+func NextLink(header http.Header) string {
+	for _, m := range linkPattern.FindAllStringSubmatch(header.Get("Link"), -1) {
+		params := strings.ReplaceAll(m[2], " ", "")
+		if strings.Contains(params, `rel="next"`) && !strings.Contains(params, `results="false"`) {
+			return m[1]
+		}
+	}
+	return ""
+}
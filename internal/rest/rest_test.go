@@ -0,0 +1,168 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestClient_Do(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/ok":
+			fmt.Fprint(w, `)]}'{"name": "x"}`)
+		case "/echo":
+			// echoes the name of a JSON or form body.
+			name := r.FormValue("name")
+			if r.Header.Get("Content-Type") == "application/json" {
+				var body struct {
+					Name string `json:"name"`
+				}
+				json.NewDecoder(r.Body).Decode(&body) //nolint:errcheck // test server
+				name = body.Name
+			}
+			fmt.Fprintf(w, `)]}'{"name": %q}`, name)
+		case "/limited":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/broken":
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	c := New(srv.URL+"/", WithHeader("Authorization", "Bearer t"), WithResponsePrefix(")]}'"))
+
+	cases := []struct {
+		name         string
+		path         string
+		body         any
+		wantName     string
+		wantErr      error
+		wantNotFound bool
+	}{
+		{
+			name:     "success",
+			path:     "/ok",
+			wantName: "x",
+		},
+		{
+			name:     "json_body",
+			path:     "/echo",
+			body:     map[string]string{"name": "y"},
+			wantName: "y",
+		},
+		{
+			name:     "form_body",
+			path:     "/echo",
+			body:     url.Values{"name": {"z"}},
+			wantName: "z",
+		},
+		{
+			name:    "rate_limited",
+			path:    "/limited",
+			wantErr: groupsync.ErrRateLimited,
+		},
+		{
+			name:    "server_error",
+			path:    "/broken",
+			wantErr: groupsync.ErrTransient,
+		},
+		{
+			name:         "not_found",
+			path:         "/missing",
+			wantNotFound: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var out struct {
+				Name string `json:"name"`
+			}
+			method := http.MethodGet
+			if tc.body != nil {
+				method = http.MethodPost
+			}
+			err := c.Do(context.Background(), method, tc.path, tc.body, &out)
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Errorf("Do got err %v, want %v", err, tc.wantErr)
+			}
+			if got := IsNotFound(err); got != tc.wantNotFound {
+				t.Errorf("IsNotFound got %t, want %t", got, tc.wantNotFound)
+			}
+			if tc.wantErr == nil && !tc.wantNotFound && err != nil {
+				t.Errorf("Do failed: %v", err)
+			}
+			if out.Name != tc.wantName {
+				t.Errorf("Do decoded name %q, want %q", out.Name, tc.wantName)
+			}
+		})
+	}
+}
+
+func TestNextLink(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		link string
+		want string
+	}{
+		{
+			name: "next",
+			link: `<https://x/a?page=1>; rel="prev", <https://x/a?page=3>; rel="next"`,
+			want: "https://x/a?page=3",
+		},
+		{
+			name: "sentry_last_page",
+			link: `<https://x/a?cursor=0:0:1>; rel="previous"; results="true"; cursor="0:0:1", <https://x/a?cursor=0:100:0>; rel="next"; results="false"; cursor="0:100:0"`,
+		},
+		{
+			name: "no_link",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			header := make(http.Header)
+			if tc.link != "" {
+				header.Set("Link", tc.link)
+			}
+			if got := NextLink(header); got != tc.want {
+				t.Errorf("NextLink got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -17,7 +17,32 @@ package tltypes
 
 // Teamlink supported system types for memberships.
 const (
-	SystemTypeGitHub       = "GITHUB"
-	SystemTypeGitLab       = "GITLAB"
-	SystemTypeGoogleGroups = "GOOGLEGROUPS"
+	SystemTypeGitHub         = "GITHUB"
+	SystemTypeGitLab         = "GITLAB"
+	SystemTypeGoogleGroups   = "GOOGLEGROUPS"
+	SystemTypeGerrit         = "GERRIT"
+	SystemTypeSentry         = "SENTRY"
+	SystemTypeKubernetes     = "KUBERNETES"
+	SystemTypeVault          = "VAULT"
+	SystemTypeAuth0          = "AUTH0"
+	SystemTypeMattermost     = "MATTERMOST"
+	SystemTypeRocketChat     = "ROCKETCHAT"
+	SystemTypeZendesk        = "ZENDESK"
+	SystemTypeServiceNow     = "SERVICENOW"
+	SystemTypeSplunk         = "SPLUNK"
+	SystemTypeLooker         = "LOOKER"
+	SystemTypeTableau        = "TABLEAU"
+	SystemTypeConfluence     = "CONFLUENCE"
+	SystemTypeJumpCloud      = "JUMPCLOUD"
+	SystemTypeOneLogin       = "ONELOGIN"
+	SystemTypePingOne        = "PINGONE"
+	SystemTypePagerDuty      = "PAGERDUTY"
+	SystemTypeOpsgenie       = "OPSGENIE"
+	SystemTypeDatabricks     = "DATABRICKS"
+	SystemTypeGoogleChat     = "GOOGLECHAT"
+	SystemTypeGoogleCalendar = "GOOGLECALENDAR"
+	SystemTypeMicrosoft365   = "MICROSOFT365"
+	SystemTypeBox            = "BOX"
+	SystemTypeDropbox        = "DROPBOX"
+	SystemTypeSalesforce     = "SALESFORCE"
 )
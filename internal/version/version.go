@@ -0,0 +1,57 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package version holds the version information of tlctl, which release
+// builds set with -ldflags.
+package version
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+)
+
+var (
+	// Name is the name of the binary.
+	Name = "tlctl"
+
+	// Version is the release version without a "v" prefix, e.g. "1.2.3", or
+	// "source" for builds which are not releases. Release builds set it with:
+	//
+	//	-ldflags "-X github.com/abcxyz/team-link/internal/version.Version=1.2.3"
+	Version = "source"
+
+	// Commit is the git commit of the build, if known.
+	Commit = func() string {
+		if info, ok := debug.ReadBuildInfo(); ok {
+			for _, setting := range info.Settings {
+				if setting.Key == "vcs.revision" {
+					return setting.Value
+				}
+			}
+		}
+		return "HEAD"
+	}()
+
+	// OSArch is the operating system and architecture of the build.
+	OSArch = runtime.GOOS + "/" + runtime.GOARCH
+
+	// HumanVersion is the version information printed by -version.
+	HumanVersion = fmt.Sprintf("%s %s (%s, %s)", Name, Version, Commit, OSArch)
+)
+
+// IsRelease reports whether the binary is a release build.
+func IsRelease() bool {
+	return Version != "source"
+}
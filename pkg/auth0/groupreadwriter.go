@@ -0,0 +1,357 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth0 provides a GroupReadWriter for the members of Auth0
+// organizations and their roles, for products which gate features by Auth0
+// roles.
+package auth0
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour
+
+	// pageSize is the number of organization members requested per page,
+	// the maximum of the Management API.
+	pageSize = 100
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Organization is an Auth0 organization, see
+// https://auth0.com/docs/api/management/v2/organizations/get-organizations-by-id.
+type Organization struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+// Role is an Auth0 role.
+type Role struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Member is a member of an Auth0 organization and its roles in the
+// organization.
+type Member struct {
+	UserID string  `json:"user_id"`
+	Email  string  `json:"email"`
+	Name   string  `json:"name,omitempty"`
+	Roles  []*Role `json:"roles,omitempty"`
+}
+
+// User is an Auth0 user.
+type User struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+	Name   string `json:"name,omitempty"`
+}
+
+// Encode returns the group ID of the members with the given role in the
+// organization with the given ID, or of all members of the organization if
+// roleID is empty.
+func Encode(orgID, roleID string) string {
+	if roleID == "" {
+		return orgID
+	}
+	return orgID + "/" + roleID
+}
+
+// Decode returns the organization and role IDs of a group ID. The role ID
+// is empty for the membership of an organization.
+func Decode(groupID string) (string, string) {
+	orgID, roleID, _ := strings.Cut(groupID, "/")
+	return orgID, roleID
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Auth0. It is wrapped to
+// add the Management API token.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Auth0 organizations and their role assignments.
+// Group IDs are of the form ORG_ID for the members of an organization and
+// ORG_ID/ROLE_ID for the members with a role in it. User IDs are emails.
+// Users given the role of an organization they are not a member of are added
+// to it. Removing a user from a role keeps them in the organization.
+type GroupReadWriter struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Auth0 tenant at the
+// given URL, e.g. https://example.us.auth0.com. It gets Management API
+// tokens with the client credentials of a machine to machine application.
+func NewGroupReadWriter(ctx context.Context, endpoint, clientID, clientSecret string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	credentials := &clientcredentials.Config{
+		ClientID:       clientID,
+		ClientSecret:   clientSecret,
+		TokenURL:       endpoint + "/oauth/token",
+		EndpointParams: url.Values{"audience": {endpoint + "/api/v2/"}},
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, config.httpClient)
+	return &GroupReadWriter{
+		client:    rest.New(endpoint+"/api/v2", rest.WithHTTPClient(credentials.Client(ctx))),
+		userCache: cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Auth0 organization, or organization role, with the
+// given ID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	orgID, roleID := Decode(groupID)
+	var org Organization
+	if err := rw.client.Do(ctx, http.MethodGet, "/organizations/"+url.PathEscape(orgID), nil, &org); err != nil {
+		return nil, fmt.Errorf("failed to get organization %s: %w", orgID, notFound(err))
+	}
+	if roleID != "" {
+		var role Role
+		if err := rw.client.Do(ctx, http.MethodGet, "/roles/"+url.PathEscape(roleID), nil, &role); err != nil {
+			return nil, fmt.Errorf("failed to get role %s: %w", roleID, notFound(err))
+		}
+	}
+	return &groupsync.Group{ID: groupID, Attributes: &org}, nil
+}
+
+// GetMembers retrieves the members of the Auth0 organization with the given
+// group ID, or its members with the role of the group ID.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	orgID, roleID := Decode(groupID)
+	members, err := rw.orgMembers(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]groupsync.Member, 0, len(members))
+	for _, m := range members {
+		if roleID == "" || slices.ContainsFunc(m.Roles, func(r *Role) bool { return r.ID == roleID }) {
+			res = append(res, &groupsync.UserMember{Usr: &groupsync.User{ID: m.Email, Attributes: m}})
+		}
+	}
+	return res, nil
+}
+
+// Descendants retrieve all users of the Auth0 organization, or organization
+// role, with the given group ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Auth0 user with the given email.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.user(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Email, Attributes: user}, nil
+}
+
+// user returns the Auth0 user with the given email. Emails of several
+// users, e.g. of unlinked social and database accounts, are ambiguous.
+func (rw *GroupReadWriter) user(ctx context.Context, userID string) (*User, error) {
+	user, err := rw.userCache.WriteThruLookup(strings.ToLower(userID), func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userID)
+		var users []*User
+		if err := rw.client.Do(ctx, http.MethodGet, "/users-by-email?email="+url.QueryEscape(userID), nil, &users); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", userID, err)
+		}
+		switch len(users) {
+		case 0:
+			return nil, fmt.Errorf("no auth0 user with email %s", userID)
+		case 1:
+			return users[0], nil
+		}
+		return nil, fmt.Errorf("%d auth0 users have email %s, link them into one user", len(users), userID)
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// SetMembers replaces the members of the Auth0 organization, or organization
+// role, with the given group ID with the given members.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	orgID, roleID := Decode(groupID)
+	orgMembers, err := rw.orgMembers(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	current, err := rw.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(current)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	userIDs := make(map[string]string, len(orgMembers))
+	for _, m := range orgMembers {
+		userIDs[strings.ToLower(m.Email)] = m.UserID
+	}
+
+	var merr error
+	var addToOrg, addToRole []string
+	for _, email := range utils.MapKeys(addMembers) {
+		if userID, ok := userIDs[email]; ok {
+			addToRole = append(addToRole, userID)
+			continue
+		}
+		user, err := rw.user(ctx, email)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to group %s: %w", email, groupID, err))
+			continue
+		}
+		addToOrg = append(addToOrg, user.UserID)
+		addToRole = append(addToRole, user.UserID)
+	}
+	var removeIDs []string
+	for _, email := range utils.MapKeys(removeMembers) {
+		removeIDs = append(removeIDs, userIDs[email])
+	}
+
+	orgMembersPath := "/organizations/" + url.PathEscape(orgID) + "/members"
+	if len(addToOrg) > 0 {
+		if err := rw.client.Do(ctx, http.MethodPost, orgMembersPath, map[string][]string{"members": addToOrg}, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add members %v to organization %s: %w", addToOrg, orgID, err))
+		}
+	}
+	if roleID == "" {
+		if len(removeIDs) > 0 {
+			if err := rw.client.Do(ctx, http.MethodDelete, orgMembersPath, map[string][]string{"members": removeIDs}, nil); err != nil {
+				merr = errors.Join(merr, fmt.Errorf("failed to remove members %v from organization %s: %w", removeIDs, orgID, err))
+			}
+		}
+		return merr
+	}
+
+	roles := map[string][]string{"roles": {roleID}}
+	for _, userID := range addToRole {
+		if err := rw.client.Do(ctx, http.MethodPost, orgMembersPath+"/"+url.PathEscape(userID)+"/roles", roles, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to assign role %s of organization %s to %s: %w", roleID, orgID, userID, err))
+		}
+	}
+	for _, userID := range removeIDs {
+		if err := rw.client.Do(ctx, http.MethodDelete, orgMembersPath+"/"+url.PathEscape(userID)+"/roles", roles, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to unassign role %s of organization %s from %s: %w", roleID, orgID, userID, err))
+		}
+	}
+	return merr
+}
+
+// orgMembers returns all members of the organization with the given ID with
+// their roles.
+func (rw *GroupReadWriter) orgMembers(ctx context.Context, orgID string) ([]*Member, error) {
+	var members []*Member
+	for page := 0; ; page++ {
+		q := url.Values{
+			"page":           {fmt.Sprint(page)},
+			"per_page":       {fmt.Sprint(pageSize)},
+			"fields":         {"user_id,email,name,roles"},
+			"include_fields": {"true"},
+		}
+		var batch []*Member
+		if err := rw.client.Do(ctx, http.MethodGet, "/organizations/"+url.PathEscape(orgID)+"/members?"+q.Encode(), nil, &batch); err != nil {
+			return nil, fmt.Errorf("failed to list members of organization %s: %w", orgID, notFound(err))
+		}
+		members = append(members, batch...)
+		if len(batch) < pageSize {
+			return members, nil
+		}
+	}
+}
+
+// notFound wraps errors of missing organizations and roles with
+// groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+// toIDMap returns the given members by lowercased ID, as emails are
+// case-insensitive.
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[strings.ToLower(m.ID())] = m
+	}
+	return memberIDs
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth0
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeAuth0{
+		users: []*User{
+			{UserID: "auth0|1", Email: "alice@example.com"},
+			{UserID: "auth0|2", Email: "bob@example.com"},
+			{UserID: "auth0|3", Email: "carol@example.com"},
+			{UserID: "auth0|4", Email: "dup@example.com"},
+			{UserID: "google-oauth2|4", Email: "dup@example.com"},
+		},
+		members: map[string][]string{
+			"auth0|1": {"rol_admin"},
+			"auth0|2": {},
+		},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(ctx, srv.URL, "client", "secret")
+
+	users, err := rw.Descendants(ctx, "org_1/rol_admin")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "org_1/rol_admin", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "Bob@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "dup@example.com"}},
+	})
+	if diff := testutil.DiffErrString(err, "2 auth0 users have email dup@example.com"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+	want := map[string][]string{
+		"auth0|1": {},
+		"auth0|2": {"rol_admin"},
+		"auth0|3": {"rol_admin"},
+	}
+	if diff := cmp.Diff(fake.members, want); diff != "" {
+		t.Errorf("unexpected organization members (-got, +want):\n%s", diff)
+	}
+
+	if err := rw.SetMembers(ctx, "org_1", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+	}); err != nil {
+		t.Fatalf("SetMembers of organization failed: %v", err)
+	}
+	if diff := cmp.Diff(slices.Sorted(maps.Keys(fake.members)), []string{"auth0|2", "auth0|3"}); diff != "" {
+		t.Errorf("unexpected organization members (-got, +want):\n%s", diff)
+	}
+
+	if _, err := rw.GetGroup(ctx, "org_2"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(org_2) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeAuth0 implements the token endpoint and the parts of the Management
+// API used by GroupReadWriter for the single organization org_1, whose
+// members are mapped to their role IDs.
+type fakeAuth0 struct {
+	mu      sync.Mutex
+	users   []*User
+	members map[string][]string
+}
+
+func (f *fakeAuth0) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/oauth/token" {
+		if r.FormValue("client_secret") != "secret" || !strings.HasSuffix(r.FormValue("audience"), "/api/v2/") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`)
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	var body map[string][]string
+	if r.Body != nil && r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v2/"), "/")
+	switch {
+	case parts[0] == "users-by-email":
+		var users []*User
+		for _, u := range f.users {
+			if u.Email == r.URL.Query().Get("email") {
+				users = append(users, u)
+			}
+		}
+		write(users)
+	case parts[0] == "roles" && len(parts) == 2 && parts[1] == "rol_admin":
+		write(&Role{ID: "rol_admin", Name: "admin"})
+	case parts[0] == "organizations" && parts[1] == "org_1":
+		switch {
+		case len(parts) == 2:
+			write(&Organization{ID: "org_1", Name: "acme"})
+		case len(parts) == 3 && r.Method == http.MethodGet:
+			var members []*Member
+			if r.URL.Query().Get("page") == "0" {
+				for _, u := range f.users {
+					if roles, ok := f.members[u.UserID]; ok {
+						m := &Member{UserID: u.UserID, Email: u.Email}
+						for _, id := range roles {
+							m.Roles = append(m.Roles, &Role{ID: id})
+						}
+						members = append(members, m)
+					}
+				}
+			}
+			write(members)
+		case len(parts) == 3 && r.Method == http.MethodPost:
+			for _, id := range body["members"] {
+				f.members[id] = []string{}
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case len(parts) == 3 && r.Method == http.MethodDelete:
+			for _, id := range body["members"] {
+				delete(f.members, id)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case len(parts) == 5 && parts[4] == "roles":
+			roles := slices.DeleteFunc(f.members[parts[3]], func(id string) bool { return slices.Contains(body["roles"], id) })
+			if r.Method == http.MethodPost {
+				roles = append(roles, body["roles"]...)
+			}
+			f.members[parts[3]] = roles
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
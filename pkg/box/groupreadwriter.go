@@ -0,0 +1,295 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package box provides a GroupReadWriter for Box groups, e.g. the groups
+// folders are shared with.
+package box
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultEndpoint is the URL of the Box API.
+	DefaultEndpoint = "https://api.box.com"
+
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of memberships requested per page, the maximum
+	// of the API.
+	pageSize = 1000
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Group is a Box group, see
+// https://developer.box.com/reference/resources/group/.
+type Group struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// User is a Box user, see https://developer.box.com/reference/resources/user/.
+// Team-link identifies users by their login, their email.
+type User struct {
+	ID     string `json:"id"`
+	Login  string `json:"login"`
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+// membership is the membership of a user in a group.
+type membership struct {
+	ID   string `json:"id"`
+	User *User  `json:"user"`
+	Role string `json:"role"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Box. It is wrapped to add
+// the OAuth access token.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Box groups. Group IDs are the IDs of the groups
+// and user IDs are logins. Members are added with the member role, and
+// existing members keep their role, e.g. admin.
+type GroupReadWriter struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Box API at the given
+// URL, e.g. https://api.box.com. It authenticates as the service account of
+// a Box Platform app using client credentials, authorized in the enterprise
+// with the given ID with the "Manage users" and "Manage groups" scopes.
+func NewGroupReadWriter(ctx context.Context, endpoint, enterpriseID, clientID, clientSecret string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	credentials := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     endpoint + "/oauth2/token",
+		EndpointParams: url.Values{
+			"box_subject_type": {"enterprise"},
+			"box_subject_id":   {enterpriseID},
+		},
+		AuthStyle: oauth2.AuthStyleInParams,
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, config.httpClient)
+	return &GroupReadWriter{
+		client:    rest.New(endpoint+"/2.0", rest.WithHTTPClient(credentials.Client(ctx))),
+		userCache: cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Box group with the given ID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	var group Group
+	if err := rw.client.Do(ctx, http.MethodGet, "/groups/"+url.PathEscape(groupID), nil, &group); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: group.ID, Attributes: &group}, nil
+}
+
+// GetMembers retrieves the users in the Box group with the given ID.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	memberships, err := rw.memberships(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]groupsync.Member, 0, len(memberships))
+	for _, m := range memberships {
+		members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: m.User.Login, StableID: m.User.ID, Attributes: m.User}})
+	}
+	return members, nil
+}
+
+// memberships returns the memberships of the Box group with the given ID.
+func (rw *GroupReadWriter) memberships(ctx context.Context, groupID string) ([]*membership, error) {
+	var memberships []*membership
+	for {
+		var resp struct {
+			TotalCount int           `json:"total_count"`
+			Entries    []*membership `json:"entries"`
+		}
+		q := url.Values{"limit": {strconv.Itoa(pageSize)}, "offset": {strconv.Itoa(len(memberships))}}
+		if err := rw.client.Do(ctx, http.MethodGet, "/groups/"+url.PathEscape(groupID)+"/memberships?"+q.Encode(), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get members of group %s: %w", groupID, notFound(err))
+		}
+		memberships = append(memberships, resp.Entries...)
+		if len(resp.Entries) == 0 || len(memberships) >= resp.TotalCount {
+			return memberships, nil
+		}
+	}
+}
+
+// Descendants retrieve all users of the Box group with the given ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the managed Box user with the given login.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.user(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Login, StableID: user.ID, Attributes: user}, nil
+}
+
+// user returns the managed Box user with the given login.
+func (rw *GroupReadWriter) user(ctx context.Context, login string) (*User, error) {
+	user, err := rw.userCache.WriteThruLookup(strings.ToLower(login), func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", login)
+		var resp struct {
+			Entries []*User `json:"entries"`
+		}
+		// filter_term matches the beginning of logins and names.
+		q := url.Values{"filter_term": {login}, "user_type": {"managed"}, "fields": {"id,login,name,status"}}
+		if err := rw.client.Do(ctx, http.MethodGet, "/users?"+q.Encode(), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", login, err)
+		}
+		for _, u := range resp.Entries {
+			if strings.EqualFold(u.Login, login) {
+				return u, nil
+			}
+		}
+		return nil, fmt.Errorf("no user exists with login %s", login)
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// SetMembers replaces the members of the Box group with the given ID with
+// the given members. Users which are not in the enterprise are reported in
+// the returned error after all other changes are made.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	memberships, err := rw.memberships(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	membershipIDs := make(map[string]string, len(memberships))
+	currentMemberIDs := make(map[string]groupsync.Member, len(memberships))
+	for _, m := range memberships {
+		id := strings.ToLower(m.User.Login)
+		membershipIDs[id] = m.ID
+		currentMemberIDs[id] = &groupsync.UserMember{Usr: &groupsync.User{ID: m.User.Login}}
+	}
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	var merr error
+	for _, login := range utils.MapKeys(addMembers) {
+		user, err := rw.user(ctx, login)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to group %s: %w", login, groupID, err))
+			continue
+		}
+		body := map[string]any{
+			"user":  map[string]string{"id": user.ID},
+			"group": map[string]string{"id": groupID},
+			"role":  "member",
+		}
+		if err := rw.client.Do(ctx, http.MethodPost, "/group_memberships", body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add %s to group %s: %w", login, groupID, err))
+		}
+	}
+	for _, login := range utils.MapKeys(removeMembers) {
+		if err := rw.client.Do(ctx, http.MethodDelete, "/group_memberships/"+url.PathEscape(membershipIDs[login]), nil, nil); err != nil && !rest.IsNotFound(err) {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove %s from group %s: %w", login, groupID, err))
+		}
+	}
+	return merr
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+// toIDMap returns the given members by lowercased ID, as logins are
+// case-insensitive.
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[strings.ToLower(m.ID())] = m
+	}
+	return memberIDs
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package box
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeBox{
+		users: map[string]*User{
+			"1": {ID: "1", Login: "alice@example.com", Status: "active"},
+			"2": {ID: "2", Login: "bob@example.com", Status: "active"},
+			"3": {ID: "3", Login: "carol@example.com", Status: "active"},
+			"4": {ID: "4", Login: "carol@example.com.au", Status: "active"},
+		},
+		memberships: map[string]map[string]string{"100": {"m1": "1", "m2": "2"}},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(ctx, srv.URL, "42", "client", "secret")
+
+	users, err := rw.Descendants(ctx, "100")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice@example.com", "bob@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	if err := rw.SetMembers(ctx, "100", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "Bob@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "dave@example.com"}},
+	}); err == nil || !strings.Contains(err.Error(), "cannot add dave@example.com") {
+		t.Errorf("SetMembers got err %v, want missing user dave", err)
+	}
+	members, err := rw.GetMembers(ctx, "100")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	var got []string
+	for _, m := range members {
+		got = append(got, m.ID())
+	}
+	slices.Sort(got)
+	if diff := cmp.Diff(got, []string{"bob@example.com", "carol@example.com"}); diff != "" {
+		t.Errorf("unexpected members (-got, +want):\n%s", diff)
+	}
+
+	if _, err := rw.GetGroup(ctx, "missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeBox implements the token endpoint and the parts of the Box API used by
+// GroupReadWriter. Memberships are returned one per page.
+type fakeBox struct {
+	mu          sync.Mutex
+	users       map[string]*User
+	memberships map[string]map[string]string
+	nextID      int
+}
+
+func (f *fakeBox) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/oauth2/token" {
+		if r.FormValue("client_secret") != "secret" || r.FormValue("box_subject_type") != "enterprise" || r.FormValue("box_subject_id") != "42" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"access_token": "token", "token_type": "bearer", "expires_in": 3600}) //nolint:errcheck // test server
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/2.0/"), "/")
+	switch {
+	case parts[0] == "users" && len(parts) == 1:
+		var entries []*User
+		for _, u := range f.users {
+			if strings.HasPrefix(u.Login, r.URL.Query().Get("filter_term")) {
+				entries = append(entries, u)
+			}
+		}
+		write(map[string]any{"entries": entries})
+	case parts[0] == "groups" && len(parts) >= 2:
+		group, ok := f.memberships[parts[1]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if len(parts) == 2 {
+			write(&Group{ID: parts[1], Name: "engineering"})
+			return
+		}
+		ids := make([]string, 0, len(group))
+		for id := range group {
+			ids = append(ids, id)
+		}
+		slices.Sort(ids)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var entries []*membership
+		if offset < len(ids) {
+			entries = append(entries, &membership{ID: ids[offset], User: f.users[group[ids[offset]]], Role: "member"})
+		}
+		write(map[string]any{"total_count": len(ids), "entries": entries})
+	case parts[0] == "group_memberships" && r.Method == http.MethodPost:
+		var body struct {
+			User  struct{ ID string } `json:"user"`
+			Group struct{ ID string } `json:"group"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.nextID++
+		f.memberships[body.Group.ID]["n"+strconv.Itoa(f.nextID)] = body.User.ID
+		w.WriteHeader(http.StatusCreated)
+	case parts[0] == "group_memberships" && r.Method == http.MethodDelete && len(parts) == 2:
+		for _, group := range f.memberships {
+			delete(group, parts[1])
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package changefeed provides groupsync.ChangeSinks publishing the membership
+// changes applied by team-link to Pub/Sub, Kafka or a webhook.
+package changefeed
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxErrorBody bounds how much of an error response is kept in an error.
+const maxErrorBody = 1024
+
+type Config struct {
+	httpClient    *http.Client
+	username      string
+	password      string
+	signingSecret []byte
+}
+
+type Opt func(config *Config)
+
+// WithHTTPClient sets the HTTP client of the Kafka and webhook sinks,
+// http.DefaultClient by default.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// WithBasicAuth authenticates the requests of the Kafka sink to the REST
+// Proxy with HTTP basic authentication.
+func WithBasicAuth(username, password string) Opt {
+	return func(config *Config) {
+		config.username = username
+		config.password = password
+	}
+}
+
+// WithSigningSecret signs the requests of the webhook sink with the given
+// secret, see WebhookSink.
+func WithSigningSecret(secret []byte) Opt {
+	return func(config *Config) {
+		config.signingSecret = secret
+	}
+}
+
+func newConfig(opts []Opt) *Config {
+	config := &Config{httpClient: http.DefaultClient}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
+
+// post sends body to the URL with the given content type and headers, and
+// returns the response body. Responses with a non-2xx status are errors.
+func post(ctx context.Context, client *http.Client, url, contentType string, body []byte, header http.Header) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to post to %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response of %s: %w", url, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("post to %s: unexpected status %d: %s", url, resp.StatusCode, b[:min(len(b), maxErrorBody)])
+	}
+	return b, nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changefeed
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	"google.golang.org/api/pubsub/v1"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+var testEvents = []*groupsync.ChangeEvent{
+	{
+		SchemaVersion: groupsync.ChangeEventSchemaVersion,
+		ID:            "e1",
+		Time:          time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		System:        "GITHUB",
+		GroupID:       "1:2",
+		Action:        groupsync.ChangeAdd,
+		MemberType:    "user",
+		MemberID:      "alice",
+		Role:          "maintainer",
+	},
+	{
+		SchemaVersion: groupsync.ChangeEventSchemaVersion,
+		ID:            "e2",
+		Time:          time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		System:        "GITHUB",
+		GroupID:       "1:2",
+		Action:        groupsync.ChangeRemove,
+		MemberType:    "user",
+		MemberID:      "bob",
+	},
+}
+
+func TestPubSubSink_Publish(t *testing.T) {
+	t.Parallel()
+
+	var got []*groupsync.ChangeEvent
+	var gotAttributes []map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/projects/p/topics/changes:publish" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 404, "message": "topic not found"}}`)
+			return
+		}
+		var req pubsub.PublishRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, m := range req.Messages {
+			data, _ := base64.StdEncoding.DecodeString(m.Data)
+			var e groupsync.ChangeEvent
+			if err := json.Unmarshal(data, &e); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			got = append(got, &e)
+			gotAttributes = append(gotAttributes, m.Attributes)
+		}
+		fmt.Fprint(w, `{"messageIds": ["1", "2"]}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+	sink := NewPubSubSink("projects/p/topics/changes", option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+	if err := sink.Publish(ctx, testEvents); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+	if diff := cmp.Diff(got, testEvents); diff != "" {
+		t.Errorf("unexpected events (-got, +want):\n%s", diff)
+	}
+	wantAttributes := []map[string]string{
+		{"schema_version": "1", "system": "GITHUB", "group_id": "1:2", "action": "add"},
+		{"schema_version": "1", "system": "GITHUB", "group_id": "1:2", "action": "remove"},
+	}
+	if diff := cmp.Diff(gotAttributes, wantAttributes); diff != "" {
+		t.Errorf("unexpected attributes (-got, +want):\n%s", diff)
+	}
+
+	missing := NewPubSubSink("projects/p/topics/missing", option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+	err := missing.Publish(ctx, testEvents)
+	if diff := testutil.DiffErrString(err, "failed to publish to pubsub topic projects/p/topics/missing"); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestKafkaSink_Publish(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		response string
+		wantErr  string
+	}{
+		{
+			name:     "success",
+			response: `{"offsets": [{"partition": 0, "offset": 1}, {"partition": 0, "offset": 2}]}`,
+		},
+		{
+			name:     "record_failed",
+			response: `{"offsets": [{"partition": 0, "offset": 1}, {"error_code": 50003, "error": "timeout"}]}`,
+			wantErr:  "failed to produce event e2: timeout (error code 50003)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []*kafkaRecord
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if user, pass, _ := r.BasicAuth(); user != "team-link" || pass != "secret" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if r.URL.Path != "/topics/access-changes" || r.Header.Get("Content-Type") != kafkaContentType {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				var req struct {
+					Records []*kafkaRecord `json:"records"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				got = req.Records
+				fmt.Fprint(w, tc.response)
+			}))
+			t.Cleanup(srv.Close)
+
+			sink := NewKafkaSink(srv.URL+"/", "access-changes", WithBasicAuth("team-link", "secret"))
+			err := sink.Publish(context.Background(), testEvents)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			want := []*kafkaRecord{
+				{Key: "1:2", Value: testEvents[0]},
+				{Key: "1:2", Value: testEvents[1]},
+			}
+			if diff := cmp.Diff(got, want); diff != "" {
+				t.Errorf("unexpected records (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWebhookSink_Publish(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("webhook-secret")
+	var got []*groupsync.ChangeEvent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(SignatureHeader) != "sha256="+Sign(secret, body) {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, "invalid signature")
+			return
+		}
+		var req struct {
+			Events []*groupsync.ChangeEvent `json:"events"`
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		got = req.Events
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+	if err := NewWebhookSink(srv.URL, WithSigningSecret(secret)).Publish(ctx, testEvents); err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+	if diff := cmp.Diff(got, testEvents); diff != "" {
+		t.Errorf("unexpected events (-got, +want):\n%s", diff)
+	}
+
+	err := NewWebhookSink(srv.URL, WithSigningSecret([]byte("wrong"))).Publish(ctx, testEvents)
+	if diff := testutil.DiffErrString(err, "unexpected status 403: invalid signature"); diff != "" {
+		t.Error(diff)
+	}
+}
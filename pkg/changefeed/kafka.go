@@ -0,0 +1,100 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changefeed
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// kafkaContentType is the content type of JSON records of the Kafka REST
+// Proxy v2 API.
+const kafkaContentType = "application/vnd.kafka.json.v2+json"
+
+// Ensure we conform to the interface.
+var _ groupsync.ChangeSink = (*KafkaSink)(nil)
+
+// KafkaSink produces each event as a JSON record of a Kafka topic through a
+// Kafka REST Proxy, see
+// https://docs.confluent.io/platform/current/kafka-rest/api.html. Records are
+// keyed by the target group ID, so that the changes of a group stay in order.
+type KafkaSink struct {
+	url    string
+	config *Config
+}
+
+type kafkaRecord struct {
+	Key   string                 `json:"key"`
+	Value *groupsync.ChangeEvent `json:"value"`
+}
+
+type kafkaOffset struct {
+	ErrorCode *int   `json:"error_code"`
+	Error     string `json:"error"`
+}
+
+// NewKafkaSink creates a KafkaSink producing to the given topic through the
+// REST Proxy at the given URL, e.g. https://kafka-rest.example.com.
+func NewKafkaSink(restProxyURL, topic string, opts ...Opt) *KafkaSink {
+	return &KafkaSink{
+		url:    strings.TrimSuffix(restProxyURL, "/") + "/topics/" + url.PathEscape(topic),
+		config: newConfig(opts),
+	}
+}
+
+// Publish produces the given events.
+func (s *KafkaSink) Publish(ctx context.Context, events []*groupsync.ChangeEvent) error {
+	records := make([]*kafkaRecord, 0, len(events))
+	for _, e := range events {
+		records = append(records, &kafkaRecord{Key: e.GroupID, Value: e})
+	}
+	body, err := json.Marshal(map[string][]*kafkaRecord{"records": records})
+	if err != nil {
+		return fmt.Errorf("failed to marshal records: %w", err)
+	}
+	header := make(http.Header)
+	header.Set("Accept", "application/vnd.kafka.v2+json")
+	if s.config.username != "" || s.config.password != "" {
+		credentials := base64.StdEncoding.EncodeToString([]byte(s.config.username + ":" + s.config.password))
+		header.Set("Authorization", "Basic "+credentials)
+	}
+	resp, err := post(ctx, s.config.httpClient, s.url, kafkaContentType, body, header)
+	if err != nil {
+		return err
+	}
+
+	// records are produced independently, each with its own result.
+	var produced struct {
+		Offsets []*kafkaOffset `json:"offsets"`
+	}
+	if err := json.Unmarshal(resp, &produced); err != nil {
+		return fmt.Errorf("failed to decode response of %s: %w", s.url, err)
+	}
+	var merr error
+	for i, o := range produced.Offsets {
+		if o.ErrorCode != nil && i < len(events) {
+			merr = errors.Join(merr, fmt.Errorf("failed to produce event %s: %s (error code %d)", events[i].ID, o.Error, *o.ErrorCode))
+		}
+	}
+	return merr
+}
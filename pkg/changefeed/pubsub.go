@@ -0,0 +1,95 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changefeed
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/pubsub/v1"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// maxPubSubMessages is the most messages Pub/Sub accepts in a publish request.
+const maxPubSubMessages = 1000
+
+// Ensure we conform to the interface.
+var _ groupsync.ChangeSink = (*PubSubSink)(nil)
+
+// PubSubSink publishes each event as a message of a Pub/Sub topic. The data
+// of a message is the JSON event, and its attributes are the schema version,
+// system, group ID and action of the event, so subscriptions can filter on
+// them.
+type PubSubSink struct {
+	topic         string
+	clientOptions []option.ClientOption
+
+	once   sync.Once
+	topics *pubsub.ProjectsTopicsService
+	svcErr error
+}
+
+// NewPubSubSink creates a PubSubSink publishing to the topic with the given
+// name, of the form projects/PROJECT/topics/TOPIC, using the given options of
+// the Pub/Sub client. The client is only created when events are published,
+// using application default credentials unless configured otherwise.
+func NewPubSubSink(topic string, opts ...option.ClientOption) *PubSubSink {
+	return &PubSubSink{topic: topic, clientOptions: opts}
+}
+
+// Publish publishes the given events.
+func (s *PubSubSink) Publish(ctx context.Context, events []*groupsync.ChangeEvent) error {
+	s.once.Do(func() {
+		svc, err := pubsub.NewService(ctx, s.clientOptions...)
+		if err != nil {
+			s.svcErr = fmt.Errorf("failed to create pubsub client: %w", err)
+			return
+		}
+		s.topics = svc.Projects.Topics
+	})
+	if s.svcErr != nil {
+		return s.svcErr
+	}
+
+	messages := make([]*pubsub.PubsubMessage, 0, len(events))
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("failed to marshal event: %w", err)
+		}
+		messages = append(messages, &pubsub.PubsubMessage{
+			Data: base64.StdEncoding.EncodeToString(data),
+			Attributes: map[string]string{
+				"schema_version": strconv.Itoa(e.SchemaVersion),
+				"system":         e.System,
+				"group_id":       e.GroupID,
+				"action":         string(e.Action),
+			},
+		})
+	}
+	for start := 0; start < len(messages); start += maxPubSubMessages {
+		batch := messages[start:min(start+maxPubSubMessages, len(messages))]
+		if _, err := s.topics.Publish(s.topic, &pubsub.PublishRequest{Messages: batch}).Context(ctx).Do(); err != nil {
+			return fmt.Errorf("failed to publish to pubsub topic %s: %w", s.topic, err)
+		}
+	}
+	return nil
+}
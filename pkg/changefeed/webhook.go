@@ -0,0 +1,70 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package changefeed
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// SignatureHeader carries the signature of the requests of a WebhookSink.
+const SignatureHeader = "X-Team-Link-Signature"
+
+// Ensure we conform to the interface.
+var _ groupsync.ChangeSink = (*WebhookSink)(nil)
+
+// WebhookSink posts events to a URL as a JSON object with an "events" list.
+// With a signing secret, the SignatureHeader of each request is sha256=HMAC,
+// where HMAC is the hex encoded HMAC-SHA256 of the body with the secret.
+type WebhookSink struct {
+	url    string
+	config *Config
+}
+
+// NewWebhookSink creates a WebhookSink posting to the given URL.
+func NewWebhookSink(url string, opts ...Opt) *WebhookSink {
+	return &WebhookSink{url: url, config: newConfig(opts)}
+}
+
+// Publish posts the given events.
+func (s *WebhookSink) Publish(ctx context.Context, events []*groupsync.ChangeEvent) error {
+	body, err := json.Marshal(map[string][]*groupsync.ChangeEvent{"events": events})
+	if err != nil {
+		return fmt.Errorf("failed to marshal events: %w", err)
+	}
+	header := make(http.Header)
+	if len(s.config.signingSecret) > 0 {
+		header.Set(SignatureHeader, "sha256="+Sign(s.config.signingSecret, body))
+	}
+	if _, err := post(ctx, s.config.httpClient, s.url, "application/json", body, header); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of body with secret, which
+// receivers of a WebhookSink compare to the SignatureHeader.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
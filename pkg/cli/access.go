@@ -0,0 +1,150 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/pkg/common"
+)
+
+var _ cli.Command = (*AccessExportCommand)(nil)
+
+// AccessExportCommand exports the current target memberships and their source
+// provenance for access recertification.
+type AccessExportCommand struct {
+	cli.BaseCommand
+
+	loggingFlags
+	stateFlags
+
+	mapping string
+	config  string
+	output  string
+}
+
+func (c *AccessExportCommand) Desc() string {
+	return `Export memberships for an access review`
+}
+
+func (c *AccessExportCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  Export the current members of all mapped target groups as CSV with the
+  columns group, user, source and justification, for access recertification
+  campaigns. Members that are not derived from a source group membership
+  have an empty source.
+
+  tlctl access export \
+	-mapping mapping.textproto \
+	-config config.textproto \
+	-output access.csv
+`
+}
+
+func (c *AccessExportCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "mapping",
+		Target:  &c.mapping,
+		Aliases: []string{"m"},
+		Example: "mapping.textproto",
+		Usage:   `The textproto file that includes group and user mapping info`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "config",
+		Target:  &c.config,
+		Aliases: []string{"c"},
+		Example: "config.textproto",
+		Usage:   `The textproto file for teamlink configs.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "output",
+		Target:  &c.output,
+		Aliases: []string{"o"},
+		Example: "access.csv",
+		Usage:   `The file the CSV is written to. Defaults to stdout.`,
+	})
+
+	c.stateFlags.register(set)
+	c.loggingFlags.register(set)
+
+	set.AfterParse(func(merr error) error {
+		if c.mapping == "" {
+			merr = errors.Join(merr, fmt.Errorf("mapping file is not provided"))
+		}
+		if c.config == "" {
+			merr = errors.Join(merr, fmt.Errorf("config file is not provided"))
+		}
+		return merr
+	})
+
+	return set
+}
+
+func (c *AccessExportCommand) Run(ctx context.Context, args []string) (retErr error) {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	ctx, err := c.withLogger(ctx, c.Stderr())
+	if err != nil {
+		return fmt.Errorf("failed to setup logger: %w", err)
+	}
+
+	var opts []common.SyncOpt
+	store, err := c.openStateStore(ctx)
+	if err != nil {
+		return err
+	}
+	if store != nil {
+		opts = append(opts, common.WithStateStore(store))
+	}
+
+	var w io.Writer = c.Stdout()
+	if c.output != "" {
+		file, err := os.Create(c.output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				retErr = errors.Join(retErr, fmt.Errorf("failed to close output file: %w", err))
+			}
+		}()
+		w = file
+	}
+
+	if err := common.ExportAccess(ctx, w, c.mapping, c.config, opts...); err != nil {
+		return fmt.Errorf("failed to export access: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+var _ cli.Command = (*CompletionCommand)(nil)
+
+// completionScripts are the completion scripts of each shell. The shells
+// call tlctl itself with COMP_LINE set to the line being completed, which
+// the command tree answers with the matching subcommands and flags.
+var completionScripts = map[string]string{
+	"bash": `complete -o default -C tlctl tlctl
+`,
+	"zsh": `autoload -U +X bashcompinit && bashcompinit
+complete -o nospace -C tlctl tlctl
+`,
+	"fish": `function __complete_tlctl
+    set -lx COMP_LINE (commandline -cp)
+    test -z (commandline -ct)
+    and set COMP_LINE "$COMP_LINE "
+    set -lx COMP_POINT (string length -- "$COMP_LINE")
+    tlctl
+end
+complete -f -c tlctl -a "(__complete_tlctl)"
+`,
+}
+
+// CompletionCommand prints the shell completion script of tlctl.
+type CompletionCommand struct {
+	cli.BaseCommand
+}
+
+func (c *CompletionCommand) Desc() string {
+	return `Print the shell completion script`
+}
+
+func (c *CompletionCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} SHELL
+
+  Print the script which completes the subcommands and flags of tlctl in the
+  given shell, one of: ` + strings.Join(utils.MapKeys(completionScripts), ", ") + `. tlctl must be on the PATH.
+
+  Enable completion in the current bash session:
+
+  source <(tlctl completion bash)
+
+  Enable completion in every zsh session:
+
+  tlctl completion zsh >> ~/.zshrc
+
+  Enable completion in fish:
+
+  tlctl completion fish > ~/.config/fish/completions/tlctl.fish
+`
+}
+
+func (c *CompletionCommand) Flags() *cli.FlagSet {
+	return c.NewFlagSet()
+}
+
+func (c *CompletionCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	shells := utils.MapKeys(completionScripts)
+	if len(args) != 1 || !slices.Contains(shells, args[0]) {
+		return fmt.Errorf("expected one shell, one of: %s", strings.Join(shells, ", "))
+	}
+	fmt.Fprint(c.Stdout(), completionScripts[args[0]])
+	return nil
+}
@@ -0,0 +1,253 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/abcxyz/pkg/cli"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/internal/version"
+	"github.com/abcxyz/team-link/pkg/common"
+	"github.com/abcxyz/team-link/pkg/e2e"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/gitlab"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+var _ cli.Command = (*E2ECommand)(nil)
+
+// RunE2E executes the tle2e CLI.
+func RunE2E(ctx context.Context, args []string) error {
+	root := &cli.RootCommand{
+		Name:    "tle2e",
+		Version: version.HumanVersion,
+		Commands: map[string]cli.CommandFactory{
+			"run": func() cli.Command {
+				return &E2ECommand{}
+			},
+		},
+	}
+	return root.Run(ctx, args) //nolint:wrapcheck // Want passthrough
+}
+
+// E2ECommand runs sync scenarios against ephemeral groups of a live sandbox
+// environment.
+type E2ECommand struct {
+	cli.BaseCommand
+
+	loggingFlags
+
+	config        string
+	system        string
+	users         []string
+	scenarios     []string
+	githubOrgID   int64
+	gitlabGroupID int
+	googleGroups  []string
+}
+
+func (c *E2ECommand) Desc() string {
+	return `Run sync scenarios against a sandbox environment`
+}
+
+func (c *E2ECommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  Run sync scenarios against ephemeral groups of a sandbox environment and
+  check their members after each sync. GitHub teams are created in the
+  sandbox org and GitLab groups under the sandbox parent group, and deleted
+  afterwards. Google groups cannot be created with the permissions of a sync,
+  so designated sandbox groups, one per scenario, are emptied instead. The
+  credentials are those of the target of the config. Fails if any scenario
+  fails.
+
+  tle2e run -config sandbox.textproto -system github -github-org-id 9999 \
+	-user tl-e2e-alice -user tl-e2e-bob
+`
+}
+
+func (c *E2ECommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "config",
+		Target:  &c.config,
+		Aliases: []string{"c"},
+		Example: "sandbox.textproto",
+		Usage:   `The textproto file for teamlink configs, whose target config has the credentials of the sandbox.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "system",
+		Target:  &c.system,
+		Example: "github",
+		Usage:   `The system of the sandbox: github, gitlab or googlegroups.`,
+	})
+
+	f.StringSliceVar(&cli.StringSliceVar{
+		Name:    "user",
+		Target:  &c.users,
+		Example: "tl-e2e-alice",
+		Usage:   `The ID of a user of the sandbox which scenarios add to groups. May be repeated, the default scenarios need two.`,
+	})
+
+	f.StringSliceVar(&cli.StringSliceVar{
+		Name:    "scenario",
+		Target:  &c.scenarios,
+		Example: "add_remove",
+		Usage:   `The name of a scenario to run, instead of all of them. May be repeated.`,
+	})
+
+	f.Int64Var(&cli.Int64Var{
+		Name:    "github-org-id",
+		Target:  &c.githubOrgID,
+		Example: "9999",
+		Usage:   `The ID of the sandbox GitHub org, in which teams are created.`,
+	})
+
+	f.IntVar(&cli.IntVar{
+		Name:    "gitlab-group-id",
+		Target:  &c.gitlabGroupID,
+		Example: "1234",
+		Usage:   `The ID of the sandbox GitLab group, under which subgroups are created.`,
+	})
+
+	f.StringSliceVar(&cli.StringSliceVar{
+		Name:    "google-group",
+		Target:  &c.googleGroups,
+		Example: "groups/abc123",
+		Usage:   `The ID of a designated sandbox Google group. May be repeated, each scenario needs one.`,
+	})
+
+	c.loggingFlags.register(set)
+
+	set.AfterParse(func(merr error) error {
+		if c.config == "" {
+			merr = errors.Join(merr, fmt.Errorf("config file is not provided"))
+		}
+		switch systemType(c.system) {
+		case tltypes.SystemTypeGitHub:
+			if c.githubOrgID == 0 {
+				merr = errors.Join(merr, fmt.Errorf("github-org-id is required with system github"))
+			}
+		case tltypes.SystemTypeGitLab:
+			if c.gitlabGroupID == 0 {
+				merr = errors.Join(merr, fmt.Errorf("gitlab-group-id is required with system gitlab"))
+			}
+		case tltypes.SystemTypeGoogleGroups:
+			if len(c.googleGroups) == 0 {
+				merr = errors.Join(merr, fmt.Errorf("google-group is required with system googlegroups"))
+			}
+		default:
+			merr = errors.Join(merr, fmt.Errorf("system must be one of: github, gitlab, googlegroups"))
+		}
+		for _, name := range c.scenarios {
+			if !slices.ContainsFunc(e2e.DefaultScenarios, func(s *e2e.Scenario) bool { return s.Name == name }) {
+				merr = errors.Join(merr, fmt.Errorf("unknown scenario %q", name))
+			}
+		}
+		return merr
+	})
+
+	return set
+}
+
+func (c *E2ECommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	ctx, err := c.withLogger(ctx, c.Stderr())
+	if err != nil {
+		return fmt.Errorf("failed to setup logger: %w", err)
+	}
+
+	config, err := utils.ParseConfigTextProto(ctx, c.config)
+	if err != nil {
+		return fmt.Errorf("failed to parse config file: %w", err)
+	}
+	sandbox, err := c.sandbox(ctx, config)
+	if err != nil {
+		return err
+	}
+
+	scenarios := e2e.DefaultScenarios
+	if len(c.scenarios) > 0 {
+		scenarios = slices.DeleteFunc(slices.Clone(scenarios), func(s *e2e.Scenario) bool {
+			return !slices.Contains(c.scenarios, s.Name)
+		})
+	}
+	results := e2e.Run(ctx, sandbox, c.users, scenarios)
+
+	w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "RESULT\tSYSTEM\tSCENARIO\tDURATION\tERROR\n")
+	var failed int
+	for _, r := range results {
+		result, errMsg := "pass", ""
+		if r.Err != nil {
+			result, errMsg = "FAIL", r.Err.Error()
+			failed++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", result, r.System, r.Scenario, r.Duration.Round(time.Millisecond), errMsg)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write results: %w", err)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d scenarios failed", failed, len(results))
+	}
+	return nil
+}
+
+// sandbox creates the sandbox of the configured system, with the client of
+// the target of the config.
+func (c *E2ECommand) sandbox(ctx context.Context, config *api.TeamLinkConfig) (e2e.Sandbox, error) {
+	system := systemType(c.system)
+	rw, err := common.NewReadWriter(ctx, system, config, &api.TeamLinkMappings{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s client: %w", c.system, err)
+	}
+	switch system {
+	case tltypes.SystemTypeGitHub:
+		teams, ok := rw.(*github.TeamReadWriter)
+		if !ok {
+			return nil, fmt.Errorf("unexpected github client %T", rw)
+		}
+		return e2e.NewGitHubSandbox(teams, c.githubOrgID), nil
+	case tltypes.SystemTypeGitLab:
+		groups, ok := rw.(*gitlab.GroupReadWriter)
+		if !ok {
+			return nil, fmt.Errorf("unexpected gitlab client %T", rw)
+		}
+		return e2e.NewGitLabSandbox(groups, c.gitlabGroupID), nil
+	default:
+		return e2e.NewGoogleGroupsSandbox(rw, c.googleGroups), nil
+	}
+}
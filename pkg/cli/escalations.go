@@ -0,0 +1,195 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+var (
+	_ cli.Command = (*EscalationsListCommand)(nil)
+	_ cli.Command = (*EscalationsApproveCommand)(nil)
+)
+
+// EscalationsListCommand lists the role escalations held back by
+// -role-escalations=require-approval.
+type EscalationsListCommand struct {
+	cli.BaseCommand
+
+	stateFlags
+}
+
+func (c *EscalationsListCommand) Desc() string {
+	return `List pending role escalations`
+}
+
+func (c *EscalationsListCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  List the role escalations held back by -role-escalations=require-approval,
+  and the approved ones the next sync applies.
+
+  tlctl escalations list -state-store /var/lib/team-link
+`
+}
+
+func (c *EscalationsListCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+	c.stateFlags.register(set)
+	set.AfterParse(func(merr error) error {
+		if c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("state-store is not provided"))
+		}
+		return merr
+	})
+	return set
+}
+
+func (c *EscalationsListCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	store, err := c.openStateStore(ctx)
+	if err != nil {
+		return err
+	}
+	records, err := groupsync.PendingEscalations(ctx, store)
+	if err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+	if len(records) == 0 {
+		c.Outf("No pending escalations")
+		return nil
+	}
+	w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "GROUP\tUSER\tFROM\tTO\tREQUESTED\tAPPROVED BY\n")
+	for _, r := range records {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.GroupID, r.UserID, r.FromRole, r.ToRole, r.RequestedAt.Format(time.RFC3339), r.ApprovedBy)
+	}
+	return w.Flush() //nolint:wrapcheck // Want passthrough
+}
+
+// EscalationsApproveCommand approves a pending role escalation.
+type EscalationsApproveCommand struct {
+	cli.BaseCommand
+
+	stateFlags
+
+	group    string
+	user     string
+	role     string
+	approver string
+}
+
+func (c *EscalationsApproveCommand) Desc() string {
+	return `Approve a pending role escalation`
+}
+
+func (c *EscalationsApproveCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  Approve a role escalation listed by "tlctl escalations list". The next sync
+  applies it.
+
+  tlctl escalations approve -state-store /var/lib/team-link \
+    -group 1234:5678 -user alice -role maintainer -approver bob
+`
+}
+
+func (c *EscalationsApproveCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "group",
+		Target:  &c.group,
+		Example: "1234:5678",
+		Usage:   `The ID of the target group.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "user",
+		Target:  &c.user,
+		Example: "alice",
+		Usage:   `The ID of the target user.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "role",
+		Target:  &c.role,
+		Example: "maintainer",
+		Usage:   `The role the escalation is pending for.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "approver",
+		Target:  &c.approver,
+		Example: "bob",
+		Usage:   `Who approves the escalation, recorded with the approval.`,
+	})
+
+	c.stateFlags.register(set)
+	set.AfterParse(func(merr error) error {
+		if c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("state-store is not provided"))
+		}
+		if c.group == "" || c.user == "" || c.role == "" {
+			merr = errors.Join(merr, fmt.Errorf("group, user and role are required"))
+		}
+		if c.approver == "" {
+			merr = errors.Join(merr, fmt.Errorf("approver is not provided"))
+		}
+		return merr
+	})
+	return set
+}
+
+func (c *EscalationsApproveCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	store, err := c.openStateStore(ctx)
+	if err != nil {
+		return err
+	}
+	if err := groupsync.ApproveEscalation(ctx, store, c.group, c.user, c.role, c.approver); err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+	c.Outf("Approved escalation of %s in group %s to %s", c.user, c.group, c.role)
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/pkg/common"
+)
+
+var _ cli.Command = (*ExportTerraformCommand)(nil)
+
+// ExportTerraformCommand exports the desired target memberships as Terraform
+// configuration.
+type ExportTerraformCommand struct {
+	cli.BaseCommand
+
+	loggingFlags
+	stateFlags
+
+	mapping string
+	config  string
+	output  string
+}
+
+func (c *ExportTerraformCommand) Desc() string {
+	return `Export the desired memberships as Terraform configuration`
+}
+
+func (c *ExportTerraformCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  Compute the members all mapped target groups should have, without
+  changing them, and write them as Terraform configuration, for orgs where
+  team-link computes the memberships and Terraform (or OpenTofu) applies
+  them. GitHub teams are exported as github_team_membership resources, and
+  GitLab groups as gitlab_group_membership resources. When GitHub teams of
+  several orgs are mapped, the resources use the provider alias
+  github.org_ORGID, which must be configured for each org.
+
+  tlctl export terraform \
+	-mapping mapping.textproto \
+	-config config.textproto \
+	-output memberships.tf
+`
+}
+
+func (c *ExportTerraformCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "mapping",
+		Target:  &c.mapping,
+		Aliases: []string{"m"},
+		Example: "mapping.textproto",
+		Usage:   `The textproto file that includes group and user mapping info`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "config",
+		Target:  &c.config,
+		Aliases: []string{"c"},
+		Example: "config.textproto",
+		Usage:   `The textproto file for teamlink configs.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "output",
+		Target:  &c.output,
+		Aliases: []string{"o"},
+		Example: "memberships.tf",
+		Usage:   `The file the configuration is written to. Defaults to stdout.`,
+	})
+
+	c.stateFlags.register(set)
+	c.loggingFlags.register(set)
+
+	set.AfterParse(func(merr error) error {
+		if c.mapping == "" {
+			merr = errors.Join(merr, fmt.Errorf("mapping file is not provided"))
+		}
+		if c.config == "" {
+			merr = errors.Join(merr, fmt.Errorf("config file is not provided"))
+		}
+		return merr
+	})
+
+	return set
+}
+
+func (c *ExportTerraformCommand) Run(ctx context.Context, args []string) (retErr error) {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	ctx, err := c.withLogger(ctx, c.Stderr())
+	if err != nil {
+		return fmt.Errorf("failed to setup logger: %w", err)
+	}
+
+	var opts []common.SyncOpt
+	store, err := c.openStateStore(ctx)
+	if err != nil {
+		return err
+	}
+	if store != nil {
+		opts = append(opts, common.WithStateStore(store))
+	}
+
+	var w io.Writer = c.Stdout()
+	if c.output != "" {
+		file, err := os.Create(c.output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				retErr = errors.Join(retErr, fmt.Errorf("failed to close output file: %w", err))
+			}
+		}()
+		w = file
+	}
+
+	if err := common.ExportTerraform(ctx, w, c.mapping, c.config, opts...); err != nil {
+		return fmt.Errorf("failed to export terraform: %w", err)
+	}
+	return nil
+}
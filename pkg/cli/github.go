@@ -0,0 +1,191 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/pkg/common"
+)
+
+var _ cli.Command = (*GitHubInvitationsCommand)(nil)
+
+// GitHubInvitationsCommand lists the pending invitations of the managed
+// GitHub orgs, and cancels or re-sends the stale ones.
+type GitHubInvitationsCommand struct {
+	cli.BaseCommand
+
+	loggingFlags
+
+	mapping   string
+	config    string
+	olderThan time.Duration
+	cancel    bool
+	resend    bool
+}
+
+func (c *GitHubInvitationsCommand) Desc() string {
+	return `List, cancel or re-send pending GitHub org invitations`
+}
+
+func (c *GitHubInvitationsCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  List the pending invitations of every GitHub org with mapped teams, along
+  with their age. Invitations older than -older-than are flagged as stale,
+  and are cancelled with -cancel or sent again with -resend.
+
+  List pending invitations:
+
+  tlctl github invitations \
+	-mapping mapping.textproto \
+	-config config.textproto
+
+  Cancel invitations pending for more than two weeks:
+
+  tlctl github invitations \
+	-mapping mapping.textproto \
+	-config config.textproto \
+	-older-than 336h \
+	-cancel
+`
+}
+
+func (c *GitHubInvitationsCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "mapping",
+		Target:  &c.mapping,
+		Aliases: []string{"m"},
+		Example: "mapping.textproto",
+		Usage:   `The textproto file that includes group and user mapping info`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "config",
+		Target:  &c.config,
+		Aliases: []string{"c"},
+		Example: "config.textproto",
+		Usage:   `The textproto file for teamlink configs.`,
+	})
+
+	f.DurationVar(&cli.DurationVar{
+		Name:    "older-than",
+		Target:  &c.olderThan,
+		Default: 7 * 24 * time.Hour,
+		Example: "336h",
+		Usage:   `Flag invitations pending for longer than this duration as stale.`,
+	})
+
+	f.BoolVar(&cli.BoolVar{
+		Name:    "cancel",
+		Target:  &c.cancel,
+		Default: false,
+		Usage:   `Cancel the stale invitations.`,
+	})
+
+	f.BoolVar(&cli.BoolVar{
+		Name:    "resend",
+		Target:  &c.resend,
+		Default: false,
+		Usage:   `Send the stale invitations again, with the same role and teams.`,
+	})
+
+	c.loggingFlags.register(set)
+
+	set.AfterParse(func(merr error) error {
+		if c.mapping == "" {
+			merr = errors.Join(merr, fmt.Errorf("mapping file is not provided"))
+		}
+		if c.config == "" {
+			merr = errors.Join(merr, fmt.Errorf("config file is not provided"))
+		}
+		if c.olderThan < 0 {
+			merr = errors.Join(merr, fmt.Errorf("older-than must not be negative"))
+		}
+		if c.cancel && c.resend {
+			merr = errors.Join(merr, fmt.Errorf("only one of cancel and resend may be set"))
+		}
+		return merr
+	})
+
+	return set
+}
+
+func (c *GitHubInvitationsCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	ctx, err := c.withLogger(ctx, c.Stderr())
+	if err != nil {
+		return fmt.Errorf("failed to setup logger: %w", err)
+	}
+
+	action := common.InvitationActionNone
+	switch {
+	case c.cancel:
+		action = common.InvitationActionCancel
+	case c.resend:
+		action = common.InvitationActionResend
+	}
+	reviews, merr := common.ReviewGitHubInvitations(ctx, c.mapping, c.config, time.Now(), c.olderThan, action)
+	if len(reviews) == 0 {
+		c.Outf("No pending invitations")
+		return merr
+	}
+
+	w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "ORG\tINVITEE\tROLE\tINVITER\tCREATED AT\tAGE\tSTATUS\tACTION\n")
+	for _, r := range reviews {
+		i := r.Invitation
+		invitee := i.GetLogin()
+		if invitee == "" {
+			invitee = i.GetEmail()
+		}
+		status := "pending"
+		if r.Stale {
+			status = "stale"
+		}
+		result := r.Action
+		if r.Err != nil {
+			result += " failed"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.OrgID, invitee, i.GetRole(), i.GetInviter().GetLogin(),
+			i.GetCreatedAt().Format(time.RFC3339), r.Age.Truncate(time.Hour), status, result)
+	}
+	if err := w.Flush(); err != nil {
+		merr = errors.Join(merr, fmt.Errorf("failed to write invitations: %w", err))
+	}
+	if merr != nil {
+		return fmt.Errorf("failed to review invitations: %w", merr)
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+var (
+	_ cli.Command = (*HistoryListCommand)(nil)
+	_ cli.Command = (*HistoryShowCommand)(nil)
+	_ cli.Command = (*HistoryDiffCommand)(nil)
+)
+
+// HistoryListCommand lists the stored sync run reports.
+type HistoryListCommand struct {
+	cli.BaseCommand
+
+	stateFlags
+}
+
+func (c *HistoryListCommand) Desc() string {
+	return `List past sync runs`
+}
+
+func (c *HistoryListCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  List the sync runs recorded in the state store, newest first.
+
+  tlctl history list -state-store /var/lib/team-link
+`
+}
+
+func (c *HistoryListCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+	c.stateFlags.register(set)
+	set.AfterParse(func(merr error) error {
+		if c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("state-store is not provided"))
+		}
+		return merr
+	})
+	return set
+}
+
+func (c *HistoryListCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	history, err := c.openRunHistory(ctx)
+	if err != nil {
+		return err
+	}
+	reports, err := history.Reports(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read history: %w", err)
+	}
+	if len(reports) == 0 {
+		c.Outf("No runs recorded")
+		return nil
+	}
+	w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "RUN\tSTARTED\tDURATION\tSOURCE\tTARGET\tGROUPS\tFAILED\tWRITES\n")
+	for _, r := range reports {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%d\n",
+			r.ID, r.StartedAt.Format(time.RFC3339), r.FinishedAt.Sub(r.StartedAt).Round(time.Second),
+			r.SourceSystem, r.TargetSystem, len(r.Groups), r.Failed(), r.Writes)
+	}
+	return w.Flush() //nolint:wrapcheck // Want passthrough
+}
+
+// HistoryShowCommand prints a stored sync run report.
+type HistoryShowCommand struct {
+	cli.BaseCommand
+
+	stateFlags
+
+	json bool
+}
+
+func (c *HistoryShowCommand) Desc() string {
+	return `Show a past sync run`
+}
+
+func (c *HistoryShowCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options] <run>
+
+  Show the groups synced by a run and the members they were set to. Runs are
+  listed by "tlctl history list".
+
+  tlctl history show -state-store /var/lib/team-link 20260303T100000Z
+`
+}
+
+func (c *HistoryShowCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.BoolVar(&cli.BoolVar{
+		Name:    "json",
+		Target:  &c.json,
+		Default: false,
+		Usage:   `Print the run report as JSON.`,
+	})
+
+	c.stateFlags.register(set)
+	set.AfterParse(func(merr error) error {
+		if c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("state-store is not provided"))
+		}
+		return merr
+	})
+	return set
+}
+
+func (c *HistoryShowCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) != 1 {
+		return fmt.Errorf("expected exactly one run, got %q", args)
+	}
+
+	history, err := c.openRunHistory(ctx)
+	if err != nil {
+		return err
+	}
+	report, err := history.Report(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read run: %w", err)
+	}
+
+	if c.json {
+		b, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal run report: %w", err)
+		}
+		c.Outf("%s", b)
+		return nil
+	}
+
+	c.Outf("Run %s from %s to %s, started %s", report.ID, report.SourceSystem, report.TargetSystem,
+		report.StartedAt.Format(time.RFC3339))
+	if report.Error != "" {
+		c.Outf("Failed: %s", report.Error)
+	}
+	w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "TARGET GROUP\tSOURCE GROUPS\tMEMBERS\tPENDING REMOVALS\tWRITES\tERROR\n")
+	for _, g := range report.Groups {
+		errMsg := g.Error
+		if g.Deferred {
+			errMsg = "deferred, api budget exhausted"
+		}
+		if g.Declined {
+			errMsg = "skipped, removal of members not confirmed"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n",
+			g.TargetGroupID, strings.Join(g.SourceGroupIDs, ","), strings.Join(g.Members, ","),
+			strings.Join(g.PendingRemovals, ","), g.Writes, errMsg)
+	}
+	return w.Flush() //nolint:wrapcheck // Want passthrough
+}
+
+// HistoryDiffCommand prints the membership changes between two stored runs.
+type HistoryDiffCommand struct {
+	cli.BaseCommand
+
+	stateFlags
+}
+
+func (c *HistoryDiffCommand) Desc() string {
+	return `Show membership changes between two past sync runs`
+}
+
+func (c *HistoryDiffCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options] <run-a> <run-b>
+
+  Show the members added to and removed from each target group between two
+  runs, e.g. to find out when access disappeared. Runs are listed by
+  "tlctl history list".
+
+  tlctl history diff -state-store /var/lib/team-link 20260303T100000Z 20260310T100000Z
+`
+}
+
+func (c *HistoryDiffCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+	c.stateFlags.register(set)
+	set.AfterParse(func(merr error) error {
+		if c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("state-store is not provided"))
+		}
+		return merr
+	})
+	return set
+}
+
+func (c *HistoryDiffCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) != 2 {
+		return fmt.Errorf("expected exactly two runs, got %q", args)
+	}
+
+	history, err := c.openRunHistory(ctx)
+	if err != nil {
+		return err
+	}
+	from, err := history.Report(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read run: %w", err)
+	}
+	to, err := history.Report(ctx, args[1])
+	if err != nil {
+		return fmt.Errorf("failed to read run: %w", err)
+	}
+
+	diffs := groupsync.DiffRuns(from, to)
+	if len(diffs) == 0 {
+		c.Outf("No membership changes between %s and %s", from.ID, to.ID)
+		return nil
+	}
+	w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "TARGET GROUP\tCHANGE\tMEMBER\tNOTE\n")
+	for _, d := range diffs {
+		if d.Note != "" {
+			fmt.Fprintf(w, "%s\t\t\t%s\n", d.TargetGroupID, d.Note)
+		}
+		for _, m := range d.Added {
+			fmt.Fprintf(w, "%s\t+\t%s\t\n", d.TargetGroupID, m)
+		}
+		for _, m := range d.Removed {
+			fmt.Fprintf(w, "%s\t-\t%s\t\n", d.TargetGroupID, m)
+		}
+	}
+	return w.Flush() //nolint:wrapcheck // Want passthrough
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/pkg/common"
+)
+
+var _ cli.Command = (*ImportTerraformCommand)(nil)
+
+// ImportTerraformCommand seeds the mapping config and the state store from
+// the teams and groups managed by a Terraform state.
+type ImportTerraformCommand struct {
+	cli.BaseCommand
+
+	loggingFlags
+	stateFlags
+
+	tfState     string
+	gitHubOrgID int64
+	output      string
+}
+
+func (c *ImportTerraformCommand) Desc() string {
+	return `Seed the mappings and state from a Terraform state`
+}
+
+func (c *ImportTerraformCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  Read the GitHub teams and GitLab groups managed by a Terraform state, e.g.
+  the output of "terraform state pull", and write a mapping file with a group
+  mapping targeting each of them, to migrate Terraform-managed teams to
+  team-link. The source of each mapping must be filled in before syncing; a
+  comment lists the members Terraform manages. With a state store, the teams
+  and groups are also recorded as managed by team-link and the IDs of the
+  team slugs are cached.
+
+  tlctl import terraform \
+	-tf-state terraform.tfstate \
+	-github-org-id 123456 \
+	-output mapping.textproto
+`
+}
+
+func (c *ImportTerraformCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "tf-state",
+		Target:  &c.tfState,
+		Example: "terraform.tfstate",
+		Usage:   `The Terraform state file to import.`,
+	})
+
+	f.Int64Var(&cli.Int64Var{
+		Name:    "github-org-id",
+		Target:  &c.gitHubOrgID,
+		Example: "123456",
+		Usage: `The ID of the GitHub org of the teams in the Terraform state, ` +
+			`which the state does not record.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "output",
+		Target:  &c.output,
+		Aliases: []string{"o"},
+		Example: "mapping.textproto",
+		Usage:   `The file the mappings are written to. Defaults to stdout.`,
+	})
+
+	c.stateFlags.register(set)
+	c.loggingFlags.register(set)
+
+	set.AfterParse(func(merr error) error {
+		if c.tfState == "" {
+			merr = errors.Join(merr, fmt.Errorf("terraform state file is not provided"))
+		}
+		return merr
+	})
+
+	return set
+}
+
+func (c *ImportTerraformCommand) Run(ctx context.Context, args []string) (retErr error) {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	ctx, err := c.withLogger(ctx, c.Stderr())
+	if err != nil {
+		return fmt.Errorf("failed to setup logger: %w", err)
+	}
+
+	in, err := os.Open(c.tfState)
+	if err != nil {
+		return fmt.Errorf("failed to open terraform state: %w", err)
+	}
+	defer in.Close()
+	imp, err := common.ImportTerraformState(in, c.gitHubOrgID)
+	if err != nil {
+		return fmt.Errorf("failed to import terraform state: %w", err)
+	}
+
+	store, err := c.openStateStore(ctx)
+	if err != nil {
+		return err
+	}
+	if store != nil {
+		if err := imp.Seed(ctx, store); err != nil {
+			return err
+		}
+	}
+
+	var w io.Writer = c.Stdout()
+	if c.output != "" {
+		file, err := os.Create(c.output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer func() {
+			if err := file.Close(); err != nil {
+				retErr = errors.Join(retErr, fmt.Errorf("failed to close output file: %w", err))
+			}
+		}()
+		w = file
+	}
+	return imp.WriteMappings(w)
+}
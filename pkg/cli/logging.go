@@ -0,0 +1,217 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/pkg/logging"
+)
+
+// loggingFlags are the logging options shared by all tlctl commands.
+type loggingFlags struct {
+	logLevel  string
+	logFormat string
+}
+
+// register adds the logging flags to the given flag set.
+func (l *loggingFlags) register(set *cli.FlagSet) {
+	f := set.NewSection("LOGGING OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "log-level",
+		Target:  &l.logLevel,
+		EnvVar:  "TEAM_LINK_LOG_LEVEL",
+		Default: "info",
+		Example: "warn,github=debug,groupsync=info",
+		Usage: `Comma separated log levels. A bare level sets the default level, ` +
+			`and package=level pairs override the level for a single package. ` +
+			`Valid levels include: ` + strings.Join(logging.LevelNames(), ",") + `.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "log-format",
+		Target:  &l.logFormat,
+		EnvVar:  "TEAM_LINK_LOG_FORMAT",
+		Default: "json",
+		Example: "text",
+		Usage:   `The log output format, one of: json, text.`,
+	})
+
+	set.AfterParse(func(merr error) error {
+		if _, _, err := parseLogLevels(l.logLevel); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("invalid log-level: %w", err))
+		}
+		if _, err := logging.LookupFormat(l.logFormat); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("invalid log-format: %w", err))
+		}
+		return merr
+	})
+}
+
+// withLogger returns a context carrying a logger configured from the flags
+// that writes to w.
+func (l *loggingFlags) withLogger(ctx context.Context, w io.Writer) (context.Context, error) {
+	logger, err := newModuleLogger(w, l.logLevel, l.logFormat)
+	if err != nil {
+		return nil, err
+	}
+	return logging.WithLogger(ctx, logger), nil
+}
+
+// newModuleLogger creates a logger which filters records by the level
+// configured for the package that emitted them. See parseLogLevels for the
+// format of levels.
+func newModuleLogger(w io.Writer, levels, format string) (*slog.Logger, error) {
+	defaultLevel, moduleLevels, err := parseLogLevels(levels)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse log levels: %w", err)
+	}
+	f, err := logging.LookupFormat(format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse log format: %w", err)
+	}
+
+	minLevel := defaultLevel
+	for _, level := range moduleLevels {
+		minLevel = min(minLevel, level)
+	}
+	base := logging.New(w, minLevel, f, false)
+	return slog.New(&moduleLevelHandler{
+		handler:      base.Handler(),
+		defaultLevel: defaultLevel,
+		moduleLevels: moduleLevels,
+		minLevel:     minLevel,
+		modules:      &sync.Map{},
+	}), nil
+}
+
+// parseLogLevels parses a comma separated list of log levels. An entry without
+// an '=' sets the default level and may appear at most once. Entries of the
+// form package=level set the level for the named package, e.g.
+// "warn,github=debug,groupsync=info". Packages may be named by their base name
+// ("github") or by a path suffix ("common/googlegroup_github").
+func parseLogLevels(s string) (slog.Level, map[string]slog.Level, error) {
+	defaultLevel := logging.LevelInfo
+	moduleLevels := make(map[string]slog.Level)
+	var seenDefault bool
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		module, name, ok := strings.Cut(entry, "=")
+		if !ok {
+			if seenDefault {
+				return 0, nil, fmt.Errorf("default log level set more than once: %q", s)
+			}
+			level, err := logging.LookupLevel(entry)
+			if err != nil {
+				return 0, nil, fmt.Errorf("invalid default log level: %w", err)
+			}
+			defaultLevel = level
+			seenDefault = true
+			continue
+		}
+		module = strings.Trim(strings.TrimSpace(module), "/")
+		if module == "" {
+			return 0, nil, fmt.Errorf("missing package name in %q", entry)
+		}
+		level, err := logging.LookupLevel(name)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid log level for package %s: %w", module, err)
+		}
+		moduleLevels[module] = level
+	}
+	return defaultLevel, moduleLevels, nil
+}
+
+// moduleLevelHandler is a slog.Handler that applies a per-package level to
+// each record based on the package of the function that logged it.
+type moduleLevelHandler struct {
+	handler      slog.Handler
+	defaultLevel slog.Level
+	moduleLevels map[string]slog.Level
+	minLevel     slog.Level
+	// modules caches the resolved level per program counter.
+	modules *sync.Map
+}
+
+// Enabled reports whether any package could log at the given level. The final
+// decision is made in Handle once the caller is known.
+func (h *moduleLevelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.minLevel && h.handler.Enabled(ctx, level)
+}
+
+// Handle drops records below the level of the package that created them.
+func (h *moduleLevelHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level < h.levelFor(r.PC) {
+		return nil
+	}
+	return h.handler.Handle(ctx, r) //nolint:wrapcheck // Want passthrough
+}
+
+func (h *moduleLevelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	c := *h
+	c.handler = h.handler.WithAttrs(attrs)
+	return &c
+}
+
+func (h *moduleLevelHandler) WithGroup(name string) slog.Handler {
+	c := *h
+	c.handler = h.handler.WithGroup(name)
+	return &c
+}
+
+func (h *moduleLevelHandler) levelFor(pc uintptr) slog.Level {
+	if len(h.moduleLevels) == 0 || pc == 0 {
+		return h.defaultLevel
+	}
+	if level, ok := h.modules.Load(pc); ok {
+		return level.(slog.Level) //nolint:forcetypeassert // only levels are stored
+	}
+	level := h.defaultLevel
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	pkg := packagePath(frame.Function)
+	// prefer the most specific (longest) matching package name.
+	var matched string
+	for module, l := range h.moduleLevels {
+		if (pkg == module || strings.HasSuffix(pkg, "/"+module)) && len(module) > len(matched) {
+			matched = module
+			level = l
+		}
+	}
+	h.modules.Store(pc, level)
+	return level
+}
+
+// packagePath extracts the package path from a fully qualified function name
+// such as "github.com/abcxyz/team-link/pkg/github.(*TeamReadWriter).SetMembers".
+func packagePath(function string) string {
+	lastSlash := strings.LastIndex(function, "/")
+	dot := strings.Index(function[lastSlash+1:], ".")
+	if dot < 0 {
+		return function
+	}
+	return function[:lastSlash+1+dot]
+}
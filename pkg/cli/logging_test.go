@@ -0,0 +1,169 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestParseLogLevels(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name             string
+		levels           string
+		wantDefault      slog.Level
+		wantModuleLevels map[string]slog.Level
+		wantErr          string
+	}{
+		{
+			name:             "empty",
+			levels:           "",
+			wantDefault:      logging.LevelInfo,
+			wantModuleLevels: map[string]slog.Level{},
+		},
+		{
+			name:             "default_only",
+			levels:           "warn",
+			wantDefault:      logging.LevelWarning,
+			wantModuleLevels: map[string]slog.Level{},
+		},
+		{
+			name:        "default_and_modules",
+			levels:      "error, github=debug,groupsync=info",
+			wantDefault: logging.LevelError,
+			wantModuleLevels: map[string]slog.Level{
+				"github":    logging.LevelDebug,
+				"groupsync": logging.LevelInfo,
+			},
+		},
+		{
+			name:        "modules_only",
+			levels:      "common/googlegroup_github=debug",
+			wantDefault: logging.LevelInfo,
+			wantModuleLevels: map[string]slog.Level{
+				"common/googlegroup_github": logging.LevelDebug,
+			},
+		},
+		{
+			name:    "duplicate_default",
+			levels:  "info,debug",
+			wantErr: "default log level set more than once",
+		},
+		{
+			name:    "invalid_module_level",
+			levels:  "github=loud",
+			wantErr: "invalid log level for package github",
+		},
+		{
+			name:    "missing_module",
+			levels:  "=debug",
+			wantErr: "missing package name",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotDefault, gotModuleLevels, err := parseLogLevels(tc.levels)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if gotDefault != tc.wantDefault {
+				t.Errorf("got default level %v, want %v", gotDefault, tc.wantDefault)
+			}
+			if diff := cmp.Diff(gotModuleLevels, tc.wantModuleLevels); diff != "" {
+				t.Errorf("unexpected module levels (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewModuleLogger(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		levels    string
+		wantDebug bool
+		wantInfo  bool
+		wantWarn  bool
+	}{
+		{
+			name:     "default_level",
+			levels:   "info",
+			wantInfo: true,
+			wantWarn: true,
+		},
+		{
+			name:      "package_more_verbose",
+			levels:    "error,cli=debug",
+			wantDebug: true,
+			wantInfo:  true,
+			wantWarn:  true,
+		},
+		{
+			name:     "package_less_verbose",
+			levels:   "debug,pkg/cli=warn",
+			wantWarn: true,
+		},
+		{
+			name:      "other_package_unaffected",
+			levels:    "warn,github=debug",
+			wantWarn:  true,
+			wantDebug: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			logger, err := newModuleLogger(&buf, tc.levels, "text")
+			if err != nil {
+				t.Fatalf("failed to create logger: %v", err)
+			}
+			ctx := context.Background()
+			logger.DebugContext(ctx, "debug message")
+			logger.InfoContext(ctx, "info message")
+			logger.WarnContext(ctx, "warn message")
+
+			got := buf.String()
+			if gotDebug := strings.Contains(got, "debug message"); gotDebug != tc.wantDebug {
+				t.Errorf("debug logged = %t, want %t: %s", gotDebug, tc.wantDebug, got)
+			}
+			if gotInfo := strings.Contains(got, "info message"); gotInfo != tc.wantInfo {
+				t.Errorf("info logged = %t, want %t: %s", gotInfo, tc.wantInfo, got)
+			}
+			if gotWarn := strings.Contains(got, "warn message"); gotWarn != tc.wantWarn {
+				t.Errorf("warn logged = %t, want %t: %s", gotWarn, tc.wantWarn, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+var _ cli.Command = (*ManCommand)(nil)
+
+// ManCommand generates the man pages of tlctl and its subcommands.
+type ManCommand struct {
+	cli.BaseCommand
+
+	outputDir string
+}
+
+func (c *ManCommand) Desc() string {
+	return `Generate man pages for all commands`
+}
+
+func (c *ManCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  Write a man page for tlctl and each of its subcommands, with their flags,
+  to a directory, e.g. tlctl-sync-run.1 for "tlctl sync run".
+
+  tlctl man -output-dir /usr/local/share/man/man1
+`
+}
+
+func (c *ManCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "output-dir",
+		Target:  &c.outputDir,
+		Aliases: []string{"o"},
+		Example: "/usr/local/share/man/man1",
+		Usage:   `The directory to write the man pages to. It is created if missing.`,
+	})
+
+	set.AfterParse(func(merr error) error {
+		if c.outputDir == "" {
+			merr = errors.Join(merr, fmt.Errorf("output-dir is not provided"))
+		}
+		return merr
+	})
+
+	return set
+}
+
+func (c *ManCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	if err := os.MkdirAll(c.outputDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	if err := writeManPages(c.outputDir, rootCmd(), []string{"tlctl"}); err != nil {
+		return err
+	}
+	c.Outf("Wrote man pages to %s", c.outputDir)
+	return nil
+}
+
+// writeManPages writes the man page of cmd, whose command line is path, and
+// of its subcommands to dir.
+func writeManPages(dir string, cmd cli.Command, path []string) error {
+	var subcommands []string
+	root, isRoot := cmd.(*cli.RootCommand)
+	if isRoot {
+		for _, name := range utils.MapKeys(root.Commands) {
+			if sub := root.Commands[name](); sub != nil && !sub.Hidden() {
+				subcommands = append(subcommands, name)
+			}
+		}
+	}
+
+	page := manPage(cmd, path, subcommands)
+	file := filepath.Join(dir, strings.Join(path, "-")+".1")
+	if err := os.WriteFile(file, []byte(page), 0o644); err != nil { //nolint:gosec // man pages are world readable
+		return fmt.Errorf("failed to write man page: %w", err)
+	}
+	for _, name := range subcommands {
+		if err := writeManPages(dir, root.Commands[name](), append(slices.Clip(path), name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// manPage renders the man page of cmd in roff.
+func manPage(cmd cli.Command, path, subcommands []string) string {
+	title := strings.Join(path, "-")
+	command := strings.Join(path, " ")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, ".TH %q 1 \"\" \"tlctl\" \"User Commands\"\n", strings.ToUpper(title))
+	b.WriteString(".SH NAME\n")
+	fmt.Fprintf(&b, "%s \\- %s\n", roffEscape(title), roffEscape(cmd.Desc()))
+
+	b.WriteString(".SH SYNOPSIS\n")
+	if len(subcommands) > 0 {
+		fmt.Fprintf(&b, "\\fB%s\\fR \\fICOMMAND\\fR\n", roffEscape(command))
+		b.WriteString(".SH COMMANDS\n")
+		for _, name := range subcommands {
+			fmt.Fprintf(&b, ".TP\n\\fB%s\\fR\n%s\n", roffEscape(name), roffEscape(manDesc(cmd, name)))
+		}
+	} else {
+		fmt.Fprintf(&b, "\\fB%s\\fR [\\fIoptions\\fR]\n", roffEscape(command))
+		b.WriteString(".SH DESCRIPTION\n.nf\n")
+		help := strings.ReplaceAll(strings.Trim(cmd.Help(), "\n"), "{{ COMMAND }}", command)
+		for _, line := range strings.Split(help, "\n") {
+			b.WriteString(roffLine(line) + "\n")
+		}
+		b.WriteString(".fi\n")
+		if options := manOptions(cmd.Flags()); options != "" {
+			b.WriteString(".SH OPTIONS\n")
+			b.WriteString(options)
+		}
+	}
+
+	var seeAlso []string
+	if len(path) > 1 {
+		seeAlso = append(seeAlso, strings.Join(path[:len(path)-1], "-"))
+	}
+	for _, name := range subcommands {
+		seeAlso = append(seeAlso, title+"-"+name)
+	}
+	if len(seeAlso) > 0 {
+		b.WriteString(".SH SEE ALSO\n")
+		for i, page := range seeAlso {
+			sep := ","
+			if i == len(seeAlso)-1 {
+				sep = ""
+			}
+			fmt.Fprintf(&b, "\\fB%s\\fR(1)%s\n", roffEscape(page), sep)
+		}
+	}
+	return b.String()
+}
+
+// manDesc returns the description of the subcommand of a root command.
+func manDesc(cmd cli.Command, name string) string {
+	root, ok := cmd.(*cli.RootCommand)
+	if !ok {
+		return ""
+	}
+	return root.Commands[name]().Desc()
+}
+
+// manOptions renders the visible flags of a command, without their aliases,
+// as roff paragraphs.
+func manOptions(set *cli.FlagSet) string {
+	if set == nil {
+		return ""
+	}
+	aliases := make(map[string]bool)
+	set.VisitAll(func(f *flag.Flag) {
+		if v, ok := f.Value.(cli.Value); ok {
+			for _, alias := range v.Aliases() {
+				aliases[alias] = true
+			}
+		}
+	})
+
+	var b strings.Builder
+	set.VisitAll(func(f *flag.Flag) {
+		v, ok := f.Value.(cli.Value)
+		if !ok || v.Hidden() || aliases[f.Name] {
+			return
+		}
+		names := make([]string, 0, len(v.Aliases())+1)
+		names = append(names, `\fB\-`+roffEscape(f.Name)+`\fR`)
+		for _, alias := range v.Aliases() {
+			names = append(names, `\fB\-`+roffEscape(alias)+`\fR`)
+		}
+		b.WriteString(".TP\n")
+		b.WriteString(strings.Join(names, ", "))
+		if !v.IsBoolFlag() {
+			fmt.Fprintf(&b, "=\\fI%s\\fR", roffEscape(v.Example()))
+		}
+		fmt.Fprintf(&b, "\n%s\n", roffLine(f.Usage))
+	})
+	return b.String()
+}
+
+// roffEscape escapes backslashes and hyphens of text for roff.
+func roffEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\e`)
+	return strings.ReplaceAll(s, "-", `\-`)
+}
+
+// roffLine escapes a line of text for roff, including a leading control
+// character.
+func roffLine(s string) string {
+	s = roffEscape(s)
+	if strings.HasPrefix(s, ".") || strings.HasPrefix(s, "'") {
+		s = `\&` + s
+	}
+	return s
+}
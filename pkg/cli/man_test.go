@@ -0,0 +1,101 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteManPages(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := writeManPages(dir, rootCmd(), []string{"tlctl"}); err != nil {
+		t.Fatalf("writeManPages failed: %v", err)
+	}
+
+	cases := []struct {
+		name         string
+		file         string
+		wantContains []string
+	}{
+		{
+			name: "root",
+			file: "tlctl.1",
+			wantContains: []string{
+				`.TH "TLCTL" 1`,
+				".SH COMMANDS\n",
+				"\\fBsync\\fR\nSync memberships\n",
+				"\\fBtlctl\\-status\\fR(1),\n",
+			},
+		},
+		{
+			name: "subcommand",
+			file: "tlctl-sync-run.1",
+			wantContains: []string{
+				"tlctl\\-sync\\-run \\- Sync membership information\n",
+				"  tlctl sync run \\e\n",
+				".SH OPTIONS\n",
+				"\\fB\\-mapping\\fR, \\fB\\-m\\fR=\\fImapping.textproto\\fR\n",
+				"\\fB\\-read\\-only\\fR\nRefuse every change",
+				".SH SEE ALSO\n\\fBtlctl\\-sync\\fR(1)\n",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := os.ReadFile(filepath.Join(dir, tc.file))
+			if err != nil {
+				t.Fatalf("failed to read man page: %v", err)
+			}
+			for _, want := range tc.wantContains {
+				if !strings.Contains(string(b), want) {
+					t.Errorf("man page %s does not contain %q:\n%s", tc.file, want, b)
+				}
+			}
+		})
+	}
+}
+
+func TestRoffLine(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain", in: "sync run", want: "sync run"},
+		{name: "hyphen", in: "-read-only", want: `\-read\-only`},
+		{name: "backslash", in: `a \ b`, want: `a \e b`},
+		{name: "control", in: ".TH", want: `\&.TH`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := roffLine(tc.in); got != tc.want {
+				t.Errorf("roffLine(%q) got %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
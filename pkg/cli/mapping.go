@@ -0,0 +1,346 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/pkg/common"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+var _ cli.Command = (*ApplyRenamesCommand)(nil)
+
+// ApplyRenamesCommand updates a mapping file with the GitHub team renames
+// detected by previous sync runs.
+type ApplyRenamesCommand struct {
+	cli.BaseCommand
+
+	stateFlags
+
+	mapping string
+	dryRun  bool
+}
+
+func (c *ApplyRenamesCommand) Desc() string {
+	return `Update renamed GitHub team slugs in a mapping file`
+}
+
+func (c *ApplyRenamesCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  Replace the slugs of GitHub teams that were renamed since the mapping file
+  was written with their current slugs. Renames are detected by sync runs
+  using the same state store. The title and description of a pull request
+  for the change are printed to stdout.
+
+  tlctl mapping apply-renames \
+	-mapping mapping.textproto \
+	-state-store /var/lib/team-link
+`
+}
+
+func (c *ApplyRenamesCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "mapping",
+		Target:  &c.mapping,
+		Aliases: []string{"m"},
+		Example: "mapping.textproto",
+		Usage:   `The textproto file that includes group and user mapping info`,
+	})
+
+	f.BoolVar(&cli.BoolVar{
+		Name:    "dry-run",
+		Target:  &c.dryRun,
+		Default: false,
+		Usage:   `Print the pull request description without updating the mapping file.`,
+	})
+
+	c.stateFlags.register(set)
+
+	set.AfterParse(func(merr error) error {
+		if c.mapping == "" {
+			merr = errors.Join(merr, fmt.Errorf("mapping file is not provided"))
+		}
+		if c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("state-store is not provided"))
+		}
+		return merr
+	})
+
+	return set
+}
+
+func (c *ApplyRenamesCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	store, err := c.openStateStore(ctx)
+	if err != nil {
+		return err
+	}
+	renames, err := github.ListTeamRenames(ctx, store)
+	if err != nil {
+		return fmt.Errorf("failed to read team renames: %w", err)
+	}
+
+	info, err := os.Stat(c.mapping)
+	if err != nil {
+		return fmt.Errorf("failed to read mapping file: %w", err)
+	}
+	content, err := os.ReadFile(c.mapping)
+	if err != nil {
+		return fmt.Errorf("failed to read mapping file: %w", err)
+	}
+	updated, applied, err := common.RewriteTeamSlugs(content, renames)
+	if err != nil {
+		return fmt.Errorf("failed to update mapping file: %w", err)
+	}
+	if len(applied) == 0 {
+		c.Errf("No renamed teams found in %s", c.mapping)
+		return nil
+	}
+	if !c.dryRun {
+		if err := os.WriteFile(c.mapping, updated, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("failed to write mapping file: %w", err)
+		}
+	}
+
+	title, body := common.TeamRenamePullRequest(applied)
+	c.Outf("%s\n\n%s", title, body)
+	return nil
+}
+
+var _ cli.Command = (*ReviewCommand)(nil)
+
+// ReviewCommand lists the user mappings of a mapping file which need review
+// because they have expired or expire soon.
+type ReviewCommand struct {
+	cli.BaseCommand
+
+	mapping string
+	within  time.Duration
+}
+
+func (c *ReviewCommand) Desc() string {
+	return `List user mappings that need review`
+}
+
+func (c *ReviewCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  List the user mappings which have expired, which sync runs ignore, or which
+  expire soon, along with their owners, e.g. for an access recertification.
+
+  tlctl mapping review \
+	-mapping mapping.textproto \
+	-within 720h
+`
+}
+
+func (c *ReviewCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "mapping",
+		Target:  &c.mapping,
+		Aliases: []string{"m"},
+		Example: "mapping.textproto",
+		Usage:   `The textproto file that includes group and user mapping info`,
+	})
+
+	f.DurationVar(&cli.DurationVar{
+		Name:    "within",
+		Target:  &c.within,
+		Default: 30 * 24 * time.Hour,
+		Example: "720h",
+		Usage:   `List mappings that expire within this duration.`,
+	})
+
+	set.AfterParse(func(merr error) error {
+		if c.mapping == "" {
+			merr = errors.Join(merr, fmt.Errorf("mapping file is not provided"))
+		}
+		if c.within < 0 {
+			merr = errors.Join(merr, fmt.Errorf("within must not be negative"))
+		}
+		return merr
+	})
+
+	return set
+}
+
+func (c *ReviewCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	mappings, err := utils.ParseMappingTextProto(ctx, c.mapping)
+	if err != nil {
+		return fmt.Errorf("failed to parse mappings file: %w", err)
+	}
+	reviews := common.ReviewUserMappings(mappings.GetUserMappings(), time.Now(), c.within)
+	if len(reviews) == 0 {
+		c.Outf("No mappings need review")
+		return nil
+	}
+	w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "STATUS\tSOURCE\tTARGET\tOWNER\tEXPIRES AT\n")
+	for _, r := range reviews {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			r.Status, r.Mapping.GetSource(), r.Mapping.GetTarget(), r.Mapping.GetOwner(), r.Mapping.GetExpiresAt())
+	}
+	return w.Flush() //nolint:wrapcheck // Want passthrough
+}
+
+var _ cli.Command = (*CheckOwnersCommand)(nil)
+
+// CheckOwnersCommand verifies that the committer of a change to a mapping
+// file owns the mappings it changes.
+type CheckOwnersCommand struct {
+	cli.BaseCommand
+
+	mapping   string
+	base      string
+	committer string
+}
+
+func (c *CheckOwnersCommand) Desc() string {
+	return `Check that the committer of a mapping change owns the changed mappings`
+}
+
+func (c *CheckOwnersCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  Compare a mapping file with its previous version and fail if the committer
+  of the change does not own the mappings it changes. The owners of a target
+  group are the owners of its group mappings in the previous version, and the
+  owner of a user mapping owns the mappings of its source user. Mappings
+  without owners may be changed by anyone. Run it in CI on changes to the
+  mapping file, e.g.:
+
+  git show origin/main:mapping.textproto > base.textproto
+  tlctl mapping check-owners \
+	-mapping mapping.textproto \
+	-base base.textproto \
+	-committer "$GITHUB_ACTOR"
+`
+}
+
+func (c *CheckOwnersCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "mapping",
+		Target:  &c.mapping,
+		Aliases: []string{"m"},
+		Example: "mapping.textproto",
+		Usage:   `The changed textproto file that includes group and user mapping info`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "base",
+		Target:  &c.base,
+		Example: "base.textproto",
+		Usage:   `The mapping file before the change.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "committer",
+		Target:  &c.committer,
+		EnvVar:  "TEAM_LINK_COMMITTER",
+		Example: "octocat",
+		Usage:   `Who made the change, as listed in the owners of mappings.`,
+	})
+
+	set.AfterParse(func(merr error) error {
+		if c.mapping == "" {
+			merr = errors.Join(merr, fmt.Errorf("mapping file is not provided"))
+		}
+		if c.base == "" {
+			merr = errors.Join(merr, fmt.Errorf("base is not provided"))
+		}
+		if c.committer == "" {
+			merr = errors.Join(merr, fmt.Errorf("committer is not provided"))
+		}
+		return merr
+	})
+
+	return set
+}
+
+func (c *CheckOwnersCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	base, err := utils.ParseMappingTextProto(ctx, c.base)
+	if err != nil {
+		return fmt.Errorf("failed to parse base mappings file: %w", err)
+	}
+	head, err := utils.ParseMappingTextProto(ctx, c.mapping)
+	if err != nil {
+		return fmt.Errorf("failed to parse mappings file: %w", err)
+	}
+	violations := common.CheckMappingOwners(base, head, c.committer)
+	if len(violations) == 0 {
+		c.Outf("All changed mappings are owned by %s", c.committer)
+		return nil
+	}
+	w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "CHANGE\tMAPPING\tOWNERS\n")
+	for _, v := range violations {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", v.Change, v.Mapping, strings.Join(v.Owners, ","))
+	}
+	if err := w.Flush(); err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+	return fmt.Errorf("%s does not own %d changed mappings", c.committer, len(violations))
+}
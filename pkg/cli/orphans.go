@@ -0,0 +1,189 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/pkg/common"
+)
+
+var _ cli.Command = (*OrphansCommand)(nil)
+
+// OrphansCommand lists the target groups synced by previous runs which are no
+// longer mapped, and cleans them up.
+type OrphansCommand struct {
+	cli.BaseCommand
+
+	loggingFlags
+	stateFlags
+
+	mapping string
+	config  string
+	cleanUp bool
+	yes     bool
+}
+
+func (c *OrphansCommand) Desc() string {
+	return `List or clean up target groups which are no longer mapped`
+}
+
+func (c *OrphansCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  List the target groups that previous sync runs recorded in the state store
+  but that the mapping file no longer maps. With -clean-up, they are archived
+  if the target system supports it, e.g. GitHub teams are renamed with an
+  "archived-" prefix, and otherwise all their members are removed. Groups are
+  never deleted. Cleaning up asks for confirmation unless -yes is set.
+
+  List orphaned groups:
+
+  tlctl orphans \
+	-mapping mapping.textproto \
+	-config config.textproto \
+	-state-store /var/lib/team-link
+
+  Clean them up:
+
+  tlctl orphans \
+	-mapping mapping.textproto \
+	-config config.textproto \
+	-state-store /var/lib/team-link \
+	-clean-up
+`
+}
+
+func (c *OrphansCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "mapping",
+		Target:  &c.mapping,
+		Aliases: []string{"m"},
+		Example: "mapping.textproto",
+		Usage:   `The textproto file that includes group and user mapping info`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "config",
+		Target:  &c.config,
+		Aliases: []string{"c"},
+		Example: "config.textproto",
+		Usage:   `The textproto file for teamlink configs.`,
+	})
+
+	f.BoolVar(&cli.BoolVar{
+		Name:    "clean-up",
+		Target:  &c.cleanUp,
+		Default: false,
+		Usage:   `Archive or empty the orphaned target groups.`,
+	})
+
+	f.BoolVar(&cli.BoolVar{
+		Name:    "yes",
+		Target:  &c.yes,
+		Aliases: []string{"y"},
+		Default: false,
+		Usage:   `Clean up without asking for confirmation.`,
+	})
+
+	c.stateFlags.register(set)
+	c.loggingFlags.register(set)
+
+	set.AfterParse(func(merr error) error {
+		if c.mapping == "" {
+			merr = errors.Join(merr, fmt.Errorf("mapping file is not provided"))
+		}
+		if c.config == "" {
+			merr = errors.Join(merr, fmt.Errorf("config file is not provided"))
+		}
+		if c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("state-store is not provided"))
+		}
+		if c.yes && !c.cleanUp {
+			merr = errors.Join(merr, fmt.Errorf("yes must be used with -clean-up"))
+		}
+		return merr
+	})
+
+	return set
+}
+
+func (c *OrphansCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	ctx, err := c.withLogger(ctx, c.Stderr())
+	if err != nil {
+		return fmt.Errorf("failed to setup logger: %w", err)
+	}
+
+	store, err := c.openStateStore(ctx)
+	if err != nil {
+		return err
+	}
+	orphans, err := common.FindOrphanedGroups(ctx, c.mapping, c.config, common.WithStateStore(store))
+	if err != nil {
+		return fmt.Errorf("failed to find orphaned groups: %w", err)
+	}
+	if len(orphans.Records) == 0 {
+		c.Outf("No orphaned groups")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "GROUP\tLAST MAPPED AT\tARCHIVED\n")
+	for _, r := range orphans.Records {
+		fmt.Fprintf(w, "%s\t%s\t%t\n", r.GroupID, r.LastMappedAt.Format(time.RFC3339), r.Archived)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write orphaned groups: %w", err)
+	}
+	if !c.cleanUp {
+		return nil
+	}
+
+	if !c.yes {
+		answer, err := c.Prompt(ctx, "Clean up %d orphaned groups? Only 'yes' will be accepted: ", len(orphans.Records))
+		if err != nil {
+			return fmt.Errorf("failed to read confirmation: %w", err)
+		}
+		if strings.TrimSpace(answer) != "yes" {
+			c.Outf("Not cleaning up")
+			return nil
+		}
+	}
+	if err := orphans.CleanUp(ctx); err != nil {
+		return fmt.Errorf("failed to clean up orphaned groups: %w", err)
+	}
+	c.Outf("Cleaned up %d orphaned groups", len(orphans.Records))
+	return nil
+}
@@ -17,14 +17,17 @@ package cli
 
 import (
 	"context"
+	"os"
 
 	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/internal/version"
 )
 
 // rootCmd defines the starting command structure.
 var rootCmd = func() cli.Command {
 	return &cli.RootCommand{
-		Name: "tlctl",
+		Name:    "tlctl",
+		Version: version.HumanVersion,
 		Commands: map[string]cli.CommandFactory{
 			"sync": func() cli.Command {
 				return &cli.RootCommand{
@@ -37,11 +40,123 @@ var rootCmd = func() cli.Command {
 					},
 				}
 			},
+			"mapping": func() cli.Command {
+				return &cli.RootCommand{
+					Name:        "mapping",
+					Description: "Maintain mapping files",
+					Commands: map[string]cli.CommandFactory{
+						"apply-renames": func() cli.Command {
+							return &ApplyRenamesCommand{}
+						},
+						"review": func() cli.Command {
+							return &ReviewCommand{}
+						},
+						"check-owners": func() cli.Command {
+							return &CheckOwnersCommand{}
+						},
+					},
+				}
+			},
+			"access": func() cli.Command {
+				return &cli.RootCommand{
+					Name:        "access",
+					Description: "Review access granted by team-link",
+					Commands: map[string]cli.CommandFactory{
+						"export": func() cli.Command {
+							return &AccessExportCommand{}
+						},
+					},
+				}
+			},
+			"export": func() cli.Command {
+				return &cli.RootCommand{
+					Name:        "export",
+					Description: "Export the desired state to other tools",
+					Commands: map[string]cli.CommandFactory{
+						"terraform": func() cli.Command {
+							return &ExportTerraformCommand{}
+						},
+					},
+				}
+			},
+			"import": func() cli.Command {
+				return &cli.RootCommand{
+					Name:        "import",
+					Description: "Import the state managed by other tools",
+					Commands: map[string]cli.CommandFactory{
+						"terraform": func() cli.Command {
+							return &ImportTerraformCommand{}
+						},
+					},
+				}
+			},
+			"history": func() cli.Command {
+				return &cli.RootCommand{
+					Name:        "history",
+					Description: "Inspect past sync runs",
+					Commands: map[string]cli.CommandFactory{
+						"list": func() cli.Command {
+							return &HistoryListCommand{}
+						},
+						"show": func() cli.Command {
+							return &HistoryShowCommand{}
+						},
+						"diff": func() cli.Command {
+							return &HistoryDiffCommand{}
+						},
+					},
+				}
+			},
+			"escalations": func() cli.Command {
+				return &cli.RootCommand{
+					Name:        "escalations",
+					Description: "Review role escalations held back for approval",
+					Commands: map[string]cli.CommandFactory{
+						"list": func() cli.Command {
+							return &EscalationsListCommand{}
+						},
+						"approve": func() cli.Command {
+							return &EscalationsApproveCommand{}
+						},
+					},
+				}
+			},
+			"github": func() cli.Command {
+				return &cli.RootCommand{
+					Name:        "github",
+					Description: "Manage GitHub orgs",
+					Commands: map[string]cli.CommandFactory{
+						"invitations": func() cli.Command {
+							return &GitHubInvitationsCommand{}
+						},
+					},
+				}
+			},
+			"status": func() cli.Command {
+				return &StatusCommand{}
+			},
+			"orphans": func() cli.Command {
+				return &OrphansCommand{}
+			},
+			"completion": func() cli.Command {
+				return &CompletionCommand{}
+			},
+			"man": func() cli.Command {
+				return &ManCommand{}
+			},
+			"self-update": func() cli.Command {
+				return &SelfUpdateCommand{}
+			},
 		},
 	}
 }
 
 // Run executes the CLI.
 func Run(ctx context.Context, args []string) error {
+	// shells run tlctl with COMP_LINE set on every tab, and self-update checks
+	// itself.
+	if os.Getenv("COMP_LINE") == "" && len(args) > 0 && args[0] != "completion" && args[0] != "self-update" {
+		warnIfOutdated(ctx, os.Stderr)
+	}
 	return rootCmd().Run(ctx, args) //nolint:wrapcheck // Want passthrough
 }
@@ -0,0 +1,168 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/internal/version"
+	"github.com/abcxyz/team-link/pkg/selfupdate"
+)
+
+const (
+	// versionCheckInterval is how long the latest release is cached between
+	// version checks.
+	versionCheckInterval = 24 * time.Hour
+
+	// versionCheckTimeout bounds how long a version check may delay a command.
+	versionCheckTimeout = 2 * time.Second
+
+	// noVersionCheckEnv disables the version check when set.
+	noVersionCheckEnv = "TEAM_LINK_NO_VERSION_CHECK"
+)
+
+var _ cli.Command = (*SelfUpdateCommand)(nil)
+
+// SelfUpdateCommand replaces the running tlctl with a release from GitHub.
+type SelfUpdateCommand struct {
+	cli.BaseCommand
+
+	version string
+	check   bool
+}
+
+func (c *SelfUpdateCommand) Desc() string {
+	return `Update tlctl to the latest release`
+}
+
+func (c *SelfUpdateCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  Replace this tlctl binary with the latest release, or the given release,
+  published on GitHub, after verifying the checksum of its archive. The token
+  in $GITHUB_TOKEN, if set, raises the rate limit of the GitHub API.
+
+  Update to the latest release:
+
+  tlctl self-update
+
+  Only check whether a newer release is available:
+
+  tlctl self-update -check
+`
+}
+
+func (c *SelfUpdateCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "version",
+		Target:  &c.version,
+		Example: "1.2.3",
+		Usage:   `The release to install instead of the latest one, e.g. to roll back.`,
+	})
+
+	f.BoolVar(&cli.BoolVar{
+		Name:    "check",
+		Target:  &c.check,
+		Default: false,
+		Usage:   `Only print whether a newer release is available.`,
+	})
+
+	return set
+}
+
+func (c *SelfUpdateCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	updater, err := selfupdate.NewUpdater(selfupdate.WithToken(c.GetEnv("GITHUB_TOKEN")))
+	if err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+	var release *selfupdate.Release
+	if c.version != "" {
+		release, err = updater.Get(ctx, c.version)
+	} else {
+		release, err = updater.Latest(ctx)
+	}
+	if err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+
+	if c.check {
+		if version.IsRelease() && !selfupdate.IsNewer(release.Version, version.Version) {
+			c.Outf("tlctl %s is the latest release", version.Version)
+			return nil
+		}
+		c.Outf("tlctl %s is available, this is %s", release.Version, version.Version)
+		return nil
+	}
+	if c.version == "" && version.IsRelease() && !selfupdate.IsNewer(release.Version, version.Version) {
+		c.Outf("tlctl %s is the latest release", version.Version)
+		return nil
+	}
+
+	exePath, err := c.ExecutablePath()
+	if err != nil {
+		return fmt.Errorf("failed to find the tlctl binary: %w", err)
+	}
+	if err := updater.Install(ctx, release, runtime.GOOS, runtime.GOARCH, exePath); err != nil {
+		return fmt.Errorf("failed to update tlctl: %w", err)
+	}
+	c.Outf("Updated tlctl from %s to %s", version.Version, release.Version)
+	return nil
+}
+
+// warnIfOutdated prints a warning to w if a newer release than the running
+// release build is available. Failures to check are ignored, e.g. offline.
+func warnIfOutdated(ctx context.Context, w io.Writer) {
+	if !version.IsRelease() || os.Getenv(noVersionCheckEnv) != "" {
+		return
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return
+	}
+	updater, err := selfupdate.NewUpdater(selfupdate.WithToken(os.Getenv("GITHUB_TOKEN")))
+	if err != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, versionCheckTimeout)
+	defer cancel()
+	latest, err := updater.CheckForUpdate(ctx, version.Version, filepath.Join(cacheDir, "team-link", "version-check.json"), versionCheckInterval)
+	if err != nil || latest == "" {
+		return
+	}
+	fmt.Fprintf(w, "A newer release of tlctl is available: %s (this is %s). "+
+		"Run \"tlctl self-update\" to update, or set %s to silence this warning.\n",
+		latest, version.Version, noVersionCheckEnv)
+}
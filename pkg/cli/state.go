@@ -0,0 +1,70 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+// stateFlags are the options for commands that read or write the state kept
+// between sync runs.
+type stateFlags struct {
+	stateStore string
+}
+
+// register adds the state flags to the given flag set.
+func (s *stateFlags) register(set *cli.FlagSet) {
+	f := set.NewSection("STATE OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "state-store",
+		Target:  &s.stateStore,
+		EnvVar:  "TEAM_LINK_STATE_STORE",
+		Example: "file:///var/lib/team-link",
+		Usage: `The location of the state kept between sync runs, such as ` +
+			`failing and dead-lettered groups. Either a directory path, a ` +
+			`file:// URL, a firestore://project/collection URL or a ` +
+			`redis://host:port/db URL. When empty ` +
+			`no state is kept.`,
+	})
+}
+
+// openStateStore opens the configured state store. It returns nil if no
+// state store is configured.
+func (s *stateFlags) openStateStore(ctx context.Context) (state.Store, error) {
+	if s.stateStore == "" {
+		return nil, nil
+	}
+	store, err := state.Open(ctx, s.stateStore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open state store: %w", err)
+	}
+	return store, nil
+}
+
+// openRunHistory opens the run history of the configured state store.
+// Retention only applies when runs are recorded, so none is configured.
+func (s *stateFlags) openRunHistory(ctx context.Context) (*groupsync.RunHistory, error) {
+	store, err := s.openStateStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return groupsync.NewRunHistory(store, 0, 0), nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+var _ cli.Command = (*StatusCommand)(nil)
+
+// StatusCommand shows the state kept between sync runs.
+type StatusCommand struct {
+	cli.BaseCommand
+
+	stateFlags
+
+	clear  string
+	kind   string
+	member string
+}
+
+func (c *StatusCommand) Desc() string {
+	return `Show failing and dead-lettered groups, flapping members, emptied groups and interrupted writes`
+}
+
+func (c *StatusCommand) Help() string {
+	return `
+Usage: {{ COMMAND }} [options]
+
+  Show groups that failed recent sync runs. Groups that failed too many
+  consecutive runs are dead-lettered and skipped until they are cleared.
+  Members of target groups whose changes are suppressed because they flap
+  are shown too, as well as target groups emptied because their source
+  group was deleted, with the number of members in their snapshot. Batched
+  writes to target groups interrupted part way, e.g. by a crash, are shown
+  with their progress until the next run completes them.
+
+  Show failing groups:
+
+  tlctl status -state-store /var/lib/team-link
+
+  Clear a dead-lettered target group once it has been fixed:
+
+  tlctl status -state-store /var/lib/team-link -clear 8583:2797
+
+  Clear a flapping member of a target group once its mappings are fixed:
+
+  tlctl status -state-store /var/lib/team-link -clear 8583:2797 -member octocat
+`
+}
+
+func (c *StatusCommand) Flags() *cli.FlagSet {
+	set := c.NewFlagSet()
+
+	f := set.NewSection("COMMAND OPTIONS")
+
+	f.StringVar(&cli.StringVar{
+		Name:    "clear",
+		Target:  &c.clear,
+		Example: "8583:2797",
+		Usage:   `The ID of a group whose failure record is cleared, so that the next run syncs it again.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "kind",
+		Target:  &c.kind,
+		Default: string(groupsync.GroupKindTarget),
+		Example: "source",
+		Usage:   `Whether the group passed to -clear is a source or target group.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "member",
+		Target:  &c.member,
+		Example: "octocat",
+		Usage:   `With -clear, clear the flapping member of the target group instead of the group.`,
+	})
+
+	c.stateFlags.register(set)
+
+	set.AfterParse(func(merr error) error {
+		if c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("state-store is not provided"))
+		}
+		if k := groupsync.GroupKind(c.kind); k != groupsync.GroupKindSource && k != groupsync.GroupKindTarget {
+			merr = errors.Join(merr, fmt.Errorf("kind must be one of: source, target"))
+		}
+		if c.member != "" && (c.clear == "" || groupsync.GroupKind(c.kind) != groupsync.GroupKindTarget) {
+			merr = errors.Join(merr, fmt.Errorf("member must be used with -clear of a target group"))
+		}
+		return merr
+	})
+
+	return set
+}
+
+func (c *StatusCommand) Run(ctx context.Context, args []string) error {
+	f := c.Flags()
+	if err := f.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+	args = f.Args()
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %q", args)
+	}
+
+	store, err := c.openStateStore(ctx)
+	if err != nil {
+		return err
+	}
+	// the thresholds only matter when recording runs.
+	queue := groupsync.NewDeadLetterQueue(store, 0)
+	flaps := groupsync.NewFlapDetector(store, 0, 0)
+
+	if c.member != "" {
+		if err := flaps.Clear(ctx, c.clear, c.member); err != nil {
+			return fmt.Errorf("failed to clear member %s of group %s: %w", c.member, c.clear, err)
+		}
+		c.Outf("Cleared member %s of target group %s", c.member, c.clear)
+		return nil
+	}
+	if c.clear != "" {
+		if err := queue.Clear(ctx, groupsync.GroupKind(c.kind), c.clear); err != nil {
+			return fmt.Errorf("failed to clear %s group %s: %w", c.kind, c.clear, err)
+		}
+		c.Outf("Cleared %s group %s", c.kind, c.clear)
+		return nil
+	}
+
+	records, err := queue.Records(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read status: %w", err)
+	}
+	flapRecords, err := flaps.Records(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read status: %w", err)
+	}
+	snapshots, err := groupsync.NewDeletedGroups(groupsync.DeletedGroupEmpty, store).Snapshots(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read status: %w", err)
+	}
+	checkpoints, err := groupsync.WriteCheckpoints(ctx, store)
+	if err != nil {
+		return fmt.Errorf("failed to read status: %w", err)
+	}
+	if len(checkpoints) > 0 {
+		w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+		fmt.Fprintf(w, "STATUS\tGROUP\tADDED\tREMOVED\tSTARTED AT\tUPDATED AT\n")
+		for _, cp := range checkpoints {
+			fmt.Fprintf(w, "interrupted\t%s\t%d/%d\t%d/%d\t%s\t%s\n",
+				cp.GroupID, cp.Added, cp.ToAdd, cp.Removed, cp.ToRemove,
+				cp.StartedAt.Format(time.RFC3339), cp.UpdatedAt.Format(time.RFC3339))
+		}
+		if err := w.Flush(); err != nil {
+			return fmt.Errorf("failed to write status: %w", err)
+		}
+	}
+	if len(snapshots) > 0 {
+		w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+		fmt.Fprintf(w, "STATUS\tGROUP\tDELETED SOURCES\tMEMBERS\tSNAPSHOT AT\n")
+		for _, s := range snapshots {
+			fmt.Fprintf(w, "emptied\t%s\t%s\t%d\t%s\n",
+				s.GroupID, strings.Join(s.DeletedSourceGroupIDs, ","), len(s.Members), s.TakenAt.Format(time.RFC3339))
+		}
+		if err := w.Flush(); err != nil {
+			return fmt.Errorf("failed to write status: %w", err)
+		}
+	}
+	if len(flapRecords) > 0 {
+		w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+		fmt.Fprintf(w, "STATUS\tGROUP\tMEMBER\tCHANGES\tSUPPRESSED AT\n")
+		for _, r := range flapRecords {
+			fmt.Fprintf(w, "flapping\t%s\t%s\t%d\t%s\n",
+				r.GroupID, r.MemberID, len(r.Changes), r.SuppressedAt.Format(time.RFC3339))
+		}
+		if err := w.Flush(); err != nil {
+			return fmt.Errorf("failed to write status: %w", err)
+		}
+	}
+	if len(records) == 0 {
+		c.Outf("No failing groups")
+		return nil
+	}
+	w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "STATUS\tKIND\tGROUP\tFAILURES\tLAST FAILURE\tCATEGORY\tERROR\n")
+	for _, r := range records {
+		status := "failing"
+		if r.DeadLettered {
+			status = "dead-lettered"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+			status, r.Kind, r.GroupID, r.ConsecutiveFailures,
+			r.LastFailure.Format(time.RFC3339), r.Category, r.LastError)
+	}
+	return w.Flush() //nolint:wrapcheck // Want passthrough
+}
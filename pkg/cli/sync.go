@@ -18,18 +18,79 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/abcxyz/pkg/cli"
+	"github.com/abcxyz/pkg/logging"
+	tltypes "github.com/abcxyz/team-link/internal"
 	"github.com/abcxyz/team-link/pkg/common"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/siem"
+	"github.com/abcxyz/team-link/pkg/simulation"
+	"github.com/abcxyz/team-link/pkg/state"
 )
 
 var _ cli.Command = (*SyncCommand)(nil)
 
+// syncLockTTL bounds how long a sync holds the lock of a shared state store,
+// in case it crashes without releasing it.
+const syncLockTTL = 2 * time.Hour
+
+// commentOnPullRequest is the -comment-on value for the pull request which
+// triggered a GitHub Actions workflow.
+const commentOnPullRequest = "pull-request"
+
 type SyncCommand struct {
 	cli.BaseCommand
 
-	mapping string
-	config  string
+	loggingFlags
+	stateFlags
+
+	mapping             string
+	config              string
+	source              string
+	target              string
+	pipelines           []string
+	retryAttempts       int
+	retryBackoff        time.Duration
+	deadLetterThreshold int
+	historyRuns         int
+	historyMaxAge       time.Duration
+	cacheStore          string
+	freezeWindows       []string
+	freezeTimeZone      string
+	flapThreshold       int
+	flapWindow          time.Duration
+	roleConflictPolicy  string
+	since               string
+	suspendedUsers      string
+	suspendedGrace      time.Duration
+	deletedGroups       string
+	roleEscalations     string
+	securityEvents      string
+	securityFormat      string
+	commentOn           string
+	unmappedGroups      string
+	skipPreflight       bool
+	detectDrift         bool
+	shadow              bool
+	autoApprove         bool
+	readOnly            bool
+	writeBatchSize      int
+	record              string
+	replay              string
+	pprofAddr           string
+
+	// approveAll and declineAll hold the answers to confirmation prompts
+	// which apply to the rest of the run.
+	approveAll bool
+	declineAll bool
 }
 
 func (c *SyncCommand) Desc() string {
@@ -61,7 +122,8 @@ func (c *SyncCommand) Flags() *cli.FlagSet {
 		Target:  &c.mapping,
 		Aliases: []string{"m"},
 		Example: "mapping.textproto",
-		Usage:   `The textproto file that includes group and user mapping info`,
+		Usage: `The textproto file that includes group and user mapping info. ` +
+			`Optional if every pipeline of the config has its own mapping_files.`,
 	})
 
 	f.StringVar(&cli.StringVar{
@@ -72,13 +134,401 @@ func (c *SyncCommand) Flags() *cli.FlagSet {
 		Usage:   `The textproto file for teamlink configs.`,
 	})
 
+	f.StringVar(&cli.StringVar{
+		Name:    "source",
+		Target:  &c.source,
+		Aliases: []string{"s"},
+		Example: "gitlab",
+		Usage: `The system to sync from, one of: ` + strings.Join(systemNames(), ", ") + `. ` +
+			`Defaults to the source of the config. Together with -target this allows ` +
+			`syncing in the reverse direction of the config, e.g. during a migration.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "target",
+		Target:  &c.target,
+		Aliases: []string{"d"},
+		Example: "github",
+		Usage:   `The system to sync to. Must be set together with -source.`,
+	})
+
+	f.StringSliceVar(&cli.StringSliceVar{
+		Name:    "pipeline",
+		Target:  &c.pipelines,
+		Example: "google-to-github",
+		Usage: `The name of a pipeline of the config to sync, instead of all of ` +
+			`them. May be repeated.`,
+	})
+
+	f.IntVar(&cli.IntVar{
+		Name:    "retry-attempts",
+		Target:  &c.retryAttempts,
+		Default: 3,
+		Example: "3",
+		Usage: `The maximum number of times a group that failed due to rate limiting ` +
+			`or a transient error is attempted within a run. Use 1 to disable retries.`,
+	})
+
+	f.DurationVar(&cli.DurationVar{
+		Name:    "retry-backoff",
+		Target:  &c.retryBackoff,
+		Default: groupsync.DefaultRetryBackoff,
+		Example: "10s",
+		Usage:   `The delay before the first retry round, doubled on every subsequent round.`,
+	})
+
+	f.IntVar(&cli.IntVar{
+		Name:    "dead-letter-threshold",
+		Target:  &c.deadLetterThreshold,
+		Default: 5,
+		Example: "5",
+		Usage: `The number of consecutive failed runs after which a group is ` +
+			`dead-lettered and skipped until cleared with "tlctl status -clear". ` +
+			`Requires -state-store. Use 0 to never dead-letter groups.`,
+	})
+
+	f.IntVar(&cli.IntVar{
+		Name:    "history-runs",
+		Target:  &c.historyRuns,
+		Default: 100,
+		Example: "100",
+		Usage: `The number of run reports kept for "tlctl history". Requires ` +
+			`-state-store. Use 0 to not record run history.`,
+	})
+
+	f.DurationVar(&cli.DurationVar{
+		Name:    "history-max-age",
+		Target:  &c.historyMaxAge,
+		Example: "2160h",
+		Usage:   `Prune run reports older than this. By default reports are kept regardless of age.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "cache-store",
+		Target:  &c.cacheStore,
+		EnvVar:  "TEAM_LINK_CACHE_STORE",
+		Example: "redis://10.0.0.3:6379/0",
+		Usage: `The location of a cache of user lookups shared by team-link ` +
+			`processes, typically a redis:// or rediss:// URL of a Redis or ` +
+			`Memorystore instance. Accepts the same locations as -state-store. ` +
+			`When empty lookups are only cached in memory.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "role-conflict-policy",
+		Target:  &c.roleConflictPolicy,
+		Default: string(groupsync.RoleConflictFirstWins),
+		Example: string(groupsync.RoleConflictHighestWins),
+		Usage: `How the role of a target user is decided when several source users ` +
+			`of a target group map to it with different roles: "first-wins" keeps ` +
+			`the role of the source user whose ID sorts first, "highest-wins" keeps ` +
+			`the highest role, and "error" fails the sync if any user mappings conflict.`,
+	})
+
+	f.IntVar(&cli.IntVar{
+		Name:    "flap-threshold",
+		Target:  &c.flapThreshold,
+		Example: "4",
+		Usage: `The number of times a member may be added to or removed from a ` +
+			`target group within -flap-window. Further changes are suppressed ` +
+			`until cleared with "tlctl status -clear GROUP -member MEMBER". ` +
+			`Requires -state-store. By default changes are never suppressed.`,
+	})
+
+	f.DurationVar(&cli.DurationVar{
+		Name:    "flap-window",
+		Target:  &c.flapWindow,
+		Default: 24 * time.Hour,
+		Example: "168h",
+		Usage:   `The window in which changes of a member are counted for -flap-threshold.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "suspended-users",
+		Target:  &c.suspendedUsers,
+		Default: string(groupsync.SuspendedUserKeep),
+		Example: string(groupsync.SuspendedUserGracePeriod),
+		Usage: `How suspended, blocked or deactivated source users are synced: ` +
+			`"keep" syncs them like active users, "remove" removes them from target ` +
+			`groups, and "grace-period" removes them once suspended for ` +
+			`-suspended-grace-period, which requires -state-store. Supported for ` +
+			`Google Groups, GitHub and GitLab sources.`,
+	})
+
+	f.DurationVar(&cli.DurationVar{
+		Name:    "suspended-grace-period",
+		Target:  &c.suspendedGrace,
+		Default: 7 * 24 * time.Hour,
+		Example: "72h",
+		Usage:   `How long suspended users are kept with -suspended-users=grace-period.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "role-escalations",
+		Target:  &c.roleEscalations,
+		Default: string(groupsync.EscalationAllow),
+		Example: string(groupsync.EscalationRequireApproval),
+		Usage: `How changes giving a target user a privileged role, maintainer ` +
+			`or higher, are applied: "allow" applies them like other changes, ` +
+			`"audit" applies them and logs a high severity "role escalation" ` +
+			`event for each, and "require-approval" holds them back until ` +
+			`approved with "tlctl escalations approve", which requires ` +
+			`-state-store.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "security-events",
+		Target:  &c.securityEvents,
+		Example: "/var/log/team-link/security.log",
+		Usage: `Append the applied membership changes and the policy violations, ` +
+			`such as role escalations and unmapped groups, to the given file as ` +
+			`security events for a SIEM, in the format of -security-events-format.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "security-events-format",
+		Target:  &c.securityFormat,
+		Default: string(siem.FormatCEF),
+		Example: string(siem.FormatECS),
+		Usage: `The format of -security-events: "cef" for the Common Event ` +
+			`Format, or "ecs" for JSON following the Elastic Common Schema.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "comment-on",
+		Target:  &c.commentOn,
+		Example: "abcxyz/team-link-config#42",
+		Usage: `Post a summary of the membership changes of the sync, or with ` +
+			`-read-only of the changes it would make, as a comment on the given ` +
+			`GitHub issue or pull request, of the form OWNER/REPO#NUMBER. ` +
+			`"pull-request" comments on the pull request which triggered the ` +
+			`GitHub Actions workflow. The comment of an earlier run is updated. ` +
+			`The token is read from $GITHUB_TOKEN and the API URL from ` +
+			`$GITHUB_API_URL, if set.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "deleted-groups",
+		Target:  &c.deletedGroups,
+		Default: string(groupsync.DeletedGroupError),
+		Example: string(groupsync.DeletedGroupSkip),
+		Usage: `How the target groups of deleted source groups are synced: ` +
+			`"error" fails their sync, "skip" leaves them as they are, and ` +
+			`"empty" syncs them without the deleted source groups after saving ` +
+			`a snapshot of their members, which requires -state-store.`,
+	})
+
+	f.BoolVar(&cli.BoolVar{
+		Name:    "read-only",
+		Target:  &c.readOnly,
+		Default: false,
+		Usage: `Refuse every change to target groups: groups which are in sync ` +
+			`succeed and groups which would change fail with the changes they ` +
+			`would make. Cannot be used with -state-store.`,
+	})
+
+	f.IntVar(&cli.IntVar{
+		Name:    "write-batch-size",
+		Target:  &c.writeBatchSize,
+		Example: "1000",
+		Usage: `Write the changes to target groups with more changes than the ` +
+			`given number in batches of that size, e.g. for teams with tens of ` +
+			`thousands of members. With -state-store, the progress of each write ` +
+			`is checkpointed, so that writes interrupted by a crash are shown by ` +
+			`"tlctl status" and resumed by the next run. 0 writes all changes at once.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "record",
+		Target:  &c.record,
+		Example: "fixture.json",
+		Usage: `Record the reads of the source and target groups into the given ` +
+			`fixture file, which -replay can sync offline later.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "replay",
+		Target:  &c.replay,
+		Example: "fixture.json",
+		Usage: `Sync against the groups recorded in the given fixture file instead ` +
+			`of calling any API, and print the changes the sync would have made. ` +
+			`Cannot be used with -state-store or -record.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "pprof-addr",
+		Target:  &c.pprofAddr,
+		Example: "localhost:6060",
+		Usage: `Serve the pprof profiling endpoints at the given address while ` +
+			`syncing, e.g. to profile syncs of large orgs.`,
+	})
+
+	f.BoolVar(&cli.BoolVar{
+		Name:    "auto-approve",
+		Target:  &c.autoApprove,
+		Aliases: []string{"yes"},
+		Default: false,
+		Usage: `Apply removals of members from target groups without asking. ` +
+			`When run in a terminal, the members a target group would lose ` +
+			`are shown and the removal is only applied once confirmed.`,
+	})
+
+	f.BoolVar(&cli.BoolVar{
+		Name:    "skip-preflight",
+		Target:  &c.skipPreflight,
+		Default: false,
+		Usage: `Skip verifying that the GitHub or GitLab credentials of the ` +
+			`target have the permissions to manage its groups before syncing.`,
+	})
+
+	f.BoolVar(&cli.BoolVar{
+		Name:    "detect-manual-changes",
+		Target:  &c.detectDrift,
+		Default: false,
+		Usage: `Record the members applied to every target group in the ` +
+			`state store, and log a "manual change overwritten" alert for each ` +
+			`change made outside of team-link since the last run which the ` +
+			`sync reverts. Requires -state-store.`,
+	})
+
+	f.BoolVar(&cli.BoolVar{
+		Name:    "shadow",
+		Target:  &c.shadow,
+		Default: false,
+		Usage: `Sync into prefixed copies of the target GitHub teams in the ` +
+			`sandbox org of the shadow config instead of the teams ` +
+			`themselves, creating missing copies.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "unmapped-groups",
+		Target:  &c.unmappedGroups,
+		Default: string(groupsync.UnmappedGroupNone),
+		Example: string(groupsync.UnmappedGroupArchive),
+		Usage: `What happens to target groups synced by previous runs whose mappings ` +
+			`were removed: "none" leaves them as they are, "flag" alerts on them, ` +
+			`and "archive" renames GitHub teams with an "archived-" prefix and ` +
+			`removes their members. Requires -state-store, which records the ` +
+			`synced target groups.`,
+	})
+
+	f.StringSliceVar(&cli.StringSliceVar{
+		Name:    "freeze-window",
+		Target:  &c.freezeWindows,
+		Example: "Fri 17:00-Mon 09:00",
+		Usage: `A window during which removals from target groups are deferred and ` +
+			`logged as pending, either weekly, e.g. "Fri 17:00-Mon 09:00", or ` +
+			`between two dates, e.g. "2026-12-20/2027-01-04". May be repeated.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "freeze-time-zone",
+		Target:  &c.freezeTimeZone,
+		Default: "UTC",
+		Example: "America/Los_Angeles",
+		Usage:   `The IANA time zone of the freeze windows.`,
+	})
+
+	f.StringVar(&cli.StringVar{
+		Name:    "since",
+		Target:  &c.since,
+		Example: "2024-12-01T00:00:00Z",
+		Usage: `Only sync the source groups whose members changed since this RFC 3339 ` +
+			`time according to the audit log of the source system, e.g. to replay ` +
+			`the changes missed by an event-driven deployment. Only supported for ` +
+			`Google Groups sources.`,
+	})
+
+	c.stateFlags.register(set)
+	c.loggingFlags.register(set)
+
 	set.AfterParse(func(merr error) error {
-		if c.mapping == "" {
-			merr = errors.Join(merr, fmt.Errorf("mapping file is not provided"))
-		}
 		if c.config == "" {
 			merr = errors.Join(merr, fmt.Errorf("config file is not provided"))
 		}
+		if (c.source == "") != (c.target == "") {
+			merr = errors.Join(merr, fmt.Errorf("source and target must be set together"))
+		}
+		if len(c.pipelines) > 0 && c.source != "" {
+			merr = errors.Join(merr, fmt.Errorf("pipeline cannot be used with source and target"))
+		}
+		for _, system := range []string{c.source, c.target} {
+			if system != "" && systemType(system) == "" {
+				merr = errors.Join(merr, fmt.Errorf("unknown system %q, must be one of: %s", system, strings.Join(systemNames(), ", ")))
+			}
+		}
+		if c.retryAttempts < 1 {
+			merr = errors.Join(merr, fmt.Errorf("retry-attempts must be at least 1"))
+		}
+		if c.deadLetterThreshold < 0 {
+			merr = errors.Join(merr, fmt.Errorf("dead-letter-threshold must not be negative"))
+		}
+		if c.historyRuns < 0 {
+			merr = errors.Join(merr, fmt.Errorf("history-runs must not be negative"))
+		}
+		if c.historyMaxAge < 0 {
+			merr = errors.Join(merr, fmt.Errorf("history-max-age must not be negative"))
+		}
+		if c.since != "" {
+			if _, err := time.Parse(time.RFC3339, c.since); err != nil {
+				merr = errors.Join(merr, fmt.Errorf("invalid since: %w", err))
+			}
+		}
+		if policy, err := groupsync.ParseSuspendedUserPolicy(c.suspendedUsers); err != nil {
+			merr = errors.Join(merr, err)
+		} else if policy == groupsync.SuspendedUserGracePeriod && c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("suspended-users %s requires state-store", policy))
+		}
+		if c.suspendedGrace < 0 {
+			merr = errors.Join(merr, fmt.Errorf("suspended-grace-period must not be negative"))
+		}
+		if c.detectDrift && c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("detect-manual-changes requires state-store"))
+		}
+		if policy, err := groupsync.ParseEscalationPolicy(c.roleEscalations); err != nil {
+			merr = errors.Join(merr, err)
+		} else if policy == groupsync.EscalationRequireApproval && c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("role-escalations %s requires state-store", policy))
+		}
+		if _, err := siem.ParseFormat(c.securityFormat); err != nil {
+			merr = errors.Join(merr, err)
+		}
+		if c.commentOn != "" && c.commentOn != commentOnPullRequest {
+			if _, err := github.ParseIssueRef(c.commentOn); err != nil {
+				merr = errors.Join(merr, fmt.Errorf("invalid comment-on: %w", err))
+			}
+		}
+		if policy, err := groupsync.ParseDeletedGroupPolicy(c.deletedGroups); err != nil {
+			merr = errors.Join(merr, err)
+		} else if policy == groupsync.DeletedGroupEmpty && c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("deleted-groups %s requires state-store", policy))
+		}
+		if c.replay != "" && (c.stateStore != "" || c.record != "") {
+			merr = errors.Join(merr, fmt.Errorf("replay cannot be used with state-store or record"))
+		}
+		if c.readOnly && c.stateStore != "" {
+			merr = errors.Join(merr, fmt.Errorf("read-only cannot be used with state-store, refused changes would be recorded as failures"))
+		}
+		if policy, err := groupsync.ParseUnmappedGroupPolicy(c.unmappedGroups); err != nil {
+			merr = errors.Join(merr, err)
+		} else if policy != groupsync.UnmappedGroupNone && c.stateStore == "" {
+			merr = errors.Join(merr, fmt.Errorf("unmapped-groups %s requires state-store", policy))
+		}
+		if _, err := groupsync.ParseRoleConflictPolicy(c.roleConflictPolicy); err != nil {
+			merr = errors.Join(merr, err)
+		}
+		if c.writeBatchSize < 0 {
+			merr = errors.Join(merr, fmt.Errorf("write-batch-size must not be negative"))
+		}
+		if c.flapThreshold < 0 {
+			merr = errors.Join(merr, fmt.Errorf("flap-threshold must not be negative"))
+		}
+		if c.flapWindow <= 0 {
+			merr = errors.Join(merr, fmt.Errorf("flap-window must be positive"))
+		}
+		if _, err := c.parseFreezeWindows(); err != nil {
+			merr = errors.Join(merr, err)
+		}
 		return merr
 	})
 
@@ -95,9 +545,349 @@ func (c *SyncCommand) Run(ctx context.Context, args []string) error {
 		return fmt.Errorf("unexpected arguments: %q", args)
 	}
 
-	if err := common.Sync(ctx, c.mapping, c.config); err != nil {
+	ctx, err := c.withLogger(ctx, c.Stderr())
+	if err != nil {
+		return fmt.Errorf("failed to setup logger: %w", err)
+	}
+
+	var securityEvents *siem.Writer
+	if c.securityEvents != "" {
+		format, err := siem.ParseFormat(c.securityFormat)
+		if err != nil {
+			return err //nolint:wrapcheck // Want passthrough
+		}
+		file, err := os.OpenFile(c.securityEvents, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to open security events file: %w", err)
+		}
+		defer file.Close()
+		securityEvents = siem.NewWriter(file, format)
+		logger := logging.FromContext(ctx)
+		ctx = logging.WithLogger(ctx, slog.New(securityEvents.Handler(logger.Handler())))
+	}
+
+	if c.pprofAddr != "" {
+		stop, err := servePprof(ctx, c.pprofAddr)
+		if err != nil {
+			return err
+		}
+		defer stop()
+	}
+
+	opts := []groupsync.Opt{
+		groupsync.WithRetry(c.retryAttempts, c.retryBackoff),
+	}
+	freezeWindows, err := c.parseFreezeWindows()
+	if err != nil {
+		return err
+	}
+	if len(freezeWindows) > 0 {
+		opts = append(opts, groupsync.WithFreezeWindows(freezeWindows...))
+	}
+	store, err := c.openStateStore(ctx)
+	if err != nil {
+		return err
+	}
+	if locker, ok := store.(state.Locker); ok {
+		unlock, err := locker.Lock(ctx, "sync", syncLockTTL)
+		if errors.Is(err, state.ErrLocked) {
+			return fmt.Errorf("another sync is running with the same state store")
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock state store: %w", err)
+		}
+		defer func() {
+			if err := unlock(context.WithoutCancel(ctx)); err != nil {
+				logging.FromContext(ctx).WarnContext(ctx, "failed to unlock state store", "error", err)
+			}
+		}()
+	}
+	if store != nil {
+		unmappedGroups, err := groupsync.ParseUnmappedGroupPolicy(c.unmappedGroups)
+		if err != nil {
+			return err //nolint:wrapcheck // Want passthrough
+		}
+		opts = append(opts, groupsync.WithDeadLetterQueue(groupsync.NewDeadLetterQueue(store, c.deadLetterThreshold)))
+		opts = append(opts, groupsync.WithManagedGroups(groupsync.NewManagedGroups(store, unmappedGroups)))
+		if c.historyRuns > 0 {
+			opts = append(opts, groupsync.WithRunHistory(groupsync.NewRunHistory(store, c.historyRuns, c.historyMaxAge)))
+		}
+		if c.flapThreshold > 0 {
+			opts = append(opts, groupsync.WithFlapDetector(groupsync.NewFlapDetector(store, c.flapThreshold, c.flapWindow)))
+		}
+	}
+	roleConflictPolicy, err := groupsync.ParseRoleConflictPolicy(c.roleConflictPolicy)
+	if err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+	suspendedUsers, err := groupsync.ParseSuspendedUserPolicy(c.suspendedUsers)
+	if err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+	deletedGroups, err := groupsync.ParseDeletedGroupPolicy(c.deletedGroups)
+	if err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+	roleEscalations, err := groupsync.ParseEscalationPolicy(c.roleEscalations)
+	if err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+	syncOpts := []common.SyncOpt{
+		common.WithSyncerOpts(opts...),
+		common.WithRoleConflictPolicy(roleConflictPolicy),
+		common.WithSuspendedUserPolicy(suspendedUsers, c.suspendedGrace),
+		common.WithDeletedGroupPolicy(deletedGroups),
+		common.WithEscalationPolicy(roleEscalations),
+	}
+	if store != nil {
+		syncOpts = append(syncOpts, common.WithStateStore(store))
+	}
+	if securityEvents != nil {
+		syncOpts = append(syncOpts, common.WithChangeSinks(securityEvents))
+	}
+	if c.skipPreflight {
+		syncOpts = append(syncOpts, common.WithoutPreflight())
+	}
+	if c.detectDrift {
+		syncOpts = append(syncOpts, common.WithDriftDetection())
+	}
+	if c.shadow {
+		syncOpts = append(syncOpts, common.WithShadow())
+	}
+	if !c.autoApprove && c.replay == "" && c.stdinIsTerminal() {
+		syncOpts = append(syncOpts, common.WithConfirmation(c.confirmRemoval))
+	}
+	if c.readOnly {
+		syncOpts = append(syncOpts, common.WithReadOnly())
+	}
+	if c.writeBatchSize > 0 {
+		syncOpts = append(syncOpts, common.WithWriteBatchSize(c.writeBatchSize))
+	}
+	if c.cacheStore != "" {
+		cache, err := state.Open(ctx, c.cacheStore)
+		if err != nil {
+			return fmt.Errorf("failed to open cache store: %w", err)
+		}
+		syncOpts = append(syncOpts, common.WithGitHubOpts(github.WithSharedCache(cache)))
+	}
+	if c.source != "" {
+		syncOpts = append(syncOpts, common.WithSystems(systemType(c.source), systemType(c.target)))
+	}
+	if len(c.pipelines) > 0 {
+		syncOpts = append(syncOpts, common.WithPipelines(c.pipelines...))
+	}
+	if c.since != "" {
+		since, err := time.Parse(time.RFC3339, c.since)
+		if err != nil {
+			return fmt.Errorf("invalid since: %w", err)
+		}
+		syncOpts = append(syncOpts, common.WithSince(since))
+	}
+	var replayTarget *simulation.Replayer
+	if c.replay != "" {
+		fixture, err := simulation.ReadFixture(c.replay)
+		if err != nil {
+			return err //nolint:wrapcheck // Want passthrough
+		}
+		replayTarget = simulation.NewReplayer(fixture.Target)
+		syncOpts = append(syncOpts, common.WithReplay(simulation.NewReplayer(fixture.Source), replayTarget))
+	}
+	if c.record != "" {
+		fixture := simulation.NewFixture(time.Now())
+		syncOpts = append(syncOpts, common.WithRecording(fixture))
+		defer func() {
+			// failed runs are recorded too, to replay their failures.
+			if err := fixture.Write(c.record); err != nil {
+				logging.FromContext(ctx).ErrorContext(ctx, "failed to write recording", "error", err)
+			}
+		}()
+	}
+	var summary *common.RunSummary
+	if c.commentOn != "" {
+		summary = common.NewRunSummary()
+		syncOpts = append(syncOpts, common.WithSummary(summary))
+	}
+	err = common.Sync(ctx, c.mapping, c.config, syncOpts...)
+	if replayTarget != nil {
+		c.renderChanges(replayTarget.Changes())
+	}
+	if summary != nil {
+		// the summary is posted for failed runs too, to show what failed.
+		if cerr := c.postSummary(ctx, summary.Markdown(err)); cerr != nil {
+			logging.FromContext(ctx).ErrorContext(ctx, "failed to post sync summary", "error", cerr)
+		}
+	}
+	if err != nil {
+		var syncErr *groupsync.SyncError
+		if errors.As(err, &syncErr) {
+			c.renderSyncError(syncErr)
+		}
 		return fmt.Errorf("failed to sync membership: %w", err)
 	}
 
 	return nil
 }
+
+// stdinIsTerminal reports whether the input of the command is a terminal, in
+// which case removals are confirmed by the user.
+func (c *SyncCommand) stdinIsTerminal() bool {
+	f, ok := c.Stdin().(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// confirmRemoval shows the members which would be removed from a target
+// group and asks the user to confirm their removal. The answers "all" and
+// "quit" confirm or decline the removals of the rest of the run.
+func (c *SyncCommand) confirmRemoval(ctx context.Context, groupID string, remove []groupsync.Member) (bool, error) {
+	if c.approveAll || c.declineAll {
+		return c.approveAll, nil
+	}
+	c.Errf("Target group %s would lose %d member(s):", groupID, len(remove))
+	for _, m := range remove {
+		kind := "user"
+		if m.IsGroup() {
+			kind = "group"
+		}
+		c.Errf("  - %s (%s)", m.ID(), kind)
+	}
+	for {
+		answer, err := c.Prompt(ctx, "Apply these removals? [y]es, [n]o, [a]ll, [q]uit: ")
+		if err != nil {
+			return false, err //nolint:wrapcheck // Want passthrough
+		}
+		switch strings.ToLower(strings.TrimSpace(answer)) {
+		case "y", "yes":
+			return true, nil
+		case "n", "no", "":
+			return false, nil
+		case "a", "all":
+			c.approveAll = true
+			return true, nil
+		case "q", "quit":
+			c.declineAll = true
+			return false, nil
+		}
+	}
+}
+
+// parseFreezeWindows parses the -freeze-window flags in the time zone of
+// -freeze-time-zone.
+func (c *SyncCommand) parseFreezeWindows() ([]groupsync.FreezeWindow, error) {
+	if len(c.freezeWindows) == 0 {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(c.freezeTimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid freeze-time-zone: %w", err)
+	}
+	windows := make([]groupsync.FreezeWindow, 0, len(c.freezeWindows))
+	for _, spec := range c.freezeWindows {
+		w, err := groupsync.ParseFreezeWindow(spec, loc)
+		if err != nil {
+			return nil, fmt.Errorf("invalid freeze-window: %w", err)
+		}
+		windows = append(windows, w)
+	}
+	return windows, nil
+}
+
+// postSummary posts the markdown summary of the sync as a comment on the
+// issue or pull request of -comment-on.
+func (c *SyncCommand) postSummary(ctx context.Context, summary string) error {
+	var ref *github.IssueRef
+	var err error
+	if c.commentOn == commentOnPullRequest {
+		ref, err = github.PullRequestFromEnv(c.GetEnv)
+	} else {
+		ref, err = github.ParseIssueRef(c.commentOn)
+	}
+	if err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+	token := c.GetEnv("GITHUB_TOKEN")
+	if token == "" {
+		return fmt.Errorf("GITHUB_TOKEN is not set")
+	}
+	commenter, err := github.NewIssueCommenterWithToken(token, c.GetEnv("GITHUB_API_URL"))
+	if err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+	if err := commenter.Post(ctx, ref, summary); err != nil {
+		return fmt.Errorf("failed to post summary: %w", err)
+	}
+	return nil
+}
+
+// renderChanges prints a table of the changes of a replayed sync.
+func (c *SyncCommand) renderChanges(changes []*simulation.Change) {
+	if len(changes) == 0 {
+		c.Outf("No changes")
+		return
+	}
+	w := tabwriter.NewWriter(c.Stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "TARGET GROUP\tADD\tREMOVE\n")
+	for _, ch := range changes {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", ch.GroupID, strings.Join(ch.Add, ","), strings.Join(ch.Remove, ","))
+	}
+	w.Flush()
+}
+
+// renderSyncError prints a table of the failed groups to stderr.
+func (c *SyncCommand) renderSyncError(syncErr *groupsync.SyncError) {
+	w := tabwriter.NewWriter(c.Stderr(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "CATEGORY\tSOURCE GROUP\tTARGET GROUP\tERROR\n")
+	for _, ge := range syncErr.Errors {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%v\n", ge.Category, ge.SourceGroupID, ge.TargetGroupID, ge.Err)
+	}
+	w.Flush()
+}
+
+// systemTypes are the names accepted by -source and -target.
+var systemTypes = map[string]string{
+	"auth0":          tltypes.SystemTypeAuth0,
+	"gerrit":         tltypes.SystemTypeGerrit,
+	"github":         tltypes.SystemTypeGitHub,
+	"gitlab":         tltypes.SystemTypeGitLab,
+	"googlegroups":   tltypes.SystemTypeGoogleGroups,
+	"kubernetes":     tltypes.SystemTypeKubernetes,
+	"mattermost":     tltypes.SystemTypeMattermost,
+	"rocketchat":     tltypes.SystemTypeRocketChat,
+	"sentry":         tltypes.SystemTypeSentry,
+	"servicenow":     tltypes.SystemTypeServiceNow,
+	"splunk":         tltypes.SystemTypeSplunk,
+	"looker":         tltypes.SystemTypeLooker,
+	"tableau":        tltypes.SystemTypeTableau,
+	"confluence":     tltypes.SystemTypeConfluence,
+	"jumpcloud":      tltypes.SystemTypeJumpCloud,
+	"onelogin":       tltypes.SystemTypeOneLogin,
+	"pingone":        tltypes.SystemTypePingOne,
+	"pagerduty":      tltypes.SystemTypePagerDuty,
+	"opsgenie":       tltypes.SystemTypeOpsgenie,
+	"databricks":     tltypes.SystemTypeDatabricks,
+	"googlechat":     tltypes.SystemTypeGoogleChat,
+	"googlecalendar": tltypes.SystemTypeGoogleCalendar,
+	"microsoft365":   tltypes.SystemTypeMicrosoft365,
+	"box":            tltypes.SystemTypeBox,
+	"dropbox":        tltypes.SystemTypeDropbox,
+	"salesforce":     tltypes.SystemTypeSalesforce,
+	"vault":          tltypes.SystemTypeVault,
+	"zendesk":        tltypes.SystemTypeZendesk,
+}
+
+// systemType returns the system type of the given name, or "" if unknown.
+func systemType(name string) string {
+	return systemTypes[strings.ToLower(name)]
+}
+
+func systemNames() []string {
+	names := make([]string, 0, len(systemTypes))
+	for name := range systemTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+)
+
+// gitHubBranchAllowance identifies an allowance on a protected branch of a
+// repository of an org.
+type gitHubBranchAllowance struct {
+	orgID     int64
+	repo      string
+	branch    string
+	allowance string
+}
+
+// branchAllowances returns the IDs of the GitHub teams of the group mappings
+// having each allowance on protected branches.
+func branchAllowances(gm *api.GroupMappings) (map[gitHubBranchAllowance][]int64, error) {
+	var merr error
+	allowances := make(map[gitHubBranchAllowance][]int64)
+	add := func(key gitHubBranchAllowance, teamID int64) {
+		if !slices.Contains(allowances[key], teamID) {
+			allowances[key] = append(allowances[key], teamID)
+		}
+	}
+	for _, m := range gm.GetMappings() {
+		team := gitHubTeam(m)
+		for _, a := range team.GetBranchAllowances() {
+			if a.GetRepo() == "" || a.GetBranch() == "" || (!a.GetPush() && !a.GetBypassPullRequests()) {
+				merr = errors.Join(merr, fmt.Errorf("branch_allowances of team %d:%d need a repo, a branch and an allowance",
+					team.GetOrgId(), team.GetTeamId()))
+				continue
+			}
+			key := gitHubBranchAllowance{orgID: team.GetOrgId(), repo: a.GetRepo(), branch: a.GetBranch()}
+			if a.GetPush() {
+				key.allowance = github.AllowancePush
+				add(key, team.GetTeamId())
+			}
+			if a.GetBypassPullRequests() {
+				key.allowance = github.AllowanceBypassPullRequests
+				add(key, team.GetTeamId())
+			}
+		}
+	}
+	if merr != nil {
+		return nil, merr
+	}
+	return allowances, nil
+}
+
+// SyncBranchAllowances gives the GitHub teams of the group mappings the
+// allowances on protected branches declared for them. The teams having an
+// allowance on a branch are exactly the teams declaring it, and allowances
+// declared by no team are left alone. With dryRun the changes are only
+// logged. Allowances which fail to sync do not stop the others and their
+// errors are returned.
+func SyncBranchAllowances(ctx context.Context, teams *github.TeamReadWriter, gm *api.GroupMappings, dryRun bool) error {
+	allowances, err := branchAllowances(gm)
+	if err != nil {
+		return fmt.Errorf("invalid branch_allowances: %w", err)
+	}
+	keys := make([]gitHubBranchAllowance, 0, len(allowances))
+	for key := range allowances {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b gitHubBranchAllowance) int {
+		return cmp.Or(cmp.Compare(a.orgID, b.orgID), cmp.Compare(a.repo, b.repo), cmp.Compare(a.branch, b.branch),
+			cmp.Compare(a.allowance, b.allowance))
+	})
+
+	var merr error
+	for _, key := range keys {
+		teamIDs := allowances[key]
+		slices.Sort(teamIDs)
+		if _, err := teams.SyncBranchAllowance(ctx, key.orgID, key.repo, key.branch, key.allowance, teamIDs, dryRun); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to sync %s allowance of branch %s of repo %s in org %d: %w",
+				key.allowance, key.branch, key.repo, key.orgID, err))
+		}
+	}
+	return merr
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gh "github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/githubtest"
+)
+
+func TestSyncBranchAllowances(t *testing.T) {
+	t.Parallel()
+
+	mapping := func(teamID int64, allowances ...*api.BranchAllowance) *api.GroupMapping {
+		return &api.GroupMapping{
+			Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/" + strconv.FormatInt(teamID, 10)}},
+			Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 8583, TeamId: teamID, BranchAllowances: allowances}},
+		}
+	}
+
+	cases := []struct {
+		name       string
+		mappings   []*api.GroupMapping
+		dryRun     bool
+		wantPush   []string
+		wantBypass []string
+		wantErr    string
+	}{
+		{
+			name: "success",
+			mappings: []*api.GroupMapping{
+				mapping(1, &api.BranchAllowance{Repo: "svc-api", Branch: "main", Push: true, BypassPullRequests: true}),
+				mapping(2, &api.BranchAllowance{Repo: "svc-api", Branch: "main", Push: true}),
+				mapping(3),
+			},
+			wantPush:   []string{"team1", "team2"},
+			wantBypass: []string{"team1"},
+		},
+		{
+			name: "push_only",
+			mappings: []*api.GroupMapping{
+				mapping(1, &api.BranchAllowance{Repo: "svc-api", Branch: "main", Push: true}),
+			},
+			wantPush:   []string{"team1"},
+			wantBypass: []string{"team3"},
+		},
+		{
+			name: "dry_run",
+			mappings: []*api.GroupMapping{
+				mapping(1, &api.BranchAllowance{Repo: "svc-api", Branch: "main", Push: true, BypassPullRequests: true}),
+			},
+			dryRun:     true,
+			wantPush:   []string{"team3"},
+			wantBypass: []string{"team3"},
+		},
+		{
+			name: "invalid_allowance",
+			mappings: []*api.GroupMapping{
+				mapping(1,
+					&api.BranchAllowance{Repo: "svc-api", Branch: "main", Push: true},
+					&api.BranchAllowance{Repo: "svc-api", Branch: "main"},
+				),
+			},
+			wantPush:   []string{"team3"},
+			wantBypass: []string{"team3"},
+			wantErr:    "branch_allowances of team 8583:1 need a repo, a branch and an allowance",
+		},
+		{
+			name: "unprotected_branch",
+			mappings: []*api.GroupMapping{
+				mapping(1,
+					&api.BranchAllowance{Repo: "svc-api", Branch: "dev", Push: true},
+					&api.BranchAllowance{Repo: "svc-api", Branch: "main", BypassPullRequests: true},
+				),
+			},
+			wantPush:   []string{"team3"},
+			wantBypass: []string{"team1"},
+			wantErr:    "failed to sync push allowance of branch dev of repo svc-api in org 8583",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := githubtest.NewBuilder().
+				WithOrg(8583, "org1").
+				WithTeam(8583, &gh.Team{ID: gh.Int64(1), Slug: gh.String("team1")}).
+				WithTeam(8583, &gh.Team{ID: gh.Int64(2), Slug: gh.String("team2")}).
+				WithTeam(8583, &gh.Team{ID: gh.Int64(3), Slug: gh.String("team3")}).
+				WithRepo(8583, &gh.Repository{Name: gh.String("svc-api")}).
+				WithPushRestrictions(8583, "svc-api", "main", "team3").
+				WithPullRequestReviews(8583, "svc-api", "main", &gh.PullRequestReviewsEnforcement{
+					RequiredApprovingReviewCount: 1,
+					BypassPullRequestAllowances: &gh.BypassPullRequestAllowances{
+						Teams: []*gh.Team{{ID: gh.Int64(3), Slug: gh.String("team3")}},
+					},
+				}).
+				Start()
+			t.Cleanup(server.Close)
+			teams := github.NewTeamReadWriter(github.NewStaticTokenSource("token"), server.Client(), nil)
+
+			err := SyncBranchAllowances(context.Background(), teams, &api.GroupMappings{Mappings: tc.mappings}, tc.dryRun)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(server.PushTeams(8583, "svc-api", "main"), tc.wantPush); diff != "" {
+				t.Errorf("unexpected push teams (-got, +want):\n%s", diff)
+			}
+			var gotBypass []string
+			for _, team := range server.PullRequestReviews(8583, "svc-api", "main").BypassPullRequestAllowances.Teams {
+				gotBypass = append(gotBypass, team.GetSlug())
+			}
+			if diff := cmp.Diff(gotBypass, tc.wantBypass); diff != "" {
+				t.Errorf("unexpected bypass teams (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
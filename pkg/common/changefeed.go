@@ -0,0 +1,77 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/changefeed"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// NewChangeSink creates the sink of the applied membership changes
+// configured by the given change feed config.
+func NewChangeSink(ctx context.Context, config *api.ChangeFeedConfig) (groupsync.ChangeSink, error) {
+	switch sink := config.GetSink().(type) {
+	case *api.ChangeFeedConfig_Pubsub:
+		if sink.Pubsub.GetTopic() == "" {
+			return nil, fmt.Errorf("pubsub change feed needs a topic")
+		}
+		return changefeed.NewPubSubSink(sink.Pubsub.GetTopic()), nil
+	case *api.ChangeFeedConfig_Kafka:
+		if sink.Kafka.GetRestProxyUrl() == "" || sink.Kafka.GetTopic() == "" {
+			return nil, fmt.Errorf("kafka change feed needs a rest_proxy_url and a topic")
+		}
+		var opts []changefeed.Opt
+		if sink.Kafka.GetUsername() != "" {
+			password, err := secret(ctx, sink.Kafka.GetPassword())
+			if err != nil {
+				return nil, fmt.Errorf("failed to read kafka password: %w", err)
+			}
+			opts = append(opts, changefeed.WithBasicAuth(sink.Kafka.GetUsername(), string(password)))
+		}
+		return changefeed.NewKafkaSink(sink.Kafka.GetRestProxyUrl(), sink.Kafka.GetTopic(), opts...), nil
+	case *api.ChangeFeedConfig_Webhook:
+		if sink.Webhook.GetUrl() == "" {
+			return nil, fmt.Errorf("webhook change feed needs a url")
+		}
+		var opts []changefeed.Opt
+		if sink.Webhook.GetSigningSecret() != nil {
+			signingSecret, err := secret(ctx, sink.Webhook.GetSigningSecret())
+			if err != nil {
+				return nil, fmt.Errorf("failed to read webhook signing secret: %w", err)
+			}
+			opts = append(opts, changefeed.WithSigningSecret(signingSecret))
+		}
+		return changefeed.NewWebhookSink(sink.Webhook.GetUrl(), opts...), nil
+	default:
+		return nil, fmt.Errorf("change feed has no sink")
+	}
+}
+
+// changeSinks publishes changes to each of its sinks.
+type changeSinks []groupsync.ChangeSink
+
+// Publish publishes the given events to every sink, even if some fail.
+func (s changeSinks) Publish(ctx context.Context, events []*groupsync.ChangeEvent) error {
+	var merr error
+	for _, sink := range s {
+		merr = errors.Join(merr, sink.Publish(ctx, events))
+	}
+	return merr
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/changefeed"
+)
+
+func TestNewChangeSink(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		config   *api.ChangeFeedConfig
+		wantType any
+		wantErr  string
+	}{
+		{
+			name: "pubsub",
+			config: &api.ChangeFeedConfig{Sink: &api.ChangeFeedConfig_Pubsub{
+				Pubsub: &api.PubSubSink{Topic: "projects/p/topics/changes"},
+			}},
+			wantType: &changefeed.PubSubSink{},
+		},
+		{
+			name: "kafka",
+			config: &api.ChangeFeedConfig{Sink: &api.ChangeFeedConfig_Kafka{
+				Kafka: &api.KafkaSink{RestProxyUrl: "https://kafka-rest.example.com", Topic: "changes"},
+			}},
+			wantType: &changefeed.KafkaSink{},
+		},
+		{
+			name: "webhook",
+			config: &api.ChangeFeedConfig{Sink: &api.ChangeFeedConfig_Webhook{
+				Webhook: &api.WebhookSink{Url: "https://hooks.example.com/team-link"},
+			}},
+			wantType: &changefeed.WebhookSink{},
+		},
+		{
+			name: "pubsub_no_topic",
+			config: &api.ChangeFeedConfig{Sink: &api.ChangeFeedConfig_Pubsub{
+				Pubsub: &api.PubSubSink{},
+			}},
+			wantErr: "pubsub change feed needs a topic",
+		},
+		{
+			name: "kafka_no_topic",
+			config: &api.ChangeFeedConfig{Sink: &api.ChangeFeedConfig_Kafka{
+				Kafka: &api.KafkaSink{RestProxyUrl: "https://kafka-rest.example.com"},
+			}},
+			wantErr: "kafka change feed needs a rest_proxy_url and a topic",
+		},
+		{
+			name: "webhook_missing_secret",
+			config: &api.ChangeFeedConfig{Sink: &api.ChangeFeedConfig_Webhook{
+				Webhook: &api.WebhookSink{
+					Url:           "https://hooks.example.com/team-link",
+					SigningSecret: &api.StaticToken{FromEnvironment: "TEAM_LINK_TEST_UNSET_WEBHOOK_SECRET"},
+				},
+			}},
+			wantErr: "failed to read webhook signing secret",
+		},
+		{
+			name:    "no_sink",
+			config:  &api.ChangeFeedConfig{},
+			wantErr: "change feed has no sink",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewChangeSink(context.Background(), tc.config)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			if tc.wantType != nil && reflect.TypeOf(got) != reflect.TypeOf(tc.wantType) {
+				t.Errorf("NewChangeSink got %T, want %T", got, tc.wantType)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"slices"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+)
+
+// RoleConflict is a target user which user mappings map to with different
+// roles.
+type RoleConflict struct {
+	TargetUser string
+	// Roles are the roles of the target user, in the order of the mappings.
+	Roles []string
+	// Sources are the source users of the mappings, in the same order.
+	Sources []string
+}
+
+// RoleConflicts returns the target users, including additional targets, which
+// the given user mappings map to with different roles, in the order of the
+// mappings. Syncs resolve such conflicts with their role conflict policy when
+// the source users are members of the same target group.
+func RoleConflicts(um *api.UserMappings) []*RoleConflict {
+	var order []string
+	byTarget := make(map[string]*RoleConflict)
+	add := func(source, target, role string) {
+		if source == "" || target == "" {
+			return
+		}
+		c, ok := byTarget[target]
+		if !ok {
+			c = &RoleConflict{TargetUser: target}
+			byTarget[target] = c
+			order = append(order, target)
+		}
+		c.Roles = append(c.Roles, role)
+		c.Sources = append(c.Sources, source)
+	}
+	for _, m := range um.GetMappings() {
+		add(m.GetSource(), m.GetTarget(), m.GetTargetRole())
+		for _, t := range m.GetAdditionalTargets() {
+			add(m.GetSource(), t.GetId(), t.GetRole())
+		}
+	}
+
+	var conflicts []*RoleConflict
+	for _, target := range order {
+		c := byTarget[target]
+		roles := slices.Clone(c.Roles)
+		slices.Sort(roles)
+		if len(slices.Compact(roles)) > 1 {
+			conflicts = append(conflicts, c)
+		}
+	}
+	return conflicts
+}
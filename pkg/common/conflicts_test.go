@@ -0,0 +1,50 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+)
+
+func TestRoleConflicts(t *testing.T) {
+	t.Parallel()
+
+	got := RoleConflicts(&api.UserMappings{
+		Mappings: []*api.UserMapping{
+			{Source: "a@example.com", Target: "a"},
+			{Source: "a.alias@example.com", Target: "a", TargetRole: "member"},
+			{Source: "b@example.com", Target: "b", TargetRole: "member"},
+			{Source: "b.alias@example.com", Target: "b", TargetRole: "maintainer"},
+			{
+				Source:            "c@example.com",
+				Target:            "c",
+				AdditionalTargets: []*api.TargetUser{{Id: "admin", Role: "maintainer"}},
+			},
+			{Source: "admin@example.com", Target: "admin", TargetRole: "owner"},
+		},
+	})
+	want := []*RoleConflict{
+		{TargetUser: "a", Roles: []string{"", "member"}, Sources: []string{"a@example.com", "a.alias@example.com"}},
+		{TargetUser: "b", Roles: []string{"member", "maintainer"}, Sources: []string{"b@example.com", "b.alias@example.com"}},
+		{TargetUser: "admin", Roles: []string{"maintainer", "owner"}, Sources: []string{"c@example.com", "admin@example.com"}},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("RoleConflicts got unexpected result (-got, +want):\n%s", diff)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// NewDerivedGroupReader wraps the reader of the source system with the
+// derived groups of the group mappings.
+func NewDerivedGroupReader(reader groupsync.GroupReader, derived []*api.DerivedGroup) (*groupsync.DerivedGroupReader, error) {
+	groups := make(map[string]groupsync.GroupExpr, len(derived))
+	var merr error
+	for i, d := range derived {
+		if d.GetId() == "" {
+			merr = errors.Join(merr, fmt.Errorf("derived group %d has no id", i))
+			continue
+		}
+		if _, ok := groups[d.GetId()]; ok {
+			merr = errors.Join(merr, fmt.Errorf("derived group id %s is not unique", d.GetId()))
+			continue
+		}
+		expr, err := groupsync.ParseGroupExpr(d.GetExpression())
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("derived group %s: %w", d.GetId(), err))
+			continue
+		}
+		groups[d.GetId()] = expr
+	}
+	if merr != nil {
+		return nil, merr
+	}
+	r, err := groupsync.NewDerivedGroupReader(reader, groups)
+	if err != nil {
+		return nil, fmt.Errorf("invalid derived groups: %w", err)
+	}
+	return r, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// NewDomainPolicy creates the member domain policy of the given source system
+// from the source config, or returns nil if there is none.
+func NewDomainPolicy(sourceSystem string, config *api.TeamLinkConfig) (*groupsync.DomainPolicy, error) {
+	mdp := config.GetSourceConfig().GetMemberDomainPolicy()
+	if mdp == nil {
+		return nil, nil //nolint:nilnil // no policy
+	}
+	if !emailIDSystems[sourceSystem] {
+		return nil, fmt.Errorf("member_domain_policy requires a source system with email user IDs, got %s", sourceSystem)
+	}
+	if len(mdp.GetAllowedDomains()) == 0 {
+		return nil, fmt.Errorf("member_domain_policy must have allowed_domains")
+	}
+	action := groupsync.DomainActionFlag
+	if mdp.GetAction() == api.MemberDomainPolicy_ACTION_EXCLUDE {
+		action = groupsync.DomainActionExclude
+	}
+	policy := groupsync.NewDomainPolicy(mdp.GetAllowedDomains(), action)
+	for _, e := range mdp.GetExceptions() {
+		if e.GetGroupId() == "" {
+			return nil, fmt.Errorf("member_domain_policy exceptions must have a group_id")
+		}
+		policy.WithException(e.GetGroupId(), e.GetAllowedDomains()...)
+	}
+	return policy, nil
+}
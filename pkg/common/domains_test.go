@@ -0,0 +1,100 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	tltypes "github.com/abcxyz/team-link/internal"
+)
+
+func TestNewDomainPolicy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name         string
+		sourceSystem string
+		policy       *api.MemberDomainPolicy
+		// allowed are the users allowed in the source group groups/vendor.
+		allowed map[string]bool
+		wantNil bool
+		wantErr string
+	}{
+		{
+			name:         "no_policy",
+			sourceSystem: tltypes.SystemTypeGitHub,
+			wantNil:      true,
+		},
+		{
+			name:         "exceptions",
+			sourceSystem: tltypes.SystemTypeGoogleGroups,
+			policy: &api.MemberDomainPolicy{
+				AllowedDomains: []string{"example.com"},
+				Action:         api.MemberDomainPolicy_ACTION_EXCLUDE,
+				Exceptions: []*api.MemberDomainException{
+					{GroupId: "groups/vendor", AllowedDomains: []string{"vendor.com"}},
+				},
+			},
+			allowed: map[string]bool{
+				"alice@example.com":    true,
+				"dave@vendor.com":      true,
+				"carol@contractor.com": false,
+			},
+		},
+		{
+			name:         "non_email_source",
+			sourceSystem: tltypes.SystemTypeGitHub,
+			policy:       &api.MemberDomainPolicy{AllowedDomains: []string{"example.com"}},
+			wantErr:      "member_domain_policy requires a source system with email user IDs, got GITHUB",
+		},
+		{
+			name:         "no_domains",
+			sourceSystem: tltypes.SystemTypeGoogleGroups,
+			policy:       &api.MemberDomainPolicy{},
+			wantErr:      "member_domain_policy must have allowed_domains",
+		},
+		{
+			name:         "exception_without_group",
+			sourceSystem: tltypes.SystemTypeGoogleGroups,
+			policy: &api.MemberDomainPolicy{
+				AllowedDomains: []string{"example.com"},
+				Exceptions:     []*api.MemberDomainException{{AllowedDomains: []string{"vendor.com"}}},
+			},
+			wantErr: "member_domain_policy exceptions must have a group_id",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := &api.TeamLinkConfig{SourceConfig: &api.SourceConfig{MemberDomainPolicy: tc.policy}}
+			got, err := NewDomainPolicy(tc.sourceSystem, config)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if tc.wantNil && got != nil {
+				t.Errorf("NewDomainPolicy got %v, want nil", got)
+			}
+			for user, want := range tc.allowed {
+				if got := got.Allowed("groups/vendor", user); got != want {
+					t.Errorf("Allowed(%q) got %t, want %t", user, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+)
+
+// gitHubEnvironment identifies a deployment environment of a repository of an
+// org.
+type gitHubEnvironment struct {
+	orgID       int64
+	repo        string
+	environment string
+}
+
+// environmentReviewers returns the IDs of the GitHub teams of the group
+// mappings which are required reviewers of each deployment environment, sorted
+// by environment.
+func environmentReviewers(gm *api.GroupMappings) (map[gitHubEnvironment][]int64, error) {
+	var merr error
+	reviewers := make(map[gitHubEnvironment][]int64)
+	for _, m := range gm.GetMappings() {
+		team := gitHubTeam(m)
+		for _, e := range team.GetReviewerEnvironments() {
+			if e.GetRepo() == "" || e.GetEnvironment() == "" {
+				merr = errors.Join(merr, fmt.Errorf("reviewer_environments of team %d:%d need a repo and an environment",
+					team.GetOrgId(), team.GetTeamId()))
+				continue
+			}
+			env := gitHubEnvironment{orgID: team.GetOrgId(), repo: e.GetRepo(), environment: e.GetEnvironment()}
+			if !slices.Contains(reviewers[env], team.GetTeamId()) {
+				reviewers[env] = append(reviewers[env], team.GetTeamId())
+			}
+		}
+	}
+	if merr != nil {
+		return nil, merr
+	}
+	return reviewers, nil
+}
+
+// SyncEnvironmentReviewers makes the GitHub teams of the group mappings the
+// required reviewers of the deployment environments declared for them. The
+// reviewers of an environment are exactly the teams declaring it, and
+// environments declared by no team are left alone. With dryRun the changes
+// are only logged. Environments which fail to sync do not stop the others and
+// their errors are returned.
+func SyncEnvironmentReviewers(ctx context.Context, teams *github.TeamReadWriter, gm *api.GroupMappings, dryRun bool) error {
+	reviewers, err := environmentReviewers(gm)
+	if err != nil {
+		return fmt.Errorf("invalid reviewer_environments: %w", err)
+	}
+	envs := make([]gitHubEnvironment, 0, len(reviewers))
+	for env := range reviewers {
+		envs = append(envs, env)
+	}
+	slices.SortFunc(envs, func(a, b gitHubEnvironment) int {
+		return cmp.Or(cmp.Compare(a.orgID, b.orgID), cmp.Compare(a.repo, b.repo), cmp.Compare(a.environment, b.environment))
+	})
+
+	var merr error
+	for _, env := range envs {
+		teamIDs := reviewers[env]
+		slices.Sort(teamIDs)
+		if _, err := teams.SyncEnvironmentReviewers(ctx, env.orgID, env.repo, env.environment, teamIDs, dryRun); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to sync reviewers of environment %s of repo %s in org %d: %w",
+				env.environment, env.repo, env.orgID, err))
+		}
+	}
+	return merr
+}
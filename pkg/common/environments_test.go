@@ -0,0 +1,131 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gh "github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/githubtest"
+)
+
+func TestSyncEnvironmentReviewers(t *testing.T) {
+	t.Parallel()
+
+	mapping := func(teamID int64, envs ...*api.GitHubEnvironment) *api.GroupMapping {
+		return &api.GroupMapping{
+			Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/" + strconv.FormatInt(teamID, 10)}},
+			Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 8583, TeamId: teamID, ReviewerEnvironments: envs}},
+		}
+	}
+	production := &api.GitHubEnvironment{Repo: "svc-api", Environment: "production"}
+	staging := &api.GitHubEnvironment{Repo: "svc-api", Environment: "staging"}
+
+	cases := []struct {
+		name          string
+		mappings      []*api.GroupMapping
+		dryRun        bool
+		wantReviewers map[string][]int64
+		wantErr       string
+	}{
+		{
+			name: "success",
+			mappings: []*api.GroupMapping{
+				mapping(2, production),
+				mapping(1, production, staging),
+				mapping(3),
+			},
+			wantReviewers: map[string][]int64{
+				"production": {1, 2},
+				"staging":    {1},
+			},
+		},
+		{
+			name:     "dry_run",
+			mappings: []*api.GroupMapping{mapping(1, production, staging)},
+			dryRun:   true,
+			wantReviewers: map[string][]int64{
+				"production": {3},
+			},
+		},
+		{
+			name:     "invalid_environment",
+			mappings: []*api.GroupMapping{mapping(1, production, &api.GitHubEnvironment{Repo: "svc-api"})},
+			wantReviewers: map[string][]int64{
+				"production": {3},
+			},
+			wantErr: "reviewer_environments of team 8583:1 need a repo and an environment",
+		},
+		{
+			name: "unknown_environment",
+			mappings: []*api.GroupMapping{
+				mapping(1, production, &api.GitHubEnvironment{Repo: "svc-api", Environment: "qa"}),
+			},
+			wantReviewers: map[string][]int64{
+				"production": {1},
+			},
+			wantErr: "failed to sync reviewers of environment qa of repo svc-api in org 8583",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := githubtest.NewBuilder().
+				WithOrg(8583, "org1").
+				WithTeam(8583, &gh.Team{ID: gh.Int64(1)}).
+				WithTeam(8583, &gh.Team{ID: gh.Int64(2)}).
+				WithTeam(8583, &gh.Team{ID: gh.Int64(3)}).
+				WithRepo(8583, &gh.Repository{Name: gh.String("svc-api")}).
+				WithEnvironment(8583, "svc-api", &gh.Environment{
+					Name: gh.String("production"),
+					ProtectionRules: []*gh.ProtectionRule{{
+						Type:      gh.String("required_reviewers"),
+						Reviewers: []*gh.RequiredReviewer{{Type: gh.String("Team"), Reviewer: &gh.Team{ID: gh.Int64(3)}}},
+					}},
+				}).
+				WithEnvironment(8583, "svc-api", &gh.Environment{Name: gh.String("staging")}).
+				Start()
+			t.Cleanup(server.Close)
+			teams := github.NewTeamReadWriter(github.NewStaticTokenSource("token"), server.Client(), nil)
+
+			err := SyncEnvironmentReviewers(context.Background(), teams, &api.GroupMappings{Mappings: tc.mappings}, tc.dryRun)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			got := make(map[string][]int64)
+			for _, name := range []string{"production", "staging"} {
+				for _, rule := range server.Environment(8583, "svc-api", name).ProtectionRules {
+					for _, r := range rule.Reviewers {
+						if team, ok := r.Reviewer.(*gh.Team); ok {
+							got[name] = append(got[name], team.GetID())
+						}
+					}
+				}
+			}
+			if diff := cmp.Diff(got, tc.wantReviewers); diff != "" {
+				t.Errorf("unexpected environment reviewers (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
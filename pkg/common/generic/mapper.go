@@ -0,0 +1,143 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package generic provides mapping between the groups of any source and
+// target system whose group IDs can be read from a group mapping, for the
+// systems which need no mapping logic of their own.
+package generic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/abcxyz/pkg/logging"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// GroupIDFunc returns the ID of a group of a mapping, in the form used by
+// the group reader or writer of its system, or false if the mapping has no
+// such group.
+type GroupIDFunc func(m *api.GroupMapping) (string, bool)
+
+// GroupMapper implements groupsync.OneToManyGroupMapper.
+type GroupMapper struct {
+	mappings map[string][]string
+}
+
+func (m *GroupMapper) AllGroupIDs(ctx context.Context) ([]string, error) {
+	res := make([]string, 0, len(m.mappings))
+	for key := range m.mappings {
+		res = append(res, key)
+	}
+	slices.Sort(res)
+	return res, nil
+}
+
+func (m *GroupMapper) ContainsGroupID(ctx context.Context, key string) (bool, error) {
+	_, ok := m.mappings[key]
+	return ok, nil
+}
+
+func (m *GroupMapper) MappedGroupIDs(ctx context.Context, key string) ([]string, error) {
+	x, ok := m.mappings[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: no mapping found for group ID: %s", groupsync.ErrGroupMappingNotFound, key)
+	}
+	return slices.Clone(x), nil
+}
+
+type BiDirectionalGroupMapper struct {
+	SourceMapper *GroupMapper
+	TargetMapper *GroupMapper
+}
+
+// NewBidirectionalGroupMapper creates mappers between the source and target
+// groups of the given mappings. Mappings without a source or target group
+// are ignored.
+func NewBidirectionalGroupMapper(mappings *api.GroupMappings, sourceGroupID, targetGroupID GroupIDFunc) *BiDirectionalGroupMapper {
+	srcToDst := make(map[string][]string)
+	dstToSrc := make(map[string][]string)
+	for _, m := range mappings.GetMappings() {
+		src, ok := sourceGroupID(m)
+		if !ok {
+			continue
+		}
+		dst, ok := targetGroupID(m)
+		if !ok {
+			continue
+		}
+		srcToDst[src] = append(srcToDst[src], dst)
+		dstToSrc[dst] = append(dstToSrc[dst], src)
+	}
+	return &BiDirectionalGroupMapper{
+		SourceMapper: &GroupMapper{mappings: srcToDst},
+		TargetMapper: &GroupMapper{mappings: dstToSrc},
+	}
+}
+
+// UserMapper implements groupsync.MultiUserMapper.
+type UserMapper struct {
+	mappings map[string][]*groupsync.MappedUser
+}
+
+func (m *UserMapper) MappedUserID(ctx context.Context, userID string) (string, error) {
+	v, ok := m.mappings[userID]
+	if !ok {
+		return "", groupsync.ErrTargetUserIDNotFound
+	}
+	return v[0].ID, nil
+}
+
+// MappedUsers returns the target user of the given source user followed by
+// its additional target users, e.g. an admin account.
+func (m *UserMapper) MappedUsers(ctx context.Context, userID string) ([]*groupsync.MappedUser, error) {
+	v, ok := m.mappings[userID]
+	if !ok {
+		return nil, groupsync.ErrTargetUserIDNotFound
+	}
+	return v, nil
+}
+
+// NewUserMapper creates a UserMapper from the source user IDs, including
+// source aliases, of the given mappings to their target user IDs, including
+// additional targets.
+func NewUserMapper(ctx context.Context, mappings *api.UserMappings) *UserMapper {
+	logger := logging.FromContext(ctx)
+
+	m := make(map[string][]*groupsync.MappedUser)
+	for _, mapping := range mappings.GetMappings() {
+		dst := mapping.GetTarget()
+		targets := []*groupsync.MappedUser{{ID: dst, Role: mapping.GetTargetRole()}}
+		for _, t := range mapping.GetAdditionalTargets() {
+			if t.GetId() != "" {
+				targets = append(targets, &groupsync.MappedUser{ID: t.GetId(), Role: t.GetRole()})
+			}
+		}
+		for _, src := range append([]string{mapping.GetSource()}, mapping.GetSourceAliases()...) {
+			if src == "" || dst == "" {
+				continue
+			}
+			if existing, ok := m[src]; ok && existing[0].ID != dst {
+				logger.WarnContext(ctx, "duplicate target user mapped for same source user",
+					"source_user", src,
+					"target_users", []string{existing[0].ID, dst},
+				)
+			}
+			m[src] = targets
+		}
+	}
+	return &UserMapper{mappings: m}
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestNewBidirectionalGroupMapper(t *testing.T) {
+	t.Parallel()
+
+	mappings := &api.GroupMappings{
+		Mappings: []*api.GroupMapping{
+			{
+				Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/a"}},
+				Target: &api.GroupMapping_Gerrit{Gerrit: &api.Gerrit{GroupId: "uuid-1"}},
+			},
+			{
+				Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/a"}},
+				Target: &api.GroupMapping_Gerrit{Gerrit: &api.Gerrit{GroupId: "uuid-2"}},
+			},
+			{
+				// not a gerrit target
+				Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/b"}},
+				Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 1, TeamId: 2}},
+			},
+		},
+	}
+	sourceGroupID := func(m *api.GroupMapping) (string, bool) {
+		return m.GetGoogleGroups().GetGroupId(), m.GetGoogleGroups() != nil
+	}
+	targetGroupID := func(m *api.GroupMapping) (string, bool) {
+		return m.GetGerrit().GetGroupId(), m.GetGerrit() != nil
+	}
+
+	got := NewBidirectionalGroupMapper(mappings, sourceGroupID, targetGroupID)
+	if diff := cmp.Diff(got.SourceMapper.mappings, map[string][]string{"groups/a": {"uuid-1", "uuid-2"}}); diff != "" {
+		t.Errorf("unexpected source mappings (-got, +want):\n%s", diff)
+	}
+	if diff := cmp.Diff(got.TargetMapper.mappings, map[string][]string{"uuid-1": {"groups/a"}, "uuid-2": {"groups/a"}}); diff != "" {
+		t.Errorf("unexpected target mappings (-got, +want):\n%s", diff)
+	}
+	if _, err := got.SourceMapper.MappedGroupIDs(context.Background(), "groups/b"); !errors.Is(err, groupsync.ErrGroupMappingNotFound) {
+		t.Errorf("MappedGroupIDs(groups/b) got err %v, want %v", err, groupsync.ErrGroupMappingNotFound)
+	}
+}
+
+func TestUserMapper(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	m := NewUserMapper(ctx, &api.UserMappings{
+		Mappings: []*api.UserMapping{
+			{
+				Source:            "alice@example.com",
+				Target:            "alice",
+				SourceAliases:     []string{"alice@contractor.example.com"},
+				AdditionalTargets: []*api.TargetUser{{Id: "alice-admin", Role: "owner"}},
+			},
+			{Source: "bob@example.com", Target: ""},
+		},
+	})
+
+	for _, src := range []string{"alice@example.com", "alice@contractor.example.com"} {
+		got, err := m.MappedUsers(ctx, src)
+		if err != nil {
+			t.Fatalf("MappedUsers(%s) failed: %v", src, err)
+		}
+		want := []*groupsync.MappedUser{{ID: "alice"}, {ID: "alice-admin", Role: "owner"}}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("MappedUsers(%s) got unexpected result (-got, +want):\n%s", src, diff)
+		}
+	}
+	if _, err := m.MappedUserID(ctx, "bob@example.com"); !errors.Is(err, groupsync.ErrTargetUserIDNotFound) {
+		t.Errorf("MappedUserID of unmapped user got err %v, want %v", err, groupsync.ErrTargetUserIDNotFound)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// package gitlabgithub provides mapping between GitLab and GitHub in either
+// direction, e.g. to keep both consistent during a platform migration.
+package gitlabgithub
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/abcxyz/pkg/logging"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// GroupMapper implements groupsync.OneToManyGroupMapper.
+type GroupMapper struct {
+	mappings map[string][]string
+}
+
+func (m *GroupMapper) AllGroupIDs(ctx context.Context) ([]string, error) {
+	res := make([]string, 0, len(m.mappings))
+	for key := range m.mappings {
+		res = append(res, key)
+	}
+	slices.Sort(res)
+	return res, nil
+}
+
+func (m *GroupMapper) ContainsGroupID(ctx context.Context, key string) (bool, error) {
+	_, ok := m.mappings[key]
+	return ok, nil
+}
+
+func (m *GroupMapper) MappedGroupIDs(ctx context.Context, key string) ([]string, error) {
+	x, ok := m.mappings[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: no mapping found for group ID: %s", groupsync.ErrGroupMappingNotFound, key)
+	}
+	return slices.Clone(x), nil
+}
+
+type BiDirectionalGroupMapper struct {
+	SourceMapper *GroupMapper
+	TargetMapper *GroupMapper
+}
+
+// NewBidirectionalGroupMapper creates mappers between GitLab groups and GitHub
+// teams. A GitLab group and a GitHub team may each be given as either side of
+// a mapping. When gitHubSource is true GitHub teams are the source groups,
+// otherwise GitLab groups are. Mappings which do not pair a GitLab group with
+// a GitHub team are ignored.
+func NewBidirectionalGroupMapper(mappings *api.GroupMappings, gitHubSource bool) *BiDirectionalGroupMapper {
+	glToGH := make(map[string][]string)
+	ghToGL := make(map[string][]string)
+	for _, m := range mappings.GetMappings() {
+		gitLabGroupID, gitHubTeamID, ok := Pair(m)
+		if !ok {
+			continue
+		}
+		glToGH[gitLabGroupID] = append(glToGH[gitLabGroupID], gitHubTeamID)
+		ghToGL[gitHubTeamID] = append(ghToGL[gitHubTeamID], gitLabGroupID)
+	}
+	if gitHubSource {
+		return &BiDirectionalGroupMapper{
+			SourceMapper: &GroupMapper{mappings: ghToGL},
+			TargetMapper: &GroupMapper{mappings: glToGH},
+		}
+	}
+	return &BiDirectionalGroupMapper{
+		SourceMapper: &GroupMapper{mappings: glToGH},
+		TargetMapper: &GroupMapper{mappings: ghToGL},
+	}
+}
+
+// Pair returns the GitLab group ID and GitHub team ID of a mapping, in the
+// form used by the GitLab and GitHub group readers and writers.
+func Pair(m *api.GroupMapping) (string, string, bool) {
+	gl := m.GetGitlab()
+	if gl == nil {
+		gl = m.GetSourceGitlab()
+	}
+	gh := m.GetGithub()
+	if gh == nil {
+		gh = m.GetSourceGithub()
+	}
+	if gl == nil || gh == nil {
+		return "", "", false
+	}
+	return strconv.FormatInt(gl.GetGroupId(), 10), github.Encode(gh.GetOrgId(), gh.GetTeamId()), true
+}
+
+// UserMapper implements groupsync.UserMapper.
+type UserMapper struct {
+	mappings map[string][]*groupsync.MappedUser
+}
+
+func (m *UserMapper) MappedUserID(ctx context.Context, userID string) (string, error) {
+	v, ok := m.mappings[userID]
+	if !ok {
+		return "", groupsync.ErrTargetUserIDNotFound
+	}
+	return v[0].ID, nil
+}
+
+// MappedUsers returns the target user of the given source user followed by
+// its additional target users, e.g. an admin account.
+func (m *UserMapper) MappedUsers(ctx context.Context, userID string) ([]*groupsync.MappedUser, error) {
+	v, ok := m.mappings[userID]
+	if !ok {
+		return nil, groupsync.ErrTargetUserIDNotFound
+	}
+	return v, nil
+}
+
+// NewUserMapper creates a UserMapper from the source usernames, including
+// source aliases, of the given mappings to their target usernames, including
+// additional targets.
+func NewUserMapper(ctx context.Context, mappings *api.UserMappings) *UserMapper {
+	logger := logging.FromContext(ctx)
+
+	m := make(map[string][]*groupsync.MappedUser)
+	for _, mapping := range mappings.GetMappings() {
+		dst := mapping.GetTarget()
+		targets := []*groupsync.MappedUser{{ID: dst, Role: mapping.GetTargetRole()}}
+		for _, t := range mapping.GetAdditionalTargets() {
+			if t.GetId() != "" {
+				targets = append(targets, &groupsync.MappedUser{ID: t.GetId(), Role: t.GetRole()})
+			}
+		}
+		for _, src := range append([]string{mapping.GetSource()}, mapping.GetSourceAliases()...) {
+			if src == "" || dst == "" {
+				continue
+			}
+			if existing, ok := m[src]; ok && existing[0].ID != dst {
+				logger.WarnContext(ctx, "duplicate target user mapped for same source user",
+					"source_user", src,
+					"target_users", []string{existing[0].ID, dst},
+				)
+			}
+			m[src] = targets
+		}
+	}
+	return &UserMapper{mappings: m}
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabgithub
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestNewBidirectionalGroupMapper(t *testing.T) {
+	t.Parallel()
+
+	mappings := &api.GroupMappings{
+		Mappings: []*api.GroupMapping{
+			{
+				Source: &api.GroupMapping_SourceGitlab{SourceGitlab: &api.GitLab{GroupId: 7}},
+				Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 1, TeamId: 2}},
+			},
+			{
+				Source: &api.GroupMapping_SourceGithub{SourceGithub: &api.GitHub{OrgId: 1, TeamId: 3}},
+				Target: &api.GroupMapping_Gitlab{Gitlab: &api.GitLab{GroupId: 7}},
+			},
+			{
+				// not a GitLab and GitHub pair
+				Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/a"}},
+				Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 1, TeamId: 4}},
+			},
+		},
+	}
+	gitLabToGitHub := map[string][]string{"7": {"1:2", "1:3"}}
+	gitHubToGitLab := map[string][]string{"1:2": {"7"}, "1:3": {"7"}}
+
+	cases := []struct {
+		name         string
+		gitHubSource bool
+		wantSource   map[string][]string
+		wantTarget   map[string][]string
+	}{
+		{
+			name:       "gitlab_source",
+			wantSource: gitLabToGitHub,
+			wantTarget: gitHubToGitLab,
+		},
+		{
+			name:         "github_source",
+			gitHubSource: true,
+			wantSource:   gitHubToGitLab,
+			wantTarget:   gitLabToGitHub,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewBidirectionalGroupMapper(mappings, tc.gitHubSource)
+			if diff := cmp.Diff(got.SourceMapper.mappings, tc.wantSource); diff != "" {
+				t.Errorf("unexpected source mappings (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(got.TargetMapper.mappings, tc.wantTarget); diff != "" {
+				t.Errorf("unexpected target mappings (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserMapper(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	m := NewUserMapper(ctx, &api.UserMappings{
+		Mappings: []*api.UserMapping{
+			{Source: "gl-alice", Target: "gh-alice", SourceAliases: []string{"gl-alice-contractor"}},
+			{Source: "gl-bob", Target: ""},
+		},
+	})
+
+	for _, src := range []string{"gl-alice", "gl-alice-contractor"} {
+		got, err := m.MappedUserID(ctx, src)
+		if err != nil {
+			t.Fatalf("MappedUserID(%s) failed: %v", src, err)
+		}
+		if got != "gh-alice" {
+			t.Errorf("MappedUserID(%s) got %q, want %q", src, got, "gh-alice")
+		}
+	}
+	if _, err := m.MappedUserID(ctx, "gl-bob"); !errors.Is(err, groupsync.ErrTargetUserIDNotFound) {
+		t.Errorf("MappedUserID of unmapped user got err %v, want %v", err, groupsync.ErrTargetUserIDNotFound)
+	}
+}
+
+func TestUserMapper_MappedUsers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	m := NewUserMapper(ctx, &api.UserMappings{
+		Mappings: []*api.UserMapping{
+			{
+				Source:            "gl-alice",
+				Target:            "gh-alice",
+				AdditionalTargets: []*api.TargetUser{{Id: "gh-alice-admin", Role: "maintainer"}},
+			},
+		},
+	})
+
+	got, err := m.MappedUsers(ctx, "gl-alice")
+	if err != nil {
+		t.Fatalf("MappedUsers failed: %v", err)
+	}
+	want := []*groupsync.MappedUser{
+		{ID: "gh-alice"},
+		{ID: "gh-alice-admin", Role: "maintainer"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("MappedUsers got unexpected result (-got, +want):\n%s", diff)
+	}
+	if _, err := m.MappedUsers(ctx, "gl-bob"); !errors.Is(err, groupsync.ErrTargetUserIDNotFound) {
+		t.Errorf("MappedUsers of unmapped user got err %v, want %v", err, groupsync.ErrTargetUserIDNotFound)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlegroupgithub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	gh "github.com/google/go-github/v61/github"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"github.com/abcxyz/pkg/logging"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// TeamLister lists the teams of a GitHub org, e.g. github.TeamReadWriter.
+type TeamLister interface {
+	ListTeams(ctx context.Context, orgID int64) ([]*groupsync.Group, error)
+}
+
+// GroupIDResolver resolves the email address of a Google group to its ID,
+// e.g. googlegroups.GroupReader.
+type GroupIDResolver interface {
+	LookupGroupID(ctx context.Context, email string) (string, error)
+}
+
+// Discover applies the GitHub team discovery rules of the given mappings. It
+// returns a mapping for every discovered team that has a same-named Google
+// group and is not already mapped explicitly. Each discovered mapping is
+// logged so that the effective mapping can be reviewed.
+func Discover(ctx context.Context, teams TeamLister, groups GroupIDResolver, mappings *api.GroupMappings) ([]*api.GroupMapping, error) {
+	logger := logging.FromContext(ctx)
+
+	mapped := make(map[string]struct{}, len(mappings.GetMappings()))
+	for _, m := range mappings.GetMappings() {
+		mapped[github.Encode(m.GetGithub().GetOrgId(), m.GetGithub().GetTeamId())] = struct{}{}
+	}
+
+	var discovered []*api.GroupMapping
+	var merr error
+	for _, rule := range mappings.GetGithubTeamDiscovery() {
+		pattern, err := regexp.Compile("^(?:" + rule.GetTeamSlugPattern() + ")$")
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("invalid team slug pattern %q: %w", rule.GetTeamSlugPattern(), err))
+			continue
+		}
+		orgTeams, err := teams.ListTeams(ctx, rule.GetOrgId())
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to list teams for org %d: %w", rule.GetOrgId(), err))
+			continue
+		}
+		for _, team := range orgTeams {
+			ghTeam, ok := team.Attributes.(*gh.Team)
+			if !ok || !pattern.MatchString(ghTeam.GetSlug()) {
+				continue
+			}
+			if _, ok := mapped[team.ID]; ok {
+				continue
+			}
+			email := ghTeam.GetSlug() + "@" + rule.GetGoogleGroupsDomain()
+			groupID, err := groups.LookupGroupID(ctx, email)
+			if errors.Is(err, groupsync.ErrGroupNotFound) {
+				logger.DebugContext(ctx, "no google group found for discovered team",
+					"github_team_id", team.ID,
+					"google_group_email", email,
+				)
+				continue
+			}
+			if err != nil {
+				merr = errors.Join(merr, fmt.Errorf("failed to lookup google group for team %s: %w", ghTeam.GetSlug(), err))
+				continue
+			}
+
+			mapping := &api.GroupMapping{
+				Source: &api.GroupMapping_GoogleGroups{
+					GoogleGroups: &api.GoogleGroups{GroupId: groupID},
+				},
+				Target: &api.GroupMapping_Github{
+					Github: &api.GitHub{
+						OrgId:                rule.GetOrgId(),
+						TeamId:               ghTeam.GetID(),
+						RequireUserEnableSso: rule.GetRequireUserEnableSso(),
+					},
+				},
+			}
+			logger.InfoContext(ctx, "discovered group mapping",
+				"github_team_id", team.ID,
+				"github_team_slug", ghTeam.GetSlug(),
+				"google_group_email", email,
+				"google_group_id", groupID,
+				"mapping", prototext.MarshalOptions{}.Format(mapping),
+			)
+			mapped[team.ID] = struct{}{}
+			discovered = append(discovered, mapping)
+		}
+	}
+	if len(mappings.GetGithubTeamDiscovery()) > 0 {
+		logger.InfoContext(ctx, "effective group mappings",
+			"explicit", len(mappings.GetMappings()),
+			"discovered", len(discovered),
+		)
+	}
+	return discovered, merr
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlegroupgithub
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gh "github.com/google/go-github/v61/github"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestDiscover(t *testing.T) {
+	t.Parallel()
+
+	teams := &testTeamLister{
+		teams: map[int64][]*gh.Team{
+			1: {
+				{ID: gh.Int64(10), Slug: gh.String("eng-infra")},
+				{ID: gh.Int64(11), Slug: gh.String("eng-web")},
+				{ID: gh.Int64(12), Slug: gh.String("eng-nogroup")},
+				{ID: gh.Int64(13), Slug: gh.String("sales")},
+			},
+		},
+	}
+	groups := &testGroupIDResolver{
+		ids: map[string]string{
+			"eng-infra@example.com": "groups/infra",
+			"eng-web@example.com":   "groups/web",
+			"sales@example.com":     "groups/sales",
+		},
+	}
+	discovered := func(groupID string, teamID int64, sso bool) *api.GroupMapping {
+		return &api.GroupMapping{
+			Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: groupID}},
+			Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 1, TeamId: teamID, RequireUserEnableSso: sso}},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		mappings *api.GroupMappings
+		want     []*api.GroupMapping
+		wantErr  string
+	}{
+		{
+			name: "matches_pattern",
+			mappings: &api.GroupMappings{
+				GithubTeamDiscovery: []*api.GitHubTeamDiscovery{
+					{OrgId: 1, TeamSlugPattern: "eng-.*", GoogleGroupsDomain: "example.com", RequireUserEnableSso: true},
+				},
+			},
+			want: []*api.GroupMapping{
+				discovered("groups/infra", 10, true),
+				discovered("groups/web", 11, true),
+			},
+		},
+		{
+			name: "pattern_matches_whole_slug",
+			mappings: &api.GroupMappings{
+				GithubTeamDiscovery: []*api.GitHubTeamDiscovery{
+					{OrgId: 1, TeamSlugPattern: "eng", GoogleGroupsDomain: "example.com"},
+				},
+			},
+		},
+		{
+			name: "skips_explicit_mappings",
+			mappings: &api.GroupMappings{
+				Mappings: []*api.GroupMapping{discovered("groups/other", 10, false)},
+				GithubTeamDiscovery: []*api.GitHubTeamDiscovery{
+					{OrgId: 1, TeamSlugPattern: "eng-.*", GoogleGroupsDomain: "example.com"},
+				},
+			},
+			want: []*api.GroupMapping{
+				discovered("groups/web", 11, false),
+			},
+		},
+		{
+			name: "list_error",
+			mappings: &api.GroupMappings{
+				GithubTeamDiscovery: []*api.GitHubTeamDiscovery{
+					{OrgId: 2, TeamSlugPattern: ".*", GoogleGroupsDomain: "example.com"},
+					{OrgId: 1, TeamSlugPattern: "sales", GoogleGroupsDomain: "example.com"},
+				},
+			},
+			want: []*api.GroupMapping{
+				discovered("groups/sales", 13, false),
+			},
+			wantErr: "failed to list teams for org 2",
+		},
+		{
+			name: "invalid_pattern",
+			mappings: &api.GroupMappings{
+				GithubTeamDiscovery: []*api.GitHubTeamDiscovery{
+					{OrgId: 1, TeamSlugPattern: "eng-(", GoogleGroupsDomain: "example.com"},
+				},
+			},
+			wantErr: "invalid team slug pattern",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Discover(context.Background(), teams, groups, tc.mappings)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected discovered mappings (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+type testTeamLister struct {
+	teams map[int64][]*gh.Team
+}
+
+func (l *testTeamLister) ListTeams(ctx context.Context, orgID int64) ([]*groupsync.Group, error) {
+	teams, ok := l.teams[orgID]
+	if !ok {
+		return nil, fmt.Errorf("org %d not found", orgID)
+	}
+	groups := make([]*groupsync.Group, 0, len(teams))
+	for _, team := range teams {
+		groups = append(groups, &groupsync.Group{ID: github.Encode(orgID, team.GetID()), Attributes: team})
+	}
+	return groups, nil
+}
+
+type testGroupIDResolver struct {
+	ids map[string]string
+}
+
+func (r *testGroupIDResolver) LookupGroupID(ctx context.Context, email string) (string, error) {
+	id, ok := r.ids[email]
+	if !ok {
+		return "", groupsync.ErrGroupNotFound
+	}
+	return id, nil
+}
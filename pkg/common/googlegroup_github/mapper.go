@@ -54,7 +54,7 @@ func (m *GroupMapper) ContainsGroupID(ctx context.Context, key string) (bool, er
 func (m *GroupMapper) MappedGroupIDs(ctx context.Context, key string) ([]string, error) {
 	x, ok := m.mappings[key]
 	if !ok {
-		return nil, fmt.Errorf("no mapping found for group ID: %s", key)
+		return nil, fmt.Errorf("%w: no mapping found for group ID: %s", groupsync.ErrGroupMappingNotFound, key)
 	}
 	// Make deep copy so the caller's operation on return won't change
 	// the value of this given map.
@@ -83,9 +83,9 @@ func NewBidirectionalGroupMapper(mappings *api.GroupMappings) *BiDirectionalGrou
 	}
 }
 
-// GoogleGroupGitHubUserMapper implements groupsync.UserMapper.
+// GoogleGroupGitHubUserMapper implements groupsync.MultiUserMapper.
 type GoogleGroupGitHubUserMapper struct {
-	mappings map[string]string
+	mappings map[string][]*groupsync.MappedUser
 }
 
 func (m *GoogleGroupGitHubUserMapper) MappedUserID(ctx context.Context, userID string) (string, error) {
@@ -93,38 +93,50 @@ func (m *GoogleGroupGitHubUserMapper) MappedUserID(ctx context.Context, userID s
 	if !ok {
 		return "", groupsync.ErrTargetUserIDNotFound
 	}
+	return v[0].ID, nil
+}
+
+// MappedUsers returns the github user of the given google group user followed
+// by its additional github users, e.g. an admin account.
+func (m *GoogleGroupGitHubUserMapper) MappedUsers(ctx context.Context, userID string) ([]*groupsync.MappedUser, error) {
+	v, ok := m.mappings[userID]
+	if !ok {
+		return nil, groupsync.ErrTargetUserIDNotFound
+	}
 	return v, nil
 }
 
 // NewUserMapper create a UserMapper for mapping from GoogleGroupUSer to GithubUser.
+// Several google group users, including the source aliases of a mapping, may
+// map to the same github user, and a google group user may map to several
+// github users through the additional targets of a mapping.
 func NewUserMapper(ctx context.Context, mappings *api.UserMappings) *GoogleGroupGitHubUserMapper {
 	logger := logging.FromContext(ctx)
 
-	ggToGHUserMapping := make(map[string]string)
-	ghToGGUserMapping := make(map[string]string)
+	ggToGHUserMapping := make(map[string][]*groupsync.MappedUser)
 
 	for _, mapping := range mappings.GetMappings() {
-		src, dst := mapping.GetSource(), mapping.GetTarget()
-		// skip user if they don't have google group or github that needs mappings.
-		if src == "" || dst == "" {
-			continue
-		}
-		// Check user mapping relation is 1:1.
-		if existingDst, ok := ggToGHUserMapping[src]; ok && existingDst != dst {
-			logger.WarnContext(ctx, "duplicate github user mapped for same google group user",
-				"google_group_user", src,
-				"duplicaed_github_user", strings.Join([]string{existingDst, dst}, ","),
-			)
+		dst := mapping.GetTarget()
+		targets := []*groupsync.MappedUser{{ID: dst, Role: mapping.GetTargetRole()}}
+		for _, t := range mapping.GetAdditionalTargets() {
+			if t.GetId() != "" {
+				targets = append(targets, &groupsync.MappedUser{ID: t.GetId(), Role: t.GetRole()})
+			}
 		}
-		ggToGHUserMapping[src] = dst
-
-		if existingSrc, ok := ghToGGUserMapping[dst]; ok && existingSrc != src {
-			logger.WarnContext(ctx, "duplicate google group user mapped for same github user",
-				"github_user", dst,
-				"duplicaed_github_user", strings.Join([]string{existingSrc, src}, ","),
-			)
+		for _, src := range append([]string{mapping.GetSource()}, mapping.GetSourceAliases()...) {
+			// skip user if they don't have google group or github that needs mappings.
+			if src == "" || dst == "" {
+				continue
+			}
+			// Check each google group user maps to a single github user.
+			if existing, ok := ggToGHUserMapping[src]; ok && existing[0].ID != dst {
+				logger.WarnContext(ctx, "duplicate github user mapped for same google group user",
+					"google_group_user", src,
+					"duplicaed_github_user", strings.Join([]string{existing[0].ID, dst}, ","),
+				)
+			}
+			ggToGHUserMapping[src] = targets
 		}
-		ghToGGUserMapping[dst] = src
 	}
 	return &GoogleGroupGitHubUserMapper{
 		mappings: ggToGHUserMapping,
@@ -21,6 +21,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/groupsync"
 )
 
 func TestCreateBidirectionalGroupMapper(t *testing.T) {
@@ -189,9 +190,9 @@ func TestNewUserMapper(t *testing.T) {
 				},
 			},
 			wantGoogleGroupToGitHubUserMapper: &GoogleGroupGitHubUserMapper{
-				mappings: map[string]string{
-					"src_id_1": "target_id_1",
-					"src_id_2": "target_id_2",
+				mappings: map[string][]*groupsync.MappedUser{
+					"src_id_1": {{ID: "target_id_1"}},
+					"src_id_2": {{ID: "target_id_2"}},
 				},
 			},
 		},
@@ -214,9 +215,33 @@ func TestNewUserMapper(t *testing.T) {
 				},
 			},
 			wantGoogleGroupToGitHubUserMapper: &GoogleGroupGitHubUserMapper{
-				mappings: map[string]string{
-					"src_id_1": "target_id_2",
-					"src_id_2": "target_id_2",
+				mappings: map[string][]*groupsync.MappedUser{
+					"src_id_1": {{ID: "target_id_2"}},
+					"src_id_2": {{ID: "target_id_2"}},
+				},
+			},
+		},
+		{
+			name: "success_with_additional_targets",
+			mappings: &api.UserMappings{
+				Mappings: []*api.UserMapping{
+					{
+						Source:     "src_id_1",
+						Target:     "target_id_1",
+						TargetRole: "member",
+						AdditionalTargets: []*api.TargetUser{
+							{Id: "target_id_1_admin", Role: "maintainer"},
+							{Id: ""},
+						},
+					},
+				},
+			},
+			wantGoogleGroupToGitHubUserMapper: &GoogleGroupGitHubUserMapper{
+				mappings: map[string][]*groupsync.MappedUser{
+					"src_id_1": {
+						{ID: "target_id_1", Role: "member"},
+						{ID: "target_id_1_admin", Role: "maintainer"},
+					},
 				},
 			},
 		},
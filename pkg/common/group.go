@@ -15,19 +15,259 @@
 package common
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 
 	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
 	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/auth0"
+	"github.com/abcxyz/team-link/pkg/common/generic"
+	gitlabgithub "github.com/abcxyz/team-link/pkg/common/gitlab_github"
 	googlegroupgithub "github.com/abcxyz/team-link/pkg/common/googlegroup_github"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/googlecalendar"
 	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/kubernetes"
+	"github.com/abcxyz/team-link/pkg/mattermost"
+	"github.com/abcxyz/team-link/pkg/microsoft365"
+	"github.com/abcxyz/team-link/pkg/rocketchat"
+	"github.com/abcxyz/team-link/pkg/salesforce"
+	"github.com/abcxyz/team-link/pkg/sentry"
 )
 
 // NewBidirectionalOneToManyGroupMapper creates two OneToManyGroupMapper, directions are src->target and target->src.
 func NewBidirectionalOneToManyGroupMapper(source, target string, gm *api.GroupMappings, config *api.TeamLinkConfig) (groupsync.OneToManyGroupMapper, groupsync.OneToManyGroupMapper, error) {
-	if source == tltypes.SystemTypeGoogleGroups && target == tltypes.SystemTypeGitHub {
+	switch {
+	case source == tltypes.SystemTypeGoogleGroups && target == tltypes.SystemTypeGitHub:
 		m := googlegroupgithub.NewBidirectionalGroupMapper(gm)
 		return m.SourceMapper, m.TargetMapper, nil
+	case source == tltypes.SystemTypeGitLab && target == tltypes.SystemTypeGitHub,
+		source == tltypes.SystemTypeGitHub && target == tltypes.SystemTypeGitLab:
+		m := gitlabgithub.NewBidirectionalGroupMapper(gm, source == tltypes.SystemTypeGitHub)
+		return m.SourceMapper, m.TargetMapper, nil
+	}
+	// other targets need no mapping logic of their own.
+	sourceGroupID, targetGroupID := SourceGroupIDFunc(source), TargetGroupIDFunc(target)
+	if sourceGroupID != nil && targetGroupID != nil {
+		m := generic.NewBidirectionalGroupMapper(gm, sourceGroupID, targetGroupID)
+		return m.SourceMapper, m.TargetMapper, nil
+	}
+	return nil, nil, fmt.Errorf("%w: no sync flow from source system %s to target system %s", groupsync.ErrUnsupportedSystem, source, target)
+}
+
+// SourceGroupIDFunc returns the function reading the source group ID of a
+// mapping for the given source system, or nil if it is unsupported.
+func SourceGroupIDFunc(source string) generic.GroupIDFunc {
+	switch source {
+	case tltypes.SystemTypeGoogleGroups:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetGoogleGroups().GetGroupId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeGitHub:
+		return func(m *api.GroupMapping) (string, bool) {
+			gh := m.GetSourceGithub()
+			return github.Encode(gh.GetOrgId(), gh.GetTeamId()), gh != nil
+		}
+	case tltypes.SystemTypeGitLab:
+		return func(m *api.GroupMapping) (string, bool) {
+			gl := m.GetSourceGitlab()
+			return strconv.FormatInt(gl.GetGroupId(), 10), gl != nil
+		}
+	case tltypes.SystemTypeJumpCloud:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetSourceJumpcloud().GetGroupId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeOneLogin:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetOnelogin().GetRoleId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypePingOne:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetPingone().GetGroupId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypePagerDuty:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetPagerduty().GetScheduleId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeOpsgenie:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetOpsgenie().GetScheduleId()
+			return id, id != ""
+		}
+	}
+	return nil
+}
+
+// TargetGroupIDFunc returns the function reading the target group ID of a
+// mapping for the given target system, or nil if it is unsupported.
+func TargetGroupIDFunc(target string) generic.GroupIDFunc {
+	switch target {
+	case tltypes.SystemTypeGitHub:
+		return func(m *api.GroupMapping) (string, bool) {
+			gh := m.GetGithub()
+			return github.Encode(gh.GetOrgId(), gh.GetTeamId()), gh.GetTeamId() != 0
+		}
+	case tltypes.SystemTypeGitLab:
+		return func(m *api.GroupMapping) (string, bool) {
+			gl := m.GetGitlab()
+			return strconv.FormatInt(gl.GetGroupId(), 10), gl != nil
+		}
+	case tltypes.SystemTypeGerrit:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetGerrit().GetGroupId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeSentry:
+		return func(m *api.GroupMapping) (string, bool) {
+			s := m.GetSentry()
+			return sentry.Encode(s.GetOrganization(), s.GetTeam()), s != nil
+		}
+	case tltypes.SystemTypeKubernetes:
+		return func(m *api.GroupMapping) (string, bool) {
+			b := m.GetKubernetesRoleBinding()
+			return kubernetes.Encode(b.GetNamespace(), b.GetName()), b != nil
+		}
+	case tltypes.SystemTypeVault:
+		return func(m *api.GroupMapping) (string, bool) {
+			name := m.GetVault().GetGroupName()
+			return name, name != ""
+		}
+	case tltypes.SystemTypeAuth0:
+		return func(m *api.GroupMapping) (string, bool) {
+			a := m.GetAuth0()
+			return auth0.Encode(a.GetOrganizationId(), a.GetRoleId()), a.GetOrganizationId() != ""
+		}
+	case tltypes.SystemTypeMattermost:
+		return func(m *api.GroupMapping) (string, bool) {
+			mm := m.GetMattermost()
+			switch {
+			case mm.GetTeamId() != "" && mm.GetChannelId() == "":
+				return mattermost.Encode(mattermost.Team, mm.GetTeamId()), true
+			case mm.GetChannelId() != "" && mm.GetTeamId() == "":
+				return mattermost.Encode(mattermost.Channel, mm.GetChannelId()), true
+			}
+			return "", false
+		}
+	case tltypes.SystemTypeRocketChat:
+		return func(m *api.GroupMapping) (string, bool) {
+			rc := m.GetRocketChat()
+			return rocketchat.Encode(rc.GetRoomId(), rc.GetPrivate()), rc.GetRoomId() != ""
+		}
+	case tltypes.SystemTypeZendesk:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetZendesk().GetGroupId()
+			return strconv.FormatInt(id, 10), id != 0
+		}
+	case tltypes.SystemTypeServiceNow:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetServiceNow().GetGroupSysId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeSplunk:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetSplunk().GetRole()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeLooker:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetLooker().GetGroupId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeTableau:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetTableau().GetGroupName()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeConfluence:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetConfluence().GetGroupId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeJumpCloud:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetJumpcloud().GetGroupId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeDatabricks:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetDatabricks().GetGroupId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeGoogleChat:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetGoogleChat().GetSpace()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeGoogleCalendar:
+		return func(m *api.GroupMapping) (string, bool) {
+			gc := m.GetGoogleCalendar()
+			role := gc.GetRole()
+			if role == "" {
+				role = googlecalendar.Reader
+			}
+			return googlecalendar.Encode(role, gc.GetCalendarId()), gc.GetCalendarId() != ""
+		}
+	case tltypes.SystemTypeMicrosoft365:
+		return func(m *api.GroupMapping) (string, bool) {
+			g := m.GetMicrosoft365()
+			kind := microsoft365.Members
+			if g.GetOwners() {
+				kind = microsoft365.Owners
+			}
+			return microsoft365.Encode(kind, g.GetGroupId()), g.GetGroupId() != ""
+		}
+	case tltypes.SystemTypeBox:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetBox().GetGroupId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeDropbox:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetDropbox().GetGroupId()
+			return id, id != ""
+		}
+	case tltypes.SystemTypeSalesforce:
+		return func(m *api.GroupMapping) (string, bool) {
+			s := m.GetSalesforce()
+			if id := s.GetPermissionSetId(); id != "" {
+				return salesforce.Encode(salesforce.PermissionSet, id), true
+			}
+			return salesforce.Encode(salesforce.Group, s.GetGroupId()), s.GetGroupId() != ""
+		}
+	case tltypes.SystemTypeGoogleGroups:
+		return func(m *api.GroupMapping) (string, bool) {
+			id := m.GetTargetGoogleGroups().GetGroupId()
+			return id, id != ""
+		}
+	}
+	return nil
+}
+
+// DiscoverGroupMappings applies the discovery rules of gm and appends the
+// discovered mappings to it. Discovery is only supported from Google Groups
+// to GitHub.
+func DiscoverGroupMappings(ctx context.Context, source, target string, reader groupsync.GroupReader, writer groupsync.GroupReadWriter, gm *api.GroupMappings) error {
+	if source != tltypes.SystemTypeGoogleGroups || target != tltypes.SystemTypeGitHub {
+		return fmt.Errorf("%w: group discovery from source system %s to target system %s", groupsync.ErrUnsupportedSystem, source, target)
+	}
+	resolver, ok := reader.(googlegroupgithub.GroupIDResolver)
+	if !ok {
+		return fmt.Errorf("source reader %T cannot resolve group IDs", reader)
+	}
+	lister, ok := writer.(googlegroupgithub.TeamLister)
+	if !ok {
+		return fmt.Errorf("target writer %T cannot list teams", writer)
+	}
+	discovered, err := googlegroupgithub.Discover(ctx, lister, resolver, gm)
+	gm.Mappings = append(gm.Mappings, discovered...)
+	if err != nil {
+		return fmt.Errorf("failed to discover one or more teams: %w", err)
 	}
-	return nil, nil, fmt.Errorf("unsupported sync flow from source system: %s to target system: %s", source, target)
+	return nil
 }
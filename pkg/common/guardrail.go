@@ -0,0 +1,67 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/groupssettings/v1"
+
+	"github.com/abcxyz/pkg/logging"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/googlegroups"
+)
+
+// GroupSettingsReader reads the settings of Google Groups, like
+// googlegroups.GroupReader.
+type GroupSettingsReader interface {
+	GetSettings(ctx context.Context, groupID string) (*groupssettings.Groups, error)
+}
+
+// CheckGroupSettings checks the settings of the given Google Groups against
+// the policy. Groups violating it, or whose settings cannot be read, are
+// logged, and with the FAIL action returned as an error.
+func CheckGroupSettings(ctx context.Context, reader GroupSettingsReader, groupIDs []string, policy *api.GroupSettingsPolicy) error {
+	p := &googlegroups.SettingsPolicy{
+		AllowedWhoCanJoin:    policy.GetAllowedWhoCanJoin(),
+		AllowExternalMembers: policy.GetAllowExternalMembers(),
+	}
+	logger := logging.FromContext(ctx)
+	var merr error
+	for _, groupID := range groupIDs {
+		settings, err := reader.GetSettings(ctx, groupID)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to check settings of group %s: %w", groupID, err))
+			continue
+		}
+		if violations := p.Violations(settings); len(violations) > 0 {
+			merr = errors.Join(merr, fmt.Errorf("group %s (%s) violates the settings policy: %s",
+				groupID, settings.Email, strings.Join(violations, ", ")))
+		}
+	}
+	if merr == nil {
+		return nil
+	}
+	if policy.GetAction() == api.GroupSettingsPolicy_ACTION_FAIL {
+		return merr
+	}
+	logger.WarnContext(ctx, "source groups may violate the settings policy",
+		"error", merr,
+	)
+	return nil
+}
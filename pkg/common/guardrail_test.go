@@ -0,0 +1,107 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"google.golang.org/api/groupssettings/v1"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+)
+
+type fakeSettingsReader map[string]*groupssettings.Groups
+
+func (f fakeSettingsReader) GetSettings(ctx context.Context, groupID string) (*groupssettings.Groups, error) {
+	settings, ok := f[groupID]
+	if !ok {
+		return nil, fmt.Errorf("group %s not found", groupID)
+	}
+	return settings, nil
+}
+
+func TestCheckGroupSettings(t *testing.T) {
+	t.Parallel()
+
+	reader := fakeSettingsReader{
+		"groups/invited":  {Email: "invited@example.com", WhoCanJoin: "INVITED_CAN_JOIN", AllowExternalMembers: "false"},
+		"groups/anyone":   {Email: "anyone@example.com", WhoCanJoin: "ANYONE_CAN_JOIN", AllowExternalMembers: "false"},
+		"groups/domain":   {Email: "domain@example.com", WhoCanJoin: "ALL_IN_DOMAIN_CAN_JOIN", AllowExternalMembers: "false"},
+		"groups/external": {Email: "external@example.com", WhoCanJoin: "CAN_REQUEST_TO_JOIN", AllowExternalMembers: "true"},
+	}
+
+	cases := []struct {
+		name     string
+		groupIDs []string
+		policy   *api.GroupSettingsPolicy
+		wantErr  string
+	}{
+		{
+			name:     "compliant",
+			groupIDs: []string{"groups/invited"},
+			policy:   &api.GroupSettingsPolicy{Action: api.GroupSettingsPolicy_ACTION_FAIL},
+		},
+		{
+			name:     "self_joinable_warn",
+			groupIDs: []string{"groups/invited", "groups/anyone"},
+			policy:   &api.GroupSettingsPolicy{},
+		},
+		{
+			name:     "self_joinable_fail",
+			groupIDs: []string{"groups/invited", "groups/anyone"},
+			policy:   &api.GroupSettingsPolicy{Action: api.GroupSettingsPolicy_ACTION_FAIL},
+			wantErr:  "group groups/anyone (anyone@example.com) violates the settings policy: whoCanJoin is ANYONE_CAN_JOIN",
+		},
+		{
+			name:     "domain_allowed",
+			groupIDs: []string{"groups/domain"},
+			policy: &api.GroupSettingsPolicy{
+				AllowedWhoCanJoin: []string{"INVITED_CAN_JOIN", "ALL_IN_DOMAIN_CAN_JOIN"},
+				Action:            api.GroupSettingsPolicy_ACTION_FAIL,
+			},
+		},
+		{
+			name:     "external_members_fail",
+			groupIDs: []string{"groups/external"},
+			policy:   &api.GroupSettingsPolicy{Action: api.GroupSettingsPolicy_ACTION_FAIL},
+			wantErr:  "allowExternalMembers is true",
+		},
+		{
+			name:     "external_members_allowed",
+			groupIDs: []string{"groups/external"},
+			policy:   &api.GroupSettingsPolicy{AllowExternalMembers: true, Action: api.GroupSettingsPolicy_ACTION_FAIL},
+		},
+		{
+			name:     "unreadable_settings_fail",
+			groupIDs: []string{"groups/missing"},
+			policy:   &api.GroupSettingsPolicy{Action: api.GroupSettingsPolicy_ACTION_FAIL},
+			wantErr:  "failed to check settings of group groups/missing",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := CheckGroupSettings(context.Background(), reader, tc.groupIDs, tc.policy)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/abcxyz/pkg/logging"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/gitlab"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/identity"
+)
+
+// emailIDSystems are the systems whose user IDs are emails.
+var emailIDSystems = map[string]bool{
+	tltypes.SystemTypeGoogleGroups:   true,
+	tltypes.SystemTypeAuth0:          true,
+	tltypes.SystemTypeZendesk:        true,
+	tltypes.SystemTypeLooker:         true,
+	tltypes.SystemTypeSentry:         true,
+	tltypes.SystemTypeJumpCloud:      true,
+	tltypes.SystemTypeOneLogin:       true,
+	tltypes.SystemTypePingOne:        true,
+	tltypes.SystemTypePagerDuty:      true,
+	tltypes.SystemTypeOpsgenie:       true,
+	tltypes.SystemTypeGoogleChat:     true,
+	tltypes.SystemTypeGoogleCalendar: true,
+	tltypes.SystemTypeMicrosoft365:   true,
+	tltypes.SystemTypeBox:            true,
+	tltypes.SystemTypeDropbox:        true,
+}
+
+// NewIdentityMapper creates the user mapper from the source system to the
+// target system: an identity.Mapper mapping users by the given user mapper of
+// the user mappings, and the users without a user mapping by the signals of
+// the identity config. Without signals it maps like the given user mapper.
+// The signals read the clients of the systems, the given reader and writer.
+func NewIdentityMapper(ctx context.Context, sourceSystem, targetSystem string, config *api.TeamLinkConfig, mappings *api.TeamLinkMappings,
+	userMapper groupsync.UserMapper, reader groupsync.GroupReader, writer groupsync.GroupReadWriter,
+) (*identity.Mapper, error) {
+	ic := config.GetIdentity()
+	systems := []string{sourceSystem, targetSystem}
+	hasSystem := func(system string) bool {
+		return sourceSystem == system || targetSystem == system
+	}
+	logger := logging.FromContext(ctx)
+
+	var merr error
+	var sources []identity.Source
+	if ic.GetMatchEmails() && (!emailIDSystems[sourceSystem] || !emailIDSystems[targetSystem]) {
+		merr = errors.Join(merr, fmt.Errorf("identity match_emails requires two systems with email user IDs, got %s and %s", sourceSystem, targetSystem))
+	}
+	if ic.GetGithubSamlIdentities() {
+		teams := gitHubTeamReadWriter(reader, writer)
+		switch {
+		case !hasSystem(tltypes.SystemTypeGitHub):
+			merr = errors.Join(merr, fmt.Errorf("identity github_saml_identities requires a GitHub source or target"))
+		case teams == nil:
+			logger.WarnContext(ctx, "github client unavailable, not matching users by saml identities")
+		default:
+			for _, orgID := range ManagedGitHubOrgs(mappings.GetGroupMappings()) {
+				sources = append(sources, identity.NewEmailSource("github_saml", tltypes.SystemTypeGitHub, func(ctx context.Context) (map[string]string, error) {
+					return teams.SAMLIdentities(ctx, orgID) //nolint:wrapcheck // Want passthrough
+				}))
+			}
+		}
+	}
+	if ic.GetGitlabVerifiedEmails() {
+		groups := gitLabGroupReadWriter(reader, writer)
+		switch {
+		case !hasSystem(tltypes.SystemTypeGitLab):
+			merr = errors.Join(merr, fmt.Errorf("identity gitlab_verified_emails requires a GitLab source or target"))
+		case groups == nil:
+			logger.WarnContext(ctx, "gitlab client unavailable, not matching users by verified emails")
+		default:
+			sources = append(sources, identity.NewEmailSource("gitlab_emails", tltypes.SystemTypeGitLab, groups.UserEmails))
+		}
+	}
+	if ic.GetGithubScimExternalIds() {
+		teams := gitHubTeamReadWriter(reader, writer)
+		other := otherSystem(sourceSystem, targetSystem, tltypes.SystemTypeGitHub)
+		orgIDs := ManagedGitHubOrgs(mappings.GetGroupMappings())
+		switch {
+		case !hasSystem(tltypes.SystemTypeGitHub):
+			merr = errors.Join(merr, fmt.Errorf("identity github_scim_external_ids requires a GitHub source or target"))
+		case teams == nil:
+			logger.WarnContext(ctx, "github client unavailable, not matching users by scim identities")
+		case ic.GetGithubScimEnterprise() != "" && len(orgIDs) == 0:
+			merr = errors.Join(merr, fmt.Errorf("identity github_scim_enterprise requires a GitHub org in the group mappings, whose credentials read the enterprise"))
+		case ic.GetGithubScimEnterprise() != "":
+			sources = append(sources, identity.NewMapSource("github_scim", other, tltypes.SystemTypeGitHub, func(ctx context.Context) (map[string]string, error) {
+				return teams.SCIMExternalIDs(ctx, orgIDs[0], ic.GetGithubScimEnterprise()) //nolint:wrapcheck // Want passthrough
+			}))
+		default:
+			for _, orgID := range orgIDs {
+				sources = append(sources, identity.NewMapSource("github_scim", other, tltypes.SystemTypeGitHub, func(ctx context.Context) (map[string]string, error) {
+					return teams.SCIMExternalIDs(ctx, orgID, "") //nolint:wrapcheck // Want passthrough
+				}))
+			}
+		}
+	}
+	if groupID := ic.GetGitlabScimGroupId(); groupID != 0 {
+		groups := gitLabGroupReadWriter(reader, writer)
+		other := otherSystem(sourceSystem, targetSystem, tltypes.SystemTypeGitLab)
+		switch {
+		case !hasSystem(tltypes.SystemTypeGitLab):
+			merr = errors.Join(merr, fmt.Errorf("identity gitlab_scim_group_id requires a GitLab source or target"))
+		case groups == nil:
+			logger.WarnContext(ctx, "gitlab client unavailable, not matching users by scim identities")
+		default:
+			sources = append(sources, identity.NewMapSource("gitlab_scim", other, tltypes.SystemTypeGitLab, func(ctx context.Context) (map[string]string, error) {
+				return groups.SCIMExternalIDs(ctx, strconv.FormatInt(groupID, 10)) //nolint:wrapcheck // Want passthrough
+			}))
+		}
+	}
+	if merr != nil {
+		return nil, fmt.Errorf("invalid identity config: %w", merr)
+	}
+
+	opts := make([]identity.Opt, 0, 2*len(systems))
+	for _, system := range systems {
+		opts = append(opts, identity.WithIDNormalizer(system, IDNormalizer(config, system)))
+		// users of two systems with email IDs only match by email when
+		// configured to.
+		if emailIDSystems[system] && (ic.GetMatchEmails() || !emailIDSystems[sourceSystem] || !emailIDSystems[targetSystem]) {
+			opts = append(opts, identity.WithEmailIDs(system))
+		}
+	}
+	return identity.NewMapper(userMapper, identity.NewResolver(sources, opts...), sourceSystem, targetSystem), nil
+}
+
+// otherSystem returns the one of the source and target systems which is not
+// the given system.
+func otherSystem(sourceSystem, targetSystem, system string) string {
+	if sourceSystem == system {
+		return targetSystem
+	}
+	return sourceSystem
+}
+
+// gitLabGroupReadWriter returns the GitLab client among the given reader and
+// writer, or nil if there is none.
+func gitLabGroupReadWriter(reader groupsync.GroupReader, writer groupsync.GroupReadWriter) *gitlab.GroupReadWriter {
+	if groups, ok := writer.(*gitlab.GroupReadWriter); ok {
+		return groups
+	}
+	if groups, ok := reader.(*gitlab.GroupReadWriter); ok {
+		return groups
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/common/generic"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/githubtest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestNewIdentityMapper(t *testing.T) {
+	t.Parallel()
+
+	server := githubtest.NewBuilder().
+		WithOrg(8583, "org1").
+		WithSAMLIdentity(8583, "alice@example.com", "alice").
+		WithSAMLIdentity(8583, "bob@example.com", "Bob").
+		WithSCIMIdentity(8583, "dave@example.com", "dave").
+		Start()
+	t.Cleanup(server.Close)
+	teams := github.NewTeamReadWriter(github.NewStaticTokenSource("token"), server.Client(), nil)
+
+	googleGroups := &api.SourceConfig{Config: &api.SourceConfig_GoogleGroupsConfig{GoogleGroupsConfig: &api.GoogleGroupsConfig{}}}
+	mappings := &api.TeamLinkMappings{
+		GroupMappings: &api.GroupMappings{Mappings: []*api.GroupMapping{{
+			Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 8583, TeamId: 1}},
+		}}},
+		UserMappings: &api.UserMappings{Mappings: []*api.UserMapping{
+			{Source: "alice@example.com", Target: "alice-admin"},
+		}},
+	}
+
+	cases := []struct {
+		name    string
+		target  string
+		config  *api.TeamLinkConfig
+		writer  groupsync.GroupReadWriter
+		userID  string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "user_mappings_only",
+			target: tltypes.SystemTypeGitHub,
+			config: &api.TeamLinkConfig{
+				SourceConfig: googleGroups,
+				TargetConfig: &api.TargetConfig{Config: &api.TargetConfig_GithubConfig{GithubConfig: &api.GitHubConfig{}}},
+			},
+			writer: teams,
+			userID: "bob@example.com",
+		},
+		{
+			name:   "user_mappings_first",
+			target: tltypes.SystemTypeGitHub,
+			config: &api.TeamLinkConfig{
+				SourceConfig: googleGroups,
+				TargetConfig: &api.TargetConfig{Config: &api.TargetConfig_GithubConfig{GithubConfig: &api.GitHubConfig{}}},
+				Identity:     &api.IdentityConfig{GithubSamlIdentities: true},
+			},
+			writer: teams,
+			userID: "alice@example.com",
+			want:   "alice-admin",
+		},
+		{
+			name:   "github_saml",
+			target: tltypes.SystemTypeGitHub,
+			config: &api.TeamLinkConfig{
+				SourceConfig: googleGroups,
+				TargetConfig: &api.TargetConfig{Config: &api.TargetConfig_GithubConfig{GithubConfig: &api.GitHubConfig{}}},
+				Identity:     &api.IdentityConfig{GithubSamlIdentities: true},
+			},
+			writer: teams,
+			userID: "Bob@example.com",
+			want:   "bob",
+		},
+		{
+			name:   "github_scim",
+			target: tltypes.SystemTypeGitHub,
+			config: &api.TeamLinkConfig{
+				SourceConfig: googleGroups,
+				TargetConfig: &api.TargetConfig{Config: &api.TargetConfig_GithubConfig{GithubConfig: &api.GitHubConfig{}}},
+				Identity:     &api.IdentityConfig{GithubScimExternalIds: true},
+			},
+			writer: teams,
+			userID: "dave@example.com",
+			want:   "dave",
+		},
+		{
+			name:   "match_emails",
+			target: tltypes.SystemTypeLooker,
+			config: &api.TeamLinkConfig{
+				SourceConfig: googleGroups,
+				TargetConfig: &api.TargetConfig{Config: &api.TargetConfig_LookerConfig{LookerConfig: &api.LookerConfig{}}},
+				Identity:     &api.IdentityConfig{MatchEmails: true},
+			},
+			userID: "carol@example.com",
+			want:   "carol@example.com",
+		},
+		{
+			name:   "emails_not_matched",
+			target: tltypes.SystemTypeLooker,
+			config: &api.TeamLinkConfig{
+				SourceConfig: googleGroups,
+				TargetConfig: &api.TargetConfig{Config: &api.TargetConfig_LookerConfig{LookerConfig: &api.LookerConfig{}}},
+			},
+			userID: "carol@example.com",
+		},
+		{
+			name:   "match_emails_without_email_ids",
+			target: tltypes.SystemTypeGitHub,
+			config: &api.TeamLinkConfig{
+				SourceConfig: googleGroups,
+				TargetConfig: &api.TargetConfig{Config: &api.TargetConfig_GithubConfig{GithubConfig: &api.GitHubConfig{}}},
+				Identity:     &api.IdentityConfig{MatchEmails: true},
+			},
+			wantErr: "match_emails requires two systems with email user IDs",
+		},
+		{
+			name:   "gitlab_emails_without_gitlab",
+			target: tltypes.SystemTypeGitHub,
+			config: &api.TeamLinkConfig{
+				SourceConfig: googleGroups,
+				TargetConfig: &api.TargetConfig{Config: &api.TargetConfig_GithubConfig{GithubConfig: &api.GitHubConfig{}}},
+				Identity:     &api.IdentityConfig{GitlabVerifiedEmails: true},
+			},
+			wantErr: "gitlab_verified_emails requires a GitLab source or target",
+		},
+		{
+			name:   "gitlab_scim_without_gitlab",
+			target: tltypes.SystemTypeGitHub,
+			config: &api.TeamLinkConfig{
+				SourceConfig: googleGroups,
+				TargetConfig: &api.TargetConfig{Config: &api.TargetConfig_GithubConfig{GithubConfig: &api.GitHubConfig{}}},
+				Identity:     &api.IdentityConfig{GitlabScimGroupId: 10},
+			},
+			wantErr: "gitlab_scim_group_id requires a GitLab source or target",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			overrides := generic.NewUserMapper(ctx, mappings.GetUserMappings())
+			m, err := NewIdentityMapper(ctx, tltypes.SystemTypeGoogleGroups, tc.target, tc.config, mappings, overrides, nil, tc.writer)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Fatalf("unexpected err: %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			got, err := m.MappedUserID(ctx, tc.userID)
+			if err != nil && !errors.Is(err, groupsync.ErrTargetUserIDNotFound) {
+				t.Fatalf("MappedUserID failed: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("MappedUserID(%s) got %q, want %q", tc.userID, got, tc.want)
+			}
+		})
+	}
+}
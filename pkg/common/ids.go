@@ -0,0 +1,88 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+// caseInsensitiveSystems are the systems whose IDs, logins or emails, are
+// compared case-insensitively unless configured otherwise.
+var caseInsensitiveSystems = map[string]bool{
+	tltypes.SystemTypeGitHub:         true,
+	tltypes.SystemTypeGitLab:         true,
+	tltypes.SystemTypeGoogleGroups:   true,
+	tltypes.SystemTypeSentry:         true,
+	tltypes.SystemTypeAuth0:          true,
+	tltypes.SystemTypeZendesk:        true,
+	tltypes.SystemTypeLooker:         true,
+	tltypes.SystemTypeTableau:        true,
+	tltypes.SystemTypeJumpCloud:      true,
+	tltypes.SystemTypeOneLogin:       true,
+	tltypes.SystemTypePingOne:        true,
+	tltypes.SystemTypePagerDuty:      true,
+	tltypes.SystemTypeOpsgenie:       true,
+	tltypes.SystemTypeDatabricks:     true,
+	tltypes.SystemTypeGoogleChat:     true,
+	tltypes.SystemTypeGoogleCalendar: true,
+	tltypes.SystemTypeMicrosoft365:   true,
+	tltypes.SystemTypeBox:            true,
+	tltypes.SystemTypeDropbox:        true,
+	tltypes.SystemTypeSalesforce:     true,
+}
+
+// IDNormalizer returns the normalizer of the IDs of the given system, one of
+// the systems of the config: groupsync.CaseInsensitiveIDs if its IDs are
+// compared case-insensitively and groupsync.UnicodeIDs otherwise. The id_case
+// of the system's config overrides the system's default.
+func IDNormalizer(config *api.TeamLinkConfig, system string) groupsync.IDNormalizer {
+	idCase := config.GetTargetConfig().GetIdCase()
+	if sourceSystem, _, err := utils.GetSrcTargetSystemType(config); err == nil && sourceSystem == system {
+		idCase = config.GetSourceConfig().GetIdCase()
+	}
+	switch idCase {
+	case api.IdCase_ID_CASE_INSENSITIVE:
+		return groupsync.CaseInsensitiveIDs
+	case api.IdCase_ID_CASE_SENSITIVE:
+		return groupsync.UnicodeIDs
+	}
+	if caseInsensitiveSystems[system] {
+		return groupsync.CaseInsensitiveIDs
+	}
+	return groupsync.UnicodeIDs
+}
+
+// NormalizeUserMappings returns a copy of the given user mappings with their
+// source IDs and source aliases normalized by normalize, and their target IDs
+// normalized like groupsync.UnicodeIDs.
+func NormalizeUserMappings(um *api.UserMappings, normalize groupsync.IDNormalizer) *api.UserMappings {
+	normalized, _ := proto.Clone(um).(*api.UserMappings)
+	for _, m := range normalized.GetMappings() {
+		m.Source = normalize(m.GetSource())
+		for i, alias := range m.GetSourceAliases() {
+			m.SourceAliases[i] = normalize(alias)
+		}
+		m.Target = groupsync.UnicodeIDs(m.GetTarget())
+		for _, t := range m.GetAdditionalTargets() {
+			t.Id = groupsync.UnicodeIDs(t.GetId())
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	tltypes "github.com/abcxyz/team-link/internal"
+)
+
+func TestIDNormalizer(t *testing.T) {
+	t.Parallel()
+
+	googleToGitHub := func(sourceCase, targetCase api.IdCase) *api.TeamLinkConfig {
+		return &api.TeamLinkConfig{
+			SourceConfig: &api.SourceConfig{
+				Config: &api.SourceConfig_GoogleGroupsConfig{GoogleGroupsConfig: &api.GoogleGroupsConfig{}},
+				IdCase: sourceCase,
+			},
+			TargetConfig: &api.TargetConfig{
+				Config: &api.TargetConfig_GithubConfig{GithubConfig: &api.GitHubConfig{}},
+				IdCase: targetCase,
+			},
+		}
+	}
+
+	cases := []struct {
+		name   string
+		config *api.TeamLinkConfig
+		system string
+		want   string
+	}{
+		{
+			name:   "default_insensitive_source",
+			config: googleToGitHub(api.IdCase_ID_CASE_UNSPECIFIED, api.IdCase_ID_CASE_UNSPECIFIED),
+			system: tltypes.SystemTypeGoogleGroups,
+			want:   "jos\u00e9@example.com",
+		},
+		{
+			name:   "default_insensitive_target",
+			config: googleToGitHub(api.IdCase_ID_CASE_UNSPECIFIED, api.IdCase_ID_CASE_UNSPECIFIED),
+			system: tltypes.SystemTypeGitHub,
+			want:   "jos\u00e9@example.com",
+		},
+		{
+			name:   "sensitive_source",
+			config: googleToGitHub(api.IdCase_ID_CASE_SENSITIVE, api.IdCase_ID_CASE_UNSPECIFIED),
+			system: tltypes.SystemTypeGoogleGroups,
+			want:   "Jos\u00e9@Example.com",
+		},
+		{
+			name:   "sensitive_target",
+			config: googleToGitHub(api.IdCase_ID_CASE_UNSPECIFIED, api.IdCase_ID_CASE_SENSITIVE),
+			system: tltypes.SystemTypeGitHub,
+			want:   "Jos\u00e9@Example.com",
+		},
+		{
+			name: "default_sensitive_target",
+			config: &api.TeamLinkConfig{
+				SourceConfig: &api.SourceConfig{Config: &api.SourceConfig_GoogleGroupsConfig{}},
+				TargetConfig: &api.TargetConfig{Config: &api.TargetConfig_GerritConfig{}},
+			},
+			system: tltypes.SystemTypeGerrit,
+			want:   "Jos\u00e9@Example.com",
+		},
+		{
+			name: "insensitive_target",
+			config: &api.TeamLinkConfig{
+				SourceConfig: &api.SourceConfig{Config: &api.SourceConfig_GoogleGroupsConfig{}},
+				TargetConfig: &api.TargetConfig{Config: &api.TargetConfig_GerritConfig{}, IdCase: api.IdCase_ID_CASE_INSENSITIVE},
+			},
+			system: tltypes.SystemTypeGerrit,
+			want:   "jos\u00e9@example.com",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := IDNormalizer(tc.config, tc.system)("Jose\u0301@Example.com")
+			if got != tc.want {
+				t.Errorf("normalized ID got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeUserMappings(t *testing.T) {
+	t.Parallel()
+
+	um := &api.UserMappings{Mappings: []*api.UserMapping{
+		{Source: "Alice@Example.com", SourceAliases: []string{"A.Smith@Example.com"}, Target: "Jose\u0301"},
+	}}
+	got := NormalizeUserMappings(um, func(id string) string { return "n:" + id })
+
+	want := &api.UserMappings{Mappings: []*api.UserMapping{
+		{Source: "n:Alice@Example.com", SourceAliases: []string{"n:A.Smith@Example.com"}, Target: "Jos\u00e9"},
+	}}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected mappings (-got, +want):\n%s", diff)
+	}
+	if got, want := um.GetMappings()[0].GetSource(), "Alice@Example.com"; got != want {
+		t.Errorf("given mappings changed, source got %q, want %q", got, want)
+	}
+}
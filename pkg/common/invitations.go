@@ -0,0 +1,140 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	gh "github.com/google/go-github/v61/github"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+// Actions taken on stale GitHub org invitations.
+const (
+	InvitationActionNone   = ""
+	InvitationActionCancel = "cancel"
+	InvitationActionResend = "resend"
+)
+
+// InvitationReview is a pending invitation to a GitHub org.
+type InvitationReview struct {
+	OrgID      int64
+	Invitation *gh.Invitation
+	// Age is the time since the invitation was sent.
+	Age time.Duration
+	// Stale is whether the invitation is older than the threshold.
+	Stale bool
+	// Action is the action taken on the invitation, one of the
+	// InvitationAction constants, and Err the error it failed with.
+	Action string
+	Err    error
+}
+
+// ReviewGitHubInvitations lists the pending invitations of every GitHub org
+// managed by the mappings, i.e. which has mapped teams or team discovery, and
+// flags the ones older than staleAfter at the given time. The given action is
+// taken on every stale invitation. Invitations of the same org are sorted by
+// age, oldest first. Orgs which fail to be listed are skipped and their
+// errors returned, as are the errors of failed actions.
+func ReviewGitHubInvitations(ctx context.Context, mappingFile, configFile string, now time.Time, staleAfter time.Duration, action string) ([]*InvitationReview, error) {
+	var merr error
+	mappings, err := utils.ParseMappingTextProto(ctx, mappingFile)
+	if err != nil {
+		merr = errors.Join(merr, fmt.Errorf("failed to parse mappings file: %w", err))
+	}
+	config, err := utils.ParseConfigTextProto(ctx, configFile)
+	if err != nil {
+		merr = errors.Join(merr, fmt.Errorf("failed to parse config file: %w", err))
+	}
+	if merr != nil {
+		return nil, merr
+	}
+
+	ghConfig := GitHubConfig(config)
+	if ghConfig == nil {
+		return nil, fmt.Errorf("config has no github_config")
+	}
+	rw, err := NewGitHubReadWriter(ctx, ghConfig, mappings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create github client: %w", err)
+	}
+	teams, ok := rw.(*github.TeamReadWriter)
+	if !ok {
+		return nil, fmt.Errorf("unexpected github readwriter %T", rw)
+	}
+
+	var reviews []*InvitationReview
+	for _, orgID := range ManagedGitHubOrgs(mappings.GetGroupMappings()) {
+		invitations, err := teams.ListInvitations(ctx, orgID)
+		if err != nil {
+			merr = errors.Join(merr, err)
+			continue
+		}
+		orgReviews := make([]*InvitationReview, 0, len(invitations))
+		for _, i := range invitations {
+			age := now.Sub(i.GetCreatedAt().Time)
+			orgReviews = append(orgReviews, &InvitationReview{
+				OrgID:      orgID,
+				Invitation: i,
+				Age:        age,
+				Stale:      age > staleAfter,
+			})
+		}
+		slices.SortStableFunc(orgReviews, func(a, b *InvitationReview) int {
+			return cmp.Compare(b.Age, a.Age)
+		})
+		for _, r := range orgReviews {
+			if !r.Stale || action == InvitationActionNone {
+				continue
+			}
+			r.Action = action
+			switch action {
+			case InvitationActionCancel:
+				r.Err = teams.CancelInvitation(ctx, orgID, r.Invitation.GetID())
+			case InvitationActionResend:
+				_, r.Err = teams.ResendInvitation(ctx, orgID, r.Invitation)
+			default:
+				r.Err = fmt.Errorf("unknown invitation action %q", action)
+			}
+			merr = errors.Join(merr, r.Err)
+		}
+		reviews = append(reviews, orgReviews...)
+	}
+	return reviews, merr
+}
+
+// ManagedGitHubOrgs returns the sorted IDs of the GitHub orgs of the given
+// group mappings, either side, and of their team discovery.
+func ManagedGitHubOrgs(gm *api.GroupMappings) []int64 {
+	var orgIDs []int64
+	for _, m := range gm.GetMappings() {
+		if orgID := gitHubTeam(m).GetOrgId(); orgID != 0 {
+			orgIDs = append(orgIDs, orgID)
+		}
+	}
+	for _, d := range gm.GetGithubTeamDiscovery() {
+		orgIDs = append(orgIDs, d.GetOrgId())
+	}
+	slices.Sort(orgIDs)
+	return slices.Compact(orgIDs)
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+)
+
+func TestManagedGitHubOrgs(t *testing.T) {
+	t.Parallel()
+
+	gm := &api.GroupMappings{
+		Mappings: []*api.GroupMapping{
+			{Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 8583, TeamId: 2797}}},
+			{Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 8583, TeamSlug: "team2"}}},
+			{Source: &api.GroupMapping_SourceGithub{SourceGithub: &api.GitHub{OrgId: 4701, TeamId: 1}}},
+			{Target: &api.GroupMapping_Gitlab{Gitlab: &api.GitLab{GroupId: 5}}},
+		},
+		GithubTeamDiscovery: []*api.GitHubTeamDiscovery{{OrgId: 9350}, {OrgId: 4701}},
+	}
+	if diff := cmp.Diff(ManagedGitHubOrgs(gm), []int64{4701, 8583, 9350}); diff != "" {
+		t.Errorf("ManagedGitHubOrgs got unexpected result (-got, +want):\n%s", diff)
+	}
+}
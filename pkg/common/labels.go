@@ -0,0 +1,96 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/abcxyz/pkg/logging"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// labelReservedChars cannot be used in labels, since they delimit labels in
+// the descriptions of target groups.
+const labelReservedChars = "[];,="
+
+// GroupLabels returns the labels of the group mappings, keyed by the ID of
+// their target group in the given target system. The labels of the mappings
+// sharing a target group are combined; they must not give a label different
+// values.
+func GroupLabels(targetSystem string, gm *api.GroupMappings) (map[string]map[string]string, error) {
+	var labels map[string]map[string]string
+	var merr error
+	for _, m := range gm.GetMappings() {
+		if len(m.GetLabels()) == 0 {
+			continue
+		}
+		targetID, ok := mappedGroupID(targetSystem, m)
+		if !ok {
+			continue
+		}
+		if labels == nil {
+			labels = make(map[string]map[string]string)
+		}
+		if labels[targetID] == nil {
+			labels[targetID] = make(map[string]string)
+		}
+		for _, k := range slices.Sorted(maps.Keys(m.GetLabels())) {
+			v := m.GetLabels()[k]
+			if k == "" || strings.ContainsAny(k, labelReservedChars) || strings.ContainsAny(v, labelReservedChars) {
+				merr = errors.Join(merr, fmt.Errorf("invalid label %q=%q of target group %s, labels must not be empty or contain any of %q",
+					k, v, targetID, labelReservedChars))
+				continue
+			}
+			if existing, ok := labels[targetID][k]; ok && existing != v {
+				merr = errors.Join(merr, fmt.Errorf("label %s of target group %s has conflicting values %q and %q",
+					k, targetID, existing, v))
+				continue
+			}
+			labels[targetID][k] = v
+		}
+	}
+	if merr != nil {
+		return nil, merr
+	}
+	return labels, nil
+}
+
+// SyncGroupLabels applies the given labels, keyed by target group ID, with
+// the labeler of the target system. With dryRun the labels are only logged.
+// Groups which fail to be labeled do not stop the others and their errors are
+// returned.
+func SyncGroupLabels(ctx context.Context, labeler groupsync.GroupLabeler, labels map[string]map[string]string, dryRun bool) error {
+	logger := logging.FromContext(ctx)
+	var merr error
+	for _, id := range slices.Sorted(maps.Keys(labels)) {
+		if dryRun {
+			logger.InfoContext(ctx, "would label target group",
+				"target_group_id", id,
+				"labels", labels[id],
+			)
+			continue
+		}
+		if err := labeler.SetGroupLabels(ctx, id, labels[id]); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to label target group %s: %w", id, err))
+		}
+	}
+	return merr
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	tltypes "github.com/abcxyz/team-link/internal"
+)
+
+func TestGroupLabels(t *testing.T) {
+	t.Parallel()
+
+	mapping := func(sourceID, targetID string, labels map[string]string) *api.GroupMapping {
+		return &api.GroupMapping{
+			Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: sourceID}},
+			Target: &api.GroupMapping_Gerrit{Gerrit: &api.Gerrit{GroupId: targetID}},
+			Labels: labels,
+		}
+	}
+
+	cases := []struct {
+		name     string
+		mappings []*api.GroupMapping
+		want     map[string]map[string]string
+		wantErr  string
+	}{
+		{
+			name: "combined",
+			mappings: []*api.GroupMapping{
+				mapping("groups/a", "reviewers", map[string]string{"env": "prod"}),
+				mapping("groups/b", "reviewers", map[string]string{"env": "prod", "owner": "sre"}),
+				mapping("groups/c", "leads", nil),
+			},
+			want: map[string]map[string]string{
+				"reviewers": {"env": "prod", "owner": "sre"},
+			},
+		},
+		{
+			name: "conflicting_values",
+			mappings: []*api.GroupMapping{
+				mapping("groups/a", "reviewers", map[string]string{"env": "prod"}),
+				mapping("groups/b", "reviewers", map[string]string{"env": "dev"}),
+			},
+			wantErr: `label env of target group reviewers has conflicting values "prod" and "dev"`,
+		},
+		{
+			name: "reserved_chars",
+			mappings: []*api.GroupMapping{
+				mapping("groups/a", "reviewers", map[string]string{"env": "prod; owner=sre"}),
+			},
+			wantErr: "labels must not be empty or contain any of",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := GroupLabels(tltypes.SystemTypeGerrit, &api.GroupMappings{Mappings: tc.mappings})
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected labels (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncGroupLabels(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	labels := map[string]map[string]string{
+		"leads":     {"env": "prod"},
+		"reviewers": {"owner": "sre"},
+	}
+
+	labeler := &fakeLabeler{}
+	if err := SyncGroupLabels(ctx, labeler, labels, true); err != nil {
+		t.Fatalf("SyncGroupLabels failed: %v", err)
+	}
+	if len(labeler.labels) != 0 {
+		t.Errorf("dry run labeled groups %v, want none", labeler.labels)
+	}
+
+	if err := SyncGroupLabels(ctx, labeler, labels, false); err != nil {
+		t.Fatalf("SyncGroupLabels failed: %v", err)
+	}
+	if diff := cmp.Diff(labeler.labels, labels); diff != "" {
+		t.Errorf("unexpected labels (-got, +want):\n%s", diff)
+	}
+}
+
+type fakeLabeler struct {
+	labels map[string]map[string]string
+}
+
+func (l *fakeLabeler) SetGroupLabels(ctx context.Context, groupID string, labels map[string]string) error {
+	if l.labels == nil {
+		l.labels = make(map[string]map[string]string)
+	}
+	l.labels[groupID] = labels
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// OrphanedGroups are the target groups synced by previous runs which are no
+// longer mapped by the mapping file.
+type OrphanedGroups struct {
+	// Records are the records of the orphaned target groups, sorted by group
+	// ID.
+	Records []*groupsync.ManagedGroupRecord
+
+	plan    *syncPlan
+	managed *groupsync.ManagedGroups
+}
+
+// FindOrphanedGroups compares the target groups recorded in the state store by
+// previous sync runs with the target groups mapped by the mapping file. The
+// state store must be set with WithStateStore.
+func FindOrphanedGroups(ctx context.Context, mappingFile, configFile string, opts ...SyncOpt) (*OrphanedGroups, error) {
+	syncConfig := &SyncConfig{}
+	for _, opt := range opts {
+		opt(syncConfig)
+	}
+	if syncConfig.store == nil {
+		return nil, fmt.Errorf("finding orphaned groups requires a state store")
+	}
+	plan, err := newSyncPlan(ctx, mappingFile, configFile, syncConfig)
+	if err != nil {
+		return nil, err
+	}
+	return plan.orphanedGroups(ctx, groupsync.NewManagedGroups(syncConfig.store, groupsync.UnmappedGroupNone))
+}
+
+func (p *syncPlan) orphanedGroups(ctx context.Context, managed *groupsync.ManagedGroups) (*OrphanedGroups, error) {
+	targetGroupIDs, err := p.targetMapper.AllGroupIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target group IDs: %w", err)
+	}
+	records, err := managed.Orphans(ctx, targetGroupIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find orphaned groups: %w", err)
+	}
+	return &OrphanedGroups{
+		Records: records,
+		plan:    p,
+		managed: managed,
+	}, nil
+}
+
+// CleanUp archives the orphaned target groups if the target system supports
+// archiving, e.g. GitHub, or removes all their members otherwise, and removes
+// their records from the state store. Groups which fail to be cleaned up keep
+// their records and their errors are returned.
+func (o *OrphanedGroups) CleanUp(ctx context.Context) error {
+	var merr error
+	for _, r := range o.Records {
+		if err := o.managed.CleanUp(ctx, r.GroupID, o.plan.writer); err != nil {
+			merr = errors.Join(merr, err)
+		}
+	}
+	return merr
+}
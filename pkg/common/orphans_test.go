@@ -0,0 +1,88 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	gggh "github.com/abcxyz/team-link/pkg/common/googlegroup_github"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestSyncPlan_OrphanedGroups(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := state.NewMemoryStore()
+	for _, id := range []string{"1:10", "1:11", "1:12"} {
+		if err := state.PutJSON(ctx, store, state.Key("managed", id), &groupsync.ManagedGroupRecord{GroupID: id}); err != nil {
+			t.Fatalf("failed to seed state store: %v", err)
+		}
+	}
+	gm := gggh.NewBidirectionalGroupMapper(&api.GroupMappings{
+		Mappings: []*api.GroupMapping{{
+			Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/eng"}},
+			Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 1, TeamId: 10}},
+		}},
+	})
+	writer := userGroups(map[string][]string{
+		"1:10": {"alice"},
+		"1:11": {"bob"},
+	})
+	plan := &syncPlan{
+		writer:       writer,
+		sourceMapper: gm.SourceMapper,
+		targetMapper: gm.TargetMapper,
+	}
+	managed := groupsync.NewManagedGroups(store, groupsync.UnmappedGroupNone)
+
+	orphans, err := plan.orphanedGroups(ctx, managed)
+	if err != nil {
+		t.Fatalf("orphanedGroups failed: %v", err)
+	}
+	if diff := cmp.Diff(recordIDs(orphans.Records), []string{"1:11", "1:12"}); diff != "" {
+		t.Errorf("unexpected orphaned groups (-got, +want):\n%s", diff)
+	}
+
+	// 1:12 no longer exists, so it fails to be cleaned up and is kept.
+	err = orphans.CleanUp(ctx)
+	if diff := testutil.DiffErrString(err, "group not found: 1:12"); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff(groupUserIDs(writer), map[string][]string{"1:10": {"alice"}, "1:11": {}}); diff != "" {
+		t.Errorf("unexpected target groups (-got, +want):\n%s", diff)
+	}
+	orphans, err = plan.orphanedGroups(ctx, managed)
+	if err != nil {
+		t.Fatalf("orphanedGroups failed: %v", err)
+	}
+	if diff := cmp.Diff(recordIDs(orphans.Records), []string{"1:12"}); diff != "" {
+		t.Errorf("unexpected orphaned groups after clean up (-got, +want):\n%s", diff)
+	}
+}
+
+func recordIDs(records []*groupsync.ManagedGroupRecord) []string {
+	ids := make([]string, 0, len(records))
+	for _, r := range records {
+		ids = append(ids, r.GroupID)
+	}
+	return ids
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+)
+
+// MappingChange is how a change affects a mapping.
+type MappingChange string
+
+const (
+	MappingChangeChanged MappingChange = "changed"
+	MappingChangeRemoved MappingChange = "removed"
+)
+
+// OwnershipViolation is a change to owned mappings made by someone who does
+// not own them.
+type OwnershipViolation struct {
+	// Mapping is the target group of the changed group mappings, or the source
+	// user of the changed user mappings.
+	Mapping string
+	Change  MappingChange
+	// Owners are the owners of the mappings before the change.
+	Owners []string
+}
+
+// CheckMappingOwners returns the changes from base to head made to mappings
+// which the committer does not own. The owners of a target group are the
+// owners of its group mappings in base, and changing, adding or removing
+// any group mapping of the target group requires being one of them. The
+// owner of a user mapping in base owns the mappings of its source user.
+// Mappings without owners in base may be changed by anyone. Owners are
+// compared case-insensitively.
+func CheckMappingOwners(base, head *api.TeamLinkMappings, committer string) []*OwnershipViolation {
+	violations := checkOwners(base.GetGroupMappings().GetMappings(), head.GetGroupMappings().GetMappings(),
+		groupMappingTarget, (*api.GroupMapping).GetOwners, committer)
+	return append(violations, checkOwners(base.GetUserMappings().GetMappings(), head.GetUserMappings().GetMappings(),
+		func(m *api.UserMapping) string { return "user " + m.GetSource() },
+		func(m *api.UserMapping) []string {
+			if m.GetOwner() == "" {
+				return nil
+			}
+			return []string{m.GetOwner()}
+		}, committer)...)
+}
+
+// checkOwners groups the base and head mappings by key and returns the groups
+// which changed and whose base owners do not include the committer.
+func checkOwners[M proto.Message](base, head []M, key func(M) string, owners func(M) []string, committer string) []*OwnershipViolation {
+	var keys []string
+	baseByKey := make(map[string][]M)
+	headByKey := make(map[string][]M)
+	for _, m := range base {
+		k := key(m)
+		if _, ok := baseByKey[k]; !ok {
+			keys = append(keys, k)
+		}
+		baseByKey[k] = append(baseByKey[k], m)
+	}
+	for _, m := range head {
+		headByKey[key(m)] = append(headByKey[key(m)], m)
+	}
+
+	var violations []*OwnershipViolation
+	for _, k := range keys {
+		var keyOwners []string
+		for _, m := range baseByKey[k] {
+			keyOwners = append(keyOwners, owners(m)...)
+		}
+		slices.Sort(keyOwners)
+		keyOwners = slices.Compact(keyOwners)
+		if len(keyOwners) == 0 || sameMappings(baseByKey[k], headByKey[k]) {
+			continue
+		}
+		if slices.ContainsFunc(keyOwners, func(o string) bool { return strings.EqualFold(o, committer) }) {
+			continue
+		}
+		change := MappingChangeChanged
+		if len(headByKey[k]) == 0 {
+			change = MappingChangeRemoved
+		}
+		violations = append(violations, &OwnershipViolation{Mapping: k, Change: change, Owners: keyOwners})
+	}
+	return violations
+}
+
+// sameMappings reports whether a and b contain the same mappings, regardless
+// of their order.
+func sameMappings[M proto.Message](a, b []M) bool {
+	contains := func(ms []M, m M) bool {
+		return slices.ContainsFunc(ms, func(o M) bool { return proto.Equal(o, m) })
+	}
+	for _, m := range a {
+		if !contains(b, m) {
+			return false
+		}
+	}
+	for _, m := range b {
+		if !contains(a, m) {
+			return false
+		}
+	}
+	return true
+}
+
+// groupMappingTarget describes the target group of a group mapping, e.g.
+// "github {org_id:1 team_id:2}".
+func groupMappingTarget(m *api.GroupMapping) string {
+	msg := m.ProtoReflect()
+	fd := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("target"))
+	if fd == nil {
+		return "no target"
+	}
+	return string(fd.Name()) + " {" + prototext.MarshalOptions{}.Format(msg.Get(fd).Message().Interface()) + "}"
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+)
+
+func TestCheckMappingOwners(t *testing.T) {
+	t.Parallel()
+
+	owned := &api.GroupMapping{
+		Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/infra"}},
+		Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 1, TeamId: 10}},
+		Owners: []string{"alice", "Bob"},
+	}
+	unowned := &api.GroupMapping{
+		Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/web"}},
+		Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 1, TeamId: 20}},
+	}
+	secondSource := &api.GroupMapping{
+		Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/all"}},
+		Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 1, TeamId: 10}},
+	}
+	ownedUser := &api.UserMapping{Source: "a@example.com", Target: "a", Owner: "carol"}
+	base := &api.TeamLinkMappings{
+		GroupMappings: &api.GroupMappings{Mappings: []*api.GroupMapping{owned, unowned}},
+		UserMappings:  &api.UserMappings{Mappings: []*api.UserMapping{ownedUser}},
+	}
+
+	cases := []struct {
+		name      string
+		head      *api.TeamLinkMappings
+		committer string
+		want      []*OwnershipViolation
+	}{
+		{
+			name:      "unchanged",
+			head:      base,
+			committer: "mallory",
+		},
+		{
+			name: "unowned_and_new_mappings",
+			head: &api.TeamLinkMappings{
+				GroupMappings: &api.GroupMappings{Mappings: []*api.GroupMapping{unowned, owned}},
+				UserMappings: &api.UserMappings{Mappings: []*api.UserMapping{
+					ownedUser,
+					{Source: "m@example.com", Target: "m"},
+				}},
+			},
+			committer: "mallory",
+		},
+		{
+			name: "owner_adds_source",
+			head: &api.TeamLinkMappings{
+				GroupMappings: &api.GroupMappings{Mappings: []*api.GroupMapping{owned, unowned, secondSource}},
+				UserMappings:  base.GetUserMappings(),
+			},
+			committer: "bob",
+		},
+		{
+			name: "non_owner_adds_source",
+			head: &api.TeamLinkMappings{
+				GroupMappings: &api.GroupMappings{Mappings: []*api.GroupMapping{owned, unowned, secondSource}},
+				UserMappings:  base.GetUserMappings(),
+			},
+			committer: "mallory",
+			want: []*OwnershipViolation{
+				{Mapping: groupMappingTarget(owned), Change: MappingChangeChanged, Owners: []string{"Bob", "alice"}},
+			},
+		},
+		{
+			name: "non_owner_removes",
+			head: &api.TeamLinkMappings{
+				GroupMappings: &api.GroupMappings{Mappings: []*api.GroupMapping{unowned}},
+				UserMappings: &api.UserMappings{Mappings: []*api.UserMapping{
+					{Source: "a@example.com", Target: "a", Owner: "mallory"},
+				}},
+			},
+			committer: "mallory",
+			want: []*OwnershipViolation{
+				{Mapping: groupMappingTarget(owned), Change: MappingChangeRemoved, Owners: []string{"Bob", "alice"}},
+				{Mapping: "user a@example.com", Change: MappingChangeChanged, Owners: []string{"carol"}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := CheckMappingOwners(base, tc.head, tc.committer)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("CheckMappingOwners got unexpected result (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
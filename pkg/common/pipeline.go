@@ -0,0 +1,161 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/abcxyz/pkg/logging"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+// PipelineRun is the sync of one source system to one target system of a
+// pipeline.
+type PipelineRun struct {
+	// Pipeline is the name of the pipeline.
+	Pipeline string
+	// Config is the config of the run, with the source and target of the run.
+	Config *api.TeamLinkConfig
+	// MappingFiles are the mapping files of the run, in order.
+	MappingFiles []string
+}
+
+// PipelineRuns expands the pipelines of the config into their runs, every
+// source of a pipeline paired with every target. Only the pipelines with the
+// given names are expanded, or all of them if there are none. Mapping files
+// of pipelines are relative to configDir and default to mappingFile.
+func PipelineRuns(config *api.TeamLinkConfig, configDir, mappingFile string, names []string) ([]*PipelineRun, error) {
+	var merr error
+	seen := make(map[string]struct{}, len(config.GetPipelines()))
+	for i, p := range config.GetPipelines() {
+		if p.GetName() == "" {
+			merr = errors.Join(merr, fmt.Errorf("pipeline %d has no name", i))
+			continue
+		}
+		if _, ok := seen[p.GetName()]; ok {
+			merr = errors.Join(merr, fmt.Errorf("pipeline name %s is not unique", p.GetName()))
+		}
+		seen[p.GetName()] = struct{}{}
+		if len(p.GetSources()) == 0 || len(p.GetTargets()) == 0 {
+			merr = errors.Join(merr, fmt.Errorf("pipeline %s must have at least one source and one target", p.GetName()))
+		}
+	}
+	for _, name := range names {
+		if _, ok := seen[name]; !ok {
+			merr = errors.Join(merr, fmt.Errorf("unknown pipeline %s", name))
+		}
+	}
+	if merr != nil {
+		return nil, merr
+	}
+
+	var runs []*PipelineRun
+	for _, p := range config.GetPipelines() {
+		if len(names) > 0 && !slices.Contains(names, p.GetName()) {
+			continue
+		}
+		if len(p.GetMappingFiles()) == 0 && mappingFile == "" {
+			merr = errors.Join(merr, fmt.Errorf("pipeline %s has no mapping_files and no mapping file is provided", p.GetName()))
+			continue
+		}
+		mappingFiles := []string{mappingFile}
+		if len(p.GetMappingFiles()) > 0 {
+			mappingFiles = make([]string, 0, len(p.GetMappingFiles()))
+			for _, f := range p.GetMappingFiles() {
+				if !filepath.IsAbs(f) {
+					f = filepath.Join(configDir, f)
+				}
+				mappingFiles = append(mappingFiles, f)
+			}
+		}
+		for _, source := range p.GetSources() {
+			for _, target := range p.GetTargets() {
+				runConfig, _ := proto.Clone(config).(*api.TeamLinkConfig)
+				runConfig.Pipelines = nil
+				runConfig.SourceConfig = source
+				runConfig.TargetConfig = target
+				if p.GetSyncPolicy() != nil {
+					runConfig.SyncPolicy = p.GetSyncPolicy()
+				}
+				runs = append(runs, &PipelineRun{
+					Pipeline:     p.GetName(),
+					Config:       runConfig,
+					MappingFiles: mappingFiles,
+				})
+			}
+		}
+	}
+	if merr != nil {
+		return nil, merr
+	}
+	return runs, nil
+}
+
+// syncPipelines syncs the pipelines of the config, or those selected with
+// WithPipelines, see PipelineRuns. Runs are synced one after the other, and
+// a failed run does not stop the following ones.
+func syncPipelines(ctx context.Context, mappingFile, configFile string, config *api.TeamLinkConfig, syncConfig *SyncConfig) error {
+	if syncConfig.sourceSystem != "" || syncConfig.targetSystem != "" {
+		return fmt.Errorf("source and target systems cannot be overridden for pipelines")
+	}
+	if syncConfig.replaySource != nil || syncConfig.replayTarget != nil {
+		return fmt.Errorf("pipelines cannot be replayed")
+	}
+	runs, err := PipelineRuns(config, filepath.Dir(configFile), mappingFile, syncConfig.pipelines)
+	if err != nil {
+		return fmt.Errorf("invalid pipelines: %w", err)
+	}
+
+	logger := logging.FromContext(ctx)
+	var merr error
+	for _, run := range runs {
+		runCtx := logging.WithLogger(ctx, logger.With("pipeline", run.Pipeline))
+		mappings, err := parseMappingFiles(runCtx, run.MappingFiles)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("pipeline %s: %w", run.Pipeline, err))
+			continue
+		}
+		plan, err := newSyncPlanFrom(runCtx, mappings, run.Config, syncConfig)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("pipeline %s: %w", run.Pipeline, err))
+			continue
+		}
+		if err := plan.sync(runCtx, syncConfig); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("pipeline %s from %s to %s: %w", run.Pipeline, plan.sourceSystem, plan.targetSystem, err))
+		}
+	}
+	return merr
+}
+
+// parseMappingFiles parses the given mapping files and concatenates their
+// mappings.
+func parseMappingFiles(ctx context.Context, files []string) (*api.TeamLinkMappings, error) {
+	mappings := &api.TeamLinkMappings{}
+	for _, f := range files {
+		m, err := utils.ParseMappingTextProto(ctx, f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse mappings file %s: %w", f, err)
+		}
+		proto.Merge(mappings, m)
+	}
+	return mappings, nil
+}
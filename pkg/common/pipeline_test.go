@@ -0,0 +1,133 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+)
+
+func TestPipelineRuns(t *testing.T) {
+	t.Parallel()
+
+	google := &api.SourceConfig{Config: &api.SourceConfig_GoogleGroupsConfig{GoogleGroupsConfig: &api.GoogleGroupsConfig{}}}
+	gitlabSource := &api.SourceConfig{Config: &api.SourceConfig_GitlabConfig{GitlabConfig: &api.GitLabConfig{}}}
+	github := &api.TargetConfig{Config: &api.TargetConfig_GithubConfig{GithubConfig: &api.GitHubConfig{}}}
+	gitlab := &api.TargetConfig{Config: &api.TargetConfig_GitlabConfig{GitlabConfig: &api.GitLabConfig{}}}
+	global := &api.SyncPolicy{MaxRemovals: 10}
+	strict := &api.SyncPolicy{AdditiveOnly: true}
+
+	config := &api.TeamLinkConfig{
+		SyncPolicy: global,
+		Pipelines: []*api.Pipeline{
+			{
+				Name:    "fan-out",
+				Sources: []*api.SourceConfig{google},
+				Targets: []*api.TargetConfig{github, gitlab},
+			},
+			{
+				Name:         "migration",
+				Sources:      []*api.SourceConfig{gitlabSource},
+				Targets:      []*api.TargetConfig{github},
+				MappingFiles: []string{"base.textproto", "/etc/team-link/extra.textproto"},
+				SyncPolicy:   strict,
+			},
+		},
+	}
+
+	cases := []struct {
+		name        string
+		config      *api.TeamLinkConfig
+		mappingFile string
+		names       []string
+		want        []*PipelineRun
+		wantErr     string
+	}{
+		{
+			name:        "all",
+			config:      config,
+			mappingFile: "mappings.textproto",
+			want: []*PipelineRun{
+				{
+					Pipeline:     "fan-out",
+					Config:       &api.TeamLinkConfig{SourceConfig: google, TargetConfig: github, SyncPolicy: global},
+					MappingFiles: []string{"mappings.textproto"},
+				},
+				{
+					Pipeline:     "fan-out",
+					Config:       &api.TeamLinkConfig{SourceConfig: google, TargetConfig: gitlab, SyncPolicy: global},
+					MappingFiles: []string{"mappings.textproto"},
+				},
+				{
+					Pipeline:     "migration",
+					Config:       &api.TeamLinkConfig{SourceConfig: gitlabSource, TargetConfig: github, SyncPolicy: strict},
+					MappingFiles: []string{"config/base.textproto", "/etc/team-link/extra.textproto"},
+				},
+			},
+		},
+		{
+			name:   "selected",
+			config: config,
+			names:  []string{"migration"},
+			want: []*PipelineRun{
+				{
+					Pipeline:     "migration",
+					Config:       &api.TeamLinkConfig{SourceConfig: gitlabSource, TargetConfig: github, SyncPolicy: strict},
+					MappingFiles: []string{"config/base.textproto", "/etc/team-link/extra.textproto"},
+				},
+			},
+		},
+		{
+			name:    "unknown_name",
+			config:  config,
+			names:   []string{"missing"},
+			wantErr: "unknown pipeline missing",
+		},
+		{
+			name:    "no_mapping_file",
+			config:  config,
+			wantErr: "pipeline fan-out has no mapping_files and no mapping file is provided",
+		},
+		{
+			name: "invalid",
+			config: &api.TeamLinkConfig{Pipelines: []*api.Pipeline{
+				{Name: "a", Sources: []*api.SourceConfig{google}},
+				{Name: "a", Sources: []*api.SourceConfig{google}, Targets: []*api.TargetConfig{github}},
+				{Sources: []*api.SourceConfig{google}, Targets: []*api.TargetConfig{github}},
+			}},
+			mappingFile: "mappings.textproto",
+			wantErr:     "pipeline a must have at least one source and one target\npipeline name a is not unique\npipeline 2 has no name",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := PipelineRuns(tc.config, "config", tc.mappingFile, tc.names)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(got, tc.want, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected runs (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
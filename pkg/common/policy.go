@@ -0,0 +1,142 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// NewSyncPolicies returns the sync policy of the config, which applies to
+// every target group, and the policies of the target groups whose mappings
+// have a sync_policy, merged with the policy of the config. Either is nil
+// if there is no such policy.
+func NewSyncPolicies(targetSystem string, config *api.TeamLinkConfig, gm *api.GroupMappings) (*groupsync.Policy, map[string]*groupsync.Policy, error) {
+	var merr error
+	global, err := NewSyncPolicy(config.GetSyncPolicy())
+	if err != nil {
+		merr = errors.Join(merr, fmt.Errorf("invalid sync_policy of config: %w", err))
+	}
+	var policies map[string]*groupsync.Policy
+	for _, m := range gm.GetMappings() {
+		if m.GetSyncPolicy() == nil {
+			continue
+		}
+		targetID, ok := mappedGroupID(targetSystem, m)
+		if !ok {
+			continue
+		}
+		policy, err := NewSyncPolicy(m.GetSyncPolicy())
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("invalid sync_policy of mapping to %s: %w", targetID, err))
+			continue
+		}
+		if policies == nil {
+			policies = make(map[string]*groupsync.Policy)
+		}
+		// a target group synced from several mappings gets the most
+		// restrictive of their policies.
+		if existing, ok := policies[targetID]; ok {
+			policies[targetID] = existing.Merge(policy)
+		} else {
+			policies[targetID] = global.Merge(policy)
+		}
+	}
+	if merr != nil {
+		return nil, nil, merr
+	}
+	return global, policies, nil
+}
+
+// NewSyncPolicy converts a SyncPolicy of the config to a groupsync.Policy, or
+// nil if it is nil.
+func NewSyncPolicy(p *api.SyncPolicy) (*groupsync.Policy, error) {
+	if p == nil {
+		return nil, nil
+	}
+	if p.GetMaxRemovals() < 0 {
+		return nil, fmt.Errorf("max_removals must not be negative, got %d", p.GetMaxRemovals())
+	}
+	if p.GetConcurrency() < 0 {
+		return nil, fmt.Errorf("concurrency must not be negative, got %d", p.GetConcurrency())
+	}
+	return &groupsync.Policy{
+		MaxRemovals:    int(p.GetMaxRemovals()),
+		AdditiveOnly:   p.GetAdditiveOnly(),
+		ProtectedUsers: p.GetProtectedUsers(),
+	}, nil
+}
+
+// NewFreezeWindows returns the freeze windows of the sync policy of the
+// config, which apply to every target group, and those of the sync policies
+// of the mappings, keyed by target group ID. The syncer defers removals
+// during either, see groupsync.WithFreezeWindows.
+func NewFreezeWindows(targetSystem string, config *api.TeamLinkConfig, gm *api.GroupMappings) ([]groupsync.FreezeWindow, map[string][]groupsync.FreezeWindow, error) {
+	var merr error
+	global, err := parseFreezeWindows(config.GetSyncPolicy())
+	if err != nil {
+		merr = errors.Join(merr, fmt.Errorf("invalid sync_policy of config: %w", err))
+	}
+	var windows map[string][]groupsync.FreezeWindow
+	for _, m := range gm.GetMappings() {
+		targetID, ok := mappedGroupID(targetSystem, m)
+		if !ok {
+			continue
+		}
+		ws, err := parseFreezeWindows(m.GetSyncPolicy())
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("invalid sync_policy of mapping to %s: %w", targetID, err))
+			continue
+		}
+		if len(ws) == 0 {
+			continue
+		}
+		if windows == nil {
+			windows = make(map[string][]groupsync.FreezeWindow)
+		}
+		windows[targetID] = append(windows[targetID], ws...)
+	}
+	if merr != nil {
+		return nil, nil, merr
+	}
+	return global, windows, nil
+}
+
+// parseFreezeWindows parses the freeze_windows of a SyncPolicy in its
+// freeze_time_zone.
+func parseFreezeWindows(p *api.SyncPolicy) ([]groupsync.FreezeWindow, error) {
+	loc, err := time.LoadLocation(p.GetFreezeTimeZone())
+	if err != nil {
+		return nil, fmt.Errorf("invalid freeze_time_zone: %w", err)
+	}
+	var windows []groupsync.FreezeWindow
+	var merr error
+	for _, spec := range p.GetFreezeWindows() {
+		w, err := groupsync.ParseFreezeWindow(spec, loc)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("invalid freeze_windows: %w", err))
+			continue
+		}
+		windows = append(windows, w)
+	}
+	if merr != nil {
+		return nil, merr
+	}
+	return windows, nil
+}
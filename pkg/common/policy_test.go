@@ -0,0 +1,182 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestNewSyncPolicies(t *testing.T) {
+	t.Parallel()
+
+	mapping := func(sourceID, targetID string, policy *api.SyncPolicy) *api.GroupMapping {
+		return &api.GroupMapping{
+			Source:     &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: sourceID}},
+			Target:     &api.GroupMapping_Gerrit{Gerrit: &api.Gerrit{GroupId: targetID}},
+			SyncPolicy: policy,
+		}
+	}
+
+	cases := []struct {
+		name         string
+		global       *api.SyncPolicy
+		mappings     []*api.GroupMapping
+		wantGlobal   *groupsync.Policy
+		wantPolicies map[string]*groupsync.Policy
+		wantErr      string
+	}{
+		{
+			name:     "no_policies",
+			mappings: []*api.GroupMapping{mapping("groups/a", "reviewers", nil)},
+		},
+		{
+			name:       "global",
+			global:     &api.SyncPolicy{MaxRemovals: 10, ProtectedUsers: []string{"admin"}},
+			mappings:   []*api.GroupMapping{mapping("groups/a", "reviewers", nil)},
+			wantGlobal: &groupsync.Policy{MaxRemovals: 10, ProtectedUsers: []string{"admin"}},
+		},
+		{
+			name:   "mapping_merged_with_global",
+			global: &api.SyncPolicy{MaxRemovals: 10, ProtectedUsers: []string{"admin"}},
+			mappings: []*api.GroupMapping{
+				mapping("groups/a", "reviewers", &api.SyncPolicy{MaxRemovals: 3, ProtectedUsers: []string{"bot"}}),
+				mapping("groups/b", "reviewers", &api.SyncPolicy{AdditiveOnly: true}),
+				mapping("groups/c", "leads", nil),
+			},
+			wantGlobal: &groupsync.Policy{MaxRemovals: 10, ProtectedUsers: []string{"admin"}},
+			wantPolicies: map[string]*groupsync.Policy{
+				"reviewers": {MaxRemovals: 3, AdditiveOnly: true, ProtectedUsers: []string{"admin", "bot"}},
+			},
+		},
+		{
+			name:   "invalid",
+			global: &api.SyncPolicy{MaxRemovals: -1},
+			mappings: []*api.GroupMapping{
+				mapping("groups/a", "reviewers", &api.SyncPolicy{Concurrency: -2}),
+			},
+			wantErr: "invalid sync_policy of config: max_removals must not be negative, got -1",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := &api.TeamLinkConfig{SyncPolicy: tc.global}
+			gm := &api.GroupMappings{Mappings: tc.mappings}
+			global, policies, err := NewSyncPolicies(tltypes.SystemTypeGerrit, config, gm)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(global, tc.wantGlobal); diff != "" {
+				t.Errorf("unexpected global policy (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(policies, tc.wantPolicies); diff != "" {
+				t.Errorf("unexpected group policies (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewFreezeWindows(t *testing.T) {
+	t.Parallel()
+
+	mapping := func(sourceID, targetID string, policy *api.SyncPolicy) *api.GroupMapping {
+		return &api.GroupMapping{
+			Source:     &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: sourceID}},
+			Target:     &api.GroupMapping_Gerrit{Gerrit: &api.Gerrit{GroupId: targetID}},
+			SyncPolicy: policy,
+		}
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	holidays := &groupsync.DateFreezeWindow{
+		Start: time.Date(2026, time.December, 20, 0, 0, 0, 0, berlin),
+		End:   time.Date(2027, time.January, 5, 0, 0, 0, 0, berlin),
+	}
+	weekend := &groupsync.WeeklyFreezeWindow{
+		StartDay: time.Friday,
+		Start:    17 * time.Hour,
+		EndDay:   time.Monday,
+		End:      9 * time.Hour,
+		Location: time.UTC,
+	}
+
+	cases := []struct {
+		name        string
+		global      *api.SyncPolicy
+		mappings    []*api.GroupMapping
+		wantGlobal  []groupsync.FreezeWindow
+		wantWindows map[string][]groupsync.FreezeWindow
+		wantErr     string
+	}{
+		{
+			name:     "no_windows",
+			global:   &api.SyncPolicy{MaxRemovals: 10},
+			mappings: []*api.GroupMapping{mapping("groups/a", "reviewers", &api.SyncPolicy{AdditiveOnly: true})},
+		},
+		{
+			name:   "global_and_mappings",
+			global: &api.SyncPolicy{FreezeWindows: []string{"Fri 17:00-Mon 09:00"}},
+			mappings: []*api.GroupMapping{
+				mapping("groups/a", "reviewers", &api.SyncPolicy{
+					FreezeWindows:  []string{"2026-12-20/2027-01-04"},
+					FreezeTimeZone: "Europe/Berlin",
+				}),
+				mapping("groups/b", "leads", nil),
+			},
+			wantGlobal:  []groupsync.FreezeWindow{weekend},
+			wantWindows: map[string][]groupsync.FreezeWindow{"reviewers": {holidays}},
+		},
+		{
+			name: "invalid",
+			mappings: []*api.GroupMapping{
+				mapping("groups/a", "reviewers", &api.SyncPolicy{FreezeWindows: []string{"whenever"}}),
+				mapping("groups/b", "leads", &api.SyncPolicy{FreezeTimeZone: "Mars/Olympus"}),
+			},
+			wantErr: "invalid sync_policy of mapping to reviewers: invalid freeze_windows",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := &api.TeamLinkConfig{SyncPolicy: tc.global}
+			gm := &api.GroupMappings{Mappings: tc.mappings}
+			global, windows, err := NewFreezeWindows(tltypes.SystemTypeGerrit, config, gm)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			sameLocation := cmp.Comparer(func(a, b *time.Location) bool { return a.String() == b.String() })
+			if diff := cmp.Diff(global, tc.wantGlobal, sameLocation); diff != "" {
+				t.Errorf("unexpected global freeze windows (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(windows, tc.wantWindows, sameLocation); diff != "" {
+				t.Errorf("unexpected group freeze windows (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
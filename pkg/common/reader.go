@@ -17,19 +17,41 @@ package common
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
 	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/github"
 	"github.com/abcxyz/team-link/pkg/googlegroups"
 	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/onelogin"
+	"github.com/abcxyz/team-link/pkg/opsgenie"
+	"github.com/abcxyz/team-link/pkg/pagerduty"
+	"github.com/abcxyz/team-link/pkg/pingone"
+	"github.com/abcxyz/team-link/pkg/state"
 )
 
 // NewReader creates a GroupReader base on source type and input config.
-func NewReader(ctx context.Context, source string, config *api.TeamLinkConfig) (groupsync.GroupReader, error) {
-	if source == tltypes.SystemTypeGoogleGroups {
+// The store keeps credentials which are rotated, it may be nil if there are
+// none. The githubOpts configure the GitHub reader.
+func NewReader(ctx context.Context, source string, config *api.TeamLinkConfig, mappings *api.TeamLinkMappings, store state.Store, githubOpts ...github.Opt) (groupsync.GroupReader, error) {
+	switch source {
+	case tltypes.SystemTypeGoogleGroups:
 		return NewGoogleGroupsReader(ctx)
+	case tltypes.SystemTypeGitHub, tltypes.SystemTypeGitLab, tltypes.SystemTypeJumpCloud:
+		// the read writers of systems that can be a target are also readers.
+		return NewReadWriter(ctx, source, config, mappings, store, githubOpts...)
+	case tltypes.SystemTypeOneLogin:
+		return NewOneLoginReader(ctx, config.GetSourceConfig().GetOneloginConfig())
+	case tltypes.SystemTypePingOne:
+		return NewPingOneReader(ctx, config.GetSourceConfig().GetPingoneConfig())
+	case tltypes.SystemTypePagerDuty:
+		return NewPagerDutyReader(ctx, config.GetSourceConfig().GetPagerdutyConfig())
+	case tltypes.SystemTypeOpsgenie:
+		return NewOpsgenieReader(ctx, config.GetSourceConfig().GetOpsgenieConfig())
 	}
-	return nil, fmt.Errorf("unsupported source type: %s", source)
+	return nil, fmt.Errorf("%w: source type %s", groupsync.ErrUnsupportedSystem, source)
 }
 
 // NewGoogleGroupsReader creates a GoogleGroupsReader.
@@ -41,3 +63,85 @@ func NewGoogleGroupsReader(ctx context.Context) (groupsync.GroupReader, error) {
 	}
 	return reader, nil
 }
+
+// NewOneLoginReader creates a GroupReader for the roles of onelogin using
+// provided config.
+func NewOneLoginReader(ctx context.Context, config *api.OneLoginConfig) (groupsync.GroupReader, error) {
+	if config.GetUrl() == "" || config.GetClientId() == "" {
+		return nil, fmt.Errorf("onelogin url and client_id are required")
+	}
+	clientSecret, err := secret(ctx, config.GetClientSecret())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get onelogin client secret: %w", err)
+	}
+	return onelogin.NewGroupReader(ctx, strings.TrimSuffix(config.GetUrl(), "/"), config.GetClientId(), string(clientSecret)), nil
+}
+
+// NewPingOneReader creates a GroupReader for the groups of pingone using
+// provided config.
+func NewPingOneReader(ctx context.Context, config *api.PingOneConfig) (groupsync.GroupReader, error) {
+	if config.GetEnvironmentId() == "" || config.GetClientId() == "" {
+		return nil, fmt.Errorf("pingone environment_id and client_id are required")
+	}
+	clientSecret, err := secret(ctx, config.GetClientSecret())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pingone client secret: %w", err)
+	}
+	var opts []pingone.Opt
+	if config.GetDomain() != "" {
+		opts = append(opts, pingone.WithDomain(config.GetDomain()))
+	}
+	return pingone.NewGroupReader(ctx, config.GetEnvironmentId(), config.GetClientId(), string(clientSecret), opts...), nil
+}
+
+// NewPagerDutyReader creates a GroupReader for the on-call users of pagerduty
+// schedules using provided config.
+func NewPagerDutyReader(ctx context.Context, config *api.PagerDutyConfig) (groupsync.GroupReader, error) {
+	apiKey, err := secret(ctx, config.GetApiKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pagerduty api key: %w", err)
+	}
+	lookahead, err := parseLookahead(config.GetLookahead())
+	if err != nil {
+		return nil, fmt.Errorf("invalid pagerduty lookahead: %w", err)
+	}
+	opts := []pagerduty.Opt{pagerduty.WithLookahead(lookahead)}
+	if config.GetUrl() != "" {
+		opts = append(opts, pagerduty.WithURL(strings.TrimSuffix(config.GetUrl(), "/")))
+	}
+	return pagerduty.NewGroupReader(string(apiKey), opts...), nil
+}
+
+// NewOpsgenieReader creates a GroupReader for the on-call users of opsgenie
+// schedules using provided config.
+func NewOpsgenieReader(ctx context.Context, config *api.OpsgenieConfig) (groupsync.GroupReader, error) {
+	apiKey, err := secret(ctx, config.GetApiKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get opsgenie api key: %w", err)
+	}
+	lookahead, err := parseLookahead(config.GetLookahead())
+	if err != nil {
+		return nil, fmt.Errorf("invalid opsgenie lookahead: %w", err)
+	}
+	opts := []opsgenie.Opt{opsgenie.WithLookahead(lookahead)}
+	if config.GetUrl() != "" {
+		opts = append(opts, opsgenie.WithURL(strings.TrimSuffix(config.GetUrl(), "/")))
+	}
+	return opsgenie.NewGroupReader(string(apiKey), opts...), nil
+}
+
+// parseLookahead parses the lookahead of an on-call schedule source, zero if
+// unset.
+func parseLookahead(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse duration %q: %w", s, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("duration %q must not be negative", s)
+	}
+	return d, nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// accessRecordHeader is the header row of the access export.
+var accessRecordHeader = []string{"group", "user", "source", "justification"}
+
+// AccessRecord is a user's membership of a target group and where it comes
+// from.
+type AccessRecord struct {
+	// Group is the ID of the target group.
+	Group string
+	// User is the ID of the target user.
+	User string
+	// Source is the source user the membership is derived from, empty if the
+	// membership is not managed by team-link.
+	Source string
+	// Justification describes why the user is a member of the group.
+	Justification string
+}
+
+// ExportAccess writes the current memberships of all mapped target groups,
+// along with the source memberships they derive from, as CSV with the columns
+// group, user, source and justification, e.g. for an access review campaign.
+// Memberships which are not derived from a source membership are exported
+// with an empty source.
+func ExportAccess(ctx context.Context, w io.Writer, mappingFile, configFile string, opts ...SyncOpt) error {
+	syncConfig := &SyncConfig{}
+	for _, opt := range opts {
+		opt(syncConfig)
+	}
+	plan, err := newSyncPlan(ctx, mappingFile, configFile, syncConfig)
+	if err != nil {
+		return err
+	}
+
+	records, merr := plan.accessRecords(ctx)
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(accessRecordHeader); err != nil {
+		return fmt.Errorf("failed to write access records: %w", err)
+	}
+	for _, r := range records {
+		if err := cw.Write([]string{r.Group, r.User, r.Source, r.Justification}); err != nil {
+			return fmt.Errorf("failed to write access records: %w", err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to write access records: %w", err)
+	}
+	return merr
+}
+
+// accessRecords returns the access records of all mapped target groups,
+// sorted by group and user. Groups which fail to be read are skipped and
+// their errors returned.
+func (p *syncPlan) accessRecords(ctx context.Context) ([]*AccessRecord, error) {
+	targetGroupIDs, err := p.targetMapper.AllGroupIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target group IDs: %w", err)
+	}
+	slices.Sort(targetGroupIDs)
+
+	owners := mappingOwners(p.mappings.GetUserMappings())
+
+	var merr error
+	var records []*AccessRecord
+	for _, targetGroupID := range targetGroupIDs {
+		groupRecords, err := p.groupAccessRecords(ctx, targetGroupID, owners)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to export target group %s: %w", targetGroupID, err))
+			continue
+		}
+		records = append(records, groupRecords...)
+	}
+	return records, merr
+}
+
+func (p *syncPlan) groupAccessRecords(ctx context.Context, targetGroupID string, owners map[string]string) ([]*AccessRecord, error) {
+	sourceGroupIDs, err := p.targetMapper.MappedGroupIDs(ctx, targetGroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mapped source groups: %w", err)
+	}
+	slices.Sort(sourceGroupIDs)
+
+	// provenance maps each target user to the source memberships it derives from.
+	provenance := make(map[string][]*AccessRecord)
+	for _, sourceGroupID := range sourceGroupIDs {
+		sourceUsers, err := p.reader.Descendants(ctx, sourceGroupID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get members of source group %s: %w", sourceGroupID, err)
+		}
+		for _, sourceUser := range sourceUsers {
+			mapped, err := groupsync.MappedUsers(ctx, p.userMapper, sourceUser.ID)
+			if errors.Is(err, groupsync.ErrTargetUserIDNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to map source user %s: %w", sourceUser.ID, err)
+			}
+			justification := fmt.Sprintf("member of %s group %s", p.sourceSystem, sourceGroupID)
+			if owner := owners[sourceUser.ID]; owner != "" {
+				justification += fmt.Sprintf(", mapping owned by %s", owner)
+			}
+			for _, u := range mapped {
+				provenance[u.ID] = append(provenance[u.ID], &AccessRecord{
+					Group:         targetGroupID,
+					User:          u.ID,
+					Source:        sourceUser.ID,
+					Justification: justification,
+				})
+			}
+		}
+	}
+
+	targetUsers, err := p.writer.Descendants(ctx, targetGroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members: %w", err)
+	}
+	targetUserIDs := make([]string, 0, len(targetUsers))
+	for _, u := range targetUsers {
+		targetUserIDs = append(targetUserIDs, u.ID)
+	}
+	slices.Sort(targetUserIDs)
+	targetUserIDs = slices.Compact(targetUserIDs)
+
+	records := make([]*AccessRecord, 0, len(targetUserIDs))
+	for _, userID := range targetUserIDs {
+		sources, ok := provenance[userID]
+		if !ok {
+			records = append(records, &AccessRecord{
+				Group:         targetGroupID,
+				User:          userID,
+				Justification: "not managed by team-link",
+			})
+			continue
+		}
+		records = append(records, sources...)
+	}
+	return records, nil
+}
+
+// mappingOwners returns the owner of each source user, including source
+// aliases, of the given user mappings.
+func mappingOwners(um *api.UserMappings) map[string]string {
+	owners := make(map[string]string)
+	for _, m := range um.GetMappings() {
+		if m.GetOwner() == "" {
+			continue
+		}
+		for _, src := range append([]string{m.GetSource()}, m.GetSourceAliases()...) {
+			owners[src] = m.GetOwner()
+		}
+	}
+	return owners
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	tltypes "github.com/abcxyz/team-link/internal"
+	gggh "github.com/abcxyz/team-link/pkg/common/googlegroup_github"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestSyncPlan_AccessRecords(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mappings := &api.TeamLinkMappings{
+		GroupMappings: &api.GroupMappings{
+			Mappings: []*api.GroupMapping{
+				{
+					Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/eng"}},
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 1, TeamId: 10}},
+				},
+				{
+					Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/oncall"}},
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 1, TeamId: 10}},
+				},
+				{
+					Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/missing"}},
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 1, TeamId: 11}},
+				},
+			},
+		},
+		UserMappings: &api.UserMappings{
+			Mappings: []*api.UserMapping{
+				{Source: "alice@example.com", Target: "alice", Owner: "mgr@example.com"},
+				{Source: "bob@example.com", Target: "bob"},
+			},
+		},
+	}
+	gm := gggh.NewBidirectionalGroupMapper(mappings.GetGroupMappings())
+	plan := &syncPlan{
+		mappings:     mappings,
+		sourceSystem: tltypes.SystemTypeGoogleGroups,
+		targetSystem: tltypes.SystemTypeGitHub,
+		reader: userGroups(map[string][]string{
+			"groups/eng":    {"alice@example.com", "bob@example.com"},
+			"groups/oncall": {"alice@example.com", "carol@example.com"},
+		}),
+		writer: userGroups(map[string][]string{
+			"1:10": {"bob", "alice", "mallory"},
+		}),
+		sourceMapper: gm.SourceMapper,
+		targetMapper: gm.TargetMapper,
+		userMapper:   gggh.NewUserMapper(ctx, mappings.GetUserMappings()),
+	}
+
+	got, err := plan.accessRecords(ctx)
+	if diff := testutil.DiffErrString(err, "failed to export target group 1:11"); diff != "" {
+		t.Error(diff)
+	}
+	want := []*AccessRecord{
+		{Group: "1:10", User: "alice", Source: "alice@example.com", Justification: "member of GOOGLEGROUPS group groups/eng, mapping owned by mgr@example.com"},
+		{Group: "1:10", User: "alice", Source: "alice@example.com", Justification: "member of GOOGLEGROUPS group groups/oncall, mapping owned by mgr@example.com"},
+		{Group: "1:10", User: "bob", Source: "bob@example.com", Justification: "member of GOOGLEGROUPS group groups/eng"},
+		{Group: "1:10", User: "mallory", Justification: "not managed by team-link"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("accessRecords got unexpected result (-got, +want):\n%s", diff)
+	}
+}
+
+// userGroups creates a groupsync.MemoryGroupReadWriter of groups with the
+// users with the given IDs as members.
+func userGroups(groups map[string][]string) *groupsync.MemoryGroupReadWriter {
+	rw := groupsync.NewMemoryGroupReadWriter()
+	for id, userIDs := range groups {
+		members := make([]groupsync.Member, 0, len(userIDs))
+		for _, userID := range userIDs {
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: userID}})
+		}
+		rw.AddGroup(&groupsync.Group{ID: id}, members...)
+	}
+	return rw
+}
+
+// groupUserIDs returns the IDs of the members of the groups of rw.
+func groupUserIDs(rw *groupsync.MemoryGroupReadWriter) map[string][]string {
+	groups := make(map[string][]string, len(rw.Members))
+	for id, members := range rw.Members {
+		groups[id] = make([]string, 0, len(members))
+		for _, m := range members {
+			groups[id] = append(groups[id], m.ID())
+		}
+	}
+	return groups
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+)
+
+// RepoGrants returns the repository permissions declared for a GitHub team.
+func RepoGrants(team *api.GitHub) ([]*github.RepoGrant, error) {
+	var merr error
+	grants := make([]*github.RepoGrant, 0, len(team.GetRepoPermissions()))
+	for _, rp := range team.GetRepoPermissions() {
+		if !slices.Contains(github.RepoPermissions, rp.GetPermission()) {
+			merr = errors.Join(merr, fmt.Errorf("invalid permission %q for repo_pattern %q, must be one of %v",
+				rp.GetPermission(), rp.GetRepoPattern(), github.RepoPermissions))
+			continue
+		}
+		pattern, err := regexp.Compile(`^(?:` + rp.GetRepoPattern() + `)$`)
+		if rp.GetRepoPattern() == "" || err != nil {
+			merr = errors.Join(merr, fmt.Errorf("invalid repo_pattern %q: %w", rp.GetRepoPattern(), err))
+			continue
+		}
+		grants = append(grants, &github.RepoGrant{Pattern: pattern, Permission: rp.GetPermission()})
+	}
+	if merr != nil {
+		return nil, merr
+	}
+	return grants, nil
+}
+
+// SyncRepoPermissions grants the GitHub teams of the group mappings the
+// repository permissions declared for them. Teams without declared
+// permissions are skipped. With dryRun the changes are only logged. Teams
+// which fail to sync, or whose permissions are invalid, do not stop the others
+// and their errors are returned.
+func SyncRepoPermissions(ctx context.Context, teams *github.TeamReadWriter, gm *api.GroupMappings, dryRun bool) error {
+	var merr error
+	for _, m := range gm.GetMappings() {
+		team := gitHubTeam(m)
+		if len(team.GetRepoPermissions()) == 0 {
+			continue
+		}
+		groupID := fmt.Sprintf("%d:%d", team.GetOrgId(), team.GetTeamId())
+		grants, err := RepoGrants(team)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("invalid repo_permissions of team %s: %w", groupID, err))
+			continue
+		}
+		if _, err := teams.SyncRepoPermissions(ctx, groupID, grants, dryRun); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to sync repo permissions of team %s: %w", groupID, err))
+		}
+	}
+	return merr
+}
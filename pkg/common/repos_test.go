@@ -0,0 +1,157 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gh "github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/githubtest"
+)
+
+func TestSyncRepoPermissions(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		mappings  []*api.GroupMapping
+		dryRun    bool
+		wantRepos map[string]string
+		wantErr   string
+	}{
+		{
+			name: "success",
+			mappings: []*api.GroupMapping{
+				{
+					Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/1"}},
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{
+						OrgId:  8583,
+						TeamId: 1,
+						RepoPermissions: []*api.RepoPermission{
+							{RepoPattern: "svc-.*", Permission: "push"},
+							{RepoPattern: "docs", Permission: "triage"},
+						},
+					}},
+				},
+				{
+					Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/2"}},
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 8583, TeamId: 2}},
+				},
+			},
+			wantRepos: map[string]string{
+				"svc-api": "push",
+				"svc-web": "push",
+				"docs":    "triage",
+			},
+		},
+		{
+			name: "dry_run",
+			mappings: []*api.GroupMapping{
+				{
+					Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/1"}},
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{
+						OrgId:           8583,
+						TeamId:          1,
+						RepoPermissions: []*api.RepoPermission{{RepoPattern: "svc-.*", Permission: "push"}},
+					}},
+				},
+			},
+			dryRun: true,
+			wantRepos: map[string]string{
+				"svc-api": "pull",
+			},
+		},
+		{
+			name: "invalid_permissions",
+			mappings: []*api.GroupMapping{
+				{
+					Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/1"}},
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{
+						OrgId:  8583,
+						TeamId: 1,
+						RepoPermissions: []*api.RepoPermission{
+							{RepoPattern: "svc-(", Permission: "push"},
+							{RepoPattern: "docs", Permission: "write"},
+						},
+					}},
+				},
+				{
+					Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/2"}},
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{
+						OrgId:           8583,
+						TeamId:          3,
+						RepoPermissions: []*api.RepoPermission{{RepoPattern: "docs", Permission: "pull"}},
+					}},
+				},
+			},
+			wantRepos: map[string]string{
+				"svc-api": "pull",
+			},
+			wantErr: `invalid repo_permissions of team 8583:1: invalid repo_pattern "svc-("`,
+		},
+		{
+			name: "unknown_team",
+			mappings: []*api.GroupMapping{
+				{
+					Source: &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/2"}},
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{
+						OrgId:           8583,
+						TeamId:          3,
+						RepoPermissions: []*api.RepoPermission{{RepoPattern: "docs", Permission: "pull"}},
+					}},
+				},
+			},
+			wantRepos: map[string]string{
+				"svc-api": "pull",
+			},
+			wantErr: "failed to sync repo permissions of team 8583:3",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := githubtest.NewBuilder().
+				WithOrg(8583, "org1").
+				WithTeam(8583, &gh.Team{ID: gh.Int64(1), Slug: gh.String("team1")}).
+				WithTeam(8583, &gh.Team{ID: gh.Int64(2), Slug: gh.String("team2")}).
+				WithRepo(8583, &gh.Repository{Name: gh.String("svc-api")}).
+				WithRepo(8583, &gh.Repository{Name: gh.String("svc-web")}).
+				WithRepo(8583, &gh.Repository{Name: gh.String("docs")}).
+				WithTeamRepo(8583, 1, "svc-api", "pull").
+				Start()
+			t.Cleanup(server.Close)
+			teams := github.NewTeamReadWriter(github.NewStaticTokenSource("token"), server.Client(), nil)
+
+			err := SyncRepoPermissions(context.Background(), teams, &api.GroupMappings{Mappings: tc.mappings}, tc.dryRun)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(server.TeamRepos(8583, 1), tc.wantRepos); diff != "" {
+				t.Errorf("unexpected team repos (-got, +want):\n%s", diff)
+			}
+			if got := server.TeamRepos(8583, 2); len(got) > 0 {
+				t.Errorf("team without repo_permissions got repos %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+)
+
+// Review statuses of user mappings.
+const (
+	ReviewStatusExpired       = "expired"
+	ReviewStatusExpiring      = "expiring"
+	ReviewStatusInvalidExpiry = "invalid_expiry"
+)
+
+// MappingReview is a user mapping that needs review.
+type MappingReview struct {
+	Mapping *api.UserMapping
+	// Status is one of the ReviewStatus constants.
+	Status string
+	// ExpiresAt is the expiry of the mapping, zero if it is invalid.
+	ExpiresAt time.Time
+}
+
+// ParseMappingExpiry parses the expires_at of a user mapping. It returns the
+// zero time if the mapping never expires.
+func ParseMappingExpiry(expiresAt string) (time.Time, error) {
+	if expiresAt == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, expiresAt); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, expiresAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expires_at %q, must be an RFC 3339 timestamp or a date", expiresAt)
+	}
+	return t, nil
+}
+
+// ActiveUserMappings returns the user mappings which have not expired at the
+// given time. A warning is logged for every expired mapping. Mappings with an
+// invalid expiry are treated as expired so that they do not grant access.
+func ActiveUserMappings(ctx context.Context, um *api.UserMappings, now time.Time) *api.UserMappings {
+	logger := logging.FromContext(ctx)
+
+	active := &api.UserMappings{Mappings: make([]*api.UserMapping, 0, len(um.GetMappings()))}
+	for _, m := range um.GetMappings() {
+		expiresAt, err := ParseMappingExpiry(m.GetExpiresAt())
+		if err != nil {
+			logger.WarnContext(ctx, "ignoring user mapping with invalid expiry",
+				"source_user", m.GetSource(),
+				"target_user", m.GetTarget(),
+				"owner", m.GetOwner(),
+				"error", err,
+			)
+			continue
+		}
+		if !expiresAt.IsZero() && !now.Before(expiresAt) {
+			logger.WarnContext(ctx, "ignoring expired user mapping",
+				"source_user", m.GetSource(),
+				"target_user", m.GetTarget(),
+				"owner", m.GetOwner(),
+				"expires_at", m.GetExpiresAt(),
+			)
+			continue
+		}
+		active.Mappings = append(active.Mappings, m)
+	}
+	return active
+}
+
+// ReviewUserMappings returns the user mappings which have expired, or expire
+// within the given duration, at the given time, along with mappings whose
+// expiry is invalid. They are sorted by expiry, invalid ones first.
+func ReviewUserMappings(um *api.UserMappings, now time.Time, within time.Duration) []*MappingReview {
+	var reviews []*MappingReview
+	for _, m := range um.GetMappings() {
+		expiresAt, err := ParseMappingExpiry(m.GetExpiresAt())
+		switch {
+		case err != nil:
+			reviews = append(reviews, &MappingReview{Mapping: m, Status: ReviewStatusInvalidExpiry})
+		case expiresAt.IsZero():
+		case !now.Before(expiresAt):
+			reviews = append(reviews, &MappingReview{Mapping: m, Status: ReviewStatusExpired, ExpiresAt: expiresAt})
+		case expiresAt.Sub(now) <= within:
+			reviews = append(reviews, &MappingReview{Mapping: m, Status: ReviewStatusExpiring, ExpiresAt: expiresAt})
+		}
+	}
+	sort.SliceStable(reviews, func(i, j int) bool {
+		return reviews[i].ExpiresAt.Before(reviews[j].ExpiresAt)
+	})
+	return reviews
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+)
+
+func TestActiveUserMappings(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	noExpiry := &api.UserMapping{Source: "a@example.com", Target: "a"}
+	future := &api.UserMapping{Source: "b@example.com", Target: "b", ExpiresAt: "2026-06-02"}
+	expired := &api.UserMapping{Source: "c@example.com", Target: "c", ExpiresAt: "2026-06-01T12:00:00Z", Owner: "mgr@example.com"}
+	invalid := &api.UserMapping{Source: "d@example.com", Target: "d", ExpiresAt: "next week"}
+
+	got := ActiveUserMappings(context.Background(), &api.UserMappings{
+		Mappings: []*api.UserMapping{noExpiry, future, expired, invalid},
+	}, now)
+	want := &api.UserMappings{Mappings: []*api.UserMapping{noExpiry, future}}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("ActiveUserMappings got unexpected result (-got, +want):\n%s", diff)
+	}
+}
+
+func TestReviewUserMappings(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	noExpiry := &api.UserMapping{Source: "a@example.com", Target: "a"}
+	later := &api.UserMapping{Source: "b@example.com", Target: "b", ExpiresAt: "2026-09-01"}
+	expiring := &api.UserMapping{Source: "c@example.com", Target: "c", ExpiresAt: "2026-06-10"}
+	expired := &api.UserMapping{Source: "d@example.com", Target: "d", ExpiresAt: "2026-05-01T00:00:00Z"}
+	invalid := &api.UserMapping{Source: "e@example.com", Target: "e", ExpiresAt: "soon"}
+
+	got := ReviewUserMappings(&api.UserMappings{
+		Mappings: []*api.UserMapping{noExpiry, later, expiring, expired, invalid},
+	}, now, 30*24*time.Hour)
+	want := []*MappingReview{
+		{Mapping: invalid, Status: ReviewStatusInvalidExpiry},
+		{Mapping: expired, Status: ReviewStatusExpired, ExpiresAt: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{Mapping: expiring, Status: ReviewStatusExpiring, ExpiresAt: time.Date(2026, 6, 10, 0, 0, 0, 0, time.UTC)},
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("ReviewUserMappings got unexpected result (-got, +want):\n%s", diff)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/abcxyz/pkg/logging"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/gitlab"
+)
+
+// ProvisionGitLabServiceAccounts creates the given GitLab service accounts
+// which do not exist, unless dryRun, in which case they are only logged.
+// Every account is attempted, and the errors of those which could not be
+// checked or created are joined.
+func ProvisionGitLabServiceAccounts(ctx context.Context, rw *gitlab.GroupReadWriter, accounts []*api.GitLabServiceAccount, dryRun bool) error {
+	logger := logging.FromContext(ctx)
+	var merr error
+	for _, sa := range accounts {
+		if sa.GetUsername() == "" {
+			merr = errors.Join(merr, fmt.Errorf("gitlab service account has no username"))
+			continue
+		}
+		if dryRun {
+			if _, err := rw.GetUser(ctx, sa.GetUsername()); err != nil {
+				logger.WarnContext(ctx, "gitlab service account is not created in a dry run",
+					"username", sa.GetUsername(),
+					"error", err,
+				)
+			}
+			continue
+		}
+		user, created, err := rw.EnsureServiceAccount(ctx, int(sa.GetGroupId()), sa.GetUsername(), sa.GetName())
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to provision gitlab service account %s: %w", sa.GetUsername(), err))
+			continue
+		}
+		if created {
+			logger.InfoContext(ctx, "created gitlab service account",
+				"username", user.ID,
+				"user_id", user.StableID,
+				"group_id", sa.GetGroupId(),
+			)
+		}
+	}
+	return merr
+}
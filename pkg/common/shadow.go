@@ -0,0 +1,128 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/abcxyz/pkg/logging"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// DefaultShadowTeamSlugPrefix is the default prefix of the slugs of the
+// copies of teams synced by shadow runs.
+const DefaultShadowTeamSlugPrefix = "shadow-"
+
+// githubTeam is implemented by the attributes of the groups of a
+// github.TeamReadWriter.
+type githubTeam interface {
+	GetID() int64
+	GetSlug() string
+}
+
+// ShadowGitHubTeams points the GitHub target teams of the group mappings at
+// their copies in the sandbox org of the given shadow config, so that a sync
+// changes no production team. Missing copies are created, unless dryRun, in
+// which case their mappings fail to sync. The repository permissions,
+// environment reviewers and branch allowances of the teams, which refer to
+// production repositories, are dropped. If any team cannot be shadowed an
+// error is returned, and the mappings must not be synced.
+func ShadowGitHubTeams(ctx context.Context, teams *github.TeamReadWriter, gm *api.GroupMappings, shadow *api.ShadowConfig, dryRun bool) error {
+	sandboxOrgID := shadow.GetGithubOrgId()
+	if sandboxOrgID == 0 {
+		return fmt.Errorf("shadow config has no github_org_id")
+	}
+	prefix := shadow.GetTeamSlugPrefix()
+	if prefix == "" {
+		prefix = DefaultShadowTeamSlugPrefix
+	}
+
+	logger := logging.FromContext(ctx)
+	var merr error
+	for _, m := range gm.GetMappings() {
+		gh := m.GetGithub()
+		if gh == nil {
+			continue
+		}
+		if gh.GetOrgId() == sandboxOrgID {
+			merr = errors.Join(merr, fmt.Errorf("team %d:%d is already in the sandbox org", gh.GetOrgId(), gh.GetTeamId()))
+			continue
+		}
+		slug := gh.GetTeamSlug()
+		if slug == "" {
+			team, err := teams.GetGroup(ctx, github.Encode(gh.GetOrgId(), gh.GetTeamId()))
+			if err != nil {
+				merr = errors.Join(merr, fmt.Errorf("failed to get slug of team %d:%d: %w", gh.GetOrgId(), gh.GetTeamId(), err))
+				continue
+			}
+			attrs, ok := team.Attributes.(githubTeam)
+			if !ok {
+				merr = errors.Join(merr, fmt.Errorf("team %d:%d has no slug", gh.GetOrgId(), gh.GetTeamId()))
+				continue
+			}
+			slug = attrs.GetSlug()
+		}
+		shadowSlug := prefix + slug
+
+		var shadowTeamID int64
+		switch copied, err := teams.GetTeamBySlug(ctx, sandboxOrgID, shadowSlug); {
+		case err == nil:
+			shadowTeamID = teamID(copied)
+		case errors.Is(err, groupsync.ErrGroupNotFound) && dryRun:
+			logger.WarnContext(ctx, "shadow team does not exist and is not created in a dry run",
+				"org_id", sandboxOrgID,
+				"team_slug", shadowSlug,
+			)
+		case errors.Is(err, groupsync.ErrGroupNotFound):
+			created, err := teams.CreateTeam(ctx, sandboxOrgID, shadowSlug,
+				fmt.Sprintf("Shadow copy of team %s of org %d", slug, gh.GetOrgId()))
+			if err != nil {
+				merr = errors.Join(merr, fmt.Errorf("failed to create shadow team %s: %w", shadowSlug, err))
+				continue
+			}
+			shadowTeamID = teamID(created)
+		default:
+			merr = errors.Join(merr, fmt.Errorf("failed to get shadow team %s: %w", shadowSlug, err))
+			continue
+		}
+
+		logger.InfoContext(ctx, "shadowing team",
+			"org_id", gh.GetOrgId(),
+			"team_slug", slug,
+			"shadow_org_id", sandboxOrgID,
+			"shadow_team_slug", shadowSlug,
+		)
+		m.Target = &api.GroupMapping_Github{Github: &api.GitHub{
+			OrgId:                sandboxOrgID,
+			TeamId:               shadowTeamID,
+			TeamSlug:             shadowSlug,
+			RequireUserEnableSso: gh.GetRequireUserEnableSso(),
+		}}
+	}
+	return merr
+}
+
+// teamID returns the GitHub team ID of a group of a github.TeamReadWriter.
+func teamID(team *groupsync.Group) int64 {
+	attrs, _ := team.Attributes.(githubTeam)
+	if attrs == nil {
+		return 0
+	}
+	return attrs.GetID()
+}
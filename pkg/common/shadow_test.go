@@ -0,0 +1,187 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gh "github.com/google/go-github/v61/github"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/githubtest"
+)
+
+func TestShadowGitHubTeams(t *testing.T) {
+	t.Parallel()
+
+	source := &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: "groups/1"}}
+
+	cases := []struct {
+		name         string
+		mappings     []*api.GroupMapping
+		shadow       *api.ShadowConfig
+		dryRun       bool
+		wantMappings []*api.GroupMapping
+		wantErr      string
+	}{
+		{
+			name: "success",
+			mappings: []*api.GroupMapping{
+				{
+					Source: source,
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{
+						OrgId:                8583,
+						TeamId:               1,
+						RequireUserEnableSso: true,
+						RepoPermissions:      []*api.RepoPermission{{RepoPattern: "svc-.*", Permission: "push"}},
+					}},
+				},
+				{
+					Source: source,
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 8583, TeamId: 2, TeamSlug: "team2"}},
+				},
+				{
+					Source: source,
+					Target: &api.GroupMapping_Gitlab{Gitlab: &api.GitLab{GroupId: 5}},
+				},
+			},
+			shadow: &api.ShadowConfig{GithubOrgId: 9999},
+			wantMappings: []*api.GroupMapping{
+				{
+					Source: source,
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{
+						OrgId:                9999,
+						TeamId:               11,
+						TeamSlug:             "shadow-team1",
+						RequireUserEnableSso: true,
+					}},
+				},
+				{
+					Source: source,
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 9999, TeamId: 10, TeamSlug: "shadow-team2"}},
+				},
+				{
+					Source: source,
+					Target: &api.GroupMapping_Gitlab{Gitlab: &api.GitLab{GroupId: 5}},
+				},
+			},
+		},
+		{
+			name: "custom_prefix",
+			mappings: []*api.GroupMapping{
+				{
+					Source: source,
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 8583, TeamId: 1}},
+				},
+			},
+			shadow: &api.ShadowConfig{GithubOrgId: 9999, TeamSlugPrefix: "rehearsal-"},
+			wantMappings: []*api.GroupMapping{
+				{
+					Source: source,
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 9999, TeamId: 11, TeamSlug: "rehearsal-team1"}},
+				},
+			},
+		},
+		{
+			name: "dry_run",
+			mappings: []*api.GroupMapping{
+				{
+					Source: source,
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 8583, TeamId: 1}},
+				},
+			},
+			shadow: &api.ShadowConfig{GithubOrgId: 9999},
+			dryRun: true,
+			wantMappings: []*api.GroupMapping{
+				{
+					Source: source,
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 9999, TeamSlug: "shadow-team1"}},
+				},
+			},
+		},
+		{
+			name: "unknown_team",
+			mappings: []*api.GroupMapping{
+				{
+					Source: source,
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 8583, TeamId: 3}},
+				},
+			},
+			shadow: &api.ShadowConfig{GithubOrgId: 9999},
+			wantMappings: []*api.GroupMapping{
+				{
+					Source: source,
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 8583, TeamId: 3}},
+				},
+			},
+			wantErr: "failed to get slug of team 8583:3",
+		},
+		{
+			name: "sandbox_team",
+			mappings: []*api.GroupMapping{
+				{
+					Source: source,
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 9999, TeamId: 10}},
+				},
+			},
+			shadow: &api.ShadowConfig{GithubOrgId: 9999},
+			wantMappings: []*api.GroupMapping{
+				{
+					Source: source,
+					Target: &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 9999, TeamId: 10}},
+				},
+			},
+			wantErr: "team 9999:10 is already in the sandbox org",
+		},
+		{
+			name:    "missing_sandbox_org",
+			shadow:  &api.ShadowConfig{},
+			wantErr: "shadow config has no github_org_id",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := githubtest.NewBuilder().
+				WithOrg(8583, "org1").
+				WithOrg(9999, "sandbox").
+				WithTeam(8583, &gh.Team{ID: gh.Int64(1), Slug: gh.String("team1")}).
+				WithTeam(8583, &gh.Team{ID: gh.Int64(2), Slug: gh.String("team2")}).
+				WithTeam(9999, &gh.Team{ID: gh.Int64(10), Slug: gh.String("shadow-team2")}).
+				Start()
+			t.Cleanup(server.Close)
+			teams := github.NewTeamReadWriter(github.NewStaticTokenSource("token"), server.Client(), nil)
+
+			gm := &api.GroupMappings{Mappings: tc.mappings}
+			err := ShadowGitHubTeams(context.Background(), teams, gm, tc.shadow, tc.dryRun)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(gm.GetMappings(), tc.wantMappings, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected mappings (-got, +want):\n%s", diff)
+			}
+			if got := server.Team(8583, 11); got != nil {
+				t.Errorf("shadow team was created in the production org: %v", got)
+			}
+		})
+	}
+}
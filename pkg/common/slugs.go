@@ -0,0 +1,112 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/github"
+)
+
+// ResolveGitHubTeams sets the team ID of every GitHub mapping configured with
+// a team slug, and returns the teams whose slug has changed since the mapping
+// was written. Mappings that cannot be resolved are left unchanged.
+func ResolveGitHubTeams(ctx context.Context, resolver *github.TeamSlugResolver, gm *api.GroupMappings) ([]*github.TeamRename, error) {
+	var renames []*github.TeamRename
+	var merr error
+	for _, m := range gm.GetMappings() {
+		gh := gitHubTeam(m)
+		if gh.GetTeamSlug() == "" {
+			continue
+		}
+		teamID, rename, err := resolver.Resolve(ctx, gh.GetOrgId(), gh.GetTeamId(), gh.GetTeamSlug())
+		if err != nil {
+			merr = errors.Join(merr, err)
+		}
+		if teamID != 0 {
+			gh.TeamId = teamID
+		}
+		if rename != nil {
+			renames = append(renames, rename)
+		}
+	}
+	return renames, merr
+}
+
+// RewriteTeamSlugs replaces the old slug of each rename with its new slug in
+// the given mapping textproto. Formatting and comments are preserved. A slug
+// which is configured for teams of more than one org is ambiguous and not
+// rewritten. It returns the updated content and the renames that were applied.
+func RewriteTeamSlugs(content []byte, renames []*github.TeamRename) ([]byte, []*github.TeamRename, error) {
+	var tm api.TeamLinkMappings
+	if err := prototext.Unmarshal(content, &tm); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal mapping file: %w", err)
+	}
+	slugOrgs := make(map[string]map[int64]struct{})
+	for _, m := range tm.GetGroupMappings().GetMappings() {
+		gh := gitHubTeam(m)
+		if gh.GetTeamSlug() == "" {
+			continue
+		}
+		if slugOrgs[gh.GetTeamSlug()] == nil {
+			slugOrgs[gh.GetTeamSlug()] = make(map[int64]struct{})
+		}
+		slugOrgs[gh.GetTeamSlug()][gh.GetOrgId()] = struct{}{}
+	}
+
+	var applied []*github.TeamRename
+	for _, r := range renames {
+		orgs := slugOrgs[r.OldSlug]
+		if _, ok := orgs[r.OrgID]; !ok || len(orgs) > 1 {
+			continue
+		}
+		pattern := regexp.MustCompile(`(team_slug\s*:\s*)"` + regexp.QuoteMeta(r.OldSlug) + `"`)
+		content = pattern.ReplaceAll(content, []byte(`${1}"`+r.NewSlug+`"`))
+		applied = append(applied, r)
+	}
+	return content, applied, nil
+}
+
+// TeamRenamePullRequest returns a title and markdown body for a pull request
+// which updates the mapping config with the given renames.
+func TeamRenamePullRequest(renames []*github.TeamRename) (string, string) {
+	var b strings.Builder
+	b.WriteString("The following GitHub teams were renamed. team-link keeps syncing them by ")
+	b.WriteString("their immutable team ID; this updates the mapping config to their current slugs.\n\n")
+	b.WriteString("| Org ID | Team ID | Old slug | New slug | Detected |\n")
+	b.WriteString("| --- | --- | --- | --- | --- |\n")
+	for _, r := range renames {
+		fmt.Fprintf(&b, "| %d | %d | `%s` | `%s` | %s |\n",
+			r.OrgID, r.TeamID, r.OldSlug, r.NewSlug, r.DetectedAt.Format(time.RFC3339))
+	}
+	return "Update renamed GitHub team slugs", b.String()
+}
+
+// gitHubTeam returns the GitHub team of a mapping, which may be either the
+// source or the target.
+func gitHubTeam(m *api.GroupMapping) *api.GitHub {
+	if gh := m.GetGithub(); gh != nil {
+		return gh
+	}
+	return m.GetSourceGithub()
+}
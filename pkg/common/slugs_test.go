@@ -0,0 +1,123 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/github"
+)
+
+func TestRewriteTeamSlugs(t *testing.T) {
+	t.Parallel()
+
+	const content = `group_mappings {
+  mappings: [
+    {
+      # the platform team
+      google_groups: { group_id: "groups/a" }
+      github: { org_id: 1 team_slug: "platform" }
+    },
+    {
+      google_groups: { group_id: "groups/b" }
+      github: { org_id: 1 team_slug:"web" }
+    },
+    {
+      google_groups: { group_id: "groups/c" }
+      github: { org_id: 1 team_slug: "shared" }
+    },
+    {
+      google_groups: { group_id: "groups/d" }
+      github: { org_id: 2 team_slug: "shared" }
+    }
+  ]
+}
+`
+	platform := &github.TeamRename{OrgID: 1, TeamID: 10, OldSlug: "platform", NewSlug: "platform-eng"}
+	web := &github.TeamRename{OrgID: 1, TeamID: 11, OldSlug: "web", NewSlug: "frontend"}
+	shared := &github.TeamRename{OrgID: 1, TeamID: 12, OldSlug: "shared", NewSlug: "shared-1"}
+	otherOrg := &github.TeamRename{OrgID: 3, TeamID: 13, OldSlug: "web", NewSlug: "web-3"}
+
+	cases := []struct {
+		name        string
+		content     string
+		renames     []*github.TeamRename
+		wantContent string
+		wantApplied []*github.TeamRename
+		wantErr     string
+	}{
+		{
+			name:    "rewrites_renamed_slugs",
+			content: content,
+			renames: []*github.TeamRename{platform, web, shared, otherOrg},
+			wantContent: `group_mappings {
+  mappings: [
+    {
+      # the platform team
+      google_groups: { group_id: "groups/a" }
+      github: { org_id: 1 team_slug: "platform-eng" }
+    },
+    {
+      google_groups: { group_id: "groups/b" }
+      github: { org_id: 1 team_slug:"frontend" }
+    },
+    {
+      google_groups: { group_id: "groups/c" }
+      github: { org_id: 1 team_slug: "shared" }
+    },
+    {
+      google_groups: { group_id: "groups/d" }
+      github: { org_id: 2 team_slug: "shared" }
+    }
+  ]
+}
+`,
+			wantApplied: []*github.TeamRename{platform, web},
+		},
+		{
+			name:        "no_renames",
+			content:     content,
+			wantContent: content,
+		},
+		{
+			name:    "invalid_content",
+			content: "group_mappings {",
+			wantErr: "failed to unmarshal mapping file",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, applied, err := RewriteTeamSlugs([]byte(tc.content), tc.renames)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(string(got), tc.wantContent); diff != "" {
+				t.Errorf("unexpected content (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(applied, tc.wantApplied); diff != "" {
+				t.Errorf("unexpected applied renames (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// StaticMembers returns the static_members of the group mappings, keyed by
+// the ID of their target group in the given target system. The static
+// members of the mappings sharing a target group are combined.
+func StaticMembers(targetSystem string, gm *api.GroupMappings) map[string][]groupsync.Member {
+	var members map[string][]groupsync.Member
+	for _, m := range gm.GetMappings() {
+		if len(m.GetStaticMembers()) == 0 {
+			continue
+		}
+		targetID, ok := mappedGroupID(targetSystem, m)
+		if !ok {
+			continue
+		}
+		if members == nil {
+			members = make(map[string][]groupsync.Member)
+		}
+		for _, u := range m.GetStaticMembers() {
+			members[targetID] = append(members[targetID], &groupsync.UserMember{
+				Usr:  &groupsync.User{ID: u.GetId()},
+				Role: u.GetRole(),
+			})
+		}
+	}
+	return members
+}
+
+// MemberLimits returns the max_members of the group mappings, keyed by the ID
+// of their target group in the given target system. The smallest limit of the
+// mappings sharing a target group applies.
+func MemberLimits(targetSystem string, gm *api.GroupMappings) map[string]int {
+	var limits map[string]int
+	for _, m := range gm.GetMappings() {
+		limit := int(m.GetMaxMembers())
+		if limit <= 0 {
+			continue
+		}
+		targetID, ok := mappedGroupID(targetSystem, m)
+		if !ok {
+			continue
+		}
+		if limits == nil {
+			limits = make(map[string]int)
+		}
+		if current, ok := limits[targetID]; !ok || limit < current {
+			limits[targetID] = limit
+		}
+	}
+	return limits
+}
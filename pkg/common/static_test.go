@@ -0,0 +1,78 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestStaticMembers(t *testing.T) {
+	t.Parallel()
+
+	mapping := func(sourceID, targetID string, static ...*api.TargetUser) *api.GroupMapping {
+		return &api.GroupMapping{
+			Source:        &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: sourceID}},
+			Target:        &api.GroupMapping_Gerrit{Gerrit: &api.Gerrit{GroupId: targetID}},
+			StaticMembers: static,
+		}
+	}
+	gm := &api.GroupMappings{Mappings: []*api.GroupMapping{
+		mapping("groups/a", "reviewers", &api.TargetUser{Id: "ci-bot"}),
+		mapping("groups/b", "reviewers", &api.TargetUser{Id: "emeritus", Role: "owner"}),
+		mapping("groups/c", "leads"),
+	}}
+
+	got := StaticMembers(tltypes.SystemTypeGerrit, gm)
+	want := map[string][]groupsync.Member{
+		"reviewers": {
+			&groupsync.UserMember{Usr: &groupsync.User{ID: "ci-bot"}},
+			&groupsync.UserMember{Usr: &groupsync.User{ID: "emeritus"}, Role: "owner"},
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected static members (-got, +want):\n%s", diff)
+	}
+}
+
+func TestMemberLimits(t *testing.T) {
+	t.Parallel()
+
+	mapping := func(sourceID, targetID string, limit int32) *api.GroupMapping {
+		return &api.GroupMapping{
+			Source:     &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: sourceID}},
+			Target:     &api.GroupMapping_Gerrit{Gerrit: &api.Gerrit{GroupId: targetID}},
+			MaxMembers: limit,
+		}
+	}
+	gm := &api.GroupMappings{Mappings: []*api.GroupMapping{
+		mapping("groups/a", "admins", 20),
+		mapping("groups/b", "admins", 10),
+		mapping("groups/c", "admins", 0),
+		mapping("groups/d", "reviewers", 500),
+		mapping("groups/e", "leads", 0),
+	}}
+
+	got := MemberLimits(tltypes.SystemTypeGerrit, gm)
+	want := map[string]int{"admins": 10, "reviewers": 500}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected member limits (-got, +want):\n%s", diff)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+// Statuses of the groups of a RunSummary.
+const (
+	SummaryApplied = "applied"
+	SummaryPlanned = "not applied (read-only)"
+	SummaryFailed  = "failed"
+)
+
+// GroupSummary is the membership impact of a sync on a target group.
+type GroupSummary struct {
+	GroupID string
+	// Add, Remove and Update are the IDs of the members added, removed and
+	// given another role, sorted.
+	Add    []string
+	Remove []string
+	Update []string
+	// Status is one of SummaryApplied, SummaryPlanned or SummaryFailed.
+	Status string
+	// Err is the error of failed writes.
+	Err error
+}
+
+// RunSummary collects the membership impact of a sync on its target groups,
+// e.g. to show reviewers of a mapping change what it would do.
+type RunSummary struct {
+	mu     sync.Mutex
+	groups map[string]*GroupSummary
+}
+
+// NewRunSummary creates an empty RunSummary.
+func NewRunSummary() *RunSummary {
+	return &RunSummary{groups: make(map[string]*GroupSummary)}
+}
+
+// WithSummary records the membership changes of target groups in summary.
+// With WithReadOnly the changes which would have been made are recorded.
+func WithSummary(summary *RunSummary) SyncOpt {
+	return func(config *SyncConfig) {
+		config.summary = summary
+	}
+}
+
+// Groups returns the summaries of the target groups which changed or failed
+// to change, sorted by group ID.
+func (s *RunSummary) Groups() []*GroupSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	groups := make([]*GroupSummary, 0, len(s.groups))
+	for _, id := range utils.MapKeys(s.groups) {
+		groups = append(groups, s.groups[id])
+	}
+	return groups
+}
+
+func (s *RunSummary) record(g *GroupSummary) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.groups[g.GroupID] = g
+}
+
+// Markdown renders the summary as markdown, e.g. for a pull request comment.
+// The failed groups of err, if it is a groupsync.SyncError, are listed too,
+// except for the refused writes of a read-only sync.
+func (s *RunSummary) Markdown(err error) string {
+	groups := s.Groups()
+	var b strings.Builder
+	b.WriteString("### team-link sync summary\n\n")
+	if len(groups) == 0 {
+		b.WriteString("No membership changes.\n")
+	} else {
+		b.WriteString("| Target group | Added | Removed | Role changed | Status |\n")
+		b.WriteString("| --- | --- | --- | --- | --- |\n")
+		for _, g := range groups {
+			status := g.Status
+			if g.Err != nil {
+				status = fmt.Sprintf("%s: %s", status, markdownCell(g.Err.Error()))
+			}
+			fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s |\n",
+				g.GroupID, markdownIDs(g.Add), markdownIDs(g.Remove), markdownIDs(g.Update), status)
+		}
+	}
+
+	var syncErr *groupsync.SyncError
+	if !errors.As(err, &syncErr) {
+		if err != nil {
+			fmt.Fprintf(&b, "\nThe sync failed: %s\n", markdownCell(err.Error()))
+		}
+		return b.String()
+	}
+	var failures []*groupsync.GroupError
+	for _, ge := range syncErr.Errors {
+		if !errors.Is(ge.Err, groupsync.ErrReadOnly) {
+			failures = append(failures, ge)
+		}
+	}
+	if len(failures) > 0 {
+		b.WriteString("\n#### Failures\n\n")
+		b.WriteString("| Category | Source group | Target group | Error |\n")
+		b.WriteString("| --- | --- | --- | --- |\n")
+		for _, ge := range failures {
+			fmt.Fprintf(&b, "| %s | `%s` | `%s` | %s |\n",
+				ge.Category, ge.SourceGroupID, ge.TargetGroupID, markdownCell(ge.Err.Error()))
+		}
+	}
+	return b.String()
+}
+
+// markdownIDs renders member IDs as a markdown table cell.
+func markdownIDs(ids []string) string {
+	if len(ids) == 0 {
+		return "-"
+	}
+	quoted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		quoted = append(quoted, "`"+id+"`")
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// markdownCell escapes text so that it fits in a markdown table cell.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+// summaryWriter records the membership changes of target groups in a
+// RunSummary. Reads are passed through.
+type summaryWriter struct {
+	groupsync.WrappedWriter
+	summary   *RunSummary
+	readOnly  bool
+	normalize groupsync.IDNormalizer
+}
+
+func newSummaryWriter(rw groupsync.GroupReadWriter, summary *RunSummary, readOnly bool, normalize groupsync.IDNormalizer) *summaryWriter {
+	return &summaryWriter{WrappedWriter: groupsync.WrappedWriter{GroupReadWriter: rw}, summary: summary, readOnly: readOnly, normalize: normalize}
+}
+
+// SetMembers sets the members of the target group and records the changes
+// to its current members, unless there are none.
+func (w *summaryWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	current, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	var diffOpts []groupsync.DiffOpt
+	if w.normalize != nil {
+		diffOpts = append(diffOpts, groupsync.DiffNormalizeIDs(w.normalize))
+	}
+	diff := groupsync.ComputeDiff(current, members, diffOpts...)
+
+	err = w.GroupReadWriter.SetMembers(ctx, groupID, members)
+	if diff.IsEmpty() && err == nil {
+		return nil
+	}
+	g := &GroupSummary{
+		GroupID: groupID,
+		Add:     summaryIDs(diff.Add),
+		Remove:  summaryIDs(diff.Remove),
+		Status:  SummaryApplied,
+	}
+	for _, u := range diff.Update {
+		g.Update = append(g.Update, u.ID())
+	}
+	switch {
+	case w.readOnly && (err == nil || errors.Is(err, groupsync.ErrReadOnly)):
+		g.Status = SummaryPlanned
+	case err != nil:
+		g.Status = SummaryFailed
+		g.Err = err
+	}
+	w.summary.record(g)
+	return err //nolint:wrapcheck // Want passthrough
+}
+
+func summaryIDs(members []groupsync.Member) []string {
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.ID())
+	}
+	return ids
+}
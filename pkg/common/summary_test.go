@@ -0,0 +1,121 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestSummaryWriter(t *testing.T) {
+	t.Parallel()
+
+	users := func(ids ...string) []groupsync.Member {
+		members := make([]groupsync.Member, 0, len(ids))
+		for _, id := range ids {
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: id}})
+		}
+		return members
+	}
+
+	cases := []struct {
+		name        string
+		readOnly    bool
+		members     []groupsync.Member
+		want        []*GroupSummary
+		wantWritten map[string][]string
+	}{
+		{
+			name:        "applied",
+			members:     users("bob", "carol"),
+			want:        []*GroupSummary{{GroupID: "team", Add: []string{"carol"}, Remove: []string{"alice"}, Status: SummaryApplied}},
+			wantWritten: map[string][]string{"team": {"bob", "carol"}},
+		},
+		{
+			name:        "read_only",
+			readOnly:    true,
+			members:     users("bob", "carol"),
+			want:        []*GroupSummary{{GroupID: "team", Add: []string{"carol"}, Remove: []string{"alice"}, Status: SummaryPlanned}},
+			wantWritten: map[string][]string{"team": {"alice", "bob"}},
+		},
+		{
+			name:        "unchanged",
+			members:     users("alice", "bob"),
+			want:        []*GroupSummary{},
+			wantWritten: map[string][]string{"team": {"alice", "bob"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rw := userGroups(map[string][]string{"team": {"alice", "bob"}})
+			var writer groupsync.GroupReadWriter = rw
+			if tc.readOnly {
+				writer = groupsync.NewReadOnlyWriter(rw)
+			}
+			summary := NewRunSummary()
+			w := newSummaryWriter(writer, summary, tc.readOnly, nil)
+
+			err := w.SetMembers(context.Background(), "team", tc.members)
+			if tc.readOnly != errors.Is(err, groupsync.ErrReadOnly) {
+				t.Errorf("SetMembers got err %v, want read-only error %t", err, tc.readOnly)
+			}
+			if diff := cmp.Diff(summary.Groups(), tc.want, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected summary (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(groupUserIDs(rw), tc.wantWritten); diff != "" {
+				t.Errorf("unexpected written members (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSummary_Markdown(t *testing.T) {
+	t.Parallel()
+
+	summary := NewRunSummary()
+	summary.record(&GroupSummary{GroupID: "2", Remove: []string{"bob"}, Status: SummaryFailed, Err: fmt.Errorf("a | b")})
+	summary.record(&GroupSummary{GroupID: "1", Add: []string{"alice", "carol"}, Update: []string{"dave"}, Status: SummaryApplied})
+	err := &groupsync.SyncError{Errors: []*groupsync.GroupError{
+		{SourceGroupID: "src", TargetGroupID: "2", Category: groupsync.ErrorCategoryAPI, Err: fmt.Errorf("boom")},
+		{SourceGroupID: "src", TargetGroupID: "3", Category: groupsync.ErrorCategoryAPI, Err: groupsync.ErrReadOnly},
+	}}
+
+	want := "### team-link sync summary\n\n" +
+		"| Target group | Added | Removed | Role changed | Status |\n" +
+		"| --- | --- | --- | --- | --- |\n" +
+		"| `1` | `alice`, `carol` | - | `dave` | applied |\n" +
+		"| `2` | - | `bob` | - | failed: a \\| b |\n" +
+		"\n#### Failures\n\n" +
+		"| Category | Source group | Target group | Error |\n" +
+		"| --- | --- | --- | --- |\n" +
+		"| api | `src` | `2` | boom |\n"
+	if diff := cmp.Diff(summary.Markdown(err), want); diff != "" {
+		t.Errorf("unexpected markdown (-got, +want):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(NewRunSummary().Markdown(nil), "### team-link sync summary\n\nNo membership changes.\n"); diff != "" {
+		t.Errorf("unexpected empty markdown (-got, +want):\n%s", diff)
+	}
+}
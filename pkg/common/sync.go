@@ -18,13 +18,425 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
+	"github.com/abcxyz/pkg/logging"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/gitlab"
 	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/simulation"
+	"github.com/abcxyz/team-link/pkg/state"
 	"github.com/abcxyz/team-link/pkg/utils"
 )
 
+// SyncConfig holds the optional settings of Sync.
+type SyncConfig struct {
+	store        state.Store
+	sourceSystem string
+	targetSystem string
+	syncerOpts   []groupsync.Opt
+	githubOpts   []github.Opt
+	roleConflict groupsync.RoleConflictPolicy
+	since        time.Time
+	suspended    groupsync.SuspendedUserPolicy
+	suspendGrace time.Duration
+	deleted      groupsync.DeletedGroupPolicy
+	escalations  groupsync.EscalationPolicy
+	noPreflight  bool
+	detectDrift  bool
+	shadow       bool
+	readOnly     bool
+	batchSize    int
+	recording    *simulation.Fixture
+	replaySource *simulation.Replayer
+	replayTarget *simulation.Replayer
+	desired      *desiredState
+	changeSinks  []groupsync.ChangeSink
+	summary      *RunSummary
+	confirm      groupsync.ConfirmFunc
+	pipelines    []string
+}
+
+// SyncOpt configures Sync.
+type SyncOpt func(config *SyncConfig)
+
+// WithStateStore sets the store which keeps state between runs, such as
+// resolved GitHub team slugs. By default state is only kept for the run.
+func WithStateStore(store state.Store) SyncOpt {
+	return func(config *SyncConfig) {
+		config.store = store
+	}
+}
+
+// WithSystems overrides the source and target systems given by the config.
+// Both systems must be configured, as either the source or the target, which
+// allows running a sync in the reverse direction of the config. When the
+// direction is reversed, the user mappings are reversed too.
+func WithSystems(source, target string) SyncOpt {
+	return func(config *SyncConfig) {
+		config.sourceSystem = source
+		config.targetSystem = target
+	}
+}
+
+// WithPipelines only syncs the pipelines of the config with the given names,
+// instead of all of them.
+func WithPipelines(names ...string) SyncOpt {
+	return func(config *SyncConfig) {
+		config.pipelines = append(config.pipelines, names...)
+	}
+}
+
+// WithSyncerOpts sets the options passed to the underlying syncer.
+func WithSyncerOpts(opts ...groupsync.Opt) SyncOpt {
+	return func(config *SyncConfig) {
+		config.syncerOpts = append(config.syncerOpts, opts...)
+	}
+}
+
+// WithRoleConflictPolicy sets how the role of a target user is decided when
+// several source users of a target group map to it with different roles.
+// With groupsync.RoleConflictError, user mappings which map a target user
+// with different roles fail the sync before any group is synced.
+func WithRoleConflictPolicy(policy groupsync.RoleConflictPolicy) SyncOpt {
+	return func(config *SyncConfig) {
+		config.roleConflict = policy
+		config.syncerOpts = append(config.syncerOpts, groupsync.WithRoleConflictPolicy(policy))
+	}
+}
+
+// WithSince only syncs the source groups which changed since the given time,
+// according to the change log of the source system. See
+// groupsync.ManyToManySyncer.SyncSince.
+func WithSince(since time.Time) SyncOpt {
+	return func(config *SyncConfig) {
+		config.since = since
+	}
+}
+
+// WithSuspendedUserPolicy sets how suspended source users are synced. With
+// groupsync.SuspendedUserGracePeriod, they are removed from target groups
+// once suspended for the grace period, which is tracked in the state store.
+// Sync fails if the policy removes suspended users and the source system does
+// not report them.
+func WithSuspendedUserPolicy(policy groupsync.SuspendedUserPolicy, grace time.Duration) SyncOpt {
+	return func(config *SyncConfig) {
+		config.suspended = policy
+		config.suspendGrace = grace
+	}
+}
+
+// WithEscalationPolicy sets how role escalations are applied to target
+// groups. With groupsync.EscalationRequireApproval, pending escalations are
+// recorded in the state store.
+func WithEscalationPolicy(policy groupsync.EscalationPolicy) SyncOpt {
+	return func(config *SyncConfig) {
+		config.escalations = policy
+	}
+}
+
+// WithChangeSinks publishes the membership changes applied to target groups
+// to the given sinks, along with the change feed of the config, if any.
+func WithChangeSinks(sinks ...groupsync.ChangeSink) SyncOpt {
+	return func(config *SyncConfig) {
+		config.changeSinks = append(config.changeSinks, sinks...)
+	}
+}
+
+// WithConfirmation asks confirm before removing members from target groups
+// or archiving them. Groups whose removals are declined fail with
+// groupsync.ErrNotConfirmed. It has no effect with WithReadOnly.
+func WithConfirmation(confirm groupsync.ConfirmFunc) SyncOpt {
+	return func(config *SyncConfig) {
+		config.confirm = confirm
+	}
+}
+
+// WithDeletedGroupPolicy sets how the target groups of deleted source groups
+// are synced. With groupsync.DeletedGroupEmpty, snapshots of the emptied
+// target groups are kept in the state store.
+func WithDeletedGroupPolicy(policy groupsync.DeletedGroupPolicy) SyncOpt {
+	return func(config *SyncConfig) {
+		config.deleted = policy
+	}
+}
+
+// WithoutPreflight skips verifying the permissions of the target system
+// credentials before syncing. See groupsync.PermissionChecker.
+func WithoutPreflight() SyncOpt {
+	return func(config *SyncConfig) {
+		config.noPreflight = true
+	}
+}
+
+// WithDriftDetection wraps the target writer in a groupsync.DriftWriter,
+// which records the members applied to target groups in the state store and
+// alerts on the changes made outside of team-link which a sync overwrites.
+// It has no effect with WithReadOnly.
+func WithDriftDetection() SyncOpt {
+	return func(config *SyncConfig) {
+		config.detectDrift = true
+	}
+}
+
+// WithShadow syncs into copies of the target GitHub teams in the sandbox org
+// of the shadow config, see ShadowGitHubTeams, instead of the teams
+// themselves, e.g. to rehearse config changes.
+func WithShadow() SyncOpt {
+	return func(config *SyncConfig) {
+		config.shadow = true
+	}
+}
+
+// WithReadOnly wraps the target writer in a groupsync.ReadOnlyWriter, so that
+// target groups which would change fail to sync with groupsync.ErrReadOnly
+// instead of being changed.
+func WithReadOnly() SyncOpt {
+	return func(config *SyncConfig) {
+		config.readOnly = true
+	}
+}
+
+// WithWriteBatchSize wraps the target writer in a groupsync.BatchedWriter, so
+// that target groups with more changes than the given batch size, e.g. large
+// org teams, are written in batches whose progress is checkpointed in the
+// state store. It has no effect with WithReadOnly.
+func WithWriteBatchSize(size int) SyncOpt {
+	return func(config *SyncConfig) {
+		config.batchSize = size
+	}
+}
+
+// WithRecording records the reads of the source and target systems into the
+// given fixture, which can later be replayed with WithReplay.
+func WithRecording(fixture *simulation.Fixture) SyncOpt {
+	return func(config *SyncConfig) {
+		config.recording = fixture
+	}
+}
+
+// WithReplay syncs the given replayers of a recorded fixture instead of the
+// configured systems, so that no API is called and the target replayer
+// collects the changes of the sync. GitHub team slugs are not resolved and
+// group mappings are not discovered, so mappings must identify teams by ID.
+func WithReplay(source, target *simulation.Replayer) SyncOpt {
+	return func(config *SyncConfig) {
+		config.replaySource = source
+		config.replayTarget = target
+	}
+}
+
+// WithGitHubOpts sets the options of the GitHub clients, e.g.
+// github.WithSharedCache.
+func WithGitHubOpts(opts ...github.Opt) SyncOpt {
+	return func(config *SyncConfig) {
+		config.githubOpts = append(config.githubOpts, opts...)
+	}
+}
+
 // Sync syncs membership informations.
-func Sync(ctx context.Context, mappingFile, configFile string) error {
+func Sync(ctx context.Context, mappingFile, configFile string, opts ...SyncOpt) error {
+	syncConfig := &SyncConfig{}
+	for _, opt := range opts {
+		opt(syncConfig)
+	}
+	config, err := utils.ParseConfigTextProto(ctx, configFile)
+	if err != nil {
+		return fmt.Errorf("failed to parse config file: %w", err)
+	}
+	if len(config.GetPipelines()) > 0 {
+		return syncPipelines(ctx, mappingFile, configFile, config, syncConfig)
+	}
+	if len(syncConfig.pipelines) > 0 {
+		return fmt.Errorf("config file has no pipelines")
+	}
+	if mappingFile == "" {
+		return fmt.Errorf("mapping file is not provided")
+	}
+	mappings, err := utils.ParseMappingTextProto(ctx, mappingFile)
+	if err != nil {
+		return fmt.Errorf("failed to parse mappings file: %w", err)
+	}
+	plan, err := newSyncPlanFrom(ctx, mappings, config, syncConfig)
+	if err != nil {
+		return err
+	}
+	return plan.sync(ctx, syncConfig)
+}
+
+// sync syncs the memberships of the plan, and the repository permissions,
+// environment reviewers and branch allowances of its GitHub teams.
+func (p *syncPlan) sync(ctx context.Context, syncConfig *SyncConfig) error {
+	if checker, ok := p.writer.(groupsync.PermissionChecker); ok && !syncConfig.noPreflight {
+		targetGroupIDs, err := p.targetMapper.AllGroupIDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get target group IDs: %w", err)
+		}
+		if err := checker.CheckWritePermissions(ctx, targetGroupIDs); err != nil {
+			return fmt.Errorf("%s credentials are missing permissions: %w", p.targetSystem, err)
+		}
+	}
+
+	if policy := GoogleGroupsConfig(p.config).GetSettingsPolicy(); policy != nil && p.sourceSystem == tltypes.SystemTypeGoogleGroups {
+		if reader, ok := p.sourceReader.(GroupSettingsReader); ok {
+			sourceGroupIDs, err := p.sourceMapper.AllGroupIDs(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get source group IDs: %w", err)
+			}
+			if p.derived != nil {
+				sourceGroupIDs = p.derived.BaseGroupIDs(sourceGroupIDs)
+			}
+			if err := CheckGroupSettings(ctx, reader, sourceGroupIDs, policy); err != nil {
+				return fmt.Errorf("source groups violate the settings policy: %w", err)
+			}
+		}
+	}
+
+	syncer, err := p.newSyncer(syncConfig)
+	if err != nil {
+		return err
+	}
+	if !syncConfig.since.IsZero() {
+		err = syncer.SyncSince(ctx, syncConfig.since)
+	} else {
+		err = syncer.SyncAll(ctx)
+	}
+	if err != nil {
+		err = fmt.Errorf("failed to sync membership: %w", err)
+	}
+	if p.repoTeams != nil {
+		if rerr := SyncRepoPermissions(ctx, p.repoTeams, p.mappings.GetGroupMappings(), syncConfig.readOnly); rerr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to sync repo permissions: %w", rerr))
+		}
+		if rerr := SyncEnvironmentReviewers(ctx, p.repoTeams, p.mappings.GetGroupMappings(), syncConfig.readOnly); rerr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to sync environment reviewers: %w", rerr))
+		}
+		if rerr := SyncBranchAllowances(ctx, p.repoTeams, p.mappings.GetGroupMappings(), syncConfig.readOnly); rerr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to sync branch allowances: %w", rerr))
+		}
+	}
+	if p.labeler != nil && len(p.labels) > 0 {
+		if lerr := SyncGroupLabels(ctx, p.labeler, p.labels, syncConfig.readOnly); lerr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to sync group labels: %w", lerr))
+		}
+	}
+	if p.userChain != nil {
+		p.userChain.LogStats(ctx)
+	}
+	if p.writes != nil {
+		p.writes.LogStats(ctx)
+	}
+	if p.githubCosts != nil {
+		p.githubCosts.LogStats(ctx)
+	}
+	if p.gitlabLimits != nil {
+		p.gitlabLimits.LogStats(ctx)
+	}
+	return err
+}
+
+// newSyncer creates the syncer of the memberships of the plan, with the
+// options of the given config.
+func (p *syncPlan) newSyncer(syncConfig *SyncConfig) (*groupsync.ManyToManySyncer, error) {
+	syncerOpts := syncConfig.syncerOpts
+	store := syncConfig.store
+	if store == nil {
+		store = state.NewMemoryStore()
+	}
+	if syncConfig.suspended != "" && syncConfig.suspended != groupsync.SuspendedUserKeep {
+		if _, ok := p.sourceReader.(groupsync.SuspendedUserReader); !ok {
+			return nil, fmt.Errorf("source system %s does not report suspended users", p.sourceSystem)
+		}
+		syncerOpts = append(slices.Clip(syncerOpts), groupsync.WithSuspendedUsers(
+			groupsync.NewSuspendedUsers(syncConfig.suspended, store, syncConfig.suspendGrace)))
+	}
+	if syncConfig.deleted != "" && syncConfig.deleted != groupsync.DeletedGroupError {
+		syncerOpts = append(slices.Clip(syncerOpts), groupsync.WithDeletedGroups(
+			groupsync.NewDeletedGroups(syncConfig.deleted, store)))
+	}
+	if p.budget != nil {
+		syncerOpts = append(slices.Clip(syncerOpts), groupsync.WithAPIBudget(p.budget))
+	}
+	if p.domains != nil {
+		syncerOpts = append(slices.Clip(syncerOpts), groupsync.WithDomainPolicy(p.domains))
+	}
+	if len(p.freezeWindows) > 0 {
+		syncerOpts = append(slices.Clip(syncerOpts), groupsync.WithFreezeWindows(p.freezeWindows...))
+	}
+	if len(p.groupFreezeWindows) > 0 {
+		syncerOpts = append(slices.Clip(syncerOpts), groupsync.WithGroupFreezeWindows(p.groupFreezeWindows))
+	}
+	if n := p.config.GetSyncPolicy().GetConcurrency(); n > 0 {
+		syncerOpts = append(slices.Clip(syncerOpts), groupsync.WithConcurrency(int(n)))
+	}
+	if static := StaticMembers(p.targetSystem, p.mappings.GetGroupMappings()); len(static) > 0 && !p.reversed {
+		syncerOpts = append(slices.Clip(syncerOpts), groupsync.WithStaticMembers(static))
+	}
+	if limits := MemberLimits(p.targetSystem, p.mappings.GetGroupMappings()); len(limits) > 0 && !p.reversed {
+		syncerOpts = append(slices.Clip(syncerOpts), groupsync.WithMemberLimits(limits))
+	}
+	syncerOpts = append(slices.Clip(syncerOpts), groupsync.WithSourceIDNormalizer(p.normalizeIDs))
+	return groupsync.NewManyToManySyncer(p.sourceSystem, p.targetSystem, p.reader, p.writer,
+		p.sourceMapper, p.targetMapper, p.userMapper, syncerOpts...), nil
+}
+
+// syncPlan holds the systems, clients and mappers of a sync.
+type syncPlan struct {
+	mappings     *api.TeamLinkMappings
+	config       *api.TeamLinkConfig
+	sourceSystem string
+	targetSystem string
+	// reversed is whether the sync runs in the reverse direction of the
+	// config.
+	reversed bool
+	reader   groupsync.GroupReader
+	// sourceReader is the reader of the source system, without derived
+	// groups.
+	sourceReader groupsync.GroupReader
+	// derived holds the derived groups of the mappings, if any.
+	derived      *groupsync.DerivedGroupReader
+	writer       groupsync.GroupReadWriter
+	sourceMapper groupsync.OneToManyGroupMapper
+	targetMapper groupsync.OneToManyGroupMapper
+	userMapper   groupsync.UserMapper
+	// userChain chains the user mappings with their ID rewrites, if any.
+	userChain *groupsync.ChainUserMapper
+	// budget caps the API calls to the target system, if configured.
+	budget *groupsync.APIBudget
+	// writes counts the write calls to the target system, unless the sync
+	// writes a desired state file.
+	writes *groupsync.WriteCounter
+	// githubCosts accounts the rate limit cost of the GitHub API, when GitHub
+	// is the source or the target.
+	githubCosts *github.CostTracker
+	// gitlabLimits is the rate limiter of the GitLab API, when GitLab is the
+	// source or target system.
+	gitlabLimits *gitlab.RateLimiter
+	// normalizeIDs normalizes the IDs of source users, like the sources of
+	// the user mappings.
+	normalizeIDs groupsync.IDNormalizer
+	// domains checks the email domains of source users, if configured.
+	domains *groupsync.DomainPolicy
+	// freezeWindows defer removals from every target group, and
+	// groupFreezeWindows from the target groups they are keyed by, as set by
+	// the sync policies.
+	freezeWindows      []groupsync.FreezeWindow
+	groupFreezeWindows map[string][]groupsync.FreezeWindow
+	// repoTeams syncs the repository permissions, environment reviewers and
+	// branch allowances of the mapped teams, when GitHub is the target.
+	repoTeams *github.TeamReadWriter
+	// labeler applies the labels of the mappings to their target groups, if
+	// the target system supports labels.
+	labeler groupsync.GroupLabeler
+	labels  map[string]map[string]string
+}
+
+// newSyncPlan parses the mapping and config files and creates the clients and
+// mappers for syncing between the configured systems.
+func newSyncPlan(ctx context.Context, mappingFile, configFile string, syncConfig *SyncConfig) (*syncPlan, error) {
 	var merr error
 	mappings, err := utils.ParseMappingTextProto(ctx, mappingFile)
 	if err != nil {
@@ -36,37 +448,375 @@ func Sync(ctx context.Context, mappingFile, configFile string) error {
 	}
 
 	if merr != nil {
-		return merr
+		return nil, merr
+	}
+	if len(config.GetPipelines()) > 0 {
+		return nil, fmt.Errorf("config file has pipelines, which are only supported by sync")
+	}
+	return newSyncPlanFrom(ctx, mappings, config, syncConfig)
+}
+
+// newSyncPlanFrom creates the clients and mappers of a sync of the given
+// mappings and config.
+func newSyncPlanFrom(ctx context.Context, mappings *api.TeamLinkMappings, config *api.TeamLinkConfig, syncConfig *SyncConfig) (*syncPlan, error) {
+	store := syncConfig.store
+	if store == nil {
+		store = state.NewMemoryStore()
+	}
+
+	mappings.UserMappings = ActiveUserMappings(ctx, mappings.GetUserMappings(), time.Now())
+	if err := checkRoleConflicts(ctx, mappings.GetUserMappings(), syncConfig.roleConflict); err != nil {
+		return nil, err
 	}
 
 	sourceSystem, targetSystem, err := utils.GetSrcTargetSystemType(config)
 	if err != nil {
-		return fmt.Errorf("failed to get source and target system type: %w", err)
+		return nil, fmt.Errorf("failed to get source and target system type: %w", err)
+	}
+	var reversed bool
+	if syncConfig.sourceSystem != "" || syncConfig.targetSystem != "" {
+		configured := []string{sourceSystem, targetSystem}
+		if !slices.Contains(configured, syncConfig.sourceSystem) || !slices.Contains(configured, syncConfig.targetSystem) ||
+			syncConfig.sourceSystem == syncConfig.targetSystem {
+			return nil, fmt.Errorf("source %q and target %q must be the two systems of the config: %s, %s",
+				syncConfig.sourceSystem, syncConfig.targetSystem, sourceSystem, targetSystem)
+		}
+		if syncConfig.sourceSystem != sourceSystem {
+			mappings.UserMappings = ReverseUserMappings(mappings.GetUserMappings())
+			reversed = true
+		}
+		sourceSystem, targetSystem = syncConfig.sourceSystem, syncConfig.targetSystem
+	}
+	if syncConfig.shadow {
+		if reversed || targetSystem != tltypes.SystemTypeGitHub {
+			return nil, fmt.Errorf("shadow runs require github as the configured target system")
+		}
+		if config.GetShadow().GetGithubOrgId() == 0 {
+			return nil, fmt.Errorf("shadow runs require a shadow config with a github_org_id")
+		}
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "syncing memberships",
+		"source_system", sourceSystem,
+		"target_system", targetSystem,
+		"shadow", syncConfig.shadow,
+	)
+
+	var reader groupsync.GroupReader
+	var writer groupsync.GroupReadWriter
+	var githubCosts *github.CostTracker
+	var gitlabLimits *gitlab.RateLimiter
+	if syncConfig.replaySource != nil && syncConfig.replayTarget != nil {
+		reader, writer = syncConfig.replaySource, syncConfig.replayTarget
+	} else {
+		githubOpts := syncConfig.githubOpts
+		if sourceSystem == tltypes.SystemTypeGitHub || targetSystem == tltypes.SystemTypeGitHub {
+			githubCosts = github.NewCostTracker()
+			githubOpts = append(slices.Clip(githubOpts), github.WithCostTracker(githubCosts))
+		}
+		if reader, writer, err = newReadWriters(ctx, sourceSystem, targetSystem, config, mappings, store, syncConfig, githubOpts); err != nil {
+			return nil, err
+		}
+		gitlabLimits = gitLabRateLimiter(reader, writer)
 	}
 
 	srcMapper, targetMapper, err := NewBidirectionalOneToManyGroupMapper(sourceSystem, targetSystem, mappings.GetGroupMappings(), config)
 	if err != nil {
-		return fmt.Errorf("failed to create mapper: %w", err)
+		return nil, fmt.Errorf("failed to create mapper: %w", err)
+	}
+	if targetMapper, err = NewWindowedMapper(targetMapper, sourceSystem, targetSystem, mappings.GetGroupMappings()); err != nil {
+		return nil, fmt.Errorf("failed to create mapper: %w", err)
+	}
+
+	domains, err := NewDomainPolicy(sourceSystem, config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid member domain policy: %w", err)
+	}
+
+	normalizeIDs := IDNormalizer(config, sourceSystem)
+	overrides, err := NewUserMapper(ctx, sourceSystem, targetSystem, NormalizeUserMappings(mappings.GetUserMappings(), normalizeIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user mapper: %w", err)
+	}
+	userChain, err := NewChainedUserMapper(overrides, mappings.GetUserMappings())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user mapper: %w", err)
+	}
+	if userChain != nil {
+		overrides = userChain
+	}
+	userMapper, err := NewIdentityMapper(ctx, sourceSystem, targetSystem, config, mappings, overrides, reader, writer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create identity mapper: %w", err)
+	}
+	// the settings and suspended users of the source system are read from
+	// the groups derived groups refer to.
+	sourceReader := reader
+	if excluded := mappings.GetGroupMappings().GetExcludedNestedGroups(); len(excluded) > 0 {
+		reader = groupsync.NewExcludingGroupReader(reader, excluded)
+	}
+	var derived *groupsync.DerivedGroupReader
+	if groups := mappings.GetGroupMappings().GetDerivedGroups(); len(groups) > 0 {
+		if derived, err = NewDerivedGroupReader(reader, groups); err != nil {
+			return nil, fmt.Errorf("failed to create derived groups: %w", err)
+		}
+		reader = derived
 	}
 
-	reader, err := NewReader(ctx, sourceSystem, config)
+	// repository permissions, environment reviewers and branch allowances are
+	// set by the client itself, outside of the wrappers of membership writes.
+	repoTeams, _ := writer.(*github.TeamReadWriter)
+	// so are the labels of target groups.
+	labeler, _ := writer.(groupsync.GroupLabeler)
+	var labels map[string]map[string]string
+	if !reversed {
+		if labels, err = GroupLabels(targetSystem, mappings.GetGroupMappings()); err != nil {
+			return nil, fmt.Errorf("invalid group labels: %w", err)
+		}
+		if len(labels) > 0 && labeler == nil {
+			logging.FromContext(ctx).WarnContext(ctx, "target system does not support group labels, ignoring them",
+				"target_system", targetSystem,
+			)
+		}
+	}
+
+	if syncConfig.desired != nil {
+		writer = newDesiredStateWriter(writer, syncConfig.desired)
+	}
+	if syncConfig.batchSize > 0 && !syncConfig.readOnly && syncConfig.desired == nil {
+		writer = groupsync.NewBatchedWriter(writer, syncConfig.batchSize, syncConfig.store)
+	}
+	var budget *groupsync.APIBudget
+	if b := config.GetTargetConfig().GetApiBudget(); b.GetMaxCallsPerRun() > 0 || b.GetMaxCallsPerHour() > 0 {
+		budget = groupsync.NewAPIBudget(targetSystem, int(b.GetMaxCallsPerRun()), int(b.GetMaxCallsPerHour()), syncConfig.store)
+		writer = groupsync.NewBudgetedWriter(writer, budget, IDNormalizer(config, targetSystem))
+	}
+	var writes *groupsync.WriteCounter
+	if syncConfig.desired == nil {
+		writes = groupsync.NewWriteCounter(writer, IDNormalizer(config, targetSystem))
+		writer = writes
+	}
+	if syncConfig.detectDrift && !syncConfig.readOnly && syncConfig.desired == nil && syncConfig.replayTarget == nil {
+		writer = groupsync.NewDriftWriter(writer, store, IDNormalizer(config, targetSystem))
+	}
+	if syncConfig.recording != nil {
+		reader = simulation.NewRecorder(reader, syncConfig.recording.Source)
+		writer = simulation.NewRecorder(writer, syncConfig.recording.Target)
+	}
+	// only publish changes which are applied to the target system.
+	if !syncConfig.readOnly && syncConfig.desired == nil && syncConfig.replayTarget == nil {
+		sinks := syncConfig.changeSinks
+		if feed := config.GetChangeFeed(); feed != nil {
+			sink, err := NewChangeSink(ctx, feed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create change feed: %w", err)
+			}
+			sinks = append(slices.Clip(sinks), sink)
+		}
+		if len(sinks) > 0 {
+			writer = groupsync.NewChangeFeedWriter(writer, targetSystem, changeSinks(sinks), IDNormalizer(config, targetSystem))
+		}
+	}
+	if syncConfig.escalations != "" && syncConfig.escalations != groupsync.EscalationAllow {
+		writer = groupsync.NewEscalationGuard(writer, syncConfig.escalations, store, IDNormalizer(config, targetSystem))
+	}
+	if syncConfig.confirm != nil && !syncConfig.readOnly && syncConfig.desired == nil {
+		writer = groupsync.NewConfirmingWriter(writer, syncConfig.confirm, IDNormalizer(config, targetSystem))
+	}
+	if syncConfig.readOnly {
+		writer = groupsync.NewReadOnlyWriter(writer)
+	}
+	if syncConfig.summary != nil && syncConfig.desired == nil {
+		writer = newSummaryWriter(writer, syncConfig.summary, syncConfig.readOnly, IDNormalizer(config, targetSystem))
+	}
+	globalPolicy, policies, err := NewSyncPolicies(targetSystem, config, mappings.GetGroupMappings())
+	if err != nil {
+		return nil, fmt.Errorf("invalid sync policy: %w", err)
+	}
+	if globalPolicy != nil || len(policies) > 0 {
+		writer = groupsync.NewPolicyWriter(writer, globalPolicy, policies, IDNormalizer(config, targetSystem))
+	}
+	freezeWindows, groupFreezeWindows, err := NewFreezeWindows(targetSystem, config, mappings.GetGroupMappings())
 	if err != nil {
-		return fmt.Errorf("failed to create reader: %w", err)
+		return nil, fmt.Errorf("invalid sync policy: %w", err)
+	}
+	var normalizeOpts []groupsync.NormalizingWriterOpt
+	if !syncConfig.readOnly && syncConfig.desired == nil {
+		normalizeOpts = append(normalizeOpts, groupsync.TrackStableIDs(targetSystem, store))
 	}
+	writer = groupsync.NewNormalizingWriter(writer, IDNormalizer(config, targetSystem), normalizeOpts...)
+
+	return &syncPlan{
+		mappings:           mappings,
+		config:             config,
+		sourceSystem:       sourceSystem,
+		targetSystem:       targetSystem,
+		reversed:           reversed,
+		reader:             reader,
+		sourceReader:       sourceReader,
+		derived:            derived,
+		writer:             writer,
+		sourceMapper:       srcMapper,
+		targetMapper:       targetMapper,
+		userMapper:         userMapper,
+		userChain:          userChain,
+		budget:             budget,
+		writes:             writes,
+		githubCosts:        githubCosts,
+		gitlabLimits:       gitlabLimits,
+		normalizeIDs:       normalizeIDs,
+		domains:            domains,
+		freezeWindows:      freezeWindows,
+		groupFreezeWindows: groupFreezeWindows,
+		repoTeams:          repoTeams,
+		labeler:            labeler,
+		labels:             labels,
+	}, nil
+}
+
+// newReadWriters creates the clients of the source and target systems. GitHub
+// team slugs of the mappings are resolved and group mappings are discovered,
+// which both require the clients.
+func newReadWriters(ctx context.Context, sourceSystem, targetSystem string, config *api.TeamLinkConfig, mappings *api.TeamLinkMappings,
+	store state.Store, syncConfig *SyncConfig, githubOpts []github.Opt,
+) (groupsync.GroupReader, groupsync.GroupReadWriter, error) {
+	logger := logging.FromContext(ctx)
 
-	writer, err := NewReadWriter(ctx, targetSystem, config, mappings)
+	reader, err := NewReader(ctx, sourceSystem, config, mappings, syncConfig.store, githubOpts...)
 	if err != nil {
-		return fmt.Errorf("failed to create writer: %w", err)
+		return nil, nil, fmt.Errorf("failed to create reader: %w", err)
 	}
 
-	userMapper, err := NewUserMapper(ctx, sourceSystem, targetSystem, mappings.GetUserMappings())
+	writer, err := NewReadWriter(ctx, targetSystem, config, mappings, syncConfig.store, githubOpts...)
 	if err != nil {
-		return fmt.Errorf("failed to create user mapper")
+		return nil, nil, fmt.Errorf("failed to create writer: %w", err)
+	}
+
+	if accounts := config.GetTargetConfig().GetGitlabConfig().GetServiceAccounts(); len(accounts) > 0 {
+		rw, ok := writer.(*gitlab.GroupReadWriter)
+		if !ok {
+			return nil, nil, fmt.Errorf("gitlab service accounts require a gitlab writer")
+		}
+		// mappings with missing static members fail individually when syncing.
+		if err := ProvisionGitLabServiceAccounts(ctx, rw, accounts, syncConfig.readOnly); err != nil {
+			logger.WarnContext(ctx, "failed to provision one or more gitlab service accounts",
+				"error", err,
+			)
+		}
+	}
+
+	var mappingsChanged bool
+	if teams := gitHubTeamReadWriter(reader, writer); teams != nil && hasTeamSlugs(mappings.GetGroupMappings()) {
+		if _, err := ResolveGitHubTeams(ctx, github.NewTeamSlugResolver(teams, store), mappings.GetGroupMappings()); err != nil {
+			// unresolved mappings fail individually when syncing.
+			logger.WarnContext(ctx, "failed to resolve one or more github team slugs",
+				"error", err,
+			)
+		}
+		mappingsChanged = true
+	}
+	if len(mappings.GetGroupMappings().GetGithubTeamDiscovery()) > 0 {
+		if err := DiscoverGroupMappings(ctx, sourceSystem, targetSystem, reader, writer, mappings.GetGroupMappings()); err != nil {
+			// explicit mappings and whatever was discovered are still synced.
+			logger.WarnContext(ctx, "failed to discover one or more group mappings",
+				"error", err,
+			)
+		}
+		mappingsChanged = true
 	}
+	if syncConfig.shadow {
+		teams, ok := writer.(*github.TeamReadWriter)
+		if !ok {
+			return nil, nil, fmt.Errorf("shadow runs require a github team writer")
+		}
+		// nothing is synced unless every team is shadowed.
+		if err := ShadowGitHubTeams(ctx, teams, mappings.GetGroupMappings(), config.GetShadow(), syncConfig.readOnly); err != nil {
+			return nil, nil, fmt.Errorf("failed to shadow github teams: %w", err)
+		}
+		mappingsChanged = true
+	}
+	if mappingsChanged {
+		// the writer is configured with the SSO requirement of each mapped team.
+		if writer, err = NewReadWriter(ctx, targetSystem, config, mappings, syncConfig.store, githubOpts...); err != nil {
+			return nil, nil, fmt.Errorf("failed to create writer: %w", err)
+		}
+	}
+	return reader, writer, nil
+}
+
+// checkRoleConflicts logs the target users which the user mappings map to with
+// different roles, and fails if the policy does not allow conflicts.
+func checkRoleConflicts(ctx context.Context, um *api.UserMappings, policy groupsync.RoleConflictPolicy) error {
+	logger := logging.FromContext(ctx)
+	var merr error
+	for _, c := range RoleConflicts(um) {
+		if policy == groupsync.RoleConflictError {
+			merr = errors.Join(merr, fmt.Errorf("target user %s is mapped from %v with conflicting roles %q", c.TargetUser, c.Sources, c.Roles))
+			continue
+		}
+		logger.WarnContext(ctx, "target user is mapped with conflicting roles",
+			"target_user", c.TargetUser,
+			"source_users", c.Sources,
+			"roles", c.Roles,
+			"policy", policy,
+		)
+	}
+	if merr != nil {
+		return fmt.Errorf("invalid user mappings: %w", merr)
+	}
+	return nil
+}
+
+// ReverseUserMappings returns the given user mappings with their source and
+// target swapped, for syncing in the reverse direction of the config. Source
+// aliases and roles are dropped since every target user, including additional
+// targets, maps back to the primary source.
+func ReverseUserMappings(um *api.UserMappings) *api.UserMappings {
+	reversed := &api.UserMappings{Mappings: make([]*api.UserMapping, 0, len(um.GetMappings()))}
+	for _, m := range um.GetMappings() {
+		reversed.Mappings = append(reversed.Mappings, &api.UserMapping{
+			Source: m.GetTarget(),
+			Target: m.GetSource(),
+		})
+		for _, t := range m.GetAdditionalTargets() {
+			reversed.Mappings = append(reversed.Mappings, &api.UserMapping{
+				Source: t.GetId(),
+				Target: m.GetSource(),
+			})
+		}
+	}
+	return reversed
+}
 
-	syncer := groupsync.NewManyToManySyncer(sourceSystem, targetSystem, reader, writer, srcMapper, targetMapper, userMapper)
-	if err := syncer.SyncAll(ctx); err != nil {
-		return fmt.Errorf("failed to sync membership: %w", err)
+// gitHubTeamReadWriter returns whichever of reader and writer accesses GitHub,
+// or nil if neither does.
+func gitHubTeamReadWriter(reader groupsync.GroupReader, writer groupsync.GroupReadWriter) *github.TeamReadWriter {
+	if teams, ok := writer.(*github.TeamReadWriter); ok {
+		return teams
+	}
+	if teams, ok := reader.(*github.TeamReadWriter); ok {
+		return teams
 	}
 	return nil
 }
+
+// gitLabRateLimiter returns the rate limiter of the GitLab reader or writer,
+// or nil if neither is a GitLab GroupReadWriter.
+func gitLabRateLimiter(reader groupsync.GroupReader, writer groupsync.GroupReadWriter) *gitlab.RateLimiter {
+	if rw, ok := writer.(*gitlab.GroupReadWriter); ok {
+		return rw.RateLimiter()
+	}
+	if rw, ok := reader.(*gitlab.GroupReadWriter); ok {
+		return rw.RateLimiter()
+	}
+	return nil
+}
+
+func hasTeamSlugs(gm *api.GroupMappings) bool {
+	for _, m := range gm.GetMappings() {
+		if gitHubTeam(m).GetTeamSlug() != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,249 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/abcxyz/pkg/logging"
+	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+// terraformHeader precedes the exported Terraform configuration.
+const terraformHeader = "# Generated by tlctl export terraform. DO NOT EDIT.\n"
+
+// invalidTerraformName matches the characters which are not allowed in the
+// names of Terraform resources.
+var invalidTerraformName = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+// desiredState holds the members which a sync would set on each target group.
+type desiredState struct {
+	mu      sync.Mutex
+	members map[string][]groupsync.Member
+}
+
+// desiredStateWriter records the members set on target groups in a
+// desiredState instead of writing them. Reads are passed through.
+type desiredStateWriter struct {
+	groupsync.GroupReadWriter
+	desired *desiredState
+}
+
+func newDesiredStateWriter(rw groupsync.GroupReadWriter, desired *desiredState) *desiredStateWriter {
+	return &desiredStateWriter{GroupReadWriter: rw, desired: desired}
+}
+
+// SetMembers records the given members of the target group.
+func (w *desiredStateWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	w.desired.mu.Lock()
+	defer w.desired.mu.Unlock()
+	w.desired.members[groupID] = append([]groupsync.Member(nil), members...)
+	return nil
+}
+
+// ExportTerraform computes the memberships a sync would set on all mapped
+// target groups, without writing them, and writes them as Terraform
+// configuration: github_team_membership resources for GitHub, and
+// gitlab_group_membership resources for GitLab. This lets team-link compute
+// the desired state while Terraform applies it.
+func ExportTerraform(ctx context.Context, w io.Writer, mappingFile, configFile string, opts ...SyncOpt) error {
+	syncConfig := &SyncConfig{}
+	for _, opt := range opts {
+		opt(syncConfig)
+	}
+	syncConfig.desired = &desiredState{members: make(map[string][]groupsync.Member)}
+	plan, err := newSyncPlan(ctx, mappingFile, configFile, syncConfig)
+	if err != nil {
+		return err
+	}
+	if plan.targetSystem != tltypes.SystemTypeGitHub && plan.targetSystem != tltypes.SystemTypeGitLab {
+		return fmt.Errorf("terraform export is not supported for target system %s", plan.targetSystem)
+	}
+
+	syncer, err := plan.newSyncer(syncConfig)
+	if err != nil {
+		return err
+	}
+	if err := syncer.SyncAll(ctx); err != nil {
+		return fmt.Errorf("failed to compute desired memberships: %w", err)
+	}
+	return writeTerraform(ctx, w, plan.targetSystem, syncConfig.desired.members)
+}
+
+// writeTerraform writes the given members of target groups as Terraform
+// configuration, sorted by group and user.
+func writeTerraform(ctx context.Context, w io.Writer, targetSystem string, members map[string][]groupsync.Member) error {
+	var b strings.Builder
+	b.WriteString(terraformHeader)
+	var err error
+	switch targetSystem {
+	case tltypes.SystemTypeGitHub:
+		err = writeGitHubTerraform(ctx, &b, members)
+	case tltypes.SystemTypeGitLab:
+		err = writeGitLabTerraform(ctx, &b, members)
+	default:
+		err = fmt.Errorf("terraform export is not supported for target system %s", targetSystem)
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write terraform configuration: %w", err)
+	}
+	return nil
+}
+
+// writeGitHubTerraform writes a github_team_membership resource per member of
+// each team. When the teams belong to several orgs, each resource uses the
+// provider alias github.org_ORGID, which must be configured for the org.
+func writeGitHubTerraform(ctx context.Context, b *strings.Builder, members map[string][]groupsync.Member) error {
+	type team struct {
+		orgID, teamID int64
+	}
+	teams := make(map[string]team, len(members))
+	orgs := make(map[int64]struct{})
+	for groupID := range members {
+		orgIDStr, teamIDStr, ok := strings.Cut(groupID, github.IDSep)
+		orgID, orgErr := strconv.ParseInt(orgIDStr, 10, 64)
+		teamID, teamErr := strconv.ParseInt(teamIDStr, 10, 64)
+		if !ok || orgErr != nil || teamErr != nil {
+			return fmt.Errorf("%w: %s", groupsync.ErrInvalidGroupID, groupID)
+		}
+		teams[groupID] = team{orgID: orgID, teamID: teamID}
+		orgs[orgID] = struct{}{}
+	}
+
+	names := make(map[string]string)
+	for _, groupID := range utils.MapKeys(teams) {
+		t := teams[groupID]
+		for _, m := range sortedUserMembers(ctx, groupID, members[groupID]) {
+			name, err := terraformName(names, fmt.Sprintf("team_%d_%s", t.teamID, m.ID()), groupID+"/"+m.ID())
+			if err != nil {
+				return err
+			}
+			role := groupsync.MemberRole(m)
+			if role == "" {
+				role = "member"
+			}
+			fmt.Fprintf(b, "\nresource \"github_team_membership\" %q {\n", name)
+			if len(orgs) > 1 {
+				fmt.Fprintf(b, "  provider = github.org_%d\n", t.orgID)
+			}
+			fmt.Fprintf(b, "  team_id  = %s\n", hclString(strconv.FormatInt(t.teamID, 10)))
+			fmt.Fprintf(b, "  username = %s\n", hclString(m.ID()))
+			fmt.Fprintf(b, "  role     = %s\n", hclString(strings.ToLower(role)))
+			b.WriteString("}\n")
+		}
+	}
+	return nil
+}
+
+// writeGitLabTerraform writes a gitlab_user data source per user, which
+// resolves the numeric user ID of the username, and a
+// gitlab_group_membership resource per member of each group.
+func writeGitLabTerraform(ctx context.Context, b *strings.Builder, members map[string][]groupsync.Member) error {
+	users := make(map[string]struct{})
+	for _, groupID := range utils.MapKeys(members) {
+		for _, m := range sortedUserMembers(ctx, groupID, members[groupID]) {
+			users[m.ID()] = struct{}{}
+		}
+	}
+	userNames := make(map[string]string)
+	dataNames := make(map[string]string, len(users))
+	for _, username := range utils.MapKeys(users) {
+		name, err := terraformName(userNames, username, username)
+		if err != nil {
+			return err
+		}
+		dataNames[username] = name
+		fmt.Fprintf(b, "\ndata \"gitlab_user\" %q {\n", name)
+		fmt.Fprintf(b, "  username = %s\n", hclString(username))
+		b.WriteString("}\n")
+	}
+
+	names := make(map[string]string)
+	for _, groupID := range utils.MapKeys(members) {
+		for _, m := range sortedUserMembers(ctx, groupID, members[groupID]) {
+			name, err := terraformName(names, fmt.Sprintf("group_%s_%s", groupID, m.ID()), groupID+"/"+m.ID())
+			if err != nil {
+				return err
+			}
+			level := strings.ToLower(groupsync.MemberRole(m))
+			if level == "" {
+				level = "developer"
+			}
+			fmt.Fprintf(b, "\nresource \"gitlab_group_membership\" %q {\n", name)
+			fmt.Fprintf(b, "  group_id     = %s\n", hclString(groupID))
+			fmt.Fprintf(b, "  user_id      = data.gitlab_user.%s.id\n", dataNames[m.ID()])
+			fmt.Fprintf(b, "  access_level = %s\n", hclString(level))
+			b.WriteString("}\n")
+		}
+	}
+	return nil
+}
+
+// sortedUserMembers returns the user members of the given target group,
+// sorted by ID. Group members are skipped since Terraform memberships only
+// grant access to users.
+func sortedUserMembers(ctx context.Context, groupID string, members []groupsync.Member) []groupsync.Member {
+	logger := logging.FromContext(ctx)
+	byID := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		if m.IsGroup() {
+			logger.WarnContext(ctx, "skipping group member in terraform export",
+				"group_id", groupID,
+				"member_id", m.ID(),
+			)
+			continue
+		}
+		byID[m.ID()] = m
+	}
+	sorted := make([]groupsync.Member, 0, len(byID))
+	for _, id := range utils.MapKeys(byID) {
+		sorted = append(sorted, byID[id])
+	}
+	return sorted
+}
+
+// terraformName returns the given name with the characters which are not
+// allowed in Terraform names replaced, and records it in names. It fails if
+// the name is already taken by a different ID.
+func terraformName(names map[string]string, name, id string) (string, error) {
+	name = invalidTerraformName.ReplaceAllString(name, "_")
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	if prev, ok := names[name]; ok && prev != id {
+		return "", fmt.Errorf("terraform name %s of %s conflicts with %s", name, id, prev)
+	}
+	names[name] = id
+	return name, nil
+}
+
+// hclString quotes s as an HCL string, escaping template sequences.
+func hclString(s string) string {
+	s = strconv.Quote(s)
+	s = strings.ReplaceAll(s, "${", "$${")
+	return strings.ReplaceAll(s, "%{", "%%{")
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestWriteTerraform(t *testing.T) {
+	t.Parallel()
+
+	user := func(id, role string) groupsync.Member {
+		return &groupsync.UserMember{Usr: &groupsync.User{ID: id}, Role: role}
+	}
+
+	cases := []struct {
+		name         string
+		targetSystem string
+		members      map[string][]groupsync.Member
+		want         string
+		wantErr      string
+	}{
+		{
+			name:         "github",
+			targetSystem: tltypes.SystemTypeGitHub,
+			members: map[string][]groupsync.Member{
+				"1:10": {
+					user("bob", "maintainer"),
+					user("alice", ""),
+					&groupsync.GroupMember{Grp: &groupsync.Group{ID: "1:11"}},
+				},
+				"1:11": {},
+			},
+			want: terraformHeader + `
+resource "github_team_membership" "team_10_alice" {
+  team_id  = "10"
+  username = "alice"
+  role     = "member"
+}
+
+resource "github_team_membership" "team_10_bob" {
+  team_id  = "10"
+  username = "bob"
+  role     = "maintainer"
+}
+`,
+		},
+		{
+			name:         "github_orgs",
+			targetSystem: tltypes.SystemTypeGitHub,
+			members: map[string][]groupsync.Member{
+				"1:10": {user("alice", "")},
+				"2:20": {user("alice", "")},
+			},
+			want: terraformHeader + `
+resource "github_team_membership" "team_10_alice" {
+  provider = github.org_1
+  team_id  = "10"
+  username = "alice"
+  role     = "member"
+}
+
+resource "github_team_membership" "team_20_alice" {
+  provider = github.org_2
+  team_id  = "20"
+  username = "alice"
+  role     = "member"
+}
+`,
+		},
+		{
+			name:         "github_invalid_group_id",
+			targetSystem: tltypes.SystemTypeGitHub,
+			members:      map[string][]groupsync.Member{"10": {user("alice", "")}},
+			wantErr:      "invalid group ID: 10",
+		},
+		{
+			name:         "gitlab",
+			targetSystem: tltypes.SystemTypeGitLab,
+			members: map[string][]groupsync.Member{
+				"12": {user("jane.doe", "Maintainer"), user("alice", "")},
+				"13": {user("alice", "reporter")},
+			},
+			want: terraformHeader + `
+data "gitlab_user" "alice" {
+  username = "alice"
+}
+
+data "gitlab_user" "jane_doe" {
+  username = "jane.doe"
+}
+
+resource "gitlab_group_membership" "group_12_alice" {
+  group_id     = "12"
+  user_id      = data.gitlab_user.alice.id
+  access_level = "developer"
+}
+
+resource "gitlab_group_membership" "group_12_jane_doe" {
+  group_id     = "12"
+  user_id      = data.gitlab_user.jane_doe.id
+  access_level = "maintainer"
+}
+
+resource "gitlab_group_membership" "group_13_alice" {
+  group_id     = "13"
+  user_id      = data.gitlab_user.alice.id
+  access_level = "reporter"
+}
+`,
+		},
+		{
+			name:         "gitlab_name_conflict",
+			targetSystem: tltypes.SystemTypeGitLab,
+			members: map[string][]groupsync.Member{
+				"12": {user("jane.doe", ""), user("jane_doe", "")},
+			},
+			wantErr: "terraform name jane_doe of jane_doe conflicts with jane.doe",
+		},
+		{
+			name:         "unsupported",
+			targetSystem: tltypes.SystemTypeGoogleGroups,
+			members:      map[string][]groupsync.Member{"groups/eng": {user("alice@example.com", "")}},
+			wantErr:      "terraform export is not supported for target system GOOGLEGROUPS",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b strings.Builder
+			err := writeTerraform(context.Background(), &b, tc.targetSystem, tc.members)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(b.String(), tc.want); diff != "" {
+				t.Errorf("unexpected terraform (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDesiredStateWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rw := userGroups(map[string][]string{"1:10": {"alice"}})
+	desired := &desiredState{members: make(map[string][]groupsync.Member)}
+	w := newDesiredStateWriter(rw, desired)
+
+	if err := w.SetMembers(ctx, "1:10", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob"}},
+	}); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+
+	if diff := cmp.Diff(groupUserIDs(rw), map[string][]string{"1:10": {"alice"}}); diff != "" {
+		t.Errorf("unexpected written members (-got, +want):\n%s", diff)
+	}
+	var got []string
+	for _, m := range desired.members["1:10"] {
+		got = append(got, m.ID())
+	}
+	if diff := cmp.Diff(got, []string{"bob"}); diff != "" {
+		t.Errorf("unexpected desired members (-got, +want):\n%s", diff)
+	}
+}
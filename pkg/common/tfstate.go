@@ -0,0 +1,276 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/state"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+// terraformStateVersion is the supported format version of Terraform state.
+const terraformStateVersion = 4
+
+// terraformState is the part of a Terraform state file read by
+// ImportTerraformState.
+type terraformState struct {
+	Version   int                       `json:"version"`
+	Resources []*terraformStateResource `json:"resources"`
+}
+
+type terraformStateResource struct {
+	Mode      string `json:"mode"`
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Instances []struct {
+		Attributes map[string]any `json:"attributes"`
+	} `json:"instances"`
+}
+
+// ImportedGroup is a GitHub team or GitLab group found in a Terraform state.
+type ImportedGroup struct {
+	// ID is the ID of the team or group.
+	ID int64
+	// Name is the slug of a GitHub team, or the full path of a GitLab group.
+	Name string
+	// Resources are the addresses of the resources managing the group or its
+	// memberships.
+	Resources []string
+	// Members are the usernames of the members managed by Terraform, along
+	// with their role.
+	Members map[string]string
+}
+
+// TerraformImport holds the GitHub teams and GitLab groups found in a
+// Terraform state.
+type TerraformImport struct {
+	// GitHubOrgID is the ID of the org of the GitHub teams.
+	GitHubOrgID  int64
+	GitHubTeams  []*ImportedGroup
+	GitLabGroups []*ImportedGroup
+}
+
+// ImportTerraformState reads the GitHub teams and GitLab groups managed by a
+// Terraform state, e.g. the output of terraform state pull. Teams are read
+// from github_team, github_team_membership and github_team_members
+// resources, and groups from gitlab_group and gitlab_group_membership
+// resources. The Terraform state does not record the org of GitHub teams, so
+// it must be given when the state has any.
+func ImportTerraformState(r io.Reader, gitHubOrgID int64) (*TerraformImport, error) {
+	var tfState terraformState
+	if err := json.NewDecoder(r).Decode(&tfState); err != nil {
+		return nil, fmt.Errorf("failed to decode terraform state: %w", err)
+	}
+	if tfState.Version != terraformStateVersion {
+		return nil, fmt.Errorf("unsupported terraform state version %d, want %d", tfState.Version, terraformStateVersion)
+	}
+
+	teams := make(map[int64]*ImportedGroup)
+	groups := make(map[int64]*ImportedGroup)
+	group := func(groups map[int64]*ImportedGroup, id int64, address string) *ImportedGroup {
+		g, ok := groups[id]
+		if !ok {
+			g = &ImportedGroup{ID: id, Members: make(map[string]string)}
+			groups[id] = g
+		}
+		if !slices.Contains(g.Resources, address) {
+			g.Resources = append(g.Resources, address)
+		}
+		return g
+	}
+
+	var merr error
+	for _, res := range tfState.Resources {
+		if res.Mode != "managed" {
+			continue
+		}
+		address := res.Type + "." + res.Name
+		for _, inst := range res.Instances {
+			attrs := inst.Attributes
+			switch res.Type {
+			case "github_team":
+				id, err := intAttribute(attrs, "id")
+				if err != nil {
+					merr = errors.Join(merr, fmt.Errorf("%s: %w", address, err))
+					continue
+				}
+				group(teams, id, address).Name = stringAttribute(attrs, "slug")
+			case "github_team_membership":
+				id, err := intAttribute(attrs, "team_id")
+				if err != nil {
+					merr = errors.Join(merr, fmt.Errorf("%s: %w", address, err))
+					continue
+				}
+				group(teams, id, address).Members[stringAttribute(attrs, "username")] = stringAttribute(attrs, "role")
+			case "github_team_members":
+				id, err := intAttribute(attrs, "team_id")
+				if err != nil {
+					merr = errors.Join(merr, fmt.Errorf("%s: %w", address, err))
+					continue
+				}
+				team := group(teams, id, address)
+				members, _ := attrs["members"].([]any)
+				for _, m := range members {
+					if member, ok := m.(map[string]any); ok {
+						team.Members[stringAttribute(member, "username")] = stringAttribute(member, "role")
+					}
+				}
+			case "gitlab_group":
+				id, err := intAttribute(attrs, "id")
+				if err != nil {
+					merr = errors.Join(merr, fmt.Errorf("%s: %w", address, err))
+					continue
+				}
+				group(groups, id, address).Name = stringAttribute(attrs, "full_path")
+			case "gitlab_group_membership":
+				// memberships reference users by their numeric ID, not their username.
+				id, err := intAttribute(attrs, "group_id")
+				if err != nil {
+					merr = errors.Join(merr, fmt.Errorf("%s: %w", address, err))
+					continue
+				}
+				group(groups, id, address)
+			}
+		}
+	}
+	if merr != nil {
+		return nil, fmt.Errorf("failed to read terraform resources: %w", merr)
+	}
+	if len(teams) > 0 && gitHubOrgID == 0 {
+		return nil, fmt.Errorf("terraform state has github teams, the id of their github org is required")
+	}
+
+	imp := &TerraformImport{GitHubOrgID: gitHubOrgID}
+	for _, id := range slices.Sorted(maps.Keys(teams)) {
+		imp.GitHubTeams = append(imp.GitHubTeams, teams[id])
+	}
+	for _, id := range slices.Sorted(maps.Keys(groups)) {
+		imp.GitLabGroups = append(imp.GitLabGroups, groups[id])
+	}
+	return imp, nil
+}
+
+// WriteMappings writes a mapping textproto with a group mapping targeting
+// each imported team and group. The source of the mappings is left for the
+// user to fill in; a comment lists the members managed by Terraform.
+func (i *TerraformImport) WriteMappings(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("# Generated by tlctl import terraform. Set the source group of each mapping\n")
+	b.WriteString("# before syncing.\n")
+	b.WriteString("group_mappings {\n")
+	b.WriteString("  mappings: [\n")
+	for _, t := range i.GitHubTeams {
+		writeImportedGroupComment(&b, t)
+		b.WriteString("    {\n")
+		b.WriteString("      github: {\n")
+		fmt.Fprintf(&b, "        org_id: %d\n", i.GitHubOrgID)
+		fmt.Fprintf(&b, "        team_id: %d\n", t.ID)
+		if t.Name != "" {
+			fmt.Fprintf(&b, "        team_slug: %q\n", t.Name)
+		}
+		b.WriteString("      }\n")
+		b.WriteString("    },\n")
+	}
+	for _, g := range i.GitLabGroups {
+		writeImportedGroupComment(&b, g)
+		b.WriteString("    {\n")
+		b.WriteString("      gitlab: {\n")
+		fmt.Fprintf(&b, "        group_id: %d\n", g.ID)
+		b.WriteString("      }\n")
+		b.WriteString("    },\n")
+	}
+	b.WriteString("  ]\n")
+	b.WriteString("}\n")
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("failed to write mappings: %w", err)
+	}
+	return nil
+}
+
+// writeImportedGroupComment writes the comment describing an imported group.
+func writeImportedGroupComment(b *strings.Builder, g *ImportedGroup) {
+	fmt.Fprintf(b, "    # %s", strings.Join(g.Resources, ", "))
+	if g.Name != "" {
+		fmt.Fprintf(b, " (%s)", g.Name)
+	}
+	b.WriteString("\n")
+	if len(g.Members) == 0 {
+		return
+	}
+	members := make([]string, 0, len(g.Members))
+	for _, username := range utils.MapKeys(g.Members) {
+		if role := g.Members[username]; role != "" {
+			username += " (" + role + ")"
+		}
+		members = append(members, username)
+	}
+	fmt.Fprintf(b, "    # members: %s\n", strings.Join(members, ", "))
+}
+
+// Seed records the imported teams and groups in store as target groups
+// managed by team-link, so that they are reported once their mapping is
+// removed, and caches the IDs of the GitHub team slugs.
+func (i *TerraformImport) Seed(ctx context.Context, store state.Store) error {
+	var merr error
+	groupIDs := make([]string, 0, len(i.GitHubTeams)+len(i.GitLabGroups))
+	for _, t := range i.GitHubTeams {
+		groupIDs = append(groupIDs, github.Encode(i.GitHubOrgID, t.ID))
+		if t.Name != "" {
+			merr = errors.Join(merr, github.SaveTeamSlug(ctx, store, i.GitHubOrgID, t.Name, t.ID))
+		}
+	}
+	for _, g := range i.GitLabGroups {
+		groupIDs = append(groupIDs, strconv.FormatInt(g.ID, 10))
+	}
+	merr = errors.Join(merr, groupsync.NewManagedGroups(store, groupsync.UnmappedGroupNone).Track(ctx, groupIDs))
+	if merr != nil {
+		return fmt.Errorf("failed to seed state store: %w", merr)
+	}
+	return nil
+}
+
+// stringAttribute returns the string attribute with the given key, or "".
+func stringAttribute(attrs map[string]any, key string) string {
+	s, _ := attrs[key].(string)
+	return s
+}
+
+// intAttribute returns the attribute with the given key as an integer. The
+// Terraform providers store IDs as strings.
+func intAttribute(attrs map[string]any, key string) (int64, error) {
+	switch v := attrs[key].(type) {
+	case string:
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s %q: %w", key, v, err)
+		}
+		return id, nil
+	case float64:
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("missing %s", key)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const testTerraformState = `{
+  "version": 4,
+  "terraform_version": "1.9.0",
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "github_team",
+      "name": "platform",
+      "instances": [{"attributes": {"id": "10", "slug": "platform", "name": "Platform"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "github_team_membership",
+      "name": "platform_alice",
+      "instances": [{"attributes": {"id": "10:alice", "team_id": "10", "username": "alice", "role": "maintainer"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "github_team_members",
+      "name": "sre",
+      "instances": [{"attributes": {"id": "11", "team_id": "11", "members": [
+        {"username": "bob", "role": "member"},
+        {"username": "carol", "role": "maintainer"}
+      ]}}]
+    },
+    {
+      "mode": "managed",
+      "type": "gitlab_group",
+      "name": "infra",
+      "instances": [{"attributes": {"id": "12", "full_path": "acme/infra"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "gitlab_group_membership",
+      "name": "infra_dave",
+      "instances": [{"attributes": {"id": "12:7", "group_id": "12", "user_id": 7, "access_level": "developer"}}]
+    },
+    {
+      "mode": "data",
+      "type": "github_team",
+      "name": "lookup",
+      "instances": [{"attributes": {"id": "99", "slug": "lookup"}}]
+    }
+  ]
+}`
+
+func TestImportTerraformState(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		state       string
+		gitHubOrgID int64
+		want        *TerraformImport
+		wantErr     string
+	}{
+		{
+			name:        "success",
+			state:       testTerraformState,
+			gitHubOrgID: 1,
+			want: &TerraformImport{
+				GitHubOrgID: 1,
+				GitHubTeams: []*ImportedGroup{
+					{
+						ID:        10,
+						Name:      "platform",
+						Resources: []string{"github_team.platform", "github_team_membership.platform_alice"},
+						Members:   map[string]string{"alice": "maintainer"},
+					},
+					{
+						ID:        11,
+						Resources: []string{"github_team_members.sre"},
+						Members:   map[string]string{"bob": "member", "carol": "maintainer"},
+					},
+				},
+				GitLabGroups: []*ImportedGroup{
+					{
+						ID:        12,
+						Name:      "acme/infra",
+						Resources: []string{"gitlab_group.infra", "gitlab_group_membership.infra_dave"},
+						Members:   map[string]string{},
+					},
+				},
+			},
+		},
+		{
+			name:    "missing_org",
+			state:   testTerraformState,
+			wantErr: "terraform state has github teams, the id of their github org is required",
+		},
+		{
+			name:    "unsupported_version",
+			state:   `{"version": 3}`,
+			wantErr: "unsupported terraform state version 3, want 4",
+		},
+		{
+			name: "invalid_id",
+			state: `{"version": 4, "resources": [{"mode": "managed", "type": "github_team", "name": "x",
+				"instances": [{"attributes": {"id": "x"}}]}]}`,
+			gitHubOrgID: 1,
+			wantErr:     `github_team.x: invalid id "x"`,
+		},
+		{
+			name:    "not_json",
+			state:   `resource "github_team" "x" {}`,
+			wantErr: "failed to decode terraform state",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ImportTerraformState(strings.NewReader(tc.state), tc.gitHubOrgID)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected import (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTerraformImport_WriteMappings(t *testing.T) {
+	t.Parallel()
+
+	imp, err := ImportTerraformState(strings.NewReader(testTerraformState), 1)
+	if err != nil {
+		t.Fatalf("ImportTerraformState failed: %v", err)
+	}
+	var b strings.Builder
+	if err := imp.WriteMappings(&b); err != nil {
+		t.Fatalf("WriteMappings failed: %v", err)
+	}
+
+	want := `# Generated by tlctl import terraform. Set the source group of each mapping
+# before syncing.
+group_mappings {
+  mappings: [
+    # github_team.platform, github_team_membership.platform_alice (platform)
+    # members: alice (maintainer)
+    {
+      github: {
+        org_id: 1
+        team_id: 10
+        team_slug: "platform"
+      }
+    },
+    # github_team_members.sre
+    # members: bob (member), carol (maintainer)
+    {
+      github: {
+        org_id: 1
+        team_id: 11
+      }
+    },
+    # gitlab_group.infra, gitlab_group_membership.infra_dave (acme/infra)
+    {
+      gitlab: {
+        group_id: 12
+      }
+    },
+  ]
+}
+`
+	if diff := cmp.Diff(b.String(), want); diff != "" {
+		t.Errorf("unexpected mappings (-got, +want):\n%s", diff)
+	}
+}
+
+func TestTerraformImport_Seed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	imp, err := ImportTerraformState(strings.NewReader(testTerraformState), 1)
+	if err != nil {
+		t.Fatalf("ImportTerraformState failed: %v", err)
+	}
+	store := state.NewMemoryStore()
+	if err := imp.Seed(ctx, store); err != nil {
+		t.Fatalf("Seed failed: %v", err)
+	}
+
+	records, err := groupsync.NewManagedGroups(store, groupsync.UnmappedGroupNone).Records(ctx)
+	if err != nil {
+		t.Fatalf("Records failed: %v", err)
+	}
+	var got []string
+	for _, r := range records {
+		got = append(got, r.GroupID)
+	}
+	if diff := cmp.Diff(got, []string{"1:10", "1:11", "12"}, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("unexpected managed groups (-got, +want):\n%s", diff)
+	}
+
+	slugs, err := store.List(ctx, "github/teamslug/")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if diff := cmp.Diff(slugs, []string{"github/teamslug/1/platform"}); diff != "" {
+		t.Errorf("unexpected cached team slugs (-got, +want):\n%s", diff)
+	}
+}
@@ -16,19 +16,55 @@ package common
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
 
 	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
 	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/common/generic"
+	glgh "github.com/abcxyz/team-link/pkg/common/gitlab_github"
 	gggh "github.com/abcxyz/team-link/pkg/common/googlegroup_github"
 	"github.com/abcxyz/team-link/pkg/groupsync"
 )
 
 // NewUserMapper creates a new UserMapper base on source and target system type.
 func NewUserMapper(ctx context.Context, source, target string, mappings *api.UserMappings) (groupsync.UserMapper, error) {
-	if source == tltypes.SystemTypeGoogleGroups && target == tltypes.SystemTypeGitHub {
+	switch {
+	case source == tltypes.SystemTypeGoogleGroups && target == tltypes.SystemTypeGitHub:
 		m := gggh.NewUserMapper(ctx, mappings)
 		return m, nil
+	case source == tltypes.SystemTypeGitLab && target == tltypes.SystemTypeGitHub,
+		source == tltypes.SystemTypeGitHub && target == tltypes.SystemTypeGitLab:
+		return glgh.NewUserMapper(ctx, mappings), nil
+	case SourceGroupIDFunc(source) != nil && TargetGroupIDFunc(target) != nil:
+		return generic.NewUserMapper(ctx, mappings), nil
 	}
-	return nil, fmt.Errorf("unsupported source to dest user mapper type: source %s, dest %s", source, target)
+	return nil, fmt.Errorf("%w: no user mapper from source %s to dest %s", groupsync.ErrUnsupportedSystem, source, target)
+}
+
+// NewChainedUserMapper chains the given user mapper, of the user mappings,
+// with a groupsync.RegexUserMapper for each of their id_rewrites. It returns
+// nil if the user mappings have no id_rewrites.
+func NewChainedUserMapper(userMapper groupsync.UserMapper, mappings *api.UserMappings) (*groupsync.ChainUserMapper, error) {
+	if len(mappings.GetIdRewrites()) == 0 {
+		return nil, nil
+	}
+	layers := []groupsync.MapperLayer{{Name: "user_mappings", Mapper: userMapper}}
+	var merr error
+	for i, r := range mappings.GetIdRewrites() {
+		pattern, err := regexp.Compile(r.GetPattern())
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("invalid pattern of id_rewrites %d: %w", i, err))
+			continue
+		}
+		layers = append(layers, groupsync.MapperLayer{
+			Name:   fmt.Sprintf("id_rewrites[%d]", i),
+			Mapper: groupsync.NewRegexUserMapper(pattern, r.GetReplacement()),
+		})
+	}
+	if merr != nil {
+		return nil, merr
+	}
+	return groupsync.NewChainUserMapper(layers...), nil
 }
@@ -0,0 +1,131 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// windowedMapper wraps a target group mapper so that the source groups of
+// mappings with active windows are only mapped to their target group during
+// one of the windows.
+type windowedMapper struct {
+	groupsync.OneToManyGroupMapper
+
+	// windows are the active windows of the mappings, keyed by target and
+	// source group ID.
+	windows map[[2]string][]groupsync.FreezeWindow
+	now     func() time.Time
+}
+
+// NewWindowedMapper wraps the given target group mapper, from the target to
+// the source system, so that source groups are only mapped to a target group
+// during the active_windows of their mapping. Target groups are still synced
+// outside of the windows, without the members of the inactive source groups.
+// The mapper is returned as is if no mapping has active windows.
+func NewWindowedMapper(targetMapper groupsync.OneToManyGroupMapper, sourceSystem, targetSystem string, gm *api.GroupMappings) (groupsync.OneToManyGroupMapper, error) {
+	windows := make(map[[2]string][]groupsync.FreezeWindow)
+	var merr error
+	for _, m := range gm.GetMappings() {
+		if len(m.GetActiveWindows()) == 0 {
+			continue
+		}
+		sourceID, ok := mappedGroupID(sourceSystem, m)
+		if !ok {
+			continue
+		}
+		targetID, ok := mappedGroupID(targetSystem, m)
+		if !ok {
+			continue
+		}
+		loc, err := time.LoadLocation(m.GetActiveTimeZone())
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("invalid active_time_zone of mapping from %s to %s: %w", sourceID, targetID, err))
+			continue
+		}
+		key := [2]string{targetID, sourceID}
+		for _, spec := range m.GetActiveWindows() {
+			w, err := groupsync.ParseFreezeWindow(spec, loc)
+			if err != nil {
+				merr = errors.Join(merr, fmt.Errorf("invalid active_windows of mapping from %s to %s: %w", sourceID, targetID, err))
+				continue
+			}
+			windows[key] = append(windows[key], w)
+		}
+	}
+	if merr != nil {
+		return nil, merr
+	}
+	if len(windows) == 0 {
+		return targetMapper, nil
+	}
+	return &windowedMapper{OneToManyGroupMapper: targetMapper, windows: windows, now: time.Now}, nil
+}
+
+// MappedGroupIDs returns the source groups mapped to the target group with the
+// given ID whose mapping is active.
+func (m *windowedMapper) MappedGroupIDs(ctx context.Context, groupID string) ([]string, error) {
+	sourceIDs, err := m.OneToManyGroupMapper.MappedGroupIDs(ctx, groupID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	now := m.now()
+	active := make([]string, 0, len(sourceIDs))
+	for _, sourceID := range sourceIDs {
+		windows, ok := m.windows[[2]string{groupID, sourceID}]
+		if !ok || activeWindow(windows, now) {
+			active = append(active, sourceID)
+			continue
+		}
+		logging.FromContext(ctx).InfoContext(ctx, "mapping is outside of its active windows",
+			"target_group_id", groupID,
+			"source_group_id", sourceID,
+		)
+	}
+	return active, nil
+}
+
+// activeWindow reports whether one of the windows contains the given time.
+func activeWindow(windows []groupsync.FreezeWindow, t time.Time) bool {
+	for _, w := range windows {
+		if w.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// mappedGroupID returns the group ID of the given system in a mapping, on
+// either side of it, like the bidirectional mappers of GitHub and GitLab.
+func mappedGroupID(system string, m *api.GroupMapping) (string, bool) {
+	if f := SourceGroupIDFunc(system); f != nil {
+		if id, ok := f(m); ok {
+			return id, true
+		}
+	}
+	if f := TargetGroupIDFunc(system); f != nil {
+		if id, ok := f(m); ok {
+			return id, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
+	tltypes "github.com/abcxyz/team-link/internal"
+)
+
+func TestNewWindowedMapper(t *testing.T) {
+	t.Parallel()
+
+	mapping := func(groupID string, windows ...string) *api.GroupMapping {
+		return &api.GroupMapping{
+			Source:         &api.GroupMapping_GoogleGroups{GoogleGroups: &api.GoogleGroups{GroupId: groupID}},
+			Target:         &api.GroupMapping_Github{Github: &api.GitHub{OrgId: 1, TeamId: 2}},
+			ActiveWindows:  windows,
+			ActiveTimeZone: "America/New_York",
+		}
+	}
+	// Monday 2026-06-01 14:00 in New York.
+	monday := time.Date(2026, 6, 1, 18, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		mappings []*api.GroupMapping
+		now      time.Time
+		want     []string
+		wantErr  string
+	}{
+		{
+			name:     "no_windows",
+			mappings: []*api.GroupMapping{mapping("groups/a"), mapping("groups/b")},
+			now:      monday,
+			want:     []string{"groups/a", "groups/b"},
+		},
+		{
+			name: "active",
+			mappings: []*api.GroupMapping{
+				mapping("groups/a"),
+				mapping("groups/oncall", "Mon 09:00-Mon 17:00"),
+			},
+			now:  monday,
+			want: []string{"groups/a", "groups/oncall"},
+		},
+		{
+			name: "inactive",
+			mappings: []*api.GroupMapping{
+				mapping("groups/a"),
+				mapping("groups/oncall", "Mon 09:00-Mon 17:00", "2026-06-02/2026-06-03"),
+			},
+			now:  monday.Add(4 * time.Hour),
+			want: []string{"groups/a"},
+		},
+		{
+			name: "date_window",
+			mappings: []*api.GroupMapping{
+				mapping("groups/oncall", "Mon 09:00-Mon 17:00", "2026-06-02/2026-06-03"),
+			},
+			now:  monday.Add(24 * time.Hour),
+			want: []string{"groups/oncall"},
+		},
+		{
+			name:     "all_inactive",
+			mappings: []*api.GroupMapping{mapping("groups/oncall", "Sat 00:00-Sun 00:00")},
+			now:      monday,
+			want:     []string{},
+		},
+		{
+			name:     "invalid_window",
+			mappings: []*api.GroupMapping{mapping("groups/oncall", "Mon 09:00")},
+			wantErr:  "invalid active_windows of mapping from groups/oncall to 1:2",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			gm := &api.GroupMappings{Mappings: tc.mappings}
+			_, targetMapper, err := NewBidirectionalOneToManyGroupMapper(tltypes.SystemTypeGoogleGroups, tltypes.SystemTypeGitHub, gm, nil)
+			if err != nil {
+				t.Fatalf("failed to create mapper: %v", err)
+			}
+			mapper, err := NewWindowedMapper(targetMapper, tltypes.SystemTypeGoogleGroups, tltypes.SystemTypeGitHub, gm)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if w, ok := mapper.(*windowedMapper); ok {
+				w.now = func() time.Time { return tc.now }
+			}
+			got, err := mapper.MappedGroupIDs(ctx, "1:2")
+			if err != nil {
+				t.Fatalf("MappedGroupIDs failed: %v", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected source groups (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
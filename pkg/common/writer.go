@@ -17,35 +17,679 @@ package common
 import (
 	"context"
 	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	admin "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/chat/v1"
+	"google.golang.org/api/option"
+
+	"github.com/abcxyz/pkg/githubauth"
 
 	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
 	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/auth0"
+	"github.com/abcxyz/team-link/pkg/box"
+	"github.com/abcxyz/team-link/pkg/confluence"
+	"github.com/abcxyz/team-link/pkg/credentials"
+	"github.com/abcxyz/team-link/pkg/databricks"
+	"github.com/abcxyz/team-link/pkg/dropbox"
+	"github.com/abcxyz/team-link/pkg/gerrit"
 	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/gitlab"
+	"github.com/abcxyz/team-link/pkg/googlecalendar"
+	"github.com/abcxyz/team-link/pkg/googlechat"
+	"github.com/abcxyz/team-link/pkg/googlegroups"
 	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/jumpcloud"
+	"github.com/abcxyz/team-link/pkg/kubernetes"
+	"github.com/abcxyz/team-link/pkg/looker"
+	"github.com/abcxyz/team-link/pkg/mattermost"
+	"github.com/abcxyz/team-link/pkg/microsoft365"
+	"github.com/abcxyz/team-link/pkg/rocketchat"
+	"github.com/abcxyz/team-link/pkg/salesforce"
+	"github.com/abcxyz/team-link/pkg/sentry"
+	"github.com/abcxyz/team-link/pkg/servicenow"
+	"github.com/abcxyz/team-link/pkg/splunk"
+	"github.com/abcxyz/team-link/pkg/state"
+	"github.com/abcxyz/team-link/pkg/tableau"
+	"github.com/abcxyz/team-link/pkg/vault"
+	"github.com/abcxyz/team-link/pkg/zendesk"
 )
 
 // NewReadWriter creates a new ReadWriter base on target system type and provided config.
-func NewReadWriter(ctx context.Context, target string, config *api.TeamLinkConfig, mappings *api.TeamLinkMappings) (groupsync.GroupReadWriter, error) {
-	if target == tltypes.SystemTypeGitHub {
-		readWriter, err := NewGitHubReadWriter(ctx, config.GetTargetConfig().GetGithubConfig(), mappings)
+// The store keeps credentials which are rotated, it may be nil if there are
+// none. The githubOpts configure the GitHub ReadWriter.
+func NewReadWriter(ctx context.Context, target string, config *api.TeamLinkConfig, mappings *api.TeamLinkMappings, store state.Store, githubOpts ...github.Opt) (groupsync.GroupReadWriter, error) {
+	switch target {
+	case tltypes.SystemTypeGitHub:
+		readWriter, err := NewGitHubReadWriter(ctx, GitHubConfig(config), mappings, githubOpts...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create readwriter for github: %w", err)
 		}
 		return readWriter, nil
+	case tltypes.SystemTypeGitLab:
+		readWriter, err := NewGitLabReadWriter(ctx, GitLabConfig(config), store)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for gitlab: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeGerrit:
+		readWriter, err := NewGerritReadWriter(ctx, config.GetTargetConfig().GetGerritConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for gerrit: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeSentry:
+		readWriter, err := NewSentryReadWriter(ctx, config.GetTargetConfig().GetSentryConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for sentry: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeKubernetes:
+		readWriter, err := NewKubernetesReadWriter(config.GetTargetConfig().GetKubernetesConfig(), mappings)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for kubernetes: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeVault:
+		readWriter, err := NewVaultReadWriter(ctx, config.GetTargetConfig().GetVaultConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for vault: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeAuth0:
+		readWriter, err := NewAuth0ReadWriter(ctx, config.GetTargetConfig().GetAuth0Config())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for auth0: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeMattermost:
+		readWriter, err := NewMattermostReadWriter(ctx, config.GetTargetConfig().GetMattermostConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for mattermost: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeRocketChat:
+		readWriter, err := NewRocketChatReadWriter(ctx, config.GetTargetConfig().GetRocketChatConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for rocket.chat: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeZendesk:
+		readWriter, err := NewZendeskReadWriter(ctx, config.GetTargetConfig().GetZendeskConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for zendesk: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeServiceNow:
+		readWriter, err := NewServiceNowReadWriter(ctx, config.GetTargetConfig().GetServiceNowConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for servicenow: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeSplunk:
+		readWriter, err := NewSplunkReadWriter(ctx, config.GetTargetConfig().GetSplunkConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for splunk: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeLooker:
+		readWriter, err := NewLookerReadWriter(ctx, config.GetTargetConfig().GetLookerConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for looker: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeTableau:
+		readWriter, err := NewTableauReadWriter(ctx, config.GetTargetConfig().GetTableauConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for tableau: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeConfluence:
+		readWriter, err := NewConfluenceReadWriter(ctx, config.GetTargetConfig().GetConfluenceConfig(), mappings)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for confluence: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeJumpCloud:
+		readWriter, err := NewJumpCloudReadWriter(ctx, JumpCloudConfig(config))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for jumpcloud: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeDatabricks:
+		readWriter, err := NewDatabricksReadWriter(ctx, config.GetTargetConfig().GetDatabricksConfig(), mappings)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for databricks: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeGoogleGroups:
+		readWriter, err := googlegroups.NewGroupReadWriterWithDefaultApplicationToken(ctx,
+			googlegroups.WithManageOwners(config.GetTargetConfig().GetGoogleGroupsConfig().GetManageOwners()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for google groups: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeGoogleChat:
+		readWriter, err := NewGoogleChatReadWriter(ctx, config.GetTargetConfig().GetGoogleChatConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for google chat: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeGoogleCalendar:
+		cs, err := calendar.NewService(ctx, option.WithScopes(calendar.CalendarScope))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for google calendar: %w", err)
+		}
+		return googlecalendar.NewGroupReadWriter(cs), nil
+	case tltypes.SystemTypeMicrosoft365:
+		readWriter, err := NewMicrosoft365ReadWriter(ctx, config.GetTargetConfig().GetMicrosoft365Config())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for microsoft 365: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeBox:
+		readWriter, err := NewBoxReadWriter(ctx, config.GetTargetConfig().GetBoxConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for box: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeDropbox:
+		readWriter, err := NewDropboxReadWriter(ctx, config.GetTargetConfig().GetDropboxConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for dropbox: %w", err)
+		}
+		return readWriter, nil
+	case tltypes.SystemTypeSalesforce:
+		readWriter, err := NewSalesforceReadWriter(ctx, config.GetTargetConfig().GetSalesforceConfig())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter for salesforce: %w", err)
+		}
+		return readWriter, nil
+	}
+	return nil, fmt.Errorf("%w: target type %s", groupsync.ErrUnsupportedSystem, target)
+}
+
+// GitHubConfig returns the GitHub config of either side of the sync.
+func GitHubConfig(config *api.TeamLinkConfig) *api.GitHubConfig {
+	if c := config.GetTargetConfig().GetGithubConfig(); c != nil {
+		return c
 	}
-	return nil, fmt.Errorf("unsupported system type %s", target)
+	return config.GetSourceConfig().GetGithubConfig()
+}
+
+// GitLabConfig returns the GitLab config of either side of the sync.
+func GitLabConfig(config *api.TeamLinkConfig) *api.GitLabConfig {
+	if c := config.GetTargetConfig().GetGitlabConfig(); c != nil {
+		return c
+	}
+	return config.GetSourceConfig().GetGitlabConfig()
+}
+
+// JumpCloudConfig returns the JumpCloud config of either side of the sync.
+func JumpCloudConfig(config *api.TeamLinkConfig) *api.JumpCloudConfig {
+	if c := config.GetTargetConfig().GetJumpcloudConfig(); c != nil {
+		return c
+	}
+	return config.GetSourceConfig().GetJumpcloudConfig()
+}
+
+// GoogleGroupsConfig returns the Google Groups config of either side of the
+// sync.
+func GoogleGroupsConfig(config *api.TeamLinkConfig) *api.GoogleGroupsConfig {
+	if c := config.GetTargetConfig().GetGoogleGroupsConfig(); c != nil {
+		return c
+	}
+	return config.GetSourceConfig().GetGoogleGroupsConfig()
+}
+
+// NewGitLabReadWriter creates a ReadWriter for gitlab using provided config.
+// Rotated tokens are kept in store, which is required to rotate tokens.
+func NewGitLabReadWriter(ctx context.Context, config *api.GitLabConfig, store state.Store) (groupsync.GroupReadWriter, error) {
+	endpoint := config.GetEnterpriseUrl()
+	if endpoint == "" {
+		endpoint = gitlab.DefaultGitLabEndpointURL
+	}
+	switch a := config.GetAuthentication().(type) {
+	case *api.GitLabConfig_StaticToken:
+		keyProvider, err := gitLabKeyProvider(ctx, a.StaticToken)
+		if err != nil {
+			return nil, err
+		}
+		clientProvider := gitlab.NewGitLabClientProvider(endpoint, keyProvider, nil)
+		return gitlab.NewGroupReadWriter(clientProvider), nil
+	case *api.GitLabConfig_RotatingToken:
+		if store == nil {
+			return nil, fmt.Errorf("rotating gitlab tokens requires a state store")
+		}
+		keyProvider, err := gitLabKeyProvider(ctx, a.RotatingToken.GetToken())
+		if err != nil {
+			return nil, err
+		}
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gitlab url %s: %w", endpoint, err)
+		}
+		var opts []gitlab.RotationOpt
+		if days := a.RotatingToken.GetRotateBeforeDays(); days > 0 {
+			opts = append(opts, gitlab.WithRotateBefore(time.Duration(days)*24*time.Hour))
+		}
+		if days := a.RotatingToken.GetValidityDays(); days > 0 {
+			opts = append(opts, gitlab.WithTokenValidity(time.Duration(days)*24*time.Hour))
+		}
+		rotating := gitlab.NewRotatingTokenProvider(endpoint, keyProvider, store, u.Host, opts...)
+		clientProvider := gitlab.NewGitLabClientProvider(endpoint, rotating, nil)
+		return gitlab.NewGroupReadWriter(clientProvider), nil
+	}
+	return nil, fmt.Errorf("unsupported authentication type method for gitlab")
+}
+
+// gitLabKeyProvider provides the configured gitlab token, decrypted once if
+// it is encrypted and read from its environment variable otherwise.
+func gitLabKeyProvider(ctx context.Context, t *api.StaticToken) (credentials.KeyProvider, error) {
+	if encrypted := t.GetEncrypted(); encrypted != "" {
+		token, err := credentials.NewKMSDecrypter().Decrypt(ctx, encrypted)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt gitlab token: %w", err)
+		}
+		return credentials.NewStaticKeyProvider(token), nil
+	}
+	return credentials.NewEnvKeyProvider(t.GetFromEnvironment()), nil
+}
+
+// NewGerritReadWriter creates a ReadWriter for gerrit using provided config.
+func NewGerritReadWriter(ctx context.Context, config *api.GerritConfig) (groupsync.GroupReadWriter, error) {
+	if config.GetUrl() == "" {
+		return nil, fmt.Errorf("gerrit url is required")
+	}
+	password, err := secret(ctx, config.GetHttpPassword())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gerrit http password: %w", err)
+	}
+	return gerrit.NewGroupReadWriter(strings.TrimSuffix(config.GetUrl(), "/"), config.GetUsername(), string(password)), nil
+}
+
+// NewSentryReadWriter creates a ReadWriter for sentry using provided config.
+func NewSentryReadWriter(ctx context.Context, config *api.SentryConfig) (groupsync.GroupReadWriter, error) {
+	endpoint := config.GetUrl()
+	if endpoint == "" {
+		endpoint = sentry.DefaultEndpoint
+	}
+	token, err := secret(ctx, config.GetAuthToken())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sentry auth token: %w", err)
+	}
+	return sentry.NewTeamReadWriter(strings.TrimSuffix(endpoint, "/"), string(token)), nil
+}
+
+// NewKubernetesReadWriter creates a ReadWriter rendering the kubernetes role
+// bindings of the given mappings.
+func NewKubernetesReadWriter(config *api.KubernetesConfig, mappings *api.TeamLinkMappings) (groupsync.GroupReadWriter, error) {
+	roleRefs := make(map[string]*kubernetes.RoleRef)
+	for _, m := range mappings.GetGroupMappings().GetMappings() {
+		b := m.GetKubernetesRoleBinding()
+		if b == nil {
+			continue
+		}
+		roleRef, err := kubernetes.NewRoleRef(b.GetRoleKind(), b.GetRoleName())
+		if err != nil {
+			return nil, fmt.Errorf("invalid role of binding %s: %w", b.GetName(), err)
+		}
+		roleRefs[kubernetes.Encode(b.GetNamespace(), b.GetName())] = roleRef
+	}
+	writer, err := kubernetes.NewRoleBindingWriter(config.GetManifestDir(), roleRefs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create role binding writer: %w", err)
+	}
+	return writer, nil
+}
+
+// NewVaultReadWriter creates a ReadWriter for vault using provided config.
+func NewVaultReadWriter(ctx context.Context, config *api.VaultConfig) (groupsync.GroupReadWriter, error) {
+	if config.GetAddress() == "" {
+		return nil, fmt.Errorf("vault address is required")
+	}
+	token, err := secret(ctx, config.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault token: %w", err)
+	}
+	return vault.NewGroupReadWriter(strings.TrimSuffix(config.GetAddress(), "/"), string(token),
+		vault.WithNamespace(config.GetNamespace())), nil
+}
+
+// NewAuth0ReadWriter creates a ReadWriter for auth0 using provided config.
+func NewAuth0ReadWriter(ctx context.Context, config *api.Auth0Config) (groupsync.GroupReadWriter, error) {
+	if config.GetDomain() == "" || config.GetClientId() == "" {
+		return nil, fmt.Errorf("auth0 domain and client_id are required")
+	}
+	clientSecret, err := secret(ctx, config.GetClientSecret())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auth0 client secret: %w", err)
+	}
+	return auth0.NewGroupReadWriter(ctx, "https://"+config.GetDomain(), config.GetClientId(), string(clientSecret)), nil
+}
+
+// NewMattermostReadWriter creates a ReadWriter for mattermost using provided
+// config.
+func NewMattermostReadWriter(ctx context.Context, config *api.MattermostConfig) (groupsync.GroupReadWriter, error) {
+	if config.GetUrl() == "" {
+		return nil, fmt.Errorf("mattermost url is required")
+	}
+	token, err := secret(ctx, config.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mattermost token: %w", err)
+	}
+	return mattermost.NewGroupReadWriter(strings.TrimSuffix(config.GetUrl(), "/"), string(token)), nil
+}
+
+// NewRocketChatReadWriter creates a ReadWriter for rocket.chat using provided
+// config.
+func NewRocketChatReadWriter(ctx context.Context, config *api.RocketChatConfig) (groupsync.GroupReadWriter, error) {
+	if config.GetUrl() == "" || config.GetUserId() == "" {
+		return nil, fmt.Errorf("rocket.chat url and user_id are required")
+	}
+	token, err := secret(ctx, config.GetAuthToken())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rocket.chat auth token: %w", err)
+	}
+	return rocketchat.NewGroupReadWriter(strings.TrimSuffix(config.GetUrl(), "/"), config.GetUserId(), string(token)), nil
+}
+
+// NewZendeskReadWriter creates a ReadWriter for zendesk using provided config.
+func NewZendeskReadWriter(ctx context.Context, config *api.ZendeskConfig) (groupsync.GroupReadWriter, error) {
+	if config.GetSubdomain() == "" || config.GetEmail() == "" {
+		return nil, fmt.Errorf("zendesk subdomain and email are required")
+	}
+	token, err := secret(ctx, config.GetApiToken())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get zendesk api token: %w", err)
+	}
+	endpoint := "https://" + config.GetSubdomain() + ".zendesk.com"
+	return zendesk.NewGroupReadWriter(endpoint, config.GetEmail(), string(token)), nil
+}
+
+// NewServiceNowReadWriter creates a ReadWriter for servicenow using provided
+// config.
+func NewServiceNowReadWriter(ctx context.Context, config *api.ServiceNowConfig) (groupsync.GroupReadWriter, error) {
+	if config.GetUrl() == "" || config.GetUsername() == "" {
+		return nil, fmt.Errorf("servicenow url and username are required")
+	}
+	password, err := secret(ctx, config.GetPassword())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get servicenow password: %w", err)
+	}
+	return servicenow.NewGroupReadWriter(strings.TrimSuffix(config.GetUrl(), "/"), config.GetUsername(), string(password)), nil
+}
+
+// NewSplunkReadWriter creates a ReadWriter for splunk using provided config.
+func NewSplunkReadWriter(ctx context.Context, config *api.SplunkConfig) (groupsync.GroupReadWriter, error) {
+	if config.GetUrl() == "" {
+		return nil, fmt.Errorf("splunk url is required")
+	}
+	token, err := secret(ctx, config.GetToken())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get splunk token: %w", err)
+	}
+	return splunk.NewGroupReadWriter(strings.TrimSuffix(config.GetUrl(), "/"), string(token)), nil
+}
+
+// NewLookerReadWriter creates a ReadWriter for looker using provided config.
+func NewLookerReadWriter(ctx context.Context, config *api.LookerConfig) (groupsync.GroupReadWriter, error) {
+	if config.GetUrl() == "" || config.GetClientId() == "" {
+		return nil, fmt.Errorf("looker url and client_id are required")
+	}
+	clientSecret, err := secret(ctx, config.GetClientSecret())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get looker client secret: %w", err)
+	}
+	return looker.NewGroupReadWriter(ctx, strings.TrimSuffix(config.GetUrl(), "/"), config.GetClientId(), string(clientSecret)), nil
+}
+
+// NewTableauReadWriter creates a ReadWriter for tableau using provided config.
+func NewTableauReadWriter(ctx context.Context, config *api.TableauConfig) (groupsync.GroupReadWriter, error) {
+	if config.GetUrl() == "" || config.GetTokenName() == "" {
+		return nil, fmt.Errorf("tableau url and token_name are required")
+	}
+	tokenSecret, err := secret(ctx, config.GetTokenSecret())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tableau token secret: %w", err)
+	}
+	return tableau.NewGroupReadWriter(strings.TrimSuffix(config.GetUrl(), "/"), config.GetSite(), config.GetTokenName(), string(tokenSecret)), nil
+}
+
+// NewConfluenceReadWriter creates a ReadWriter for confluence using provided
+// config. When the config manages space permissions, they are read from the
+// given mappings.
+func NewConfluenceReadWriter(ctx context.Context, config *api.ConfluenceConfig, mappings *api.TeamLinkMappings) (groupsync.GroupReadWriter, error) {
+	if config.GetUrl() == "" || config.GetEmail() == "" {
+		return nil, fmt.Errorf("confluence url and email are required")
+	}
+	apiToken, err := secret(ctx, config.GetApiToken())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get confluence api token: %w", err)
+	}
+	var opts []confluence.Opt
+	if config.GetManageSpacePermissions() {
+		permissions := make(map[string][]*confluence.SpacePermission)
+		for _, m := range mappings.GetGroupMappings().GetMappings() {
+			c := m.GetConfluence()
+			for _, p := range c.GetSpacePermissions() {
+				for _, op := range p.GetOperations() {
+					if !strings.Contains(op, ":") {
+						return nil, fmt.Errorf("invalid operation %q of space %s, want OPERATION:TARGET", op, p.GetSpaceKey())
+					}
+				}
+				permissions[c.GetGroupId()] = append(permissions[c.GetGroupId()], &confluence.SpacePermission{
+					SpaceKey:   p.GetSpaceKey(),
+					Operations: p.GetOperations(),
+				})
+			}
+		}
+		opts = append(opts, confluence.WithSpacePermissions(permissions))
+	}
+	return confluence.NewGroupReadWriter(strings.TrimSuffix(config.GetUrl(), "/"), config.GetEmail(), string(apiToken), opts...), nil
+}
+
+// NewJumpCloudReadWriter creates a ReadWriter for jumpcloud using provided
+// config.
+func NewJumpCloudReadWriter(ctx context.Context, config *api.JumpCloudConfig) (groupsync.GroupReadWriter, error) {
+	endpoint := config.GetUrl()
+	if endpoint == "" {
+		endpoint = jumpcloud.DefaultEndpoint
+	}
+	apiKey, err := secret(ctx, config.GetApiKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jumpcloud api key: %w", err)
+	}
+	return jumpcloud.NewGroupReadWriter(strings.TrimSuffix(endpoint, "/"), string(apiKey), jumpcloud.WithOrgID(config.GetOrgId())), nil
+}
+
+// NewDatabricksReadWriter creates a ReadWriter for databricks using provided
+// config, setting the entitlements of the given mappings.
+func NewDatabricksReadWriter(ctx context.Context, config *api.DatabricksConfig, mappings *api.TeamLinkMappings) (groupsync.GroupReadWriter, error) {
+	if config.GetAccountId() == "" || config.GetClientId() == "" {
+		return nil, fmt.Errorf("databricks account_id and client_id are required")
+	}
+	endpoint := config.GetUrl()
+	if endpoint == "" {
+		endpoint = databricks.DefaultEndpoint
+	}
+	clientSecret, err := secret(ctx, config.GetClientSecret())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get databricks client secret: %w", err)
+	}
+	entitlements := make(map[string][]string)
+	for _, m := range mappings.GetGroupMappings().GetMappings() {
+		if d := m.GetDatabricks(); len(d.GetEntitlements()) > 0 {
+			entitlements[d.GetGroupId()] = d.GetEntitlements()
+		}
+	}
+	return databricks.NewGroupReadWriter(ctx, strings.TrimSuffix(endpoint, "/"), config.GetAccountId(), config.GetClientId(), string(clientSecret),
+		databricks.WithEntitlements(entitlements),
+	), nil
+}
+
+// NewMicrosoft365ReadWriter creates a ReadWriter for microsoft 365 using
+// provided config.
+func NewMicrosoft365ReadWriter(ctx context.Context, config *api.Microsoft365Config) (groupsync.GroupReadWriter, error) {
+	if config.GetTenantId() == "" || config.GetClientId() == "" {
+		return nil, fmt.Errorf("microsoft 365 tenant_id and client_id are required")
+	}
+	graphURL, loginURL := config.GetGraphUrl(), config.GetLoginUrl()
+	if graphURL == "" {
+		graphURL = microsoft365.DefaultGraphEndpoint
+	}
+	if loginURL == "" {
+		loginURL = microsoft365.DefaultLoginEndpoint
+	}
+	clientSecret, err := secret(ctx, config.GetClientSecret())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get microsoft 365 client secret: %w", err)
+	}
+	return microsoft365.NewGroupReadWriter(ctx, config.GetTenantId(), config.GetClientId(), string(clientSecret),
+		microsoft365.WithEndpoints(graphURL, loginURL),
+	), nil
+}
+
+// NewBoxReadWriter creates a ReadWriter for box using provided config.
+func NewBoxReadWriter(ctx context.Context, config *api.BoxConfig) (groupsync.GroupReadWriter, error) {
+	if config.GetEnterpriseId() == "" || config.GetClientId() == "" {
+		return nil, fmt.Errorf("box enterprise_id and client_id are required")
+	}
+	endpoint := config.GetUrl()
+	if endpoint == "" {
+		endpoint = box.DefaultEndpoint
+	}
+	clientSecret, err := secret(ctx, config.GetClientSecret())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get box client secret: %w", err)
+	}
+	return box.NewGroupReadWriter(ctx, strings.TrimSuffix(endpoint, "/"), config.GetEnterpriseId(), config.GetClientId(), string(clientSecret)), nil
+}
+
+// NewDropboxReadWriter creates a ReadWriter for dropbox using provided config.
+func NewDropboxReadWriter(ctx context.Context, config *api.DropboxConfig) (groupsync.GroupReadWriter, error) {
+	if config.GetAppKey() == "" {
+		return nil, fmt.Errorf("dropbox app_key is required")
+	}
+	endpoint := config.GetUrl()
+	if endpoint == "" {
+		endpoint = dropbox.DefaultEndpoint
+	}
+	appSecret, err := secret(ctx, config.GetAppSecret())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dropbox app secret: %w", err)
+	}
+	refreshToken, err := secret(ctx, config.GetRefreshToken())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dropbox refresh token: %w", err)
+	}
+	return dropbox.NewGroupReadWriter(ctx, strings.TrimSuffix(endpoint, "/"), config.GetAppKey(), string(appSecret), string(refreshToken)), nil
+}
+
+// NewSalesforceReadWriter creates a ReadWriter for salesforce using provided
+// config.
+func NewSalesforceReadWriter(ctx context.Context, config *api.SalesforceConfig) (groupsync.GroupReadWriter, error) {
+	if config.GetUrl() == "" || config.GetClientId() == "" {
+		return nil, fmt.Errorf("salesforce url and client_id are required")
+	}
+	clientSecret, err := secret(ctx, config.GetClientSecret())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get salesforce client secret: %w", err)
+	}
+	return salesforce.NewGroupReadWriter(ctx, strings.TrimSuffix(config.GetUrl(), "/"), config.GetClientId(), string(clientSecret)), nil
+}
+
+// NewGoogleChatReadWriter creates a ReadWriter for google chat using provided
+// config, authenticating with application default credentials.
+func NewGoogleChatReadWriter(ctx context.Context, config *api.GoogleChatConfig) (groupsync.GroupReadWriter, error) {
+	scopes := []string{chat.ChatMembershipsScope, chat.ChatSpacesReadonlyScope}
+	if config.GetUseAdminAccess() {
+		scopes = []string{chat.ChatAdminMembershipsScope, chat.ChatAdminSpacesReadonlyScope}
+	}
+	cs, err := chat.NewService(ctx, option.WithScopes(scopes...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create chat service: %w", err)
+	}
+	as, err := admin.NewService(ctx, option.WithScopes(admin.AdminDirectoryUserReadonlyScope))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin service: %w", err)
+	}
+	return googlechat.NewGroupReadWriter(cs, as, googlechat.WithAdminAccess(config.GetUseAdminAccess())), nil
+}
+
+// secret returns the value of a StaticToken, decrypting it if it is
+// encrypted and reading it from its environment variable otherwise.
+func secret(ctx context.Context, t *api.StaticToken) ([]byte, error) {
+	if encrypted := t.GetEncrypted(); encrypted != "" {
+		value, err := credentials.NewKMSDecrypter().Decrypt(ctx, encrypted)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt: %w", err)
+		}
+		return value, nil
+	}
+	return credentials.NewEnvKeyProvider(t.GetFromEnvironment()).Key(ctx) //nolint:wrapcheck // Want passthrough
 }
 
 // NewGitHubReadWriter creates a ReadWriter for github using provided config.
-func NewGitHubReadWriter(ctx context.Context, config *api.GitHubConfig, mappings *api.TeamLinkMappings) (groupsync.GroupReadWriter, error) {
+func NewGitHubReadWriter(ctx context.Context, config *api.GitHubConfig, mappings *api.TeamLinkMappings, opts ...github.Opt) (groupsync.GroupReadWriter, error) {
 	orgTeamSSORequired := computeOrgTeamSSORequired(mappings)
+	if size := config.GetPageSize(); size > 0 {
+		opts = append(opts, github.WithPageSize(int(size)))
+	}
 	switch a := config.GetAuthentication().(type) {
 	case *api.GitHubConfig_StaticAuth:
-		tokenSource, err := github.NewStaticTokenSourceFromEnvVar(a.StaticAuth.GetFromEnvironment())
+		tokenSource, err := staticTokenSource(ctx, a.StaticAuth)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create StaticTokenSource: %w", err)
+			return nil, err
 		}
-		writer, err := github.NewTeamReadWriterWithStaticTokenSource(ctx, tokenSource, config.GetEnterpriseUrl(), orgTeamSSORequired)
+		writer, err := github.NewTeamReadWriterWithStaticTokenSource(ctx, tokenSource, config.GetEnterpriseUrl(), orgTeamSSORequired, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create readwriter: %w", err)
+		}
+		return writer, nil
+	case *api.GitHubConfig_GhAppAuth:
+		tokenSource, err := appTokenSource(ctx, a.GhAppAuth, config.GetEnterpriseUrl())
+		if err != nil {
+			return nil, err
+		}
+		return newGitHubAppReadWriter(ctx, tokenSource, nil, config.GetEnterpriseUrl(), orgTeamSSORequired, opts...)
+	case *api.GitHubConfig_GhAppsByOrgAuth:
+		sources := make(map[int64]github.OrgTokenSource, len(a.GhAppsByOrgAuth.GetOrgApps()))
+		for orgID, app := range a.GhAppsByOrgAuth.GetOrgApps() {
+			tokenSource, err := appTokenSource(ctx, app, config.GetEnterpriseUrl())
+			if err != nil {
+				return nil, fmt.Errorf("failed to configure github app of org %d: %w", orgID, err)
+			}
+			sources[orgID] = tokenSource
+		}
+		var fallback github.OrgTokenSource
+		if app := a.GhAppsByOrgAuth.GetDefaultApp(); app != nil {
+			tokenSource, err := appTokenSource(ctx, app, config.GetEnterpriseUrl())
+			if err != nil {
+				return nil, fmt.Errorf("failed to configure default github app: %w", err)
+			}
+			fallback = tokenSource
+		}
+		return newGitHubAppReadWriter(ctx, github.NewPerOrgTokenSource(sources, fallback), sources,
+			config.GetEnterpriseUrl(), orgTeamSSORequired, opts...)
+	case *api.GitHubConfig_EnvOrgAuth:
+		var fallback github.OrgTokenSource
+		var token string
+		if envVar := a.EnvOrgAuth.GetDefaultFromEnvironment(); envVar != "" {
+			tokenSource, err := github.NewStaticTokenSourceFromEnvVar(envVar)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create StaticTokenSource: %w", err)
+			}
+			fallback, token = tokenSource, tokenSource.GetStaticToken()
+		}
+		tokenSource := github.NewEnvTokenSource(a.EnvOrgAuth.GetPrefix(), fallback)
+		writer, err := github.NewTeamReadWriterWithTokenSource(ctx, tokenSource, token, config.GetEnterpriseUrl(), orgTeamSSORequired, opts...)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create readwriter: %w", err)
 		}
@@ -54,6 +698,74 @@ func NewGitHubReadWriter(ctx context.Context, config *api.GitHubConfig, mappings
 	return nil, fmt.Errorf("unsupported authentication type method for github")
 }
 
+// staticTokenSource creates a StaticTokenSource using the encrypted token of
+// the config, or the token read from its environment variable.
+func staticTokenSource(ctx context.Context, t *api.StaticToken) (*github.StaticTokenSource, error) {
+	if encrypted := t.GetEncrypted(); encrypted != "" {
+		token, err := credentials.NewKMSDecrypter().Decrypt(ctx, encrypted)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt github token: %w", err)
+		}
+		return github.NewStaticTokenSource(string(token)), nil
+	}
+	tokenSource, err := github.NewStaticTokenSourceFromEnvVar(t.GetFromEnvironment())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create StaticTokenSource: %w", err)
+	}
+	return tokenSource, nil
+}
+
+// appTokenSource creates an AppTokenSource for the configured GitHub App.
+func appTokenSource(ctx context.Context, app *api.GitHubApp, endpoint string) (*github.AppTokenSource, error) {
+	if app.GetAppId() == "" {
+		return nil, fmt.Errorf("github app id is not provided")
+	}
+	var keyProvider credentials.KeyProvider
+	switch {
+	case app.GetEncryptedPrivateKey() != "":
+		key, err := credentials.NewKMSDecrypter().Decrypt(ctx, app.GetEncryptedPrivateKey())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt github app private key: %w", err)
+		}
+		keyProvider = credentials.NewStaticKeyProvider(key)
+	case app.GetPrivateKeyFromEnvironment() != "":
+		keyProvider = credentials.NewEnvKeyProvider(app.GetPrivateKeyFromEnvironment())
+	default:
+		return nil, fmt.Errorf("github app %s has no private_key_from_environment or encrypted_private_key", app.GetAppId())
+	}
+	var appOpts []githubauth.Option
+	if endpoint != "" && endpoint != github.DefaultGitHubEndpointURL {
+		appOpts = append(appOpts, githubauth.WithBaseURL(strings.TrimSuffix(endpoint, "/")+"/api/v3"))
+	}
+	if id := app.GetInstallationId(); id != "" {
+		return github.NewInstallationTokenSource(keyProvider, app.GetAppId(), id, appOpts...), nil
+	}
+	return github.NewAppTokenSource(keyProvider, app.GetAppId(), appOpts...), nil
+}
+
+// newGitHubAppReadWriter creates a ReadWriter for github minting tokens with
+// the given token source, which are cached until shortly before they expire.
+// User lookups use the token of the lowest mapped org, or of the lowest
+// configured org if no org is mapped.
+func newGitHubAppReadWriter(ctx context.Context, tokenSource github.OrgTokenSource, configuredOrgs map[int64]github.OrgTokenSource,
+	endpoint string, orgTeamSSORequired map[int64]map[int64]bool, opts ...github.Opt,
+) (groupsync.GroupReadWriter, error) {
+	var userLookupOrgID int64
+	for _, orgIDs := range [][]int64{slices.Sorted(maps.Keys(orgTeamSSORequired)), slices.Sorted(maps.Keys(configuredOrgs))} {
+		// mappings of other systems have no org ID.
+		if i := slices.IndexFunc(orgIDs, func(id int64) bool { return id != 0 }); i >= 0 {
+			userLookupOrgID = orgIDs[i]
+			break
+		}
+	}
+	cached := github.NewCachingTokenSource(tokenSource, state.NewMemoryStore(), github.DefaultTokenCacheDuration)
+	writer, err := github.NewTeamReadWriterWithAppTokenSource(ctx, cached, userLookupOrgID, endpoint, orgTeamSSORequired, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create readwriter: %w", err)
+	}
+	return writer, nil
+}
+
 // computeOrgTeamSSORequired compute whether a team in a org requires
 // user to have SSO enabled to do membership syncing using the provided
 // api.TeamLinkMappings. The result is stored as a map of type
@@ -67,11 +779,12 @@ func NewGitHubReadWriter(ctx context.Context, config *api.GitHubConfig, mappings
 func computeOrgTeamSSORequired(mappings *api.TeamLinkMappings) map[int64]map[int64]bool {
 	orgTeamSSORequired := make(map[int64]map[int64]bool)
 	for _, v := range mappings.GetGroupMappings().GetMappings() {
-		if _, ok := orgTeamSSORequired[v.GetGithub().GetOrgId()]; !ok {
-			orgTeamSSORequired[v.GetGithub().GetOrgId()] = make(map[int64]bool)
-			orgTeamSSORequired[v.GetGithub().GetOrgId()][v.GetGithub().GetTeamId()] = v.GetGithub().GetRequireUserEnableSso()
+		gh := gitHubTeam(v)
+		if _, ok := orgTeamSSORequired[gh.GetOrgId()]; !ok {
+			orgTeamSSORequired[gh.GetOrgId()] = make(map[int64]bool)
+			orgTeamSSORequired[gh.GetOrgId()][gh.GetTeamId()] = gh.GetRequireUserEnableSso()
 		} else {
-			orgTeamSSORequired[v.GetGithub().GetOrgId()][v.GetGithub().GetTeamId()] = v.GetGithub().GetRequireUserEnableSso()
+			orgTeamSSORequired[gh.GetOrgId()][gh.GetTeamId()] = gh.GetRequireUserEnableSso()
 		}
 	}
 	return orgTeamSSORequired
@@ -15,10 +15,12 @@
 package common
 
 import (
+	"context"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/abcxyz/pkg/testutil"
 	api "github.com/abcxyz/team-link/apis/v1alpha3/proto"
 )
 
@@ -182,3 +184,69 @@ func TestComputeOrgTeamSSORequired(t *testing.T) {
 		})
 	}
 }
+
+func TestNewGitHubReadWriter_Apps(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		config  *api.GitHubConfig
+		wantErr string
+	}{
+		{
+			name: "single_app",
+			config: &api.GitHubConfig{
+				Authentication: &api.GitHubConfig_GhAppAuth{
+					GhAppAuth: &api.GitHubApp{AppId: "1", PrivateKeyFromEnvironment: "APP_KEY"},
+				},
+			},
+		},
+		{
+			name: "apps_by_org",
+			config: &api.GitHubConfig{
+				Authentication: &api.GitHubConfig_GhAppsByOrgAuth{
+					GhAppsByOrgAuth: &api.GitHubAppsByOrg{
+						OrgApps: map[int64]*api.GitHubApp{
+							1: {AppId: "1", PrivateKeyFromEnvironment: "APP_KEY_1", InstallationId: "11"},
+							2: {AppId: "2", PrivateKeyFromEnvironment: "APP_KEY_2"},
+						},
+						DefaultApp: &api.GitHubApp{AppId: "3", PrivateKeyFromEnvironment: "APP_KEY_3"},
+					},
+				},
+			},
+		},
+		{
+			name: "missing_app_id",
+			config: &api.GitHubConfig{
+				Authentication: &api.GitHubConfig_GhAppsByOrgAuth{
+					GhAppsByOrgAuth: &api.GitHubAppsByOrg{
+						OrgApps: map[int64]*api.GitHubApp{1: {PrivateKeyFromEnvironment: "APP_KEY_1"}},
+					},
+				},
+			},
+			wantErr: "failed to configure github app of org 1: github app id is not provided",
+		},
+		{
+			name: "missing_private_key",
+			config: &api.GitHubConfig{
+				Authentication: &api.GitHubConfig_GhAppsByOrgAuth{
+					GhAppsByOrgAuth: &api.GitHubAppsByOrg{
+						DefaultApp: &api.GitHubApp{AppId: "3", KeyLocation: "keystore://key"},
+					},
+				},
+			},
+			wantErr: "failed to configure default github app: github app 3 has no private_key_from_environment or encrypted_private_key",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewGitHubReadWriter(context.Background(), tc.config, &api.TeamLinkMappings{})
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package confluence provides a GroupReadWriter for Confluence Cloud groups,
+// which can also grant the space permissions of the groups.
+package confluence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of group members requested per page.
+	pageSize = 200
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Group is a Confluence group.
+type Group struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// User is a Confluence user, identified by their Atlassian account ID.
+type User struct {
+	AccountID   string `json:"accountId"`
+	Email       string `json:"email,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// SpacePermission lists the operations a group is granted in a space.
+type SpacePermission struct {
+	// SpaceKey is the key of the space, e.g. "ENG".
+	SpaceKey string
+	// Operations are of the form OPERATION:TARGET, e.g. "read:space" or
+	// "create:page".
+	Operations []string
+}
+
+// permission is a permission of a space.
+type permission struct {
+	ID       int64 `json:"id"`
+	Subjects struct {
+		Group struct {
+			Results []*Group `json:"results"`
+		} `json:"group"`
+	} `json:"subjects"`
+	Operation struct {
+		Operation  string `json:"operation"`
+		TargetType string `json:"targetType"`
+	} `json:"operation"`
+}
+
+// key returns the operation of the permission in the form OPERATION:TARGET.
+func (p *permission) key() string {
+	return p.Operation.Operation + ":" + p.Operation.TargetType
+}
+
+type Config struct {
+	cacheDuration    time.Duration
+	httpClient       *http.Client
+	spacePermissions map[string][]*SpacePermission
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Confluence.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// WithSpacePermissions makes SetMembers grant the given space permissions,
+// by group ID, to the groups it writes. Operations of a group in a listed
+// space which are not listed are revoked. Permissions in spaces which are not
+// listed are left alone.
+func WithSpacePermissions(permissions map[string][]*SpacePermission) Opt {
+	return func(config *Config) {
+		config.spacePermissions = permissions
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Confluence Cloud groups, and optionally their
+// space permissions. Group IDs are group IDs and user IDs are Atlassian
+// account IDs.
+type GroupReadWriter struct {
+	client           *rest.Client
+	userCache        *cache.Cache[*User]
+	spacePermissions map[string][]*SpacePermission
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Confluence Cloud site
+// at the given URL, e.g. https://example.atlassian.net. It authenticates with
+// the email and API token of a site administrator, who must also be a space
+// administrator of the spaces whose permissions are managed.
+func NewGroupReadWriter(endpoint, email, apiToken string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &GroupReadWriter{
+		client: rest.New(endpoint+"/wiki/rest/api",
+			rest.WithHTTPClient(config.httpClient),
+			rest.WithBasicAuth(email, apiToken),
+		),
+		userCache:        cache.New[*User](config.cacheDuration),
+		spacePermissions: config.spacePermissions,
+	}
+}
+
+// GetGroup retrieves the Confluence group with the given ID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	var group Group
+	if err := rw.client.Do(ctx, http.MethodGet, "/group/by-id?id="+url.QueryEscape(groupID), nil, &group); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: group.ID, Attributes: &group}, nil
+}
+
+// GetMembers retrieves the users of the Confluence group with the given ID.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	var members []groupsync.Member
+	for start := 0; ; start += pageSize {
+		q := url.Values{
+			"start": {strconv.Itoa(start)},
+			"limit": {strconv.Itoa(pageSize)},
+		}
+		var resp struct {
+			Results []*User `json:"results"`
+		}
+		if err := rw.client.Do(ctx, http.MethodGet, "/group/"+url.PathEscape(groupID)+"/membersByGroupId?"+q.Encode(), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get members of group %s: %w", groupID, notFound(err))
+		}
+		for _, u := range resp.Results {
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: u.AccountID, Attributes: u}})
+		}
+		if len(resp.Results) < pageSize {
+			return members, nil
+		}
+	}
+}
+
+// Descendants retrieve all users of the Confluence group with the given ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Confluence user with the given account ID.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.userCache.WriteThruLookup(userID, func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userID)
+		var user User
+		if err := rw.client.Do(ctx, http.MethodGet, "/user?accountId="+url.QueryEscape(userID), nil, &user); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", userID, err)
+		}
+		return &user, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.AccountID, Attributes: user}, nil
+}
+
+// SetMembers replaces the users of the Confluence group with the given ID with
+// the given members. If space permissions are configured for the group, they
+// are granted and revoked as well, even if some members cannot be changed.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	currentMembers, err := rw.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	path := "/group/userByGroupId?groupId=" + url.QueryEscape(groupID)
+	var merr error
+	for _, accountID := range utils.MapKeys(addMembers) {
+		if err := rw.client.Do(ctx, http.MethodPost, path, map[string]string{"accountId": accountID}, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add %s to group %s: %w", accountID, groupID, err))
+		}
+	}
+	for _, accountID := range utils.MapKeys(removeMembers) {
+		if err := rw.client.Do(ctx, http.MethodDelete, path+"&accountId="+url.QueryEscape(accountID), nil, nil); err != nil && !rest.IsNotFound(err) {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove %s from group %s: %w", accountID, groupID, err))
+		}
+	}
+	for _, p := range rw.spacePermissions[groupID] {
+		if err := rw.setSpacePermissions(ctx, groupID, p); err != nil {
+			merr = errors.Join(merr, err)
+		}
+	}
+	return merr
+}
+
+// setSpacePermissions grants the operations of the given space permission to
+// the group with the given ID, and revokes its other operations in the space.
+func (rw *GroupReadWriter) setSpacePermissions(ctx context.Context, groupID string, want *SpacePermission) error {
+	base := "/space/" + url.PathEscape(want.SpaceKey)
+	var space struct {
+		Permissions []*permission `json:"permissions"`
+	}
+	if err := rw.client.Do(ctx, http.MethodGet, base+"?expand=permissions", nil, &space); err != nil {
+		return fmt.Errorf("failed to get permissions of space %s: %w", want.SpaceKey, err)
+	}
+	current := make(map[string]*permission)
+	for _, p := range space.Permissions {
+		if slices.ContainsFunc(p.Subjects.Group.Results, func(g *Group) bool { return g.ID == groupID }) {
+			current[p.key()] = p
+		}
+	}
+	var grant, revoke []string
+	for _, op := range want.Operations {
+		if _, ok := current[op]; !ok && !slices.Contains(grant, op) {
+			grant = append(grant, op)
+		}
+	}
+	for _, op := range utils.MapKeys(current) {
+		if !slices.Contains(want.Operations, op) {
+			revoke = append(revoke, op)
+		}
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "space permissions to change",
+		"group_id", groupID,
+		"space_key", want.SpaceKey,
+		"grant_operations", grant,
+		"revoke_operations", revoke,
+	)
+
+	var merr error
+	for _, op := range grant {
+		operation, target, _ := strings.Cut(op, ":")
+		body := map[string]any{
+			"subject":   map[string]string{"type": "group", "identifier": groupID},
+			"operation": map[string]string{"key": operation, "target": target},
+		}
+		if err := rw.client.Do(ctx, http.MethodPost, base+"/permission", body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to grant %s in space %s to group %s: %w", op, want.SpaceKey, groupID, err))
+		}
+	}
+	for _, op := range revoke {
+		path := base + "/permission/" + strconv.FormatInt(current[op].ID, 10)
+		if err := rw.client.Do(ctx, http.MethodDelete, path, nil, nil); err != nil && !rest.IsNotFound(err) {
+			merr = errors.Join(merr, fmt.Errorf("failed to revoke %s in space %s from group %s: %w", op, want.SpaceKey, groupID, err))
+		}
+	}
+	return merr
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[m.ID()] = m
+	}
+	return memberIDs
+}
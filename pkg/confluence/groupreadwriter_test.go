@@ -0,0 +1,188 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confluence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeConfluence{
+		groups: map[string][]string{"g1": {"a1", "a2"}},
+		permissions: []*fakePermission{
+			{id: 1, group: "g1", operation: "read:space"},
+			{id: 2, group: "g1", operation: "delete:page"},
+			{id: 3, group: "other", operation: "administer:space"},
+		},
+		nextID: 4,
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(srv.URL, "admin@example.com", "token", WithSpacePermissions(map[string][]*SpacePermission{
+		"g1": {{SpaceKey: "ENG", Operations: []string{"read:space", "create:page"}}},
+	}))
+
+	users, err := rw.Descendants(ctx, "g1")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"a1", "a2"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	if err := rw.SetMembers(ctx, "g1", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "a2"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "a3"}},
+	}); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(fake.groups["g1"], []string{"a2", "a3"}); diff != "" {
+		t.Errorf("unexpected members (-got, +want):\n%s", diff)
+	}
+	var got []string
+	for _, p := range fake.permissions {
+		got = append(got, p.group+":"+p.operation)
+	}
+	want := []string{"g1:read:space", "other:administer:space", "g1:create:page"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected space permissions (-got, +want):\n%s", diff)
+	}
+
+	if _, err := rw.GetGroup(ctx, "missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+type fakePermission struct {
+	id        int64
+	group     string
+	operation string
+}
+
+// fakeConfluence implements the parts of the Confluence REST API used by
+// GroupReadWriter, with the single space ENG.
+type fakeConfluence struct {
+	mu          sync.Mutex
+	groups      map[string][]string
+	permissions []*fakePermission
+	nextID      int64
+}
+
+func (f *fakeConfluence) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if user, pass, _ := r.BasicAuth(); user != "admin@example.com" || pass != "token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/wiki/rest/api")
+	q := r.URL.Query()
+
+	switch {
+	case path == "/group/by-id":
+		if _, ok := f.groups[q.Get("id")]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		write(&Group{ID: q.Get("id"), Name: q.Get("id")})
+	case strings.HasSuffix(path, "/membersByGroupId"):
+		group := strings.TrimSuffix(strings.TrimPrefix(path, "/group/"), "/membersByGroupId")
+		members, ok := f.groups[group]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var users []*User
+		for _, id := range members {
+			users = append(users, &User{AccountID: id})
+		}
+		write(map[string]any{"results": users})
+	case path == "/group/userByGroupId":
+		group := q.Get("groupId")
+		if r.Method == http.MethodPost {
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f.groups[group] = append(f.groups[group], body["accountId"])
+			slices.Sort(f.groups[group])
+			return
+		}
+		f.groups[group] = slices.DeleteFunc(f.groups[group], func(id string) bool { return id == q.Get("accountId") })
+		w.WriteHeader(http.StatusNoContent)
+	case path == "/space/ENG":
+		var permissions []map[string]any
+		for _, p := range f.permissions {
+			op, target, _ := strings.Cut(p.operation, ":")
+			permissions = append(permissions, map[string]any{
+				"id":        p.id,
+				"subjects":  map[string]any{"group": map[string]any{"results": []*Group{{ID: p.group}}}},
+				"operation": map[string]string{"operation": op, "targetType": target},
+			})
+		}
+		write(map[string]any{"permissions": permissions})
+	case path == "/space/ENG/permission":
+		var body struct {
+			Subject   map[string]string `json:"subject"`
+			Operation map[string]string `json:"operation"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Subject["type"] != "group" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.permissions = append(f.permissions, &fakePermission{
+			id:        f.nextID,
+			group:     body.Subject["identifier"],
+			operation: body.Operation["key"] + ":" + body.Operation["target"],
+		})
+		f.nextID++
+	case strings.HasPrefix(path, "/space/ENG/permission/") && r.Method == http.MethodDelete:
+		id, _ := strconv.ParseInt(strings.TrimPrefix(path, "/space/ENG/permission/"), 10, 64)
+		f.permissions = slices.DeleteFunc(f.permissions, func(p *fakePermission) bool { return p.id == id })
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -16,9 +16,47 @@ package credentials
 
 import (
 	"context"
+	"fmt"
+	"os"
 )
 
 // KeyProvider provides a private key.
 type KeyProvider interface {
 	Key(ctx context.Context) ([]byte, error)
 }
+
+// EnvKeyProvider provides a key read from an environment variable.
+type EnvKeyProvider struct {
+	envVar string
+}
+
+// NewEnvKeyProvider creates a KeyProvider which reads the key from the given
+// environment variable each time it is requested.
+func NewEnvKeyProvider(envVar string) *EnvKeyProvider {
+	return &EnvKeyProvider{envVar: envVar}
+}
+
+// Key returns the value of the environment variable.
+func (p *EnvKeyProvider) Key(ctx context.Context) ([]byte, error) {
+	v := os.Getenv(p.envVar)
+	if v == "" {
+		return nil, fmt.Errorf("environment variable %s is not set", p.envVar)
+	}
+	return []byte(v), nil
+}
+
+// StaticKeyProvider provides a key known in advance, e.g. decrypted from the
+// config.
+type StaticKeyProvider struct {
+	key []byte
+}
+
+// NewStaticKeyProvider creates a KeyProvider which always returns the given key.
+func NewStaticKeyProvider(key []byte) *StaticKeyProvider {
+	return &StaticKeyProvider{key: key}
+}
+
+// Key returns the key.
+func (p *StaticKeyProvider) Key(ctx context.Context) ([]byte, error) {
+	return p.key, nil
+}
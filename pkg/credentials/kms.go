@@ -0,0 +1,99 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/option"
+)
+
+// KMSScheme prefixes values encrypted with Cloud KMS, which have the form
+// kms://KEY:CIPHERTEXT. KEY is the name of the crypto key and CIPHERTEXT is
+// the base64 encoded ciphertext, as printed by:
+//
+//	gcloud kms encrypt --key KEY --plaintext-file - --ciphertext-file - | base64 -w0
+const KMSScheme = "kms://"
+
+var kmsKeyName = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+/keyRings/[^/]+/cryptoKeys/[^/]+$`)
+
+// KMSDecrypter decrypts values encrypted with Cloud KMS.
+type KMSDecrypter struct {
+	clientOptions []option.ClientOption
+
+	once   sync.Once
+	keys   *cloudkms.ProjectsLocationsKeyRingsCryptoKeysService
+	svcErr error
+}
+
+// NewKMSDecrypter creates a KMSDecrypter using the given options of the Cloud
+// KMS client. The client is only created when a value is decrypted, using
+// application default credentials unless configured otherwise.
+func NewKMSDecrypter(opts ...option.ClientOption) *KMSDecrypter {
+	return &KMSDecrypter{clientOptions: opts}
+}
+
+// Decrypt decrypts a value of the form kms://KEY:CIPHERTEXT.
+func (d *KMSDecrypter) Decrypt(ctx context.Context, value string) ([]byte, error) {
+	key, ciphertext, err := parseKMSValue(value)
+	if err != nil {
+		return nil, err
+	}
+	d.once.Do(func() {
+		svc, err := cloudkms.NewService(ctx, d.clientOptions...)
+		if err != nil {
+			d.svcErr = fmt.Errorf("failed to create kms client: %w", err)
+			return
+		}
+		d.keys = svc.Projects.Locations.KeyRings.CryptoKeys
+	})
+	if d.svcErr != nil {
+		return nil, d.svcErr
+	}
+
+	resp, err := d.keys.Decrypt(key, &cloudkms.DecryptRequest{Ciphertext: ciphertext}).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt value with kms key %s: %w", key, err)
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(resp.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode decrypted value: %w", err)
+	}
+	return plaintext, nil
+}
+
+// parseKMSValue splits a value of the form kms://KEY:CIPHERTEXT into the key
+// name and the base64 encoded ciphertext.
+func parseKMSValue(value string) (string, string, error) {
+	rest, ok := strings.CutPrefix(value, KMSScheme)
+	if !ok {
+		return "", "", fmt.Errorf("encrypted value must start with %s", KMSScheme)
+	}
+	key, ciphertext, ok := strings.Cut(rest, ":")
+	if !ok || !kmsKeyName.MatchString(key) {
+		return "", "", fmt.Errorf("encrypted value must be of the form %sprojects/p/locations/l/keyRings/r/cryptoKeys/k:CIPHERTEXT", KMSScheme)
+	}
+	ciphertext = strings.TrimSpace(ciphertext)
+	if _, err := base64.StdEncoding.DecodeString(ciphertext); err != nil || ciphertext == "" {
+		return "", "", fmt.Errorf("ciphertext of encrypted value is not base64 encoded")
+	}
+	return key, ciphertext, nil
+}
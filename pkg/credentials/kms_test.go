@@ -0,0 +1,125 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/option"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+const testKMSKey = "projects/p/locations/global/keyRings/team-link/cryptoKeys/config"
+
+func TestKMSDecrypter_Decrypt(t *testing.T) {
+	t.Parallel()
+
+	// the fake "encrypts" by reversing the plaintext.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/v1/"), ":decrypt")
+		if !ok || name != testKMSKey {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 404, "message": "key not found"}}`)
+			return
+		}
+		var req cloudkms.DecryptRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		ciphertext, _ := base64.StdEncoding.DecodeString(req.Ciphertext)
+		json.NewEncoder(w).Encode(&cloudkms.DecryptResponse{ //nolint:errcheck // test server
+			Plaintext: base64.StdEncoding.EncodeToString([]byte(reverse(string(ciphertext)))),
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	ciphertext := base64.StdEncoding.EncodeToString([]byte(reverse("ghp_token")))
+
+	cases := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "success",
+			value: KMSScheme + testKMSKey + ":" + ciphertext,
+			want:  "ghp_token",
+		},
+		{
+			name:  "trailing_newline",
+			value: KMSScheme + testKMSKey + ":" + ciphertext + "\n",
+			want:  "ghp_token",
+		},
+		{
+			name:    "not_encrypted",
+			value:   "ghp_token",
+			wantErr: "encrypted value must start with kms://",
+		},
+		{
+			name:    "missing_ciphertext",
+			value:   KMSScheme + testKMSKey,
+			wantErr: "must be of the form",
+		},
+		{
+			name:    "invalid_key",
+			value:   KMSScheme + "projects/p/keyRings/team-link:" + ciphertext,
+			wantErr: "must be of the form",
+		},
+		{
+			name:    "invalid_ciphertext",
+			value:   KMSScheme + testKMSKey + ":not base64!",
+			wantErr: "not base64 encoded",
+		},
+		{
+			name:    "unknown_key",
+			value:   KMSScheme + "projects/p/locations/global/keyRings/team-link/cryptoKeys/other:" + ciphertext,
+			wantErr: "failed to decrypt value with kms key",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := NewKMSDecrypter(option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+			got, err := d.Decrypt(context.Background(), tc.value)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if string(got) != tc.want {
+				t.Errorf("Decrypt got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func reverse(s string) string {
+	b := []byte(s)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
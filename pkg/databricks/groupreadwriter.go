@@ -0,0 +1,388 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package databricks provides a GroupReadWriter for Databricks account groups
+// through the SCIM API, so that workspace access and entitlements follow team
+// membership.
+package databricks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultEndpoint is the URL of the account console of Databricks on
+	// AWS. Accounts on Azure and GCP use e.g.
+	// https://accounts.azuredatabricks.net.
+	DefaultEndpoint = "https://accounts.cloud.databricks.com"
+
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// patchOpSchema is the schema of SCIM PATCH requests.
+	patchOpSchema = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Group is a Databricks account group.
+type Group struct {
+	ID           string   `json:"id"`
+	DisplayName  string   `json:"displayName"`
+	Members      []*Value `json:"members,omitempty"`
+	Entitlements []*Value `json:"entitlements,omitempty"`
+}
+
+// EntitlementValues returns the entitlements of the group, e.g.
+// "workspace-access" or "databricks-sql-access".
+func (g *Group) EntitlementValues() []string {
+	values := make([]string, 0, len(g.Entitlements))
+	for _, e := range g.Entitlements {
+		values = append(values, e.Value)
+	}
+	return values
+}
+
+// Value is a multi-valued SCIM attribute, e.g. a member of a group. The ref
+// of a member tells whether it is a user, a group or a service principal.
+type Value struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// User is a Databricks account user. The user name is the email.
+type User struct {
+	ID          string `json:"id"`
+	UserName    string `json:"userName"`
+	DisplayName string `json:"displayName,omitempty"`
+	Active      bool   `json:"active,omitempty"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+	entitlements  map[string][]string
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Databricks. It is wrapped
+// to add the OAuth access token.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// WithEntitlements makes SetMembers replace the entitlements of the groups it
+// writes with the given entitlements, by group ID. The entitlements of
+// groups which are not listed are left alone.
+func WithEntitlements(entitlements map[string][]string) Opt {
+	return func(config *Config) {
+		config.entitlements = entitlements
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Databricks account groups: their users and
+// nested groups. Group IDs are SCIM group IDs and user IDs are user names,
+// i.e. emails. Service principals in groups are left alone.
+type GroupReadWriter struct {
+	client       *rest.Client
+	usersByID    *cache.Cache[*User]
+	usersByName  *cache.Cache[*User]
+	entitlements map[string][]string
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Databricks account with
+// the given ID at the given account console URL, usually DefaultEndpoint. It
+// authenticates with the OAuth client ID and secret of a service principal
+// with the account admin role.
+func NewGroupReadWriter(ctx context.Context, endpoint, accountID, clientID, clientSecret string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	credentials := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     endpoint + "/oidc/accounts/" + accountID + "/v1/token",
+		Scopes:       []string{"all-apis"},
+		AuthStyle:    oauth2.AuthStyleInHeader,
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, config.httpClient)
+	return &GroupReadWriter{
+		client:       rest.New(endpoint+"/api/2.0/accounts/"+accountID+"/scim/v2", rest.WithHTTPClient(credentials.Client(ctx))),
+		usersByID:    cache.New[*User](config.cacheDuration),
+		usersByName:  cache.New[*User](config.cacheDuration),
+		entitlements: config.entitlements,
+	}
+}
+
+// GetGroup retrieves the Databricks group with the given ID, including its
+// entitlements.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	group, err := rw.group(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupsync.Group{ID: group.ID, Attributes: group}, nil
+}
+
+// GetMembers retrieves the users and nested groups of the Databricks group
+// with the given ID.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	group, err := rw.group(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return rw.members(ctx, group)
+}
+
+// Descendants retrieve all users (children, recursively) of the Databricks
+// group with the given ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Databricks user with the given user name.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.userByName(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.UserName, Attributes: user}, nil
+}
+
+// SetMembers replaces the users and nested groups of the Databricks group with
+// the given ID with the given members, in a single SCIM PATCH request. If
+// entitlements are configured for the group, they are replaced in the same
+// request. Users which are not in the account are reported in the returned
+// error after all other changes are made.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	group, err := rw.group(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	currentMembers, err := rw.members(ctx, group)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	var merr error
+	var ops []map[string]any
+	var add []*Value
+	for _, id := range utils.MapKeys(addMembers) {
+		if addMembers[id].IsGroup() {
+			add = append(add, &Value{Value: id})
+			continue
+		}
+		user, err := rw.userByName(ctx, id)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to group %s: %w", id, groupID, err))
+			continue
+		}
+		add = append(add, &Value{Value: user.ID})
+	}
+	if len(add) > 0 {
+		ops = append(ops, map[string]any{"op": "add", "path": "members", "value": add})
+	}
+	for _, id := range utils.MapKeys(removeMembers) {
+		ops = append(ops, map[string]any{"op": "remove", "path": fmt.Sprintf("members[value eq %q]", scimID(removeMembers[id]))})
+	}
+	if want, ok := rw.entitlements[groupID]; ok && !equalSets(want, group.EntitlementValues()) {
+		logger.InfoContext(ctx, "entitlements to set",
+			"group_id", groupID,
+			"entitlements", want,
+		)
+		entitlements := make([]*Value, 0, len(want))
+		for _, e := range want {
+			entitlements = append(entitlements, &Value{Value: e})
+		}
+		ops = append(ops, map[string]any{"op": "replace", "path": "entitlements", "value": entitlements})
+	}
+	if len(ops) == 0 {
+		return merr
+	}
+
+	body := map[string]any{"schemas": []string{patchOpSchema}, "Operations": ops}
+	if err := rw.client.Do(ctx, http.MethodPatch, "/Groups/"+url.PathEscape(groupID), body, nil); err != nil {
+		merr = errors.Join(merr, fmt.Errorf("failed to update group %s: %w", groupID, err))
+	}
+	return merr
+}
+
+// group returns the Databricks group with the given ID.
+func (rw *GroupReadWriter) group(ctx context.Context, groupID string) (*Group, error) {
+	var group Group
+	if err := rw.client.Do(ctx, http.MethodGet, "/Groups/"+url.PathEscape(groupID), nil, &group); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", groupID, notFound(err))
+	}
+	return &group, nil
+}
+
+// members returns the users and nested groups of the given group.
+func (rw *GroupReadWriter) members(ctx context.Context, group *Group) ([]groupsync.Member, error) {
+	members := make([]groupsync.Member, 0, len(group.Members))
+	for _, m := range group.Members {
+		switch {
+		case strings.HasPrefix(m.Ref, "Groups/"):
+			members = append(members, &groupsync.GroupMember{Grp: &groupsync.Group{ID: m.Value, Attributes: m}})
+		case strings.HasPrefix(m.Ref, "Users/"):
+			user, err := rw.userByID(ctx, m.Value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get member %s of group %s: %w", m.Value, group.ID, err)
+			}
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: user.UserName, Attributes: user}})
+		}
+	}
+	return members, nil
+}
+
+// userByID returns the Databricks user with the given SCIM ID.
+func (rw *GroupReadWriter) userByID(ctx context.Context, id string) (*User, error) {
+	user, err := rw.usersByID.WriteThruLookup(id, func() (*User, error) {
+		var user User
+		if err := rw.client.Do(ctx, http.MethodGet, "/Users/"+url.PathEscape(id), nil, &user); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", id, err)
+		}
+		return &user, nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// userByName returns the Databricks user with the given user name.
+func (rw *GroupReadWriter) userByName(ctx context.Context, userName string) (*User, error) {
+	user, err := rw.usersByName.WriteThruLookup(strings.ToLower(userName), func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userName)
+		q := url.Values{"filter": {fmt.Sprintf("userName eq %q", userName)}}
+		var resp struct {
+			Resources []*User `json:"Resources"`
+		}
+		if err := rw.client.Do(ctx, http.MethodGet, "/Users?"+q.Encode(), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", userName, err)
+		}
+		if len(resp.Resources) == 0 {
+			return nil, fmt.Errorf("user %s is not in the databricks account", userName)
+		}
+		return resp.Resources[0], nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// scimID returns the SCIM ID of a current member, the ID of its user or the
+// ID of its group.
+func scimID(m groupsync.Member) string {
+	if user, ok := databricksUser(m); ok {
+		return user.ID
+	}
+	return m.ID()
+}
+
+// databricksUser returns the Databricks user of a current user member.
+func databricksUser(m groupsync.Member) (*User, bool) {
+	u, ok := m.(*groupsync.UserMember)
+	if !ok {
+		return nil, false
+	}
+	user, ok := u.Usr.Attributes.(*User)
+	return user, ok
+}
+
+// equalSets reports whether a and b have the same elements.
+func equalSets(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+// toIDMap returns the given members by ID, lowercased for users as user
+// names are emails.
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		if m.IsGroup() {
+			memberIDs[m.ID()] = m
+			continue
+		}
+		memberIDs[strings.ToLower(m.ID())] = m
+	}
+	return memberIDs
+}
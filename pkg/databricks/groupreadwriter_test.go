@@ -0,0 +1,223 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package databricks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeDatabricks{
+		users: []*User{
+			{ID: "1", UserName: "alice@example.com"},
+			{ID: "2", UserName: "bob@example.com"},
+			{ID: "3", UserName: "carol@example.com"},
+		},
+		groups: map[string]*Group{
+			"g1": {ID: "g1", Members: []*Value{
+				{Value: "1", Ref: "Users/1"},
+				{Value: "2", Ref: "Users/2"},
+				{Value: "g2", Ref: "Groups/g2"},
+				{Value: "sp", Ref: "ServicePrincipals/sp"},
+			}},
+			"g2": {ID: "g2", Members: []*Value{{Value: "3", Ref: "Users/3"}}},
+			"g3": {ID: "g3"},
+		},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(ctx, srv.URL, "acct", "client", "secret", WithEntitlements(map[string][]string{
+		"g1": {"workspace-access", "databricks-sql-access"},
+	}))
+
+	users, err := rw.Descendants(ctx, "g1")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice@example.com", "bob@example.com", "carol@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "g1", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "Bob@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "dave@example.com"}},
+		&groupsync.GroupMember{Grp: &groupsync.Group{ID: "g3"}},
+	})
+	if diff := testutil.DiffErrString(err, "user dave@example.com is not in the databricks account"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+	want := &Group{
+		ID: "g1",
+		Members: []*Value{
+			{Value: "2", Ref: "Users/2"},
+			{Value: "sp", Ref: "ServicePrincipals/sp"},
+			{Value: "3", Ref: "Users/3"},
+			{Value: "g3", Ref: "Groups/g3"},
+		},
+		Entitlements: []*Value{{Value: "workspace-access"}, {Value: "databricks-sql-access"}},
+	}
+	if diff := cmp.Diff(fake.groups["g1"], want); diff != "" {
+		t.Errorf("unexpected group (-got, +want):\n%s", diff)
+	}
+
+	// nothing to change.
+	fake.patches = 0
+	if err := rw.SetMembers(ctx, "g2", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+	}); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	if fake.patches != 0 {
+		t.Errorf("SetMembers sent %d patches, want none", fake.patches)
+	}
+
+	if _, err := rw.GetGroup(ctx, "missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+var (
+	removeMemberPath = regexp.MustCompile(`^members\[value eq "([^"]+)"\]$`)
+	userNameFilter   = regexp.MustCompile(`^userName eq "([^"]+)"$`)
+)
+
+// fakeDatabricks implements the token endpoint and the parts of the account
+// SCIM API used by GroupReadWriter for the account "acct".
+type fakeDatabricks struct {
+	mu      sync.Mutex
+	patches int
+	users   []*User
+	groups  map[string]*Group
+}
+
+func (f *fakeDatabricks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/oidc/accounts/acct/v1/token" {
+		if id, secret, _ := r.BasicAuth(); id != "client" || secret != "secret" || r.FormValue("scope") != "all-apis" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`)
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	path, ok := strings.CutPrefix(r.URL.Path, "/api/2.0/accounts/acct/scim/v2")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case path == "/Users":
+		var found []*User
+		m := userNameFilter.FindStringSubmatch(r.URL.Query().Get("filter"))
+		for _, u := range f.users {
+			if m != nil && strings.EqualFold(u.UserName, m[1]) {
+				found = append(found, u)
+			}
+		}
+		write(map[string]any{"Resources": found, "totalResults": len(found)})
+	case strings.HasPrefix(path, "/Users/"):
+		i := slices.IndexFunc(f.users, func(u *User) bool { return u.ID == strings.TrimPrefix(path, "/Users/") })
+		if i < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		write(f.users[i])
+	case strings.HasPrefix(path, "/Groups/"):
+		g, ok := f.groups[strings.TrimPrefix(path, "/Groups/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodGet {
+			write(g)
+			return
+		}
+		f.patches++
+		var body struct {
+			Schemas    []string `json:"schemas"`
+			Operations []struct {
+				Op    string   `json:"op"`
+				Path  string   `json:"path"`
+				Value []*Value `json:"value"`
+			} `json:"Operations"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || !slices.Equal(body.Schemas, []string{patchOpSchema}) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for _, op := range body.Operations {
+			switch {
+			case op.Op == "add" && op.Path == "members":
+				for _, v := range op.Value {
+					ref := "Users/" + v.Value
+					if _, ok := f.groups[v.Value]; ok {
+						ref = "Groups/" + v.Value
+					}
+					g.Members = append(g.Members, &Value{Value: v.Value, Ref: ref})
+				}
+			case op.Op == "remove" && removeMemberPath.MatchString(op.Path):
+				id := removeMemberPath.FindStringSubmatch(op.Path)[1]
+				g.Members = slices.DeleteFunc(g.Members, func(v *Value) bool { return v.Value == id })
+			case op.Op == "replace" && op.Path == "entitlements":
+				g.Entitlements = op.Value
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dropbox provides a GroupReadWriter for the groups of a Dropbox
+// team, e.g. the groups team folders are shared with.
+package dropbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultEndpoint is the URL of the Dropbox API.
+	DefaultEndpoint = "https://api.dropboxapi.com"
+
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of members requested per page, the maximum of
+	// the API.
+	pageSize = 1000
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Tag is the tag of a union of the Dropbox API, see
+// https://www.dropbox.com/developers/documentation/http/documentation#formats.
+type Tag struct {
+	Tag string `json:".tag"`
+}
+
+// Group is a Dropbox team group, see
+// https://www.dropbox.com/developers/documentation/http/teams#team-groups-get_info.
+type Group struct {
+	Tag
+	GroupID             string `json:"group_id"`
+	GroupName           string `json:"group_name"`
+	GroupManagementType *Tag   `json:"group_management_type,omitempty"`
+	MemberCount         int    `json:"member_count,omitempty"`
+}
+
+// Member is the profile of a Dropbox team member. Team-link identifies
+// members by their email.
+type Member struct {
+	TeamMemberID string `json:"team_member_id"`
+	Email        string `json:"email"`
+	Status       *Tag   `json:"status,omitempty"`
+}
+
+// groupSelector selects a group by its ID.
+type groupSelector struct {
+	Tag     string `json:".tag"`
+	GroupID string `json:"group_id"`
+}
+
+// userSelector selects a team member by their email.
+type userSelector struct {
+	Tag   string `json:".tag"`
+	Email string `json:"email"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Dropbox. It is wrapped to
+// add the OAuth access token.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Dropbox team groups. Group IDs are the IDs of
+// the groups, e.g. g:1a2b3c, and user IDs are emails. Members are added with
+// the member access type, and existing members keep theirs, e.g. owner.
+type GroupReadWriter struct {
+	client    *rest.Client
+	userCache *cache.Cache[*Member]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Dropbox API at the
+// given URL, e.g. https://api.dropboxapi.com. It authenticates with the
+// refresh token of a team admin who authorized the scoped app with the
+// given app key and secret, with the team_data.member and groups.write
+// scopes.
+func NewGroupReadWriter(ctx context.Context, endpoint, appKey, appSecret, refreshToken string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	oauthConfig := &oauth2.Config{
+		ClientID:     appKey,
+		ClientSecret: appSecret,
+		Endpoint: oauth2.Endpoint{
+			TokenURL:  endpoint + "/oauth2/token",
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, config.httpClient)
+	tokenSource := oauthConfig.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken})
+	return &GroupReadWriter{
+		client:    rest.New(endpoint+"/2", rest.WithHTTPClient(oauth2.NewClient(ctx, tokenSource))),
+		userCache: cache.New[*Member](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Dropbox team group with the given ID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	body := map[string]any{".tag": "group_ids", "group_ids": []string{groupID}}
+	var groups []*Group
+	if err := rw.client.Do(ctx, http.MethodPost, "/team/groups/get_info", body, &groups); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", groupID, notFound(err))
+	}
+	if len(groups) != 1 || groups[0].Tag.Tag != "group_info" {
+		return nil, fmt.Errorf("failed to get group %s: %w", groupID, groupsync.ErrGroupNotFound)
+	}
+	return &groupsync.Group{ID: groups[0].GroupID, Attributes: groups[0]}, nil
+}
+
+// GetMembers retrieves the members of the Dropbox team group with the given
+// ID.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	var members []groupsync.Member
+	path := "/team/groups/members/list"
+	var body any = map[string]any{"group": &groupSelector{Tag: "group_id", GroupID: groupID}, "limit": pageSize}
+	for {
+		var resp struct {
+			Members []struct {
+				Profile *Member `json:"profile"`
+			} `json:"members"`
+			Cursor  string `json:"cursor"`
+			HasMore bool   `json:"has_more"`
+		}
+		if err := rw.client.Do(ctx, http.MethodPost, path, body, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get members of group %s: %w", groupID, notFound(err))
+		}
+		for _, m := range resp.Members {
+			rw.userCache.Set(strings.ToLower(m.Profile.Email), m.Profile)
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: m.Profile.Email, StableID: m.Profile.TeamMemberID, Attributes: m.Profile}})
+		}
+		if !resp.HasMore {
+			return members, nil
+		}
+		path, body = "/team/groups/members/list/continue", map[string]string{"cursor": resp.Cursor}
+	}
+}
+
+// Descendants retrieve all users of the Dropbox team group with the given ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Dropbox team member with the given email.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	member, err := rw.userCache.WriteThruLookup(strings.ToLower(userID), func() (*Member, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userID)
+		body := map[string]any{"members": []*userSelector{{Tag: "email", Email: userID}}}
+		var resp struct {
+			MembersInfo []struct {
+				Tag
+				Profile *Member `json:"profile"`
+			} `json:"members_info"`
+		}
+		if err := rw.client.Do(ctx, http.MethodPost, "/team/members/get_info_v2", body, &resp); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", userID, err)
+		}
+		if len(resp.MembersInfo) != 1 || resp.MembersInfo[0].Tag.Tag != "member_info" {
+			return nil, fmt.Errorf("no team member exists with email %s", userID)
+		}
+		return resp.MembersInfo[0].Profile, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: member.Email, StableID: member.TeamMemberID, Attributes: member}, nil
+}
+
+// SetMembers replaces the members of the Dropbox team group with the given ID
+// with the given members. Dropbox rejects all additions if any of the added
+// users is not a member of the team.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	currentMembers, err := rw.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	group := &groupSelector{Tag: "group_id", GroupID: groupID}
+	var merr error
+	if len(addMembers) > 0 {
+		add := make([]map[string]any, 0, len(addMembers))
+		for _, email := range utils.MapKeys(addMembers) {
+			add = append(add, map[string]any{
+				"user":        &userSelector{Tag: "email", Email: email},
+				"access_type": "member",
+			})
+		}
+		body := map[string]any{"group": group, "members": add, "return_members": false}
+		if err := rw.client.Do(ctx, http.MethodPost, "/team/groups/members/add", body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add members %v to group %s: %w", utils.MapKeys(addMembers), groupID, err))
+		}
+	}
+	if len(removeMembers) > 0 {
+		remove := make([]*userSelector, 0, len(removeMembers))
+		for _, email := range utils.MapKeys(removeMembers) {
+			remove = append(remove, &userSelector{Tag: "email", Email: email})
+		}
+		body := map[string]any{"group": group, "users": remove, "return_members": false}
+		if err := rw.client.Do(ctx, http.MethodPost, "/team/groups/members/remove", body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove members %v from group %s: %w", utils.MapKeys(removeMembers), groupID, err))
+		}
+	}
+	return merr
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+// Dropbox reports them as a conflict with the group_not_found error.
+func notFound(err error) error {
+	var rerr *rest.Error
+	if errors.As(err, &rerr) && rerr.StatusCode == http.StatusConflict && strings.Contains(rerr.Body, "group_not_found") {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+// toIDMap returns the given members by lowercased ID, as emails are
+// case-insensitive.
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[strings.ToLower(m.ID())] = m
+	}
+	return memberIDs
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dropbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeDropbox{
+		team:    []string{"alice@example.com", "bob@example.com", "carol@example.com"},
+		members: map[string][]string{"g:1": {"alice@example.com", "bob@example.com"}},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(ctx, srv.URL, "key", "secret", "refresh")
+
+	users, err := rw.Descendants(ctx, "g:1")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice@example.com", "bob@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	if err := rw.SetMembers(ctx, "g:1", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "Bob@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+	}); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(fake.members["g:1"], []string{"bob@example.com", "carol@example.com"}); diff != "" {
+		t.Errorf("unexpected members (-got, +want):\n%s", diff)
+	}
+
+	user, err := rw.GetUser(ctx, "Carol@example.com")
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if got, want := user.StableID, "dbmid:2"; got != want {
+		t.Errorf("GetUser got team member ID %q, want %q", got, want)
+	}
+	if _, err := rw.GetUser(ctx, "dave@example.com"); err == nil {
+		t.Errorf("GetUser(dave) got nil err, want error")
+	}
+
+	for _, f := range []func(context.Context, string) error{
+		func(ctx context.Context, id string) error { _, err := rw.GetGroup(ctx, id); return err },
+		func(ctx context.Context, id string) error { _, err := rw.GetMembers(ctx, id); return err },
+	} {
+		if err := f(ctx, "g:missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+			t.Errorf("got err %v, want %v", err, groupsync.ErrGroupNotFound)
+		}
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeDropbox implements the token endpoint and the parts of the Dropbox
+// team API used by GroupReadWriter. Group members are listed one per page.
+type fakeDropbox struct {
+	mu      sync.Mutex
+	team    []string
+	members map[string][]string
+}
+
+func (f *fakeDropbox) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/oauth2/token" {
+		if r.FormValue("grant_type") != "refresh_token" || r.FormValue("refresh_token") != "refresh" || r.FormValue("client_secret") != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"access_token": "token", "token_type": "bearer", "expires_in": 14400}) //nolint:errcheck // test server
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	var body struct {
+		GroupIDs []string `json:"group_ids"`
+		Group    struct {
+			GroupID string `json:"group_id"`
+		} `json:"group"`
+		Cursor  string `json:"cursor"`
+		Members []struct {
+			Email string `json:"email"`
+			User  struct {
+				Email string `json:"email"`
+			} `json:"user"`
+		} `json:"members"`
+		Users []struct {
+			Email string `json:"email"`
+		} `json:"users"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	groupID := body.Group.GroupID
+	if r.URL.Path == "/2/team/groups/members/list/continue" {
+		groupID, body.Cursor, _ = strings.Cut(body.Cursor, "#")
+	}
+	if _, ok := f.members[groupID]; !ok && groupID != "" {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"error_summary": "group_not_found/..", "error": {".tag": "group_not_found"}}`)
+		return
+	}
+	profile := func(email string) map[string]any {
+		return map[string]any{"team_member_id": "dbmid:" + strconv.Itoa(slices.Index(f.team, email)), "email": email}
+	}
+
+	switch r.URL.Path {
+	case "/2/team/groups/get_info":
+		var groups []map[string]any
+		for _, id := range body.GroupIDs {
+			if _, ok := f.members[id]; !ok {
+				groups = append(groups, map[string]any{".tag": "id_not_found", "id_not_found": id})
+				continue
+			}
+			groups = append(groups, map[string]any{".tag": "group_info", "group_id": id, "group_name": "engineering"})
+		}
+		write(groups)
+	case "/2/team/groups/members/list", "/2/team/groups/members/list/continue":
+		i, _ := strconv.Atoi(body.Cursor)
+		resp := map[string]any{"members": []any{}, "has_more": false}
+		if i < len(f.members[groupID]) {
+			resp["members"] = []any{map[string]any{"profile": profile(f.members[groupID][i]), "access_type": map[string]string{".tag": "member"}}}
+			resp["has_more"] = i+1 < len(f.members[groupID])
+			resp["cursor"] = groupID + "#" + strconv.Itoa(i+1)
+		}
+		write(resp)
+	case "/2/team/groups/members/add":
+		for _, m := range body.Members {
+			f.members[groupID] = append(f.members[groupID], strings.ToLower(m.User.Email))
+		}
+		slices.Sort(f.members[groupID])
+		write(map[string]any{"group_info": map[string]string{"group_id": groupID}})
+	case "/2/team/groups/members/remove":
+		for _, u := range body.Users {
+			f.members[groupID] = slices.DeleteFunc(f.members[groupID], func(s string) bool { return strings.EqualFold(s, u.Email) })
+		}
+		write(map[string]any{"group_info": map[string]string{"group_id": groupID}})
+	case "/2/team/members/get_info_v2":
+		var info []map[string]any
+		for _, m := range body.Members {
+			i := slices.IndexFunc(f.team, func(s string) bool { return strings.EqualFold(s, m.Email) })
+			if i < 0 {
+				info = append(info, map[string]any{".tag": "id_not_found", "id_not_found": m.Email})
+				continue
+			}
+			info = append(info, map[string]any{".tag": "member_info", "profile": profile(f.team[i])})
+		}
+		write(map[string]any{"members_info": info})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package e2e runs sync scenarios against ephemeral groups of live sandbox
+// environments of group systems, e.g. a sandbox GitHub org, and checks their
+// members afterwards, so that regressions of the connectors are caught before
+// a release.
+package e2e
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// SourceSystem is the system type of the scenario source groups.
+const SourceSystem = "E2E"
+
+// sourceGroupID is the ID of the scenario source group.
+const sourceGroupID = "source"
+
+// Sandbox provisions ephemeral groups of a group system.
+type Sandbox interface {
+	// System returns the system type of the groups, e.g. GITHUB.
+	System() string
+
+	// ReadWriter returns the client syncing the groups.
+	ReadWriter() groupsync.GroupReadWriter
+
+	// Provision creates an empty group named after the given name, and
+	// returns its ID.
+	Provision(ctx context.Context, name string) (string, error)
+
+	// Cleanup deletes a group created by Provision.
+	Cleanup(ctx context.Context, groupID string) error
+}
+
+// Step is a sync of a scenario.
+type Step struct {
+	// Users are the indexes of the sandbox users which are the members of
+	// the source group, and which the target group must have after the sync.
+	Users []int
+}
+
+// Scenario is a sequence of syncs into the same ephemeral target group.
+type Scenario struct {
+	Name  string
+	Steps []*Step
+}
+
+// users returns the number of sandbox users the scenario needs.
+func (s *Scenario) users() int {
+	var n int
+	for _, step := range s.Steps {
+		for _, u := range step.Users {
+			n = max(n, u+1)
+		}
+	}
+	return n
+}
+
+// DefaultScenarios are the scenarios run unless configured otherwise. They
+// need two sandbox users.
+var DefaultScenarios = []*Scenario{
+	{
+		Name:  "add_remove",
+		Steps: []*Step{{Users: []int{0, 1}}, {Users: []int{1}}, {Users: []int{}}},
+	},
+	{
+		Name:  "replace",
+		Steps: []*Step{{Users: []int{0}}, {Users: []int{1}}},
+	},
+	{
+		Name:  "idempotent",
+		Steps: []*Step{{Users: []int{0, 1}}, {Users: []int{0, 1}}},
+	},
+}
+
+// Result is the outcome of a scenario.
+type Result struct {
+	System   string
+	Scenario string
+	Duration time.Duration
+	// Err is nil if the scenario passed.
+	Err error
+}
+
+// Run runs the given scenarios, each in its own group provisioned by the
+// sandbox and cleaned up afterwards. The given users are the IDs of users of
+// the sandbox system, which scenario steps refer to by index.
+func Run(ctx context.Context, sandbox Sandbox, users []string, scenarios []*Scenario) []*Result {
+	results := make([]*Result, 0, len(scenarios))
+	for _, s := range scenarios {
+		start := time.Now()
+		err := runScenario(ctx, sandbox, users, s)
+		results = append(results, &Result{
+			System:   sandbox.System(),
+			Scenario: s.Name,
+			Duration: time.Since(start),
+			Err:      err,
+		})
+	}
+	return results
+}
+
+// runScenario provisions a target group, syncs each step into it and checks
+// its members.
+func runScenario(ctx context.Context, sandbox Sandbox, users []string, s *Scenario) (retErr error) {
+	if n := s.users(); n > len(users) {
+		return fmt.Errorf("scenario needs %d users, only %d given", n, len(users))
+	}
+	suffix, err := randomSuffix()
+	if err != nil {
+		return err
+	}
+	groupID, err := sandbox.Provision(ctx, fmt.Sprintf("tle2e-%s-%s", strings.ReplaceAll(s.Name, "_", "-"), suffix))
+	if err != nil {
+		return fmt.Errorf("failed to provision group: %w", err)
+	}
+	defer func() {
+		if err := sandbox.Cleanup(ctx, groupID); err != nil {
+			retErr = errors.Join(retErr, fmt.Errorf("failed to clean up group %s: %w", groupID, err))
+		}
+	}()
+
+	logger := logging.FromContext(ctx)
+	source := newSource()
+	syncer := groupsync.NewManyToManySyncer(SourceSystem, sandbox.System(), source, sandbox.ReadWriter(),
+		staticMapper{sourceGroupID: {groupID}}, staticMapper{groupID: {sourceGroupID}}, identityMapper{})
+	for i, step := range s.Steps {
+		want := make([]string, 0, len(step.Users))
+		for _, u := range step.Users {
+			want = append(want, users[u])
+		}
+		logger.InfoContext(ctx, "running scenario step",
+			"scenario", s.Name,
+			"step", i,
+			"group_id", groupID,
+			"users", want,
+		)
+		source.setMembers(sourceGroupID, want)
+		if err := syncer.Sync(ctx, sourceGroupID); err != nil {
+			return fmt.Errorf("step %d: sync failed: %w", i, err)
+		}
+		members, err := sandbox.ReadWriter().GetMembers(ctx, groupID)
+		if err != nil {
+			return fmt.Errorf("step %d: failed to get members: %w", i, err)
+		}
+		if got := userIDs(members); !slices.Equal(got, normalize(want)) {
+			return fmt.Errorf("step %d: group %s got members %v, want %v", i, groupID, got, normalize(want))
+		}
+	}
+	return nil
+}
+
+// randomSuffix returns a random suffix making the names of provisioned
+// groups unique.
+func randomSuffix() (string, error) {
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate group name: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// userIDs returns the sorted, lowercased IDs of the user members.
+func userIDs(members []groupsync.Member) []string {
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		if !m.IsGroup() {
+			ids = append(ids, m.ID())
+		}
+	}
+	return normalize(ids)
+}
+
+// normalize returns the sorted, lowercased copies of the given IDs, since
+// the IDs of some systems, e.g. GitHub logins, are case-insensitive.
+func normalize(ids []string) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, strings.ToLower(id))
+	}
+	slices.Sort(out)
+	return out
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gh "github.com/google/go-github/v61/github"
+	gl "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/credentials"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/githubtest"
+	"github.com/abcxyz/team-link/pkg/gitlab"
+	"github.com/abcxyz/team-link/pkg/gitlabtest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestRun_GitHub(t *testing.T) {
+	t.Parallel()
+
+	server := githubtest.NewBuilder().
+		WithOrg(8583, "sandbox").
+		WithUser(&gh.User{ID: gh.Int64(1), Login: gh.String("alice")}).
+		WithUser(&gh.User{ID: gh.Int64(2), Login: gh.String("bob")}).
+		Start()
+	t.Cleanup(server.Close)
+	teams := github.NewTeamReadWriter(github.NewStaticTokenSource("token"), server.Client(), nil)
+
+	results := Run(context.Background(), NewGitHubSandbox(teams, 8583), []string{"alice", "bob"}, DefaultScenarios)
+	checkResults(t, results, len(DefaultScenarios))
+	if got, err := teams.ListTeams(context.Background(), 8583); err != nil || len(got) > 0 {
+		t.Errorf("got teams %v (err %v) after cleanup, want none", got, err)
+	}
+}
+
+func TestRun_GitLab(t *testing.T) {
+	t.Parallel()
+
+	server := gitlabtest.NewBuilder().
+		WithUser(&gl.User{ID: 1, Username: "alice"}).
+		WithUser(&gl.User{ID: 2, Username: "bob"}).
+		WithGroup(&gl.Group{ID: 100, Name: "sandbox", Path: "sandbox"}).
+		Start()
+	t.Cleanup(server.Close)
+	groups := gitlab.NewGroupReadWriter(gitlab.NewGitLabClientProvider(server.URL, credentials.NewStaticKeyProvider([]byte("token")), nil))
+
+	results := Run(context.Background(), NewGitLabSandbox(groups, 100), []string{"alice", "bob"}, DefaultScenarios)
+	checkResults(t, results, len(DefaultScenarios))
+	members, err := groups.GetMembers(context.Background(), "100")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if len(members) > 0 {
+		t.Errorf("got subgroups %v after cleanup, want none", members)
+	}
+}
+
+func TestRun_Failures(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		sandbox   *fakeSandbox
+		users     []string
+		scenarios []*Scenario
+		wantErr   string
+	}{
+		{
+			name:    "too_few_users",
+			sandbox: &fakeSandbox{},
+			users:   []string{"alice"},
+			scenarios: []*Scenario{
+				{Name: "two_users", Steps: []*Step{{Users: []int{0, 1}}}},
+			},
+			wantErr: "scenario needs 2 users, only 1 given",
+		},
+		{
+			name:    "provision_failure",
+			sandbox: &fakeSandbox{provisionErr: errors.New("no permission")},
+			users:   []string{"alice"},
+			scenarios: []*Scenario{
+				{Name: "one_user", Steps: []*Step{{Users: []int{0}}}},
+			},
+			wantErr: "failed to provision group: no permission",
+		},
+		{
+			name:    "wrong_members",
+			sandbox: &fakeSandbox{dropWrites: true},
+			users:   []string{"alice"},
+			scenarios: []*Scenario{
+				{Name: "one_user", Steps: []*Step{{Users: []int{0}}}},
+			},
+			wantErr: "step 0: group target got members [], want [alice]",
+		},
+		{
+			name:    "cleanup_failure",
+			sandbox: &fakeSandbox{cleanupErr: errors.New("gone")},
+			users:   []string{"alice"},
+			scenarios: []*Scenario{
+				{Name: "one_user", Steps: []*Step{{Users: []int{0}}}},
+			},
+			wantErr: "failed to clean up group target: gone",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			results := Run(context.Background(), tc.sandbox, tc.users, tc.scenarios)
+			if got, want := len(results), len(tc.scenarios); got != want {
+				t.Fatalf("got %d results, want %d", got, want)
+			}
+			if diff := testutil.DiffErrString(results[0].Err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+		})
+	}
+}
+
+func checkResults(tb testing.TB, results []*Result, want int) {
+	tb.Helper()
+
+	if got := len(results); got != want {
+		tb.Fatalf("got %d results, want %d", got, want)
+	}
+	for _, r := range results {
+		if r.Err != nil {
+			tb.Errorf("scenario %s of %s failed: %v", r.Scenario, r.System, r.Err)
+		}
+	}
+}
+
+// fakeSandbox provisions the group "target" of an in-memory group system.
+type fakeSandbox struct {
+	provisionErr error
+	cleanupErr   error
+	// dropWrites drops the members set by syncs.
+	dropWrites bool
+
+	groups *source
+}
+
+func (s *fakeSandbox) System() string {
+	return "FAKE"
+}
+
+func (s *fakeSandbox) ReadWriter() groupsync.GroupReadWriter {
+	return s
+}
+
+func (s *fakeSandbox) Provision(ctx context.Context, name string) (string, error) {
+	if s.provisionErr != nil {
+		return "", s.provisionErr
+	}
+	s.groups = newSource()
+	s.groups.setMembers("target", nil)
+	return "target", nil
+}
+
+func (s *fakeSandbox) Cleanup(ctx context.Context, groupID string) error {
+	return s.cleanupErr
+}
+
+func (s *fakeSandbox) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	return s.groups.GetGroup(ctx, groupID)
+}
+
+func (s *fakeSandbox) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	return s.groups.GetMembers(ctx, groupID)
+}
+
+func (s *fakeSandbox) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	return s.groups.Descendants(ctx, groupID)
+}
+
+func (s *fakeSandbox) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	return s.groups.GetUser(ctx, userID)
+}
+
+func (s *fakeSandbox) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	if s.dropWrites {
+		return nil
+	}
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.ID())
+	}
+	s.groups.setMembers(groupID, ids)
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	tltypes "github.com/abcxyz/team-link/internal"
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/gitlab"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+var (
+	_ Sandbox = (*GitHubSandbox)(nil)
+	_ Sandbox = (*GitLabSandbox)(nil)
+	_ Sandbox = (*GoogleGroupsSandbox)(nil)
+)
+
+// GitHubSandbox provisions teams of a sandbox GitHub org.
+type GitHubSandbox struct {
+	teams *github.TeamReadWriter
+	orgID int64
+}
+
+// NewGitHubSandbox creates a GitHubSandbox provisioning teams of the org with
+// the given ID, whose credentials must allow creating and deleting teams.
+func NewGitHubSandbox(teams *github.TeamReadWriter, orgID int64) *GitHubSandbox {
+	return &GitHubSandbox{teams: teams, orgID: orgID}
+}
+
+func (s *GitHubSandbox) System() string {
+	return tltypes.SystemTypeGitHub
+}
+
+func (s *GitHubSandbox) ReadWriter() groupsync.GroupReadWriter {
+	return s.teams
+}
+
+// Provision creates a team with the given name.
+func (s *GitHubSandbox) Provision(ctx context.Context, name string) (string, error) {
+	team, err := s.teams.CreateTeam(ctx, s.orgID, name, "Ephemeral team of a team-link e2e test")
+	if err != nil {
+		return "", fmt.Errorf("failed to create team: %w", err)
+	}
+	return team.ID, nil
+}
+
+// Cleanup deletes the team with the given ID.
+func (s *GitHubSandbox) Cleanup(ctx context.Context, groupID string) error {
+	return s.teams.DeleteTeam(ctx, groupID) //nolint:wrapcheck // Want passthrough
+}
+
+// GitLabSandbox provisions subgroups of a sandbox GitLab group.
+type GitLabSandbox struct {
+	groups   *gitlab.GroupReadWriter
+	parentID int
+}
+
+// NewGitLabSandbox creates a GitLabSandbox provisioning subgroups of the
+// group with the given ID, whose credentials must allow creating and deleting
+// subgroups.
+func NewGitLabSandbox(groups *gitlab.GroupReadWriter, parentID int) *GitLabSandbox {
+	return &GitLabSandbox{groups: groups, parentID: parentID}
+}
+
+func (s *GitLabSandbox) System() string {
+	return tltypes.SystemTypeGitLab
+}
+
+func (s *GitLabSandbox) ReadWriter() groupsync.GroupReadWriter {
+	return s.groups
+}
+
+// Provision creates a subgroup with the given name and path.
+func (s *GitLabSandbox) Provision(ctx context.Context, name string) (string, error) {
+	group, err := s.groups.CreateGroup(ctx, s.parentID, name, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to create group: %w", err)
+	}
+	return group.ID, nil
+}
+
+// Cleanup deletes the subgroup with the given ID.
+func (s *GitLabSandbox) Cleanup(ctx context.Context, groupID string) error {
+	return s.groups.DeleteGroup(ctx, groupID) //nolint:wrapcheck // Want passthrough
+}
+
+// GoogleGroupsSandbox hands out designated sandbox Google groups, since
+// creating groups needs broader admin privileges than syncing them. The
+// members of a group are removed when it is handed out and cleaned up, so
+// each scenario needs a group of its own.
+type GoogleGroupsSandbox struct {
+	groups   groupsync.GroupReadWriter
+	mu       sync.Mutex
+	groupIDs []string
+}
+
+// NewGoogleGroupsSandbox creates a GoogleGroupsSandbox handing out the groups
+// with the given IDs.
+func NewGoogleGroupsSandbox(groups groupsync.GroupReadWriter, groupIDs []string) *GoogleGroupsSandbox {
+	return &GoogleGroupsSandbox{groups: groups, groupIDs: groupIDs}
+}
+
+func (s *GoogleGroupsSandbox) System() string {
+	return tltypes.SystemTypeGoogleGroups
+}
+
+func (s *GoogleGroupsSandbox) ReadWriter() groupsync.GroupReadWriter {
+	return s.groups
+}
+
+// Provision empties the next unused designated group. The name is ignored.
+func (s *GoogleGroupsSandbox) Provision(ctx context.Context, name string) (string, error) {
+	s.mu.Lock()
+	if len(s.groupIDs) == 0 {
+		s.mu.Unlock()
+		return "", fmt.Errorf("no sandbox google group left for %s", name)
+	}
+	groupID := s.groupIDs[0]
+	s.groupIDs = s.groupIDs[1:]
+	s.mu.Unlock()
+
+	if err := s.groups.SetMembers(ctx, groupID, nil); err != nil {
+		return "", fmt.Errorf("failed to empty group %s: %w", groupID, err)
+	}
+	return groupID, nil
+}
+
+// Cleanup removes the members of the group with the given ID.
+func (s *GoogleGroupsSandbox) Cleanup(ctx context.Context, groupID string) error {
+	return s.groups.SetMembers(ctx, groupID, nil) //nolint:wrapcheck // Want passthrough
+}
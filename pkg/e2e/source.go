@@ -0,0 +1,103 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// source is an in-memory group system holding the scenario source groups.
+// Its users have the IDs of the sandbox users they map to.
+type source struct {
+	mu      sync.Mutex
+	members map[string][]string
+}
+
+func newSource() *source {
+	return &source{members: make(map[string][]string)}
+}
+
+// setMembers sets the user members of the group with the given ID.
+func (s *source) setMembers(groupID string, userIDs []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.members[groupID] = userIDs
+}
+
+func (s *source) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.members[groupID]; !ok {
+		return nil, fmt.Errorf("%w: %s", groupsync.ErrGroupNotFound, groupID)
+	}
+	return &groupsync.Group{ID: groupID}, nil
+}
+
+func (s *source) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	userIDs, ok := s.members[groupID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", groupsync.ErrGroupNotFound, groupID)
+	}
+	members := make([]groupsync.Member, 0, len(userIDs))
+	for _, id := range userIDs {
+		members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: id}})
+	}
+	return members, nil
+}
+
+func (s *source) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	return groupsync.Descendants(ctx, groupID, s.GetMembers) //nolint:wrapcheck // Want passthrough
+}
+
+func (s *source) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	return &groupsync.User{ID: userID}, nil
+}
+
+// staticMapper maps group IDs to the group IDs of a map.
+type staticMapper map[string][]string
+
+func (m staticMapper) AllGroupIDs(ctx context.Context) ([]string, error) {
+	ids := make([]string, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (m staticMapper) ContainsGroupID(ctx context.Context, groupID string) (bool, error) {
+	_, ok := m[groupID]
+	return ok, nil
+}
+
+func (m staticMapper) MappedGroupIDs(ctx context.Context, groupID string) ([]string, error) {
+	ids, ok := m[groupID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", groupsync.ErrGroupMappingNotFound, groupID)
+	}
+	return ids, nil
+}
+
+// identityMapper maps every user ID to itself.
+type identityMapper struct{}
+
+func (identityMapper) MappedUserID(ctx context.Context, userID string) (string, error) {
+	return userID, nil
+}
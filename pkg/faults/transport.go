@@ -0,0 +1,239 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package faults provides an http.RoundTripper injecting faults, i.e. errors,
+// latency and rate limit responses, into the requests of the clients of group
+// systems, to test how syncers handle retries and partial failures.
+package faults
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrInjected is the error of requests failed by WithNetworkErrorRate.
+var ErrInjected = errors.New("injected network error")
+
+// Config holds the faults injected by a Transport. Rates are probabilities
+// between 0 and 1 of a request getting the fault.
+type Config struct {
+	errorRate        float64
+	errorStatus      int
+	networkErrorRate float64
+	rateLimitRate    float64
+	retryAfter       time.Duration
+	latency          time.Duration
+	jitter           time.Duration
+	match            func(r *http.Request) bool
+	seed             int64
+}
+
+// Opt configures a Transport.
+type Opt func(config *Config)
+
+// WithErrorRate fails the given rate of requests with the given server error
+// status, http.StatusServiceUnavailable if 0.
+func WithErrorRate(rate float64, status int) Opt {
+	return func(config *Config) {
+		config.errorRate = rate
+		if status == 0 {
+			status = http.StatusServiceUnavailable
+		}
+		config.errorStatus = status
+	}
+}
+
+// WithNetworkErrorRate fails the given rate of requests with ErrInjected,
+// without a response, like a connection reset.
+func WithNetworkErrorRate(rate float64) Opt {
+	return func(config *Config) {
+		config.networkErrorRate = rate
+	}
+}
+
+// WithRateLimitRate rejects the given rate of requests with a 429 response
+// with the headers of an exhausted GitHub rate limit, which is reset, and
+// may be retried, after the given duration.
+func WithRateLimitRate(rate float64, retryAfter time.Duration) Opt {
+	return func(config *Config) {
+		config.rateLimitRate = rate
+		config.retryAfter = retryAfter
+	}
+}
+
+// WithLatency delays every request by the given latency plus a random jitter
+// of up to the given jitter.
+func WithLatency(latency, jitter time.Duration) Opt {
+	return func(config *Config) {
+		config.latency = latency
+		config.jitter = jitter
+	}
+}
+
+// WithMatcher only injects faults into the requests for which match returns
+// true, e.g. the writes of a single endpoint. By default faults are injected
+// into all requests.
+func WithMatcher(match func(r *http.Request) bool) Opt {
+	return func(config *Config) {
+		config.match = match
+	}
+}
+
+// WithSeed seeds the random choice of the faulty requests, so that a test
+// sequentially sending the same requests gets the same faults. By default
+// the seed is 1.
+func WithSeed(seed int64) Opt {
+	return func(config *Config) {
+		config.seed = seed
+	}
+}
+
+// Stats counts the requests of a Transport and the faults injected into them.
+type Stats struct {
+	Requests      int
+	Errors        int
+	NetworkErrors int
+	RateLimits    int
+}
+
+// Transport is an http.RoundTripper injecting faults into the requests sent
+// by another http.RoundTripper. Requests with faults other than latency are
+// not sent.
+type Transport struct {
+	base   http.RoundTripper
+	config *Config
+
+	mu    sync.Mutex
+	rand  *rand.Rand
+	stats Stats
+}
+
+// NewTransport creates a Transport sending requests with the given
+// http.RoundTripper, http.DefaultTransport if nil.
+func NewTransport(base http.RoundTripper, opts ...Opt) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	config := &Config{seed: 1}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &Transport{
+		base:   base,
+		config: config,
+		rand:   rand.New(rand.NewSource(config.seed)), //nolint:gosec // not used for security
+	}
+}
+
+// NewClient returns a copy of the given client, http.DefaultClient if nil,
+// whose transport injects faults.
+func NewClient(client *http.Client, opts ...Opt) *http.Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	c := *client
+	c.Transport = NewTransport(client.Transport, opts...)
+	return &c
+}
+
+// Stats returns the number of requests sent so far and of the faults
+// injected into them.
+func (t *Transport) Stats() Stats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.stats
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if t.config.match != nil && !t.config.match(r) {
+		return t.base.RoundTrip(r) //nolint:wrapcheck // Want passthrough
+	}
+
+	// a single draw picks at most one fault, so rates add up.
+	t.mu.Lock()
+	t.stats.Requests++
+	draw := t.rand.Float64()
+	var jitter time.Duration
+	if t.config.jitter > 0 {
+		jitter = time.Duration(t.rand.Int63n(int64(t.config.jitter)))
+	}
+	var fault string
+	switch {
+	case draw < t.config.networkErrorRate:
+		fault = "network"
+		t.stats.NetworkErrors++
+	case draw < t.config.networkErrorRate+t.config.errorRate:
+		fault = "error"
+		t.stats.Errors++
+	case draw < t.config.networkErrorRate+t.config.errorRate+t.config.rateLimitRate:
+		fault = "rate_limit"
+		t.stats.RateLimits++
+	}
+	t.mu.Unlock()
+
+	if delay := t.config.latency + jitter; delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return nil, fmt.Errorf("request canceled during injected latency: %w", r.Context().Err())
+		}
+	}
+
+	switch fault {
+	case "network":
+		return nil, fmt.Errorf("%s %s: %w", r.Method, r.URL, ErrInjected)
+	case "error":
+		return response(r, t.config.errorStatus, nil), nil
+	case "rate_limit":
+		header := make(http.Header)
+		header.Set("Retry-After", strconv.Itoa(int(t.config.retryAfter.Seconds())))
+		header.Set("X-RateLimit-Limit", "5000")
+		header.Set("X-RateLimit-Remaining", "0")
+		header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(t.config.retryAfter).Unix(), 10))
+		return response(r, http.StatusTooManyRequests, header), nil
+	}
+	return t.base.RoundTrip(r) //nolint:wrapcheck // Want passthrough
+}
+
+// response returns an injected response with the given status and header to
+// the given request.
+func response(r *http.Request, status int, header http.Header) *http.Response {
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "application/json")
+	body := fmt.Sprintf(`{"message": "injected fault: %s"}`, http.StatusText(status))
+	if r.Body != nil {
+		r.Body.Close()
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewBufferString(body)),
+		ContentLength: int64(len(body)),
+		Request:       r,
+	}
+}
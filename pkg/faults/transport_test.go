@@ -0,0 +1,247 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package faults
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	gh "github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/team-link/pkg/github"
+	"github.com/abcxyz/team-link/pkg/githubtest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := []struct {
+		name       string
+		opts       []Opt
+		method     string
+		wantStatus int
+		wantErr    error
+		wantHeader map[string]string
+		wantStats  Stats
+	}{
+		{
+			name:       "no_faults",
+			method:     http.MethodGet,
+			wantStatus: http.StatusOK,
+			wantStats:  Stats{Requests: 1},
+		},
+		{
+			name:       "error",
+			opts:       []Opt{WithErrorRate(1, 0)},
+			method:     http.MethodGet,
+			wantStatus: http.StatusServiceUnavailable,
+			wantStats:  Stats{Requests: 1, Errors: 1},
+		},
+		{
+			name:       "error_status",
+			opts:       []Opt{WithErrorRate(1, http.StatusBadGateway)},
+			method:     http.MethodGet,
+			wantStatus: http.StatusBadGateway,
+			wantStats:  Stats{Requests: 1, Errors: 1},
+		},
+		{
+			name:      "network_error",
+			opts:      []Opt{WithNetworkErrorRate(1)},
+			method:    http.MethodGet,
+			wantErr:   ErrInjected,
+			wantStats: Stats{Requests: 1, NetworkErrors: 1},
+		},
+		{
+			name:       "rate_limit",
+			opts:       []Opt{WithRateLimitRate(1, 30*time.Second)},
+			method:     http.MethodGet,
+			wantStatus: http.StatusTooManyRequests,
+			wantHeader: map[string]string{"Retry-After": "30", "X-RateLimit-Remaining": "0"},
+			wantStats:  Stats{Requests: 1, RateLimits: 1},
+		},
+		{
+			name: "not_matched",
+			opts: []Opt{
+				WithErrorRate(1, 0),
+				WithMatcher(func(r *http.Request) bool { return r.Method != http.MethodGet }),
+			},
+			method:     http.MethodGet,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "matched",
+			opts: []Opt{
+				WithErrorRate(1, 0),
+				WithMatcher(func(r *http.Request) bool { return r.Method != http.MethodGet }),
+			},
+			method:     http.MethodPut,
+			wantStatus: http.StatusServiceUnavailable,
+			wantStats:  Stats{Requests: 1, Errors: 1},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			transport := NewTransport(nil, tc.opts...)
+			req, err := http.NewRequestWithContext(context.Background(), tc.method, srv.URL, nil)
+			if err != nil {
+				t.Fatalf("failed to create request: %v", err)
+			}
+			resp, err := transport.RoundTrip(req)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("RoundTrip got err %v, want %v", err, tc.wantErr)
+			}
+			if resp != nil {
+				resp.Body.Close()
+				if got, want := resp.StatusCode, tc.wantStatus; got != want {
+					t.Errorf("RoundTrip got status %d, want %d", got, want)
+				}
+				for k, want := range tc.wantHeader {
+					if got := resp.Header.Get(k); got != want {
+						t.Errorf("RoundTrip got header %s %q, want %q", k, got, want)
+					}
+				}
+			}
+			if diff := cmp.Diff(transport.Stats(), tc.wantStats); diff != "" {
+				t.Errorf("unexpected stats (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransport_Rates(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	run := func() Stats {
+		client := NewClient(srv.Client(), WithErrorRate(0.2, 0), WithRateLimitRate(0.1, 0), WithSeed(42))
+		for range 1000 {
+			resp, err := client.Get(srv.URL)
+			if err != nil {
+				t.Fatalf("Get failed: %v", err)
+			}
+			resp.Body.Close()
+		}
+		return client.Transport.(*Transport).Stats()
+	}
+
+	got := run()
+	if got.Errors < 150 || got.Errors > 250 {
+		t.Errorf("got %d errors of 1000 requests, want about 200", got.Errors)
+	}
+	if got.RateLimits < 50 || got.RateLimits > 150 {
+		t.Errorf("got %d rate limits of 1000 requests, want about 100", got.RateLimits)
+	}
+	// the same seed injects the same faults.
+	if diff := cmp.Diff(run(), got); diff != "" {
+		t.Errorf("unexpected stats of seeded rerun (-got, +want):\n%s", diff)
+	}
+}
+
+func TestTransport_Latency(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	client := NewClient(srv.Client(), WithLatency(50*time.Millisecond, 10*time.Millisecond))
+	start := time.Now()
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	resp.Body.Close()
+	if got := time.Since(start); got < 50*time.Millisecond {
+		t.Errorf("request took %s, want at least 50ms", got)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if _, err := client.Do(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Do got err %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+// TestTransport_GitHub checks that the faults are classified as retryable by
+// the GitHub team client.
+func TestTransport_GitHub(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		opt     Opt
+		wantErr error
+	}{
+		{
+			name:    "server_error",
+			opt:     WithErrorRate(1, 0),
+			wantErr: groupsync.ErrTransient,
+		},
+		{
+			name:    "rate_limit",
+			opt:     WithRateLimitRate(1, 0),
+			wantErr: groupsync.ErrRateLimited,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := githubtest.NewBuilder().
+				WithOrg(8583, "org1").
+				WithUser(&gh.User{ID: gh.Int64(1), Login: gh.String("user1")}).
+				WithTeam(8583, &gh.Team{ID: gh.Int64(1), Slug: gh.String("team1")}).
+				Start()
+			t.Cleanup(server.Close)
+			// only writes fail, so that the current members are read.
+			client := gh.NewClient(NewClient(nil, tc.opt, WithMatcher(func(r *http.Request) bool {
+				return r.Method != http.MethodGet
+			})))
+			client.BaseURL = server.Client().BaseURL
+			rw := github.NewTeamReadWriter(github.NewStaticTokenSource("token"), client, nil)
+
+			err := rw.SetMembers(context.Background(), "8583:1", []groupsync.Member{
+				&groupsync.UserMember{Usr: &groupsync.User{ID: "user1"}},
+			})
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("SetMembers got err %v, want %v", err, tc.wantErr)
+			}
+			if got := server.TeamMembers(8583, 1); len(got) > 0 {
+				t.Errorf("team got members %v despite failed writes", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gerrit provides a GroupReadWriter for Gerrit internal groups, e.g.
+// the groups granted code review permissions in project ACLs.
+package gerrit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// xssiPrefix precedes every JSON response of Gerrit.
+	xssiPrefix = ")]}'"
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// GroupInfo is a Gerrit group, see
+// https://gerrit-review.googlesource.com/Documentation/rest-api-groups.html#group-info.
+type GroupInfo struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Owner       string `json:"owner,omitempty"`
+	OwnerID     string `json:"owner_id,omitempty"`
+	GroupID     int    `json:"group_id,omitempty"`
+}
+
+// AccountInfo is a Gerrit account, see
+// https://gerrit-review.googlesource.com/Documentation/rest-api-accounts.html#account-info.
+type AccountInfo struct {
+	AccountID int    `json:"_account_id"`
+	Name      string `json:"name,omitempty"`
+	Email     string `json:"email,omitempty"`
+	Username  string `json:"username,omitempty"`
+}
+
+// userID returns the ID of the account used by team-link, its username, or
+// its numeric ID if it has none.
+func (a *AccountInfo) userID() string {
+	if a.Username != "" {
+		return a.Username
+	}
+	return strconv.Itoa(a.AccountID)
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Gerrit.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Gerrit internal groups. Group IDs are group
+// UUIDs and user IDs are account usernames. Groups included in a group are
+// its group members.
+type GroupReadWriter struct {
+	client    *rest.Client
+	userCache *cache.Cache[*AccountInfo]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Gerrit server at the
+// given URL, e.g. https://gerrit.example.com. It authenticates with the
+// username and HTTP password of an account which owns the synced groups, or
+// has the "Administrate Server" capability.
+func NewGroupReadWriter(endpoint, username, httpPassword string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &GroupReadWriter{
+		// authenticated requests are prefixed with /a.
+		client: rest.New(endpoint+"/a",
+			rest.WithHTTPClient(config.httpClient),
+			rest.WithBasicAuth(username, httpPassword),
+			rest.WithResponsePrefix(xssiPrefix),
+		),
+		userCache: cache.New[*AccountInfo](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Gerrit group with the given UUID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	var group GroupInfo
+	if err := rw.client.Do(ctx, http.MethodGet, "/groups/"+url.PathEscape(groupID), nil, &group); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: group.ID, Attributes: &group}, nil
+}
+
+// GetMembers retrieves the members of the Gerrit group with the given UUID:
+// its accounts and its included groups.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	var accounts []*AccountInfo
+	if err := rw.client.Do(ctx, http.MethodGet, "/groups/"+url.PathEscape(groupID)+"/members/", nil, &accounts); err != nil {
+		return nil, fmt.Errorf("failed to get members of group %s: %w", groupID, notFound(err))
+	}
+	var included []*GroupInfo
+	if err := rw.client.Do(ctx, http.MethodGet, "/groups/"+url.PathEscape(groupID)+"/groups/", nil, &included); err != nil {
+		return nil, fmt.Errorf("failed to get included groups of group %s: %w", groupID, notFound(err))
+	}
+
+	members := make([]groupsync.Member, 0, len(accounts)+len(included))
+	for _, a := range accounts {
+		members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: a.userID(), Attributes: a}})
+	}
+	for _, g := range included {
+		members = append(members, &groupsync.GroupMember{Grp: &groupsync.Group{ID: g.ID, Attributes: g}})
+	}
+	return members, nil
+}
+
+// Descendants retrieve all users (children, recursively) of the Gerrit group
+// with the given UUID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Gerrit account with the given username.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	account, err := rw.userCache.WriteThruLookup(userID, func() (*AccountInfo, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userID)
+		var account AccountInfo
+		if err := rw.client.Do(ctx, http.MethodGet, "/accounts/"+url.PathEscape(userID), nil, &account); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", userID, err)
+		}
+		return &account, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: account.userID(), Attributes: account}, nil
+}
+
+// SetMembers replaces the members of the Gerrit group with the given UUID
+// with the given members. Accounts and included groups of the group which
+// are not in the given members are removed, and given members which are not
+// in the group are added.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	currentMembers, err := rw.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	addUsers, addGroups := splitIDs(addMembers)
+	removeUsers, removeGroups := splitIDs(removeMembers)
+	base := "/groups/" + url.PathEscape(groupID)
+
+	var merr error
+	if len(addUsers) > 0 {
+		if err := rw.client.Do(ctx, http.MethodPost, base+"/members.add", map[string][]string{"members": addUsers}, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add members %v to group %s: %w", addUsers, groupID, err))
+		}
+	}
+	if len(addGroups) > 0 {
+		if err := rw.client.Do(ctx, http.MethodPost, base+"/groups.add", map[string][]string{"groups": addGroups}, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to include groups %v in group %s: %w", addGroups, groupID, err))
+		}
+	}
+	if len(removeUsers) > 0 {
+		if err := rw.client.Do(ctx, http.MethodPost, base+"/members.delete", map[string][]string{"members": removeUsers}, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove members %v from group %s: %w", removeUsers, groupID, err))
+		}
+	}
+	if len(removeGroups) > 0 {
+		if err := rw.client.Do(ctx, http.MethodPost, base+"/groups.delete", map[string][]string{"groups": removeGroups}, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to exclude groups %v from group %s: %w", removeGroups, groupID, err))
+		}
+	}
+	return merr
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+// splitIDs returns the sorted IDs of the user and group members.
+func splitIDs(members map[string]groupsync.Member) ([]string, []string) {
+	var users, groups []string
+	for _, id := range utils.MapKeys(members) {
+		if members[id].IsGroup() {
+			groups = append(groups, id)
+		} else {
+			users = append(users, id)
+		}
+	}
+	return users, groups
+}
+
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[m.ID()] = m
+	}
+	return memberIDs
+}
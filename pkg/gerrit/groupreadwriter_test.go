@@ -0,0 +1,179 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gerrit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeGerrit{
+		accounts: map[string]*AccountInfo{
+			"alice": {AccountID: 1, Username: "alice"},
+			"bob":   {AccountID: 2, Username: "bob"},
+			"carol": {AccountID: 3, Username: "carol"},
+		},
+		members: map[string][]string{"reviewers": {"alice", "bob"}, "leads": {"carol"}, "old": {}},
+		groups:  map[string][]string{"reviewers": {"old"}, "leads": {}, "old": {}},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(srv.URL, "team-link", "secret")
+
+	users, err := rw.Descendants(ctx, "reviewers")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice", "bob"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	if err := rw.SetMembers(ctx, "reviewers", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol"}},
+		&groupsync.GroupMember{Grp: &groupsync.Group{ID: "leads"}},
+	}); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	members, err := rw.GetMembers(ctx, "reviewers")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	var got []string
+	for _, m := range members {
+		got = append(got, fmt.Sprintf("%s:%t", m.ID(), m.IsGroup()))
+	}
+	if diff := cmp.Diff(got, []string{"bob:false", "carol:false", "leads:true"}); diff != "" {
+		t.Errorf("unexpected members (-got, +want):\n%s", diff)
+	}
+
+	user, err := rw.GetUser(ctx, "carol")
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if got, want := user.ID, "carol"; got != want {
+		t.Errorf("GetUser got %q, want %q", got, want)
+	}
+
+	if _, err := rw.GetGroup(ctx, "missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeGerrit implements the parts of the Gerrit REST API used by
+// GroupReadWriter.
+type fakeGerrit struct {
+	mu       sync.Mutex
+	accounts map[string]*AccountInfo
+	members  map[string][]string
+	groups   map[string][]string
+}
+
+func (f *fakeGerrit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if user, pass, _ := r.BasicAuth(); user != "team-link" || pass != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/a/"), "/")
+	write := func(v any) {
+		fmt.Fprintln(w, xssiPrefix)
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+
+	switch {
+	case parts[0] == "accounts" && len(parts) == 2:
+		a, ok := f.accounts[parts[1]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		write(a)
+	case parts[0] == "groups":
+		group := parts[1]
+		if _, ok := f.members[group]; !ok {
+			http.Error(w, "Not found: "+group, http.StatusNotFound)
+			return
+		}
+		if len(parts) == 2 {
+			write(&GroupInfo{ID: group, Name: group})
+			return
+		}
+		switch op := parts[2]; op {
+		case "members":
+			var accounts []*AccountInfo
+			for _, u := range f.members[group] {
+				accounts = append(accounts, f.accounts[u])
+			}
+			write(accounts)
+		case "groups":
+			var groups []*GroupInfo
+			for _, g := range f.groups[group] {
+				groups = append(groups, &GroupInfo{ID: g, Name: g})
+			}
+			write(groups)
+		case "members.add", "members.delete", "groups.add", "groups.delete":
+			var body map[string][]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			target := f.members
+			ids := body["members"]
+			if strings.HasPrefix(op, "groups") {
+				target, ids = f.groups, body["groups"]
+			}
+			for _, id := range ids {
+				target[group] = slices.DeleteFunc(target[group], func(s string) bool { return s == id })
+				if strings.HasSuffix(op, ".add") {
+					target[group] = append(target[group], id)
+				}
+			}
+			slices.Sort(target[group])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
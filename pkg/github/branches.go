@@ -0,0 +1,161 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// AllowancePush allows teams to push to a protected branch which
+	// restricts who can push.
+	AllowancePush = "push"
+
+	// AllowanceBypassPullRequests allows teams to bypass the required pull
+	// requests of a protected branch.
+	AllowanceBypassPullRequests = "bypass_pull_requests"
+)
+
+// BranchAllowanceChange is a change of the teams having an allowance on a
+// protected branch. Teams are slugs.
+type BranchAllowanceChange struct {
+	Repo   string
+	Branch string
+	// Allowance is AllowancePush or AllowanceBypassPullRequests.
+	Allowance string
+	Add       []string
+	Remove    []string
+}
+
+// SyncBranchAllowance makes the teams with the given IDs exactly the teams
+// having the given allowance on the protected branch with the given name of
+// the repository with the given name, in the org with the given ID. Users and
+// apps having the allowance keep it. The branch must restrict who can push
+// for AllowancePush, and require pull requests for
+// AllowanceBypassPullRequests. The change is returned, or nil if the teams
+// already are the ones having the allowance, and only made unless dryRun is
+// set.
+func (g *TeamReadWriter) SyncBranchAllowance(ctx context.Context, orgID int64, repo, branch, allowance string, teamIDs []int64, dryRun bool) (*BranchAllowanceChange, error) {
+	if allowance != AllowancePush && allowance != AllowanceBypassPullRequests {
+		return nil, fmt.Errorf("unknown branch allowance %q", allowance)
+	}
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get github client: %w", err)
+	}
+	login, err := g.orgLogin(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+	want := make(map[string]struct{}, len(teamIDs))
+	for _, id := range teamIDs {
+		team, err := g.getGitHubTeam(ctx, client, orgID, id)
+		if err != nil {
+			return nil, fmt.Errorf("could not get team %s: %w", Encode(orgID, id), classifyErr(err))
+		}
+		want[team.GetSlug()] = struct{}{}
+	}
+
+	current := make(map[string]struct{})
+	var reviews *github.PullRequestReviewsEnforcement
+	switch allowance {
+	case AllowancePush:
+		teams, _, err := client.Repositories.ListTeamRestrictions(ctx, login, repo, branch)
+		if err != nil {
+			return nil, fmt.Errorf("could not list teams allowed to push to branch %s of repo %s: %w", branch, repo, classifyErr(err))
+		}
+		for _, t := range teams {
+			current[t.GetSlug()] = struct{}{}
+		}
+	case AllowanceBypassPullRequests:
+		reviews, _, err = client.Repositories.GetPullRequestReviewEnforcement(ctx, login, repo, branch)
+		if err != nil {
+			return nil, fmt.Errorf("could not get pull request reviews of branch %s of repo %s: %w", branch, repo, classifyErr(err))
+		}
+		if bypass := reviews.BypassPullRequestAllowances; bypass != nil {
+			for _, t := range bypass.Teams {
+				current[t.GetSlug()] = struct{}{}
+			}
+		}
+	}
+
+	change := &BranchAllowanceChange{
+		Repo:      repo,
+		Branch:    branch,
+		Allowance: allowance,
+		Add:       utils.MapKeys(sets.SubtractMapKeys(want, current)),
+		Remove:    utils.MapKeys(sets.SubtractMapKeys(current, want)),
+	}
+	if len(change.Add) == 0 && len(change.Remove) == 0 {
+		return nil, nil
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "setting branch allowance",
+		"org_id", orgID,
+		"repo", repo,
+		"branch", branch,
+		"allowance", allowance,
+		"add_teams", change.Add,
+		"remove_teams", change.Remove,
+		"dry_run", dryRun,
+	)
+	if dryRun {
+		return change, nil
+	}
+
+	slugs := utils.MapKeys(want)
+	switch allowance {
+	case AllowancePush:
+		if _, _, err := client.Repositories.ReplaceTeamRestrictions(ctx, login, repo, branch, slugs); err != nil {
+			return change, fmt.Errorf("could not set teams allowed to push to branch %s of repo %s: %w", branch, repo, classifyErr(err))
+		}
+	case AllowanceBypassPullRequests:
+		if _, _, err := client.Repositories.UpdatePullRequestReviewEnforcement(ctx, login, repo, branch, bypassUpdate(reviews, slugs)); err != nil {
+			return change, fmt.Errorf("could not set teams allowed to bypass pull requests of branch %s of repo %s: %w", branch, repo, classifyErr(err))
+		}
+	}
+	return change, nil
+}
+
+// bypassUpdate returns the update of the given pull request reviews of a
+// branch setting the teams allowed to bypass them to the teams with the given
+// slugs, and keeping the users and apps allowed to bypass them.
+func bypassUpdate(reviews *github.PullRequestReviewsEnforcement, teams []string) *github.PullRequestReviewsEnforcementUpdate {
+	bypass := &github.BypassPullRequestAllowancesRequest{
+		Users: []string{},
+		Teams: teams,
+		Apps:  []string{},
+	}
+	if current := reviews.BypassPullRequestAllowances; current != nil {
+		for _, u := range current.Users {
+			bypass.Users = append(bypass.Users, u.GetLogin())
+		}
+		for _, a := range current.Apps {
+			bypass.Apps = append(bypass.Apps, a.GetSlug())
+		}
+	}
+	return &github.PullRequestReviewsEnforcementUpdate{
+		BypassPullRequestAllowancesRequest: bypass,
+		// the count is always sent, so it is sent unchanged.
+		RequiredApprovingReviewCount: reviews.RequiredApprovingReviewCount,
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/githubtest"
+)
+
+func TestTeamReadWriter_SyncBranchAllowance(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		branch     string
+		allowance  string
+		teamIDs    []int64
+		dryRun     bool
+		wantChange *BranchAllowanceChange
+		wantPush   []string
+		wantBypass []string
+		wantErr    string
+	}{
+		{
+			name:      "push",
+			branch:    "main",
+			allowance: AllowancePush,
+			teamIDs:   []int64{1, 3},
+			wantChange: &BranchAllowanceChange{
+				Repo:      "svc-api",
+				Branch:    "main",
+				Allowance: AllowancePush,
+				Add:       []string{"team3"},
+				Remove:    []string{"team2"},
+			},
+			wantPush:   []string{"team1", "team3"},
+			wantBypass: []string{"team2"},
+		},
+		{
+			name:      "bypass",
+			branch:    "main",
+			allowance: AllowanceBypassPullRequests,
+			teamIDs:   []int64{3},
+			wantChange: &BranchAllowanceChange{
+				Repo:      "svc-api",
+				Branch:    "main",
+				Allowance: AllowanceBypassPullRequests,
+				Add:       []string{"team3"},
+				Remove:    []string{"team2"},
+			},
+			wantPush:   []string{"team1", "team2"},
+			wantBypass: []string{"team3"},
+		},
+		{
+			name:      "remove_all",
+			branch:    "main",
+			allowance: AllowancePush,
+			wantChange: &BranchAllowanceChange{
+				Repo:      "svc-api",
+				Branch:    "main",
+				Allowance: AllowancePush,
+				Add:       []string{},
+				Remove:    []string{"team1", "team2"},
+			},
+			wantPush:   []string{},
+			wantBypass: []string{"team2"},
+		},
+		{
+			name:      "dry_run",
+			branch:    "main",
+			allowance: AllowanceBypassPullRequests,
+			teamIDs:   []int64{3},
+			dryRun:    true,
+			wantChange: &BranchAllowanceChange{
+				Repo:      "svc-api",
+				Branch:    "main",
+				Allowance: AllowanceBypassPullRequests,
+				Add:       []string{"team3"},
+				Remove:    []string{"team2"},
+			},
+			wantPush:   []string{"team1", "team2"},
+			wantBypass: []string{"team2"},
+		},
+		{
+			name:       "unchanged",
+			branch:     "main",
+			allowance:  AllowancePush,
+			teamIDs:    []int64{2, 1},
+			wantPush:   []string{"team1", "team2"},
+			wantBypass: []string{"team2"},
+		},
+		{
+			name:       "unprotected_branch",
+			branch:     "dev",
+			allowance:  AllowancePush,
+			teamIDs:    []int64{1},
+			wantPush:   []string{"team1", "team2"},
+			wantBypass: []string{"team2"},
+			wantErr:    "could not list teams allowed to push to branch dev of repo svc-api",
+		},
+		{
+			name:       "unknown_team",
+			branch:     "main",
+			allowance:  AllowancePush,
+			teamIDs:    []int64{4},
+			wantPush:   []string{"team1", "team2"},
+			wantBypass: []string{"team2"},
+			wantErr:    "could not get team 8583:4",
+		},
+		{
+			name:       "unknown_allowance",
+			branch:     "main",
+			allowance:  "merge",
+			wantPush:   []string{"team1", "team2"},
+			wantBypass: []string{"team2"},
+			wantErr:    `unknown branch allowance "merge"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := githubtest.NewBuilder().
+				WithOrg(8583, "org1").
+				WithTeam(8583, &github.Team{ID: github.Int64(1), Slug: github.String("team1")}).
+				WithTeam(8583, &github.Team{ID: github.Int64(2), Slug: github.String("team2")}).
+				WithTeam(8583, &github.Team{ID: github.Int64(3), Slug: github.String("team3")}).
+				WithRepo(8583, &github.Repository{Name: github.String("svc-api")}).
+				WithPushRestrictions(8583, "svc-api", "main", "team1", "team2").
+				WithPullRequestReviews(8583, "svc-api", "main", &github.PullRequestReviewsEnforcement{
+					RequiredApprovingReviewCount: 2,
+					BypassPullRequestAllowances: &github.BypassPullRequestAllowances{
+						Users: []*github.User{{Login: github.String("release-manager")}},
+						Teams: []*github.Team{{ID: github.Int64(2), Slug: github.String("team2")}},
+						Apps:  []*github.App{{Slug: github.String("release-bot")}},
+					},
+				}).
+				Start()
+			t.Cleanup(server.Close)
+			tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token"}}
+
+			rw := NewTeamReadWriter(tokenSource, server.Client(), nil)
+			got, err := rw.SyncBranchAllowance(context.Background(), 8583, "svc-api", tc.branch, tc.allowance, tc.teamIDs, tc.dryRun)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.wantChange); diff != "" {
+				t.Errorf("unexpected change (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(server.PushTeams(8583, "svc-api", "main"), tc.wantPush); diff != "" {
+				t.Errorf("unexpected push teams (-got, +want):\n%s", diff)
+			}
+
+			reviews := server.PullRequestReviews(8583, "svc-api", "main")
+			bypass := reviews.BypassPullRequestAllowances
+			var gotBypass []string
+			for _, team := range bypass.Teams {
+				gotBypass = append(gotBypass, team.GetSlug())
+			}
+			if diff := cmp.Diff(gotBypass, tc.wantBypass); diff != "" {
+				t.Errorf("unexpected bypass teams (-got, +want):\n%s", diff)
+			}
+			// the other settings and allowances are kept.
+			if got, want := reviews.RequiredApprovingReviewCount, 2; got != want {
+				t.Errorf("got %d required approving reviews, want %d", got, want)
+			}
+			if len(bypass.Users) != 1 || bypass.Users[0].GetLogin() != "release-manager" {
+				t.Errorf("got bypass users %v, want release-manager", bypass.Users)
+			}
+			if len(bypass.Apps) != 1 || bypass.Apps[0].GetSlug() != "release-bot" {
+				t.Errorf("got bypass apps %v, want release-bot", bypass.Apps)
+			}
+		})
+	}
+}
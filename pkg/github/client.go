@@ -17,6 +17,7 @@ package github
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/go-github/v61/github"
 	"golang.org/x/oauth2"
@@ -26,15 +27,57 @@ const DefaultGitHubEndpointURL = "https://github.com"
 
 // NewTeamReadWriterWithStaticTokenSource creates a team readwriter using provided endpoint
 // and static token source.
-func NewTeamReadWriterWithStaticTokenSource(ctx context.Context, s *StaticTokenSource, endpoint string, orgTeamSSORequired map[int64]map[int64]bool) (*TeamReadWriter, error) {
-	ghc := github.NewClient(oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{
-		AccessToken: s.GetStaticToken(),
-	})))
+func NewTeamReadWriterWithStaticTokenSource(ctx context.Context, s *StaticTokenSource, endpoint string, orgTeamSSORequired map[int64]map[int64]bool, opts ...Opt) (*TeamReadWriter, error) {
+	return NewTeamReadWriterWithTokenSource(ctx, s, s.GetStaticToken(), endpoint, orgTeamSSORequired, opts...)
+}
+
+// NewTeamReadWriterWithTokenSource creates a team readwriter using provided endpoint
+// and token source. Requests that are not made on behalf of an org, such as user
+// lookups, use the given token, or are unauthenticated if it is empty.
+func NewTeamReadWriterWithTokenSource(ctx context.Context, s OrgTokenSource, token, endpoint string, orgTeamSSORequired map[int64]map[int64]bool, opts ...Opt) (*TeamReadWriter, error) {
+	var ts oauth2.TokenSource
+	if token != "" {
+		ts = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+	}
+	return newTeamReadWriter(ctx, s, ts, endpoint, orgTeamSSORequired, opts...)
+}
+
+// NewTeamReadWriterWithAppTokenSource creates a team readwriter using provided endpoint
+// and token source, typically minting GitHub App installation tokens. Such tokens are
+// only valid for an org, so requests that are not made on behalf of an org, such as
+// user lookups, use the token of userLookupOrgID. The token source is asked for a
+// token on each such request, so it should cache tokens, see CachingTokenSource.
+func NewTeamReadWriterWithAppTokenSource(ctx context.Context, s OrgTokenSource, userLookupOrgID int64, endpoint string, orgTeamSSORequired map[int64]map[int64]bool, opts ...Opt) (*TeamReadWriter, error) {
+	return newTeamReadWriter(ctx, s, &orgOAuth2TokenSource{ctx: ctx, source: s, orgID: userLookupOrgID}, endpoint, orgTeamSSORequired, opts...)
+}
+
+func newTeamReadWriter(ctx context.Context, s OrgTokenSource, ts oauth2.TokenSource, endpoint string, orgTeamSSORequired map[int64]map[int64]bool, opts ...Opt) (*TeamReadWriter, error) {
+	ghc := github.NewClient(nil)
+	if ts != nil {
+		ghc = github.NewClient(oauth2.NewClient(ctx, ts))
+	}
 	var err error
 	if endpoint != DefaultGitHubEndpointURL {
 		if ghc, err = ghc.WithEnterpriseURLs(endpoint, endpoint); err != nil {
 			return nil, fmt.Errorf("failed to create github client with enterprise endpoint %s: %w", endpoint, err)
 		}
 	}
-	return NewTeamReadWriter(s, ghc, orgTeamSSORequired), nil
+	return NewTeamReadWriter(s, ghc, orgTeamSSORequired, opts...), nil
+}
+
+// orgOAuth2TokenSource adapts an OrgTokenSource to an oauth2.TokenSource
+// returning the token of an org. The tokens expire immediately so that the
+// OrgTokenSource decides when to mint a new one.
+type orgOAuth2TokenSource struct {
+	ctx    context.Context //nolint:containedctx // oauth2.TokenSource has no context
+	source OrgTokenSource
+	orgID  int64
+}
+
+func (s *orgOAuth2TokenSource) Token() (*oauth2.Token, error) {
+	token, err := s.source.TokenForOrg(s.ctx, s.orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token for org %d: %w", s.orgID, err)
+	}
+	return &oauth2.Token{AccessToken: token, Expiry: time.Now()}, nil
 }
@@ -0,0 +1,158 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+// summaryCommentMarker marks the comments posted by IssueCommenter, so that
+// later runs update their comment instead of adding another.
+const summaryCommentMarker = "<!-- team-link-sync-summary -->"
+
+var issueRefPattern = regexp.MustCompile(`^([A-Za-z0-9_.-]+)/([A-Za-z0-9_.-]+)#([0-9]+)$`)
+
+// IssueRef identifies a GitHub issue or pull request.
+type IssueRef struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+func (r *IssueRef) String() string {
+	return fmt.Sprintf("%s/%s#%d", r.Owner, r.Repo, r.Number)
+}
+
+// ParseIssueRef parses an issue or pull request of the form OWNER/REPO#NUMBER.
+func ParseIssueRef(s string) (*IssueRef, error) {
+	m := issueRefPattern.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("issue %q must be of the form OWNER/REPO#NUMBER", s)
+	}
+	number, err := strconv.Atoi(m[3])
+	if err != nil || number <= 0 {
+		return nil, fmt.Errorf("invalid issue number in %q", s)
+	}
+	return &IssueRef{Owner: m[1], Repo: m[2], Number: number}, nil
+}
+
+// PullRequestFromEnv returns the pull request which triggered the GitHub
+// Actions workflow run, read from the GITHUB_REPOSITORY variable and the
+// event payload at GITHUB_EVENT_PATH.
+func PullRequestFromEnv(getenv func(string) string) (*IssueRef, error) {
+	owner, repo, ok := strings.Cut(getenv("GITHUB_REPOSITORY"), "/")
+	if !ok {
+		return nil, fmt.Errorf("GITHUB_REPOSITORY is not set, not running in GitHub Actions")
+	}
+	path := getenv("GITHUB_EVENT_PATH")
+	if path == "" {
+		return nil, fmt.Errorf("GITHUB_EVENT_PATH is not set, not running in GitHub Actions")
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workflow event: %w", err)
+	}
+	var event struct {
+		Number      int `json:"number"`
+		PullRequest *struct {
+			Number int `json:"number"`
+		} `json:"pull_request"`
+	}
+	if err := json.Unmarshal(b, &event); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow event: %w", err)
+	}
+	if event.PullRequest == nil {
+		return nil, fmt.Errorf("workflow was not triggered by a pull request")
+	}
+	number := event.PullRequest.Number
+	if number == 0 {
+		number = event.Number
+	}
+	return &IssueRef{Owner: owner, Repo: repo, Number: number}, nil
+}
+
+// IssueCommenter keeps a comment with a report, e.g. a sync summary, on a
+// GitHub issue or pull request up to date.
+type IssueCommenter struct {
+	client   *github.Client
+	pageSize int
+}
+
+// NewIssueCommenter creates an IssueCommenter using the given client, which
+// needs permission to write issues or pull requests of the repository.
+func NewIssueCommenter(client *github.Client) *IssueCommenter {
+	return &IssueCommenter{client: client, pageSize: DefaultPageSize}
+}
+
+// NewIssueCommenterWithToken creates an IssueCommenter calling the API at
+// apiURL, or GitHub.com if it is empty, with the given token, e.g. the
+// GITHUB_TOKEN of a workflow run.
+func NewIssueCommenterWithToken(token, apiURL string) (*IssueCommenter, error) {
+	client := github.NewClient(nil).WithAuthToken(token)
+	if apiURL != "" {
+		var err error
+		if client, err = client.WithEnterpriseURLs(apiURL, apiURL); err != nil {
+			return nil, fmt.Errorf("failed to create github client with enterprise endpoint %s: %w", apiURL, err)
+		}
+	}
+	return NewIssueCommenter(client), nil
+}
+
+// Post posts body as a comment on the issue or pull request. A comment
+// posted by an earlier call is updated instead, so that a pull request shows
+// the report of its latest revision only.
+func (c *IssueCommenter) Post(ctx context.Context, ref *IssueRef, body string) error {
+	body = summaryCommentMarker + "\n" + body
+
+	var existing *github.IssueComment
+	if err := paginate(c.pageSize, func(opts *github.ListOptions) (int, *github.Response, error) {
+		page, resp, err := c.client.Issues.ListComments(ctx, ref.Owner, ref.Repo, ref.Number, &github.IssueListCommentsOptions{ListOptions: *opts})
+		if err != nil {
+			return 0, resp, fmt.Errorf("failed to list comments: %w", err)
+		}
+		for _, comment := range page {
+			if existing == nil && strings.HasPrefix(comment.GetBody(), summaryCommentMarker) {
+				existing = comment
+			}
+		}
+		return len(page), resp, nil
+	}); err != nil {
+		return fmt.Errorf("could not list comments of %s: %w", ref, classifyErr(err))
+	}
+
+	logger := logging.FromContext(ctx)
+	if existing != nil {
+		logger.InfoContext(ctx, "updating comment", "issue", ref.String(), "comment_id", existing.GetID())
+		if _, _, err := c.client.Issues.EditComment(ctx, ref.Owner, ref.Repo, existing.GetID(), &github.IssueComment{Body: &body}); err != nil {
+			return fmt.Errorf("failed to update comment %d of %s: %w", existing.GetID(), ref, classifyErr(err))
+		}
+		return nil
+	}
+	logger.InfoContext(ctx, "adding comment", "issue", ref.String())
+	if _, _, err := c.client.Issues.CreateComment(ctx, ref.Owner, ref.Repo, ref.Number, &github.IssueComment{Body: &body}); err != nil {
+		return fmt.Errorf("failed to comment on %s: %w", ref, classifyErr(err))
+	}
+	return nil
+}
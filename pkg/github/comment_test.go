@@ -0,0 +1,198 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestParseIssueRef(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		in      string
+		want    *IssueRef
+		wantErr string
+	}{
+		{
+			name: "success",
+			in:   "abcxyz/team-link#42",
+			want: &IssueRef{Owner: "abcxyz", Repo: "team-link", Number: 42},
+		},
+		{
+			name:    "missing_number",
+			in:      "abcxyz/team-link",
+			wantErr: "must be of the form OWNER/REPO#NUMBER",
+		},
+		{
+			name:    "zero_number",
+			in:      "abcxyz/team-link#0",
+			wantErr: "invalid issue number",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseIssueRef(tc.in)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected ref (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPullRequestFromEnv(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	prEvent := filepath.Join(dir, "pr.json")
+	if err := os.WriteFile(prEvent, []byte(`{"number": 7, "pull_request": {"number": 7}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	pushEvent := filepath.Join(dir, "push.json")
+	if err := os.WriteFile(pushEvent, []byte(`{"ref": "refs/heads/main"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name    string
+		env     map[string]string
+		want    *IssueRef
+		wantErr string
+	}{
+		{
+			name: "pull_request",
+			env:  map[string]string{"GITHUB_REPOSITORY": "abcxyz/config", "GITHUB_EVENT_PATH": prEvent},
+			want: &IssueRef{Owner: "abcxyz", Repo: "config", Number: 7},
+		},
+		{
+			name:    "push",
+			env:     map[string]string{"GITHUB_REPOSITORY": "abcxyz/config", "GITHUB_EVENT_PATH": pushEvent},
+			wantErr: "not triggered by a pull request",
+		},
+		{
+			name:    "not_actions",
+			env:     map[string]string{},
+			wantErr: "not running in GitHub Actions",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := PullRequestFromEnv(func(key string) string { return tc.env[key] })
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected ref (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIssueCommenter_Post(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeComments{comments: []*github.IssueComment{
+		{ID: github.Int64(1), Body: github.String("LGTM")},
+	}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	commenter := NewIssueCommenter(githubClient(server))
+	ref := &IssueRef{Owner: "abcxyz", Repo: "config", Number: 7}
+
+	if err := commenter.Post(ctx, ref, "first"); err != nil {
+		t.Fatalf("Post failed: %v", err)
+	}
+	if err := commenter.Post(ctx, ref, "second"); err != nil {
+		t.Fatalf("Post failed: %v", err)
+	}
+
+	if diff := cmp.Diff(fake.bodies(), []string{"LGTM", summaryCommentMarker + "\nsecond"}); diff != "" {
+		t.Errorf("unexpected comments (-got, +want):\n%s", diff)
+	}
+}
+
+// fakeComments implements the issue comment endpoints of the GitHub API for
+// the issue abcxyz/config#7.
+type fakeComments struct {
+	mu       sync.Mutex
+	comments []*github.IssueComment
+}
+
+func (f *fakeComments) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var comment github.IssueComment
+	if r.Body != nil {
+		json.NewDecoder(r.Body).Decode(&comment) //nolint:errcheck // empty for GET
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/repos/abcxyz/config/issues/7/comments":
+		json.NewEncoder(w).Encode(f.comments) //nolint:errcheck // test server
+	case r.Method == http.MethodPost && r.URL.Path == "/repos/abcxyz/config/issues/7/comments":
+		comment.ID = github.Int64(int64(len(f.comments) + 1))
+		f.comments = append(f.comments, &comment)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(&comment) //nolint:errcheck // test server
+	case r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, "/repos/abcxyz/config/issues/comments/"):
+		id, _ := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/repos/abcxyz/config/issues/comments/"), 10, 64)
+		for _, c := range f.comments {
+			if c.GetID() == id {
+				c.Body = comment.Body
+				json.NewEncoder(w).Encode(c) //nolint:errcheck // test server
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		http.Error(w, fmt.Sprintf("unexpected request %s %s", r.Method, r.URL.Path), http.StatusNotFound)
+	}
+}
+
+func (f *fakeComments) bodies() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	bodies := make([]string, 0, len(f.comments))
+	for _, c := range f.comments {
+		bodies = append(bodies, c.GetBody())
+	}
+	return bodies
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+// APICost is the rate limit cost of requests to the GitHub API.
+type APICost struct {
+	// REST is the number of REST requests, each of which costs a point of the
+	// primary rate limit.
+	REST int
+	// RESTWrites is the number of REST requests which are not reads. The
+	// secondary rate limits charge 5 points for each of them.
+	RESTWrites int
+	// GraphQL is the number of points of the GraphQL rate limit spent, as
+	// reported by the queries.
+	GraphQL int
+}
+
+func (c *APICost) add(other APICost) {
+	c.REST += other.REST
+	c.RESTWrites += other.RESTWrites
+	c.GraphQL += other.GraphQL
+}
+
+// GroupCost is the rate limit cost of the requests made for a team.
+type GroupCost struct {
+	// GroupID is the ID of the team, or "" for the requests not made for a
+	// team, e.g. user lookups of source users.
+	GroupID string
+	APICost
+}
+
+// CostTracker accounts the rate limit cost of the requests a TeamReadWriter
+// makes, per team, e.g. to find out which mappings are expensive and should
+// be synced by another GitHub App installation. See WithCostTracker.
+type CostTracker struct {
+	mu    sync.Mutex
+	costs map[string]*APICost
+}
+
+// NewCostTracker creates an empty CostTracker.
+func NewCostTracker() *CostTracker {
+	return &CostTracker{costs: make(map[string]*APICost)}
+}
+
+// WithCostTracker accounts the rate limit cost of the requests of the
+// TeamReadWriter in tracker.
+func WithCostTracker(tracker *CostTracker) Opt {
+	return func(config *Config) {
+		config.costs = tracker
+	}
+}
+
+// Groups returns the costs per team, sorted by team ID. The requests not
+// made for a team come first.
+func (t *CostTracker) Groups() []*GroupCost {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	groups := make([]*GroupCost, 0, len(t.costs))
+	for _, id := range utils.MapKeys(t.costs) {
+		groups = append(groups, &GroupCost{GroupID: id, APICost: *t.costs[id]})
+	}
+	return groups
+}
+
+// Total returns the cost of all requests.
+func (t *CostTracker) Total() APICost {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var total APICost
+	for _, c := range t.costs {
+		total.add(*c)
+	}
+	return total
+}
+
+// LogStats logs the cost of each team and the total cost.
+func (t *CostTracker) LogStats(ctx context.Context) {
+	logger := logging.FromContext(ctx)
+	for _, g := range t.Groups() {
+		logger.InfoContext(ctx, "github api cost",
+			"group_id", g.GroupID,
+			"rest_requests", g.REST,
+			"rest_writes", g.RESTWrites,
+			"graphql_points", g.GraphQL,
+		)
+	}
+	total := t.Total()
+	logger.InfoContext(ctx, "github api cost of run",
+		"rest_requests", total.REST,
+		"rest_writes", total.RESTWrites,
+		"graphql_points", total.GraphQL,
+	)
+}
+
+// add adds cost to the team of the context. It does nothing on a nil
+// tracker.
+func (t *CostTracker) add(ctx context.Context, cost APICost) {
+	if t == nil {
+		return
+	}
+	groupID, _ := ctx.Value(costGroupKey{}).(string)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	c, ok := t.costs[groupID]
+	if !ok {
+		c = &APICost{}
+		t.costs[groupID] = c
+	}
+	c.add(cost)
+}
+
+// transport wraps base, or http.DefaultTransport if it is nil, to account
+// REST requests. GraphQL queries are accounted by the points they report.
+func (t *CostTracker) transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if !strings.HasSuffix(req.URL.Path, "/graphql") {
+			cost := APICost{REST: 1}
+			if req.Method != http.MethodGet && req.Method != http.MethodHead {
+				cost.RESTWrites = 1
+			}
+			t.add(req.Context(), cost)
+		}
+		return base.RoundTrip(req) //nolint:wrapcheck // Want passthrough
+	})
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type costGroupKey struct{}
+
+// withCostGroup returns a context whose requests are accounted to the team
+// with the given ID.
+func withCostGroup(ctx context.Context, groupID string) context.Context {
+	return context.WithValue(ctx, costGroupKey{}, groupID)
+}
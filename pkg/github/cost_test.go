@@ -0,0 +1,85 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v61/github"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/abcxyz/team-link/pkg/githubtest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestCostTracker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := githubtest.NewBuilder().
+		WithOrg(8583, "org1").
+		WithUser(&github.User{ID: proto.Int64(1), Login: proto.String("alice")}).
+		WithUser(&github.User{ID: proto.Int64(2), Login: proto.String("bob")}).
+		WithTeam(8583, &github.Team{ID: proto.Int64(1), Slug: proto.String("team1")}, "alice").
+		WithTeam(8583, &github.Team{ID: proto.Int64(2), Slug: proto.String("team2")}).
+		WithSAMLIdentity(8583, "alice@example.com", "alice").
+		Start()
+	t.Cleanup(server.Close)
+
+	costs := NewCostTracker()
+	rw := NewTeamReadWriter(NewStaticTokenSource("token"), server.Client(), nil, WithCostTracker(costs))
+
+	if _, err := rw.GetMembers(ctx, "8583:2"); err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if err := rw.SetMembers(ctx, "8583:1", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "alice"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob"}},
+	}); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	if _, err := rw.SAMLIdentities(ctx, 8583); err != nil {
+		t.Fatalf("SAMLIdentities failed: %v", err)
+	}
+
+	groups := costs.Groups()
+	if diff := cmp.Diff(groupIDs(groups), []string{"", "8583:1", "8583:2"}); diff != "" {
+		t.Errorf("unexpected groups (-got, +want):\n%s", diff)
+	}
+	if got, want := groups[0].GraphQL, 1; got != want {
+		t.Errorf("got %d graphql points without a team, want %d", got, want)
+	}
+	if got, want := groups[1].RESTWrites, server.Writes(); got != want {
+		t.Errorf("got %d rest writes to team 8583:1, want %d", got, want)
+	}
+	if groups[2].REST == 0 || groups[2].RESTWrites != 0 {
+		t.Errorf("got cost %+v of reading team 8583:2, want reads only", groups[2].APICost)
+	}
+
+	total := costs.Total()
+	if got, want := total.REST, groups[0].REST+groups[1].REST+groups[2].REST; got != want {
+		t.Errorf("got %d rest requests in total, want %d", got, want)
+	}
+}
+
+func groupIDs(groups []*GroupCost) []string {
+	ids := make([]string, 0, len(groups))
+	for _, g := range groups {
+		ids = append(ids, g.GroupID)
+	}
+	return ids
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+// MaxEnvironmentReviewers is the maximum number of required reviewers of a
+// deployment environment.
+const MaxEnvironmentReviewers = 6
+
+// EnvironmentReviewersChange is a change of the required reviewers of a
+// deployment environment. Reviewers are "team:<id>" or "user:<id>".
+type EnvironmentReviewersChange struct {
+	Repo        string
+	Environment string
+	Add         []string
+	Remove      []string
+}
+
+// SyncEnvironmentReviewers makes the teams with the given IDs the required
+// reviewers of the deployment environment with the given name of the
+// repository with the given name, in the org with the given ID. Other teams
+// and users are removed from the reviewers, while the other protection rules
+// of the environment, e.g. its wait timer, are kept. The environment must
+// exist. The change is returned, or nil if the teams already are the
+// reviewers, and only made unless dryRun is set.
+func (g *TeamReadWriter) SyncEnvironmentReviewers(ctx context.Context, orgID int64, repo, environment string, teamIDs []int64, dryRun bool) (*EnvironmentReviewersChange, error) {
+	if len(teamIDs) > MaxEnvironmentReviewers {
+		return nil, fmt.Errorf("environment %s of repo %s can have at most %d reviewers, got %d teams",
+			environment, repo, MaxEnvironmentReviewers, len(teamIDs))
+	}
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get github client: %w", err)
+	}
+	login, err := g.orgLogin(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+	env, _, err := client.Repositories.GetEnvironment(ctx, login, repo, environment)
+	if err != nil {
+		return nil, fmt.Errorf("could not get environment %s of repo %s: %w", environment, repo, classifyErr(err))
+	}
+
+	// the environment is replaced as a whole, so its other settings are sent
+	// back unchanged.
+	update := &github.CreateUpdateEnvironment{
+		CanAdminsBypass:        env.CanAdminsBypass,
+		DeploymentBranchPolicy: env.DeploymentBranchPolicy,
+	}
+	current := make(map[string]struct{})
+	for _, rule := range env.ProtectionRules {
+		switch rule.GetType() {
+		case "wait_timer":
+			update.WaitTimer = rule.WaitTimer
+		case "required_reviewers":
+			update.PreventSelfReview = rule.PreventSelfReview
+			for _, r := range rule.Reviewers {
+				current[reviewerKey(r)] = struct{}{}
+			}
+		}
+	}
+	want := make(map[string]struct{}, len(teamIDs))
+	for _, id := range teamIDs {
+		want["team:"+strconv.FormatInt(id, 10)] = struct{}{}
+		update.Reviewers = append(update.Reviewers, &github.EnvReviewers{Type: github.String("Team"), ID: github.Int64(id)})
+	}
+
+	change := &EnvironmentReviewersChange{
+		Repo:        repo,
+		Environment: environment,
+		Add:         utils.MapKeys(sets.SubtractMapKeys(want, current)),
+		Remove:      utils.MapKeys(sets.SubtractMapKeys(current, want)),
+	}
+	if len(change.Add) == 0 && len(change.Remove) == 0 {
+		return nil, nil
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "setting environment reviewers",
+		"org_id", orgID,
+		"repo", repo,
+		"environment", environment,
+		"add_reviewers", change.Add,
+		"remove_reviewers", change.Remove,
+		"dry_run", dryRun,
+	)
+	if dryRun {
+		return change, nil
+	}
+	if _, _, err := client.Repositories.CreateUpdateEnvironment(ctx, login, repo, environment, update); err != nil {
+		return change, fmt.Errorf("could not set reviewers of environment %s of repo %s: %w", environment, repo, classifyErr(err))
+	}
+	return change, nil
+}
+
+// reviewerKey identifies a required reviewer as "team:<id>" or "user:<id>".
+func reviewerKey(r *github.RequiredReviewer) string {
+	switch reviewer := r.Reviewer.(type) {
+	case *github.Team:
+		return "team:" + strconv.FormatInt(reviewer.GetID(), 10)
+	case *github.User:
+		return "user:" + strconv.FormatInt(reviewer.GetID(), 10)
+	}
+	return r.GetType()
+}
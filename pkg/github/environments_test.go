@@ -0,0 +1,172 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/githubtest"
+)
+
+func TestTeamReadWriter_SyncEnvironmentReviewers(t *testing.T) {
+	t.Parallel()
+
+	production := &github.Environment{
+		Name:                   github.String("production"),
+		CanAdminsBypass:        github.Bool(true),
+		DeploymentBranchPolicy: &github.BranchPolicy{ProtectedBranches: github.Bool(true), CustomBranchPolicies: github.Bool(false)},
+		ProtectionRules: []*github.ProtectionRule{
+			{Type: github.String("wait_timer"), WaitTimer: github.Int(30)},
+			{
+				Type:              github.String("required_reviewers"),
+				PreventSelfReview: github.Bool(true),
+				Reviewers: []*github.RequiredReviewer{
+					{Type: github.String("Team"), Reviewer: &github.Team{ID: github.Int64(2)}},
+					{Type: github.String("User"), Reviewer: &github.User{ID: github.Int64(42)}},
+				},
+			},
+		},
+	}
+	// the settings of the environment which must be kept.
+	unchanged := envSettings{waitTimer: 30, preventSelfReview: true, canAdminsBypass: true, protectedBranches: true}
+
+	cases := []struct {
+		name        string
+		environment string
+		teamIDs     []int64
+		dryRun      bool
+		wantChange  *EnvironmentReviewersChange
+		wantEnv     envSettings
+		wantErr     string
+	}{
+		{
+			name:        "success",
+			environment: "production",
+			teamIDs:     []int64{1, 3},
+			wantChange: &EnvironmentReviewersChange{
+				Repo:        "svc-api",
+				Environment: "production",
+				Add:         []string{"team:1", "team:3"},
+				Remove:      []string{"team:2", "user:42"},
+			},
+			wantEnv: unchanged.withReviewers("team:1", "team:3"),
+		},
+		{
+			name:        "dry_run",
+			environment: "production",
+			teamIDs:     []int64{1},
+			dryRun:      true,
+			wantChange: &EnvironmentReviewersChange{
+				Repo:        "svc-api",
+				Environment: "production",
+				Add:         []string{"team:1"},
+				Remove:      []string{"team:2", "user:42"},
+			},
+			wantEnv: unchanged.withReviewers("team:2", "user:42"),
+		},
+		{
+			name:        "unchanged",
+			environment: "staging",
+			teamIDs:     []int64{1},
+			wantEnv:     envSettings{reviewers: []string{"team:1"}},
+		},
+		{
+			name:        "too_many_teams",
+			environment: "production",
+			teamIDs:     []int64{1, 2, 3, 4, 5, 6, 7},
+			wantErr:     "can have at most 6 reviewers, got 7 teams",
+			wantEnv:     unchanged.withReviewers("team:2", "user:42"),
+		},
+		{
+			name:        "unknown_environment",
+			environment: "qa",
+			teamIDs:     []int64{1},
+			wantErr:     "could not get environment qa of repo svc-api",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := githubtest.NewBuilder().
+				WithOrg(8583, "org1").
+				WithRepo(8583, &github.Repository{Name: github.String("svc-api")}).
+				WithEnvironment(8583, "svc-api", production).
+				WithEnvironment(8583, "svc-api", &github.Environment{
+					Name: github.String("staging"),
+					ProtectionRules: []*github.ProtectionRule{{
+						Type:      github.String("required_reviewers"),
+						Reviewers: []*github.RequiredReviewer{{Type: github.String("Team"), Reviewer: &github.Team{ID: github.Int64(1)}}},
+					}},
+				})
+			for id := int64(1); id <= 7; id++ {
+				builder.WithTeam(8583, &github.Team{ID: github.Int64(id)})
+			}
+			server := builder.Start()
+			t.Cleanup(server.Close)
+			tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token"}}
+
+			rw := NewTeamReadWriter(tokenSource, server.Client(), nil)
+			got, err := rw.SyncEnvironmentReviewers(context.Background(), 8583, "svc-api", tc.environment, tc.teamIDs, tc.dryRun)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.wantChange); diff != "" {
+				t.Errorf("unexpected change (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(settingsOf(server.Environment(8583, "svc-api", tc.environment)), tc.wantEnv, cmp.AllowUnexported(envSettings{})); diff != "" {
+				t.Errorf("unexpected environment (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// envSettings are the settings of an environment compared by tests.
+type envSettings struct {
+	reviewers         []string
+	waitTimer         int
+	preventSelfReview bool
+	canAdminsBypass   bool
+	protectedBranches bool
+}
+
+func (s envSettings) withReviewers(reviewers ...string) envSettings {
+	s.reviewers = reviewers
+	return s
+}
+
+func settingsOf(env *github.Environment) envSettings {
+	if env == nil {
+		return envSettings{}
+	}
+	s := envSettings{
+		canAdminsBypass:   env.GetCanAdminsBypass(),
+		protectedBranches: env.GetDeploymentBranchPolicy().GetProtectedBranches(),
+	}
+	for _, rule := range env.ProtectionRules {
+		s.waitTimer += rule.GetWaitTimer()
+		s.preventSelfReview = s.preventSelfReview || rule.GetPreventSelfReview()
+		for _, r := range rule.Reviewers {
+			s.reviewers = append(s.reviewers, reviewerKey(r))
+		}
+	}
+	return s
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+// Roles of org invitations which can be sent again as is. Other roles, e.g.
+// "reinstate", are sent as "direct_member".
+const (
+	invitationRoleAdmin          = "admin"
+	invitationRoleBillingManager = "billing_manager"
+	invitationRoleDirectMember   = "direct_member"
+)
+
+// ListInvitations retrieves the pending invitations of the GitHub org with the
+// given ID.
+func (g *TeamReadWriter) ListInvitations(ctx context.Context, orgID int64) ([]*github.Invitation, error) {
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get github client: %w", err)
+	}
+	login, err := g.orgLogin(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "listing pending invitations", "org_id", orgID)
+	var invitations []*github.Invitation
+	if err := paginate(g.pageSize, func(opts *github.ListOptions) (int, *github.Response, error) {
+		page, resp, err := client.Organizations.ListPendingOrgInvitations(ctx, login, opts)
+		if err != nil {
+			return 0, resp, fmt.Errorf("failed to list pending invitations: %w", err)
+		}
+		invitations = append(invitations, page...)
+		return len(page), resp, nil
+	}); err != nil {
+		return nil, fmt.Errorf("could not list invitations for org %d: %w", orgID, classifyErr(err))
+	}
+	return invitations, nil
+}
+
+// CancelInvitation cancels the pending invitation with the given ID to the
+// GitHub org with the given ID.
+func (g *TeamReadWriter) CancelInvitation(ctx context.Context, orgID, invitationID int64) error {
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("could not get github client: %w", err)
+	}
+	login, err := g.orgLogin(ctx, client, orgID)
+	if err != nil {
+		return err
+	}
+	// go-github has no method for this endpoint.
+	req, err := client.NewRequest(http.MethodDelete, fmt.Sprintf("orgs/%v/invitations/%v", login, invitationID), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if _, err := client.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("could not cancel invitation %d to org %d: %w", invitationID, orgID, classifyErr(err))
+	}
+	return nil
+}
+
+// ResendInvitation sends the given pending invitation to the GitHub org with
+// the given ID again, which resets its expiry. GitHub has no endpoint for
+// this, so the invitation is cancelled and a new one is created for the same
+// invitee, role and teams. The new invitation is returned.
+func (g *TeamReadWriter) ResendInvitation(ctx context.Context, orgID int64, invitation *github.Invitation) (*github.Invitation, error) {
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get github client: %w", err)
+	}
+	login, err := g.orgLogin(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &github.CreateOrgInvitationOptions{
+		Role: github.String(invitationRoleDirectMember),
+	}
+	switch role := invitation.GetRole(); role {
+	case invitationRoleAdmin, invitationRoleBillingManager:
+		opts.Role = github.String(role)
+	}
+	if invitation.GetLogin() != "" {
+		user, err := g.getGitHubUser(ctx, client, invitation.GetLogin())
+		if err != nil {
+			return nil, fmt.Errorf("could not get invitee: %w", classifyErr(err))
+		}
+		opts.InviteeID = user.ID
+	} else {
+		opts.Email = invitation.Email
+	}
+	invitationID := strconv.FormatInt(invitation.GetID(), 10)
+	if err := paginate(g.pageSize, func(listOpts *github.ListOptions) (int, *github.Response, error) {
+		teams, resp, err := client.Organizations.ListOrgInvitationTeams(ctx, login, invitationID, listOpts)
+		if err != nil {
+			return 0, resp, fmt.Errorf("failed to list invitation teams: %w", err)
+		}
+		for _, team := range teams {
+			opts.TeamID = append(opts.TeamID, team.GetID())
+		}
+		return len(teams), resp, nil
+	}); err != nil {
+		return nil, fmt.Errorf("could not get teams of invitation %d: %w", invitation.GetID(), classifyErr(err))
+	}
+
+	if err := g.CancelInvitation(ctx, orgID, invitation.GetID()); err != nil {
+		return nil, err
+	}
+	created, _, err := client.Organizations.CreateOrgInvitation(ctx, login, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create invitation to org %d after cancelling invitation %d: %w", orgID, invitation.GetID(), classifyErr(err))
+	}
+	return created, nil
+}
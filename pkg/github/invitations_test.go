@@ -0,0 +1,169 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestTeamReadWriter_Invitations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeInvitations{
+		invitations: []*github.Invitation{
+			{ID: github.Int64(1), Login: github.String("user1"), Role: github.String("direct_member")},
+			{ID: github.Int64(2), Email: github.String("user2@example.com"), Role: github.String("reinstate")},
+			{ID: github.Int64(3), Login: github.String("user3"), Role: github.String("admin")},
+		},
+		teams:  map[int64][]int64{1: {2797, 9350}},
+		nextID: 100,
+	}
+	server := httptest.NewServer(fake.handler())
+	t.Cleanup(server.Close)
+
+	tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token"}}
+	groupRW := NewTeamReadWriter(tokenSource, githubClient(server), nil)
+
+	got, err := groupRW.ListInvitations(ctx, 8583)
+	if err != nil {
+		t.Fatalf("ListInvitations failed: %v", err)
+	}
+	if diff := cmp.Diff(invitationIDs(got), []int64{1, 2, 3}); diff != "" {
+		t.Errorf("unexpected invitations (-got, +want):\n%s", diff)
+	}
+
+	if err := groupRW.CancelInvitation(ctx, 8583, 3); err != nil {
+		t.Fatalf("CancelInvitation failed: %v", err)
+	}
+
+	resent, err := groupRW.ResendInvitation(ctx, 8583, got[0])
+	if err != nil {
+		t.Fatalf("ResendInvitation(login) failed: %v", err)
+	}
+	if diff := cmp.Diff(fake.created[0], &github.CreateOrgInvitationOptions{
+		InviteeID: github.Int64(4701),
+		Role:      github.String("direct_member"),
+		TeamID:    []int64{2797, 9350},
+	}); diff != "" {
+		t.Errorf("unexpected invitation created (-got, +want):\n%s", diff)
+	}
+	if _, err := groupRW.ResendInvitation(ctx, 8583, got[1]); err != nil {
+		t.Fatalf("ResendInvitation(email) failed: %v", err)
+	}
+	if diff := cmp.Diff(fake.created[1], &github.CreateOrgInvitationOptions{
+		Email: github.String("user2@example.com"),
+		Role:  github.String("direct_member"),
+	}); diff != "" {
+		t.Errorf("unexpected invitation created (-got, +want):\n%s", diff)
+	}
+
+	got, err = groupRW.ListInvitations(ctx, 8583)
+	if err != nil {
+		t.Fatalf("ListInvitations failed: %v", err)
+	}
+	if diff := cmp.Diff(invitationIDs(got), []int64{resent.GetID(), resent.GetID() + 1}); diff != "" {
+		t.Errorf("unexpected invitations after cancelling and resending (-got, +want):\n%s", diff)
+	}
+
+	err = groupRW.CancelInvitation(ctx, 8583, 3)
+	if diff := testutil.DiffErrString(err, "could not cancel invitation 3 to org 8583"); diff != "" {
+		t.Errorf("unexpected error: %s", diff)
+	}
+}
+
+func invitationIDs(invitations []*github.Invitation) []int64 {
+	ids := make([]int64, 0, len(invitations))
+	for _, i := range invitations {
+		ids = append(ids, i.GetID())
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeInvitations implements the invitation endpoints of the GitHub API for
+// org 8583 "org1", in which the user "user1" has the ID 4701.
+type fakeInvitations struct {
+	mu          sync.Mutex
+	invitations []*github.Invitation
+	teams       map[int64][]int64
+	created     []*github.CreateOrgInvitationOptions
+	nextID      int64
+}
+
+func (f *fakeInvitations) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /organizations/8583", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&github.Organization{ID: github.Int64(8583), Login: github.String("org1")}) //nolint:errcheck // test server
+	})
+	mux.HandleFunc("GET /users/user1", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&github.User{ID: github.Int64(4701), Login: github.String("user1")}) //nolint:errcheck // test server
+	})
+	mux.HandleFunc("GET /orgs/org1/invitations", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		json.NewEncoder(w).Encode(f.invitations) //nolint:errcheck // test server
+	})
+	mux.HandleFunc("GET /orgs/org1/invitations/{id}/teams", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		id, _ := strconv.ParseInt(r.PathValue("id"), 10, 64)
+		teams := make([]*github.Team, 0, len(f.teams[id]))
+		for _, teamID := range f.teams[id] {
+			teams = append(teams, &github.Team{ID: github.Int64(teamID)})
+		}
+		json.NewEncoder(w).Encode(teams) //nolint:errcheck // test server
+	})
+	mux.HandleFunc("DELETE /orgs/org1/invitations/{id}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		id, _ := strconv.ParseInt(r.PathValue("id"), 10, 64)
+		i := slices.IndexFunc(f.invitations, func(i *github.Invitation) bool { return i.GetID() == id })
+		if i < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		f.invitations = slices.Delete(f.invitations, i, i+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("POST /orgs/org1/invitations", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		var opts github.CreateOrgInvitationOptions
+		if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.created = append(f.created, &opts)
+		invitation := &github.Invitation{ID: github.Int64(f.nextID), Email: opts.Email, Role: opts.Role}
+		f.nextID++
+		f.invitations = append(f.invitations, invitation)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(invitation) //nolint:errcheck // test server
+	})
+	return mux
+}
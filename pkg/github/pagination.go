@@ -20,26 +20,46 @@ import (
 	"github.com/google/go-github/v61/github"
 )
 
+// DefaultPageSize is the default number of items requested per page of a
+// list call, the maximum GitHub allows.
+const DefaultPageSize = 100
+
 // paginate is a helper function that iterates through a series of
 // well-structured GitHub responses by continuously invoking `f` for each
 // `NextPage` token. It is the caller's responsibility to capture any values
 // inside the closer (e.g. append to a slice or map); this function does not
-// accumulate responses.
-func paginate(f func(opts *github.ListOptions) (*github.Response, error)) error {
+// accumulate responses. `f` returns the number of items on the page.
+//
+// Listing stops only on a page without a next page. A full page of a
+// response without a Link header, e.g. from a proxy that drops it, is
+// followed by a request for the next page rather than taken as the last
+// one, since a truncated listing of team members would remove members.
+func paginate(perPage int, f func(opts *github.ListOptions) (int, *github.Response, error)) error {
 	opts := &github.ListOptions{
-		PerPage: 100,
+		Page:    1,
+		PerPage: perPage,
 	}
 
 	for {
-		resp, err := f(opts)
+		n, resp, err := f(opts)
 		if err != nil {
-			return fmt.Errorf("failed to paginate: %w", err)
+			return fmt.Errorf("failed to paginate page %d: %w", opts.Page, err)
+		}
+		if resp == nil {
+			break
 		}
 
-		if resp == nil || resp.NextPage == 0 {
+		next := resp.NextPage
+		if next == 0 && n >= perPage && (resp.Response == nil || resp.Header.Get("Link") == "") {
+			next = opts.Page + 1
+		}
+		if next == 0 || n == 0 {
 			break
 		}
-		opts.Page = resp.NextPage
+		if next <= opts.Page {
+			return fmt.Errorf("failed to paginate: next page %d does not advance past page %d", next, opts.Page)
+		}
+		opts.Page = next
 	}
 
 	return nil
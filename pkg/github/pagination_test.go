@@ -0,0 +1,94 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	withLink := &http.Response{Header: http.Header{"Link": {`<https://api.github.com/x?page=2>; rel="last"`}}}
+
+	cases := []struct {
+		name      string
+		perPage   int
+		pages     []int
+		nextPages []int
+		response  *http.Response
+		wantPages []int
+		wantErr   string
+	}{
+		{
+			name:      "follows_next_page",
+			perPage:   2,
+			pages:     []int{2, 2, 1},
+			nextPages: []int{2, 3, 0},
+			response:  withLink,
+			wantPages: []int{1, 2, 3},
+		},
+		{
+			name:      "full_page_without_link_header",
+			perPage:   2,
+			pages:     []int{2, 2, 0},
+			nextPages: []int{0, 0, 0},
+			response:  &http.Response{},
+			wantPages: []int{1, 2, 3},
+		},
+		{
+			name:      "full_page_with_link_header",
+			perPage:   2,
+			pages:     []int{2},
+			nextPages: []int{0},
+			response:  withLink,
+			wantPages: []int{1},
+		},
+		{
+			name:      "next_page_does_not_advance",
+			perPage:   2,
+			pages:     []int{2, 2},
+			nextPages: []int{2, 2},
+			response:  withLink,
+			wantPages: []int{1, 2},
+			wantErr:   "next page 2 does not advance past page 2",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotPages []int
+			err := paginate(tc.perPage, func(opts *github.ListOptions) (int, *github.Response, error) {
+				i := len(gotPages)
+				gotPages = append(gotPages, opts.Page)
+				return tc.pages[i], &github.Response{Response: tc.response, NextPage: tc.nextPages[i]}, nil
+			})
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(gotPages, tc.wantPages); diff != "" {
+				t.Errorf("unexpected pages (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
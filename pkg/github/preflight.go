@@ -0,0 +1,84 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+const (
+	// scopesHeader lists the scopes of personal access tokens (classic) and
+	// OAuth app tokens. It is absent for other tokens.
+	scopesHeader = "X-OAuth-Scopes"
+
+	// scopeAdminOrg is the scope personal access tokens (classic) need to
+	// manage team members and invite users to orgs.
+	scopeAdminOrg = "admin:org"
+)
+
+// CheckWritePermissions verifies that a token can be obtained for the org of
+// each given team and that it can read the org. Personal access tokens
+// (classic) must also have the admin:org scope. GitHub App installation tokens
+// are requested with the members:write permission, so obtaining one verifies
+// it. Fine-grained personal access tokens do not report their permissions and
+// are not verified further. The IDs must be of the form 'orgID:teamID'.
+func (g *TeamReadWriter) CheckWritePermissions(ctx context.Context, groupIDs []string) error {
+	orgIDs := make(map[int64]struct{})
+	for _, groupID := range groupIDs {
+		orgID, _, err := parseID(groupID)
+		if err != nil {
+			return fmt.Errorf("could not parse groupID %s: %w", groupID, err)
+		}
+		orgIDs[orgID] = struct{}{}
+	}
+
+	logger := logging.FromContext(ctx)
+	var merr error
+	for _, orgID := range slices.Sorted(maps.Keys(orgIDs)) {
+		client, err := g.githubClientForOrg(ctx, orgID)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("org %d: could not get a token with the members:write permission: %w", orgID, err))
+			continue
+		}
+		_, resp, err := client.Organizations.GetByID(ctx, orgID)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("org %d: token cannot read the org: %w", orgID, classifyErr(err)))
+			continue
+		}
+		if len(resp.Header.Values(scopesHeader)) == 0 {
+			logger.InfoContext(ctx, "token does not report its permissions, skipping scope check",
+				"org_id", orgID,
+			)
+			continue
+		}
+		var scopes []string
+		for _, s := range strings.Split(resp.Header.Get(scopesHeader), ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				scopes = append(scopes, s)
+			}
+		}
+		if !slices.Contains(scopes, scopeAdminOrg) {
+			merr = errors.Join(merr, fmt.Errorf("org %d: token has scopes %q, it needs the %s scope to manage teams", orgID, scopes, scopeAdminOrg))
+		}
+	}
+	return merr
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestTeamReadWriter_CheckWritePermissions(t *testing.T) {
+	t.Parallel()
+
+	// tokens are named after the scopes reported for them, "fine-grained"
+	// reports none and "unknown" cannot read the org.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		switch token {
+		case "unknown":
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case "fine-grained":
+		default:
+			w.Header().Set("X-OAuth-Scopes", token)
+		}
+		w.Write([]byte(`{"id": 1}`)) //nolint:errcheck // test server
+	}))
+	t.Cleanup(server.Close)
+
+	cases := []struct {
+		name     string
+		token    string
+		groupIDs []string
+		wantErr  string
+	}{
+		{
+			name:     "admin_org",
+			token:    "repo, admin:org",
+			groupIDs: []string{"1:10", "1:11"},
+		},
+		{
+			name:     "fine_grained",
+			token:    "fine-grained",
+			groupIDs: []string{"1:10"},
+		},
+		{
+			name:     "missing_scope",
+			token:    "repo, read:org",
+			groupIDs: []string{"1:10"},
+			wantErr:  `org 1: token has scopes ["repo" "read:org"], it needs the admin:org scope`,
+		},
+		{
+			name:     "cannot_read_org",
+			token:    "unknown",
+			groupIDs: []string{"1:10"},
+			wantErr:  "org 1: token cannot read the org",
+		},
+		{
+			name:     "no_token",
+			token:    "admin:org",
+			groupIDs: []string{"1:10", "2:20"},
+			wantErr:  "org 2: could not get a token",
+		},
+		{
+			name:     "invalid_id",
+			groupIDs: []string{"10"},
+			wantErr:  "could not parse groupID 10",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tokenSource := NewPerOrgTokenSource(map[int64]OrgTokenSource{1: NewStaticTokenSource(tc.token)}, nil)
+			rw := NewTeamReadWriter(tokenSource, githubClient(server), nil)
+			err := rw.CheckWritePermissions(context.Background(), tc.groupIDs)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+// RepoPermissions are the permissions of teams on repositories, from the
+// least to the most privileged.
+var RepoPermissions = []string{"pull", "triage", "push", "maintain", "admin"}
+
+// RepoGrant grants a team a permission on the repositories of its org whose
+// names match a pattern.
+type RepoGrant struct {
+	// Pattern must match the whole repository name.
+	Pattern *regexp.Regexp
+	// Permission is one of RepoPermissions.
+	Permission string
+}
+
+// RepoPermissionChange is a change of the permission of a team on a
+// repository.
+type RepoPermissionChange struct {
+	Repo string
+	// From is the permission the team had, or "" if it had no access.
+	From string
+	To   string
+}
+
+// SyncRepoPermissions grants the GitHub team with the given ID the permissions
+// of the given grants on the matching repositories of its org. A repository
+// matching several grants gets the highest of their permissions. Archived
+// repositories and repositories matching no grant are left alone; the team
+// keeps its access to them. The ID must be of the form 'orgID:teamID'. The
+// changes are returned, and only made unless dryRun is set.
+func (g *TeamReadWriter) SyncRepoPermissions(ctx context.Context, groupID string, grants []*RepoGrant, dryRun bool) ([]*RepoPermissionChange, error) {
+	orgID, teamID, err := parseID(groupID)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse groupID %s: %w", groupID, err)
+	}
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get github client: %w", err)
+	}
+	repos, err := g.orgRepos(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]string)
+	if err := paginate(g.pageSize, func(opts *github.ListOptions) (int, *github.Response, error) {
+		page, resp, err := client.Teams.ListTeamReposByID(ctx, orgID, teamID, opts)
+		if err != nil {
+			return 0, resp, fmt.Errorf("failed to list team repos: %w", err)
+		}
+		for _, repo := range page {
+			current[repo.GetName()] = highestPermission(repo.GetPermissions())
+		}
+		return len(page), resp, nil
+	}); err != nil {
+		return nil, fmt.Errorf("could not list repos of team %s: %w", groupID, classifyErr(err))
+	}
+
+	logger := logging.FromContext(ctx)
+	var changes []*RepoPermissionChange
+	for _, repo := range repos {
+		if repo.GetArchived() {
+			continue
+		}
+		want := ""
+		for _, grant := range grants {
+			if grant.Pattern.MatchString(repo.GetName()) && permissionRank(grant.Permission) > permissionRank(want) {
+				want = grant.Permission
+			}
+		}
+		if want == "" || current[repo.GetName()] == want {
+			continue
+		}
+		change := &RepoPermissionChange{Repo: repo.GetName(), From: current[repo.GetName()], To: want}
+		changes = append(changes, change)
+		logger.InfoContext(ctx, "setting team repo permission",
+			"group_id", groupID,
+			"repo", change.Repo,
+			"from", change.From,
+			"to", change.To,
+			"dry_run", dryRun,
+		)
+		if dryRun {
+			continue
+		}
+		if _, err := client.Teams.AddTeamRepoByID(ctx, orgID, teamID, repo.GetOwner().GetLogin(), repo.GetName(),
+			&github.TeamAddTeamRepoOptions{Permission: want}); err != nil {
+			return changes, fmt.Errorf("could not grant team %s %s on repo %s: %w", groupID, want, repo.GetName(), classifyErr(err))
+		}
+	}
+	return changes, nil
+}
+
+// orgRepos returns the repositories of the org with the given ID.
+func (g *TeamReadWriter) orgRepos(ctx context.Context, client *github.Client, orgID int64) ([]*github.Repository, error) {
+	cacheKey := strconv.FormatInt(orgID, 10)
+	if repos, ok := g.orgReposCache.Lookup(cacheKey); ok {
+		return repos, nil
+	}
+	login, err := g.orgLogin(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "listing repos", "org_id", orgID)
+	var repos []*github.Repository
+	if err := paginate(g.pageSize, func(opts *github.ListOptions) (int, *github.Response, error) {
+		page, resp, err := client.Repositories.ListByOrg(ctx, login, &github.RepositoryListByOrgOptions{ListOptions: *opts})
+		if err != nil {
+			return 0, resp, fmt.Errorf("failed to list repos: %w", err)
+		}
+		repos = append(repos, page...)
+		return len(page), resp, nil
+	}); err != nil {
+		return nil, fmt.Errorf("could not list repos for org %d: %w", orgID, classifyErr(err))
+	}
+	g.orgReposCache.Set(cacheKey, repos)
+	return repos, nil
+}
+
+// highestPermission returns the highest of the given permissions which are
+// granted, or "" if there is none.
+func highestPermission(permissions map[string]bool) string {
+	for _, p := range slices.Backward(RepoPermissions) {
+		if permissions[p] {
+			return p
+		}
+	}
+	return ""
+}
+
+// permissionRank orders permissions by privilege, with 0 for no permission.
+func permissionRank(permission string) int {
+	return slices.Index(RepoPermissions, permission) + 1
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/githubtest"
+)
+
+func TestTeamReadWriter_SyncRepoPermissions(t *testing.T) {
+	t.Parallel()
+
+	grants := []*RepoGrant{
+		{Pattern: regexp.MustCompile(`^(?:svc-.*)$`), Permission: "push"},
+		{Pattern: regexp.MustCompile(`^(?:svc-core)$`), Permission: "maintain"},
+	}
+
+	cases := []struct {
+		name        string
+		groupID     string
+		grants      []*RepoGrant
+		dryRun      bool
+		opts        []Opt
+		wantChanges []*RepoPermissionChange
+		wantRepos   map[string]string
+		wantErr     string
+	}{
+		{
+			name:    "success",
+			groupID: "8583:1",
+			grants:  grants,
+			wantChanges: []*RepoPermissionChange{
+				{Repo: "svc-api", From: "pull", To: "push"},
+				{Repo: "svc-core", From: "", To: "maintain"},
+			},
+			wantRepos: map[string]string{
+				"svc-api":  "push",
+				"svc-core": "maintain",
+				"svc-web":  "push",
+				"docs":     "admin",
+			},
+		},
+		{
+			name:    "paginated",
+			groupID: "8583:1",
+			grants:  grants,
+			opts:    []Opt{WithPageSize(1)},
+			wantChanges: []*RepoPermissionChange{
+				{Repo: "svc-api", From: "pull", To: "push"},
+				{Repo: "svc-core", From: "", To: "maintain"},
+			},
+			wantRepos: map[string]string{
+				"svc-api":  "push",
+				"svc-core": "maintain",
+				"svc-web":  "push",
+				"docs":     "admin",
+			},
+		},
+		{
+			name:    "dry_run",
+			groupID: "8583:1",
+			grants:  grants,
+			dryRun:  true,
+			wantChanges: []*RepoPermissionChange{
+				{Repo: "svc-api", From: "pull", To: "push"},
+				{Repo: "svc-core", From: "", To: "maintain"},
+			},
+			wantRepos: map[string]string{
+				"svc-api": "pull",
+				"svc-web": "push",
+				"docs":    "admin",
+			},
+		},
+		{
+			name:    "no_grants",
+			groupID: "8583:1",
+			wantRepos: map[string]string{
+				"svc-api": "pull",
+				"svc-web": "push",
+				"docs":    "admin",
+			},
+		},
+		{
+			name:    "invalid_id",
+			groupID: "8583",
+			grants:  grants,
+			wantErr: "could not parse groupID 8583",
+			wantRepos: map[string]string{
+				"svc-api": "pull",
+				"svc-web": "push",
+				"docs":    "admin",
+			},
+		},
+		{
+			name:    "unknown_team",
+			groupID: "8583:2",
+			grants:  grants,
+			wantErr: "could not list repos of team 8583:2",
+			wantRepos: map[string]string{
+				"svc-api": "pull",
+				"svc-web": "push",
+				"docs":    "admin",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := githubtest.NewBuilder().
+				WithOrg(8583, "org1").
+				WithTeam(8583, &github.Team{ID: github.Int64(1), Slug: github.String("team1")}).
+				WithRepo(8583, &github.Repository{Name: github.String("svc-api")}).
+				WithRepo(8583, &github.Repository{Name: github.String("svc-core")}).
+				WithRepo(8583, &github.Repository{Name: github.String("svc-web")}).
+				WithRepo(8583, &github.Repository{Name: github.String("svc-old"), Archived: github.Bool(true)}).
+				WithRepo(8583, &github.Repository{Name: github.String("docs")}).
+				WithTeamRepo(8583, 1, "svc-api", "pull").
+				WithTeamRepo(8583, 1, "svc-web", "push").
+				WithTeamRepo(8583, 1, "docs", "admin").
+				Start()
+			t.Cleanup(server.Close)
+			tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token"}}
+
+			rw := NewTeamReadWriter(tokenSource, server.Client(), nil, tc.opts...)
+			got, err := rw.SyncRepoPermissions(context.Background(), tc.groupID, tc.grants, tc.dryRun)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.wantChanges); diff != "" {
+				t.Errorf("unexpected changes (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(server.TeamRepos(8583, 1), tc.wantRepos); diff != "" {
+				t.Errorf("unexpected team repos (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/google/go-github/v61/github"
+)
+
+// externalIdentitiesFields selects a page of the external identities of a SAML
+// identity provider, i.e. the SAML and SCIM identities linked to users.
+const externalIdentitiesFields = `samlIdentityProvider {
+      externalIdentities(first: $first, after: $after) {
+        pageInfo { hasNextPage endCursor }
+        nodes {
+          guid
+          samlIdentity { nameId }
+          user { login }
+        }
+      }
+    }`
+
+// orgExternalIdentitiesQuery lists the external identities of an org.
+const orgExternalIdentitiesQuery = `query($owner: String!, $first: Int!, $after: String) {
+  organization(login: $owner) {
+    ` + externalIdentitiesFields + `
+  }
+  rateLimit {
+    cost
+  }
+}`
+
+// enterpriseExternalIdentitiesQuery lists the external identities of an
+// enterprise, e.g. of Enterprise Managed Users.
+const enterpriseExternalIdentitiesQuery = `query($owner: String!, $first: Int!, $after: String) {
+  enterprise(slug: $owner) {
+    ownerInfo {
+      ` + externalIdentitiesFields + `
+    }
+  }
+  rateLimit {
+    cost
+  }
+}`
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+// externalIdentity is an identity of a SAML identity provider linked to a
+// user. The GUID of an identity provisioned by SCIM is the ID of its SCIM
+// user.
+type externalIdentity struct {
+	GUID         string `json:"guid"`
+	SAMLIdentity *struct {
+		NameID string `json:"nameId"`
+	} `json:"samlIdentity"`
+	User *struct {
+		Login string `json:"login"`
+	} `json:"user"`
+}
+
+type identityProviderOwner struct {
+	SAMLIdentityProvider *struct {
+		ExternalIdentities struct {
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage"`
+				EndCursor   string `json:"endCursor"`
+			} `json:"pageInfo"`
+			Nodes []*externalIdentity `json:"nodes"`
+		} `json:"externalIdentities"`
+	} `json:"samlIdentityProvider"`
+}
+
+type externalIdentitiesResponse struct {
+	Data struct {
+		Organization *identityProviderOwner `json:"organization"`
+		Enterprise   *struct {
+			OwnerInfo *identityProviderOwner `json:"ownerInfo"`
+		} `json:"enterprise"`
+		RateLimit *struct {
+			Cost int `json:"cost"`
+		} `json:"rateLimit"`
+	} `json:"data"`
+	Errors []graphQLError `json:"errors"`
+}
+
+// SAMLIdentities returns the logins of the members of the GitHub org with the
+// given ID who have linked a SAML single sign-on identity, keyed by the NameID
+// of the identity, typically their email. Orgs without SAML single sign-on
+// have none. Identities whose user left the org are left out.
+func (g *TeamReadWriter) SAMLIdentities(ctx context.Context, orgID int64) (map[string]string, error) {
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get github client: %w", err)
+	}
+	login, err := g.orgLogin(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "listing saml identities", "org_id", orgID)
+	identities, err := g.externalIdentities(ctx, client, orgExternalIdentitiesQuery, login)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saml identities of org %d: %w", orgID, err)
+	}
+	nameIDs := make(map[string]string, len(identities))
+	for _, id := range identities {
+		if id.SAMLIdentity != nil && id.SAMLIdentity.NameID != "" && id.User != nil {
+			nameIDs[id.SAMLIdentity.NameID] = id.User.Login
+		}
+	}
+	return nameIDs, nil
+}
+
+// SCIMExternalIDs returns the logins of the users provisioned by SCIM to the
+// GitHub org with the given ID, keyed by the externalId of their SCIM user,
+// the ID of the user in the identity provider. With an enterprise slug, the
+// users provisioned to the enterprise are returned instead, e.g. Enterprise
+// Managed Users, using the credentials of the org. Users whose identity is not
+// linked to a GitHub user yet are left out.
+func (g *TeamReadWriter) SCIMExternalIDs(ctx context.Context, orgID int64, enterprise string) (map[string]string, error) {
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get github client: %w", err)
+	}
+	owner, scimPath, query := enterprise, "scim/v2/enterprises/%s/Users", enterpriseExternalIdentitiesQuery
+	if enterprise == "" {
+		if owner, err = g.orgLogin(ctx, client, orgID); err != nil {
+			return nil, err
+		}
+		scimPath, query = "scim/v2/organizations/%s/Users", orgExternalIdentitiesQuery
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "listing scim identities",
+		"org_id", orgID,
+		"enterprise", enterprise,
+	)
+
+	// SCIM users have the externalId, and the external identities of the
+	// same GUID have the login.
+	externalIDs := make(map[string]string)
+	for start := 1; ; {
+		u := fmt.Sprintf(scimPath+"?startIndex=%d&count=%d", url.PathEscape(owner), start, g.pageSize)
+		req, err := client.NewRequest(http.MethodGet, u, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create scim users request: %w", err)
+		}
+		var page github.SCIMProvisionedIdentities
+		if _, err := client.Do(ctx, req, &page); err != nil {
+			return nil, fmt.Errorf("failed to list scim users of %s: %w", owner, classifyErr(err))
+		}
+		for _, user := range page.Resources {
+			if user.GetID() != "" && user.GetExternalID() != "" {
+				externalIDs[user.GetID()] = user.GetExternalID()
+			}
+		}
+		start += len(page.Resources)
+		if len(page.Resources) == 0 || start > page.GetTotalResults() {
+			break
+		}
+	}
+	identities, err := g.externalIdentities(ctx, client, query, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scim identities of %s: %w", owner, err)
+	}
+	logins := make(map[string]string, len(externalIDs))
+	for _, id := range identities {
+		if externalID, ok := externalIDs[id.GUID]; ok && id.User != nil {
+			logins[externalID] = id.User.Login
+		}
+	}
+	return logins, nil
+}
+
+// externalIdentities returns the external identities of the org or enterprise
+// owner, selected by the given query.
+func (g *TeamReadWriter) externalIdentities(ctx context.Context, client *github.Client, query, owner string) ([]*externalIdentity, error) {
+	var identities []*externalIdentity
+	variables := map[string]any{"owner": owner, "first": g.pageSize}
+	for {
+		// the GraphQL endpoint is /graphql on github.com and /api/graphql on
+		// GitHub Enterprise Server, next to the REST base URL /api/v3/.
+		req, err := client.NewRequest(http.MethodPost, "../graphql", &graphQLRequest{Query: query, Variables: variables})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create external identities request: %w", err)
+		}
+		var resp externalIdentitiesResponse
+		if _, err := client.Do(ctx, req, &resp); err != nil {
+			return nil, classifyErr(err)
+		}
+		// queries cost at least a point, even if their cost is not reported.
+		cost := 1
+		if resp.Data.RateLimit != nil {
+			cost = max(resp.Data.RateLimit.Cost, 1)
+		}
+		g.costs.add(ctx, APICost{GraphQL: cost})
+		if len(resp.Errors) > 0 {
+			return nil, fmt.Errorf("graphql error: %s", resp.Errors[0].Message)
+		}
+		provider := resp.Data.Organization
+		if resp.Data.Enterprise != nil {
+			provider = resp.Data.Enterprise.OwnerInfo
+		}
+		if provider == nil || provider.SAMLIdentityProvider == nil {
+			return identities, nil
+		}
+		page := provider.SAMLIdentityProvider.ExternalIdentities
+		identities = append(identities, page.Nodes...)
+		if !page.PageInfo.HasNextPage {
+			return identities, nil
+		}
+		if page.PageInfo.EndCursor == "" || page.PageInfo.EndCursor == variables["after"] {
+			return nil, fmt.Errorf("next page of external identities does not advance")
+		}
+		variables["after"] = page.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/githubtest"
+)
+
+func TestTeamReadWriter_SAMLIdentities(t *testing.T) {
+	t.Parallel()
+
+	server := githubtest.NewBuilder().
+		WithOrg(8583, "org1").
+		WithOrg(9000, "org2").
+		WithSAMLIdentity(8583, "alice@example.com", "alice").
+		WithSAMLIdentity(8583, "bob@example.com", "bob").
+		WithSAMLIdentity(8583, "carol@example.com", "carol").
+		Start()
+	t.Cleanup(server.Close)
+	tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token", 9000: "org_2_test_token"}}
+
+	cases := []struct {
+		name    string
+		orgID   int64
+		opts    []Opt
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name:  "success",
+			orgID: 8583,
+			want: map[string]string{
+				"alice@example.com": "alice",
+				"bob@example.com":   "bob",
+				"carol@example.com": "carol",
+			},
+		},
+		{
+			name:  "paginated",
+			orgID: 8583,
+			opts:  []Opt{WithPageSize(2)},
+			want: map[string]string{
+				"alice@example.com": "alice",
+				"bob@example.com":   "bob",
+				"carol@example.com": "carol",
+			},
+		},
+		{
+			name:  "no_saml",
+			orgID: 9000,
+			want:  map[string]string{},
+		},
+		{
+			name:    "unknown_org",
+			orgID:   1234,
+			wantErr: "could not get org 1234",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rw := NewTeamReadWriter(tokenSource, server.Client(), nil, tc.opts...)
+			got, err := rw.SAMLIdentities(context.Background(), tc.orgID)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected identities (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTeamReadWriter_SCIMExternalIDs(t *testing.T) {
+	t.Parallel()
+
+	server := githubtest.NewBuilder().
+		WithOrg(8583, "org1").
+		WithOrg(9000, "org2").
+		WithSCIMIdentity(8583, "00u1", "alice").
+		WithSCIMIdentity(8583, "00u2", "bob").
+		WithSAMLIdentity(8583, "dave@example.com", "dave").
+		WithSCIMIdentity(8583, "00u3", "carol").
+		WithEnterpriseSCIMIdentity("acme", "00u4", "erin_acme").
+		Start()
+	t.Cleanup(server.Close)
+	tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token", 9000: "org_2_test_token"}}
+
+	cases := []struct {
+		name       string
+		orgID      int64
+		enterprise string
+		opts       []Opt
+		want       map[string]string
+		wantErr    string
+	}{
+		{
+			name:  "org",
+			orgID: 8583,
+			want:  map[string]string{"00u1": "alice", "00u2": "bob", "00u3": "carol"},
+		},
+		{
+			name:  "paginated",
+			orgID: 8583,
+			opts:  []Opt{WithPageSize(2)},
+			want:  map[string]string{"00u1": "alice", "00u2": "bob", "00u3": "carol"},
+		},
+		{
+			name:       "enterprise",
+			orgID:      9000,
+			enterprise: "acme",
+			want:       map[string]string{"00u4": "erin_acme"},
+		},
+		{
+			name:  "no_scim",
+			orgID: 9000,
+			want:  map[string]string{},
+		},
+		{
+			name:    "unknown_org",
+			orgID:   1234,
+			wantErr: "could not get org 1234",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rw := NewTeamReadWriter(tokenSource, server.Client(), nil, tc.opts...)
+			got, err := rw.SCIMExternalIDs(context.Background(), tc.orgID, tc.enterprise)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected external IDs (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
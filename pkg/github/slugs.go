@@ -0,0 +1,180 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v61/github"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const (
+	stateKeyPrefix  = "github"
+	teamSlugState   = "teamslug"
+	teamRenameState = "teamrename"
+)
+
+// TeamRename records that the slug of a team no longer matches the slug it
+// is configured with.
+type TeamRename struct {
+	OrgID      int64     `json:"org_id"`
+	TeamID     int64     `json:"team_id"`
+	OldSlug    string    `json:"old_slug"`
+	NewSlug    string    `json:"new_slug"`
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// TeamSlugResolver resolves configured team slugs to immutable team IDs.
+// Resolved IDs are cached in a state.Store so that a team which is renamed
+// after it was first resolved keeps syncing under its old slug, and the
+// rename is recorded so the configuration can be updated.
+type TeamSlugResolver struct {
+	teams *TeamReadWriter
+	store state.Store
+	now   func() time.Time
+}
+
+// NewTeamSlugResolver creates a TeamSlugResolver which caches resolved IDs
+// in the given store.
+func NewTeamSlugResolver(teams *TeamReadWriter, store state.Store) *TeamSlugResolver {
+	return &TeamSlugResolver{
+		teams: teams,
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Resolve returns the ID of the team configured with the given ID and slug.
+// If teamID is zero the ID is resolved from the slug, using a previously
+// cached ID if the slug no longer exists. If the team's current slug differs
+// from the given slug the returned TeamRename describes the rename.
+func (r *TeamSlugResolver) Resolve(ctx context.Context, orgID, teamID int64, slug string) (int64, *TeamRename, error) {
+	if slug == "" {
+		return teamID, nil, nil
+	}
+	logger := logging.FromContext(ctx)
+
+	if teamID == 0 {
+		var cached int64
+		err := state.GetJSON(ctx, r.store, teamSlugKey(orgID, slug), &cached)
+		switch {
+		case err == nil:
+			teamID = cached
+		case errors.Is(err, state.ErrNotFound):
+			group, err := r.teams.GetTeamBySlug(ctx, orgID, slug)
+			if err != nil {
+				return 0, nil, fmt.Errorf("failed to resolve team slug %s: %w", slug, err)
+			}
+			_, teamID, _ = parseID(group.ID)
+		default:
+			return 0, nil, fmt.Errorf("failed to read cached team ID: %w", err)
+		}
+	}
+
+	group, err := r.teams.GetGroup(ctx, Encode(orgID, teamID))
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get team %s (%d): %w", slug, teamID, err)
+	}
+	currentSlug := slug
+	if team, ok := group.Attributes.(*github.Team); ok {
+		currentSlug = team.GetSlug()
+	}
+
+	var merr error
+	merr = errors.Join(merr, state.PutJSON(ctx, r.store, teamSlugKey(orgID, currentSlug), teamID))
+	renameKey := teamRenameKey(orgID, teamID)
+	if currentSlug == slug {
+		merr = errors.Join(merr, r.store.Delete(ctx, renameKey))
+		if merr != nil {
+			return teamID, nil, fmt.Errorf("failed to update team slug cache: %w", merr)
+		}
+		return teamID, nil, nil
+	}
+
+	// keep resolving the configured slug to this team until the config is updated.
+	merr = errors.Join(merr, state.PutJSON(ctx, r.store, teamSlugKey(orgID, slug), teamID))
+	rename := &TeamRename{
+		OrgID:      orgID,
+		TeamID:     teamID,
+		OldSlug:    slug,
+		NewSlug:    currentSlug,
+		DetectedAt: r.now().UTC(),
+	}
+	var existing TeamRename
+	if err := state.GetJSON(ctx, r.store, renameKey, &existing); err == nil && existing.NewSlug == currentSlug {
+		rename.DetectedAt = existing.DetectedAt
+	}
+	merr = errors.Join(merr, state.PutJSON(ctx, r.store, renameKey, rename))
+	logger.WarnContext(ctx, "github team was renamed, update the mapping config",
+		"org_id", orgID,
+		"team_id", teamID,
+		"configured_slug", slug,
+		"current_slug", currentSlug,
+	)
+	if merr != nil {
+		return teamID, rename, fmt.Errorf("failed to update team slug cache: %w", merr)
+	}
+	return teamID, rename, nil
+}
+
+// Renames returns the renames recorded by Resolve which have not been
+// resolved by updating the configuration.
+func (r *TeamSlugResolver) Renames(ctx context.Context) ([]*TeamRename, error) {
+	return ListTeamRenames(ctx, r.store)
+}
+
+// ListTeamRenames returns the team renames recorded in the given store.
+func ListTeamRenames(ctx context.Context, store state.Store) ([]*TeamRename, error) {
+	keys, err := store.List(ctx, state.Key(stateKeyPrefix, teamRenameState)+"/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list team renames: %w", err)
+	}
+	renames := make([]*TeamRename, 0, len(keys))
+	for _, key := range keys {
+		var rename TeamRename
+		if err := state.GetJSON(ctx, store, key, &rename); err != nil {
+			if errors.Is(err, state.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read team rename: %w", err)
+		}
+		renames = append(renames, &rename)
+	}
+	return renames, nil
+}
+
+// SaveTeamSlug caches the ID of the team with the given slug in store, as if
+// Resolve had resolved it, e.g. when importing teams managed by another tool.
+func SaveTeamSlug(ctx context.Context, store state.Store, orgID int64, slug string, teamID int64) error {
+	if err := state.PutJSON(ctx, store, teamSlugKey(orgID, slug), teamID); err != nil {
+		return fmt.Errorf("failed to save team slug %s: %w", slug, err)
+	}
+	return nil
+}
+
+func teamSlugKey(orgID int64, slug string) string {
+	return state.Key(stateKeyPrefix, teamSlugState, strconv.FormatInt(orgID, 10), slug)
+}
+
+func teamRenameKey(orgID, teamID int64) string {
+	return state.Key(stateKeyPrefix, teamRenameState, Encode(orgID, teamID))
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v61/github"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestTeamSlugResolver_Rename(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	team := &github.Team{
+		ID:           proto.Int64(2797),
+		Slug:         proto.String("team1"),
+		Organization: &github.Organization{ID: proto.Int64(8583)},
+	}
+	data := &GitHubData{
+		orgLogins: map[string]string{"8583": "org1"},
+		teams:     map[string]map[string]*github.Team{"8583": {"2797": team}},
+	}
+	server := fakeGitHub(data)
+	defer server.Close()
+
+	store := state.NewMemoryStore()
+	tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token"}}
+	// each resolver gets a fresh TeamReadWriter, like a new sync run would.
+	newResolver := func() *TeamSlugResolver {
+		r := NewTeamSlugResolver(NewTeamReadWriter(tokenSource, server.Client(), nil), store)
+		r.now = func() time.Time { return time.Time{} }
+		return r
+	}
+
+	teamID, rename, err := newResolver().Resolve(ctx, 8583, 0, "team1")
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if teamID != 2797 || rename != nil {
+		t.Errorf("Resolve before rename got (%d, %v), want (2797, nil)", teamID, rename)
+	}
+
+	// the old slug no longer exists, but the cached ID still resolves it.
+	team.Slug = proto.String("team-one")
+	server.SetTeam(8583, team)
+	resolver := newResolver()
+	teamID, rename, err = resolver.Resolve(ctx, 8583, 0, "team1")
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	wantRename := &TeamRename{OrgID: 8583, TeamID: 2797, OldSlug: "team1", NewSlug: "team-one"}
+	if teamID != 2797 {
+		t.Errorf("Resolve after rename got team ID %d, want 2797", teamID)
+	}
+	if diff := cmp.Diff(rename, wantRename); diff != "" {
+		t.Errorf("unexpected rename (-got, +want):\n%s", diff)
+	}
+	renames, err := resolver.Renames(ctx)
+	if err != nil {
+		t.Fatalf("Renames failed: %v", err)
+	}
+	if diff := cmp.Diff(renames, []*TeamRename{wantRename}); diff != "" {
+		t.Errorf("unexpected renames (-got, +want):\n%s", diff)
+	}
+
+	// updating the config to the new slug resolves the rename.
+	teamID, rename, err = resolver.Resolve(ctx, 8583, 0, "team-one")
+	if err != nil {
+		t.Fatalf("Resolve failed: %v", err)
+	}
+	if teamID != 2797 || rename != nil {
+		t.Errorf("Resolve with new slug got (%d, %v), want (2797, nil)", teamID, rename)
+	}
+	renames, err = resolver.Renames(ctx)
+	if err != nil {
+		t.Fatalf("Renames failed: %v", err)
+	}
+	if len(renames) != 0 {
+		t.Errorf("got %d renames after config update, want 0", len(renames))
+	}
+}
+
+func TestTeamSlugResolver_NotFound(t *testing.T) {
+	t.Parallel()
+
+	server := fakeGitHub(&GitHubData{
+		orgLogins: map[string]string{"8583": "org1"},
+		teams:     map[string]map[string]*github.Team{"8583": {}},
+	})
+	defer server.Close()
+
+	tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token"}}
+	resolver := NewTeamSlugResolver(NewTeamReadWriter(tokenSource, server.Client(), nil), state.NewMemoryStore())
+
+	_, _, err := resolver.Resolve(context.Background(), 8583, 0, "missing")
+	if diff := testutil.DiffErrString(err, "group not found"); diff != "" {
+		t.Errorf("unexpected error: %s", diff)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -29,6 +30,7 @@ import (
 	"github.com/abcxyz/pkg/logging"
 	"github.com/abcxyz/pkg/sets"
 	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/state"
 	"github.com/abcxyz/team-link/pkg/utils"
 )
 
@@ -38,6 +40,13 @@ const (
 	// We don't expect user info (e.g. username etc.) nor team info (team name etc.)
 	// to change frequently so a time to live of 1 day is the default.
 	DefaultCacheDuration = time.Hour * 24
+
+	// ArchivedTeamPrefix prefixes the names of teams archived by ArchiveGroup.
+	ArchivedTeamPrefix = "archived-"
+
+	// teamRoleMember and teamRoleMaintainer are the roles of a team membership.
+	teamRoleMember     = "member"
+	teamRoleMaintainer = "maintainer"
 )
 
 type OrgTokenSource interface {
@@ -49,6 +58,9 @@ type Config struct {
 	includeSubTeams         bool
 	inviteToOrgIfNotAMember bool
 	cacheDuration           time.Duration
+	sharedCache             state.Store
+	pageSize                int
+	costs                   *CostTracker
 }
 
 type Opt func(writer *Config)
@@ -83,17 +95,40 @@ func WithInviteToOrgIfNotAMember() Opt {
 	}
 }
 
+// WithSharedCache additionally caches user lookups in the given store, e.g. a
+// state.RedisStore, so that they are shared by team-link processes using the
+// same store. Entries expire after the cache duration.
+func WithSharedCache(store state.Store) Opt {
+	return func(config *Config) {
+		config.sharedCache = store
+	}
+}
+
+// WithPageSize sets the number of items requested per page when listing
+// teams, team members, child teams and invitations, DefaultPageSize by
+// default. It is clamped to the 1 to 100 range GitHub allows.
+func WithPageSize(size int) Opt {
+	return func(config *Config) {
+		config.pageSize = min(max(size, 1), DefaultPageSize)
+	}
+}
+
 // TeamReadWriter adheres to the groupsync.GroupReadWriter interface
 // and provides mechanisms for manipulating GitHub Teams.
 type TeamReadWriter struct {
 	orgTokenSource          OrgTokenSource
 	client                  *github.Client
 	userCache               *cache.Cache[*github.User]
+	sharedUserCache         *state.Cache[*github.User]
 	teamCache               *cache.Cache[*github.Team]
 	orgMembershipCache      *cache.Cache[bool]
+	orgLoginCache           *cache.Cache[string]
+	orgReposCache           *cache.Cache[[]*github.Repository]
 	includeSubTeams         bool
 	inviteToOrgIfNotAMember bool
 	orgTeamSSORequired      map[int64]map[int64]bool
+	pageSize                int
+	costs                   *CostTracker
 }
 
 // NewTeamReadWriter creates a new TeamReadWriter. By default, TeamReadWriter considers
@@ -111,10 +146,18 @@ func NewTeamReadWriter(orgTokenSource OrgTokenSource, client *github.Client, org
 		includeSubTeams:         true,
 		inviteToOrgIfNotAMember: false,
 		cacheDuration:           DefaultCacheDuration,
+		pageSize:                DefaultPageSize,
 	}
 	for _, opt := range opts {
 		opt(config)
 	}
+	if config.costs != nil {
+		httpClient := client.Client()
+		httpClient.Transport = config.costs.transport(httpClient.Transport)
+		tracked := github.NewClient(httpClient)
+		tracked.BaseURL, tracked.UploadURL = client.BaseURL, client.UploadURL
+		client = tracked
+	}
 	t := &TeamReadWriter{
 		orgTokenSource:          orgTokenSource,
 		client:                  client,
@@ -123,7 +166,14 @@ func NewTeamReadWriter(orgTokenSource OrgTokenSource, client *github.Client, org
 		userCache:               cache.New[*github.User](config.cacheDuration),
 		teamCache:               cache.New[*github.Team](config.cacheDuration),
 		orgMembershipCache:      cache.New[bool](config.cacheDuration),
+		orgLoginCache:           cache.New[string](config.cacheDuration),
+		orgReposCache:           cache.New[[]*github.Repository](config.cacheDuration),
 		orgTeamSSORequired:      orgTeamSSORequired,
+		pageSize:                config.pageSize,
+		costs:                   config.costs,
+	}
+	if config.sharedCache != nil {
+		t.sharedUserCache = state.NewCache[*github.User](config.sharedCache, state.Key("cache", "github", "users"), config.cacheDuration)
 	}
 	// TODO: Obtain and retrieve Org User's SAML info.
 	return t
@@ -131,6 +181,7 @@ func NewTeamReadWriter(orgTokenSource OrgTokenSource, client *github.Client, org
 
 // GetGroup retrieves the GitHub team with the given ID. The ID must be of the form 'orgID:teamID'.
 func (g *TeamReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	ctx = withCostGroup(ctx, groupID)
 	orgID, teamID, err := parseID(groupID)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse groupID %s: %w", groupID, err)
@@ -141,7 +192,7 @@ func (g *TeamReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsy
 	}
 	team, err := g.getGitHubTeam(ctx, client, orgID, teamID)
 	if err != nil {
-		return nil, fmt.Errorf("could not get team: %w", err)
+		return nil, fmt.Errorf("could not get team: %w", classifyErr(err))
 	}
 	return &groupsync.Group{
 		ID:         Encode(team.GetOrganization().GetID(), team.GetID()),
@@ -167,9 +218,134 @@ func (g *TeamReadWriter) getGitHubTeam(ctx context.Context, client *github.Clien
 	return team, nil
 }
 
+// ListTeams retrieves all teams of the GitHub org with the given ID. Each
+// returned group has an ID of the form 'orgID:teamID' and the *github.Team as
+// its attributes.
+func (g *TeamReadWriter) ListTeams(ctx context.Context, orgID int64) ([]*groupsync.Group, error) {
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get github client: %w", err)
+	}
+	login, err := g.orgLogin(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "listing teams", "org_id", orgID)
+	var groups []*groupsync.Group
+	if err := paginate(g.pageSize, func(opts *github.ListOptions) (int, *github.Response, error) {
+		teams, resp, err := client.Teams.ListTeams(ctx, login, opts)
+		if err != nil {
+			return 0, resp, fmt.Errorf("failed to list teams: %w", err)
+		}
+		for _, team := range teams {
+			g.teamCache.Set(Encode(orgID, team.GetID()), team)
+			groups = append(groups, &groupsync.Group{
+				ID:         Encode(orgID, team.GetID()),
+				Attributes: team,
+			})
+		}
+		return len(teams), resp, nil
+	}); err != nil {
+		return nil, fmt.Errorf("could not list teams for org %d: %w", orgID, classifyErr(err))
+	}
+	return groups, nil
+}
+
+// GetTeamBySlug retrieves the team with the given slug in the GitHub org with
+// the given ID. The returned group has an ID of the form 'orgID:teamID'.
+func (g *TeamReadWriter) GetTeamBySlug(ctx context.Context, orgID int64, slug string) (*groupsync.Group, error) {
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get github client: %w", err)
+	}
+	login, err := g.orgLogin(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+	team, resp, err := client.Teams.GetTeamBySlug(ctx, login, slug)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("could not get team %s: %w", slug, groupsync.ErrGroupNotFound)
+		}
+		return nil, fmt.Errorf("could not get team %s: %w", slug, classifyErr(err))
+	}
+	g.teamCache.Set(Encode(orgID, team.GetID()), team)
+	return &groupsync.Group{
+		ID:         Encode(orgID, team.GetID()),
+		Attributes: team,
+	}, nil
+}
+
+// CreateTeam creates a team with the given name and description in the
+// GitHub org with the given ID. The returned group has an ID of the form
+// 'orgID:teamID'.
+func (g *TeamReadWriter) CreateTeam(ctx context.Context, orgID int64, name, description string) (*groupsync.Group, error) {
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get github client: %w", err)
+	}
+	login, err := g.orgLogin(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "creating team",
+		"org_id", orgID,
+		"name", name,
+	)
+	team, _, err := client.Teams.CreateTeam(ctx, login, github.NewTeam{Name: name, Description: &description})
+	if err != nil {
+		return nil, fmt.Errorf("could not create team %s: %w", name, classifyErr(err))
+	}
+	g.teamCache.Set(Encode(orgID, team.GetID()), team)
+	return &groupsync.Group{
+		ID:         Encode(orgID, team.GetID()),
+		Attributes: team,
+	}, nil
+}
+
+// DeleteTeam deletes the GitHub team with the given ID, along with its child
+// teams. The ID must be of the form 'orgID:teamID'. Unlike ArchiveGroup it
+// cannot be undone, and is meant for teams created with CreateTeam, e.g. by
+// tests.
+func (g *TeamReadWriter) DeleteTeam(ctx context.Context, groupID string) error {
+	ctx = withCostGroup(ctx, groupID)
+	orgID, teamID, err := parseID(groupID)
+	if err != nil {
+		return fmt.Errorf("could not parse groupID %s: %w", groupID, err)
+	}
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("could not create github client: %w", err)
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "deleting team", "team_id", groupID)
+	if _, err := client.Teams.DeleteTeamByID(ctx, orgID, teamID); err != nil {
+		return fmt.Errorf("could not delete team %s: %w", groupID, classifyErr(err))
+	}
+	return nil
+}
+
+// orgLogin returns the login of the org with the given ID, which some
+// endpoints require instead of the ID.
+func (g *TeamReadWriter) orgLogin(ctx context.Context, client *github.Client, orgID int64) (string, error) {
+	cacheKey := strconv.FormatInt(orgID, 10)
+	if login, ok := g.orgLoginCache.Lookup(cacheKey); ok {
+		return login, nil
+	}
+	org, _, err := client.Organizations.GetByID(ctx, orgID)
+	if err != nil {
+		return "", fmt.Errorf("could not get org %d: %w", orgID, classifyErr(err))
+	}
+	g.orgLoginCache.Set(cacheKey, org.GetLogin())
+	return org.GetLogin(), nil
+}
+
 // GetMembers retrieves the direct members (children) of the GitHub team with given ID.
 // The ID must be of the form 'orgID:teamID'.
 func (g *TeamReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	ctx = withCostGroup(ctx, groupID)
 	logger := logging.FromContext(ctx)
 	logger.InfoContext(ctx, "fetching members for team", "team_id", groupID)
 	orgID, teamID, err := parseID(groupID)
@@ -182,7 +358,7 @@ func (g *TeamReadWriter) GetMembers(ctx context.Context, groupID string) ([]grou
 	}
 
 	users := make(map[string]*github.User, 32)
-	if err := paginate(func(listOpts *github.ListOptions) (*github.Response, error) {
+	if err := paginate(g.pageSize, func(listOpts *github.ListOptions) (int, *github.Response, error) {
 		opts := &github.TeamListTeamMembersOptions{
 			Role:        "all",
 			ListOptions: *listOpts,
@@ -190,7 +366,10 @@ func (g *TeamReadWriter) GetMembers(ctx context.Context, groupID string) ([]grou
 
 		members, resp, err := client.Teams.ListTeamMembersByID(ctx, orgID, teamID, opts)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list team membership: %w", err)
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				err = fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+			}
+			return 0, nil, fmt.Errorf("failed to list team membership: %w", err)
 		}
 
 		for _, m := range members {
@@ -199,22 +378,22 @@ func (g *TeamReadWriter) GetMembers(ctx context.Context, groupID string) ([]grou
 				users[v] = m
 			}
 		}
-		return resp, nil
+		return len(members), resp, nil
 	}); err != nil {
-		return nil, err
+		return nil, classifyErr(err)
 	}
 
 	members := make([]groupsync.Member, 0, len(users))
 	for _, user := range users {
-		members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: user.GetLogin(), Attributes: user}})
+		members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: user.GetLogin(), StableID: stableID(user.GetID()), Attributes: user}})
 	}
 
 	if g.includeSubTeams {
 		childTeams := make(map[int64]*github.Team, len(users))
-		if err := paginate(func(listOpts *github.ListOptions) (*github.Response, error) {
+		if err := paginate(g.pageSize, func(listOpts *github.ListOptions) (int, *github.Response, error) {
 			members, resp, err := client.Teams.ListChildTeamsByParentID(ctx, orgID, teamID, listOpts)
 			if err != nil {
-				return nil, fmt.Errorf("failed to list team membership: %w", err)
+				return 0, nil, fmt.Errorf("failed to list team membership: %w", err)
 			}
 
 			for _, m := range members {
@@ -222,9 +401,9 @@ func (g *TeamReadWriter) GetMembers(ctx context.Context, groupID string) ([]grou
 					childTeams[v] = m
 				}
 			}
-			return resp, nil
+			return len(members), resp, nil
 		}); err != nil {
-			return nil, err
+			return nil, classifyErr(err)
 		}
 		for _, team := range childTeams {
 			members = append(members, &groupsync.GroupMember{Grp: &groupsync.Group{
@@ -253,25 +432,56 @@ func (g *TeamReadWriter) Descendants(ctx context.Context, groupID string) ([]*gr
 func (g *TeamReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
 	user, err := g.getGitHubUser(ctx, g.client, userID)
 	if err != nil {
-		return nil, fmt.Errorf("could not get user: %w", err)
+		return nil, fmt.Errorf("could not get user: %w", classifyErr(err))
 	}
 	return &groupsync.User{
 		ID:         user.GetLogin(),
+		StableID:   stableID(user.GetID()),
 		Attributes: user,
 	}, nil
 }
 
+// stableID returns the StableID of the user with the given numeric ID, which
+// does not change when the user changes their login, or "" if it is unknown.
+func stableID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
+
+// IsSuspended reports whether the GitHub user with the given login is
+// suspended. Only GitHub Enterprise Server reports suspended users.
+func (g *TeamReadWriter) IsSuspended(ctx context.Context, userID string) (bool, error) {
+	user, err := g.getGitHubUser(ctx, g.client, userID)
+	if err != nil {
+		return false, fmt.Errorf("could not get user: %w", classifyErr(err))
+	}
+	return user.SuspendedAt != nil, nil
+}
+
 func (g *TeamReadWriter) getGitHubUser(ctx context.Context, client *github.Client, userID string) (*github.User, error) {
 	if user, ok := g.userCache.Lookup(userID); ok {
 		return user, nil
 	}
 	logger := logging.FromContext(ctx)
+	if g.sharedUserCache != nil {
+		if user, ok := g.sharedUserCache.Lookup(ctx, userID); ok {
+			g.userCache.Set(userID, user)
+			return user, nil
+		}
+	}
 	logger.InfoContext(ctx, "fetching user", "user_id", userID)
 	user, _, err := client.Users.Get(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch user %s: %w", userID, err)
 	}
 	g.userCache.Set(userID, user)
+	if g.sharedUserCache != nil {
+		if err := g.sharedUserCache.Set(ctx, userID, user); err != nil {
+			logger.WarnContext(ctx, "failed to cache user", "user_id", userID, "error", err)
+		}
+	}
 	return user, nil
 }
 
@@ -279,6 +489,7 @@ func (g *TeamReadWriter) getGitHubUser(ctx context.Context, client *github.Clien
 // The ID must be of the form 'orgID:teamID'. Any members of the GitHub team not found in the given members list
 // will be removed. Likewise, any members of the given list that are not currently members of the team will be added.
 func (g *TeamReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	ctx = withCostGroup(ctx, groupID)
 	orgID, teamID, err := parseID(groupID)
 	if err != nil {
 		return fmt.Errorf("could not parse groupID %s: %w", groupID, err)
@@ -318,12 +529,48 @@ func (g *TeamReadWriter) SetMembers(ctx context.Context, groupID string, members
 		"remove_member_ids", utils.MapKeys(removeMembers),
 	)
 
+	merr := g.addMembers(ctx, client, orgID, teamID, groupID, sortedMembers(addMembers))
+	merr = errors.Join(merr, g.removeMembers(ctx, client, orgID, teamID, groupID, sortedMembers(removeMembers)))
+	return classifyErr(merr)
+}
+
+// AddMembers adds the given members to the GitHub team with the given ID,
+// which must be of the form 'orgID:teamID'. Members are added as in
+// SetMembers, without listing the current members of the team.
+func (g *TeamReadWriter) AddMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	ctx = withCostGroup(ctx, groupID)
+	orgID, teamID, err := parseID(groupID)
+	if err != nil {
+		return fmt.Errorf("could not parse groupID %s: %w", groupID, err)
+	}
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("could not create github client: %w", err)
+	}
+	return classifyErr(g.addMembers(ctx, client, orgID, teamID, groupID, members))
+}
+
+// RemoveMembers removes the given members from the GitHub team with the given
+// ID, which must be of the form 'orgID:teamID'.
+func (g *TeamReadWriter) RemoveMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	ctx = withCostGroup(ctx, groupID)
+	orgID, teamID, err := parseID(groupID)
+	if err != nil {
+		return fmt.Errorf("could not parse groupID %s: %w", groupID, err)
+	}
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("could not create github client: %w", err)
+	}
+	return classifyErr(g.removeMembers(ctx, client, orgID, teamID, groupID, members))
+}
+
+func (g *TeamReadWriter) addMembers(ctx context.Context, client *github.Client, orgID, teamID int64, groupID string, members []groupsync.Member) error {
 	var merr error
-	// Add GitHub team memberships.
-	for _, member := range addMembers {
+	for _, member := range members {
 		if member.IsUser() {
 			user, _ := member.User()
-			if err := g.addUserToTeam(ctx, client, orgID, teamID, user.ID); err != nil {
+			if err := g.addUserToTeam(ctx, client, orgID, teamID, user.ID, groupsync.MemberRole(member)); err != nil {
 				merr = errors.Join(merr, fmt.Errorf("failed to add user(%s) add user to team(%s): %w", user.ID, groupID, err))
 			}
 		} else if member.IsGroup() && g.includeSubTeams {
@@ -338,8 +585,12 @@ func (g *TeamReadWriter) SetMembers(ctx context.Context, groupID string, members
 			}
 		}
 	}
-	// Remove GitHub team memberships
-	for _, member := range removeMembers {
+	return merr
+}
+
+func (g *TeamReadWriter) removeMembers(ctx context.Context, client *github.Client, orgID, teamID int64, groupID string, members []groupsync.Member) error {
+	var merr error
+	for _, member := range members {
 		if member.IsUser() {
 			user, _ := member.User()
 			if _, err := client.Teams.RemoveTeamMembershipByID(ctx, orgID, teamID, user.ID); err != nil {
@@ -360,6 +611,80 @@ func (g *TeamReadWriter) SetMembers(ctx context.Context, groupID string, members
 	return merr
 }
 
+// ArchiveGroup renames the GitHub team with the given ID with the
+// ArchivedTeamPrefix, unless it has it already, and removes all its members.
+// The ID must be of the form 'orgID:teamID'.
+func (g *TeamReadWriter) ArchiveGroup(ctx context.Context, groupID string) error {
+	ctx = withCostGroup(ctx, groupID)
+	orgID, teamID, err := parseID(groupID)
+	if err != nil {
+		return fmt.Errorf("could not parse groupID %s: %w", groupID, err)
+	}
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("could not create github client: %w", err)
+	}
+	team, _, err := client.Teams.GetTeamByID(ctx, orgID, teamID)
+	if err != nil {
+		return fmt.Errorf("could not get team: %w", classifyErr(err))
+	}
+	if name := team.GetName(); !strings.HasPrefix(name, ArchivedTeamPrefix) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "renaming archived team",
+			"team_id", groupID,
+			"name", name,
+		)
+		team, _, err = client.Teams.EditTeamByID(ctx, orgID, teamID, github.NewTeam{Name: ArchivedTeamPrefix + name}, false)
+		if err != nil {
+			return fmt.Errorf("could not rename team %s: %w", groupID, classifyErr(err))
+		}
+		g.teamCache.Set(Encode(orgID, teamID), team)
+	}
+	if err := g.SetMembers(ctx, groupID, nil); err != nil {
+		return fmt.Errorf("could not remove members of team %s: %w", groupID, err)
+	}
+	return nil
+}
+
+// SetGroupLabels appends the given labels, along with the
+// groupsync.ManagedByMarker, to the description of the GitHub team with the
+// given ID, replacing the labels set before. The team is only edited if its
+// description changes. The ID must be of the form 'orgID:teamID'.
+func (g *TeamReadWriter) SetGroupLabels(ctx context.Context, groupID string, labels map[string]string) error {
+	ctx = withCostGroup(ctx, groupID)
+	orgID, teamID, err := parseID(groupID)
+	if err != nil {
+		return fmt.Errorf("could not parse groupID %s: %w", groupID, err)
+	}
+	client, err := g.githubClientForOrg(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("could not create github client: %w", err)
+	}
+	team, err := g.getGitHubTeam(ctx, client, orgID, teamID)
+	if err != nil {
+		return classifyErr(err)
+	}
+	description := groupsync.LabeledDescription(team.GetDescription(), labels)
+	if description == team.GetDescription() {
+		return nil
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "labeling team",
+		"team_id", groupID,
+		"description", description,
+	)
+	patch := github.NewTeam{Name: team.GetName(), Description: &description}
+	if team.Parent != nil {
+		patch.ParentTeamID = team.Parent.ID
+	}
+	team, _, err = client.Teams.EditTeamByID(ctx, orgID, teamID, patch, false)
+	if err != nil {
+		return fmt.Errorf("could not edit description of team %s: %w", groupID, classifyErr(err))
+	}
+	g.teamCache.Set(Encode(orgID, teamID), team)
+	return nil
+}
+
 func (g *TeamReadWriter) githubClientForOrg(ctx context.Context, orgID int64) (*github.Client, error) {
 	token, err := g.orgTokenSource.TokenForOrg(ctx, orgID)
 	if err != nil {
@@ -368,14 +693,22 @@ func (g *TeamReadWriter) githubClientForOrg(ctx context.Context, orgID int64) (*
 	return g.client.WithAuthToken(token), nil
 }
 
-func (g *TeamReadWriter) addUserToTeam(ctx context.Context, client *github.Client, orgID, teamID int64, userID string) error {
+// addUserToTeam adds the user to the team with the given role, "member" or
+// "maintainer". An empty role is "member".
+func (g *TeamReadWriter) addUserToTeam(ctx context.Context, client *github.Client, orgID, teamID int64, userID, role string) error {
+	if role == "" {
+		role = teamRoleMember
+	}
+	if role != teamRoleMember && role != teamRoleMaintainer {
+		return fmt.Errorf("invalid team role %q, must be %q or %q", role, teamRoleMember, teamRoleMaintainer)
+	}
 	orgIDStr := strconv.FormatInt(orgID, 10)
 	isMember, err := g.isOrgMember(ctx, client, orgIDStr, userID)
 	if err != nil {
 		return fmt.Errorf("could not check if user is a member of organization %d: %w", orgID, err)
 	}
 	if isMember {
-		membershipOpt := &github.TeamAddTeamMembershipOptions{Role: "member"}
+		membershipOpt := &github.TeamAddTeamMembershipOptions{Role: role}
 		// TODO: check userID SAML info and check if the given team requires user to enable SSO.
 		if _, _, err := client.Teams.AddTeamMembershipByID(ctx, orgID, teamID, userID, membershipOpt); err != nil {
 			return fmt.Errorf("failed to add GitHub user(%s) for team(%d): %w", userID, teamID, err)
@@ -441,19 +774,43 @@ func (g *TeamReadWriter) inviteToOrg(ctx context.Context, client *github.Client,
 	return nil
 }
 
-// parseID parses an ID string formatted using encode.
+// classifyErr marks GitHub primary and secondary rate limit errors, including
+// 429 responses, which go-github does not recognize as such, with
+// groupsync.ErrRateLimited and server errors with groupsync.ErrTransient so
+// that syncers can categorize and retry them. Other errors, including nil, are
+// returned as is.
+func classifyErr(err error) error {
+	var primaryErr *github.RateLimitError
+	var secondaryErr *github.AbuseRateLimitError
+	if errors.As(err, &primaryErr) || errors.As(err, &secondaryErr) {
+		return fmt.Errorf("%w: %w", groupsync.ErrRateLimited, err)
+	}
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		switch status := errResp.Response.StatusCode; {
+		case status == http.StatusTooManyRequests:
+			return fmt.Errorf("%w: %w", groupsync.ErrRateLimited, err)
+		case status >= http.StatusInternalServerError:
+			return fmt.Errorf("%w: %w", groupsync.ErrTransient, err)
+		}
+	}
+	return err
+}
+
+// parseID parses an ID string formatted using encode. Errors wrap
+// groupsync.ErrInvalidGroupID.
 func parseID(groupID string) (int64, int64, error) {
 	idComponents := strings.Split(groupID, IDSep)
 	if len(idComponents) != 2 {
-		return 0, 0, fmt.Errorf("invalid group id: %s", groupID)
+		return 0, 0, fmt.Errorf("%w: %s", groupsync.ErrInvalidGroupID, groupID)
 	}
 	orgID, err := strconv.ParseInt(strings.TrimSpace(idComponents[0]), 10, 64)
 	if err != nil {
-		return 0, 0, fmt.Errorf("could not parse %s as a github org ID: %w", idComponents[0], err)
+		return 0, 0, fmt.Errorf("%w: could not parse %s as a github org ID: %w", groupsync.ErrInvalidGroupID, idComponents[0], err)
 	}
 	teamID, err := strconv.ParseInt(strings.TrimSpace(idComponents[1]), 10, 64)
 	if err != nil {
-		return 0, 0, fmt.Errorf("could not parse %s as a github team ID: %w", idComponents[1], err)
+		return 0, 0, fmt.Errorf("%w: could not parse %s as a github team ID: %w", groupsync.ErrInvalidGroupID, idComponents[1], err)
 	}
 	return orgID, teamID, nil
 }
@@ -474,6 +831,15 @@ func Encode(orgID, teamID int64) string {
 	return fmt.Sprintf("%d%s%d", orgID, IDSep, teamID)
 }
 
+// sortedMembers returns the members of the given map, sorted by their keys.
+func sortedMembers(members map[string]groupsync.Member) []groupsync.Member {
+	sorted := make([]groupsync.Member, 0, len(members))
+	for _, id := range utils.MapKeys(members) {
+		sorted = append(sorted, members[id])
+	}
+	return sorted
+}
+
 func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
 	memberIDs := make(map[string]groupsync.Member, len(members))
 	for _, m := range members {
@@ -16,8 +16,11 @@ package github
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"math/rand"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -25,13 +28,17 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"testing/quick"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-github/v61/github"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/abcxyz/pkg/logging"
 	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/githubtest"
 	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
 )
 
 func TestTeamReadWriter_GetGroup(t *testing.T) {
@@ -121,7 +128,7 @@ func TestTeamReadWriter_GetGroup(t *testing.T) {
 			server := fakeGitHub(tc.data)
 			defer server.Close()
 
-			client := githubClient(server)
+			client := server.Client()
 
 			groupRW := NewTeamReadWriter(tc.tokenSource, client, nil)
 
@@ -137,6 +144,66 @@ func TestTeamReadWriter_GetGroup(t *testing.T) {
 	}
 }
 
+func TestTeamReadWriter_ListTeams(t *testing.T) {
+	t.Parallel()
+
+	team := func(id int64, slug string) *github.Team {
+		return &github.Team{ID: proto.Int64(id), Slug: proto.String(slug)}
+	}
+	data := &GitHubData{
+		orgLogins: map[string]string{"8583": "org1"},
+		teams: map[string]map[string]*github.Team{
+			"8583": {
+				"2797": team(2797, "team1"),
+				"9350": team(9350, "team2"),
+			},
+		},
+	}
+
+	cases := []struct {
+		name    string
+		orgID   int64
+		want    []*groupsync.Group
+		wantErr string
+	}{
+		{
+			name:  "success",
+			orgID: 8583,
+			want: []*groupsync.Group{
+				{ID: "8583:2797", Attributes: team(2797, "team1")},
+				{ID: "8583:9350", Attributes: team(9350, "team2")},
+			},
+		},
+		{
+			name:    "org_not_found",
+			orgID:   4701,
+			wantErr: "could not get org 4701",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			server := fakeGitHub(data)
+			defer server.Close()
+
+			tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token"}}
+			groupRW := NewTeamReadWriter(tokenSource, server.Client(), nil)
+
+			got, err := groupRW.ListTeams(ctx, tc.orgID)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected teams (-got, +want) = %v", diff)
+			}
+		})
+	}
+}
+
 func TestTeamReadWriter_GetMembers(t *testing.T) {
 	t.Parallel()
 
@@ -226,7 +293,8 @@ func TestTeamReadWriter_GetMembers(t *testing.T) {
 			want: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -236,7 +304,8 @@ func TestTeamReadWriter_GetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &github.User{
 							ID:    proto.Int64(3208),
 							Login: proto.String("user3"),
@@ -359,7 +428,8 @@ func TestTeamReadWriter_GetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -369,7 +439,8 @@ func TestTeamReadWriter_GetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &github.User{
 							ID:    proto.Int64(3208),
 							Login: proto.String("user3"),
@@ -467,7 +538,8 @@ func TestTeamReadWriter_GetMembers(t *testing.T) {
 			want: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -477,7 +549,8 @@ func TestTeamReadWriter_GetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &github.User{
 							ID:    proto.Int64(3208),
 							Login: proto.String("user3"),
@@ -498,7 +571,7 @@ func TestTeamReadWriter_GetMembers(t *testing.T) {
 			server := fakeGitHub(tc.data)
 			defer server.Close()
 
-			client := githubClient(server)
+			client := server.Client()
 
 			groupRW := NewTeamReadWriter(tc.tokenSource, client, nil, tc.opts...)
 
@@ -604,7 +677,8 @@ func TestTeamReadWriter_GetDescendants(t *testing.T) {
 			groupID: "8583:9350",
 			want: []*groupsync.User{
 				{
-					ID: "user1",
+					ID:       "user1",
+					StableID: "2286",
 					Attributes: &github.User{
 						ID:    proto.Int64(2286),
 						Login: proto.String("user1"),
@@ -612,7 +686,8 @@ func TestTeamReadWriter_GetDescendants(t *testing.T) {
 					},
 				},
 				{
-					ID: "user3",
+					ID:       "user3",
+					StableID: "3208",
 					Attributes: &github.User{
 						ID:    proto.Int64(3208),
 						Login: proto.String("user3"),
@@ -719,7 +794,8 @@ func TestTeamReadWriter_GetDescendants(t *testing.T) {
 			groupID: "8583:9350",
 			want: []*groupsync.User{
 				{
-					ID: "user1",
+					ID:       "user1",
+					StableID: "2286",
 					Attributes: &github.User{
 						ID:    proto.Int64(2286),
 						Login: proto.String("user1"),
@@ -727,7 +803,8 @@ func TestTeamReadWriter_GetDescendants(t *testing.T) {
 					},
 				},
 				{
-					ID: "user3",
+					ID:       "user3",
+					StableID: "3208",
 					Attributes: &github.User{
 						ID:    proto.Int64(3208),
 						Login: proto.String("user3"),
@@ -747,7 +824,7 @@ func TestTeamReadWriter_GetDescendants(t *testing.T) {
 			server := fakeGitHub(tc.data)
 			defer server.Close()
 
-			client := githubClient(server)
+			client := server.Client()
 
 			groupRW := NewTeamReadWriter(tc.tokenSource, client, nil)
 
@@ -808,7 +885,8 @@ func TestTeamReadWriter_GetUser(t *testing.T) {
 			},
 			userID: "user1",
 			want: &groupsync.User{
-				ID: "user1",
+				ID:       "user1",
+				StableID: "2286",
 				Attributes: &github.User{
 					ID:    proto.Int64(2286),
 					Login: proto.String("user1"),
@@ -827,7 +905,7 @@ func TestTeamReadWriter_GetUser(t *testing.T) {
 			server := fakeGitHub(tc.data)
 			defer server.Close()
 
-			client := githubClient(server)
+			client := server.Client()
 
 			groupRW := NewTeamReadWriter(tc.tokenSource, client, nil)
 
@@ -934,7 +1012,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			inputMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -944,7 +1023,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -954,7 +1034,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &github.User{
 							ID:    proto.Int64(3208),
 							Login: proto.String("user3"),
@@ -966,7 +1047,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			wantMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -976,7 +1058,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -986,7 +1069,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &github.User{
 							ID:    proto.Int64(3208),
 							Login: proto.String("user3"),
@@ -1073,7 +1157,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			inputMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1085,7 +1170,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			wantMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1172,7 +1258,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			inputMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1182,7 +1269,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -1194,7 +1282,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			wantMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1204,7 +1293,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -1333,7 +1423,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1343,7 +1434,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -1353,7 +1445,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &github.User{
 							ID:    proto.Int64(3208),
 							Login: proto.String("user3"),
@@ -1386,7 +1479,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1396,7 +1490,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -1406,7 +1501,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &github.User{
 							ID:    proto.Int64(3208),
 							Login: proto.String("user3"),
@@ -1509,7 +1605,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			inputMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1521,7 +1618,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			wantMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1653,7 +1751,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1663,7 +1762,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -1696,7 +1796,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1706,7 +1807,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -1823,7 +1925,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1833,7 +1936,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -1843,7 +1947,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &github.User{
 							ID:    proto.Int64(3208),
 							Login: proto.String("user3"),
@@ -1855,7 +1960,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			wantMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1865,7 +1971,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -1875,7 +1982,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &github.User{
 							ID:    proto.Int64(3208),
 							Login: proto.String("user3"),
@@ -1969,7 +2077,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			wantMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -1979,7 +2088,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -1989,7 +2099,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &github.User{
 							ID:    proto.Int64(3208),
 							Login: proto.String("user3"),
@@ -2076,7 +2187,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			inputMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -2086,7 +2198,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -2096,7 +2209,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &github.User{
 							ID:    proto.Int64(3208),
 							Login: proto.String("user3"),
@@ -2106,7 +2220,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "fakeuser",
+						ID:       "fakeuser",
+						StableID: "9999",
 						Attributes: &github.User{
 							ID:    proto.Int64(9999),
 							Login: proto.String("fakeuser"),
@@ -2118,7 +2233,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			wantMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &github.User{
 							ID:    proto.Int64(2286),
 							Login: proto.String("user1"),
@@ -2128,7 +2244,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &github.User{
 							ID:    proto.Int64(5660),
 							Login: proto.String("user2"),
@@ -2138,7 +2255,8 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &github.User{
 							ID:    proto.Int64(3208),
 							Login: proto.String("user3"),
@@ -2160,7 +2278,7 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 			server := fakeGitHub(tc.data)
 			defer server.Close()
 
-			client := githubClient(server)
+			client := server.Client()
 
 			groupRW := NewTeamReadWriter(tc.tokenSource, client, nil, tc.opts...)
 
@@ -2184,297 +2302,503 @@ func TestTeamReadWriter_SetMembers(t *testing.T) {
 	}
 }
 
-type fakeTokenSource struct {
-	orgTokens map[int64]string
-}
+func TestTeamReadWriter_ArchiveGroup(t *testing.T) {
+	t.Parallel()
 
-func (f *fakeTokenSource) TokenForOrg(ctx context.Context, orgID int64) (string, error) {
-	return f.orgTokens[orgID], nil
-}
+	ctx := context.Background()
+	data := &GitHubData{
+		users: map[string]*github.User{
+			"user1": {
+				ID:    proto.Int64(2286),
+				Login: proto.String("user1"),
+			},
+		},
+		teams: map[string]map[string]*github.Team{
+			"8583": {
+				"2797": &github.Team{
+					ID:   proto.Int64(2797),
+					Name: proto.String("team1"),
+					Organization: &github.Organization{
+						ID: proto.Int64(8583),
+					},
+				},
+			},
+		},
+		teamMembers: map[string]map[string]map[string]struct{}{
+			"8583": {
+				"2797": {
+					"user1": struct{}{},
+				},
+			},
+		},
+	}
+	server := fakeGitHub(data)
+	t.Cleanup(server.Close)
+	tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token"}}
+	rw := NewTeamReadWriter(tokenSource, server.Client(), nil)
 
-type GitHubData struct {
-	users       map[string]*github.User
-	teams       map[string]map[string]*github.Team
-	teamMembers map[string]map[string]map[string]struct{}
-}
+	// archiving twice does not prefix the name twice.
+	for range 2 {
+		if err := rw.ArchiveGroup(ctx, "8583:2797"); err != nil {
+			t.Fatalf("ArchiveGroup failed: %v", err)
+		}
+	}
+	if got, want := server.Team(8583, 2797).GetName(), "archived-team1"; got != want {
+		t.Errorf("team name got %q, want %q", got, want)
+	}
+	members, err := rw.GetMembers(ctx, "8583:2797")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if len(members) != 0 {
+		t.Errorf("archived team has members %v, want none", members)
+	}
 
-func githubClient(server *httptest.Server) *github.Client {
-	client := github.NewClient(nil)
-	baseURL, _ := url.Parse(server.URL + "/")
-	client.BaseURL = baseURL
-	return client
+	if err := rw.ArchiveGroup(ctx, "8583:1"); err == nil {
+		t.Errorf("ArchiveGroup of missing team got no error")
+	}
 }
 
-func fakeGitHub(githubData *GitHubData) *httptest.Server {
-	mux := http.NewServeMux()
-	mux.Handle("GET /users/{username}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		username := r.PathValue("username")
-		user, ok := githubData.users[username]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "user not found")
-			return
-		}
-		jsn, err := json.Marshal(user)
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to marshal user")
-			return
-		}
-		_, err = w.Write(jsn)
-		if err != nil {
-			return
-		}
-	}))
-	mux.Handle("GET /organizations/{org_id}/team/{team_id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "missing or malformed authorization header")
-			return
-		}
-		orgID := r.PathValue("org_id")
-		teamID := r.PathValue("team_id")
-		teams, ok := githubData.teams[orgID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "orgID not found")
-			return
-		}
-		team, ok := teams[teamID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "team not found")
-		}
-		jsn, err := json.Marshal(team)
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to marshal team")
-			return
-		}
-		_, err = w.Write(jsn)
-		if err != nil {
-			return
-		}
-	}))
-	mux.Handle("GET /organizations/{org_id}/team/{team_id}/members", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "missing or malformed authorization header")
-			return
-		}
-		orgID := r.PathValue("org_id")
-		teamID := r.PathValue("team_id")
-		teamMembers, ok := githubData.teamMembers[orgID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "orgID not found")
-			return
+func TestTeamReadWriter_SetGroupLabels(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	data := &GitHubData{
+		teams: map[string]map[string]*github.Team{
+			"8583": {
+				"2797": &github.Team{
+					ID:          proto.Int64(2797),
+					Name:        proto.String("team1"),
+					Description: proto.String("Infra team"),
+					Organization: &github.Organization{
+						ID: proto.Int64(8583),
+					},
+				},
+			},
+		},
+	}
+	server := fakeGitHub(data)
+	t.Cleanup(server.Close)
+	tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token"}}
+	rw := NewTeamReadWriter(tokenSource, server.Client(), nil)
+
+	// labeling twice leaves a single suffix.
+	for _, labels := range []map[string]string{{"env": "dev"}, {"env": "prod"}} {
+		if err := rw.SetGroupLabels(ctx, "8583:2797", labels); err != nil {
+			t.Fatalf("SetGroupLabels failed: %v", err)
 		}
-		members, ok := teamMembers[teamID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "team not found")
-			return
+	}
+	team := server.Team(8583, 2797)
+	if got, want := team.GetDescription(), "Infra team [managed by team-link; env=prod]"; got != want {
+		t.Errorf("team description got %q, want %q", got, want)
+	}
+	if got, want := team.GetName(), "team1"; got != want {
+		t.Errorf("team name got %q, want %q", got, want)
+	}
+
+	if err := rw.SetGroupLabels(ctx, "8583:1", nil); err == nil {
+		t.Errorf("SetGroupLabels of missing team got no error")
+	}
+}
+
+// TestTeamReadWriter_SetMembersIdempotent checks that setting the members a
+// team already has writes nothing, whatever their order, case and
+// duplicates.
+func TestTeamReadWriter_SetMembersIdempotent(t *testing.T) {
+	t.Parallel()
+
+	logins := []string{"alice", "bob", "carol", "dave", "erin"}
+	property := func(subset uint8, order []uint8, upper uint8) bool {
+		builder := githubtest.NewBuilder().WithOrg(8583, "org1")
+		for i, login := range logins {
+			builder.WithUser(&github.User{ID: proto.Int64(int64(i + 1)), Login: proto.String(login)})
 		}
-		var users []*github.User
-		for username := range members {
-			user, ok := githubData.users[username]
-			if !ok {
-				w.WriteHeader(500)
-				fmt.Fprintf(w, "user data inconsistency")
-				return
+		server := builder.
+			WithTeam(8583, &github.Team{ID: proto.Int64(1), Slug: proto.String("team1")}, "alice", "carol").
+			Start()
+		defer server.Close()
+		ctx := context.Background()
+
+		var members []groupsync.Member
+		for i, login := range logins {
+			if subset&(1<<i) != 0 {
+				members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: login}})
 			}
-			users = append(users, user)
-		}
-		jsn, err := json.Marshal(users)
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to marshal users")
-			return
-		}
-		_, err = w.Write(jsn)
-		if err != nil {
-			return
-		}
-	}))
-	mux.Handle("PUT /organizations/{org_id}/team/{team_id}/memberships/{username}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "missing or malformed authorization header")
-			return
-		}
-		orgID := r.PathValue("org_id")
-		teamID := r.PathValue("team_id")
-		username := strings.ToLower(r.PathValue("username"))
-		teamMembers, ok := githubData.teamMembers[orgID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "orgID not found")
-			return
-		}
-		members, ok := teamMembers[teamID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "team not found")
-			return
-		}
-		_, ok = githubData.users[username]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "user not found")
-			return
-		}
-		members[username] = struct{}{}
-		respBody := make(map[string]string)
-		respBody["url"] = r.URL.String()
-		respBody["role"] = "member"
-		respBody["state"] = "pending"
-		jsn, err := json.Marshal(respBody)
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to marshal response")
-			return
 		}
-		_, err = w.Write(jsn)
-		if err != nil {
-			return
+		if err := NewTeamReadWriter(NewStaticTokenSource("token"), server.Client(), nil).SetMembers(ctx, "8583:1", members); err != nil {
+			t.Logf("SetMembers failed: %v", err)
+			return false
 		}
-	}))
-	mux.Handle("DELETE /organizations/{org_id}/team/{team_id}/memberships/{username}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "missing or malformed authorization header")
-			return
-		}
-		orgID := r.PathValue("org_id")
-		teamID := r.PathValue("team_id")
-		username := strings.ToLower(r.PathValue("username"))
-		teamMembers, ok := githubData.teamMembers[orgID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "orgID not found")
-			return
+		want := server.TeamMembers(8583, 1)
+		writes := server.Writes()
+
+		// the rerun gets the members shuffled, partly uppercased and with a
+		// duplicate.
+		again := slices.Clone(members)
+		for i, o := range order {
+			if len(again) > 0 {
+				j := int(o) % len(again)
+				again[i%len(again)], again[j] = again[j], again[i%len(again)]
+			}
 		}
-		members, ok := teamMembers[teamID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "team not found")
-			return
+		for i, m := range again {
+			if upper&(1<<i) != 0 {
+				again[i] = &groupsync.UserMember{Usr: &groupsync.User{ID: strings.ToUpper(m.ID())}}
+			}
 		}
-		_, ok = githubData.users[username]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "user not found")
-			return
+		if len(again) > 0 {
+			again = append(again, again[0])
 		}
-		delete(members, username)
-		w.WriteHeader(http.StatusNoContent)
-	}))
-	mux.Handle("GET /organizations/{org_id}/team/{team_id}/teams", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "missing or malformed authorization header")
-			return
+		if err := NewTeamReadWriter(NewStaticTokenSource("token"), server.Client(), nil).SetMembers(ctx, "8583:1", again); err != nil {
+			t.Logf("SetMembers of rerun failed: %v", err)
+			return false
 		}
-		orgID := r.PathValue("org_id")
-		teamID := r.PathValue("team_id")
-		orgTeams, ok := githubData.teams[orgID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "orgID not found")
-			return
+		if got := server.Writes() - writes; got != 0 {
+			t.Logf("rerun with subset %b issued %d writes", subset, got)
+			return false
 		}
-		var childTeams []*github.Team
-		for _, team := range orgTeams {
-			if team.Parent != nil && team.Parent.ID != nil {
-				parentID := strconv.FormatInt(*team.Parent.ID, 10)
-				if parentID == teamID {
-					childTeams = append(childTeams, team)
+		return slices.Equal(server.TeamMembers(8583, 1), want)
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 50, Rand: rand.New(rand.NewSource(1))}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTeamReadWriter_AddRemoveMembers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := githubtest.NewBuilder().
+		WithOrg(8583, "org1").
+		WithUser(&github.User{ID: proto.Int64(1), Login: proto.String("user1")}).
+		WithUser(&github.User{ID: proto.Int64(2), Login: proto.String("user2")}).
+		WithUser(&github.User{ID: proto.Int64(3), Login: proto.String("user3")}).
+		WithTeam(8583, &github.Team{ID: proto.Int64(2797)}, "user1", "user2").
+		Start()
+	t.Cleanup(server.Close)
+	tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token"}}
+	rw := NewTeamReadWriter(tokenSource, server.Client(), nil)
+
+	if err := rw.AddMembers(ctx, "8583:2797", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "user3"}},
+	}); err != nil {
+		t.Fatalf("AddMembers failed: %v", err)
+	}
+	if err := rw.RemoveMembers(ctx, "8583:2797", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "user1"}},
+	}); err != nil {
+		t.Fatalf("RemoveMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(server.TeamMembers(8583, 2797), []string{"user2", "user3"}); diff != "" {
+		t.Errorf("unexpected team members (-got, +want):\n%s", diff)
+	}
+
+	if err := rw.AddMembers(ctx, "invalid", nil); err == nil {
+		t.Errorf("AddMembers with invalid group ID got no error")
+	}
+}
+
+func TestTeamReadWriter_LargeTeams(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		members    int
+		childTeams int
+		opts       []Opt
+		noLinks    bool
+	}{
+		{
+			name:       "default_page_size",
+			members:    1234,
+			childTeams: 150,
+		},
+		{
+			name:       "small_page_size",
+			members:    1234,
+			childTeams: 150,
+			opts:       []Opt{WithPageSize(7)},
+		},
+		{
+			name:       "without_link_headers",
+			members:    1234,
+			childTeams: 150,
+			noLinks:    true,
+		},
+		{
+			name:       "without_link_headers_full_last_page",
+			members:    1000,
+			childTeams: 200,
+			noLinks:    true,
+		},
+		{
+			name:       "without_link_headers_small_page_size",
+			members:    1001,
+			childTeams: 14,
+			opts:       []Opt{WithPageSize(7)},
+			noLinks:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := logging.WithLogger(context.Background(), logging.New(io.Discard, slog.LevelError, logging.FormatJSON, false))
+			builder := githubtest.NewBuilder().WithOrg(8583, "org1")
+			if tc.noLinks {
+				builder.WithoutLinkHeaders()
+			}
+			logins := make([]string, 0, tc.members)
+			for i := range tc.members {
+				login := fmt.Sprintf("user%d", i)
+				builder.WithUser(&github.User{ID: proto.Int64(int64(i)), Login: &login})
+				logins = append(logins, login)
+			}
+			builder.WithTeam(8583, &github.Team{ID: proto.Int64(1), Organization: &github.Organization{ID: proto.Int64(8583)}}, logins...)
+			wantIDs := append([]string{}, logins...)
+			for i := range tc.childTeams {
+				id := int64(i + 2)
+				builder.WithTeam(8583, &github.Team{
+					ID:           proto.Int64(id),
+					Organization: &github.Organization{ID: proto.Int64(8583)},
+					Parent:       &github.Team{ID: proto.Int64(1)},
+				})
+				wantIDs = append(wantIDs, Encode(8583, id))
+			}
+			server := builder.Start()
+			t.Cleanup(server.Close)
+			tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token"}}
+			rw := NewTeamReadWriter(tokenSource, server.Client(), nil, tc.opts...)
+
+			members, err := rw.GetMembers(ctx, "8583:1")
+			if err != nil {
+				t.Fatalf("GetMembers failed: %v", err)
+			}
+			gotIDs := make([]string, 0, len(members))
+			for _, m := range members {
+				gotIDs = append(gotIDs, m.ID())
+			}
+			slices.Sort(gotIDs)
+			slices.Sort(wantIDs)
+			if diff := cmp.Diff(gotIDs, wantIDs); diff != "" {
+				t.Errorf("unexpected members (-got, +want):\n%s", diff)
+			}
+
+			groups, err := rw.ListTeams(ctx, 8583)
+			if err != nil {
+				t.Fatalf("ListTeams failed: %v", err)
+			}
+			if got, want := len(groups), tc.childTeams+1; got != want {
+				t.Errorf("ListTeams got %d teams, want %d", got, want)
+			}
+
+			// in sync, so nothing is removed by a complete listing.
+			if err := rw.SetMembers(ctx, "8583:1", members); err != nil {
+				t.Fatalf("SetMembers failed: %v", err)
+			}
+			if got, want := len(server.TeamMembers(8583, 1)), tc.members; got != want {
+				t.Errorf("SetMembers left %d members, want %d", got, want)
+			}
+		})
+	}
+}
+
+func BenchmarkTeamReadWriter_SetMembers(b *testing.B) {
+	// members are logged, which would dominate the benchmark.
+	ctx := logging.WithLogger(context.Background(), logging.New(io.Discard, slog.LevelError, logging.FormatJSON, false))
+	for _, n := range []int{10_000, 100_000} {
+		b.Run(fmt.Sprintf("members=%d", n), func(b *testing.B) {
+			builder := githubtest.NewBuilder().WithOrg(8583, "org1")
+			logins := make([]string, 0, n)
+			members := make([]groupsync.Member, 0, n)
+			for i := range n {
+				login := fmt.Sprintf("user%d", i)
+				builder.WithUser(&github.User{ID: proto.Int64(int64(i)), Login: &login})
+				logins = append(logins, login)
+				members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: login}})
+			}
+			server := builder.WithTeam(8583, &github.Team{ID: proto.Int64(2797)}, logins...).Start()
+			b.Cleanup(server.Close)
+			tokenSource := &fakeTokenSource{orgTokens: map[int64]string{8583: "org_1_test_token"}}
+			rw := NewTeamReadWriter(tokenSource, server.Client(), nil)
+
+			// the team is in sync, so only its members are read and diffed.
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				if err := rw.SetMembers(ctx, "8583:2797", members); err != nil {
+					b.Fatalf("SetMembers failed: %v", err)
 				}
 			}
-		}
-		jsn, err := json.Marshal(childTeams)
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to marshal users")
-			return
-		}
-		_, err = w.Write(jsn)
-		if err != nil {
-			return
-		}
-	}))
-	mux.Handle("PATCH /organizations/{org_id}/team/{team_id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "missing or malformed authorization header")
-			return
-		}
-		orgID := r.PathValue("org_id")
-		teamID := r.PathValue("team_id")
-		payload := make(map[string]any)
-		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to read request body")
-			return
-		}
-		parentTeamID, ok := payload["parent_team_id"].(float64)
-		if ok {
-			// this is an add parent operation
-			teams, ok := githubData.teams[orgID]
-			if !ok {
-				w.WriteHeader(404)
-				fmt.Fprintf(w, "orgID not found")
-				return
+		})
+	}
+}
+
+func TestParseID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		groupID    string
+		wantOrgID  int64
+		wantTeamID int64
+		wantErr    string
+	}{
+		{
+			name:       "valid",
+			groupID:    "8583:2797",
+			wantOrgID:  8583,
+			wantTeamID: 2797,
+		},
+		{
+			name:    "missing_separator",
+			groupID: "8583",
+			wantErr: "invalid group ID: 8583",
+		},
+		{
+			name:    "invalid_org_id",
+			groupID: "org:2797",
+			wantErr: "invalid group ID: could not parse org as a github org ID",
+		},
+		{
+			name:    "invalid_team_id",
+			groupID: "8583:team",
+			wantErr: "invalid group ID: could not parse team as a github team ID",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			orgID, teamID, err := parseID(tc.groupID)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if err != nil && !errors.Is(err, groupsync.ErrInvalidGroupID) {
+				t.Errorf("parseID got err %v, want %v", err, groupsync.ErrInvalidGroupID)
 			}
-			team, ok := teams[teamID]
-			if !ok {
-				w.WriteHeader(404)
-				fmt.Fprintf(w, "team not found")
-				return
+			if orgID != tc.wantOrgID || teamID != tc.wantTeamID {
+				t.Errorf("parseID got %d:%d, want %d:%d", orgID, teamID, tc.wantOrgID, tc.wantTeamID)
 			}
-			parentTeam, ok := teams[strconv.FormatInt(int64(parentTeamID), 10)]
-			if !ok {
-				w.WriteHeader(404)
-				fmt.Fprintf(w, "parent team not found")
-				return
+		})
+	}
+}
+
+func TestClassifyErr(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		err           error
+		wantRateLimit bool
+		wantTransient bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "other_error",
+			err:  fmt.Errorf("boom"),
+		},
+		{
+			name:          "primary_rate_limit",
+			err:           fmt.Errorf("failed to list: %w", &github.RateLimitError{Message: "slow down"}),
+			wantRateLimit: true,
+		},
+		{
+			name:          "secondary_rate_limit",
+			err:           &github.AbuseRateLimitError{Message: "slow down"},
+			wantRateLimit: true,
+		},
+		{
+			name: "too_many_requests",
+			err: &github.ErrorResponse{
+				Response: &http.Response{StatusCode: http.StatusTooManyRequests},
+			},
+			wantRateLimit: true,
+		},
+		{
+			name: "server_error",
+			err: &github.ErrorResponse{
+				Response: &http.Response{StatusCode: http.StatusBadGateway},
+			},
+			wantTransient: true,
+		},
+		{
+			name: "client_error",
+			err: &github.ErrorResponse{
+				Response: &http.Response{StatusCode: http.StatusNotFound},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := classifyErr(tc.err)
+			if tc.err == nil && got != nil {
+				t.Errorf("expected nil error, got %v", got)
 			}
-			team.Parent = parentTeam
-		} else {
-			// this is a remove parent operation
-			teams, ok := githubData.teams[orgID]
-			if !ok {
-				w.WriteHeader(404)
-				fmt.Fprintf(w, "orgID not found")
-				return
+			if gotRateLimit := errors.Is(got, groupsync.ErrRateLimited); gotRateLimit != tc.wantRateLimit {
+				t.Errorf("errors.Is(ErrRateLimited) = %t, want %t", gotRateLimit, tc.wantRateLimit)
 			}
-			team, ok := teams[teamID]
-			if !ok {
-				w.WriteHeader(404)
-				fmt.Fprintf(w, "team not found")
-				return
+			if gotTransient := errors.Is(got, groupsync.ErrTransient); gotTransient != tc.wantTransient {
+				t.Errorf("errors.Is(ErrTransient) = %t, want %t", gotTransient, tc.wantTransient)
 			}
-			team.Parent = nil
-		}
-		team := githubData.teams[orgID][teamID]
-		jsn, err := json.Marshal(team)
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to marshal team")
-			return
+		})
+	}
+}
+
+type fakeTokenSource struct {
+	orgTokens map[int64]string
+}
+
+func (f *fakeTokenSource) TokenForOrg(ctx context.Context, orgID int64) (string, error) {
+	return f.orgTokens[orgID], nil
+}
+
+type GitHubData struct {
+	users       map[string]*github.User
+	orgLogins   map[string]string
+	teams       map[string]map[string]*github.Team
+	teamMembers map[string]map[string]map[string]struct{}
+}
+
+func githubClient(server *httptest.Server) *github.Client {
+	client := github.NewClient(nil)
+	baseURL, _ := url.Parse(server.URL + "/")
+	client.BaseURL = baseURL
+	return client
+}
+
+// fakeGitHub starts a githubtest.Server serving githubData.
+func fakeGitHub(githubData *GitHubData) *githubtest.Server {
+	builder := githubtest.NewBuilder()
+	for orgID, login := range githubData.orgLogins {
+		builder.WithOrg(fakeID(orgID), login)
+	}
+	for _, user := range githubData.users {
+		builder.WithUser(user)
+	}
+	for orgID, teams := range githubData.teams {
+		for _, team := range teams {
+			builder.WithTeam(fakeID(orgID), team)
 		}
-		_, err = w.Write(jsn)
-		if err != nil {
-			return
+	}
+	for orgID, members := range githubData.teamMembers {
+		for teamID, logins := range members {
+			builder.WithTeamMembers(fakeID(orgID), fakeID(teamID), utils.MapKeys(logins)...)
 		}
-	}))
-	return httptest.NewServer(mux)
+	}
+	return builder.Start()
+}
+
+func fakeID(id string) int64 {
+	i, _ := strconv.ParseInt(id, 10, 64)
+	return i
 }
 
 func sortByID(members []groupsync.Member) {
@@ -19,19 +19,32 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/abcxyz/pkg/githubauth"
+	"github.com/abcxyz/pkg/logging"
 	"github.com/abcxyz/team-link/pkg/credentials"
+	"github.com/abcxyz/team-link/pkg/state"
 )
 
 // DefaultStaticTokenEnvVar is where we read default github token from.
 // This is the default EnvVar we will write to, nosec here to avoid linting.
 const DefaultStaticTokenEnvVar = "TEAM_LINK_GITHUB_TOKEN" // #nosec G101
 
+// DefaultOrgTokenEnvVarPrefix is the prefix of the env vars EnvTokenSource
+// reads the token of each org from, followed by the org ID.
+const DefaultOrgTokenEnvVarPrefix = "TEAMLINK_GITHUB_TOKEN_" // #nosec G101
+
+// DefaultTokenCacheDuration is how long CachingTokenSource caches tokens.
+// GitHub App installation tokens are valid for an hour, this leaves a margin
+// for the token to be used.
+const DefaultTokenCacheDuration = 50 * time.Minute
+
 type AppTokenSource struct {
-	keyProvider credentials.KeyProvider
-	appID       string
-	appOpts     []githubauth.Option
+	keyProvider    credentials.KeyProvider
+	appID          string
+	installationID string
+	appOpts        []githubauth.Option
 }
 
 func NewAppTokenSource(keyProvider credentials.KeyProvider, appID string, appOpts ...githubauth.Option) *AppTokenSource {
@@ -42,8 +55,20 @@ func NewAppTokenSource(keyProvider credentials.KeyProvider, appID string, appOpt
 	}
 }
 
+// NewInstallationTokenSource creates an AppTokenSource which mints tokens for
+// the given installation of the app, instead of looking up the installation
+// on each org.
+func NewInstallationTokenSource(keyProvider credentials.KeyProvider, appID, installationID string, appOpts ...githubauth.Option) *AppTokenSource {
+	return &AppTokenSource{
+		keyProvider:    keyProvider,
+		appID:          appID,
+		installationID: installationID,
+		appOpts:        appOpts,
+	}
+}
+
 func (s *AppTokenSource) TokenForOrg(ctx context.Context, orgID int64) (string, error) {
-	// TODO(https://github.com/abcxyz/team-link/issues/45): Consider caching the tokens we mint in this method.
+	// Tokens minted here can be cached with NewCachingTokenSource.
 	privateKey, err := s.keyProvider.Key(ctx)
 	if err != nil {
 		return "", fmt.Errorf("unable to get GitHub app private key: %w", err)
@@ -57,7 +82,12 @@ func (s *AppTokenSource) TokenForOrg(ctx context.Context, orgID int64) (string,
 	if err != nil {
 		return "", fmt.Errorf("unable to create GitHub app: %w", err)
 	}
-	appInstallation, err := app.InstallationForOrg(ctx, strconv.FormatInt(orgID, 10))
+	var appInstallation *githubauth.AppInstallation
+	if s.installationID != "" {
+		appInstallation, err = app.InstallationForID(ctx, s.installationID)
+	} else {
+		appInstallation, err = app.InstallationForOrg(ctx, strconv.FormatInt(orgID, 10))
+	}
 	if err != nil {
 		return "", fmt.Errorf("failed to get installation for org %d: %w", orgID, err)
 	}
@@ -85,6 +115,13 @@ func (s *StaticTokenSource) GetStaticToken() string {
 	return s.token
 }
 
+// NewStaticTokenSource creates a StaticTokenSource using the given token.
+func NewStaticTokenSource(token string) *StaticTokenSource {
+	return &StaticTokenSource{
+		token: token,
+	}
+}
+
 // NewStaticTokenSourceFromEnvVar creates a StaticTokenSource using token read from EnvVar.
 func NewStaticTokenSourceFromEnvVar(envVarName string) (*StaticTokenSource, error) {
 	if envVarName == "" {
@@ -99,3 +136,101 @@ func NewStaticTokenSourceFromEnvVar(envVarName string) (*StaticTokenSource, erro
 		token: token,
 	}, nil
 }
+
+// EnvTokenSource implements OrgTokenSource by reading the token of each org
+// from an env var named after the org ID, e.g. TEAMLINK_GITHUB_TOKEN_123.
+// The env vars are read each time a token is requested.
+type EnvTokenSource struct {
+	prefix   string
+	fallback OrgTokenSource
+	getenv   func(string) string
+}
+
+// NewEnvTokenSource creates an EnvTokenSource reading the env vars with the
+// given prefix, DefaultOrgTokenEnvVarPrefix if empty. Orgs without an env var
+// get their token from fallback, or fail if fallback is nil.
+func NewEnvTokenSource(prefix string, fallback OrgTokenSource) *EnvTokenSource {
+	if prefix == "" {
+		prefix = DefaultOrgTokenEnvVarPrefix
+	}
+	return &EnvTokenSource{
+		prefix:   prefix,
+		fallback: fallback,
+		getenv:   os.Getenv,
+	}
+}
+
+func (s *EnvTokenSource) TokenForOrg(ctx context.Context, orgID int64) (string, error) {
+	envVar := s.prefix + strconv.FormatInt(orgID, 10)
+	if token := s.getenv(envVar); token != "" {
+		return token, nil
+	}
+	if s.fallback == nil {
+		return "", fmt.Errorf("failed to get token for org %d from env var: %s", orgID, envVar)
+	}
+	return s.fallback.TokenForOrg(ctx, orgID) //nolint:wrapcheck // Want passthrough
+}
+
+// PerOrgTokenSource implements OrgTokenSource by delegating to the token
+// source configured for each org, e.g. an AppTokenSource per org for orgs
+// managed by separate GitHub Apps.
+type PerOrgTokenSource struct {
+	sources  map[int64]OrgTokenSource
+	fallback OrgTokenSource
+}
+
+// NewPerOrgTokenSource creates a PerOrgTokenSource using the given token
+// sources keyed by org ID. Orgs without a token source get their token from
+// fallback, or fail if fallback is nil.
+func NewPerOrgTokenSource(sources map[int64]OrgTokenSource, fallback OrgTokenSource) *PerOrgTokenSource {
+	return &PerOrgTokenSource{
+		sources:  sources,
+		fallback: fallback,
+	}
+}
+
+func (s *PerOrgTokenSource) TokenForOrg(ctx context.Context, orgID int64) (string, error) {
+	source, ok := s.sources[orgID]
+	if !ok {
+		source = s.fallback
+	}
+	if source == nil {
+		return "", fmt.Errorf("no token source configured for org %d", orgID)
+	}
+	return source.TokenForOrg(ctx, orgID) //nolint:wrapcheck // Want passthrough
+}
+
+// CachingTokenSource implements OrgTokenSource by caching the tokens of
+// another OrgTokenSource in a state.Store. With a store shared by several
+// team-link processes, e.g. a state.RedisStore, they reuse each other's
+// tokens instead of each minting their own. The tokens are stored in plain
+// text, so the store must be protected accordingly.
+type CachingTokenSource struct {
+	source OrgTokenSource
+	cache  *state.Cache[string]
+}
+
+// NewCachingTokenSource creates a CachingTokenSource which caches the tokens
+// of source in store for ttl, which must be shorter than the validity of the
+// tokens, see DefaultTokenCacheDuration.
+func NewCachingTokenSource(source OrgTokenSource, store state.Store, ttl time.Duration) *CachingTokenSource {
+	return &CachingTokenSource{
+		source: source,
+		cache:  state.NewCache[string](store, state.Key("cache", "github", "tokens"), ttl),
+	}
+}
+
+func (s *CachingTokenSource) TokenForOrg(ctx context.Context, orgID int64) (string, error) {
+	key := strconv.FormatInt(orgID, 10)
+	if token, ok := s.cache.Lookup(ctx, key); ok {
+		return token, nil
+	}
+	token, err := s.source.TokenForOrg(ctx, orgID)
+	if err != nil {
+		return "", err //nolint:wrapcheck // Want passthrough
+	}
+	if err := s.cache.Set(ctx, key, token); err != nil {
+		logging.FromContext(ctx).WarnContext(ctx, "failed to cache token", "org_id", orgID, "error", err)
+	}
+	return token, nil
+}
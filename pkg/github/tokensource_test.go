@@ -0,0 +1,171 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+type countingTokenSource struct {
+	calls int
+}
+
+func (s *countingTokenSource) TokenForOrg(ctx context.Context, orgID int64) (string, error) {
+	s.calls++
+	return fmt.Sprintf("token-%d-%d", orgID, s.calls), nil
+}
+
+func TestCachingTokenSource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := state.NewMemoryStore()
+	source := &countingTokenSource{}
+
+	// two processes sharing the store reuse each other's tokens.
+	for _, ts := range []*CachingTokenSource{
+		NewCachingTokenSource(source, store, DefaultTokenCacheDuration),
+		NewCachingTokenSource(source, store, DefaultTokenCacheDuration),
+	} {
+		for _, tc := range []struct {
+			orgID int64
+			want  string
+		}{
+			{orgID: 1, want: "token-1-1"},
+			{orgID: 2, want: "token-2-2"},
+		} {
+			got, err := ts.TokenForOrg(ctx, tc.orgID)
+			if err != nil {
+				t.Fatalf("TokenForOrg(%d) failed: %v", tc.orgID, err)
+			}
+			if got != tc.want {
+				t.Errorf("TokenForOrg(%d) got %q, want %q", tc.orgID, got, tc.want)
+			}
+		}
+	}
+	if got, want := source.calls, 2; got != want {
+		t.Errorf("minted %d tokens, want %d", got, want)
+	}
+}
+
+func TestEnvTokenSource(t *testing.T) {
+	t.Parallel()
+
+	env := map[string]string{
+		"TEAMLINK_GITHUB_TOKEN_1": "token-1",
+		"CUSTOM_2":                "token-2",
+	}
+
+	cases := []struct {
+		name     string
+		prefix   string
+		fallback OrgTokenSource
+		orgID    int64
+		want     string
+		wantErr  string
+	}{
+		{
+			name:  "default_prefix",
+			orgID: 1,
+			want:  "token-1",
+		},
+		{
+			name:   "custom_prefix",
+			prefix: "CUSTOM_",
+			orgID:  2,
+			want:   "token-2",
+		},
+		{
+			name:     "fallback",
+			fallback: NewStaticTokenSource("token-default"),
+			orgID:    2,
+			want:     "token-default",
+		},
+		{
+			name:    "missing",
+			orgID:   2,
+			wantErr: "failed to get token for org 2 from env var: TEAMLINK_GITHUB_TOKEN_2",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ts := NewEnvTokenSource(tc.prefix, tc.fallback)
+			ts.getenv = func(k string) string { return env[k] }
+			got, err := ts.TokenForOrg(context.Background(), tc.orgID)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if got != tc.want {
+				t.Errorf("TokenForOrg(%d) got %q, want %q", tc.orgID, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPerOrgTokenSource(t *testing.T) {
+	t.Parallel()
+
+	sources := map[int64]OrgTokenSource{
+		1: NewStaticTokenSource("app-1"),
+		2: NewStaticTokenSource("app-2"),
+	}
+
+	cases := []struct {
+		name     string
+		fallback OrgTokenSource
+		orgID    int64
+		want     string
+		wantErr  string
+	}{
+		{
+			name:  "configured_org",
+			orgID: 2,
+			want:  "app-2",
+		},
+		{
+			name:     "fallback",
+			fallback: NewStaticTokenSource("default-app"),
+			orgID:    3,
+			want:     "default-app",
+		},
+		{
+			name:    "unconfigured_org",
+			orgID:   3,
+			wantErr: "no token source configured for org 3",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewPerOrgTokenSource(sources, tc.fallback).TokenForOrg(context.Background(), tc.orgID)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if got != tc.want {
+				t.Errorf("TokenForOrg(%d) got %q, want %q", tc.orgID, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,941 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package githubtest provides a fake GitHub API server for testing code which
+// reads and writes GitHub teams, e.g. with github.TeamReadWriter, without
+// calling GitHub.
+package githubtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v61/github"
+)
+
+// Builder builds the orgs, teams and users served by a Server.
+type Builder struct {
+	server *Server
+}
+
+// NewBuilder creates a Builder of a Server without any org, team or user.
+func NewBuilder() *Builder {
+	return &Builder{server: &Server{
+		users:       make(map[string]*github.User),
+		orgLogins:   make(map[string]string),
+		teams:       make(map[string]map[string]*github.Team),
+		teamMembers: make(map[string]map[string]map[string]struct{}),
+		identities:  make(map[string][]*externalIdentity),
+		repos:       make(map[string][]*github.Repository),
+		teamRepos:   make(map[string]map[string]string),
+		envs:        make(map[string]*github.Environment),
+		pushTeams:   make(map[string][]string),
+		reviews:     make(map[string]*github.PullRequestReviewsEnforcement),
+	}}
+}
+
+// WithOrg adds an org with the given ID and login, without any team.
+func (b *Builder) WithOrg(orgID int64, login string) *Builder {
+	id := strconv.FormatInt(orgID, 10)
+	b.server.orgLogins[id] = login
+	b.server.addOrg(id)
+	return b
+}
+
+// WithUser adds a user, identified by its login.
+func (b *Builder) WithUser(user *github.User) *Builder {
+	u := *user
+	b.server.users[u.GetLogin()] = &u
+	return b
+}
+
+// WithTeam adds a team, identified by its ID, to the org with the given ID,
+// with the users with the given logins as its members. Child teams refer to
+// their parent with the Parent field.
+func (b *Builder) WithTeam(orgID int64, team *github.Team, members ...string) *Builder {
+	b.WithTeamMembers(orgID, team.GetID(), members...)
+	b.server.SetTeam(orgID, team)
+	return b
+}
+
+// WithTeamMembers sets the members of the team with the given ID to the users
+// with the given logins. The team need not be added with WithTeam, which
+// allows serving the members of a team the org does not list.
+func (b *Builder) WithTeamMembers(orgID, teamID int64, members ...string) *Builder {
+	id := strconv.FormatInt(orgID, 10)
+	b.server.addOrg(id)
+	set := make(map[string]struct{}, len(members))
+	for _, m := range members {
+		set[m] = struct{}{}
+	}
+	b.server.teamMembers[id][strconv.FormatInt(teamID, 10)] = set
+	return b
+}
+
+// WithSAMLIdentity links the SAML identity with the given NameID to the user
+// with the given login in the org with the given ID, which enables SAML
+// single sign-on for the org.
+func (b *Builder) WithSAMLIdentity(orgID int64, nameID, login string) *Builder {
+	return b.withIdentity(strconv.FormatInt(orgID, 10), &externalIdentity{nameID: nameID, login: login})
+}
+
+// WithSCIMIdentity adds a SCIM user with the given externalId, provisioned to
+// the org with the given ID and linked to the user with the given login.
+func (b *Builder) WithSCIMIdentity(orgID int64, externalID, login string) *Builder {
+	return b.withIdentity(strconv.FormatInt(orgID, 10), &externalIdentity{externalID: externalID, login: login})
+}
+
+// WithEnterpriseSCIMIdentity adds a SCIM user with the given externalId,
+// provisioned to the enterprise with the given slug, like Enterprise Managed
+// Users, and linked to the user with the given login.
+func (b *Builder) WithEnterpriseSCIMIdentity(enterprise, externalID, login string) *Builder {
+	return b.withIdentity(enterpriseKey(enterprise), &externalIdentity{externalID: externalID, login: login})
+}
+
+// WithRepo adds a repository, identified by its name, to the org with the
+// given ID, which must have been added with WithOrg.
+func (b *Builder) WithRepo(orgID int64, repo *github.Repository) *Builder {
+	id := strconv.FormatInt(orgID, 10)
+	r := *repo
+	r.Owner = &github.User{Login: github.String(b.server.orgLogins[id])}
+	b.server.repos[id] = append(b.server.repos[id], &r)
+	return b
+}
+
+// WithTeamRepo grants the team with the given ID of the org with the given ID
+// the given permission on the repository with the given name.
+func (b *Builder) WithTeamRepo(orgID, teamID int64, repo, permission string) *Builder {
+	key := teamRepoKey(strconv.FormatInt(orgID, 10), strconv.FormatInt(teamID, 10))
+	if b.server.teamRepos[key] == nil {
+		b.server.teamRepos[key] = make(map[string]string)
+	}
+	b.server.teamRepos[key][repo] = permission
+	return b
+}
+
+// WithEnvironment adds a deployment environment, identified by its name, to
+// the repository with the given name of the org with the given ID.
+func (b *Builder) WithEnvironment(orgID int64, repo string, env *github.Environment) *Builder {
+	e := *env
+	b.server.envs[repoKey(b.server.orgLogins[strconv.FormatInt(orgID, 10)], repo, env.GetName())] = &e
+	return b
+}
+
+// WithPushRestrictions restricts who can push to the protected branch with the
+// given name of the repository with the given name of the org with the given
+// ID, allowing the teams with the given slugs.
+func (b *Builder) WithPushRestrictions(orgID int64, repo, branch string, teamSlugs ...string) *Builder {
+	key := repoKey(b.server.orgLogins[strconv.FormatInt(orgID, 10)], repo, branch)
+	b.server.pushTeams[key] = append([]string{}, teamSlugs...)
+	return b
+}
+
+// WithPullRequestReviews requires pull requests before merging into the
+// protected branch with the given name of the repository with the given name
+// of the org with the given ID.
+func (b *Builder) WithPullRequestReviews(orgID int64, repo, branch string, reviews *github.PullRequestReviewsEnforcement) *Builder {
+	r := *reviews
+	b.server.reviews[repoKey(b.server.orgLogins[strconv.FormatInt(orgID, 10)], repo, branch)] = &r
+	return b
+}
+
+func (b *Builder) withIdentity(owner string, id *externalIdentity) *Builder {
+	id.guid = fmt.Sprintf("guid-%s-%d", owner, len(b.server.identities[owner]))
+	b.server.identities[owner] = append(b.server.identities[owner], id)
+	return b
+}
+
+// WithoutLinkHeaders omits the Link headers of paginated responses, like
+// proxies which strip them, so that clients only know a list is complete from
+// a page which is not full.
+func (b *Builder) WithoutLinkHeaders() *Builder {
+	b.server.noLinks = true
+	return b
+}
+
+// Start starts a Server serving what was built. It must be closed with Close.
+func (b *Builder) Start() *Server {
+	s := b.server
+	s.Server = httptest.NewServer(s.handler())
+	return s
+}
+
+// Server is a fake GitHub API server. It serves the endpoints used to read and
+// write teams, their members, their repository permissions, the reviewers of
+// deployment environments and the team allowances of protected branches, and
+// to list the SAML and SCIM identities of orgs and enterprises, which require
+// a bearer token, like the installation tokens of GitHub apps, except for
+// users and orgs. Teams, their members, their repository permissions,
+// environments and branch protections are changed by requests. Lists are
+// paginated like GitHub does, with 30 items per page unless the per_page
+// parameter, at most 100, says otherwise, and Link headers to the next and
+// last pages.
+type Server struct {
+	*httptest.Server
+
+	noLinks     bool
+	writes      int
+	mu          sync.Mutex
+	users       map[string]*github.User
+	orgLogins   map[string]string
+	teams       map[string]map[string]*github.Team
+	teamMembers map[string]map[string]map[string]struct{}
+	// identities are the external identities of orgs, keyed by org ID, and
+	// of enterprises, keyed by enterpriseKey.
+	identities map[string][]*externalIdentity
+	repos      map[string][]*github.Repository
+	// teamRepos are the permissions of teams on repositories, keyed by
+	// teamRepoKey and repository name.
+	teamRepos map[string]map[string]string
+	// envs are the deployment environments of repositories, keyed by repoKey.
+	envs map[string]*github.Environment
+	// pushTeams are the slugs of the teams allowed to push to protected
+	// branches which restrict who can push, and reviews are the required pull
+	// request reviews of protected branches, both keyed by repoKey with the
+	// branch as name.
+	pushTeams map[string][]string
+	reviews   map[string]*github.PullRequestReviewsEnforcement
+}
+
+func teamRepoKey(orgID, teamID string) string {
+	return orgID + "/" + teamID
+}
+
+// repoKey identifies an environment or a branch, by its name, of a repository.
+func repoKey(owner, repo, name string) string {
+	return owner + "/" + repo + "/" + name
+}
+
+// externalIdentity is a SAML identity, with a NameID, or a SCIM user, with an
+// externalId, linked to a user.
+type externalIdentity struct {
+	guid       string
+	nameID     string
+	externalID string
+	login      string
+}
+
+func enterpriseKey(slug string) string {
+	return "enterprise/" + slug
+}
+
+// Client returns a GitHub client calling the server. Team endpoints need a
+// token, e.g. set with WithAuthToken.
+func (s *Server) Client() *github.Client {
+	client := github.NewClient(nil)
+	baseURL, _ := url.Parse(s.URL + "/")
+	client.BaseURL = baseURL
+	return client
+}
+
+// Writes returns the number of write requests the server received, e.g. to
+// check that an unchanged sync writes nothing.
+func (s *Server) Writes() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.writes
+}
+
+// Team returns the team with the given ID of the org with the given ID, or nil
+// if there is none.
+func (s *Server) Team(orgID, teamID int64) *github.Team {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	team, ok := s.teams[strconv.FormatInt(orgID, 10)][strconv.FormatInt(teamID, 10)]
+	if !ok {
+		return nil
+	}
+	t := *team
+	return &t
+}
+
+// SetTeam adds or replaces a team of the org with the given ID while the
+// server is running, e.g. to rename it outside of the code under test.
+func (s *Server) SetTeam(orgID int64, team *github.Team) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := strconv.FormatInt(orgID, 10)
+	s.addOrg(id)
+	t := *team
+	s.teams[id][strconv.FormatInt(t.GetID(), 10)] = &t
+}
+
+// TeamMembers returns the sorted logins of the members of the team with the
+// given ID of the org with the given ID.
+func (s *Server) TeamMembers(orgID, teamID int64) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var logins []string
+	for login := range s.teamMembers[strconv.FormatInt(orgID, 10)][strconv.FormatInt(teamID, 10)] {
+		logins = append(logins, login)
+	}
+	slices.Sort(logins)
+	return logins
+}
+
+// TeamRepos returns the permissions of the team with the given ID of the org
+// with the given ID, keyed by repository name.
+func (s *Server) TeamRepos(orgID, teamID int64) map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return maps.Clone(s.teamRepos[teamRepoKey(strconv.FormatInt(orgID, 10), strconv.FormatInt(teamID, 10))])
+}
+
+// Environment returns the deployment environment with the given name of the
+// repository with the given name of the org with the given ID, or nil if there
+// is none.
+func (s *Server) Environment(orgID int64, repo, name string) *github.Environment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	env, ok := s.envs[repoKey(s.orgLogins[strconv.FormatInt(orgID, 10)], repo, name)]
+	if !ok {
+		return nil
+	}
+	e := *env
+	return &e
+}
+
+// PushTeams returns the sorted slugs of the teams allowed to push to the
+// protected branch with the given name of the repository with the given name
+// of the org with the given ID.
+func (s *Server) PushTeams(orgID int64, repo, branch string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	slugs := slices.Clone(s.pushTeams[repoKey(s.orgLogins[strconv.FormatInt(orgID, 10)], repo, branch)])
+	slices.Sort(slugs)
+	return slugs
+}
+
+// PullRequestReviews returns the required pull request reviews of the
+// protected branch with the given name of the repository with the given name
+// of the org with the given ID, or nil if there are none.
+func (s *Server) PullRequestReviews(orgID int64, repo, branch string) *github.PullRequestReviewsEnforcement {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	reviews, ok := s.reviews[repoKey(s.orgLogins[strconv.FormatInt(orgID, 10)], repo, branch)]
+	if !ok {
+		return nil
+	}
+	r := *reviews
+	return &r
+}
+
+// teamBySlug returns the team with the given slug of the org with the given
+// ID, or nil if there is none.
+func (s *Server) teamBySlug(orgID, slug string) *github.Team {
+	for _, team := range s.teams[orgID] {
+		if team.GetSlug() == slug {
+			return team
+		}
+	}
+	return nil
+}
+
+// addOrg adds the org with the given ID, unless it exists.
+func (s *Server) addOrg(id string) {
+	if _, ok := s.teams[id]; !ok {
+		s.teams[id] = make(map[string]*github.Team)
+	}
+	if _, ok := s.teamMembers[id]; !ok {
+		s.teamMembers[id] = make(map[string]map[string]struct{})
+	}
+}
+
+// orgID returns the ID of the org with the given login.
+func (s *Server) orgID(login string) string {
+	for id, l := range s.orgLogins {
+		if l == login {
+			return id
+		}
+	}
+	return ""
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/{username}", func(w http.ResponseWriter, r *http.Request) {
+		user, ok := s.users[r.PathValue("username")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		writeJSON(w, user)
+	})
+	mux.HandleFunc("GET /organizations/{org_id}", func(w http.ResponseWriter, r *http.Request) {
+		orgID := r.PathValue("org_id")
+		login, ok := s.orgLogins[orgID]
+		if !ok {
+			writeError(w, http.StatusNotFound, "orgID not found")
+			return
+		}
+		id, _ := strconv.ParseInt(orgID, 10, 64)
+		writeJSON(w, &github.Organization{ID: &id, Login: &login})
+	})
+	mux.HandleFunc("GET /orgs/{org}/teams", authorized(func(w http.ResponseWriter, r *http.Request) {
+		teams, ok := s.teams[s.orgID(r.PathValue("org"))]
+		if !ok {
+			writeError(w, http.StatusNotFound, "org not found")
+			return
+		}
+		teamList := make([]*github.Team, 0, len(teams))
+		for _, team := range teams {
+			teamList = append(teamList, team)
+		}
+		slices.SortFunc(teamList, func(a, b *github.Team) int {
+			return int(a.GetID() - b.GetID())
+		})
+		writePage(w, r, teamList, !s.noLinks)
+	}))
+	mux.HandleFunc("POST /orgs/{org}/teams", authorized(func(w http.ResponseWriter, r *http.Request) {
+		orgID := s.orgID(r.PathValue("org"))
+		if orgID == "" {
+			writeError(w, http.StatusNotFound, "org not found")
+			return
+		}
+		var payload github.NewTeam
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload.Name == "" {
+			writeError(w, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+		slug := strings.ToLower(strings.ReplaceAll(payload.Name, " ", "-"))
+		if s.teamBySlug(orgID, slug) != nil {
+			writeError(w, http.StatusUnprocessableEntity, "team already exists")
+			return
+		}
+		// team IDs are unique across orgs.
+		var id int64
+		for _, teams := range s.teams {
+			for _, team := range teams {
+				id = max(id, team.GetID())
+			}
+		}
+		id++
+		org, _ := strconv.ParseInt(orgID, 10, 64)
+		login := s.orgLogins[orgID]
+		team := &github.Team{
+			ID:           &id,
+			Name:         &payload.Name,
+			Slug:         &slug,
+			Description:  payload.Description,
+			Organization: &github.Organization{ID: &org, Login: &login},
+		}
+		s.teams[orgID][strconv.FormatInt(id, 10)] = team
+		s.teamMembers[orgID][strconv.FormatInt(id, 10)] = make(map[string]struct{})
+		w.WriteHeader(http.StatusCreated)
+		writeJSON(w, team)
+	}))
+	mux.HandleFunc("GET /orgs/{org}/teams/{slug}", func(w http.ResponseWriter, r *http.Request) {
+		for _, team := range s.teams[s.orgID(r.PathValue("org"))] {
+			if team.GetSlug() == r.PathValue("slug") {
+				writeJSON(w, team)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "team not found")
+	})
+	mux.HandleFunc("GET /organizations/{org_id}/team/{team_id}", authorized(func(w http.ResponseWriter, r *http.Request) {
+		teams, ok := s.teams[r.PathValue("org_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "orgID not found")
+			return
+		}
+		team, ok := teams[r.PathValue("team_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "team not found")
+			return
+		}
+		writeJSON(w, team)
+	}))
+	mux.HandleFunc("DELETE /organizations/{org_id}/team/{team_id}", authorized(func(w http.ResponseWriter, r *http.Request) {
+		teams, ok := s.teams[r.PathValue("org_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "orgID not found")
+			return
+		}
+		if _, ok := teams[r.PathValue("team_id")]; !ok {
+			writeError(w, http.StatusNotFound, "team not found")
+			return
+		}
+		delete(teams, r.PathValue("team_id"))
+		delete(s.teamMembers[r.PathValue("org_id")], r.PathValue("team_id"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	mux.HandleFunc("PATCH /organizations/{org_id}/team/{team_id}", authorized(func(w http.ResponseWriter, r *http.Request) {
+		teams, ok := s.teams[r.PathValue("org_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "orgID not found")
+			return
+		}
+		team, ok := teams[r.PathValue("team_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "team not found")
+			return
+		}
+		payload := make(map[string]any)
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			writeError(w, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+		// a missing or null parent_team_id removes the parent.
+		team.Parent = nil
+		if parentTeamID, ok := payload["parent_team_id"].(float64); ok {
+			parentTeam, ok := teams[strconv.FormatInt(int64(parentTeamID), 10)]
+			if !ok {
+				writeError(w, http.StatusNotFound, "parent team not found")
+				return
+			}
+			team.Parent = parentTeam
+		}
+		if name, ok := payload["name"].(string); ok {
+			team.Name = &name
+		}
+		if description, ok := payload["description"].(string); ok {
+			team.Description = &description
+		}
+		writeJSON(w, team)
+	}))
+	mux.HandleFunc("GET /organizations/{org_id}/team/{team_id}/members", authorized(func(w http.ResponseWriter, r *http.Request) {
+		teamMembers, ok := s.teamMembers[r.PathValue("org_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "orgID not found")
+			return
+		}
+		members, ok := teamMembers[r.PathValue("team_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "team not found")
+			return
+		}
+		var users []*github.User
+		for username := range members {
+			user, ok := s.users[username]
+			if !ok {
+				writeError(w, http.StatusInternalServerError, "user data inconsistency")
+				return
+			}
+			users = append(users, user)
+		}
+		slices.SortFunc(users, func(a, b *github.User) int {
+			return strings.Compare(a.GetLogin(), b.GetLogin())
+		})
+		writePage(w, r, users, !s.noLinks)
+	}))
+	mux.HandleFunc("PUT /organizations/{org_id}/team/{team_id}/memberships/{username}", authorized(func(w http.ResponseWriter, r *http.Request) {
+		members, username, ok := s.membership(w, r)
+		if !ok {
+			return
+		}
+		members[username] = struct{}{}
+		writeJSON(w, map[string]string{
+			"url":   r.URL.String(),
+			"role":  "member",
+			"state": "pending",
+		})
+	}))
+	mux.HandleFunc("DELETE /organizations/{org_id}/team/{team_id}/memberships/{username}", authorized(func(w http.ResponseWriter, r *http.Request) {
+		members, username, ok := s.membership(w, r)
+		if !ok {
+			return
+		}
+		delete(members, username)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	mux.HandleFunc("GET /organizations/{org_id}/team/{team_id}/teams", authorized(func(w http.ResponseWriter, r *http.Request) {
+		teams, ok := s.teams[r.PathValue("org_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "orgID not found")
+			return
+		}
+		var childTeams []*github.Team
+		for _, team := range teams {
+			if team.GetParent().GetID() != 0 && strconv.FormatInt(team.GetParent().GetID(), 10) == r.PathValue("team_id") {
+				childTeams = append(childTeams, team)
+			}
+		}
+		slices.SortFunc(childTeams, func(a, b *github.Team) int {
+			return int(a.GetID() - b.GetID())
+		})
+		writePage(w, r, childTeams, !s.noLinks)
+	}))
+	mux.HandleFunc("GET /orgs/{org}/repos", authorized(func(w http.ResponseWriter, r *http.Request) {
+		orgID := s.orgID(r.PathValue("org"))
+		if orgID == "" {
+			writeError(w, http.StatusNotFound, "org not found")
+			return
+		}
+		writePage(w, r, s.repos[orgID], !s.noLinks)
+	}))
+	mux.HandleFunc("GET /organizations/{org_id}/team/{team_id}/repos", authorized(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.teams[r.PathValue("org_id")][r.PathValue("team_id")]; !ok {
+			writeError(w, http.StatusNotFound, "team not found")
+			return
+		}
+		permissions := s.teamRepos[teamRepoKey(r.PathValue("org_id"), r.PathValue("team_id"))]
+		var repos []*github.Repository
+		for _, repo := range s.repos[r.PathValue("org_id")] {
+			permission, ok := permissions[repo.GetName()]
+			if !ok {
+				continue
+			}
+			rp := *repo
+			// like GitHub, a permission implies the lower ones.
+			rp.Permissions = make(map[string]bool)
+			for _, p := range []string{"pull", "triage", "push", "maintain", "admin"} {
+				rp.Permissions[p] = true
+				if p == permission {
+					break
+				}
+			}
+			repos = append(repos, &rp)
+		}
+		writePage(w, r, repos, !s.noLinks)
+	}))
+	mux.HandleFunc("PUT /organizations/{org_id}/team/{team_id}/repos/{owner}/{repo}", authorized(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.teams[r.PathValue("org_id")][r.PathValue("team_id")]; !ok {
+			writeError(w, http.StatusNotFound, "team not found")
+			return
+		}
+		if !slices.ContainsFunc(s.repos[r.PathValue("org_id")], func(repo *github.Repository) bool {
+			return repo.GetOwner().GetLogin() == r.PathValue("owner") && repo.GetName() == r.PathValue("repo")
+		}) {
+			writeError(w, http.StatusNotFound, "repo not found")
+			return
+		}
+		var opts github.TeamAddTeamRepoOptions
+		if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+			writeError(w, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+		key := teamRepoKey(r.PathValue("org_id"), r.PathValue("team_id"))
+		if s.teamRepos[key] == nil {
+			s.teamRepos[key] = make(map[string]string)
+		}
+		s.teamRepos[key][r.PathValue("repo")] = opts.Permission
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	mux.HandleFunc("GET /repos/{owner}/{repo}/environments/{name}", authorized(func(w http.ResponseWriter, r *http.Request) {
+		env, ok := s.envs[repoKey(r.PathValue("owner"), r.PathValue("repo"), r.PathValue("name"))]
+		if !ok {
+			writeError(w, http.StatusNotFound, "environment not found")
+			return
+		}
+		writeJSON(w, env)
+	}))
+	mux.HandleFunc("PUT /repos/{owner}/{repo}/environments/{name}", authorized(func(w http.ResponseWriter, r *http.Request) {
+		key := repoKey(r.PathValue("owner"), r.PathValue("repo"), r.PathValue("name"))
+		env, ok := s.envs[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "environment not found")
+			return
+		}
+		var update github.CreateUpdateEnvironment
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			writeError(w, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+		orgID := s.orgID(r.PathValue("owner"))
+		e := &github.Environment{
+			Name:                   env.Name,
+			CanAdminsBypass:        update.CanAdminsBypass,
+			DeploymentBranchPolicy: update.DeploymentBranchPolicy,
+		}
+		if update.GetWaitTimer() > 0 {
+			e.ProtectionRules = append(e.ProtectionRules, &github.ProtectionRule{Type: github.String("wait_timer"), WaitTimer: update.WaitTimer})
+		}
+		if len(update.Reviewers) > 0 {
+			rule := &github.ProtectionRule{Type: github.String("required_reviewers"), PreventSelfReview: update.PreventSelfReview}
+			for _, reviewer := range update.Reviewers {
+				id := strconv.FormatInt(reviewer.GetID(), 10)
+				switch reviewer.GetType() {
+				case "Team":
+					team, ok := s.teams[orgID][id]
+					if !ok {
+						writeError(w, http.StatusUnprocessableEntity, "team not found")
+						return
+					}
+					rule.Reviewers = append(rule.Reviewers, &github.RequiredReviewer{Type: github.String("Team"), Reviewer: team})
+				default:
+					rule.Reviewers = append(rule.Reviewers, &github.RequiredReviewer{Type: github.String("User"), Reviewer: &github.User{ID: reviewer.ID}})
+				}
+			}
+			e.ProtectionRules = append(e.ProtectionRules, rule)
+		}
+		s.envs[key] = e
+		writeJSON(w, e)
+	}))
+	mux.HandleFunc("GET /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams", authorized(func(w http.ResponseWriter, r *http.Request) {
+		slugs, ok := s.pushTeams[repoKey(r.PathValue("owner"), r.PathValue("repo"), r.PathValue("branch"))]
+		if !ok {
+			writeError(w, http.StatusNotFound, "push restrictions not enabled")
+			return
+		}
+		teams := []*github.Team{}
+		for _, slug := range slugs {
+			if team := s.teamBySlug(s.orgID(r.PathValue("owner")), slug); team != nil {
+				teams = append(teams, team)
+			}
+		}
+		writeJSON(w, teams)
+	}))
+	mux.HandleFunc("PUT /repos/{owner}/{repo}/branches/{branch}/protection/restrictions/teams", authorized(func(w http.ResponseWriter, r *http.Request) {
+		key := repoKey(r.PathValue("owner"), r.PathValue("repo"), r.PathValue("branch"))
+		if _, ok := s.pushTeams[key]; !ok {
+			writeError(w, http.StatusNotFound, "push restrictions not enabled")
+			return
+		}
+		var slugs []string
+		if err := json.NewDecoder(r.Body).Decode(&slugs); err != nil {
+			writeError(w, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+		teams := []*github.Team{}
+		for _, slug := range slugs {
+			team := s.teamBySlug(s.orgID(r.PathValue("owner")), slug)
+			if team == nil {
+				writeError(w, http.StatusUnprocessableEntity, "team not found")
+				return
+			}
+			teams = append(teams, team)
+		}
+		s.pushTeams[key] = slugs
+		writeJSON(w, teams)
+	}))
+	mux.HandleFunc("GET /repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews", authorized(func(w http.ResponseWriter, r *http.Request) {
+		reviews, ok := s.reviews[repoKey(r.PathValue("owner"), r.PathValue("repo"), r.PathValue("branch"))]
+		if !ok {
+			writeError(w, http.StatusNotFound, "pull request reviews not enabled")
+			return
+		}
+		writeJSON(w, reviews)
+	}))
+	mux.HandleFunc("PATCH /repos/{owner}/{repo}/branches/{branch}/protection/required_pull_request_reviews", authorized(func(w http.ResponseWriter, r *http.Request) {
+		key := repoKey(r.PathValue("owner"), r.PathValue("repo"), r.PathValue("branch"))
+		reviews, ok := s.reviews[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "pull request reviews not enabled")
+			return
+		}
+		var update github.PullRequestReviewsEnforcementUpdate
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			writeError(w, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+		rv := *reviews
+		rv.RequiredApprovingReviewCount = update.RequiredApprovingReviewCount
+		if req := update.BypassPullRequestAllowancesRequest; req != nil {
+			bypass := &github.BypassPullRequestAllowances{}
+			for _, login := range req.Users {
+				bypass.Users = append(bypass.Users, &github.User{Login: github.String(login)})
+			}
+			for _, slug := range req.Teams {
+				team := s.teamBySlug(s.orgID(r.PathValue("owner")), slug)
+				if team == nil {
+					writeError(w, http.StatusUnprocessableEntity, "team not found")
+					return
+				}
+				bypass.Teams = append(bypass.Teams, team)
+			}
+			for _, slug := range req.Apps {
+				bypass.Apps = append(bypass.Apps, &github.App{Slug: github.String(slug)})
+			}
+			rv.BypassPullRequestAllowances = bypass
+		}
+		s.reviews[key] = &rv
+		writeJSON(w, &rv)
+	}))
+	mux.HandleFunc("POST /graphql", authorized(s.externalIdentities))
+	mux.HandleFunc("GET /scim/v2/organizations/{org}/Users", authorized(func(w http.ResponseWriter, r *http.Request) {
+		s.scimUsers(w, r, s.orgID(r.PathValue("org")))
+	}))
+	mux.HandleFunc("GET /scim/v2/enterprises/{enterprise}/Users", authorized(func(w http.ResponseWriter, r *http.Request) {
+		s.scimUsers(w, r, enterpriseKey(r.PathValue("enterprise")))
+	}))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		// GraphQL queries are reads.
+		if r.Method != http.MethodGet && r.URL.Path != "/graphql" {
+			s.writes++
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// externalIdentities serves the GraphQL queries of the external identities
+// of an org or an enterprise, paginated with the index of the last identity as
+// cursor.
+func (s *Server) externalIdentities(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Query     string `json:"query"`
+		Variables struct {
+			Owner string `json:"owner"`
+			First int    `json:"first"`
+			After string `json:"after"`
+		} `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid graphql request")
+		return
+	}
+	enterprise := strings.Contains(req.Query, "enterprise(")
+	owner := s.orgID(req.Variables.Owner)
+	if enterprise {
+		owner = enterpriseKey(req.Variables.Owner)
+	}
+	// orgs and enterprises without identities have no identity provider.
+	var provider any
+	if identities, ok := s.identities[owner]; ok {
+		start := 0
+		if req.Variables.After != "" {
+			start, _ = strconv.Atoi(req.Variables.After)
+		}
+		end := min(start+max(req.Variables.First, 1), len(identities))
+		nodes := make([]any, 0, end-start)
+		for _, id := range identities[start:end] {
+			var saml any
+			if id.nameID != "" {
+				saml = map[string]any{"nameId": id.nameID}
+			}
+			nodes = append(nodes, map[string]any{
+				"guid":         id.guid,
+				"samlIdentity": saml,
+				"user":         map[string]any{"login": id.login},
+			})
+		}
+		provider = map[string]any{"externalIdentities": map[string]any{
+			"pageInfo": map[string]any{"hasNextPage": end < len(identities), "endCursor": strconv.Itoa(end)},
+			"nodes":    nodes,
+		}}
+	}
+	data := map[string]any{"organization": map[string]any{"samlIdentityProvider": provider}}
+	if enterprise {
+		data = map[string]any{"enterprise": map[string]any{"ownerInfo": map[string]any{"samlIdentityProvider": provider}}}
+	}
+	if strings.Contains(req.Query, "rateLimit") {
+		data["rateLimit"] = map[string]any{"cost": 1}
+	}
+	writeJSON(w, map[string]any{"data": data})
+}
+
+// scimUsers serves the SCIM users of the org or enterprise with the given key,
+// paginated by the startIndex and count parameters.
+func (s *Server) scimUsers(w http.ResponseWriter, r *http.Request, owner string) {
+	var users []*github.SCIMUserAttributes
+	for _, id := range s.identities[owner] {
+		if id.externalID != "" {
+			users = append(users, &github.SCIMUserAttributes{ID: github.String(id.guid), ExternalID: github.String(id.externalID), UserName: id.login})
+		}
+	}
+	start := 1
+	if v, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && v > 0 {
+		start = v
+	}
+	count := 100
+	if v, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && v > 0 {
+		count = v
+	}
+	from := min(start-1, len(users))
+	to := min(from+count, len(users))
+	writeJSON(w, &github.SCIMProvisionedIdentities{
+		TotalResults: github.Int(len(users)),
+		StartIndex:   github.Int(start),
+		ItemsPerPage: github.Int(to - from),
+		Resources:    users[from:to],
+	})
+}
+
+// membership returns the members of the team of a membership request and the
+// requested user, or writes an error response if either does not exist.
+func (s *Server) membership(w http.ResponseWriter, r *http.Request) (map[string]struct{}, string, bool) {
+	teamMembers, ok := s.teamMembers[r.PathValue("org_id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "orgID not found")
+		return nil, "", false
+	}
+	members, ok := teamMembers[r.PathValue("team_id")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "team not found")
+		return nil, "", false
+	}
+	// logins are case insensitive.
+	username := strings.ToLower(r.PathValue("username"))
+	if _, ok := s.users[username]; !ok {
+		writeError(w, http.StatusNotFound, "user not found")
+		return nil, "", false
+	}
+	return members, username, true
+}
+
+// authorized fails requests without a bearer token.
+func authorized(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+			writeError(w, http.StatusInternalServerError, "missing or malformed authorization header")
+			return
+		}
+		h(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	jsn, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to marshal response")
+		return
+	}
+	w.Write(jsn) //nolint:errcheck // the client went away
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.WriteHeader(status)
+	fmt.Fprint(w, msg)
+}
+
+// writePage writes the page of items requested by the page and per_page
+// parameters, along with a Link header to the next and last pages, if any and
+// links is set.
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T, links bool) {
+	perPage := 30
+	if v, err := strconv.Atoi(r.URL.Query().Get("per_page")); err == nil && v > 0 {
+		perPage = min(v, 100)
+	}
+	page := 1
+	if v, err := strconv.Atoi(r.URL.Query().Get("page")); err == nil && v > 0 {
+		page = v
+	}
+	lastPage := max((len(items)+perPage-1)/perPage, 1)
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+
+	if links && page < lastPage {
+		pageURL := func(p int) string {
+			u := *r.URL
+			q := u.Query()
+			q.Set("page", strconv.Itoa(p))
+			q.Set("per_page", strconv.Itoa(perPage))
+			u.RawQuery = q.Encode()
+			return fmt.Sprintf("http://%s%s", r.Host, u.RequestURI())
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next", <%s>; rel="last"`, pageURL(page+1), pageURL(lastPage)))
+	}
+	// an empty page is an empty list rather than null, like GitHub.
+	writeJSON(w, append([]T{}, items[start:end]...))
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v61/github"
+)
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := NewBuilder().
+		WithOrg(1, "org1").
+		WithUser(&github.User{ID: github.Int64(10), Login: github.String("alice")}).
+		WithUser(&github.User{ID: github.Int64(11), Login: github.String("bob")}).
+		WithTeam(1, &github.Team{ID: github.Int64(100), Slug: github.String("eng")}, "alice").
+		Start()
+	t.Cleanup(server.Close)
+	client := server.Client().WithAuthToken("test-token")
+
+	team, _, err := client.Teams.GetTeamBySlug(ctx, "org1", "eng")
+	if err != nil {
+		t.Fatalf("GetTeamBySlug failed: %v", err)
+	}
+	if got, want := team.GetID(), int64(100); got != want {
+		t.Errorf("GetTeamBySlug got team %d, want %d", got, want)
+	}
+
+	if _, _, err := client.Teams.AddTeamMembershipByID(ctx, 1, 100, "Bob", nil); err != nil {
+		t.Fatalf("AddTeamMembershipByID failed: %v", err)
+	}
+	if _, err := client.Teams.RemoveTeamMembershipByID(ctx, 1, 100, "alice"); err != nil {
+		t.Fatalf("RemoveTeamMembershipByID failed: %v", err)
+	}
+	if diff := cmp.Diff(server.TeamMembers(1, 100), []string{"bob"}); diff != "" {
+		t.Errorf("unexpected team members (-got, +want):\n%s", diff)
+	}
+
+	if _, _, err := server.Client().Teams.ListTeamMembersByID(ctx, 1, 100, nil); err == nil {
+		t.Errorf("ListTeamMembersByID without token got no error")
+	}
+	if _, _, err := client.Teams.GetTeamByID(ctx, 1, 999); err == nil {
+		t.Errorf("GetTeamByID of missing team got no error")
+	}
+}
+
+func TestServer_Pagination(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	builder := NewBuilder()
+	logins := make([]string, 0, 45)
+	for i := range 45 {
+		login := fmt.Sprintf("user%02d", i)
+		builder.WithUser(&github.User{ID: github.Int64(int64(i)), Login: &login})
+		logins = append(logins, login)
+	}
+	server := builder.WithTeam(1, &github.Team{ID: github.Int64(100)}, logins...).Start()
+	t.Cleanup(server.Close)
+	client := server.Client().WithAuthToken("test-token")
+
+	var got []string
+	opts := &github.TeamListTeamMembersOptions{ListOptions: github.ListOptions{PerPage: 20}}
+	var pages []int
+	for {
+		users, resp, err := client.Teams.ListTeamMembersByID(ctx, 1, 100, opts)
+		if err != nil {
+			t.Fatalf("ListTeamMembersByID failed: %v", err)
+		}
+		pages = append(pages, len(users))
+		for _, u := range users {
+			got = append(got, u.GetLogin())
+		}
+		if resp.NextPage == 0 {
+			if got, want := resp.LastPage, 0; got != want {
+				t.Errorf("last page got LastPage %d, want %d", got, want)
+			}
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	if diff := cmp.Diff(pages, []int{20, 20, 5}); diff != "" {
+		t.Errorf("unexpected page sizes (-got, +want):\n%s", diff)
+	}
+	if diff := cmp.Diff(got, logins); diff != "" {
+		t.Errorf("unexpected team members (-got, +want):\n%s", diff)
+	}
+
+	// pages past the last one are empty.
+	opts.Page = 10
+	users, _, err := client.Teams.ListTeamMembersByID(ctx, 1, 100, opts)
+	if err != nil {
+		t.Fatalf("ListTeamMembersByID failed: %v", err)
+	}
+	if len(users) != 0 {
+		t.Errorf("ListTeamMembersByID of page 10 got %d users, want 0", len(users))
+	}
+}
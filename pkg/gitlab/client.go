@@ -24,12 +24,15 @@ import (
 	"github.com/abcxyz/team-link/pkg/credentials"
 )
 
+const DefaultGitLabEndpointURL = "https://gitlab.com"
+
 // ClientProvider provides a GitLab client.
 type ClientProvider struct {
 	instanceURL string
 	keyProvider credentials.KeyProvider
 	httpClient  *http.Client
 	clientOpts  []ClientOpt
+	limiter     *RateLimiter
 }
 
 type ClientOpt func(client *gitlab.Client)
@@ -41,16 +44,23 @@ func WithUserAgent(userAgent string) ClientOpt {
 	}
 }
 
-// NewGitLabClientProvider creates a new GitLabClientProvider.
+// NewGitLabClientProvider creates a new GitLabClientProvider. The clients it
+// provides share a RateLimiter.
 func NewGitLabClientProvider(instanceURL string, keyProvider credentials.KeyProvider, httpClient *http.Client, opts ...ClientOpt) *ClientProvider {
 	return &ClientProvider{
 		instanceURL: instanceURL,
 		keyProvider: keyProvider,
 		httpClient:  httpClient,
 		clientOpts:  opts,
+		limiter:     NewRateLimiter(),
 	}
 }
 
+// RateLimiter returns the RateLimiter shared by the provided clients.
+func (g *ClientProvider) RateLimiter() *RateLimiter {
+	return g.limiter
+}
+
 // Client returns a GitLab client initialized with a PAT.
 func (g *ClientProvider) Client(ctx context.Context) (*gitlab.Client, error) {
 	token, err := g.keyProvider.Key(ctx)
@@ -60,6 +70,9 @@ func (g *ClientProvider) Client(ctx context.Context) (*gitlab.Client, error) {
 
 	opts := []gitlab.ClientOptionFunc{
 		gitlab.WithBaseURL(g.instanceURL),
+		gitlab.WithCustomLimiter(g.limiter),
+		gitlab.WithCustomRetry(g.limiter.checkRetry),
+		gitlab.WithCustomBackoff(g.limiter.backoff),
 	}
 	if g.httpClient != nil {
 		opts = append(opts, gitlab.WithHTTPClient(g.httpClient))
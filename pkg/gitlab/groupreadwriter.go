@@ -18,7 +18,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -38,6 +40,9 @@ const (
 	DefaultCacheDuration = time.Hour * 24
 )
 
+// errUserNotFound is returned when no GitLab user has a username.
+var errUserNotFound = errors.New("no user exists with username")
+
 type Config struct {
 	includeSubGroups bool
 	cacheDuration    time.Duration
@@ -85,18 +90,45 @@ func NewGroupReadWriter(clientProvider *ClientProvider, opts ...Opt) *GroupReadW
 	}
 }
 
+// RateLimiter returns the RateLimiter shared by the requests of the
+// GroupReadWriter.
+func (rw *GroupReadWriter) RateLimiter() *RateLimiter {
+	return rw.clientProvider.RateLimiter()
+}
+
 // GetUser retrieves the GitLab user with the given ID. The ID is the GitLab user's login.
 func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
 	user, err := rw.getGitLabUser(ctx, userID)
 	if err != nil {
-		return nil, fmt.Errorf("could not get user: %w", err)
+		return nil, fmt.Errorf("could not get user: %w", classifyErr(err))
 	}
 	return &groupsync.User{
 		ID:         user.Username,
+		StableID:   stableID(user.ID),
 		Attributes: user,
 	}, nil
 }
 
+// stableID returns the StableID of the user with the given numeric ID, which
+// does not change when the user changes their username, or "" if it is
+// unknown.
+func stableID(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
+
+// IsSuspended reports whether the GitLab user with the given username is
+// blocked, banned or deactivated.
+func (rw *GroupReadWriter) IsSuspended(ctx context.Context, userID string) (bool, error) {
+	user, err := rw.getGitLabUser(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("could not get user: %w", classifyErr(err))
+	}
+	return user.State != "" && user.State != "active", nil
+}
+
 func (rw *GroupReadWriter) getGitLabUser(ctx context.Context, userID string) (*gitlab.User, error) {
 	user, err := rw.userCache.WriteThruLookup(userID, func() (*gitlab.User, error) {
 		logger := logging.FromContext(ctx)
@@ -110,7 +142,7 @@ func (rw *GroupReadWriter) getGitLabUser(ctx context.Context, userID string) (*g
 			return nil, fmt.Errorf("failed to fetch user %s: %w", userID, err)
 		}
 		if len(users) == 0 {
-			return nil, fmt.Errorf("no user exists with username %s", userID)
+			return nil, fmt.Errorf("%w %s", errUserNotFound, userID)
 		}
 		if len(users) > 1 {
 			return nil, fmt.Errorf("multiple users exist with username %s: this should not be possible", userID)
@@ -124,11 +156,81 @@ func (rw *GroupReadWriter) getGitLabUser(ctx context.Context, userID string) (*g
 	return user, nil
 }
 
+// UserEmails returns the usernames of the active GitLab users keyed by their
+// primary email. GitLab only shows the emails of other users to admins, so
+// without an admin token there are none.
+func (rw *GroupReadWriter) UserEmails(ctx context.Context) (map[string]string, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "listing user emails")
+	client, err := rw.clientProvider.Client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gitlab client: %w", err)
+	}
+	emails := make(map[string]string)
+	if err := paginate(func(opts *gitlab.ListOptions) (*gitlab.Response, error) {
+		active := true
+		users, resp, err := client.Users.ListUsers(&gitlab.ListUsersOptions{ListOptions: *opts, Active: &active}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+		for _, user := range users {
+			if user.Email != "" {
+				emails[user.Email] = user.Username
+			}
+		}
+		return resp, nil
+	}); err != nil {
+		return nil, fmt.Errorf("could not list user emails: %w", classifyErr(err))
+	}
+	return emails, nil
+}
+
+// scimIdentity is a SCIM identity of a group, see
+// https://docs.gitlab.com/ee/api/scim.html.
+type scimIdentity struct {
+	ExternUID string `json:"extern_uid"`
+	UserID    int    `json:"user_id"`
+	Active    bool   `json:"active"`
+}
+
+// SCIMExternalIDs returns the usernames of the active users provisioned by
+// SCIM to the top-level GitLab group with the given ID, keyed by their
+// externalId, the ID of the user in the identity provider. Listing SCIM
+// identities requires the Owner role in the group.
+func (rw *GroupReadWriter) SCIMExternalIDs(ctx context.Context, groupID string) (map[string]string, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "listing scim identities", "group_id", groupID)
+	client, err := rw.clientProvider.Client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gitlab client: %w", err)
+	}
+	req, err := client.NewRequest(http.MethodGet, fmt.Sprintf("groups/%s/scim/identities", gitlab.PathEscape(groupID)), nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scim identities request: %w", err)
+	}
+	var identities []*scimIdentity
+	if _, err := client.Do(req, &identities); err != nil {
+		return nil, fmt.Errorf("could not list scim identities of group %s: %w", groupID, classifyErr(err))
+	}
+	usernames := make(map[string]string, len(identities))
+	for _, id := range identities {
+		if !id.Active || id.ExternUID == "" {
+			continue
+		}
+		user, _, err := client.Users.GetUser(id.UserID, gitlab.GetUsersOptions{}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("could not get user %d: %w", id.UserID, classifyErr(err))
+		}
+		usernames[id.ExternUID] = user.Username
+	}
+	return usernames, nil
+}
+
 // GetGroup retrieves the GitLab group with the given ID. The ID is the GitLab group's integer ID.
 func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
 	group, err := rw.getGitLabGroup(ctx, groupID)
 	if err != nil {
-		return nil, fmt.Errorf("could not get group: %w", err)
+		return nil, fmt.Errorf("could not get group: %w", classifyErr(err))
 	}
 	return &groupsync.Group{
 		ID:         strconv.Itoa(group.ID),
@@ -156,6 +258,80 @@ func (rw *GroupReadWriter) getGitLabGroup(ctx context.Context, groupID string) (
 	return group, nil
 }
 
+// SetGroupLabels appends the given labels, along with the
+// groupsync.ManagedByMarker, to the description of the GitLab group with the
+// given ID, replacing the labels set before. The group is only updated if its
+// description changes.
+func (rw *GroupReadWriter) SetGroupLabels(ctx context.Context, groupID string, labels map[string]string) error {
+	group, err := rw.getGitLabGroup(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get group: %w", classifyErr(err))
+	}
+	description := groupsync.LabeledDescription(group.Description, labels)
+	if description == group.Description {
+		return nil
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "labeling group",
+		"group_id", groupID,
+		"description", description,
+	)
+	client, err := rw.clientProvider.Client(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get gitlab client: %w", err)
+	}
+	updated, _, err := client.Groups.UpdateGroup(group.ID, &gitlab.UpdateGroupOptions{Description: &description})
+	if err != nil {
+		return fmt.Errorf("failed to update description of group %s: %w", groupID, classifyErr(err))
+	}
+	rw.groupCache.Set(groupID, updated)
+	return nil
+}
+
+// CreateGroup creates a GitLab group with the given name and path, as a
+// subgroup of the group with the given parent ID unless it is 0.
+func (rw *GroupReadWriter) CreateGroup(ctx context.Context, parentID int, name, path string) (*groupsync.Group, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "creating group",
+		"parent_id", parentID,
+		"name", name,
+	)
+	client, err := rw.clientProvider.Client(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gitlab client: %w", err)
+	}
+	opts := &gitlab.CreateGroupOptions{Name: &name, Path: &path}
+	if parentID != 0 {
+		opts.ParentID = &parentID
+	}
+	group, _, err := client.Groups.CreateGroup(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create group %s: %w", name, classifyErr(err))
+	}
+	groupID := strconv.Itoa(group.ID)
+	rw.groupCache.Set(groupID, group)
+	return &groupsync.Group{
+		ID:         groupID,
+		Attributes: group,
+	}, nil
+}
+
+// DeleteGroup deletes the GitLab group with the given ID, along with its
+// subgroups and projects. It is meant for groups created with CreateGroup,
+// e.g. by tests.
+func (rw *GroupReadWriter) DeleteGroup(ctx context.Context, groupID string) error {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "deleting group", "group_id", groupID)
+	client, err := rw.clientProvider.Client(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get gitlab client: %w", err)
+	}
+	if _, err := client.Groups.DeleteGroup(groupID, &gitlab.DeleteGroupOptions{}); err != nil {
+		return fmt.Errorf("failed to delete group %s: %w", groupID, classifyErr(err))
+	}
+	return nil
+}
+
 // GetMembers retrieves the direct members (and optionally subgroups) of the GitLab group with given ID.
 // The ID is the GitLab group's integer ID.
 func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
@@ -170,6 +346,9 @@ func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]gr
 	if err := paginate(func(listOpts *gitlab.ListOptions) (*gitlab.Response, error) {
 		userMembers, resp, err := client.Groups.ListGroupMembers(groupID, &gitlab.ListGroupMembersOptions{ListOptions: *listOpts})
 		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				err = fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+			}
 			return nil, fmt.Errorf("failed to fetch group members for %s: %w", groupID, err)
 		}
 
@@ -178,12 +357,12 @@ func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]gr
 		}
 		return resp, nil
 	}); err != nil {
-		return nil, err
+		return nil, classifyErr(err)
 	}
 
 	members := make([]groupsync.Member, 0, len(users))
 	for _, user := range users {
-		members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: user.Username, Attributes: user}})
+		members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: user.Username, StableID: stableID(user.ID), Attributes: user}})
 	}
 
 	if rw.includeSubGroups {
@@ -199,7 +378,7 @@ func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]gr
 			}
 			return resp, nil
 		}); err != nil {
-			return nil, err
+			return nil, classifyErr(err)
 		}
 
 		for _, group := range groups {
@@ -259,10 +438,11 @@ func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, membe
 
 	var merr error
 	// Add GitLab group memberships.
-	for _, member := range addMembers {
+	for _, id := range utils.MapKeys(addMembers) {
+		member := addMembers[id]
 		if member.IsUser() {
 			user, _ := member.User()
-			if err := rw.addUserToGroup(ctx, groupID, user.ID); err != nil {
+			if err := rw.addUserToGroup(ctx, groupID, user.ID, groupsync.MemberRole(member)); err != nil {
 				merr = errors.Join(merr, err)
 			}
 		} else if member.IsGroup() && rw.includeSubGroups {
@@ -273,7 +453,8 @@ func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, membe
 		}
 	}
 	// Remove GitLab group memberships
-	for _, member := range removeMembers {
+	for _, id := range utils.MapKeys(removeMembers) {
+		member := removeMembers[id]
 		if member.IsUser() {
 			user, _ := member.User()
 			if err := rw.removeUserFromGroup(ctx, groupID, user); err != nil {
@@ -288,15 +469,22 @@ func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, membe
 			}
 		}
 	}
-	return merr
+	return classifyErr(merr)
 }
 
-func (rw *GroupReadWriter) addUserToGroup(ctx context.Context, groupID, userID string) error {
+// addUserToGroup adds the user to the group with the access level of the
+// given role, e.g. "maintainer". An empty role is "developer".
+func (rw *GroupReadWriter) addUserToGroup(ctx context.Context, groupID, userID, role string) error {
 	logger := logging.FromContext(ctx)
 	logger.InfoContext(ctx, "adding user to group",
 		"group_id", groupID,
 		"user_id", userID,
+		"role", role,
 	)
+	level, err := accessLevel(role)
+	if err != nil {
+		return fmt.Errorf("failed to add GitLab user(%s) for group(%s): %w", userID, groupID, err)
+	}
 
 	client, err := rw.clientProvider.Client(ctx)
 	if err != nil {
@@ -304,13 +492,33 @@ func (rw *GroupReadWriter) addUserToGroup(ctx context.Context, groupID, userID s
 	}
 	if _, _, err := client.GroupMembers.AddGroupMember(groupID, &gitlab.AddGroupMemberOptions{
 		Username:    &userID,
-		AccessLevel: pointer.To(gitlab.DeveloperPermissions),
+		AccessLevel: pointer.To(level),
 	}); err != nil {
 		return fmt.Errorf("failed to add GitLab user(%s) for group(%s): %w", userID, groupID, err)
 	}
 	return nil
 }
 
+// accessLevels are the access levels of the roles a user may be added with.
+var accessLevels = map[string]gitlab.AccessLevelValue{
+	"guest":      gitlab.GuestPermissions,
+	"reporter":   gitlab.ReporterPermissions,
+	"developer":  gitlab.DeveloperPermissions,
+	"maintainer": gitlab.MaintainerPermissions,
+	"owner":      gitlab.OwnerPermissions,
+}
+
+func accessLevel(role string) (gitlab.AccessLevelValue, error) {
+	if role == "" {
+		return gitlab.DeveloperPermissions, nil
+	}
+	level, ok := accessLevels[strings.ToLower(role)]
+	if !ok {
+		return 0, fmt.Errorf("invalid group role %q", role)
+	}
+	return level, nil
+}
+
 func (rw *GroupReadWriter) removeUserFromGroup(ctx context.Context, groupID string, user *groupsync.User) error {
 	logger := logging.FromContext(ctx)
 	logger.InfoContext(ctx, "adding user to group",
@@ -365,6 +573,23 @@ func (rw *GroupReadWriter) transferSubGroup(ctx context.Context, group *groupsyn
 	return nil
 }
 
+// classifyErr marks GitLab rate limit responses (HTTP 429) with
+// groupsync.ErrRateLimited and server errors (HTTP 5xx) with
+// groupsync.ErrTransient so that syncers can categorize and retry them. Other
+// errors, including nil, are returned as is.
+func classifyErr(err error) error {
+	var errResp *gitlab.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		switch status := errResp.Response.StatusCode; {
+		case status == http.StatusTooManyRequests:
+			return fmt.Errorf("%w: %w", groupsync.ErrRateLimited, err)
+		case status >= http.StatusInternalServerError:
+			return fmt.Errorf("%w: %w", groupsync.ErrTransient, err)
+		}
+	}
+	return err
+}
+
 func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
 	memberIDs := make(map[string]groupsync.Member, len(members))
 	for _, m := range members {
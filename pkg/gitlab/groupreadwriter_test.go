@@ -16,20 +16,23 @@ package gitlab
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand"
 	"net/http"
-	"net/http/httptest"
 	"slices"
 	"strconv"
 	"strings"
 	"testing"
+	"testing/quick"
 
 	"github.com/google/go-cmp/cmp"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
 	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/gitlabtest"
 	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
 )
 
 func TestGroupReadWriter_GetGroup(t *testing.T) {
@@ -82,7 +85,7 @@ func TestGroupReadWriter_GetGroup(t *testing.T) {
 			server := fakeGitLab(tc.data)
 			defer server.Close()
 
-			clientProvider := gitlabClientProvider(server)
+			clientProvider := gitlabClientProvider(server.URL)
 			groupRW := NewGroupReadWriter(clientProvider)
 
 			got, err := groupRW.GetGroup(ctx, tc.groupID)
@@ -164,7 +167,8 @@ func TestGroupReadWriter_GetMembers(t *testing.T) {
 			want: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.GroupMember{
 							ID:       2286,
 							Username: "user1",
@@ -174,7 +178,8 @@ func TestGroupReadWriter_GetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.GroupMember{
 							ID:       3208,
 							Username: "user3",
@@ -265,7 +270,8 @@ func TestGroupReadWriter_GetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.GroupMember{
 							ID:       2286,
 							Username: "user1",
@@ -275,7 +281,8 @@ func TestGroupReadWriter_GetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.GroupMember{
 							ID:       3208,
 							Username: "user3",
@@ -351,7 +358,8 @@ func TestGroupReadWriter_GetMembers(t *testing.T) {
 			want: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.GroupMember{
 							ID:       2286,
 							Username: "user1",
@@ -361,7 +369,8 @@ func TestGroupReadWriter_GetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.GroupMember{
 							ID:       3208,
 							Username: "user3",
@@ -382,7 +391,7 @@ func TestGroupReadWriter_GetMembers(t *testing.T) {
 			server := fakeGitLab(tc.data)
 			defer server.Close()
 
-			clientProvider := gitlabClientProvider(server)
+			clientProvider := gitlabClientProvider(server.URL)
 			groupRW := NewGroupReadWriter(clientProvider, tc.opts...)
 
 			got, err := groupRW.GetMembers(ctx, tc.groupID)
@@ -466,7 +475,8 @@ func TestGroupReadWriter_GetDescendants(t *testing.T) {
 			groupID: "2",
 			want: []*groupsync.User{
 				{
-					ID: "user1",
+					ID:       "user1",
+					StableID: "2286",
 					Attributes: &gitlab.GroupMember{
 						ID:       2286,
 						Username: "user1",
@@ -474,7 +484,8 @@ func TestGroupReadWriter_GetDescendants(t *testing.T) {
 					},
 				},
 				{
-					ID: "user3",
+					ID:       "user3",
+					StableID: "3208",
 					Attributes: &gitlab.GroupMember{
 						ID:       3208,
 						Username: "user3",
@@ -555,7 +566,8 @@ func TestGroupReadWriter_GetDescendants(t *testing.T) {
 			groupID: "2",
 			want: []*groupsync.User{
 				{
-					ID: "user1",
+					ID:       "user1",
+					StableID: "2286",
 					Attributes: &gitlab.GroupMember{
 						ID:       2286,
 						Username: "user1",
@@ -563,7 +575,8 @@ func TestGroupReadWriter_GetDescendants(t *testing.T) {
 					},
 				},
 				{
-					ID: "user2",
+					ID:       "user2",
+					StableID: "5660",
 					Attributes: &gitlab.GroupMember{
 						ID:       5660,
 						Username: "user2",
@@ -571,7 +584,8 @@ func TestGroupReadWriter_GetDescendants(t *testing.T) {
 					},
 				},
 				{
-					ID: "user3",
+					ID:       "user3",
+					StableID: "3208",
 					Attributes: &gitlab.GroupMember{
 						ID:       3208,
 						Username: "user3",
@@ -647,7 +661,8 @@ func TestGroupReadWriter_GetDescendants(t *testing.T) {
 			groupID: "2",
 			want: []*groupsync.User{
 				{
-					ID: "user1",
+					ID:       "user1",
+					StableID: "2286",
 					Attributes: &gitlab.GroupMember{
 						ID:       2286,
 						Username: "user1",
@@ -655,7 +670,8 @@ func TestGroupReadWriter_GetDescendants(t *testing.T) {
 					},
 				},
 				{
-					ID: "user3",
+					ID:       "user3",
+					StableID: "3208",
 					Attributes: &gitlab.GroupMember{
 						ID:       3208,
 						Username: "user3",
@@ -675,7 +691,7 @@ func TestGroupReadWriter_GetDescendants(t *testing.T) {
 			server := fakeGitLab(tc.data)
 			defer server.Close()
 
-			clientProvider := gitlabClientProvider(server)
+			clientProvider := gitlabClientProvider(server.URL)
 			groupRW := NewGroupReadWriter(clientProvider, tc.opts...)
 
 			got, err := groupRW.Descendants(ctx, tc.groupID)
@@ -728,7 +744,8 @@ func TestGroupReadWriter_GetUser(t *testing.T) {
 			},
 			userID: "user1",
 			want: &groupsync.User{
-				ID: "user1",
+				ID:       "user1",
+				StableID: "2286",
 				Attributes: &gitlab.User{
 					ID:       2286,
 					Username: "user1",
@@ -747,7 +764,7 @@ func TestGroupReadWriter_GetUser(t *testing.T) {
 			server := fakeGitLab(tc.data)
 			defer server.Close()
 
-			clientProvider := gitlabClientProvider(server)
+			clientProvider := gitlabClientProvider(server.URL)
 			groupRW := NewGroupReadWriter(clientProvider)
 
 			got, err := groupRW.GetUser(ctx, tc.userID)
@@ -762,6 +779,181 @@ func TestGroupReadWriter_GetUser(t *testing.T) {
 	}
 }
 
+func TestGroupReadWriter_UserEmails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := gitlabtest.NewBuilder().
+		WithUser(&gitlab.User{ID: 1, Username: "alice", Email: "alice@example.com"}).
+		WithUser(&gitlab.User{ID: 2, Username: "bob", Email: "bob@example.com"}).
+		// emails of other users are hidden from non-admins.
+		WithUser(&gitlab.User{ID: 3, Username: "carol"}).
+		Start()
+	defer server.Close()
+
+	got, err := NewGroupReadWriter(gitlabClientProvider(server.URL)).UserEmails(ctx)
+	if err != nil {
+		t.Fatalf("UserEmails failed: %v", err)
+	}
+	want := map[string]string{
+		"alice@example.com": "alice",
+		"bob@example.com":   "bob",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected user emails (-got, +want):\n%s", diff)
+	}
+}
+
+func TestGroupReadWriter_SCIMExternalIDs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := gitlabtest.NewBuilder().
+		WithUser(&gitlab.User{ID: 1, Username: "alice"}).
+		WithUser(&gitlab.User{ID: 2, Username: "bob"}).
+		WithGroup(&gitlab.Group{ID: 10}).
+		WithSCIMIdentity(10, "00u1", "alice", true).
+		WithSCIMIdentity(10, "00u2", "bob", false).
+		Start()
+	defer server.Close()
+	rw := NewGroupReadWriter(gitlabClientProvider(server.URL))
+
+	got, err := rw.SCIMExternalIDs(ctx, "10")
+	if err != nil {
+		t.Fatalf("SCIMExternalIDs failed: %v", err)
+	}
+	// deprovisioned identities are left out.
+	if diff := cmp.Diff(got, map[string]string{"00u1": "alice"}); diff != "" {
+		t.Errorf("unexpected external IDs (-got, +want):\n%s", diff)
+	}
+
+	if _, err := rw.SCIMExternalIDs(ctx, "11"); err == nil {
+		t.Errorf("SCIMExternalIDs of unknown group got no error")
+	}
+}
+
+// TestGroupReadWriter_SetMembersIdempotent checks that setting the members a
+// group already has writes nothing, whatever their order and duplicates.
+func TestGroupReadWriter_SetMembersIdempotent(t *testing.T) {
+	t.Parallel()
+
+	usernames := []string{"alice", "bob", "carol", "dave", "erin"}
+	property := func(subset uint8, order []uint8) bool {
+		builder := gitlabtest.NewBuilder()
+		for i, username := range usernames {
+			builder.WithUser(&gitlab.User{ID: i + 1, Username: username})
+		}
+		server := builder.WithGroup(&gitlab.Group{ID: 1, Name: "group1"}, "alice", "carol").Start()
+		defer server.Close()
+		ctx := context.Background()
+
+		var members []groupsync.Member
+		for i, username := range usernames {
+			if subset&(1<<i) != 0 {
+				members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: username}})
+			}
+		}
+		if err := NewGroupReadWriter(gitlabClientProvider(server.URL)).SetMembers(ctx, "1", members); err != nil {
+			t.Logf("SetMembers failed: %v", err)
+			return false
+		}
+		want := server.GroupMembers(1)
+		writes := server.Writes()
+
+		// the rerun gets the members shuffled and with a duplicate.
+		again := slices.Clone(members)
+		for i, o := range order {
+			if len(again) > 0 {
+				j := int(o) % len(again)
+				again[i%len(again)], again[j] = again[j], again[i%len(again)]
+			}
+		}
+		if len(again) > 0 {
+			again = append(again, again[0])
+		}
+		if err := NewGroupReadWriter(gitlabClientProvider(server.URL)).SetMembers(ctx, "1", again); err != nil {
+			t.Logf("SetMembers of rerun failed: %v", err)
+			return false
+		}
+		if got := server.Writes() - writes; got != 0 {
+			t.Logf("rerun with subset %b issued %d writes", subset, got)
+			return false
+		}
+		return slices.Equal(server.GroupMembers(1), want)
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 50, Rand: rand.New(rand.NewSource(1))}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGroupReadWriter_SetGroupLabels(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := fakeGitLab(&GitLabData{
+		groups: map[string]*gitlab.Group{
+			"1": {ID: 1, Name: "group1", Description: "Platform team"},
+		},
+	})
+	t.Cleanup(server.Close)
+	groupRW := NewGroupReadWriter(gitlabClientProvider(server.URL))
+
+	// labeling twice leaves a single suffix.
+	for _, labels := range []map[string]string{{"env": "dev"}, {"env": "prod", "owner": "sre"}} {
+		if err := groupRW.SetGroupLabels(ctx, "1", labels); err != nil {
+			t.Fatalf("SetGroupLabels failed: %v", err)
+		}
+	}
+	if got, want := server.Group(1).Description, "Platform team [managed by team-link; env=prod, owner=sre]"; got != want {
+		t.Errorf("group description got %q, want %q", got, want)
+	}
+
+	if err := groupRW.SetGroupLabels(ctx, "2", nil); err == nil {
+		t.Errorf("SetGroupLabels of missing group got no error")
+	}
+}
+
+func TestAccessLevel(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		role    string
+		want    gitlab.AccessLevelValue
+		wantErr string
+	}{
+		{
+			name: "default",
+			role: "",
+			want: gitlab.DeveloperPermissions,
+		},
+		{
+			name: "maintainer",
+			role: "Maintainer",
+			want: gitlab.MaintainerPermissions,
+		},
+		{
+			name:    "invalid",
+			role:    "admin",
+			wantErr: `invalid group role "admin"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := accessLevel(tc.role)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			if got != tc.want {
+				t.Errorf("accessLevel(%q) got %v, want %v", tc.role, got, tc.want)
+			}
+		})
+	}
+}
+
 func TestGroupReadWriter_SetMembers(t *testing.T) {
 	t.Parallel()
 
@@ -830,7 +1022,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			inputMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.User{
 							ID:       2286,
 							Username: "user1",
@@ -840,7 +1033,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &gitlab.User{
 							ID:       5660,
 							Username: "user2",
@@ -850,7 +1044,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.User{
 							ID:       3208,
 							Username: "user3",
@@ -862,7 +1057,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			wantMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.GroupMember{
 							ID:       2286,
 							Username: "user1",
@@ -872,7 +1068,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &gitlab.GroupMember{
 							ID:       5660,
 							Username: "user2",
@@ -882,7 +1079,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.GroupMember{
 							ID:       3208,
 							Username: "user3",
@@ -948,7 +1146,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			inputMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.User{
 							ID:       2286,
 							Username: "user1",
@@ -960,7 +1159,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			wantMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.GroupMember{
 							ID:       2286,
 							Username: "user1",
@@ -1026,7 +1226,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			inputMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.User{
 							ID:       2286,
 							Username: "user1",
@@ -1036,7 +1237,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &gitlab.User{
 							ID:       5660,
 							Username: "user2",
@@ -1048,7 +1250,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			wantMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.GroupMember{
 							ID:       2286,
 							Username: "user1",
@@ -1058,7 +1261,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user2",
+						ID:       "user2",
+						StableID: "5660",
 						Attributes: &gitlab.GroupMember{
 							ID:       5660,
 							Username: "user2",
@@ -1130,7 +1334,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			inputMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.User{
 							ID:       2286,
 							Username: "user1",
@@ -1140,7 +1345,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.User{
 							ID:       3208,
 							Username: "user3",
@@ -1171,7 +1377,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.GroupMember{
 							ID:       2286,
 							Username: "user1",
@@ -1181,7 +1388,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.GroupMember{
 							ID:       3208,
 							Username: "user3",
@@ -1248,7 +1456,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			inputMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.User{
 							ID:       2286,
 							Username: "user1",
@@ -1258,7 +1467,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.User{
 							ID:       3208,
 							Username: "user3",
@@ -1270,7 +1480,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			wantMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.GroupMember{
 							ID:       2286,
 							Username: "user1",
@@ -1280,7 +1491,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.GroupMember{
 							ID:       3208,
 							Username: "user3",
@@ -1351,7 +1563,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			inputMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.User{
 							ID:       2286,
 							Username: "user1",
@@ -1361,7 +1574,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.User{
 							ID:       3208,
 							Username: "user3",
@@ -1392,7 +1606,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.GroupMember{
 							ID:       2286,
 							Username: "user1",
@@ -1402,7 +1617,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.GroupMember{
 							ID:       3208,
 							Username: "user3",
@@ -1469,7 +1685,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			inputMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.User{
 							ID:       2286,
 							Username: "user1",
@@ -1479,7 +1696,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.User{
 							ID:       3208,
 							Username: "user3",
@@ -1500,7 +1718,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			wantMembers: []groupsync.Member{
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user1",
+						ID:       "user1",
+						StableID: "2286",
 						Attributes: &gitlab.GroupMember{
 							ID:       2286,
 							Username: "user1",
@@ -1510,7 +1729,8 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 				},
 				&groupsync.UserMember{
 					Usr: &groupsync.User{
-						ID: "user3",
+						ID:       "user3",
+						StableID: "3208",
 						Attributes: &gitlab.GroupMember{
 							ID:       3208,
 							Username: "user3",
@@ -1531,7 +1751,7 @@ func TestGroupReadWriter_SetMembers(t *testing.T) {
 			server := fakeGitLab(tc.data)
 			defer server.Close()
 
-			clientProvider := gitlabClientProvider(server)
+			clientProvider := gitlabClientProvider(server.URL)
 			groupRW := NewGroupReadWriter(clientProvider, tc.opts...)
 
 			err := groupRW.SetMembers(ctx, tc.groupID, tc.inputMembers)
@@ -1561,265 +1781,41 @@ type GitLabData struct {
 	subgroups    map[string]map[string]struct{}
 }
 
-func (d *GitLabData) findGroupByID(groupID int) *gitlab.Group {
-	for _, group := range d.groups {
-		if group.ID == groupID {
-			return group
-		}
-	}
-	return nil
-}
-
 type emptyKeyProvider struct{}
 
 func (p *emptyKeyProvider) Key(ctx context.Context) ([]byte, error) {
 	return []byte{}, nil
 }
 
-func gitlabClientProvider(server *httptest.Server) *ClientProvider {
-	return NewGitLabClientProvider(server.URL, &emptyKeyProvider{}, nil)
+func gitlabClientProvider(instanceURL string) *ClientProvider {
+	return NewGitLabClientProvider(instanceURL, &emptyKeyProvider{}, nil)
 }
 
-func fakeGitLab(gitlabData *GitLabData) *httptest.Server {
-	mux := http.NewServeMux()
-	mux.Handle("GET /api/v4/users", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		username := r.FormValue("username")
-		user, ok := gitlabData.users[username]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "user not found")
-			return
-		}
-		jsn, err := json.Marshal([]*gitlab.User{user})
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to marshal user")
-			return
-		}
-		_, err = w.Write(jsn)
-		if err != nil {
-			return
-		}
-	}))
-	mux.Handle("GET /api/v4/groups/{group_id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		groupID := r.PathValue("group_id")
-		group, ok := gitlabData.groups[groupID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "group not found")
-			return
-		}
-		jsn, err := json.Marshal(group)
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to marshal group")
-			return
-		}
-		_, err = w.Write(jsn)
-		if err != nil {
-			return
-		}
-	}))
-	mux.Handle("GET /api/v4/groups/{group_id}/members", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		groupID := r.PathValue("group_id")
-		members, ok := gitlabData.groupMembers[groupID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "group not found")
-			return
-		}
-		var users []*gitlab.User
-		for username := range members {
-			user, ok := gitlabData.users[username]
-			if !ok {
-				w.WriteHeader(500)
-				fmt.Fprintf(w, "user data inconsistency")
-				return
-			}
-			users = append(users, user)
-		}
-		jsn, err := json.Marshal(users)
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to marshal users")
-			return
-		}
-		_, err = w.Write(jsn)
-		if err != nil {
-			return
-		}
-	}))
-	mux.Handle("GET /api/v4/groups/{group_id}/subgroups", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		groupID := r.PathValue("group_id")
-		members, ok := gitlabData.subgroups[groupID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "group not found")
-			return
-		}
-		var subgroups []*gitlab.Group
-		for id := range members {
-			subgroup, ok := gitlabData.groups[id]
-			if !ok {
-				w.WriteHeader(500)
-				fmt.Fprintf(w, "user data inconsistency")
-				return
-			}
-			subgroups = append(subgroups, subgroup)
-		}
-		jsn, err := json.Marshal(subgroups)
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to marshal subgroups")
-			return
-		}
-		_, err = w.Write(jsn)
-		if err != nil {
-			return
-		}
-	}))
-	mux.Handle("POST /api/v4/groups/{group_id}/members", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		groupID := r.PathValue("group_id")
-		payload := make(map[string]any)
-		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to read request body")
-			return
-		}
-		username, ok := payload["username"].(string)
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "user not found")
-			return
-		}
-		members, ok := gitlabData.groupMembers[groupID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "group not found")
-			return
-		}
-		user, ok := gitlabData.users[username]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "user not found")
-			return
-		}
-		members[username] = struct{}{}
-		resp := &gitlab.GroupMember{
-			ID:       user.ID,
-			Username: username,
-		}
-		jsn, err := json.Marshal(resp)
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to marshal response")
-			return
-		}
-		_, err = w.Write(jsn)
-		if err != nil {
-			return
-		}
-	}))
-	mux.Handle("DELETE /api/v4/groups/{group_id}/members/{user_id}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		groupID := r.PathValue("group_id")
-		userID, err := strconv.Atoi(r.PathValue("user_id"))
-		if err != nil {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "user not found")
-			return
-		}
-		var username string
-		for _, user := range gitlabData.users {
-			if user.ID == userID {
-				username = user.Username
-				break
-			}
-		}
-		if username == "" {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "user not found")
-			return
-		}
-		members, ok := gitlabData.groupMembers[groupID]
-		if !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "group not found")
-			return
-		}
-		if _, ok = members[username]; !ok {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "member not found")
-			return
-		}
-		delete(members, username)
-		w.WriteHeader(http.StatusNoContent)
-	}))
-	mux.Handle("POST /api/v4/groups/{id}/transfer", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		groupID, err := strconv.Atoi(r.PathValue("id"))
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "missing or malformed group id")
-			return
-		}
-		payload := make(map[string]any)
-		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to read request body")
-			return
-		}
-		var parentGroupID int
-		parentGroupIDPayload, ok := payload["group_id"]
-		if ok {
-			parentGroupIDFloat, ok := parentGroupIDPayload.(float64)
-			if !ok {
-				w.WriteHeader(500)
-				fmt.Fprintf(w, "malformed parent group id %v", parentGroupIDPayload)
-				return
-			}
-			parentGroupID = int(parentGroupIDFloat)
-		}
-		childGroup := gitlabData.findGroupByID(groupID)
-		if childGroup == nil {
-			w.WriteHeader(404)
-			fmt.Fprintf(w, "group not found")
-			return
-		}
-		oldParentGroup := gitlabData.findGroupByID(childGroup.ParentID)
-		newParentGroup := gitlabData.findGroupByID(parentGroupID)
-		childGroup.ParentID = 0
-		if newParentGroup != nil {
-			childGroup.ParentID = newParentGroup.ID
-		}
-		if oldParentGroup != nil {
-			oldParentSubgroups, ok := gitlabData.subgroups[strconv.Itoa(oldParentGroup.ID)]
-			if !ok {
-				w.WriteHeader(500)
-				fmt.Fprintf(w, "group %d subgroup membership data inconsistent", oldParentGroup.ID)
-				return
-			}
-			delete(oldParentSubgroups, strconv.Itoa(childGroup.ID))
-		}
-		if newParentGroup != nil {
-			newParentSubgroups, ok := gitlabData.subgroups[strconv.Itoa(newParentGroup.ID)]
-			if !ok {
-				w.WriteHeader(500)
-				fmt.Fprintf(w, "group %d subgroup membership data inconsistent", newParentGroup.ID)
-				return
-			}
-			newParentSubgroups[strconv.Itoa(childGroup.ID)] = struct{}{}
-		}
-		jsn, err := json.Marshal(childGroup)
-		if err != nil {
-			w.WriteHeader(500)
-			fmt.Fprintf(w, "failed to marshal group")
-			return
-		}
-		_, err = w.Write(jsn)
-		if err != nil {
-			return
+// fakeGitLab starts a gitlabtest.Server serving gitlabData.
+func fakeGitLab(gitlabData *GitLabData) *gitlabtest.Server {
+	builder := gitlabtest.NewBuilder()
+	for _, user := range gitlabData.users {
+		builder.WithUser(user)
+	}
+	for _, group := range gitlabData.groups {
+		builder.WithGroup(group)
+	}
+	for groupID, members := range gitlabData.groupMembers {
+		builder.WithGroupMembers(fakeID(groupID), utils.MapKeys(members)...)
+	}
+	for groupID, subgroups := range gitlabData.subgroups {
+		ids := make([]int, 0, len(subgroups))
+		for id := range subgroups {
+			ids = append(ids, fakeID(id))
 		}
-	}))
-	return httptest.NewServer(mux)
+		builder.WithSubgroups(fakeID(groupID), ids...)
+	}
+	return builder.Start()
+}
+
+func fakeID(id string) int {
+	i, _ := strconv.Atoi(id)
+	return i
 }
 
 func sortByID(members []groupsync.Member) {
@@ -1827,3 +1823,66 @@ func sortByID(members []groupsync.Member) {
 		return strings.Compare(a.ID(), b.ID())
 	})
 }
+
+func TestClassifyErr(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		err           error
+		wantRateLimit bool
+		wantTransient bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "other_error",
+			err:  fmt.Errorf("boom"),
+		},
+		{
+			name: "too_many_requests",
+			err: fmt.Errorf("failed to list: %w", &gitlab.ErrorResponse{
+				Response: &http.Response{StatusCode: http.StatusTooManyRequests},
+			}),
+			wantRateLimit: true,
+		},
+		{
+			name: "bad_gateway",
+			err: &gitlab.ErrorResponse{
+				Response: &http.Response{StatusCode: http.StatusBadGateway},
+			},
+			wantTransient: true,
+		},
+		{
+			name: "service_unavailable",
+			err: &gitlab.ErrorResponse{
+				Response: &http.Response{StatusCode: http.StatusServiceUnavailable},
+			},
+			wantTransient: true,
+		},
+		{
+			name: "client_error",
+			err: &gitlab.ErrorResponse{
+				Response: &http.Response{StatusCode: http.StatusNotFound},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := classifyErr(tc.err)
+			if tc.err == nil && got != nil {
+				t.Errorf("expected nil error, got %v", got)
+			}
+			if gotRateLimit := errors.Is(got, groupsync.ErrRateLimited); gotRateLimit != tc.wantRateLimit {
+				t.Errorf("errors.Is(ErrRateLimited) = %t, want %t", gotRateLimit, tc.wantRateLimit)
+			}
+			if gotTransient := errors.Is(got, groupsync.ErrTransient); gotTransient != tc.wantTransient {
+				t.Errorf("errors.Is(ErrTransient) = %t, want %t", gotTransient, tc.wantTransient)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+// scopeAPI is the scope GitLab tokens need to manage group members.
+const scopeAPI = "api"
+
+// CheckWritePermissions verifies that the GitLab token is active and has the
+// api scope. Tokens which cannot report their scopes, e.g. on GitLab versions
+// without the personal_access_tokens/self endpoint, are not verified.
+func (rw *GroupReadWriter) CheckWritePermissions(ctx context.Context, groupIDs []string) error {
+	client, err := rw.clientProvider.Client(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get gitlab client: %w", err)
+	}
+	token, resp, err := client.PersonalAccessTokens.GetSinglePersonalAccessToken()
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			logging.FromContext(ctx).InfoContext(ctx, "token does not report its scopes, skipping scope check")
+			return nil
+		}
+		return fmt.Errorf("failed to get gitlab token: %w", classifyErr(err))
+	}
+	if !token.Active || token.Revoked {
+		return fmt.Errorf("gitlab token %q is not active", token.Name)
+	}
+	if !slices.Contains(token.Scopes, scopeAPI) {
+		return fmt.Errorf("gitlab token %q has scopes %q, it needs the %s scope to manage group members", token.Name, token.Scopes, scopeAPI)
+	}
+	return nil
+}
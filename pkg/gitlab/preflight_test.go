@@ -0,0 +1,85 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestGroupReadWriter_CheckWritePermissions(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		status  int
+		token   string
+		wantErr string
+	}{
+		{
+			name:   "api_scope",
+			status: http.StatusOK,
+			token:  `{"name": "team-link", "active": true, "scopes": ["api"]}`,
+		},
+		{
+			name:    "missing_scope",
+			status:  http.StatusOK,
+			token:   `{"name": "team-link", "active": true, "scopes": ["read_api"]}`,
+			wantErr: `gitlab token "team-link" has scopes ["read_api"], it needs the api scope`,
+		},
+		{
+			name:    "revoked",
+			status:  http.StatusOK,
+			token:   `{"name": "team-link", "active": false, "revoked": true, "scopes": ["api"]}`,
+			wantErr: `gitlab token "team-link" is not active`,
+		},
+		{
+			name:   "unsupported",
+			status: http.StatusNotFound,
+			token:  `{"message": "404 Not Found"}`,
+		},
+		{
+			name:    "unauthorized",
+			status:  http.StatusUnauthorized,
+			token:   `{"message": "401 Unauthorized"}`,
+			wantErr: "failed to get gitlab token",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.Handle("GET /api/v4/personal_access_tokens/self", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.token)) //nolint:errcheck // test server
+			}))
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			rw := NewGroupReadWriter(gitlabClientProvider(server.URL))
+			err := rw.CheckWritePermissions(context.Background(), []string{"1"})
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// DefaultMaxRateLimitWait is how long a request waits at most for the rate
+// limit of GitLab to reset. GitLab rate limits are per minute, so a longer
+// wait means the limit is not about to reset and the request fails instead.
+const DefaultMaxRateLimitWait = time.Minute
+
+const (
+	headerRateLimitRemaining = "RateLimit-Remaining"
+	headerRateLimitReset     = "RateLimit-Reset"
+	headerRetryAfter         = "Retry-After"
+
+	// minRetryBackoff and serverErrorBackoff are the delays before retrying a
+	// rate limited request without rate limit headers, doubled per attempt,
+	// and a request which failed with a server error, per attempt.
+	minRetryBackoff    = 100 * time.Millisecond
+	serverErrorBackoff = 800 * time.Millisecond
+)
+
+// RateLimiter shares the rate limit of a GitLab instance between all clients
+// of a ClientProvider, and thereby between the requests made for all groups.
+// It follows the RateLimit-Remaining and RateLimit-Reset headers of the
+// responses: once the limit is spent, requests wait until it resets. Rate
+// limited responses (HTTP 429) hold back all requests until their
+// Retry-After, and are retried. Waits longer than the maximum wait fail with
+// groupsync.ErrRateLimited instead, which syncers retry later.
+type RateLimiter struct {
+	maxWait time.Duration
+	// after is time.After, replaced by tests.
+	after func(time.Duration) <-chan time.Time
+
+	mu           sync.Mutex
+	remaining    int
+	reset        time.Time
+	blockedUntil time.Time
+	waits        int
+	waited       time.Duration
+	rateLimited  int
+}
+
+// RateLimitOpt configures a RateLimiter.
+type RateLimitOpt func(l *RateLimiter)
+
+// WithMaxRateLimitWait sets how long a request waits at most for the rate
+// limit to reset, DefaultMaxRateLimitWait by default.
+func WithMaxRateLimitWait(maxWait time.Duration) RateLimitOpt {
+	return func(l *RateLimiter) {
+		l.maxWait = maxWait
+	}
+}
+
+// NewRateLimiter creates a RateLimiter which knows nothing of the rate limit
+// until it sees a response.
+func NewRateLimiter(opts ...RateLimitOpt) *RateLimiter {
+	l := &RateLimiter{
+		maxWait:   DefaultMaxRateLimitWait,
+		after:     time.After,
+		remaining: -1,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Wait blocks until a request can be made without exceeding the rate limit.
+// It is called by GitLab clients before every request.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	wait := l.delay()
+	if wait <= 0 {
+		return nil
+	}
+	if wait > l.maxWait {
+		return fmt.Errorf("%w: gitlab rate limit resets in %s, later than the maximum wait of %s",
+			groupsync.ErrRateLimited, wait.Round(time.Second), l.maxWait)
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "waiting for gitlab rate limit to reset", "wait", wait)
+	l.recordWait(wait)
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("failed to wait for gitlab rate limit: %w", ctx.Err())
+	case <-l.after(wait):
+		return nil
+	}
+}
+
+// LogStats logs how often and how long requests waited for the rate limit.
+func (l *RateLimiter) LogStats(ctx context.Context) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "gitlab rate limit stats",
+		"waits", l.waits,
+		"waited", l.waited,
+		"rate_limited_responses", l.rateLimited,
+		"remaining", l.remaining,
+	)
+}
+
+// delay returns how long to wait before the next request, which is not
+// positive if it can be made right away.
+func (l *RateLimiter) delay() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	wait := l.blockedUntil.Sub(now)
+	if l.remaining == 0 {
+		wait = max(wait, l.reset.Sub(now))
+	}
+	return wait
+}
+
+func (l *RateLimiter) recordWait(wait time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.waits++
+	l.waited += wait
+}
+
+// observe updates the rate limit from the headers of a response.
+func (l *RateLimiter) observe(resp *http.Response) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	remaining, rerr := strconv.Atoi(resp.Header.Get(headerRateLimitRemaining))
+	reset, terr := strconv.ParseInt(resp.Header.Get(headerRateLimitReset), 10, 64)
+	if rerr == nil && terr == nil {
+		l.remaining = remaining
+		l.reset = time.Unix(reset, 0)
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		return
+	}
+	l.rateLimited++
+	until, ok := retryAfter(resp.Header, now)
+	if !ok && terr == nil {
+		until, ok = time.Unix(reset, 0), true
+	}
+	if ok && until.After(l.blockedUntil) {
+		l.blockedUntil = until
+	}
+}
+
+// checkRetry decides whether a request is retried, like the default policy
+// of GitLab clients: rate limited requests and server errors are. It is
+// called for every response, whose rate limit headers are observed. Rate
+// limited requests are not retried if the wait exceeds the maximum wait.
+func (l *RateLimiter) checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err() //nolint:wrapcheck // Want passthrough
+	}
+	if err != nil {
+		return false, err
+	}
+	l.observe(resp)
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return l.delay() <= l.maxWait, nil
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return true, nil
+	}
+	return false, nil
+}
+
+// backoff returns the delay before retrying a request: until the rate limit
+// resets for rate limited requests, or a short delay growing with the
+// attempts otherwise.
+func (l *RateLimiter) backoff(minWait, _ time.Duration, attempt int, resp *http.Response) time.Duration {
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		return time.Duration(attempt+1) * serverErrorBackoff
+	}
+	if wait := l.delay(); wait > 0 {
+		l.recordWait(wait)
+		return wait
+	}
+	return time.Duration(float64(max(minWait, minRetryBackoff)) * math.Pow(2, float64(attempt)))
+}
+
+// retryAfter returns the time given by the Retry-After header, which is
+// either a number of seconds or an HTTP date.
+func retryAfter(header http.Header, now time.Time) (time.Time, bool) {
+	v := header.Get(headerRetryAfter)
+	if v == "" {
+		return time.Time{}, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return now.Add(time.Duration(seconds) * time.Second), true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestRateLimiter_Requests(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name            string
+		retryAfter      string
+		wantErr         error
+		wantRequests    int32
+		wantRateLimited int
+	}{
+		{
+			name:            "retried",
+			retryAfter:      "0",
+			wantRequests:    3,
+			wantRateLimited: 1,
+		},
+		{
+			name:            "wait_too_long",
+			retryAfter:      "120",
+			wantErr:         groupsync.ErrRateLimited,
+			wantRequests:    1,
+			wantRateLimited: 1,
+		},
+		{
+			name:            "wait_too_long_http_date",
+			retryAfter:      time.Now().Add(10 * time.Minute).UTC().Format(http.TimeFormat),
+			wantErr:         groupsync.ErrRateLimited,
+			wantRequests:    1,
+			wantRateLimited: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) == 1 {
+					w.Header().Set("Retry-After", tc.retryAfter)
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				fmt.Fprint(w, `{"id": 1, "name": "group1"}`)
+			}))
+			t.Cleanup(srv.Close)
+			ctx := context.Background()
+			provider := gitlabClientProvider(srv.URL)
+			rw := NewGroupReadWriter(provider)
+
+			// the second group is read once the first was retried or failed, and
+			// fails right away if the rate limit holds back all requests.
+			_, err := rw.GetGroup(ctx, "1")
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("GetGroup got err %v, want %v", err, tc.wantErr)
+			}
+			_, err = rw.GetGroup(ctx, "2")
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("GetGroup got err %v, want %v", err, tc.wantErr)
+			}
+			if got := requests.Load(); got != tc.wantRequests {
+				t.Errorf("got %d requests, want %d", got, tc.wantRequests)
+			}
+			if got := provider.RateLimiter().rateLimited; got != tc.wantRateLimited {
+				t.Errorf("got %d rate limited responses, want %d", got, tc.wantRateLimited)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		remaining int
+		resetIn   time.Duration
+		wantWait  bool
+		wantErr   error
+	}{
+		{
+			name:      "remaining",
+			remaining: 10,
+			resetIn:   30 * time.Second,
+		},
+		{
+			name:     "spent",
+			resetIn:  30 * time.Second,
+			wantWait: true,
+		},
+		{
+			name:    "spent_reset",
+			resetIn: -time.Second,
+		},
+		{
+			name:    "spent_long_reset",
+			resetIn: time.Hour,
+			wantErr: groupsync.ErrRateLimited,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := NewRateLimiter()
+			var waited time.Duration
+			l.after = func(d time.Duration) <-chan time.Time {
+				waited = d
+				ch := make(chan time.Time, 1)
+				ch <- time.Now()
+				return ch
+			}
+			resp := &http.Response{StatusCode: http.StatusOK, Header: make(http.Header)}
+			resp.Header.Set(headerRateLimitRemaining, strconv.Itoa(tc.remaining))
+			resp.Header.Set(headerRateLimitReset, strconv.FormatInt(time.Now().Add(tc.resetIn).Unix(), 10))
+			l.observe(resp)
+
+			err := l.Wait(context.Background())
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("Wait got err %v, want %v", err, tc.wantErr)
+			}
+			if got := waited > 0; got != tc.wantWait {
+				t.Errorf("Wait waited %s, want wait %t", waited, tc.wantWait)
+			}
+			if tc.wantWait && (waited > tc.resetIn || waited < tc.resetIn-2*time.Second) {
+				t.Errorf("Wait waited %s, want about %s", waited, tc.resetIn)
+			}
+		})
+	}
+}
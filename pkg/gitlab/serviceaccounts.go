@@ -0,0 +1,75 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// EnsureServiceAccount returns the GitLab user with the given username,
+// creating it as a service account named name if there is none. The account
+// is created in the top-level group with the given ID, as required on
+// GitLab.com, or for the whole instance if the ID is 0, which requires an
+// administrator token. Service accounts are a GitLab Premium and Ultimate
+// feature. The returned bool reports whether the account was created.
+func (rw *GroupReadWriter) EnsureServiceAccount(ctx context.Context, topLevelGroupID int, username, name string) (*groupsync.User, bool, error) {
+	user, err := rw.getGitLabUser(ctx, username)
+	switch {
+	case err == nil:
+		return &groupsync.User{ID: user.Username, StableID: stableID(user.ID), Attributes: user}, false, nil
+	case !errors.Is(err, errUserNotFound):
+		return nil, false, fmt.Errorf("could not get user: %w", classifyErr(err))
+	}
+
+	if name == "" {
+		name = username
+	}
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "creating service account",
+		"group_id", topLevelGroupID,
+		"username", username,
+	)
+	client, err := rw.clientProvider.Client(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get gitlab client: %w", err)
+	}
+	if topLevelGroupID == 0 {
+		user, _, err = client.Users.CreateServiceAccountUser(&gitlab.CreateServiceAccountUserOptions{
+			Name:     &name,
+			Username: &username,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to create service account %s: %w", username, classifyErr(err))
+		}
+	} else {
+		sa, _, err := client.Groups.CreateServiceAccount(topLevelGroupID, &gitlab.CreateServiceAccountOptions{
+			Name:     &name,
+			Username: &username,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to create service account %s in group %d: %w", username, topLevelGroupID, classifyErr(err))
+		}
+		user = &gitlab.User{ID: sa.ID, Username: sa.UserName, Name: sa.Name, State: "active", Bot: true}
+	}
+	rw.userCache.Set(user.Username, user)
+	return &groupsync.User{ID: user.Username, StableID: stableID(user.ID), Attributes: user}, true, nil
+}
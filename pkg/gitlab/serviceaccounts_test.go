@@ -0,0 +1,114 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"context"
+	"testing"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/gitlabtest"
+)
+
+func TestGroupReadWriter_EnsureServiceAccount(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		groupID     int
+		username    string
+		wantCreated bool
+		wantUserID  string
+		wantBot     bool
+		wantErr     string
+	}{
+		{
+			name:       "exists",
+			username:   "alice",
+			wantUserID: "1",
+		},
+		{
+			name:        "instance_account",
+			username:    "tl-bot",
+			wantCreated: true,
+			wantUserID:  "3",
+			wantBot:     true,
+		},
+		{
+			name:        "group_account",
+			groupID:     10,
+			username:    "tl-bot",
+			wantCreated: true,
+			wantUserID:  "3",
+			wantBot:     true,
+		},
+		{
+			name:     "subgroup",
+			groupID:  11,
+			username: "tl-bot",
+			wantErr:  "failed to create service account tl-bot in group 11",
+		},
+		{
+			name:     "missing_group",
+			groupID:  12,
+			username: "tl-bot",
+			wantErr:  "failed to create service account tl-bot in group 12",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := gitlabtest.NewBuilder().
+				WithUser(&gitlab.User{ID: 1, Username: "alice"}).
+				WithUser(&gitlab.User{ID: 2, Username: "bob"}).
+				WithGroup(&gitlab.Group{ID: 10, Name: "top"}).
+				WithGroup(&gitlab.Group{ID: 11, Name: "sub", ParentID: 10}).
+				Start()
+			defer server.Close()
+			ctx := context.Background()
+			rw := NewGroupReadWriter(gitlabClientProvider(server.URL))
+
+			user, created, err := rw.EnsureServiceAccount(ctx, tc.groupID, tc.username, "")
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Fatalf("unexpected err: %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if created != tc.wantCreated {
+				t.Errorf("EnsureServiceAccount got created %t, want %t", created, tc.wantCreated)
+			}
+			if user.ID != tc.username || user.StableID != tc.wantUserID {
+				t.Errorf("EnsureServiceAccount got user %s (%s), want %s (%s)", user.ID, user.StableID, tc.username, tc.wantUserID)
+			}
+
+			// the account is a user of the instance from now on.
+			got, err := NewGroupReadWriter(gitlabClientProvider(server.URL)).getGitLabUser(ctx, tc.username)
+			if err != nil {
+				t.Fatalf("failed to get user: %v", err)
+			}
+			if got.Bot != tc.wantBot {
+				t.Errorf("user %s got bot %t, want %t", tc.username, got.Bot, tc.wantBot)
+			}
+			if _, created, err := rw.EnsureServiceAccount(ctx, tc.groupID, tc.username, ""); err != nil || created {
+				t.Errorf("EnsureServiceAccount of existing account got created %t, err %v", created, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/credentials"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const (
+	// DefaultRotateBefore is how long before its expiry a token is rotated.
+	DefaultRotateBefore = 7 * 24 * time.Hour
+	// DefaultTokenValidity is how long a rotated token is valid.
+	DefaultTokenValidity = 30 * 24 * time.Hour
+
+	// storeCheckInterval is how often the token is read from the store again,
+	// to pick up the token rotated by another process.
+	storeCheckInterval = time.Minute
+)
+
+// rotatedToken is a token rotated by a RotatingTokenProvider, as kept in the
+// state store.
+type rotatedToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// Initial is the hash of the configured token the token was rotated
+	// from, so that a rotated token is ignored once another token is
+	// configured.
+	Initial string `json:"initial"`
+}
+
+// RotationConfig holds the optional settings of a RotatingTokenProvider.
+type RotationConfig struct {
+	rotateBefore time.Duration
+	validity     time.Duration
+	httpClient   *http.Client
+}
+
+// RotationOpt configures a RotatingTokenProvider.
+type RotationOpt func(config *RotationConfig)
+
+// WithRotateBefore sets how long before its expiry the token is rotated,
+// DefaultRotateBefore by default.
+func WithRotateBefore(d time.Duration) RotationOpt {
+	return func(config *RotationConfig) {
+		config.rotateBefore = d
+	}
+}
+
+// WithTokenValidity sets how long a rotated token is valid,
+// DefaultTokenValidity by default. GitLab rounds it to days.
+func WithTokenValidity(d time.Duration) RotationOpt {
+	return func(config *RotationConfig) {
+		config.validity = d
+	}
+}
+
+// WithRotationHTTPClient sets the HTTP client used to rotate the token.
+func WithRotationHTTPClient(client *http.Client) RotationOpt {
+	return func(config *RotationConfig) {
+		config.httpClient = client
+	}
+}
+
+// RotatingTokenProvider implements credentials.KeyProvider with a GitLab
+// group access token, or any other access token, which it rotates shortly
+// before it expires, so that long-running deployments keep working. The
+// rotated token replaces the configured one, which GitLab revokes, so it is
+// kept in a state store and shared by the processes using the same store.
+// The tokens are stored in plain text, so the store must be protected
+// accordingly.
+type RotatingTokenProvider struct {
+	instanceURL string
+	initial     credentials.KeyProvider
+	store       state.Store
+	name        string
+	config      *RotationConfig
+	now         func() time.Time
+
+	mu        sync.Mutex
+	current   *rotatedToken
+	checkedAt time.Time
+}
+
+// NewRotatingTokenProvider creates a RotatingTokenProvider for the GitLab
+// instance at the given URL, starting from the token of initial. The rotated
+// token is kept in store under the given name.
+func NewRotatingTokenProvider(instanceURL string, initial credentials.KeyProvider, store state.Store, name string, opts ...RotationOpt) *RotatingTokenProvider {
+	config := &RotationConfig{
+		rotateBefore: DefaultRotateBefore,
+		validity:     DefaultTokenValidity,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &RotatingTokenProvider{
+		instanceURL: instanceURL,
+		initial:     initial,
+		store:       store,
+		name:        name,
+		config:      config,
+		now:         time.Now,
+	}
+}
+
+// Key returns the current token, after rotating it if it expires soon. If the
+// rotation fails, the token is returned as long as it has not expired.
+func (p *RotatingTokenProvider) Key(ctx context.Context) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.current == nil || p.now().Sub(p.checkedAt) >= storeCheckInterval {
+		current, err := p.load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		p.current, p.checkedAt = current, p.now()
+	}
+	if p.current.ExpiresAt.IsZero() || p.now().Add(p.config.rotateBefore).Before(p.current.ExpiresAt) {
+		return []byte(p.current.Token), nil
+	}
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "rotating gitlab token",
+		"token_name", p.name,
+		"expires_at", p.current.ExpiresAt,
+	)
+	rotated, err := p.rotate(ctx)
+	if err != nil {
+		if p.now().Before(p.current.ExpiresAt) {
+			logger.WarnContext(ctx, "failed to rotate gitlab token, using it until it expires",
+				"token_name", p.name,
+				"expires_at", p.current.ExpiresAt,
+				"error", err,
+			)
+			return []byte(p.current.Token), nil
+		}
+		return nil, fmt.Errorf("failed to rotate expired gitlab token %s: %w", p.name, err)
+	}
+	p.current = rotated
+	if err := state.PutJSON(ctx, p.store, p.key(), rotated); err != nil {
+		// the previous token is revoked, so other processes and restarts
+		// cannot authenticate anymore.
+		logger.ErrorContext(ctx, "failed to save rotated gitlab token",
+			"alert", true,
+			"token_name", p.name,
+			"error", err,
+		)
+	}
+	return []byte(rotated.Token), nil
+}
+
+// load returns the token kept in the store, unless it was rotated from
+// another token than the configured one, in which case the configured token
+// and its expiry are returned.
+func (p *RotatingTokenProvider) load(ctx context.Context) (*rotatedToken, error) {
+	initial, err := p.initial.Key(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gitlab token: %w", err)
+	}
+	hash := tokenHash(initial)
+	var stored rotatedToken
+	switch err := state.GetJSON(ctx, p.store, p.key(), &stored); {
+	case err == nil && stored.Initial == hash:
+		return &stored, nil
+	case err == nil, errors.Is(err, state.ErrNotFound):
+	default:
+		return nil, fmt.Errorf("failed to read rotated gitlab token: %w", err)
+	}
+	// the expiry of the current token is known, unless it is the configured
+	// token.
+	if p.current != nil && p.current.Initial == hash {
+		return p.current, nil
+	}
+
+	client, err := p.client(string(initial))
+	if err != nil {
+		return nil, err
+	}
+	self, _, err := client.PersonalAccessTokens.GetSinglePersonalAccessToken(gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gitlab token %s: %w", p.name, classifyErr(err))
+	}
+	t := &rotatedToken{Token: string(initial), Initial: hash}
+	if self.ExpiresAt != nil {
+		t.ExpiresAt = time.Time(*self.ExpiresAt)
+	}
+	return t, nil
+}
+
+// rotate rotates the current token, which revokes it. If it was revoked
+// already, the token rotated by another process is read from the store.
+func (p *RotatingTokenProvider) rotate(ctx context.Context) (*rotatedToken, error) {
+	client, err := p.client(p.current.Token)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := gitlab.ISOTime(p.now().UTC().Add(p.config.validity).Truncate(24 * time.Hour))
+	token, resp, err := client.PersonalAccessTokens.RotatePersonalAccessTokenSelf(
+		&gitlab.RotatePersonalAccessTokenOptions{ExpiresAt: &expiresAt}, gitlab.WithContext(ctx))
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusUnauthorized {
+			var stored rotatedToken
+			if sErr := state.GetJSON(ctx, p.store, p.key(), &stored); sErr == nil && stored.Token != p.current.Token {
+				return &stored, nil
+			}
+		}
+		return nil, classifyErr(err)
+	}
+	rotated := &rotatedToken{Token: token.Token, Initial: p.current.Initial}
+	if token.ExpiresAt != nil {
+		rotated.ExpiresAt = time.Time(*token.ExpiresAt)
+	}
+	return rotated, nil
+}
+
+func (p *RotatingTokenProvider) client(token string) (*gitlab.Client, error) {
+	opts := []gitlab.ClientOptionFunc{gitlab.WithBaseURL(p.instanceURL)}
+	if p.config.httpClient != nil {
+		opts = append(opts, gitlab.WithHTTPClient(p.config.httpClient))
+	}
+	client, err := gitlab.NewClient(token, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitLab client: %w", err)
+	}
+	return client, nil
+}
+
+func (p *RotatingTokenProvider) key() string {
+	return state.Key("gitlab", "tokens", p.name)
+}
+
+func tokenHash(token []byte) string {
+	sum := sha256.Sum256(token)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlab
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/credentials"
+	"github.com/abcxyz/team-link/pkg/gitlabtest"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestRotatingTokenProvider_Key(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	cases := []struct {
+		name       string
+		expiresAt  time.Time
+		initial    string
+		stored     *rotatedToken
+		want       string
+		wantActive []string
+		wantStored *rotatedToken
+		wantErr    string
+	}{
+		{
+			name:       "not_expiring",
+			expiresAt:  now.Add(60 * day),
+			initial:    "glgat-initial",
+			want:       "glgat-initial",
+			wantActive: []string{"glgat-initial"},
+		},
+		{
+			name:       "expiring",
+			expiresAt:  now.Add(3 * day),
+			initial:    "glgat-initial",
+			want:       "glgat-rotated-2",
+			wantActive: []string{"glgat-rotated-2"},
+			wantStored: &rotatedToken{
+				Token:     "glgat-rotated-2",
+				ExpiresAt: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+				Initial:   tokenHash([]byte("glgat-initial")),
+			},
+		},
+		{
+			name:      "rotated_before",
+			expiresAt: now.Add(60 * day),
+			initial:   "glgat-old",
+			stored: &rotatedToken{
+				Token:     "glgat-initial",
+				ExpiresAt: now.Add(60 * day),
+				Initial:   tokenHash([]byte("glgat-old")),
+			},
+			want:       "glgat-initial",
+			wantActive: []string{"glgat-initial"},
+			wantStored: &rotatedToken{
+				Token:     "glgat-initial",
+				ExpiresAt: now.Add(60 * day),
+				Initial:   tokenHash([]byte("glgat-old")),
+			},
+		},
+		{
+			name:      "rotated_from_another_token",
+			expiresAt: now.Add(60 * day),
+			initial:   "glgat-initial",
+			stored: &rotatedToken{
+				Token:     "glgat-revoked",
+				ExpiresAt: now.Add(60 * day),
+				Initial:   tokenHash([]byte("glgat-other")),
+			},
+			want:       "glgat-initial",
+			wantActive: []string{"glgat-initial"},
+			wantStored: &rotatedToken{
+				Token:     "glgat-revoked",
+				ExpiresAt: now.Add(60 * day),
+				Initial:   tokenHash([]byte("glgat-other")),
+			},
+		},
+		{
+			name:       "unknown_token",
+			expiresAt:  now.Add(60 * day),
+			initial:    "glgat-unknown",
+			wantActive: []string{"glgat-initial"},
+			wantErr:    "failed to get gitlab token default",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			server := gitlabtest.NewBuilder().WithAccessToken("glgat-initial", tc.expiresAt).Start()
+			t.Cleanup(server.Close)
+			store := state.NewMemoryStore()
+			if tc.stored != nil {
+				if err := state.PutJSON(ctx, store, state.Key("gitlab", "tokens", "default"), tc.stored); err != nil {
+					t.Fatalf("failed to store token: %v", err)
+				}
+			}
+
+			p := NewRotatingTokenProvider(server.URL, credentials.NewStaticKeyProvider([]byte(tc.initial)), store, "default")
+			p.now = func() time.Time { return now }
+			got, err := p.Key(ctx)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if string(got) != tc.want {
+				t.Errorf("Key got %q, want %q", got, tc.want)
+			}
+			if diff := cmp.Diff(server.ActiveTokens(), tc.wantActive); diff != "" {
+				t.Errorf("unexpected active tokens (-got, +want):\n%s", diff)
+			}
+			var stored *rotatedToken
+			if err := state.GetJSON(ctx, store, state.Key("gitlab", "tokens", "default"), &stored); err != nil && tc.wantStored != nil {
+				t.Errorf("failed to read stored token: %v", err)
+			}
+			if diff := cmp.Diff(stored, tc.wantStored); diff != "" {
+				t.Errorf("unexpected stored token (-got, +want):\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+
+			// another process using the same store uses the rotated token.
+			other := NewRotatingTokenProvider(server.URL, credentials.NewStaticKeyProvider([]byte(tc.initial)), store, "default")
+			other.now = func() time.Time { return now }
+			got, err = other.Key(ctx)
+			if err != nil {
+				t.Fatalf("Key of other process failed: %v", err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("Key of other process got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
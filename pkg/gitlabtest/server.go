@@ -0,0 +1,568 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gitlabtest provides a fake GitLab API server for testing code which
+// reads and writes GitLab groups, e.g. with gitlab.GroupReadWriter, without
+// calling GitLab.
+package gitlabtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/abcxyz/pkg/pointer"
+)
+
+// Builder builds the groups and users served by a Server.
+type Builder struct {
+	server *Server
+}
+
+// NewBuilder creates a Builder of a Server without any group or user.
+func NewBuilder() *Builder {
+	return &Builder{server: &Server{
+		users:        make(map[string]*gitlab.User),
+		groups:       make(map[string]*gitlab.Group),
+		groupMembers: make(map[string]map[string]struct{}),
+		subgroups:    make(map[string]map[string]struct{}),
+		scim:         make(map[string][]map[string]any),
+		tokens:       make(map[string]*gitlab.PersonalAccessToken),
+	}}
+}
+
+// WithUser adds a user, identified by its username.
+func (b *Builder) WithUser(user *gitlab.User) *Builder {
+	u := *user
+	b.server.users[u.Username] = &u
+	return b
+}
+
+// WithGroup adds a group, identified by its ID, with the users with the given
+// usernames as its members. A group with a ParentID is a subgroup of its
+// parent.
+func (b *Builder) WithGroup(group *gitlab.Group, members ...string) *Builder {
+	g := *group
+	id := strconv.Itoa(g.ID)
+	b.server.groups[id] = &g
+	if _, ok := b.server.subgroups[id]; !ok {
+		b.server.subgroups[id] = make(map[string]struct{})
+	}
+	if g.ParentID != 0 {
+		b.WithSubgroups(g.ParentID, g.ID)
+	}
+	return b.WithGroupMembers(g.ID, members...)
+}
+
+// WithGroupMembers sets the members of the group with the given ID to the
+// users with the given usernames.
+func (b *Builder) WithGroupMembers(groupID int, members ...string) *Builder {
+	set := make(map[string]struct{}, len(members))
+	for _, m := range members {
+		set[m] = struct{}{}
+	}
+	b.server.groupMembers[strconv.Itoa(groupID)] = set
+	return b
+}
+
+// WithSubgroups adds the groups with the given IDs to the subgroups of the
+// group with the given ID. The subgroups need not be added with WithGroup,
+// which allows serving inconsistent data.
+func (b *Builder) WithSubgroups(groupID int, subgroupIDs ...int) *Builder {
+	id := strconv.Itoa(groupID)
+	if _, ok := b.server.subgroups[id]; !ok {
+		b.server.subgroups[id] = make(map[string]struct{})
+	}
+	for _, sub := range subgroupIDs {
+		b.server.subgroups[id][strconv.Itoa(sub)] = struct{}{}
+	}
+	return b
+}
+
+// WithSCIMIdentity adds a SCIM identity with the given externalId, linked to
+// the user with the given username, to the group with the given ID. The user
+// must be added with WithUser.
+func (b *Builder) WithSCIMIdentity(groupID int, externUID, username string, active bool) *Builder {
+	id := strconv.Itoa(groupID)
+	b.server.scim[id] = append(b.server.scim[id], map[string]any{
+		"extern_uid": externUID,
+		"user_id":    b.server.users[username].ID,
+		"active":     active,
+	})
+	return b
+}
+
+// WithAccessToken adds an access token with the given value, which expires
+// at the given time. Access tokens are only checked by the endpoints of the
+// current token, which reads and rotates it.
+func (b *Builder) WithAccessToken(token string, expiresAt time.Time) *Builder {
+	b.server.tokens[token] = &gitlab.PersonalAccessToken{
+		ID:        len(b.server.tokens) + 1,
+		Name:      "team-link",
+		Active:    true,
+		ExpiresAt: pointer.To(gitlab.ISOTime(expiresAt)),
+	}
+	return b
+}
+
+// Start starts a Server serving what was built. It must be closed with Close.
+func (b *Builder) Start() *Server {
+	s := b.server
+	s.Server = httptest.NewServer(s.handler())
+	return s
+}
+
+// Server is a fake GitLab API server. It serves the endpoints used to read and
+// write groups, their members and their subgroups, which are changed by
+// requests, to list users and the SCIM identities of groups, and to create
+// service accounts.
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	users        map[string]*gitlab.User
+	groups       map[string]*gitlab.Group
+	groupMembers map[string]map[string]struct{}
+	subgroups    map[string]map[string]struct{}
+	scim         map[string][]map[string]any
+	tokens       map[string]*gitlab.PersonalAccessToken
+	writes       int
+}
+
+// Client returns a GitLab client calling the server.
+func (s *Server) Client() (*gitlab.Client, error) {
+	client, err := gitlab.NewClient("", gitlab.WithBaseURL(s.URL))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+	return client, nil
+}
+
+// Writes returns the number of write requests the server received, e.g. to
+// check that an unchanged sync writes nothing.
+func (s *Server) Writes() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.writes
+}
+
+// ActiveTokens returns the sorted values of the access tokens which are not
+// revoked.
+func (s *Server) ActiveTokens() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var tokens []string
+	for token, t := range s.tokens {
+		if t.Active {
+			tokens = append(tokens, token)
+		}
+	}
+	slices.Sort(tokens)
+	return tokens
+}
+
+// Group returns the group with the given ID, or nil if there is none.
+func (s *Server) Group(groupID int) *gitlab.Group {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	group := s.findGroup(groupID)
+	if group == nil {
+		return nil
+	}
+	g := *group
+	return &g
+}
+
+// GroupMembers returns the sorted usernames of the members of the group with
+// the given ID.
+func (s *Server) GroupMembers(groupID int) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var usernames []string
+	for username := range s.groupMembers[strconv.Itoa(groupID)] {
+		usernames = append(usernames, username)
+	}
+	slices.Sort(usernames)
+	return usernames
+}
+
+func (s *Server) findGroup(groupID int) *gitlab.Group {
+	for _, group := range s.groups {
+		if group.ID == groupID {
+			return group
+		}
+	}
+	return nil
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		token, ok := s.tokens[r.Header.Get("PRIVATE-TOKEN")]
+		if !ok || !token.Active {
+			writeError(w, http.StatusUnauthorized, "401 Unauthorized")
+			return
+		}
+		writeJSON(w, token)
+	})
+	mux.HandleFunc("POST /api/v4/personal_access_tokens/self/rotate", func(w http.ResponseWriter, r *http.Request) {
+		token, ok := s.tokens[r.Header.Get("PRIVATE-TOKEN")]
+		if !ok || !token.Active {
+			writeError(w, http.StatusUnauthorized, "401 Unauthorized")
+			return
+		}
+		var opts gitlab.RotatePersonalAccessTokenOptions
+		if err := json.NewDecoder(r.Body).Decode(&opts); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request")
+			return
+		}
+		token.Active, token.Revoked = false, true
+		rotated := *token
+		rotated.ID = len(s.tokens) + 1
+		rotated.Active, rotated.Revoked = true, false
+		rotated.ExpiresAt = opts.ExpiresAt
+		value := fmt.Sprintf("glgat-rotated-%d", rotated.ID)
+		s.tokens[value] = &rotated
+		resp := rotated
+		resp.Token = value
+		writeJSON(w, &resp)
+	})
+	mux.HandleFunc("GET /api/v4/users", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("username") == "" {
+			users := make([]*gitlab.User, 0, len(s.users))
+			for _, u := range s.users {
+				users = append(users, u)
+			}
+			slices.SortFunc(users, func(a, b *gitlab.User) int {
+				return a.ID - b.ID
+			})
+			writeJSON(w, users)
+			return
+		}
+		user, ok := s.users[r.FormValue("username")]
+		if !ok {
+			// like GitLab, unknown usernames match no user.
+			writeJSON(w, []*gitlab.User{})
+			return
+		}
+		writeJSON(w, []*gitlab.User{user})
+	})
+	mux.HandleFunc("GET /api/v4/users/{user_id}", func(w http.ResponseWriter, r *http.Request) {
+		for _, user := range s.users {
+			if strconv.Itoa(user.ID) == r.PathValue("user_id") {
+				writeJSON(w, user)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, "user not found")
+	})
+	mux.HandleFunc("GET /api/v4/groups/{group_id}/scim/identities", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.groups[r.PathValue("group_id")]; !ok {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		writeJSON(w, append([]map[string]any{}, s.scim[r.PathValue("group_id")]...))
+	})
+	mux.HandleFunc("GET /api/v4/groups/{group_id}", func(w http.ResponseWriter, r *http.Request) {
+		group, ok := s.groups[r.PathValue("group_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		writeJSON(w, group)
+	})
+	mux.HandleFunc("PUT /api/v4/groups/{group_id}", func(w http.ResponseWriter, r *http.Request) {
+		group, ok := s.groups[r.PathValue("group_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		payload := make(map[string]any)
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			writeError(w, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+		if description, ok := payload["description"].(string); ok {
+			group.Description = description
+		}
+		writeJSON(w, group)
+	})
+	mux.HandleFunc("POST /api/v4/service_accounts", func(w http.ResponseWriter, r *http.Request) {
+		user, ok := s.createServiceAccount(w, r)
+		if !ok {
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		writeJSON(w, user)
+	})
+	mux.HandleFunc("POST /api/v4/groups/{group_id}/service_accounts", func(w http.ResponseWriter, r *http.Request) {
+		groupID, err := strconv.Atoi(r.PathValue("group_id"))
+		if err != nil || s.findGroup(groupID) == nil {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		if s.findGroup(groupID).ParentID != 0 {
+			writeError(w, http.StatusBadRequest, "service accounts can only be created in top-level groups")
+			return
+		}
+		user, ok := s.createServiceAccount(w, r)
+		if !ok {
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		writeJSON(w, &gitlab.GroupServiceAccount{ID: user.ID, Name: user.Name, UserName: user.Username})
+	})
+	mux.HandleFunc("POST /api/v4/groups", func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Name     string `json:"name"`
+			Path     string `json:"path"`
+			ParentID int    `json:"parent_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload.Name == "" || payload.Path == "" {
+			writeError(w, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+		if payload.ParentID != 0 && s.findGroup(payload.ParentID) == nil {
+			writeError(w, http.StatusNotFound, "parent group not found")
+			return
+		}
+		var id int
+		for _, group := range s.groups {
+			id = max(id, group.ID)
+		}
+		id++
+		group := &gitlab.Group{ID: id, Name: payload.Name, Path: payload.Path, ParentID: payload.ParentID}
+		key := strconv.Itoa(id)
+		s.groups[key] = group
+		s.groupMembers[key] = make(map[string]struct{})
+		s.subgroups[key] = make(map[string]struct{})
+		if payload.ParentID != 0 {
+			s.subgroups[strconv.Itoa(payload.ParentID)][key] = struct{}{}
+		}
+		w.WriteHeader(http.StatusCreated)
+		writeJSON(w, group)
+	})
+	mux.HandleFunc("DELETE /api/v4/groups/{group_id}", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("group_id")
+		group, ok := s.groups[id]
+		if !ok {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		delete(s.groups, id)
+		delete(s.groupMembers, id)
+		delete(s.subgroups, id)
+		if group.ParentID != 0 {
+			delete(s.subgroups[strconv.Itoa(group.ParentID)], id)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("GET /api/v4/groups/{group_id}/members", func(w http.ResponseWriter, r *http.Request) {
+		members, ok := s.groupMembers[r.PathValue("group_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		var users []*gitlab.User
+		for username := range members {
+			user, ok := s.users[username]
+			if !ok {
+				writeError(w, http.StatusInternalServerError, "user data inconsistency")
+				return
+			}
+			users = append(users, user)
+		}
+		writeJSON(w, users)
+	})
+	mux.HandleFunc("GET /api/v4/groups/{group_id}/subgroups", func(w http.ResponseWriter, r *http.Request) {
+		ids, ok := s.subgroups[r.PathValue("group_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		var subgroups []*gitlab.Group
+		for id := range ids {
+			subgroup, ok := s.groups[id]
+			if !ok {
+				writeError(w, http.StatusInternalServerError, "group data inconsistency")
+				return
+			}
+			subgroups = append(subgroups, subgroup)
+		}
+		writeJSON(w, subgroups)
+	})
+	mux.HandleFunc("POST /api/v4/groups/{group_id}/members", func(w http.ResponseWriter, r *http.Request) {
+		payload := make(map[string]any)
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			writeError(w, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+		username, ok := payload["username"].(string)
+		if !ok {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		members, ok := s.groupMembers[r.PathValue("group_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		user, ok := s.users[username]
+		if !ok {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		members[username] = struct{}{}
+		writeJSON(w, &gitlab.GroupMember{ID: user.ID, Username: username})
+	})
+	mux.HandleFunc("DELETE /api/v4/groups/{group_id}/members/{user_id}", func(w http.ResponseWriter, r *http.Request) {
+		userID, err := strconv.Atoi(r.PathValue("user_id"))
+		if err != nil {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		var username string
+		for _, user := range s.users {
+			if user.ID == userID {
+				username = user.Username
+				break
+			}
+		}
+		if username == "" {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		members, ok := s.groupMembers[r.PathValue("group_id")]
+		if !ok {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		if _, ok := members[username]; !ok {
+			writeError(w, http.StatusNotFound, "member not found")
+			return
+		}
+		delete(members, username)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("POST /api/v4/groups/{id}/transfer", func(w http.ResponseWriter, r *http.Request) {
+		groupID, err := strconv.Atoi(r.PathValue("id"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "missing or malformed group id")
+			return
+		}
+		payload := make(map[string]any)
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			writeError(w, http.StatusBadRequest, "failed to read request body")
+			return
+		}
+		// a missing group_id transfers the group to the top level.
+		var parentGroupID int
+		if v, ok := payload["group_id"]; ok {
+			f, ok := v.(float64)
+			if !ok {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("malformed parent group id %v", v))
+				return
+			}
+			parentGroupID = int(f)
+		}
+		childGroup := s.findGroup(groupID)
+		if childGroup == nil {
+			writeError(w, http.StatusNotFound, "group not found")
+			return
+		}
+		oldParentGroup := s.findGroup(childGroup.ParentID)
+		newParentGroup := s.findGroup(parentGroupID)
+		childGroup.ParentID = 0
+		if newParentGroup != nil {
+			childGroup.ParentID = newParentGroup.ID
+		}
+		if oldParentGroup != nil {
+			oldParentSubgroups, ok := s.subgroups[strconv.Itoa(oldParentGroup.ID)]
+			if !ok {
+				writeError(w, http.StatusInternalServerError, fmt.Sprintf("group %d subgroup membership data inconsistent", oldParentGroup.ID))
+				return
+			}
+			delete(oldParentSubgroups, strconv.Itoa(childGroup.ID))
+		}
+		if newParentGroup != nil {
+			newParentSubgroups, ok := s.subgroups[strconv.Itoa(newParentGroup.ID)]
+			if !ok {
+				writeError(w, http.StatusInternalServerError, fmt.Sprintf("group %d subgroup membership data inconsistent", newParentGroup.ID))
+				return
+			}
+			newParentSubgroups[strconv.Itoa(childGroup.ID)] = struct{}{}
+		}
+		writeJSON(w, childGroup)
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if r.Method != http.MethodGet {
+			s.writes++
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// createServiceAccount adds a bot user with the username and name of the
+// request. It writes an error response and returns false if the request is
+// invalid or the username is taken.
+func (s *Server) createServiceAccount(w http.ResponseWriter, r *http.Request) (*gitlab.User, bool) {
+	var payload struct {
+		Name     string `json:"name"`
+		Username string `json:"username"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload.Username == "" {
+		writeError(w, http.StatusBadRequest, "failed to read request body")
+		return nil, false
+	}
+	if _, ok := s.users[payload.Username]; ok {
+		writeError(w, http.StatusBadRequest, "username has already been taken")
+		return nil, false
+	}
+	var id int
+	for _, u := range s.users {
+		id = max(id, u.ID)
+	}
+	user := &gitlab.User{
+		ID:       id + 1,
+		Username: payload.Username,
+		Name:     payload.Name,
+		State:    "active",
+		Bot:      true,
+	}
+	s.users[user.Username] = user
+	return user, true
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	jsn, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to marshal response")
+		return
+	}
+	w.Write(jsn) //nolint:errcheck // the client went away
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.WriteHeader(status)
+	fmt.Fprint(w, msg)
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabtest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := NewBuilder().
+		WithUser(&gitlab.User{ID: 10, Username: "alice"}).
+		WithUser(&gitlab.User{ID: 11, Username: "bob"}).
+		WithGroup(&gitlab.Group{ID: 1, Name: "eng"}, "alice").
+		WithGroup(&gitlab.Group{ID: 2, Name: "sre", ParentID: 1}).
+		Start()
+	t.Cleanup(server.Close)
+	client, err := server.Client()
+	if err != nil {
+		t.Fatalf("Client failed: %v", err)
+	}
+
+	subgroups, _, err := client.Groups.ListSubGroups(1, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		t.Fatalf("ListSubGroups failed: %v", err)
+	}
+	if len(subgroups) != 1 || subgroups[0].ID != 2 {
+		t.Errorf("ListSubGroups got %v, want group 2", subgroups)
+	}
+
+	if _, _, err := client.GroupMembers.AddGroupMember(1, &gitlab.AddGroupMemberOptions{Username: gitlab.Ptr("bob")}, gitlab.WithContext(ctx)); err != nil {
+		t.Fatalf("AddGroupMember failed: %v", err)
+	}
+	if _, err := client.GroupMembers.RemoveGroupMember(1, 10, nil, gitlab.WithContext(ctx)); err != nil {
+		t.Fatalf("RemoveGroupMember failed: %v", err)
+	}
+	if diff := cmp.Diff(server.GroupMembers(1), []string{"bob"}); diff != "" {
+		t.Errorf("unexpected group members (-got, +want):\n%s", diff)
+	}
+
+	if _, _, err := client.Groups.TransferSubGroup(2, &gitlab.TransferSubGroupOptions{}, gitlab.WithContext(ctx)); err != nil {
+		t.Fatalf("TransferSubGroup failed: %v", err)
+	}
+	if got := server.Group(2).ParentID; got != 0 {
+		t.Errorf("transferred group has parent %d, want none", got)
+	}
+	if _, _, err := client.Groups.GetGroup(3, nil, gitlab.WithContext(ctx)); err == nil {
+		t.Errorf("GetGroup of missing group got no error")
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package googlecalendar provides a GroupReadWriter for the access control
+// lists of Google Calendars, so that team calendars are shared with the
+// members of the team without sharing them with its Google Group.
+package googlecalendar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// Reader is the role of users who can see the details of all events.
+	Reader = "reader"
+	// Writer is the role of users who can also change events.
+	Writer = "writer"
+
+	// userScope is the scope type of ACL rules granting a single user access.
+	userScope = "user"
+)
+
+// roleRanks orders the roles team-link grants, and those it never lowers.
+var roleRanks = map[string]int{
+	"freeBusyReader": 1,
+	Reader:           2,
+	Writer:           3,
+	"owner":          4,
+}
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Encode returns the group ID of the users granted the given role on the
+// calendar with the given ID.
+func Encode(role, calendarID string) string {
+	return role + "/" + calendarID
+}
+
+// Decode returns the role, Reader or Writer, and calendar ID of a group ID.
+func Decode(groupID string) (string, string, error) {
+	role, calendarID, _ := strings.Cut(groupID, "/")
+	if (role != Reader && role != Writer) || calendarID == "" {
+		return "", "", fmt.Errorf("%w: group ID %q must be of the form reader/CALENDAR_ID or writer/CALENDAR_ID", groupsync.ErrInvalidGroupID, groupID)
+	}
+	return role, calendarID, nil
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the ACL rules of Google Calendars which grant single users
+// access. A group is the set of users granted a role on a calendar, see
+// Encode, and user IDs are emails. Users granted a higher role than the
+// group's, e.g. writers or owners of a calendar synced as reader/CALENDAR_ID,
+// keep it; users granted a lower role are upgraded. Rules granting access to
+// domains, groups or the public are left alone.
+type GroupReadWriter struct {
+	calendar *calendar.Service
+}
+
+// NewGroupReadWriter creates a GroupReadWriter using the given Calendar API
+// service, which must be authorized to manage the sharing of the calendars.
+func NewGroupReadWriter(calendarService *calendar.Service) *GroupReadWriter {
+	return &GroupReadWriter{calendar: calendarService}
+}
+
+// GetGroup retrieves the calendar of the given group ID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	_, calendarID, err := Decode(groupID)
+	if err != nil {
+		return nil, err
+	}
+	cal, err := rw.calendar.Calendars.Get(calendarID).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar %s: %w", calendarID, notFound(err))
+	}
+	return &groupsync.Group{ID: groupID, Attributes: cal}, nil
+}
+
+// GetMembers retrieves the users granted the role of the given group ID on
+// its calendar.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	role, calendarID, err := Decode(groupID)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := rw.userRules(ctx, calendarID)
+	if err != nil {
+		return nil, err
+	}
+	var members []groupsync.Member
+	for _, r := range rules {
+		if r.Role == role {
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: r.Scope.Value, Attributes: r}})
+		}
+	}
+	return members, nil
+}
+
+// Descendants retrieve all users granted the role of the given group ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser returns the user with the given email. Calendars can be shared
+// with any Google account, so the user is not looked up.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	return &groupsync.User{ID: userID}, nil
+}
+
+// SetMembers replaces the users granted the role of the given group ID on
+// its calendar with the given members. Users are granted the role without
+// being notified by email.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	role, calendarID, err := Decode(groupID)
+	if err != nil {
+		return err
+	}
+	rules, err := rw.userRules(ctx, calendarID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentRoles := make(map[string]*calendar.AclRule, len(rules))
+	currentMemberIDs := make(map[string]struct{})
+	for _, r := range rules {
+		email := strings.ToLower(r.Scope.Value)
+		currentRoles[email] = r
+		if r.Role == role {
+			currentMemberIDs[email] = struct{}{}
+		}
+	}
+	newMemberIDs := make(map[string]struct{}, len(members))
+	for _, m := range members {
+		newMemberIDs[strings.ToLower(m.ID())] = struct{}{}
+	}
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	var merr error
+	for _, email := range utils.MapKeys(addMembers) {
+		if current, ok := currentRoles[email]; ok && roleRanks[current.Role] > roleRanks[role] {
+			logger.InfoContext(ctx, "keeping higher calendar role",
+				"group_id", groupID,
+				"user_id", email,
+				"role", current.Role,
+			)
+			continue
+		}
+		rule := &calendar.AclRule{Role: role, Scope: &calendar.AclRuleScope{Type: userScope, Value: email}}
+		if _, err := rw.calendar.Acl.Insert(calendarID, rule).SendNotifications(false).Context(ctx).Do(); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to grant %s %s on calendar %s: %w", email, role, calendarID, classifyErr(err)))
+		}
+	}
+	for _, email := range utils.MapKeys(removeMembers) {
+		if err := rw.calendar.Acl.Delete(calendarID, currentRoles[email].Id).Context(ctx).Do(); err != nil && !isNotFound(err) {
+			merr = errors.Join(merr, fmt.Errorf("failed to revoke %s of %s on calendar %s: %w", role, email, calendarID, classifyErr(err)))
+		}
+	}
+	return merr
+}
+
+// userRules returns the ACL rules of the calendar with the given ID which
+// grant single users access.
+func (rw *GroupReadWriter) userRules(ctx context.Context, calendarID string) ([]*calendar.AclRule, error) {
+	var rules []*calendar.AclRule
+	if err := rw.calendar.Acl.List(calendarID).Context(ctx).Pages(ctx, func(page *calendar.Acl) error {
+		for _, r := range page.Items {
+			if r.Scope != nil && r.Scope.Type == userScope {
+				rules = append(rules, r)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to list acl of calendar %s: %w", calendarID, notFound(err))
+	}
+	return rules, nil
+}
+
+func isNotFound(err error) bool {
+	var gerr *googleapi.Error
+	return errors.As(err, &gerr) && gerr.Code == http.StatusNotFound
+}
+
+// notFound wraps errors of missing calendars with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if isNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return classifyErr(err)
+}
+
+// classifyErr marks rate limit responses with groupsync.ErrRateLimited and
+// server errors with groupsync.ErrTransient. Other errors, including nil,
+// are returned as is. Calendar reports exceeded rate limits as 403 responses
+// with a rate limit reason.
+func classifyErr(err error) error {
+	var gerr *googleapi.Error
+	switch {
+	case !errors.As(err, &gerr):
+		return err
+	case gerr.Code == http.StatusTooManyRequests || (gerr.Code == http.StatusForbidden && isRateLimitReason(gerr)):
+		return fmt.Errorf("%w: %w", groupsync.ErrRateLimited, err)
+	case gerr.Code >= http.StatusInternalServerError:
+		return fmt.Errorf("%w: %w", groupsync.ErrTransient, err)
+	}
+	return err
+}
+
+func isRateLimitReason(gerr *googleapi.Error) bool {
+	for _, item := range gerr.Errors {
+		if item.Reason == "rateLimitExceeded" || item.Reason == "userRateLimitExceeded" {
+			return true
+		}
+	}
+	return false
+}
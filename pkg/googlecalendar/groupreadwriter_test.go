@@ -0,0 +1,212 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlecalendar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+const testCalendar = "c_team@group.calendar.google.com"
+
+func TestGroupReadWriter_SetMembers(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		groupID string
+		members []string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:    "readers",
+			groupID: Encode(Reader, testCalendar),
+			members: []string{"Bob@example.com", "carol@example.com", "owner@example.com", "writer@example.com"},
+			want: []string{
+				"bob@example.com:reader",
+				"carol@example.com:reader",
+				"free@example.com:freeBusyReader",
+				"owner@example.com:owner",
+				"writer@example.com:writer",
+			},
+		},
+		{
+			name:    "writers",
+			groupID: Encode(Writer, testCalendar),
+			members: []string{"alice@example.com", "free@example.com"},
+			want: []string{
+				"alice@example.com:writer",
+				"bob@example.com:reader",
+				"free@example.com:writer",
+				"owner@example.com:owner",
+			},
+		},
+		{
+			name:    "invalid_role",
+			groupID: "owner/" + testCalendar,
+			want: []string{
+				"alice@example.com:reader",
+				"bob@example.com:reader",
+				"free@example.com:freeBusyReader",
+				"owner@example.com:owner",
+				"writer@example.com:writer",
+			},
+			wantErr: "must be of the form reader/CALENDAR_ID or writer/CALENDAR_ID",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			fake := &fakeCalendar{rules: map[string]*calendar.AclRule{
+				"default":                 {Id: "default", Role: "none", Scope: &calendar.AclRuleScope{Type: "default"}},
+				"domain:example.com":      {Id: "domain:example.com", Role: Reader, Scope: &calendar.AclRuleScope{Type: "domain", Value: "example.com"}},
+				"user:alice@example.com":  userRule("alice@example.com", Reader),
+				"user:bob@example.com":    userRule("bob@example.com", Reader),
+				"user:free@example.com":   userRule("free@example.com", "freeBusyReader"),
+				"user:owner@example.com":  userRule("owner@example.com", "owner"),
+				"user:writer@example.com": userRule("writer@example.com", Writer),
+			}}
+			srv := httptest.NewServer(fake)
+			t.Cleanup(srv.Close)
+			cs, err := calendar.NewService(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+			if err != nil {
+				t.Fatalf("failed to create calendar service: %v", err)
+			}
+			rw := NewGroupReadWriter(cs)
+
+			members := make([]groupsync.Member, 0, len(tc.members))
+			for _, email := range tc.members {
+				members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: email}})
+			}
+			err = rw.SetMembers(ctx, tc.groupID, members)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(fake.userRoles(), tc.want); diff != "" {
+				t.Errorf("unexpected acl (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroupReadWriter_GetGroup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := httptest.NewServer(&fakeCalendar{})
+	t.Cleanup(srv.Close)
+	cs, err := calendar.NewService(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("failed to create calendar service: %v", err)
+	}
+	rw := NewGroupReadWriter(cs)
+
+	group, err := rw.GetGroup(ctx, Encode(Writer, testCalendar))
+	if err != nil {
+		t.Fatalf("GetGroup failed: %v", err)
+	}
+	if got, want := group.ID, Encode(Writer, testCalendar); got != want {
+		t.Errorf("GetGroup got %q, want %q", got, want)
+	}
+	if _, err := rw.GetGroup(ctx, Encode(Reader, "missing@group.calendar.google.com")); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userRule(email, role string) *calendar.AclRule {
+	return &calendar.AclRule{Id: "user:" + email, Role: role, Scope: &calendar.AclRuleScope{Type: userScope, Value: email}}
+}
+
+// fakeCalendar implements the parts of the Calendar API used by
+// GroupReadWriter for the single calendar testCalendar. Rules are keyed by
+// ID.
+type fakeCalendar struct {
+	mu    sync.Mutex
+	rules map[string]*calendar.AclRule
+}
+
+func (f *fakeCalendar) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	base := "/calendars/" + testCalendar
+	switch {
+	case r.URL.Path == base && r.Method == http.MethodGet:
+		write(&calendar.Calendar{Id: testCalendar, Summary: "Team"})
+	case r.URL.Path == base+"/acl" && r.Method == http.MethodGet:
+		acl := &calendar.Acl{}
+		for _, id := range slices.Sorted(maps.Keys(f.rules)) {
+			acl.Items = append(acl.Items, f.rules[id])
+		}
+		write(acl)
+	case r.URL.Path == base+"/acl" && r.Method == http.MethodPost:
+		if r.FormValue("sendNotifications") != "false" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var rule calendar.AclRule
+		if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// like Calendar, granting a user replaces its rule.
+		rule.Id = "user:" + rule.Scope.Value
+		f.rules[rule.Id] = &rule
+		write(&rule)
+	case strings.HasPrefix(r.URL.Path, base+"/acl/") && r.Method == http.MethodDelete:
+		delete(f.rules, strings.TrimPrefix(r.URL.Path, base+"/acl/"))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": {"code": 404, "message": "not found"}}`)
+	}
+}
+
+// userRoles returns the sorted rules granting users access as email:role.
+func (f *fakeCalendar) userRoles() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var roles []string
+	for _, r := range f.rules {
+		if r.Scope.Type == userScope {
+			roles = append(roles, r.Scope.Value+":"+r.Role)
+		}
+	}
+	slices.Sort(roles)
+	return roles
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package googlechat provides a GroupReadWriter for the members of Google
+// Chat spaces, so that project spaces include the members of the teams
+// working on them.
+package googlechat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	admin "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/chat/v1"
+	"google.golang.org/api/googleapi"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of memberships requested per page, the maximum
+	// of the API.
+	pageSize = 1000
+
+	// humanFilter restricts listed memberships to users, leaving out apps.
+	// It is required with admin access.
+	humanFilter = `member.type = "HUMAN"`
+
+	// roleMember is the role of space members which are not space managers.
+	roleMember = "ROLE_MEMBER"
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+type Config struct {
+	cacheDuration  time.Duration
+	useAdminAccess bool
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithAdminAccess manages the spaces as a Google Workspace administrator with
+// the Chat and spaces privilege, so that the caller need not be a member of
+// the spaces.
+func WithAdminAccess(useAdminAccess bool) Opt {
+	return func(config *Config) {
+		config.useAdminAccess = useAdminAccess
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Google Chat spaces. Group IDs are the resource
+// names of spaces, e.g. spaces/AAAAxyz, and user IDs are the primary emails
+// of Google Workspace users. Chat only knows users by their numeric ID, which
+// is resolved to their email with the Directory API. Space managers are never
+// removed, so that spaces keep their owners, and apps and Google Groups which
+// are members of a space are left alone.
+type GroupReadWriter struct {
+	chat           *chat.Service
+	directory      *admin.Service
+	useAdminAccess bool
+	userCache      *cache.Cache[*admin.User]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter using the given Chat and
+// Directory API services.
+func NewGroupReadWriter(chatService *chat.Service, directory *admin.Service, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &GroupReadWriter{
+		chat:           chatService,
+		directory:      directory,
+		useAdminAccess: config.useAdminAccess,
+		userCache:      cache.New[*admin.User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Google Chat space with the given resource name.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	space, err := rw.chat.Spaces.Get(groupID).UseAdminAccess(rw.useAdminAccess).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get space %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: space.Name, Attributes: space}, nil
+}
+
+// GetMembers retrieves the users which are members of the Google Chat space
+// with the given resource name, or are invited to it.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	memberships, err := rw.memberships(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]groupsync.Member, 0, len(memberships))
+	for _, m := range memberships {
+		user, err := rw.user(ctx, strings.TrimPrefix(m.Member.Name, "users/"))
+		if err != nil {
+			return nil, fmt.Errorf("could not get member %s of space %s: %w", m.Member.Name, groupID, err)
+		}
+		members = append(members, &groupsync.UserMember{Usr: &groupsync.User{
+			ID:         user.PrimaryEmail,
+			StableID:   user.Id,
+			Attributes: m,
+		}})
+	}
+	return members, nil
+}
+
+// Descendants retrieve all users of the Google Chat space with the given
+// resource name.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Google Workspace user with the given email.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.user(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.PrimaryEmail, StableID: user.Id, Attributes: user}, nil
+}
+
+// user returns the Google Workspace user with the given email or numeric ID.
+func (rw *GroupReadWriter) user(ctx context.Context, key string) (*admin.User, error) {
+	user, err := rw.userCache.WriteThruLookup(strings.ToLower(key), func() (*admin.User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", key)
+		user, err := rw.directory.Users.Get(key).Fields("id", "primaryEmail").Context(ctx).Do()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", key, classifyErr(err))
+		}
+		return user, nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// SetMembers replaces the users of the Google Chat space with the given
+// resource name with the given members. Users are added as space members;
+// space managers which are not in the given members are kept.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	current, err := rw.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(current)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	var merr error
+	for _, email := range utils.MapKeys(addMembers) {
+		membership := &chat.Membership{Member: &chat.User{Name: "users/" + email, Type: "HUMAN"}}
+		if _, err := rw.chat.Spaces.Members.Create(groupID, membership).UseAdminAccess(rw.useAdminAccess).Context(ctx).Do(); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add %s to space %s: %w", email, groupID, classifyErr(err)))
+		}
+	}
+	for _, email := range utils.MapKeys(removeMembers) {
+		membership, ok := chatMembership(removeMembers[email])
+		if !ok {
+			merr = errors.Join(merr, fmt.Errorf("cannot remove %s from space %s: unknown member", email, groupID))
+			continue
+		}
+		if membership.Role != roleMember {
+			logger.InfoContext(ctx, "keeping space manager",
+				"group_id", groupID,
+				"user_id", email,
+			)
+			continue
+		}
+		if _, err := rw.chat.Spaces.Members.Delete(membership.Name).UseAdminAccess(rw.useAdminAccess).Context(ctx).Do(); err != nil && !isNotFound(err) {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove %s from space %s: %w", email, groupID, classifyErr(err)))
+		}
+	}
+	return merr
+}
+
+// memberships returns the memberships of users in the space with the given
+// resource name, including invitations.
+func (rw *GroupReadWriter) memberships(ctx context.Context, groupID string) ([]*chat.Membership, error) {
+	var memberships []*chat.Membership
+	if err := rw.chat.Spaces.Members.List(groupID).
+		Filter(humanFilter).
+		PageSize(pageSize).
+		ShowInvited(true).
+		UseAdminAccess(rw.useAdminAccess).
+		Context(ctx).
+		Pages(ctx, func(page *chat.ListMembershipsResponse) error {
+			for _, m := range page.Memberships {
+				if m.Member != nil && m.Member.Type == "HUMAN" {
+					memberships = append(memberships, m)
+				}
+			}
+			return nil
+		}); err != nil {
+		return nil, fmt.Errorf("failed to list members of space %s: %w", groupID, notFound(err))
+	}
+	return memberships, nil
+}
+
+// chatMembership returns the membership of a member returned by GetMembers.
+func chatMembership(m groupsync.Member) (*chat.Membership, bool) {
+	user, err := m.User()
+	if err != nil {
+		return nil, false
+	}
+	membership, ok := user.Attributes.(*chat.Membership)
+	return membership, ok
+}
+
+func isNotFound(err error) bool {
+	var gerr *googleapi.Error
+	return errors.As(err, &gerr) && gerr.Code == http.StatusNotFound
+}
+
+// notFound wraps errors of missing spaces with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if isNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return classifyErr(err)
+}
+
+// classifyErr marks rate limit responses with groupsync.ErrRateLimited and
+// server errors with groupsync.ErrTransient. Other errors, including nil,
+// are returned as is.
+func classifyErr(err error) error {
+	var gerr *googleapi.Error
+	switch {
+	case !errors.As(err, &gerr):
+		return err
+	case gerr.Code == http.StatusTooManyRequests:
+		return fmt.Errorf("%w: %w", groupsync.ErrRateLimited, err)
+	case gerr.Code >= http.StatusInternalServerError:
+		return fmt.Errorf("%w: %w", groupsync.ErrTransient, err)
+	}
+	return err
+}
+
+// toIDMap returns the given members by lowercased ID, as emails are
+// case-insensitive.
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[strings.ToLower(m.ID())] = m
+	}
+	return memberIDs
+}
@@ -0,0 +1,219 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlechat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	admin "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/chat/v1"
+	"google.golang.org/api/option"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter_SetMembers(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		members []string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:    "add_and_remove",
+			members: []string{"Bob@example.com", "carol@example.com"},
+			want: []string{
+				"bob@example.com:ROLE_MEMBER",
+				"carol@example.com:ROLE_MEMBER",
+				"manager@example.com:ROLE_MANAGER",
+			},
+		},
+		{
+			name: "keeps_managers",
+			want: []string{"manager@example.com:ROLE_MANAGER"},
+		},
+		{
+			name:    "unknown_user",
+			members: []string{"alice@example.com", "dave@example.com"},
+			want: []string{
+				"alice@example.com:ROLE_MEMBER",
+				"manager@example.com:ROLE_MANAGER",
+			},
+			wantErr: "failed to add dave@example.com to space spaces/s1",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			fake := &fakeChat{
+				users: map[string]string{
+					"1": "alice@example.com",
+					"2": "bob@example.com",
+					"3": "carol@example.com",
+					"9": "manager@example.com",
+				},
+				members: map[string]string{"1": "ROLE_MEMBER", "2": "ROLE_MEMBER", "9": "ROLE_MANAGER"},
+			}
+			srv := httptest.NewServer(fake)
+			t.Cleanup(srv.Close)
+			chatService, err := chat.NewService(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+			if err != nil {
+				t.Fatalf("failed to create chat service: %v", err)
+			}
+			directory, err := admin.NewService(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+			if err != nil {
+				t.Fatalf("failed to create admin service: %v", err)
+			}
+			rw := NewGroupReadWriter(chatService, directory)
+
+			members := make([]groupsync.Member, 0, len(tc.members))
+			for _, email := range tc.members {
+				members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: email}})
+			}
+			err = rw.SetMembers(ctx, "spaces/s1", members)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(fake.roles(), tc.want); diff != "" {
+				t.Errorf("unexpected members (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroupReadWriter_GetGroup(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := httptest.NewServer(&fakeChat{})
+	t.Cleanup(srv.Close)
+	chatService, err := chat.NewService(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("failed to create chat service: %v", err)
+	}
+	rw := NewGroupReadWriter(chatService, nil)
+
+	group, err := rw.GetGroup(ctx, "spaces/s1")
+	if err != nil {
+		t.Fatalf("GetGroup failed: %v", err)
+	}
+	if got, want := group.ID, "spaces/s1"; got != want {
+		t.Errorf("GetGroup got %q, want %q", got, want)
+	}
+	if _, err := rw.GetGroup(ctx, "spaces/missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+// fakeChat implements the parts of the Chat and Directory APIs used by
+// GroupReadWriter for the single space spaces/s1. Users are keyed by ID and
+// members map user IDs to their roles.
+type fakeChat struct {
+	mu      sync.Mutex
+	users   map[string]string
+	members map[string]string
+}
+
+func (f *fakeChat) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	notFound := func() {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error": {"code": 404, "message": "not found"}}`)
+	}
+
+	path := r.URL.Path
+	switch {
+	case strings.HasPrefix(path, "/admin/directory/v1/users/"):
+		key := strings.TrimPrefix(path, "/admin/directory/v1/users/")
+		for id, email := range f.users {
+			if key == id || strings.EqualFold(key, email) {
+				write(&admin.User{Id: id, PrimaryEmail: email})
+				return
+			}
+		}
+		notFound()
+	case path == "/v1/spaces/s1" && r.Method == http.MethodGet:
+		write(&chat.Space{Name: "spaces/s1", DisplayName: "Project"})
+	case path == "/v1/spaces/s1/members" && r.Method == http.MethodGet:
+		if r.FormValue("filter") != humanFilter {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		resp := &chat.ListMembershipsResponse{Memberships: []*chat.Membership{
+			{Name: "spaces/s1/members/app", Member: &chat.User{Name: "users/app", Type: "BOT"}},
+		}}
+		for id, role := range f.members {
+			resp.Memberships = append(resp.Memberships, &chat.Membership{
+				Name:   "spaces/s1/members/" + id,
+				Member: &chat.User{Name: "users/" + id, Type: "HUMAN"},
+				Role:   role,
+			})
+		}
+		write(resp)
+	case path == "/v1/spaces/s1/members" && r.Method == http.MethodPost:
+		var m chat.Membership
+		if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		email := strings.TrimPrefix(m.Member.Name, "users/")
+		for id, e := range f.users {
+			if strings.EqualFold(e, email) {
+				f.members[id] = "ROLE_MEMBER"
+				write(&m)
+				return
+			}
+		}
+		notFound()
+	case strings.HasPrefix(path, "/v1/spaces/s1/members/") && r.Method == http.MethodDelete:
+		delete(f.members, strings.TrimPrefix(path, "/v1/spaces/s1/members/"))
+		write(&chat.Membership{})
+	default:
+		notFound()
+	}
+}
+
+// roles returns the sorted members of the space as email:role.
+func (f *fakeChat) roles() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	roles := make([]string, 0, len(f.members))
+	for id, role := range f.members {
+		roles = append(roles, f.users[id]+":"+role)
+	}
+	slices.Sort(roles)
+	return roles
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlegroups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	reports "google.golang.org/api/admin/reports/v1"
+	"google.golang.org/api/cloudidentity/v1"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// memberChangeEvents are the admin audit log events changing the members of
+// a group, see
+// https://developers.google.com/admin-sdk/reports/v1/appendix/activity/admin-group-settings.
+var memberChangeEvents = []string{"ADD_GROUP_MEMBER", "REMOVE_GROUP_MEMBER"}
+
+// Ensure we conform to the interface.
+var _ groupsync.ChangeLogReader = (*GroupReader)(nil)
+
+// ChangedGroupIDs returns the IDs, of the form groups/{group}, of the groups
+// whose members were added or removed since the given time according to the
+// admin audit log, along with the groups containing them. It requires a
+// reports service, see WithReportsService.
+func (g GroupReader) ChangedGroupIDs(ctx context.Context, since time.Time) ([]string, error) {
+	if g.reports == nil {
+		return nil, fmt.Errorf("reading the audit log requires a reports service")
+	}
+	var emails []string
+	for _, event := range memberChangeEvents {
+		if err := g.reports.Activities.List("all", "admin").
+			EventName(event).
+			StartTime(since.UTC().Format(time.RFC3339)).
+			Context(ctx).
+			Pages(ctx, func(page *reports.Activities) error {
+				for _, a := range page.Items {
+					for _, e := range a.Events {
+						for _, p := range e.Parameters {
+							if p.Name == "GROUP_EMAIL" && !slices.Contains(emails, p.Value) {
+								emails = append(emails, p.Value)
+							}
+						}
+					}
+				}
+				return nil
+			}); err != nil {
+			return nil, fmt.Errorf("failed to list %s events: %w", event, err)
+		}
+	}
+
+	logger := logging.FromContext(ctx)
+	var ids []string
+	for _, email := range emails {
+		id, err := g.LookupGroupID(ctx, email)
+		if errors.Is(err, groupsync.ErrGroupNotFound) {
+			logger.InfoContext(ctx, "skipping changed group that no longer exists", "group_email", email)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+		if err := g.identity.Groups.Memberships.SearchTransitiveGroups("groups/-").
+			Query(fmt.Sprintf("member_key_id == '%s' && 'cloudidentity.googleapis.com/groups.discussion_forum' in labels", email)).
+			Context(ctx).
+			Pages(ctx, func(page *cloudidentity.SearchTransitiveGroupsResponse) error {
+				for _, r := range page.Memberships {
+					ids = append(ids, r.Group)
+				}
+				return nil
+			}); err != nil {
+			return nil, fmt.Errorf("failed to search groups containing %s: %w", email, err)
+		}
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids), nil
+}
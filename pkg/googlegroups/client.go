@@ -19,7 +19,9 @@ import (
 	"fmt"
 
 	admin "google.golang.org/api/admin/directory/v1"
+	reports "google.golang.org/api/admin/reports/v1"
 	"google.golang.org/api/cloudidentity/v1"
+	"google.golang.org/api/groupssettings/v1"
 
 	"github.com/abcxyz/team-link/pkg/groupsync"
 )
@@ -41,5 +43,27 @@ func NewGroupReaderWithDefaultApplicationToken(ctx context.Context) (groupsync.G
 	if err != nil {
 		return nil, fmt.Errorf("failed to create admin service: %w", err)
 	}
-	return NewGroupReader(cs, as), nil
+	rs, err := reports.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reports service: %w", err)
+	}
+	ss, err := groupssettings.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create groups settings service: %w", err)
+	}
+	return NewGroupReader(cs, as, WithReportsService(rs), WithSettingsService(ss)), nil
+}
+
+// NewGroupReadWriterWithDefaultApplicationToken creates a read writer for
+// GoogleGroups, authenticating like NewGroupReaderWithDefaultApplicationToken.
+func NewGroupReadWriterWithDefaultApplicationToken(ctx context.Context, opts ...WriterOpt) (groupsync.GroupReadWriter, error) {
+	cs, err := cloudidentity.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cloudidentity service: %w", err)
+	}
+	as, err := admin.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admin service: %w", err)
+	}
+	return NewGroupReadWriter(cs, as, opts...), nil
 }
@@ -16,11 +16,16 @@ package googlegroups
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	admin "google.golang.org/api/admin/directory/v1"
+	reports "google.golang.org/api/admin/reports/v1"
 	"google.golang.org/api/cloudidentity/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/groupssettings/v1"
 
 	"github.com/abcxyz/pkg/logging"
 	"github.com/abcxyz/team-link/pkg/groupsync"
@@ -32,20 +37,40 @@ const (
 )
 
 // Ensure we conform to the interface.
-var _ groupsync.GroupReader = (*GroupReader)(nil)
+var (
+	_ groupsync.GroupReader         = (*GroupReader)(nil)
+	_ groupsync.SuspendedUserReader = (*GroupReader)(nil)
+)
 
 // GroupReader provides read operations for groups and users in GCP.
 type GroupReader struct {
 	identity *cloudidentity.Service
 	admin    *admin.Service
+	reports  *reports.Service
+	settings *groupssettings.Service
+}
+
+// Opt configures a GroupReader.
+type Opt func(g *GroupReader)
+
+// WithReportsService sets the service reading the admin audit log, which is
+// needed to list the groups that changed, see ChangedGroupIDs.
+func WithReportsService(reportsService *reports.Service) Opt {
+	return func(g *GroupReader) {
+		g.reports = reportsService
+	}
 }
 
 // NewGroupReader create a new GroupReader.
-func NewGroupReader(identityService *cloudidentity.Service, adminService *admin.Service) *GroupReader {
-	return &GroupReader{
+func NewGroupReader(identityService *cloudidentity.Service, adminService *admin.Service, opts ...Opt) *GroupReader {
+	g := &GroupReader{
 		identity: identityService,
 		admin:    adminService,
 	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
 }
 
 // Descendants retrieve all users (children, recursively) of a group.
@@ -71,7 +96,7 @@ func (g GroupReader) Descendants(ctx context.Context, groupID string) ([]*groups
 		},
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch descendants: %w", err)
+		return nil, fmt.Errorf("failed to fetch descendants: %w", notFound(err))
 	}
 	return members, nil
 }
@@ -88,6 +113,17 @@ func (g GroupReader) GetGroup(ctx context.Context, groupID string) (*groupsync.G
 	}, nil
 }
 
+// LookupGroupID returns the ID, of the form groups/{group}, of the group with
+// the given email address. It returns groupsync.ErrGroupNotFound if there is
+// no such group.
+func (g GroupReader) LookupGroupID(ctx context.Context, email string) (string, error) {
+	resp, err := g.identity.Groups.Lookup().GroupKeyId(email).Context(ctx).Do()
+	if err != nil {
+		return "", fmt.Errorf("could not lookup group %s: %w", email, notFound(err))
+	}
+	return resp.Name, nil
+}
+
 // GetMembers retrieves the direct members (children) of the group with given ID.
 // This includes both users and subgroups.
 func (g GroupReader) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
@@ -111,11 +147,25 @@ func (g GroupReader) GetMembers(ctx context.Context, groupID string) ([]groupsyn
 			return nil
 		},
 	); err != nil {
-		return nil, fmt.Errorf("could not get group members: %w", err)
+		return nil, fmt.Errorf("could not get group members: %w", notFound(err))
 	}
 	return members, nil
 }
 
+// IsSuspended reports whether the Google Workspace user with the given email
+// or ID is suspended. Users outside of the Workspace domain are not.
+func (g GroupReader) IsSuspended(ctx context.Context, userID string) (bool, error) {
+	user, err := g.admin.Users.Get(userID).Fields("suspended").Context(ctx).Do()
+	if err != nil {
+		var gerr *googleapi.Error
+		if errors.As(err, &gerr) && gerr.Code == http.StatusNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("could not get user: %w", err)
+	}
+	return user.Suspended, nil
+}
+
 // GetUser retrieves the User with the given ID. Should be of the form: users/{userid}.
 func (g GroupReader) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
 	user, err := g.admin.Users.Get(userID).Context(ctx).Do()
@@ -124,3 +174,12 @@ func (g GroupReader) GetUser(ctx context.Context, userID string) (*groupsync.Use
 	}
 	return &groupsync.User{ID: user.Id, Attributes: user}, nil
 }
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) && gerr.Code == http.StatusNotFound {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlegroups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	admin "google.golang.org/api/admin/directory/v1"
+	"google.golang.org/api/cloudidentity/v1"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+// Google Groups membership roles. Every membership has the MEMBER role, and
+// managers and owners have the MANAGER or OWNER role as well.
+const (
+	RoleMember  = "MEMBER"
+	RoleManager = "MANAGER"
+	RoleOwner   = "OWNER"
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// WriterOpt configures a GroupReadWriter.
+type WriterOpt func(rw *GroupReadWriter)
+
+// WithManageOwners lets the GroupReadWriter remove and demote group owners.
+// By default, owners are kept whatever the synced members.
+func WithManageOwners(manage bool) WriterOpt {
+	return func(rw *GroupReadWriter) {
+		rw.manageOwners = manage
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the memberships of Google groups. Member IDs are emails. The
+// role of a member, "member", "manager" or "owner", is set when it is added
+// and updated when it changes; members without a role keep the one they have.
+type GroupReadWriter struct {
+	GroupReader
+
+	manageOwners bool
+}
+
+// NewGroupReadWriter creates a GroupReadWriter.
+func NewGroupReadWriter(identityService *cloudidentity.Service, adminService *admin.Service, opts ...WriterOpt) *GroupReadWriter {
+	rw := &GroupReadWriter{
+		GroupReader: *NewGroupReader(identityService, adminService),
+	}
+	for _, opt := range opts {
+		opt(rw)
+	}
+	return rw
+}
+
+// SetMembers replaces the members of the Google group with the given ID, of
+// the form groups/{group}, with the given members. Owners of the group are
+// neither removed nor demoted unless the GroupReadWriter manages owners, see
+// WithManageOwners.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	current := make(map[string]*cloudidentity.Membership)
+	if err := rw.identity.Groups.Memberships.List(groupID).Context(ctx).View("FULL").Pages(ctx,
+		func(page *cloudidentity.ListMembershipsResponse) error {
+			for _, m := range page.Memberships {
+				current[m.PreferredMemberKey.Id] = m
+			}
+			return nil
+		},
+	); err != nil {
+		return fmt.Errorf("could not get group memberships: %w", err)
+	}
+
+	// members are processed in the order of their IDs, and duplicates are
+	// added once.
+	desired := make(map[string]groupsync.Member, len(members))
+	for _, member := range members {
+		if _, ok := desired[member.ID()]; !ok {
+			desired[member.ID()] = member
+		}
+	}
+
+	logger := logging.FromContext(ctx)
+	var merr error
+	for _, id := range utils.MapKeys(desired) {
+		member := desired[id]
+		role, err := membershipRole(groupsync.MemberRole(member))
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("invalid role of member %s: %w", member.ID(), err))
+			continue
+		}
+		m, ok := current[member.ID()]
+		if !ok {
+			logger.InfoContext(ctx, "adding member", "group_id", groupID, "member_id", member.ID(), "role", role)
+			if _, err := rw.identity.Groups.Memberships.Create(groupID, &cloudidentity.Membership{
+				PreferredMemberKey: &cloudidentity.EntityKey{Id: member.ID()},
+				Roles:              membershipRoles(role),
+			}).Context(ctx).Do(); err != nil {
+				merr = errors.Join(merr, fmt.Errorf("failed to add member %s to group %s: %w", member.ID(), groupID, err))
+			}
+			continue
+		}
+		currentRole := highestRole(m)
+		if groupsync.MemberRole(member) == "" || currentRole == role {
+			continue
+		}
+		if currentRole == RoleOwner && !rw.manageOwners {
+			logger.InfoContext(ctx, "keeping owner role of member", "group_id", groupID, "member_id", member.ID(), "role", role)
+			continue
+		}
+		logger.InfoContext(ctx, "updating role of member", "group_id", groupID, "member_id", member.ID(),
+			"current_role", currentRole, "role", role)
+		req := &cloudidentity.ModifyMembershipRolesRequest{}
+		if role != RoleMember {
+			req.AddRoles = []*cloudidentity.MembershipRole{{Name: role}}
+		}
+		if currentRole != RoleMember {
+			req.RemoveRoles = []string{currentRole}
+		}
+		if _, err := rw.identity.Groups.Memberships.ModifyMembershipRoles(m.Name, req).Context(ctx).Do(); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to update role of member %s in group %s: %w", member.ID(), groupID, err))
+		}
+	}
+
+	for _, id := range utils.MapKeys(current) {
+		if _, ok := desired[id]; ok {
+			continue
+		}
+		m := current[id]
+		if highestRole(m) == RoleOwner && !rw.manageOwners {
+			logger.InfoContext(ctx, "keeping owner", "group_id", groupID, "member_id", id)
+			continue
+		}
+		logger.InfoContext(ctx, "removing member", "group_id", groupID, "member_id", id)
+		if _, err := rw.identity.Groups.Memberships.Delete(m.Name).Context(ctx).Do(); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove member %s from group %s: %w", id, groupID, err))
+		}
+	}
+	return merr
+}
+
+// membershipRole returns the Google Groups role of a member role, which is
+// RoleMember if it is empty.
+func membershipRole(role string) (string, error) {
+	if role == "" {
+		return RoleMember, nil
+	}
+	r := strings.ToUpper(role)
+	if !slices.Contains([]string{RoleMember, RoleManager, RoleOwner}, r) {
+		return "", fmt.Errorf("unknown role %q, must be member, manager or owner", role)
+	}
+	return r, nil
+}
+
+// membershipRoles returns the roles of a new membership with the given role.
+func membershipRoles(role string) []*cloudidentity.MembershipRole {
+	roles := []*cloudidentity.MembershipRole{{Name: RoleMember}}
+	if role != RoleMember {
+		roles = append(roles, &cloudidentity.MembershipRole{Name: role})
+	}
+	return roles
+}
+
+// highestRole returns the highest role of a membership.
+func highestRole(m *cloudidentity.Membership) string {
+	role := RoleMember
+	for _, r := range m.Roles {
+		switch {
+		case r.Name == RoleOwner:
+			return RoleOwner
+		case r.Name == RoleManager:
+			role = RoleManager
+		}
+	}
+	return role
+}
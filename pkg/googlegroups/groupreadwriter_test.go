@@ -0,0 +1,244 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlegroups
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/cloudidentity/v1"
+	"google.golang.org/api/option"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter_SetMembers(t *testing.T) {
+	t.Parallel()
+
+	user := func(id, role string) groupsync.Member {
+		return &groupsync.UserMember{Usr: &groupsync.User{ID: id}, Role: role}
+	}
+
+	cases := []struct {
+		name         string
+		manageOwners bool
+		members      []groupsync.Member
+		want         []string
+		wantErr      string
+	}{
+		{
+			name: "keeps_owners",
+			members: []groupsync.Member{
+				user("alice@example.com", ""),
+				user("carol@example.com", "manager"),
+				user("dave@example.com", "owner"),
+				&groupsync.GroupMember{Grp: &groupsync.Group{ID: "eng@example.com"}},
+			},
+			want: []string{
+				"alice@example.com:MEMBER",
+				"carol@example.com:MANAGER",
+				"dave@example.com:OWNER",
+				"eng@example.com:MEMBER",
+				"owner@example.com:OWNER",
+			},
+		},
+		{
+			name: "keeps_owner_role",
+			members: []groupsync.Member{
+				user("alice@example.com", "member"),
+				user("bob@example.com", "member"),
+				user("owner@example.com", "member"),
+			},
+			want: []string{
+				"alice@example.com:MEMBER",
+				"bob@example.com:MEMBER",
+				"owner@example.com:OWNER",
+			},
+		},
+		{
+			name:         "manages_owners",
+			manageOwners: true,
+			members: []groupsync.Member{
+				user("alice@example.com", "owner"),
+				user("bob@example.com", ""),
+			},
+			want: []string{
+				"alice@example.com:OWNER",
+				"bob@example.com:MANAGER",
+			},
+		},
+		{
+			name:         "demotes_owner",
+			manageOwners: true,
+			members:      []groupsync.Member{user("owner@example.com", "member")},
+			want:         []string{"owner@example.com:MEMBER"},
+		},
+		{
+			name: "unknown_role",
+			members: []groupsync.Member{
+				user("alice@example.com", ""),
+				user("bob@example.com", "manager"),
+				user("carol@example.com", "admin"),
+			},
+			want: []string{
+				"alice@example.com:MEMBER",
+				"bob@example.com:MANAGER",
+				"owner@example.com:OWNER",
+			},
+			wantErr: `unknown role "admin"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			fake := &fakeCloudIdentity{memberships: map[string][]string{
+				"alice@example.com": {RoleMember},
+				"bob@example.com":   {RoleMember, RoleManager},
+				"owner@example.com": {RoleMember, RoleOwner},
+			}}
+			srv := httptest.NewServer(fake)
+			t.Cleanup(srv.Close)
+			identity, err := cloudidentity.NewService(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+			if err != nil {
+				t.Fatalf("failed to create cloudidentity service: %v", err)
+			}
+			rw := NewGroupReadWriter(identity, nil, WithManageOwners(tc.manageOwners))
+
+			err = rw.SetMembers(ctx, "groups/g1", tc.members)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(fake.roles(), tc.want); diff != "" {
+				t.Errorf("unexpected memberships (-got, +want):\n%s", diff)
+			}
+
+			// rerunning with the members reversed and duplicated writes nothing.
+			again := slices.Clone(tc.members)
+			slices.Reverse(again)
+			again = append(again, again...)
+			writes := fake.writeCount()
+			_ = rw.SetMembers(ctx, "groups/g1", again)
+			if got := fake.writeCount() - writes; got != 0 {
+				t.Errorf("rerun of SetMembers issued %d writes, want 0", got)
+			}
+		})
+	}
+}
+
+// fakeCloudIdentity implements the membership methods of the Cloud Identity
+// API used by GroupReadWriter for the group groups/g1. Memberships are named
+// after the member email.
+type fakeCloudIdentity struct {
+	mu          sync.Mutex
+	memberships map[string][]string
+	writes      int
+}
+
+// writeCount returns the number of write requests served.
+func (f *fakeCloudIdentity) writeCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.writes
+}
+
+// roles returns the members and their highest role, sorted by member.
+func (f *fakeCloudIdentity) roles() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var got []string
+	for id, roles := range f.memberships {
+		got = append(got, fmt.Sprintf("%s:%s", id, highestRole(membership(id, roles))))
+	}
+	slices.Sort(got)
+	return got
+}
+
+func membership(id string, roles []string) *cloudidentity.Membership {
+	m := &cloudidentity.Membership{
+		Name:               "groups/g1/memberships/" + id,
+		PreferredMemberKey: &cloudidentity.EntityKey{Id: id},
+		Type:               MemberTypeUser,
+	}
+	for _, r := range roles {
+		m.Roles = append(m.Roles, &cloudidentity.MembershipRole{Name: r})
+	}
+	return m
+}
+
+func (f *fakeCloudIdentity) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	if r.Method != http.MethodGet {
+		f.writes++
+	}
+	name, method, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/v1/groups/g1/memberships"), ":")
+	id := strings.TrimPrefix(name, "/")
+	switch {
+	case r.Method == http.MethodGet && id == "":
+		resp := &cloudidentity.ListMembershipsResponse{}
+		for id, roles := range f.memberships {
+			resp.Memberships = append(resp.Memberships, membership(id, roles))
+		}
+		write(resp)
+	case r.Method == http.MethodPost && id == "":
+		var m cloudidentity.Membership
+		if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var roles []string
+		for _, role := range m.Roles {
+			roles = append(roles, role.Name)
+		}
+		f.memberships[m.PreferredMemberKey.Id] = roles
+		write(&cloudidentity.Operation{Done: true})
+	case r.Method == http.MethodDelete && f.memberships[id] != nil:
+		delete(f.memberships, id)
+		write(&cloudidentity.Operation{Done: true})
+	case r.Method == http.MethodPost && method == "modifyMembershipRoles" && f.memberships[id] != nil:
+		var req cloudidentity.ModifyMembershipRolesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		roles := slices.DeleteFunc(f.memberships[id], func(role string) bool {
+			return slices.Contains(req.RemoveRoles, role)
+		})
+		for _, role := range req.AddRoles {
+			roles = append(roles, role.Name)
+		}
+		f.memberships[id] = roles
+		write(&cloudidentity.ModifyMembershipRolesResponse{Membership: membership(id, roles)})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
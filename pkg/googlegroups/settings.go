@@ -0,0 +1,88 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlegroups
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/api/groupssettings/v1"
+)
+
+// Values of the whoCanJoin setting of groups.
+const (
+	WhoCanJoinAnyone  = "ANYONE_CAN_JOIN"
+	WhoCanJoinDomain  = "ALL_IN_DOMAIN_CAN_JOIN"
+	WhoCanJoinInvited = "INVITED_CAN_JOIN"
+	WhoCanJoinRequest = "CAN_REQUEST_TO_JOIN"
+)
+
+// DefaultAllowedWhoCanJoin are the whoCanJoin settings of groups which nobody
+// can join by themselves.
+var DefaultAllowedWhoCanJoin = []string{WhoCanJoinInvited, WhoCanJoinRequest}
+
+// WithSettingsService sets the service reading group settings, which is
+// needed by GetSettings.
+func WithSettingsService(settingsService *groupssettings.Service) Opt {
+	return func(g *GroupReader) {
+		g.settings = settingsService
+	}
+}
+
+// GetSettings retrieves the settings of the group with the given ID. The ID
+// must be of the form: groups/{group}.
+func (g GroupReader) GetSettings(ctx context.Context, groupID string) (*groupssettings.Groups, error) {
+	if g.settings == nil {
+		return nil, fmt.Errorf("group settings service is not configured")
+	}
+	group, err := g.identity.Groups.Get(groupID).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("could not get group %s: %w", groupID, notFound(err))
+	}
+	// the settings API identifies groups by email.
+	settings, err := g.settings.Groups.Get(group.GroupKey.Id).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("could not get settings of group %s: %w", groupID, notFound(err))
+	}
+	return settings, nil
+}
+
+// SettingsPolicy restricts the settings of groups.
+type SettingsPolicy struct {
+	// AllowedWhoCanJoin are the allowed values of the whoCanJoin setting,
+	// DefaultAllowedWhoCanJoin if empty.
+	AllowedWhoCanJoin []string
+	// AllowExternalMembers is whether groups may have members external to the
+	// domain.
+	AllowExternalMembers bool
+}
+
+// Violations describes how the given settings violate the policy, if they
+// do.
+func (p *SettingsPolicy) Violations(settings *groupssettings.Groups) []string {
+	allowed := p.AllowedWhoCanJoin
+	if len(allowed) == 0 {
+		allowed = DefaultAllowedWhoCanJoin
+	}
+	var violations []string
+	if !slices.Contains(allowed, settings.WhoCanJoin) {
+		violations = append(violations, fmt.Sprintf("whoCanJoin is %s, must be one of %v", settings.WhoCanJoin, allowed))
+	}
+	if !p.AllowExternalMembers && settings.AllowExternalMembers == "true" {
+		violations = append(violations, "allowExternalMembers is true")
+	}
+	return violations
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package googlegroups
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/cloudidentity/v1"
+	"google.golang.org/api/groupssettings/v1"
+	"google.golang.org/api/option"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestGroupReader_GetSettings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/groups/g1":
+			json.NewEncoder(w).Encode(&cloudidentity.Group{ //nolint:errcheck // test server
+				Name:     "groups/g1",
+				GroupKey: &cloudidentity.EntityKey{Id: "eng@example.com"},
+			})
+		case r.URL.Path == "/settings/eng@example.com":
+			json.NewEncoder(w).Encode(&groupssettings.Groups{ //nolint:errcheck // test server
+				Email:                "eng@example.com",
+				WhoCanJoin:           WhoCanJoinAnyone,
+				AllowExternalMembers: "false",
+			})
+		case strings.HasPrefix(r.URL.Path, "/v1/groups/"):
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"code": 404, "message": "group not found"}}`)) //nolint:errcheck // test server
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	identity, err := cloudidentity.NewService(ctx, option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("failed to create cloudidentity service: %v", err)
+	}
+	settings, err := groupssettings.NewService(ctx, option.WithEndpoint(srv.URL+"/settings/"), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatalf("failed to create groupssettings service: %v", err)
+	}
+
+	cases := []struct {
+		name    string
+		groupID string
+		opts    []Opt
+		want    *groupssettings.Groups
+		wantErr string
+	}{
+		{
+			name:    "success",
+			groupID: "groups/g1",
+			opts:    []Opt{WithSettingsService(settings)},
+			want: &groupssettings.Groups{
+				Email:                "eng@example.com",
+				WhoCanJoin:           WhoCanJoinAnyone,
+				AllowExternalMembers: "false",
+			},
+		},
+		{
+			name:    "unknown_group",
+			groupID: "groups/g2",
+			opts:    []Opt{WithSettingsService(settings)},
+			wantErr: "could not get group groups/g2",
+		},
+		{
+			name:    "no_settings_service",
+			groupID: "groups/g1",
+			wantErr: "group settings service is not configured",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewGroupReader(identity, nil, tc.opts...).GetSettings(ctx, tc.groupID)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.want, cmpopts.IgnoreFields(groupssettings.Groups{}, "ServerResponse")); diff != "" {
+				t.Errorf("unexpected settings (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSettingsPolicy_Violations(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		policy   *SettingsPolicy
+		settings *groupssettings.Groups
+		want     []string
+	}{
+		{
+			name:     "invited",
+			policy:   &SettingsPolicy{},
+			settings: &groupssettings.Groups{WhoCanJoin: WhoCanJoinInvited, AllowExternalMembers: "false"},
+		},
+		{
+			name:     "anyone",
+			policy:   &SettingsPolicy{},
+			settings: &groupssettings.Groups{WhoCanJoin: WhoCanJoinAnyone, AllowExternalMembers: "false"},
+			want:     []string{"whoCanJoin is ANYONE_CAN_JOIN, must be one of [INVITED_CAN_JOIN CAN_REQUEST_TO_JOIN]"},
+		},
+		{
+			name:     "external_members",
+			policy:   &SettingsPolicy{AllowedWhoCanJoin: []string{WhoCanJoinInvited, WhoCanJoinDomain}},
+			settings: &groupssettings.Groups{WhoCanJoin: WhoCanJoinDomain, AllowExternalMembers: "true"},
+			want:     []string{"allowExternalMembers is true"},
+		},
+		{
+			name:     "external_members_allowed",
+			policy:   &SettingsPolicy{AllowExternalMembers: true},
+			settings: &groupssettings.Groups{WhoCanJoin: WhoCanJoinRequest, AllowExternalMembers: "true"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(tc.policy.Violations(tc.settings), tc.want); diff != "" {
+				t.Errorf("unexpected violations (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
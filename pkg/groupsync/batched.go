@@ -0,0 +1,249 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const checkpointKeyPrefix = "checkpoint"
+
+// MemberPatcher is a GroupWriter which can add and remove some members of a
+// group without replacing all of them. BatchedWriter applies each batch with
+// it, instead of listing the members of the group again for every batch.
+type MemberPatcher interface {
+	// AddMembers adds the given members to the group with the given ID.
+	// Members which are already in the group are given their role.
+	AddMembers(ctx context.Context, groupID string, members []Member) error
+
+	// RemoveMembers removes the given members from the group with the given
+	// ID.
+	RemoveMembers(ctx context.Context, groupID string, members []Member) error
+}
+
+// WriteCheckpoint records the progress of a batched write to a target group.
+// It is kept in the state store until the write completes, so that a write
+// interrupted by a crash can be told apart from one that never started.
+type WriteCheckpoint struct {
+	GroupID   string    `json:"group_id"`
+	ToAdd     int       `json:"to_add"`
+	ToRemove  int       `json:"to_remove"`
+	ToUpdate  int       `json:"to_update,omitempty"`
+	Added     int       `json:"added"`
+	Removed   int       `json:"removed"`
+	Updated   int       `json:"updated,omitempty"`
+	StartedAt time.Time `json:"started_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WriteCheckpoints returns the checkpoints of the batched writes which did
+// not complete, e.g. because the process crashed.
+func WriteCheckpoints(ctx context.Context, store state.Store) ([]*WriteCheckpoint, error) {
+	keys, err := store.List(ctx, checkpointKeyPrefix+"/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list write checkpoints: %w", err)
+	}
+	checkpoints := make([]*WriteCheckpoint, 0, len(keys))
+	for _, key := range keys {
+		var checkpoint WriteCheckpoint
+		if err := state.GetJSON(ctx, store, key, &checkpoint); err != nil {
+			if errors.Is(err, state.ErrNotFound) {
+				// completed since listing
+				continue
+			}
+			return nil, fmt.Errorf("failed to read write checkpoint: %w", err)
+		}
+		checkpoints = append(checkpoints, &checkpoint)
+	}
+	return checkpoints, nil
+}
+
+// BatchedWriter wraps a GroupReadWriter and applies the changes to large
+// groups in batches of bounded size, e.g. for org memberships with tens of
+// thousands of members. Additions are applied first, then role changes, then
+// removals. The progress
+// of each write is checkpointed in a state store, if any, so that a write
+// interrupted part way is reported by the next run, which resumes it: the
+// changes are computed from the current members, so the batches already
+// applied are not applied again. Reads are passed through.
+type BatchedWriter struct {
+	WrappedWriter
+
+	batchSize int
+	store     state.Store
+	now       func() time.Time
+}
+
+// NewBatchedWriter creates a BatchedWriter wrapping rw which applies at most
+// batchSize changes at a time, at least one, and checkpoints its progress in
+// store, unless it is nil.
+func NewBatchedWriter(rw GroupReadWriter, batchSize int, store state.Store) *BatchedWriter {
+	return &BatchedWriter{
+		WrappedWriter: WrappedWriter{GroupReadWriter: rw},
+		batchSize:     max(batchSize, 1),
+		store:         store,
+		now:           time.Now,
+	}
+}
+
+// SetMembers replaces the members of the group with the given ID with the
+// given members, in batches if there are more changes than the batch size.
+// If the wrapped writer is not a MemberPatcher, each batch sets the members
+// of the group to its current members with the batch applied, and the last
+// batch sets the given members.
+func (w *BatchedWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	current, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	diff := ComputeDiff(current, members)
+	add, remove := diff.Add, diff.Remove
+	update := make([]Member, 0, len(diff.Update))
+	for _, m := range diff.Update {
+		update = append(update, m)
+	}
+	key := state.Key(checkpointKeyPrefix, groupID)
+	logger := logging.FromContext(ctx)
+	if w.store != nil {
+		var previous WriteCheckpoint
+		err := state.GetJSON(ctx, w.store, key, &previous)
+		switch {
+		case err == nil:
+			logger.WarnContext(ctx, "resuming interrupted batched write",
+				"group_id", groupID,
+				"started_at", previous.StartedAt,
+				"added", previous.Added,
+				"removed", previous.Removed,
+				"to_add", previous.ToAdd,
+				"to_remove", previous.ToRemove,
+				"to_update", previous.ToUpdate,
+			)
+		case !errors.Is(err, state.ErrNotFound):
+			return fmt.Errorf("failed to read write checkpoint: %w", err)
+		}
+	}
+	if len(add)+len(update)+len(remove) <= w.batchSize {
+		if err := w.GroupReadWriter.SetMembers(ctx, groupID, members); err != nil {
+			return err //nolint:wrapcheck // Want passthrough
+		}
+		return w.clearCheckpoint(ctx, key)
+	}
+
+	checkpoint := &WriteCheckpoint{
+		GroupID:   groupID,
+		ToAdd:     len(add),
+		ToRemove:  len(remove),
+		ToUpdate:  len(update),
+		StartedAt: w.now(),
+	}
+	patcher, _ := w.GroupReadWriter.(MemberPatcher)
+	// pending are the members of the group once the batches so far are applied.
+	var pending map[string]Member
+	if patcher == nil {
+		pending = make(map[string]Member, len(current))
+		for _, m := range current {
+			pending[m.ID()] = m
+		}
+	}
+	// role changes are applied by adding the members with their new role.
+	upsert := slices.Concat(add, update)
+	changes := slices.Concat(upsert, remove)
+	for start := 0; start < len(changes); start += w.batchSize {
+		end := min(start+w.batchSize, len(changes))
+		var batchAdd, batchRemove []Member
+		if start < len(upsert) {
+			batchAdd = upsert[start:min(end, len(upsert))]
+		}
+		if end > len(upsert) {
+			batchRemove = remove[max(start-len(upsert), 0) : end-len(upsert)]
+		}
+		added := max(min(end, len(add))-start, 0)
+
+		if err := w.applyBatch(ctx, groupID, patcher, pending, batchAdd, batchRemove, members, end == len(changes)); err != nil {
+			return fmt.Errorf("failed to write batch after adding %d of %d and removing %d of %d members: %w",
+				checkpoint.Added, checkpoint.ToAdd, checkpoint.Removed, checkpoint.ToRemove, err)
+		}
+		checkpoint.Added += added
+		checkpoint.Updated += len(batchAdd) - added
+		checkpoint.Removed += len(batchRemove)
+		checkpoint.UpdatedAt = w.now()
+		logger.InfoContext(ctx, "wrote batch of member changes",
+			"group_id", groupID,
+			"added", checkpoint.Added,
+			"removed", checkpoint.Removed,
+			"updated", checkpoint.Updated,
+			"to_add", checkpoint.ToAdd,
+			"to_remove", checkpoint.ToRemove,
+			"to_update", checkpoint.ToUpdate,
+		)
+		if end < len(changes) && w.store != nil {
+			if err := state.PutJSON(ctx, w.store, key, checkpoint); err != nil {
+				return fmt.Errorf("failed to write checkpoint: %w", err)
+			}
+		}
+	}
+	return w.clearCheckpoint(ctx, key)
+}
+
+// applyBatch adds, or updates the role of, and removes the given members of
+// the group. Without a patcher, it sets the pending members with the batch
+// applied, or the given final members for the last batch.
+func (w *BatchedWriter) applyBatch(ctx context.Context, groupID string, patcher MemberPatcher, pending map[string]Member,
+	add, remove, final []Member, last bool,
+) error {
+	if patcher != nil {
+		if len(add) > 0 {
+			if err := patcher.AddMembers(ctx, groupID, add); err != nil {
+				return err //nolint:wrapcheck // Want passthrough
+			}
+		}
+		if len(remove) > 0 {
+			return patcher.RemoveMembers(ctx, groupID, remove) //nolint:wrapcheck // Want passthrough
+		}
+		return nil
+	}
+	if last {
+		return w.GroupReadWriter.SetMembers(ctx, groupID, final) //nolint:wrapcheck // Want passthrough
+	}
+	for _, m := range add {
+		pending[m.ID()] = m
+	}
+	for _, m := range remove {
+		delete(pending, m.ID())
+	}
+	members := make([]Member, 0, len(pending))
+	for _, m := range pending {
+		members = append(members, m)
+	}
+	sortMembers(members)
+	return w.GroupReadWriter.SetMembers(ctx, groupID, members) //nolint:wrapcheck // Want passthrough
+}
+
+func (w *BatchedWriter) clearCheckpoint(ctx context.Context, key string) error {
+	if w.store == nil {
+		return nil
+	}
+	if err := w.store.Delete(ctx, key); err != nil {
+		return fmt.Errorf("failed to clear write checkpoint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestBatchedWriter_SetMembers(t *testing.T) {
+	t.Parallel()
+
+	users := func(ids ...string) []Member {
+		members := make([]Member, 0, len(ids))
+		for _, id := range ids {
+			members = append(members, &UserMember{Usr: &User{ID: id}})
+		}
+		return members
+	}
+	maintainers := func(ids ...string) []Member {
+		members := make([]Member, 0, len(ids))
+		for _, id := range ids {
+			members = append(members, &UserMember{Usr: &User{ID: id}, Role: "maintainer"})
+		}
+		return members
+	}
+
+	cases := []struct {
+		name        string
+		patcher     bool
+		current     []Member
+		members     []Member
+		failAt      int
+		wantWrites  [][]string
+		wantMembers []string
+		wantRoles   map[string]string
+		wantErr     string
+	}{
+		{
+			name:        "small_write",
+			current:     users("a", "b"),
+			members:     users("b", "c"),
+			wantWrites:  [][]string{{"b", "c"}},
+			wantMembers: []string{"b", "c"},
+		},
+		{
+			name:    "batches",
+			current: users("a", "b", "c"),
+			members: users("c", "d", "e", "f"),
+			wantWrites: [][]string{
+				{"a", "b", "c", "d", "e"},
+				{"b", "c", "d", "e", "f"},
+				{"c", "d", "e", "f"},
+			},
+			wantMembers: []string{"c", "d", "e", "f"},
+		},
+		{
+			name:    "patcher",
+			patcher: true,
+			current: users("a", "b", "c"),
+			members: users("c", "d", "e", "f"),
+			wantWrites: [][]string{
+				{"+d", "+e"},
+				{"+f"},
+				{"-a"},
+				{"-b"},
+			},
+			wantMembers: []string{"c", "d", "e", "f"},
+		},
+		{
+			name:    "role_changes",
+			current: users("a", "b", "c"),
+			members: slices.Concat(maintainers("a", "b"), users("c", "d", "e", "f")),
+			wantWrites: [][]string{
+				{"a", "b", "c", "d", "e"},
+				{"a", "b", "c", "d", "e", "f"},
+				{"a", "b", "c", "d", "e", "f"},
+			},
+			wantMembers: []string{"a", "b", "c", "d", "e", "f"},
+			wantRoles:   map[string]string{"a": "maintainer", "b": "maintainer"},
+		},
+		{
+			name:    "patcher_role_changes",
+			patcher: true,
+			current: users("a", "b", "c"),
+			members: slices.Concat(maintainers("a", "b"), users("c", "d", "e", "f")),
+			wantWrites: [][]string{
+				{"+d", "+e"},
+				{"+f", "+a"},
+				{"+b"},
+			},
+			wantMembers: []string{"a", "b", "c", "d", "e", "f"},
+			wantRoles:   map[string]string{"a": "maintainer", "b": "maintainer"},
+		},
+		{
+			name:    "interrupted",
+			current: users("a", "b", "c"),
+			members: users("c", "d", "e", "f"),
+			failAt:  2,
+			wantWrites: [][]string{
+				{"a", "b", "c", "d", "e"},
+			},
+			wantMembers: []string{"a", "b", "c", "d", "e"},
+			wantErr:     "failed to write batch after adding 2 of 3 and removing 0 of 2 members: injected",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			rw := &recordingWriter{
+				MemoryGroupReadWriter: &MemoryGroupReadWriter{Members: map[string][]Member{"g": tc.current}},
+				failAt:                tc.failAt,
+			}
+			var inner GroupReadWriter = rw
+			if tc.patcher {
+				inner = &recordingPatcher{recordingWriter: rw}
+			}
+			store := state.NewMemoryStore()
+			w := NewBatchedWriter(inner, 2, store)
+
+			err := w.SetMembers(ctx, "g", tc.members)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(rw.writes, tc.wantWrites); diff != "" {
+				t.Errorf("unexpected writes (-got, +want):\n%s", diff)
+			}
+			got, _ := rw.GetMembers(ctx, "g")
+			if diff := cmp.Diff(memberIDs(got), tc.wantMembers); diff != "" {
+				t.Errorf("unexpected members (-got, +want):\n%s", diff)
+			}
+			gotRoles := make(map[string]string)
+			for _, m := range got {
+				if role := MemberRole(m); role != "" {
+					gotRoles[m.ID()] = role
+				}
+			}
+			if diff := cmp.Diff(gotRoles, tc.wantRoles, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected roles (-got, +want):\n%s", diff)
+			}
+
+			checkpoints, err := WriteCheckpoints(ctx, store)
+			if err != nil {
+				t.Fatalf("WriteCheckpoints failed: %v", err)
+			}
+			if tc.wantErr == "" {
+				if len(checkpoints) > 0 {
+					t.Errorf("got checkpoints %v, want none", checkpoints)
+				}
+				return
+			}
+			if len(checkpoints) != 1 || checkpoints[0].Added != 2 || checkpoints[0].ToAdd != 3 {
+				t.Fatalf("got checkpoints %v, want one having added 2 of 3 members", checkpoints)
+			}
+
+			// the next run resumes the write and clears the checkpoint.
+			rw.failAt = 0
+			if err := w.SetMembers(ctx, "g", tc.members); err != nil {
+				t.Fatalf("SetMembers failed: %v", err)
+			}
+			got, _ = rw.GetMembers(ctx, "g")
+			if diff := cmp.Diff(memberIDs(got), []string{"c", "d", "e", "f"}); diff != "" {
+				t.Errorf("unexpected members after resuming (-got, +want):\n%s", diff)
+			}
+			if checkpoints, _ := WriteCheckpoints(ctx, store); len(checkpoints) > 0 {
+				t.Errorf("got checkpoints %v after resuming, want none", checkpoints)
+			}
+		})
+	}
+}
+
+// recordingWriter records the IDs of the members set by each write and fails
+// the write with the given number, counting from 1.
+type recordingWriter struct {
+	*MemoryGroupReadWriter
+	writes [][]string
+	failAt int
+}
+
+func (w *recordingWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	if len(w.writes)+1 == w.failAt {
+		return fmt.Errorf("injected")
+	}
+	w.writes = append(w.writes, memberIDs(members))
+	return w.MemoryGroupReadWriter.SetMembers(ctx, groupID, members)
+}
+
+// recordingPatcher is a MemberPatcher which records the IDs of the members
+// added and removed by each call as +ID and -ID.
+type recordingPatcher struct {
+	*recordingWriter
+}
+
+func (w *recordingPatcher) AddMembers(ctx context.Context, groupID string, members []Member) error {
+	current, _ := w.GetMembers(ctx, groupID)
+	w.record("+", members)
+	// members already in the group are replaced, to change their role.
+	kept := ComputeDiff(members, current, DiffIgnoreRoles()).Add
+	return w.MemoryGroupReadWriter.SetMembers(ctx, groupID, append(kept, members...))
+}
+
+func (w *recordingPatcher) RemoveMembers(ctx context.Context, groupID string, members []Member) error {
+	current, _ := w.GetMembers(ctx, groupID)
+	w.record("-", members)
+	return w.MemoryGroupReadWriter.SetMembers(ctx, groupID, ComputeDiff(members, current).Add)
+}
+
+func (w *recordingPatcher) record(prefix string, members []Member) {
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, prefix+m.ID())
+	}
+	w.writes = append(w.writes, ids)
+}
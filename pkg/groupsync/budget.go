@@ -0,0 +1,278 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const budgetKeyPrefix = "budget"
+
+const (
+	// budgetLockTTL bounds how long a budget holds the lock of its usage in a
+	// shared state store, in case it crashes without releasing it.
+	budgetLockTTL = 30 * time.Second
+	// budgetLockAttempts is how often saving the usage tries to acquire the
+	// lock held by another process, waiting budgetLockBackoff in between.
+	budgetLockAttempts = 5
+	budgetLockBackoff  = 200 * time.Millisecond
+)
+
+// budgetUsage is the number of API calls spent in an hour, as kept in the
+// state store.
+type budgetUsage struct {
+	Hour  time.Time `json:"hour"`
+	Calls int       `json:"calls"`
+}
+
+// APIBudget caps the API calls made to a target system per run and per clock
+// hour. A syncer with a budget defers the target groups it reaches once the
+// budget is exhausted to the next run, instead of tripping the rate limits of
+// the target system. A target group which starts within the budget is synced
+// completely, so a run may exceed the budget by the calls of one group per
+// concurrently synced group. The calls spent per hour are kept in a state
+// store, so that they add up across runs and across the processes sharing
+// the store.
+type APIBudget struct {
+	system  string
+	perRun  int
+	perHour int
+	store   state.Store
+	now     func() time.Time
+
+	mu       sync.Mutex
+	runCalls int
+	hour     budgetUsage
+	loaded   bool
+	// unsaved are the calls of the hour which are not in the store yet.
+	unsaved  int
+	deferred []string
+}
+
+// NewAPIBudget creates an APIBudget of the given target system which allows
+// perRun calls per run and perHour calls per hour, keeping the calls per
+// hour in store. A limit of 0 is unlimited.
+func NewAPIBudget(system string, perRun, perHour int, store state.Store) *APIBudget {
+	return &APIBudget{
+		system:  system,
+		perRun:  perRun,
+		perHour: perHour,
+		store:   store,
+		now:     time.Now,
+	}
+}
+
+// Spend records that n API calls were made.
+func (b *APIBudget) Spend(n int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.runCalls += n
+	b.hour.Calls += n
+	b.unsaved += n
+}
+
+// Deferred returns the IDs of the target groups deferred because the budget
+// was exhausted, sorted.
+func (b *APIBudget) Deferred() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return slices.Sorted(slices.Values(b.deferred))
+}
+
+// exhausted reports whether the budget is spent, and if so records that the
+// given target group is deferred. Failing to read the calls of the hour does
+// not exhaust the budget.
+func (b *APIBudget) exhausted(ctx context.Context, targetGroupID string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.loadHour(ctx)
+	if (b.perRun > 0 && b.runCalls >= b.perRun) || (b.perHour > 0 && b.hour.Calls >= b.perHour) {
+		if !slices.Contains(b.deferred, targetGroupID) {
+			b.deferred = append(b.deferred, targetGroupID)
+		}
+		return true
+	}
+	return false
+}
+
+// loadHour reads the calls spent in the current hour, once per hour.
+func (b *APIBudget) loadHour(ctx context.Context) {
+	hour := b.now().UTC().Truncate(time.Hour)
+	if b.loaded && b.hour.Hour.Equal(hour) {
+		return
+	}
+	b.loaded = true
+	b.hour = budgetUsage{Hour: hour}
+	b.unsaved = 0
+	if b.perHour <= 0 || b.store == nil {
+		return
+	}
+	var usage budgetUsage
+	if err := state.GetJSON(ctx, b.store, b.key(), &usage); err != nil {
+		if !errors.Is(err, state.ErrNotFound) {
+			logging.FromContext(ctx).WarnContext(ctx, "failed to read api budget usage",
+				"target_system", b.system,
+				"error", err,
+			)
+		}
+		return
+	}
+	if usage.Hour.Equal(hour) {
+		b.hour.Calls = usage.Calls
+	}
+}
+
+// save adds the calls spent in the current hour since the last save to those
+// kept in the state store. If the store is a state.Locker, the usage is
+// updated under a lock, so that the calls of processes sharing the store add
+// up instead of overwriting each other.
+func (b *APIBudget) save(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.perHour <= 0 || b.store == nil || !b.loaded || b.unsaved == 0 {
+		return nil
+	}
+	if locker, ok := b.store.(state.Locker); ok {
+		unlock, err := b.lock(ctx, locker)
+		if err != nil {
+			return fmt.Errorf("failed to lock api budget usage: %w", err)
+		}
+		defer func() {
+			if err := unlock(context.WithoutCancel(ctx)); err != nil {
+				logging.FromContext(ctx).WarnContext(ctx, "failed to unlock api budget usage",
+					"target_system", b.system,
+					"error", err,
+				)
+			}
+		}()
+	}
+	var usage budgetUsage
+	if err := state.GetJSON(ctx, b.store, b.key(), &usage); err != nil && !errors.Is(err, state.ErrNotFound) {
+		return fmt.Errorf("failed to read api budget usage: %w", err)
+	}
+	if !usage.Hour.Equal(b.hour.Hour) {
+		usage = budgetUsage{Hour: b.hour.Hour}
+	}
+	usage.Calls += b.unsaved
+	if err := state.PutJSON(ctx, b.store, b.key(), &usage); err != nil {
+		return fmt.Errorf("failed to save api budget usage: %w", err)
+	}
+	// the usage now includes the calls of other processes.
+	b.hour = usage
+	b.unsaved = 0
+	return nil
+}
+
+// lock acquires the lock of the usage in the state store, waiting for other
+// processes which hold it.
+func (b *APIBudget) lock(ctx context.Context, locker state.Locker) (func(ctx context.Context) error, error) {
+	for attempt := 1; ; attempt++ {
+		unlock, err := locker.Lock(ctx, b.key(), budgetLockTTL)
+		if !errors.Is(err, state.ErrLocked) || attempt == budgetLockAttempts {
+			return unlock, err //nolint:wrapcheck // Want passthrough
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err() //nolint:wrapcheck // Want passthrough
+		case <-time.After(budgetLockBackoff):
+		}
+	}
+}
+
+func (b *APIBudget) key() string {
+	return state.Key(budgetKeyPrefix, b.system)
+}
+
+// BudgetedWriter wraps a GroupReadWriter and spends the API calls of its
+// reads and writes from an APIBudget. Each read costs a call. Writes cost a
+// call to read the current members, a call per member added, removed or
+// given another role, and another call for the wrapped writer to read the
+// members again.
+type BudgetedWriter struct {
+	WrappedWriter
+
+	budget    *APIBudget
+	normalize IDNormalizer
+}
+
+// NewBudgetedWriter creates a BudgetedWriter wrapping rw. Member IDs are
+// compared by their form normalized by normalize, unless it is nil, like a
+// NormalizingWriter.
+func NewBudgetedWriter(rw GroupReadWriter, budget *APIBudget, normalize IDNormalizer) *BudgetedWriter {
+	return &BudgetedWriter{
+		WrappedWriter: WrappedWriter{GroupReadWriter: rw},
+		budget:        budget,
+		normalize:     normalize,
+	}
+}
+
+// Descendants returns the descendants of the group with the wrapped reader.
+func (w *BudgetedWriter) Descendants(ctx context.Context, groupID string) ([]*User, error) {
+	w.budget.Spend(1)
+	return w.GroupReadWriter.Descendants(ctx, groupID) //nolint:wrapcheck // Want passthrough
+}
+
+// GetGroup returns the group with the wrapped reader.
+func (w *BudgetedWriter) GetGroup(ctx context.Context, groupID string) (*Group, error) {
+	w.budget.Spend(1)
+	return w.GroupReadWriter.GetGroup(ctx, groupID) //nolint:wrapcheck // Want passthrough
+}
+
+// GetMembers returns the members of the group with the wrapped reader.
+func (w *BudgetedWriter) GetMembers(ctx context.Context, groupID string) ([]Member, error) {
+	w.budget.Spend(1)
+	return w.GroupReadWriter.GetMembers(ctx, groupID) //nolint:wrapcheck // Want passthrough
+}
+
+// GetUser returns the user with the wrapped reader.
+func (w *BudgetedWriter) GetUser(ctx context.Context, userID string) (*User, error) {
+	w.budget.Spend(1)
+	return w.GroupReadWriter.GetUser(ctx, userID) //nolint:wrapcheck // Want passthrough
+}
+
+// SetMembers replaces the members of the group with the given members, with
+// the wrapped writer, and spends the calls it needs.
+func (w *BudgetedWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	current, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	diffOpts := []DiffOpt{DiffByKind()}
+	if w.normalize != nil {
+		diffOpts = append(diffOpts, DiffNormalizeIDs(w.normalize))
+	}
+	diff := ComputeDiff(current, members, diffOpts...)
+	w.budget.Spend(len(diff.Add) + len(diff.Remove) + len(diff.Update) + 1)
+	return w.GroupReadWriter.SetMembers(ctx, groupID, members) //nolint:wrapcheck // Want passthrough
+}
+
+// ArchiveGroup archives the group with the wrapped writer, if it is a
+// GroupArchiver.
+func (w *BudgetedWriter) ArchiveGroup(ctx context.Context, groupID string) error {
+	archiver, ok := w.GroupReadWriter.(GroupArchiver)
+	if !ok {
+		return fmt.Errorf("group writer cannot archive group %s", groupID)
+	}
+	w.budget.Spend(1)
+	return archiver.ArchiveGroup(ctx, groupID) //nolint:wrapcheck // Want passthrough
+}
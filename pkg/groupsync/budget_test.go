@@ -0,0 +1,230 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestSync_APIBudget(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2026, 6, 1, 10, 30, 0, 0, time.UTC)
+	store := state.NewMemoryStore()
+
+	newSyncer := func(perRun, perHour int) (*ManyToManySyncer, *APIBudget, *MemoryGroupReadWriter, *RunHistory) {
+		source := &MemoryGroupReadWriter{
+			Members: map[string][]Member{
+				"1": {
+					&UserMember{Usr: &User{ID: "alice@example.com"}},
+					&UserMember{Usr: &User{ID: "bob@example.com"}},
+				},
+			},
+		}
+		target := &MemoryGroupReadWriter{
+			Members: map[string][]Member{"a": {}, "b": {}, "c": {}},
+		}
+		budget := NewAPIBudget("target", perRun, perHour, store)
+		budget.now = func() time.Time { return now }
+		history := NewRunHistory(state.NewMemoryStore(), 10, 0)
+		syncer := NewManyToManySyncer(
+			"source",
+			"target",
+			source,
+			NewBudgetedWriter(target, budget, nil),
+			&testGroupMapper{m: map[string][]string{"1": {"a", "b", "c"}}},
+			&testGroupMapper{m: map[string][]string{"a": {"1"}, "b": {"1"}, "c": {"1"}}},
+			&testUserMapper{m: map[string]string{"alice@example.com": "alice", "bob@example.com": "bob"}},
+			WithAPIBudget(budget),
+			WithRunHistory(history),
+		)
+		return syncer, budget, target, history
+	}
+
+	// syncing a group costs 4 calls: reading its members, adding 2 members
+	// and reading its members again.
+	syncer, budget, target, history := newSyncer(6, 10)
+	if err := syncer.SyncAll(ctx); err != nil {
+		t.Fatalf("SyncAll failed: %v", err)
+	}
+	if diff := cmp.Diff(budget.Deferred(), []string{"c"}); diff != "" {
+		t.Errorf("unexpected deferred groups (-got, +want):\n%s", diff)
+	}
+	for id, want := range map[string]int{"a": 2, "b": 2, "c": 0} {
+		if got := len(target.Members[id]); got != want {
+			t.Errorf("group %s got %d members, want %d", id, got, want)
+		}
+	}
+	reports, err := history.Reports(ctx)
+	if err != nil {
+		t.Fatalf("Reports failed: %v", err)
+	}
+	var deferred []string
+	for _, g := range reports[0].Groups {
+		if g.Deferred {
+			deferred = append(deferred, g.TargetGroupID)
+		}
+	}
+	if diff := cmp.Diff(deferred, []string{"c"}); diff != "" {
+		t.Errorf("unexpected deferred groups in report (-got, +want):\n%s", diff)
+	}
+
+	// the next run in the same hour has 2 calls left of its hourly budget.
+	syncer, budget, _, _ = newSyncer(0, 10)
+	if err := syncer.SyncAll(ctx); err != nil {
+		t.Fatalf("SyncAll failed: %v", err)
+	}
+	if diff := cmp.Diff(budget.Deferred(), []string{"b", "c"}); diff != "" {
+		t.Errorf("unexpected deferred groups in the same hour (-got, +want):\n%s", diff)
+	}
+
+	// the budget is refilled the next hour.
+	now = now.Add(time.Hour)
+	syncer, budget, _, _ = newSyncer(0, 100)
+	if err := syncer.SyncAll(ctx); err != nil {
+		t.Fatalf("SyncAll failed: %v", err)
+	}
+	if got := budget.Deferred(); len(got) > 0 {
+		t.Errorf("got deferred groups %v the next hour, want none", got)
+	}
+}
+
+func TestBudgetedWriter_SetMembers(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		normalize IDNormalizer
+		members   []Member
+		// wantCalls are the calls spent, including the reads of the current
+		// members by the BudgetedWriter and by the wrapped writer.
+		wantCalls int
+	}{
+		{
+			name: "unchanged",
+			members: []Member{
+				&UserMember{Usr: &User{ID: "Alice"}},
+				&UserMember{Usr: &User{ID: "bob"}},
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "role_changed",
+			members: []Member{
+				&UserMember{Usr: &User{ID: "Alice"}, Role: "maintainer"},
+				&UserMember{Usr: &User{ID: "bob"}},
+			},
+			wantCalls: 3,
+		},
+		{
+			name:      "case_changed",
+			normalize: CaseInsensitiveIDs,
+			members: []Member{
+				&UserMember{Usr: &User{ID: "alice"}},
+				&UserMember{Usr: &User{ID: "bob"}},
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "case_changed_not_normalized",
+			members: []Member{
+				&UserMember{Usr: &User{ID: "alice"}},
+				&UserMember{Usr: &User{ID: "bob"}},
+			},
+			wantCalls: 4,
+		},
+		{
+			name: "group_replaces_user",
+			members: []Member{
+				&UserMember{Usr: &User{ID: "Alice"}},
+				&GroupMember{Grp: &Group{ID: "bob"}},
+			},
+			wantCalls: 4,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			rw := &recordingWriter{
+				MemoryGroupReadWriter: &MemoryGroupReadWriter{Members: map[string][]Member{"g": {
+					&UserMember{Usr: &User{ID: "Alice"}},
+					&UserMember{Usr: &User{ID: "bob"}},
+				}}},
+			}
+			budget := NewAPIBudget("target", 0, 0, nil)
+			// the members are written with SetMembers, even by a MemberPatcher.
+			w := NewBudgetedWriter(&recordingPatcher{recordingWriter: rw}, budget, tc.normalize)
+
+			if err := w.SetMembers(ctx, "g", tc.members); err != nil {
+				t.Fatalf("SetMembers failed: %v", err)
+			}
+			if diff := cmp.Diff(rw.writes, [][]string{memberIDs(tc.members)}); diff != "" {
+				t.Errorf("unexpected writes (-got, +want):\n%s", diff)
+			}
+			if got := budget.runCalls; got != tc.wantCalls {
+				t.Errorf("spent %d calls, want %d", got, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestAPIBudget_Save(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2026, 6, 1, 10, 30, 0, 0, time.UTC)
+	store := state.NewMemoryStore()
+	newBudget := func() *APIBudget {
+		b := NewAPIBudget("target", 0, 100, store)
+		b.now = func() time.Time { return now }
+		b.exhausted(ctx, "g")
+		return b
+	}
+
+	// two processes which share the store spend calls in the same hour.
+	first, second := newBudget(), newBudget()
+	first.Spend(3)
+	second.Spend(5)
+	for _, b := range []*APIBudget{first, second, first} {
+		if err := b.save(ctx); err != nil {
+			t.Fatalf("save failed: %v", err)
+		}
+	}
+	first.Spend(2)
+	if err := first.save(ctx); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+
+	var usage budgetUsage
+	if err := state.GetJSON(ctx, store, first.key(), &usage); err != nil {
+		t.Fatalf("failed to get usage: %v", err)
+	}
+	if got, want := usage.Calls, 10; got != want {
+		t.Errorf("saved %d calls, want %d", got, want)
+	}
+	if got, want := first.hour.Calls, 10; got != want {
+		t.Errorf("first budget got %d calls of the hour, want %d", got, want)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+// Ensure we conform to the interfaces.
+var (
+	_ MultiUserMapper = (*ChainUserMapper)(nil)
+	_ UserMapper      = (*RegexUserMapper)(nil)
+	_ UserMapper      = (UserMapperFunc)(nil)
+)
+
+// UserMapperFunc adapts a function to a UserMapper, e.g. a call to a remote
+// mapping service. It returns ErrTargetUserIDNotFound for users it does not
+// map.
+type UserMapperFunc func(ctx context.Context, userID string) (string, error)
+
+// MappedUserID calls f.
+func (f UserMapperFunc) MappedUserID(ctx context.Context, userID string) (string, error) {
+	return f(ctx, userID)
+}
+
+// RegexUserMapper maps the user IDs matching a regular expression by
+// expanding a template with the submatches of the expression, e.g.
+// "^(.+)@example\.com$" and "$1" map emails to usernames.
+type RegexUserMapper struct {
+	pattern  *regexp.Regexp
+	template string
+}
+
+// NewRegexUserMapper creates a RegexUserMapper. The template is expanded as
+// by regexp.Regexp.Expand.
+func NewRegexUserMapper(pattern *regexp.Regexp, template string) *RegexUserMapper {
+	return &RegexUserMapper{pattern: pattern, template: template}
+}
+
+// MappedUserID returns the expanded template if the user ID matches the
+// pattern, or ErrTargetUserIDNotFound otherwise.
+func (m *RegexUserMapper) MappedUserID(ctx context.Context, userID string) (string, error) {
+	match := m.pattern.FindStringSubmatchIndex(userID)
+	if match == nil {
+		return "", ErrTargetUserIDNotFound
+	}
+	mapped := string(m.pattern.ExpandString(nil, m.template, userID, match))
+	if mapped == "" {
+		return "", ErrTargetUserIDNotFound
+	}
+	return mapped, nil
+}
+
+// MapperLayer is a layer of a ChainUserMapper.
+type MapperLayer struct {
+	// Name identifies the layer in logs and stats.
+	Name string
+	// Mapper maps the users of the layer.
+	Mapper UserMapper
+	// FallThroughOnError tries the next layer when the mapper fails, e.g.
+	// when a remote mapping service is unavailable, instead of failing the
+	// user.
+	FallThroughOnError bool
+}
+
+// LayerStats counts the lookups of a layer of a ChainUserMapper.
+type LayerStats struct {
+	Name string
+	// Lookups is the number of users the layer was asked to map.
+	Lookups int
+	// Hits is the number of users the layer mapped, which short-circuit the
+	// following layers.
+	Hits int
+	// Misses is the number of users the layer did not map.
+	Misses int
+	// Errors is the number of users the layer failed to map.
+	Errors int
+	// Duration is the total time spent in the layer.
+	Duration time.Duration
+}
+
+// ChainUserMapper maps users with a chain of mappers, e.g. the user mappings
+// of a file, then a regular expression, then a remote service. Each user is
+// mapped by the first layer which maps it; the following layers are not
+// asked. A layer error fails the user, unless the layer falls through on
+// errors.
+type ChainUserMapper struct {
+	layers []MapperLayer
+
+	mu    sync.Mutex
+	stats []LayerStats
+}
+
+// NewChainUserMapper creates a ChainUserMapper of the given layers, in the
+// order they are asked.
+func NewChainUserMapper(layers ...MapperLayer) *ChainUserMapper {
+	stats := make([]LayerStats, len(layers))
+	for i, l := range layers {
+		stats[i].Name = l.Name
+	}
+	return &ChainUserMapper{layers: layers, stats: stats}
+}
+
+// MappedUserID returns the ID of the first user mapped to the given user ID.
+func (m *ChainUserMapper) MappedUserID(ctx context.Context, userID string) (string, error) {
+	users, err := m.MappedUsers(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return users[0].ID, nil
+}
+
+// MappedUsers returns the users the first layer mapping the given user ID
+// maps it to. It returns ErrTargetUserIDNotFound if no layer maps it.
+func (m *ChainUserMapper) MappedUsers(ctx context.Context, userID string) ([]*MappedUser, error) {
+	for i, l := range m.layers {
+		start := time.Now()
+		users, err := MappedUsers(ctx, l.Mapper, userID)
+		m.record(i, err, time.Since(start))
+		switch {
+		case err == nil:
+			return users, nil
+		case errors.Is(err, ErrTargetUserIDNotFound):
+			continue
+		case l.FallThroughOnError:
+			logging.FromContext(ctx).WarnContext(ctx, "mapper layer failed, trying the next layer",
+				"layer", l.Name,
+				"source_user_id", userID,
+				"error", err,
+			)
+			continue
+		default:
+			return nil, fmt.Errorf("mapper layer %s failed to map user %s: %w", l.Name, userID, err)
+		}
+	}
+	return nil, ErrTargetUserIDNotFound
+}
+
+func (m *ChainUserMapper) record(layer int, err error, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s := &m.stats[layer]
+	s.Lookups++
+	s.Duration += d
+	switch {
+	case err == nil:
+		s.Hits++
+	case errors.Is(err, ErrTargetUserIDNotFound):
+		s.Misses++
+	default:
+		s.Errors++
+	}
+}
+
+// Stats returns the stats of the layers, in order.
+func (m *ChainUserMapper) Stats() []LayerStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats := make([]LayerStats, len(m.stats))
+	copy(stats, m.stats)
+	return stats
+}
+
+// LogStats logs the stats of the layers.
+func (m *ChainUserMapper) LogStats(ctx context.Context) {
+	logger := logging.FromContext(ctx)
+	for _, s := range m.Stats() {
+		logger.InfoContext(ctx, "mapper layer stats",
+			"layer", s.Name,
+			"lookups", s.Lookups,
+			"hits", s.Hits,
+			"misses", s.Misses,
+			"errors", s.Errors,
+			"duration", s.Duration,
+		)
+	}
+}
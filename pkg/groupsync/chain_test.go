@@ -0,0 +1,172 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestChainUserMapper(t *testing.T) {
+	t.Parallel()
+
+	static := UserMapperFunc(func(ctx context.Context, userID string) (string, error) {
+		if userID == "alice@example.com" {
+			return "alice-static", nil
+		}
+		return "", ErrTargetUserIDNotFound
+	})
+	regex := NewRegexUserMapper(regexp.MustCompile(`^(.+)@example\.com$`), "$1")
+	unavailable := UserMapperFunc(func(ctx context.Context, userID string) (string, error) {
+		return "", fmt.Errorf("service unavailable")
+	})
+	remote := UserMapperFunc(func(ctx context.Context, userID string) (string, error) {
+		return "remote-" + userID, nil
+	})
+
+	cases := []struct {
+		name      string
+		layers    []MapperLayer
+		userIDs   []string
+		want      []string
+		wantErr   string
+		wantStats []LayerStats
+	}{
+		{
+			name: "first_layer_short_circuits",
+			layers: []MapperLayer{
+				{Name: "static", Mapper: static},
+				{Name: "regex", Mapper: regex},
+			},
+			userIDs: []string{"alice@example.com", "bob@example.com"},
+			want:    []string{"alice-static", "bob"},
+			wantStats: []LayerStats{
+				{Name: "static", Lookups: 2, Hits: 1, Misses: 1},
+				{Name: "regex", Lookups: 1, Hits: 1},
+			},
+		},
+		{
+			name: "not_mapped",
+			layers: []MapperLayer{
+				{Name: "static", Mapper: static},
+				{Name: "regex", Mapper: regex},
+			},
+			userIDs: []string{"carol@other.com"},
+			wantErr: "target user ID not found",
+			wantStats: []LayerStats{
+				{Name: "static", Lookups: 1, Misses: 1},
+				{Name: "regex", Lookups: 1, Misses: 1},
+			},
+		},
+		{
+			name: "error_stops_chain",
+			layers: []MapperLayer{
+				{Name: "service", Mapper: unavailable},
+				{Name: "remote", Mapper: remote},
+			},
+			userIDs: []string{"carol@other.com"},
+			wantErr: "mapper layer service failed to map user carol@other.com: failed to map user: service unavailable",
+			wantStats: []LayerStats{
+				{Name: "service", Lookups: 1, Errors: 1},
+				{Name: "remote"},
+			},
+		},
+		{
+			name: "fall_through_on_error",
+			layers: []MapperLayer{
+				{Name: "service", Mapper: unavailable, FallThroughOnError: true},
+				{Name: "remote", Mapper: remote},
+			},
+			userIDs: []string{"carol@other.com"},
+			want:    []string{"remote-carol@other.com"},
+			wantStats: []LayerStats{
+				{Name: "service", Lookups: 1, Errors: 1},
+				{Name: "remote", Lookups: 1, Hits: 1},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := NewChainUserMapper(tc.layers...)
+			var got []string
+			var err error
+			for _, id := range tc.userIDs {
+				var mapped string
+				mapped, err = m.MappedUserID(context.Background(), id)
+				if err != nil {
+					break
+				}
+				got = append(got, mapped)
+			}
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected mapped users (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(m.Stats(), tc.wantStats, cmpopts.IgnoreFields(LayerStats{}, "Duration")); diff != "" {
+				t.Errorf("unexpected stats (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRegexUserMapper(t *testing.T) {
+	t.Parallel()
+
+	m := NewRegexUserMapper(regexp.MustCompile(`^(?P<user>[a-z]+)\.(?P<team>[a-z]+)@example\.com$`), "${team}-${user}")
+
+	cases := []struct {
+		name    string
+		userID  string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "match",
+			userID: "alice.infra@example.com",
+			want:   "infra-alice",
+		},
+		{
+			name:    "no_match",
+			userID:  "alice@example.com",
+			wantErr: "target user ID not found",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := m.MappedUserID(context.Background(), tc.userID)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			if got != tc.want {
+				t.Errorf("MappedUserID got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+// ChangeEventSchemaVersion is the version of the schema of ChangeEvent. It is
+// only incremented for changes which break consumers, such as removing or
+// renaming a field; fields may be added without a new version.
+const ChangeEventSchemaVersion = 1
+
+// ChangeAction is the kind of membership change of a ChangeEvent.
+type ChangeAction string
+
+const (
+	// ChangeAdd is a member added to a group.
+	ChangeAdd ChangeAction = "add"
+	// ChangeRemove is a member removed from a group.
+	ChangeRemove ChangeAction = "remove"
+	// ChangeRole is a change of the role of a user in a group.
+	ChangeRole ChangeAction = "role"
+)
+
+// ChangeEvent is a membership change applied to a target group, as
+// published to a ChangeSink.
+type ChangeEvent struct {
+	SchemaVersion int `json:"schema_version"`
+	// ID uniquely identifies the event, so consumers can drop duplicates.
+	ID   string    `json:"id"`
+	Time time.Time `json:"time"`
+	// System is the target system, e.g. GITHUB.
+	System  string       `json:"system"`
+	GroupID string       `json:"group_id"`
+	Action  ChangeAction `json:"action"`
+	// MemberType is "user" or "group".
+	MemberType string `json:"member_type"`
+	MemberID   string `json:"member_id"`
+	// Role is the role of the member after the change, empty for removals
+	// and the default role.
+	Role string `json:"role,omitempty"`
+	// PreviousRole is the role of the member before a role change.
+	PreviousRole string `json:"previous_role,omitempty"`
+}
+
+// ChangeSink publishes the membership changes applied by a sync, e.g. to a
+// message queue which downstream systems consume.
+type ChangeSink interface {
+	// Publish publishes the given events, in order.
+	Publish(ctx context.Context, events []*ChangeEvent) error
+}
+
+// ChangeFeedWriter wraps a GroupReadWriter and publishes the membership
+// changes of SetMembers to a ChangeSink once they are applied. Reads are
+// passed through. Failing to publish does not fail the write, which is
+// already applied, but is logged as an alert.
+type ChangeFeedWriter struct {
+	WrappedWriter
+
+	system    string
+	sink      ChangeSink
+	normalize IDNormalizer
+	now       func() time.Time
+}
+
+// NewChangeFeedWriter creates a ChangeFeedWriter wrapping rw, the writer of
+// the given target system. Member IDs are compared by their form normalized
+// by normalize, unless it is nil, like a NormalizingWriter.
+func NewChangeFeedWriter(rw GroupReadWriter, system string, sink ChangeSink, normalize IDNormalizer) *ChangeFeedWriter {
+	return &ChangeFeedWriter{
+		WrappedWriter: WrappedWriter{GroupReadWriter: rw},
+		system:        system,
+		sink:          sink,
+		normalize:     normalize,
+		now:           time.Now,
+	}
+}
+
+// SetMembers replaces the members of the group with the given ID with the
+// given members and publishes the changes. If the write fails, the members
+// are read again and only the changes which were applied are published.
+func (w *ChangeFeedWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	current, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	writeErr := w.GroupReadWriter.SetMembers(ctx, groupID, members)
+	applied := members
+	if writeErr != nil {
+		if applied, err = w.GetMembers(ctx, groupID); err != nil {
+			logging.FromContext(ctx).ErrorContext(ctx, "failed to read members to publish changes of a failed write",
+				"alert", true,
+				"group_id", groupID,
+				"error", err,
+			)
+			return writeErr //nolint:wrapcheck // Want passthrough
+		}
+	}
+
+	if events := w.events(groupID, current, applied); len(events) > 0 {
+		if err := w.sink.Publish(ctx, events); err != nil {
+			logging.FromContext(ctx).ErrorContext(ctx, "failed to publish membership changes",
+				"alert", true,
+				"group_id", groupID,
+				"events", len(events),
+				"error", err,
+			)
+		}
+	}
+	return writeErr //nolint:wrapcheck // Want passthrough
+}
+
+// events returns the events of the changes from the current to the applied
+// members of the group, ordered by action and member ID.
+func (w *ChangeFeedWriter) events(groupID string, current, applied []Member) []*ChangeEvent {
+	var diffOpts []DiffOpt
+	if w.normalize != nil {
+		diffOpts = append(diffOpts, DiffNormalizeIDs(w.normalize))
+	}
+	diff := ComputeDiff(current, applied, diffOpts...)
+	if diff.IsEmpty() {
+		return nil
+	}
+	currentRoles := make(map[string]string, len(current))
+	for _, m := range current {
+		currentRoles[w.key(m.ID())] = MemberRole(m)
+	}
+
+	now := w.now().UTC()
+	event := func(action ChangeAction, m Member) *ChangeEvent {
+		memberType := "user"
+		if m.IsGroup() {
+			memberType = "group"
+		}
+		return &ChangeEvent{
+			SchemaVersion: ChangeEventSchemaVersion,
+			ID:            newEventID(),
+			Time:          now,
+			System:        w.system,
+			GroupID:       groupID,
+			Action:        action,
+			MemberType:    memberType,
+			MemberID:      m.ID(),
+		}
+	}
+	events := make([]*ChangeEvent, 0, len(diff.Add)+len(diff.Remove)+len(diff.Update))
+	for _, m := range diff.Add {
+		e := event(ChangeAdd, m)
+		e.Role = MemberRole(m)
+		events = append(events, e)
+	}
+	for _, m := range diff.Remove {
+		events = append(events, event(ChangeRemove, m))
+	}
+	for _, u := range diff.Update {
+		e := event(ChangeRole, u)
+		e.Role = u.Role
+		e.PreviousRole = currentRoles[w.key(u.ID())]
+		events = append(events, e)
+	}
+	return events
+}
+
+func (w *ChangeFeedWriter) key(id string) string {
+	if w.normalize != nil {
+		return w.normalize(id)
+	}
+	return id
+}
+
+// newEventID returns a random event ID.
+func newEventID() string {
+	b := make([]byte, 16)
+	// crypto/rand.Read never returns an error.
+	rand.Read(b) //nolint:errcheck
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestChangeFeedWriter(t *testing.T) {
+	t.Parallel()
+
+	user := func(id, role string) Member {
+		return &UserMember{Usr: &User{ID: id}, Role: role}
+	}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	event := func(action ChangeAction, memberType, memberID, role, previousRole string) *ChangeEvent {
+		return &ChangeEvent{
+			SchemaVersion: ChangeEventSchemaVersion,
+			Time:          now,
+			System:        "GITHUB",
+			GroupID:       "1:2",
+			Action:        action,
+			MemberType:    memberType,
+			MemberID:      memberID,
+			Role:          role,
+			PreviousRole:  previousRole,
+		}
+	}
+
+	cases := []struct {
+		name       string
+		members    []Member
+		writeErr   error
+		publishErr error
+		want       []*ChangeEvent
+		wantErr    string
+	}{
+		{
+			name: "changes",
+			members: []Member{
+				user("Alice", "maintainer"),
+				user("carol", ""),
+				&GroupMember{Grp: &Group{ID: "1:3"}},
+			},
+			want: []*ChangeEvent{
+				event(ChangeAdd, "group", "1:3", "", ""),
+				event(ChangeAdd, "user", "carol", "", ""),
+				event(ChangeRemove, "user", "bob", "", ""),
+				event(ChangeRole, "user", "Alice", "maintainer", "member"),
+			},
+		},
+		{
+			name:    "no_changes",
+			members: []Member{user("alice", "member"), user("bob", "")},
+		},
+		{
+			name:     "write_failed",
+			members:  []Member{user("carol", "")},
+			writeErr: fmt.Errorf("write failed"),
+			wantErr:  "write failed",
+		},
+		{
+			name:       "publish_failed",
+			members:    []Member{user("alice", "member")},
+			publishErr: fmt.Errorf("publish failed"),
+			want:       []*ChangeEvent{event(ChangeRemove, "user", "bob", "", "")},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			rw := &failingWriter{MemoryGroupReadWriter: NewMemoryGroupReadWriter(), err: tc.writeErr}
+			rw.AddGroup(&Group{ID: "1:2"}, user("alice", "member"), user("bob", ""))
+			sink := &testChangeSink{err: tc.publishErr}
+			w := NewChangeFeedWriter(rw, "GITHUB", sink, strings.ToLower)
+			w.now = func() time.Time { return now }
+
+			err := w.SetMembers(ctx, "1:2", tc.members)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			for _, e := range sink.events {
+				if e.ID == "" {
+					t.Errorf("event %v has no ID", e)
+				}
+			}
+			if diff := cmp.Diff(sink.events, tc.want, cmpopts.IgnoreFields(ChangeEvent{}, "ID")); diff != "" {
+				t.Errorf("unexpected events (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// failingWriter is a MemoryGroupReadWriter whose writes fail with err, unless
+// it is nil, without being applied.
+type failingWriter struct {
+	*MemoryGroupReadWriter
+	err error
+}
+
+func (w *failingWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	if w.err != nil {
+		return w.err
+	}
+	return w.MemoryGroupReadWriter.SetMembers(ctx, groupID, members)
+}
+
+// testChangeSink records the published events, even when it fails with err.
+type testChangeSink struct {
+	events []*ChangeEvent
+	err    error
+}
+
+func (s *testChangeSink) Publish(ctx context.Context, events []*ChangeEvent) error {
+	s.events = append(s.events, events...)
+	return s.err
+}
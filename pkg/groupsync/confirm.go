@@ -0,0 +1,102 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ErrNotConfirmed denotes that a ConfirmingWriter did not write a group
+// because the removal of its members was declined.
+const ErrNotConfirmed = Error("removal of members was not confirmed")
+
+// ConfirmFunc asks whether the given members may be removed from the group
+// with the given ID.
+type ConfirmFunc func(ctx context.Context, groupID string, remove []Member) (bool, error)
+
+// ConfirmingWriter wraps a GroupReadWriter and asks for confirmation before
+// writes which remove members of a group, e.g. from the user running a sync
+// in a terminal. Writes which only add members or change roles are passed
+// through. Confirmations are asked one at a time, even when groups are
+// written concurrently.
+type ConfirmingWriter struct {
+	WrappedWriter
+	confirm   ConfirmFunc
+	normalize IDNormalizer
+
+	mu sync.Mutex
+}
+
+// NewConfirmingWriter creates a ConfirmingWriter wrapping rw which asks
+// confirm. Member IDs are compared by their form normalized by normalize,
+// unless it is nil.
+func NewConfirmingWriter(rw GroupReadWriter, confirm ConfirmFunc, normalize IDNormalizer) *ConfirmingWriter {
+	return &ConfirmingWriter{WrappedWriter: WrappedWriter{GroupReadWriter: rw}, confirm: confirm, normalize: normalize}
+}
+
+// SetMembers sets the members of the group if no current members would be
+// removed, or if their removal is confirmed. Otherwise it returns
+// ErrNotConfirmed.
+func (w *ConfirmingWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	current, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	var diffOpts []DiffOpt
+	if w.normalize != nil {
+		diffOpts = append(diffOpts, DiffNormalizeIDs(w.normalize))
+	}
+	diff := ComputeDiff(current, members, diffOpts...)
+	if err := w.ask(ctx, groupID, diff.Remove); err != nil {
+		return err
+	}
+	return w.GroupReadWriter.SetMembers(ctx, groupID, members) //nolint:wrapcheck // Want passthrough
+}
+
+// ArchiveGroup archives the group with the wrapped writer if the removal of
+// all its members is confirmed.
+func (w *ConfirmingWriter) ArchiveGroup(ctx context.Context, groupID string) error {
+	archiver, ok := w.GroupReadWriter.(GroupArchiver)
+	if !ok {
+		return fmt.Errorf("group writer cannot archive group %s", groupID)
+	}
+	current, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	if err := w.ask(ctx, groupID, current); err != nil {
+		return err
+	}
+	return archiver.ArchiveGroup(ctx, groupID) //nolint:wrapcheck // Want passthrough
+}
+
+// ask asks for confirmation of the removal of the given members, if any.
+func (w *ConfirmingWriter) ask(ctx context.Context, groupID string, remove []Member) error {
+	if len(remove) == 0 {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	ok, err := w.confirm(ctx, groupID, remove)
+	if err != nil {
+		return fmt.Errorf("failed to confirm removal of members from group %s: %w", groupID, err)
+	}
+	if !ok {
+		return fmt.Errorf("%w: group %s would remove %q", ErrNotConfirmed, groupID, memberIDs(remove))
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestConfirmingWriter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		members    []Member
+		confirm    bool
+		confirmErr error
+		wantAsked  []string
+		wantIDs    []string
+		wantErr    string
+		wantErrIs  error
+	}{
+		{
+			name: "additions_only",
+			members: []Member{
+				&UserMember{Usr: &User{ID: "alice"}},
+				&UserMember{Usr: &User{ID: "bob"}},
+				&UserMember{Usr: &User{ID: "carol"}},
+			},
+			wantIDs: []string{"alice", "bob", "carol"},
+		},
+		{
+			name:      "removal_confirmed",
+			members:   []Member{&UserMember{Usr: &User{ID: "alice"}}},
+			confirm:   true,
+			wantAsked: []string{"bob"},
+			wantIDs:   []string{"alice"},
+		},
+		{
+			name:      "removal_declined",
+			members:   []Member{&UserMember{Usr: &User{ID: "alice"}}},
+			wantAsked: []string{"bob"},
+			wantIDs:   []string{"alice", "bob"},
+			wantErr:   `removal of members was not confirmed: group 99 would remove ["bob"]`,
+			wantErrIs: ErrNotConfirmed,
+		},
+		{
+			name:       "confirm_error",
+			members:    []Member{&UserMember{Usr: &User{ID: "alice"}}},
+			confirmErr: fmt.Errorf("stdin closed"),
+			wantAsked:  []string{"bob"},
+			wantIDs:    []string{"alice", "bob"},
+			wantErr:    "failed to confirm removal of members from group 99: stdin closed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &MemoryGroupReadWriter{
+				Members: map[string][]Member{"99": {
+					&UserMember{Usr: &User{ID: "alice"}},
+					&UserMember{Usr: &User{ID: "bob"}},
+				}},
+			}
+			var asked []string
+			w := NewConfirmingWriter(client, func(ctx context.Context, groupID string, remove []Member) (bool, error) {
+				asked = append(asked, memberIDs(remove)...)
+				return tc.confirm, tc.confirmErr
+			}, nil)
+
+			err := w.SetMembers(context.Background(), "99", tc.members)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			if tc.wantErrIs != nil && !errors.Is(err, tc.wantErrIs) {
+				t.Errorf("SetMembers got err %v, want %v", err, tc.wantErrIs)
+			}
+			if diff := cmp.Diff(asked, tc.wantAsked); diff != "" {
+				t.Errorf("unexpected confirmation (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(targetIDs(t, client), tc.wantIDs); diff != "" {
+				t.Errorf("unexpected target members (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncAll_NotConfirmed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	target := &MemoryGroupReadWriter{
+		Members: map[string][]Member{"99": {
+			&UserMember{Usr: &User{ID: "xy"}},
+			&UserMember{Usr: &User{ID: "zw"}},
+		}},
+	}
+	var asked int
+	writer := NewConfirmingWriter(target, func(ctx context.Context, groupID string, remove []Member) (bool, error) {
+		asked++
+		return false, nil
+	}, nil)
+	queue := NewDeadLetterQueue(state.NewMemoryStore(), 1)
+	history := NewRunHistory(state.NewMemoryStore(), 10, 0)
+	syncer := NewManyToManySyncer(
+		"source",
+		"target",
+		&MemoryGroupReadWriter{
+			Members: map[string][]Member{"1": {&UserMember{Usr: &User{ID: "a"}}}},
+		},
+		writer,
+		&testGroupMapper{m: map[string][]string{"1": {"99"}}},
+		&testGroupMapper{m: map[string][]string{"99": {"1"}}},
+		&testUserMapper{m: map[string]string{"a": "xy"}},
+		WithRetry(3, 0),
+		WithDeadLetterQueue(queue),
+		WithRunHistory(history),
+	)
+
+	if err := syncer.SyncAll(ctx); err != nil {
+		t.Fatalf("SyncAll failed: %v", err)
+	}
+	if asked != 1 {
+		t.Errorf("got %d confirmations, want 1", asked)
+	}
+	if diff := cmp.Diff(targetIDs(t, target), []string{"xy", "zw"}); diff != "" {
+		t.Errorf("unexpected target members (-got, +want):\n%s", diff)
+	}
+	records, err := queue.Records(ctx)
+	if err != nil {
+		t.Fatalf("failed to list records: %v", err)
+	}
+	if len(records) > 0 {
+		t.Errorf("got dead-letter records %v, want none", records)
+	}
+	reports, err := history.Reports(ctx)
+	if err != nil {
+		t.Fatalf("Reports failed: %v", err)
+	}
+	want := []*GroupReport{{TargetGroupID: "99", SourceGroupIDs: []string{"1"}, Declined: true}}
+	if diff := cmp.Diff(reports[0].Groups, want); diff != "" {
+		t.Errorf("unexpected group reports (-got, +want):\n%s", diff)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const deadLetterKeyPrefix = "deadletter"
+
+// GroupKind identifies which side of a sync a group ID belongs to.
+type GroupKind string
+
+const (
+	GroupKindSource GroupKind = "source"
+	GroupKindTarget GroupKind = "target"
+)
+
+// DeadLetterRecord tracks the consecutive failed runs of a single group.
+// Once a group reaches the dead-letter threshold it is skipped by subsequent
+// runs until the record is cleared.
+type DeadLetterRecord struct {
+	Kind                GroupKind     `json:"kind"`
+	GroupID             string        `json:"group_id"`
+	ConsecutiveFailures int           `json:"consecutive_failures"`
+	Category            ErrorCategory `json:"category"`
+	LastError           string        `json:"last_error"`
+	FirstFailure        time.Time     `json:"first_failure"`
+	LastFailure         time.Time     `json:"last_failure"`
+	DeadLettered        bool          `json:"dead_lettered"`
+}
+
+// DeadLetterQueue persists failure records in a state.Store and decides when
+// a group has failed often enough to be dead-lettered.
+type DeadLetterQueue struct {
+	store     state.Store
+	threshold int
+	now       func() time.Time
+}
+
+// NewDeadLetterQueue creates a DeadLetterQueue that dead-letters groups after
+// threshold consecutive failed runs.
+func NewDeadLetterQueue(store state.Store, threshold int) *DeadLetterQueue {
+	return &DeadLetterQueue{
+		store:     store,
+		threshold: threshold,
+		now:       time.Now,
+	}
+}
+
+// Records returns all failure records, including groups which are failing but
+// not yet dead-lettered.
+func (q *DeadLetterQueue) Records(ctx context.Context) ([]*DeadLetterRecord, error) {
+	keys, err := q.store.List(ctx, deadLetterKeyPrefix+"/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead-letter records: %w", err)
+	}
+	records := make([]*DeadLetterRecord, 0, len(keys))
+	for _, key := range keys {
+		var record DeadLetterRecord
+		if err := state.GetJSON(ctx, q.store, key, &record); err != nil {
+			if errors.Is(err, state.ErrNotFound) {
+				// deleted since listing
+				continue
+			}
+			return nil, fmt.Errorf("failed to read dead-letter record: %w", err)
+		}
+		records = append(records, &record)
+	}
+	return records, nil
+}
+
+// DeadLettered reports whether the given group is dead-lettered.
+func (q *DeadLetterQueue) DeadLettered(ctx context.Context, kind GroupKind, groupID string) (bool, error) {
+	var record DeadLetterRecord
+	if err := state.GetJSON(ctx, q.store, deadLetterKey(kind, groupID), &record); err != nil {
+		if errors.Is(err, state.ErrNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read dead-letter record: %w", err)
+	}
+	return record.DeadLettered, nil
+}
+
+// Clear removes the record for the given group so that it is synced again by
+// the next run.
+func (q *DeadLetterQueue) Clear(ctx context.Context, kind GroupKind, groupID string) error {
+	if err := q.store.Delete(ctx, deadLetterKey(kind, groupID)); err != nil {
+		return fmt.Errorf("failed to clear dead-letter record: %w", err)
+	}
+	return nil
+}
+
+// recordRun updates the failure records after a run. Groups in failures have
+// their consecutive failure count incremented and all other existing records
+// for attempted groups are removed. It returns the records of groups that
+// were dead-lettered by this run.
+func (q *DeadLetterQueue) recordRun(ctx context.Context, attempted map[GroupKind][]string, failures []*GroupError) ([]*DeadLetterRecord, error) {
+	existing, err := q.Records(ctx)
+	if err != nil {
+		return nil, err
+	}
+	records := make(map[string]*DeadLetterRecord, len(existing))
+	for _, r := range existing {
+		records[deadLetterKey(r.Kind, r.GroupID)] = r
+	}
+
+	// several source groups may report the same failed target group, only
+	// count it once per run.
+	failed := make(map[string]*GroupError, len(failures))
+	for _, ge := range failures {
+		kind, id := GroupKindTarget, ge.TargetGroupID
+		if id == "" {
+			kind, id = GroupKindSource, ge.SourceGroupID
+		}
+		failed[deadLetterKey(kind, id)] = ge
+	}
+
+	var merr error
+	var deadLettered []*DeadLetterRecord
+	now := q.now().UTC()
+	for kind, ids := range attempted {
+		for _, id := range ids {
+			key := deadLetterKey(kind, id)
+			record, ok := records[key]
+			if ok && record.DeadLettered {
+				// skipped this run, leave it for a human.
+				continue
+			}
+			ge, ok := failed[key]
+			if !ok {
+				if record != nil {
+					merr = errors.Join(merr, q.store.Delete(ctx, key))
+				}
+				continue
+			}
+			if record == nil {
+				record = &DeadLetterRecord{Kind: kind, GroupID: id, FirstFailure: now}
+			}
+			record.ConsecutiveFailures++
+			record.Category = ge.Category
+			record.LastError = ge.Err.Error()
+			record.LastFailure = now
+			if q.threshold > 0 && record.ConsecutiveFailures >= q.threshold {
+				record.DeadLettered = true
+				deadLettered = append(deadLettered, record)
+			}
+			merr = errors.Join(merr, state.PutJSON(ctx, q.store, key, record))
+		}
+	}
+	if merr != nil {
+		return deadLettered, fmt.Errorf("failed to update dead-letter records: %w", merr)
+	}
+	return deadLettered, nil
+}
+
+func deadLetterKey(kind GroupKind, groupID string) string {
+	return state.Key(deadLetterKeyPrefix, string(kind), groupID)
+}
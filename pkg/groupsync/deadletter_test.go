@@ -0,0 +1,166 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestSyncAll_DeadLetter(t *testing.T) {
+	t.Parallel()
+
+	forbidden := fmt.Errorf("forbidden")
+	cases := []struct {
+		name        string
+		failures    map[string][]error
+		runs        int
+		threshold   int
+		wantCalls   map[string]int
+		wantRecords []*DeadLetterRecord
+	}{
+		{
+			name:      "dead_lettered_after_threshold",
+			failures:  map[string][]error{"99": {forbidden, forbidden, forbidden, forbidden}},
+			runs:      4,
+			threshold: 2,
+			wantCalls: map[string]int{"99": 2, "98": 4},
+			wantRecords: []*DeadLetterRecord{
+				{
+					Kind:                GroupKindTarget,
+					GroupID:             "99",
+					ConsecutiveFailures: 2,
+					Category:            ErrorCategoryAPI,
+					LastError:           "error setting members to target group 99: forbidden",
+					DeadLettered:        true,
+				},
+			},
+		},
+		{
+			name:      "below_threshold",
+			failures:  map[string][]error{"99": {forbidden, forbidden}},
+			runs:      2,
+			threshold: 3,
+			wantCalls: map[string]int{"99": 2, "98": 2},
+			wantRecords: []*DeadLetterRecord{
+				{
+					Kind:                GroupKindTarget,
+					GroupID:             "99",
+					ConsecutiveFailures: 2,
+					Category:            ErrorCategoryAPI,
+					LastError:           "error setting members to target group 99: forbidden",
+				},
+			},
+		},
+		{
+			name:        "success_resets",
+			failures:    map[string][]error{"99": {forbidden, forbidden}},
+			runs:        3,
+			threshold:   3,
+			wantCalls:   map[string]int{"99": 3, "98": 3},
+			wantRecords: []*DeadLetterRecord{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			targetGroupClient := &flakyGroupWriter{
+				MemoryGroupReadWriter: &MemoryGroupReadWriter{
+					Members: map[string][]Member{
+						"99": {},
+						"98": {},
+					},
+				},
+				failures: tc.failures,
+				calls:    make(map[string]int),
+			}
+			queue := NewDeadLetterQueue(state.NewMemoryStore(), tc.threshold)
+			queue.now = func() time.Time { return time.Time{} }
+			syncer := NewManyToManySyncer(
+				"source",
+				"target",
+				&MemoryGroupReadWriter{
+					Members: map[string][]Member{
+						"1": {&UserMember{Usr: &User{ID: "a"}}},
+						"2": {&UserMember{Usr: &User{ID: "b"}}},
+					},
+				},
+				targetGroupClient,
+				&testGroupMapper{m: map[string][]string{"1": {"99"}, "2": {"98"}}},
+				&testGroupMapper{m: map[string][]string{"99": {"1"}, "98": {"2"}}},
+				&testUserMapper{m: map[string]string{"a": "xy", "b": "zw"}},
+				WithDeadLetterQueue(queue),
+			)
+
+			for range tc.runs {
+				// failures are reported by the records, not the error.
+				_ = syncer.SyncAll(ctx)
+			}
+
+			if diff := cmp.Diff(targetGroupClient.calls, tc.wantCalls); diff != "" {
+				t.Errorf("unexpected SetMembers calls (-got, +want): \n%s", diff)
+			}
+			got, err := queue.Records(ctx)
+			if err != nil {
+				t.Fatalf("failed to list records: %v", err)
+			}
+			if diff := cmp.Diff(got, tc.wantRecords); diff != "" {
+				t.Errorf("unexpected records (-got, +want): \n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeadLetterQueue_Clear(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	queue := NewDeadLetterQueue(state.NewMemoryStore(), 1)
+	if _, err := queue.recordRun(ctx,
+		map[GroupKind][]string{GroupKindTarget: {"groups/99"}},
+		[]*GroupError{{SourceGroupID: "1", TargetGroupID: "groups/99", Err: fmt.Errorf("forbidden")}},
+	); err != nil {
+		t.Fatalf("failed to record run: %v", err)
+	}
+
+	got, err := queue.DeadLettered(ctx, GroupKindTarget, "groups/99")
+	if err != nil {
+		t.Fatalf("DeadLettered failed: %v", err)
+	}
+	if !got {
+		t.Errorf("DeadLettered before Clear = false, want true")
+	}
+
+	if err := queue.Clear(ctx, GroupKindTarget, "groups/99"); err != nil {
+		t.Fatalf("Clear failed: %v", err)
+	}
+	got, err = queue.DeadLettered(ctx, GroupKindTarget, "groups/99")
+	if err != nil {
+		t.Fatalf("DeadLettered failed: %v", err)
+	}
+	if got {
+		t.Errorf("DeadLettered after Clear = true, want false")
+	}
+}
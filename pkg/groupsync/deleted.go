@@ -0,0 +1,137 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const snapshotKeyPrefix = "snapshot"
+
+// DeletedGroupPolicy decides how a target group is synced when one of its
+// source groups no longer exists.
+type DeletedGroupPolicy string
+
+const (
+	// DeletedGroupError fails the sync of the target group. It is the default.
+	DeletedGroupError DeletedGroupPolicy = "error"
+	// DeletedGroupSkip leaves the target group as it is and logs a warning.
+	DeletedGroupSkip DeletedGroupPolicy = "skip"
+	// DeletedGroupEmpty syncs the target group as if the deleted source group
+	// had no members, which empties it if it was its only source group. The
+	// members of the target group are saved in a snapshot beforehand.
+	DeletedGroupEmpty DeletedGroupPolicy = "empty"
+)
+
+// DeletedGroupPolicies are the supported policies.
+var DeletedGroupPolicies = []DeletedGroupPolicy{DeletedGroupError, DeletedGroupSkip, DeletedGroupEmpty}
+
+// ParseDeletedGroupPolicy parses the name of a DeletedGroupPolicy.
+func ParseDeletedGroupPolicy(s string) (DeletedGroupPolicy, error) {
+	for _, p := range DeletedGroupPolicies {
+		if string(p) == s {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("unknown deleted group policy %q, must be one of %q", s, DeletedGroupPolicies)
+}
+
+// TargetSnapshot is the membership of a target group saved before a deleted
+// source group changed it, so that it can be restored by hand.
+type TargetSnapshot struct {
+	GroupID string `json:"group_id"`
+	// DeletedSourceGroupIDs are the deleted source groups of the target group.
+	DeletedSourceGroupIDs []string  `json:"deleted_source_group_ids"`
+	Members               []string  `json:"members"`
+	TakenAt               time.Time `json:"taken_at"`
+}
+
+// DeletedGroups applies a DeletedGroupPolicy to the target groups of deleted
+// source groups. With DeletedGroupEmpty, it keeps the snapshots of the target
+// groups in a state.Store.
+type DeletedGroups struct {
+	policy DeletedGroupPolicy
+	store  state.Store
+	now    func() time.Time
+}
+
+// NewDeletedGroups creates a DeletedGroups applying the given policy. The
+// store is only used by DeletedGroupEmpty.
+func NewDeletedGroups(policy DeletedGroupPolicy, store state.Store) *DeletedGroups {
+	return &DeletedGroups{
+		policy: policy,
+		store:  store,
+		now:    time.Now,
+	}
+}
+
+// Snapshots returns the snapshots of all target groups.
+func (d *DeletedGroups) Snapshots(ctx context.Context) ([]*TargetSnapshot, error) {
+	keys, err := d.store.List(ctx, snapshotKeyPrefix+"/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list target group snapshots: %w", err)
+	}
+	var snapshots []*TargetSnapshot
+	for _, key := range keys {
+		var snapshot TargetSnapshot
+		if err := state.GetJSON(ctx, d.store, key, &snapshot); err != nil {
+			if errors.Is(err, state.ErrNotFound) {
+				// deleted since listing
+				continue
+			}
+			return nil, fmt.Errorf("failed to read target group snapshot: %w", err)
+		}
+		snapshots = append(snapshots, &snapshot)
+	}
+	return snapshots, nil
+}
+
+// Clear removes the snapshot of the given target group, e.g. once it was
+// restored or is no longer needed.
+func (d *DeletedGroups) Clear(ctx context.Context, groupID string) error {
+	if err := d.store.Delete(ctx, state.Key(snapshotKeyPrefix, groupID)); err != nil {
+		return fmt.Errorf("failed to clear target group snapshot: %w", err)
+	}
+	return nil
+}
+
+// snapshot saves the given members of the target group, unless a snapshot
+// of it exists already, which keeps the members from before the first
+// deletion.
+func (d *DeletedGroups) snapshot(ctx context.Context, groupID string, deletedSourceGroupIDs []string, members []Member) error {
+	key := state.Key(snapshotKeyPrefix, groupID)
+	var existing TargetSnapshot
+	err := state.GetJSON(ctx, d.store, key, &existing)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, state.ErrNotFound) {
+		return fmt.Errorf("failed to read target group snapshot: %w", err)
+	}
+	if err := state.PutJSON(ctx, d.store, key, &TargetSnapshot{
+		GroupID:               groupID,
+		DeletedSourceGroupIDs: deletedSourceGroupIDs,
+		Members:               memberIDs(members),
+		TakenAt:               d.now().UTC(),
+	}); err != nil {
+		return fmt.Errorf("failed to save target group snapshot: %w", err)
+	}
+	return nil
+}
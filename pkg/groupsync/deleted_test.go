@@ -0,0 +1,109 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestSync_DeletedGroups(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name          string
+		policy        DeletedGroupPolicy
+		wantErr       string
+		want          []string
+		wantSnapshots []*TargetSnapshot
+	}{
+		{
+			name:    "error",
+			policy:  DeletedGroupError,
+			wantErr: "group not found",
+			want:    []string{"alice", "carol"},
+		},
+		{
+			name:   "skip",
+			policy: DeletedGroupSkip,
+			want:   []string{"alice", "carol"},
+		},
+		{
+			name:   "empty",
+			policy: DeletedGroupEmpty,
+			want:   []string{"alice"},
+			wantSnapshots: []*TargetSnapshot{{
+				GroupID:               "99",
+				DeletedSourceGroupIDs: []string{"2"},
+				Members:               []string{"alice", "carol"},
+				TakenAt:               now,
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sourceGroupClient := &MemoryGroupReadWriter{
+				Members: map[string][]Member{"1": {
+					&UserMember{Usr: &User{ID: "alice@example.com"}},
+				}},
+			}
+			targetGroupClient := &MemoryGroupReadWriter{
+				Members: map[string][]Member{"99": {
+					&UserMember{Usr: &User{ID: "alice"}},
+					&UserMember{Usr: &User{ID: "carol"}},
+				}},
+			}
+			deleted := NewDeletedGroups(tc.policy, state.NewMemoryStore())
+			deleted.now = func() time.Time { return now }
+			syncer := NewManyToManySyncer(
+				"source",
+				"target",
+				sourceGroupClient,
+				targetGroupClient,
+				&testGroupMapper{m: map[string][]string{"1": {"99"}, "2": {"99"}}},
+				&testGroupMapper{m: map[string][]string{"99": {"1", "2"}}},
+				&testUserMapper{m: map[string]string{"alice@example.com": "alice"}},
+				WithDeletedGroups(deleted),
+			)
+
+			err := syncer.Sync(ctx, "1")
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(targetIDs(t, targetGroupClient), tc.want); diff != "" {
+				t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
+			}
+			snapshots, err := deleted.Snapshots(ctx)
+			if err != nil {
+				t.Fatalf("Snapshots failed: %v", err)
+			}
+			if diff := cmp.Diff(snapshots, tc.wantSnapshots); diff != "" {
+				t.Errorf("unexpected snapshots (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
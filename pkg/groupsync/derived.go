@@ -0,0 +1,174 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+// Ensure we conform to the interfaces.
+var (
+	_ ChangeLogReader     = (*DerivedGroupReader)(nil)
+	_ SuspendedUserReader = (*DerivedGroupReader)(nil)
+)
+
+// DerivedGroupReader wraps a GroupReader with derived groups, whose users are
+// given by a GroupExpr over other groups, e.g. "eng-all minus interns". They
+// are evaluated whenever they are read, so targets can be built from
+// combinations of groups without creating new groups in the source system.
+// The members of a derived group are its users; it has no group members.
+type DerivedGroupReader struct {
+	GroupReader
+	groups map[string]GroupExpr
+}
+
+// NewDerivedGroupReader creates a DerivedGroupReader with the given derived
+// groups, keyed by their ID. Derived groups may refer to other derived
+// groups, but not to themselves.
+func NewDerivedGroupReader(reader GroupReader, groups map[string]GroupExpr) (*DerivedGroupReader, error) {
+	r := &DerivedGroupReader{GroupReader: reader, groups: groups}
+	for _, id := range slices.Sorted(maps.Keys(groups)) {
+		if err := r.checkCycle(id, nil); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func (r *DerivedGroupReader) checkCycle(groupID string, path []string) error {
+	if slices.Contains(path, groupID) {
+		return fmt.Errorf("derived group %s refers to itself: %v", groupID, append(path, groupID))
+	}
+	expr, ok := r.groups[groupID]
+	if !ok {
+		return nil
+	}
+	for _, id := range expr.GroupIDs() {
+		if err := r.checkCycle(id, append(slices.Clip(path), groupID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsDerived reports whether the group with the given ID is a derived group.
+func (r *DerivedGroupReader) IsDerived(groupID string) bool {
+	_, ok := r.groups[groupID]
+	return ok
+}
+
+// BaseGroupIDs returns the given group IDs with the derived groups replaced
+// by the groups of the source system they refer to, e.g. to check the
+// settings of the groups a sync reads.
+func (r *DerivedGroupReader) BaseGroupIDs(groupIDs []string) []string {
+	var ids []string
+	for _, id := range groupIDs {
+		expr, ok := r.groups[id]
+		if !ok {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+			continue
+		}
+		for _, base := range r.BaseGroupIDs(expr.GroupIDs()) {
+			if !slices.Contains(ids, base) {
+				ids = append(ids, base)
+			}
+		}
+	}
+	return ids
+}
+
+// Descendants returns the users of the derived group with the given ID, or
+// the descendants of the group of the wrapped reader.
+func (r *DerivedGroupReader) Descendants(ctx context.Context, groupID string) ([]*User, error) {
+	expr, ok := r.groups[groupID]
+	if !ok {
+		return r.GroupReader.Descendants(ctx, groupID) //nolint:wrapcheck // Want passthrough
+	}
+	logging.FromContext(ctx).InfoContext(ctx, "evaluating derived group",
+		"group_id", groupID,
+		"expression", expr.String(),
+	)
+	users, err := EvalGroupExpr(ctx, expr, r.Descendants)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate derived group %s: %w", groupID, err)
+	}
+	return users, nil
+}
+
+// GetGroup returns the derived group with the given ID, or the group of the
+// wrapped reader.
+func (r *DerivedGroupReader) GetGroup(ctx context.Context, groupID string) (*Group, error) {
+	if _, ok := r.groups[groupID]; ok {
+		return &Group{ID: groupID}, nil
+	}
+	return r.GroupReader.GetGroup(ctx, groupID) //nolint:wrapcheck // Want passthrough
+}
+
+// GetMembers returns the users of the derived group with the given ID as its
+// members, or the members of the group of the wrapped reader.
+func (r *DerivedGroupReader) GetMembers(ctx context.Context, groupID string) ([]Member, error) {
+	if _, ok := r.groups[groupID]; !ok {
+		return r.GroupReader.GetMembers(ctx, groupID) //nolint:wrapcheck // Want passthrough
+	}
+	users, err := r.Descendants(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]Member, 0, len(users))
+	for _, u := range users {
+		members = append(members, &UserMember{Usr: u})
+	}
+	return members, nil
+}
+
+// ChangedGroupIDs returns the groups of the wrapped reader which changed
+// since the given time, along with the derived groups referring to them.
+func (r *DerivedGroupReader) ChangedGroupIDs(ctx context.Context, since time.Time) ([]string, error) {
+	changeLog, ok := r.GroupReader.(ChangeLogReader)
+	if !ok {
+		return nil, fmt.Errorf("group reader does not support listing changed groups")
+	}
+	changed, err := changeLog.ChangedGroupIDs(ctx, since)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	for _, id := range slices.Sorted(maps.Keys(r.groups)) {
+		for _, base := range r.BaseGroupIDs([]string{id}) {
+			if slices.Contains(changed, base) {
+				changed = append(changed, id)
+				break
+			}
+		}
+	}
+	return changed, nil
+}
+
+// IsSuspended reports whether the user with the given ID is suspended, if
+// the wrapped reader is a SuspendedUserReader.
+func (r *DerivedGroupReader) IsSuspended(ctx context.Context, userID string) (bool, error) {
+	reader, ok := r.GroupReader.(SuspendedUserReader)
+	if !ok {
+		return false, fmt.Errorf("group reader does not report suspended users")
+	}
+	return reader.IsSuspended(ctx, userID) //nolint:wrapcheck // Want passthrough
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestDerivedGroupReader(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	source := NewMemoryGroupReadWriter()
+	source.AddGroup(&Group{ID: "interns"}, &UserMember{Usr: &User{ID: "carol"}})
+	source.AddGroup(&Group{ID: "eng-all"},
+		&UserMember{Usr: &User{ID: "alice"}},
+		&UserMember{Usr: &User{ID: "carol"}},
+		&GroupMember{Grp: &Group{ID: "sre"}},
+	)
+	source.AddGroup(&Group{ID: "sre"}, &UserMember{Usr: &User{ID: "bob"}})
+
+	r, err := NewDerivedGroupReader(&changeLogReader{GroupReader: source, changed: []string{"interns"}}, map[string]GroupExpr{
+		"eng-fulltime": mustParseGroupExpr(t, "eng-all minus interns"),
+		"eng-core":     mustParseGroupExpr(t, "eng-fulltime minus sre"),
+	})
+	if err != nil {
+		t.Fatalf("NewDerivedGroupReader failed: %v", err)
+	}
+
+	users, err := r.Descendants(ctx, "eng-fulltime")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDsOf(users), []string{"alice", "bob"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	members, err := r.GetMembers(ctx, "eng-core")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(memberIDs(members), []string{"alice"}); diff != "" {
+		t.Errorf("unexpected members (-got, +want):\n%s", diff)
+	}
+
+	// groups of the source system are read as they are.
+	members, err = r.GetMembers(ctx, "eng-all")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(memberIDs(members), []string{"alice", "carol", "sre"}); diff != "" {
+		t.Errorf("unexpected members (-got, +want):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(r.BaseGroupIDs([]string{"eng-core", "sre", "other"}), []string{"eng-all", "interns", "sre", "other"}); diff != "" {
+		t.Errorf("unexpected base group IDs (-got, +want):\n%s", diff)
+	}
+
+	changed, err := r.ChangedGroupIDs(ctx, time.Time{})
+	if err != nil {
+		t.Fatalf("ChangedGroupIDs failed: %v", err)
+	}
+	if diff := cmp.Diff(changed, []string{"interns", "eng-core", "eng-fulltime"}); diff != "" {
+		t.Errorf("unexpected changed groups (-got, +want):\n%s", diff)
+	}
+}
+
+func TestNewDerivedGroupReader_Cycle(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewDerivedGroupReader(NewMemoryGroupReadWriter(), map[string]GroupExpr{
+		"a": mustParseGroupExpr(t, "b union eng"),
+		"b": mustParseGroupExpr(t, "eng minus a"),
+	})
+	if diff := testutil.DiffErrString(err, "derived group a refers to itself: [a b a]"); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func mustParseGroupExpr(tb testing.TB, s string) GroupExpr {
+	tb.Helper()
+	expr, err := ParseGroupExpr(s)
+	if err != nil {
+		tb.Fatalf("ParseGroupExpr failed: %v", err)
+	}
+	return expr
+}
+
+func userIDsOf(users []*User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	return ids
+}
+
+// changeLogReader is a ChangeLogReader reporting fixed changed groups.
+type changeLogReader struct {
+	GroupReader
+	changed []string
+}
+
+func (r *changeLogReader) ChangedGroupIDs(ctx context.Context, since time.Time) ([]string, error) {
+	return r.changed, nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"slices"
+	"strings"
+)
+
+// Diff is the difference between the current and the desired members of a
+// group, as applied by the syncers and writers of this package.
+type Diff struct {
+	// Add are the desired members which are not current members, sorted by ID.
+	Add []Member
+	// Remove are the current members which are not desired, sorted by ID.
+	Remove []Member
+	// Update are the desired user members which are current members with a
+	// different role, sorted by ID.
+	Update []*UserMember
+}
+
+// IsEmpty reports whether the diff has no changes.
+func (d *Diff) IsEmpty() bool {
+	return len(d.Add) == 0 && len(d.Remove) == 0 && len(d.Update) == 0
+}
+
+// diffConfig holds the member identity options of ComputeDiff.
+type diffConfig struct {
+	normalize   IDNormalizer
+	byKind      bool
+	ignoreRoles bool
+}
+
+// DiffOpt configures how ComputeDiff identifies members.
+type DiffOpt func(config *diffConfig)
+
+// DiffNormalizeIDs compares member IDs by their form normalized by normalize,
+// e.g. CaseInsensitiveIDs, like a NormalizingWriter. By default IDs are
+// compared exactly.
+func DiffNormalizeIDs(normalize IDNormalizer) DiffOpt {
+	return func(config *diffConfig) {
+		config.normalize = normalize
+	}
+}
+
+// DiffByKind tells user and group members with the same ID apart. By default
+// members are identified by their ID alone, like writers which keep users
+// and groups in the same namespace.
+func DiffByKind() DiffOpt {
+	return func(config *diffConfig) {
+		config.byKind = true
+	}
+}
+
+// DiffIgnoreRoles leaves role changes out of the diff, e.g. for groups whose
+// reader does not report roles.
+func DiffIgnoreRoles() DiffOpt {
+	return func(config *diffConfig) {
+		config.ignoreRoles = true
+	}
+}
+
+// ComputeDiff returns the members to add to, remove from and update in a
+// group with the current members to make its members the desired members.
+// Members which are listed more than once are diffed once. Users with the
+// same StableID are the same member, even if their IDs differ.
+func ComputeDiff(current, desired []Member, opts ...DiffOpt) *Diff {
+	config := &diffConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+	key := func(m Member) string {
+		id := m.ID()
+		if config.normalize != nil {
+			id = config.normalize(id)
+		}
+		if config.byKind && m.IsGroup() {
+			return "group/" + id
+		}
+		return id
+	}
+
+	currentByKey := make(map[string]Member, len(current))
+	currentByStableID := make(map[string]Member, len(current))
+	for _, m := range current {
+		currentByKey[key(m)] = m
+		if id := stableID(m); id != "" {
+			currentByStableID[id] = m
+		}
+	}
+	diff := &Diff{}
+	desiredKeys := make(map[string]struct{}, len(desired))
+	for _, m := range desired {
+		k := key(m)
+		// a renamed user is the current member with the same stable ID.
+		if c, ok := currentByStableID[stableID(m)]; ok && stableID(m) != "" {
+			k = key(c)
+		}
+		if _, ok := desiredKeys[k]; ok {
+			continue
+		}
+		desiredKeys[k] = struct{}{}
+		c, ok := currentByKey[k]
+		if !ok {
+			diff.Add = append(diff.Add, m)
+			continue
+		}
+		if config.ignoreRoles {
+			continue
+		}
+		if u, ok := m.(*UserMember); ok {
+			if cu, ok := c.(*UserMember); ok && cu.Role != u.Role {
+				diff.Update = append(diff.Update, u)
+			}
+		}
+	}
+	removed := make(map[string]struct{}, len(current))
+	for _, m := range current {
+		k := key(m)
+		if _, ok := desiredKeys[k]; ok {
+			continue
+		}
+		if _, ok := removed[k]; ok {
+			continue
+		}
+		removed[k] = struct{}{}
+		diff.Remove = append(diff.Remove, m)
+	}
+	sortMembers(diff.Add)
+	sortMembers(diff.Remove)
+	slices.SortFunc(diff.Update, func(a, b *UserMember) int {
+		return strings.Compare(a.ID(), b.ID())
+	})
+	return diff
+}
+
+// stableID returns the StableID of a user member, or "" if it has none.
+func stableID(m Member) string {
+	if u, ok := m.(*UserMember); ok {
+		return u.Usr.StableID
+	}
+	return ""
+}
+
+func sortMembers(members []Member) {
+	slices.SortFunc(members, func(a, b Member) int {
+		return strings.Compare(a.ID(), b.ID())
+	})
+}
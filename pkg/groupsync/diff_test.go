@@ -0,0 +1,129 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestComputeDiff(t *testing.T) {
+	t.Parallel()
+
+	user := func(id, role string) *UserMember {
+		return &UserMember{Usr: &User{ID: id}, Role: role}
+	}
+	group := func(id string) *GroupMember {
+		return &GroupMember{Grp: &Group{ID: id}}
+	}
+
+	cases := []struct {
+		name    string
+		current []Member
+		desired []Member
+		opts    []DiffOpt
+		want    *Diff
+	}{
+		{
+			name: "empty",
+			want: &Diff{},
+		},
+		{
+			name:    "add_and_remove",
+			current: []Member{user("carol", ""), user("alice", ""), group("old")},
+			desired: []Member{user("dave", ""), user("alice", ""), user("bob", "")},
+			want: &Diff{
+				Add:    []Member{user("bob", ""), user("dave", "")},
+				Remove: []Member{user("carol", ""), group("old")},
+			},
+		},
+		{
+			name:    "role_update",
+			current: []Member{user("alice", "member"), user("bob", "maintainer")},
+			desired: []Member{user("alice", "maintainer"), user("bob", "maintainer")},
+			want: &Diff{
+				Update: []*UserMember{user("alice", "maintainer")},
+			},
+		},
+		{
+			name:    "ignore_roles",
+			current: []Member{user("alice", "member")},
+			desired: []Member{user("alice", "maintainer")},
+			opts:    []DiffOpt{DiffIgnoreRoles()},
+			want:    &Diff{},
+		},
+		{
+			name:    "duplicates",
+			current: []Member{user("alice", "")},
+			desired: []Member{user("bob", ""), user("bob", ""), user("alice", "")},
+			want: &Diff{
+				Add: []Member{user("bob", "")},
+			},
+		},
+		{
+			name:    "ids_compared_exactly",
+			current: []Member{user("Alice", "")},
+			desired: []Member{user("alice", "")},
+			want: &Diff{
+				Add:    []Member{user("alice", "")},
+				Remove: []Member{user("Alice", "")},
+			},
+		},
+		{
+			name:    "normalized_ids",
+			current: []Member{user("Alice", "")},
+			desired: []Member{user("alice", "")},
+			opts:    []DiffOpt{DiffNormalizeIDs(CaseInsensitiveIDs)},
+			want:    &Diff{},
+		},
+		{
+			name:    "kinds_share_ids",
+			current: []Member{group("eng")},
+			desired: []Member{user("eng", "")},
+			want:    &Diff{},
+		},
+		{
+			name:    "renamed_user",
+			current: []Member{&UserMember{Usr: &User{ID: "alice2", StableID: "1"}}, user("bob", "")},
+			desired: []Member{&UserMember{Usr: &User{ID: "alice", StableID: "1"}}, user("bob", "")},
+			want:    &Diff{},
+		},
+		{
+			name:    "by_kind",
+			current: []Member{group("eng")},
+			desired: []Member{user("eng", "")},
+			opts:    []DiffOpt{DiffByKind()},
+			want: &Diff{
+				Add:    []Member{user("eng", "")},
+				Remove: []Member{group("eng")},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ComputeDiff(tc.current, tc.desired, tc.opts...)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected diff (-got, +want):\n%s", diff)
+			}
+			if got, want := got.IsEmpty(), tc.want.IsEmpty(); got != want {
+				t.Errorf("IsEmpty got %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+// DomainAction decides what happens to source users outside of the allowed
+// domains of a DomainPolicy.
+type DomainAction string
+
+const (
+	// DomainActionFlag logs the users outside of the allowed domains and
+	// syncs them like the others.
+	DomainActionFlag DomainAction = "flag"
+	// DomainActionExclude logs the users outside of the allowed domains and
+	// excludes them from the target groups.
+	DomainActionExclude DomainAction = "exclude"
+)
+
+// DomainPolicy checks the email domains of the users of source groups
+// against an allowlist, e.g. so that contractors added to a source group do
+// not silently get access to the target groups. User IDs must be emails;
+// users whose ID has no domain are outside of every allowlist.
+type DomainPolicy struct {
+	allowed    []string
+	action     DomainAction
+	exceptions map[string][]string
+}
+
+// NewDomainPolicy creates a DomainPolicy allowing the users of the given
+// domains, and applying the given action to the others.
+func NewDomainPolicy(allowed []string, action DomainAction) *DomainPolicy {
+	return &DomainPolicy{
+		allowed:    lowerAll(allowed),
+		action:     action,
+		exceptions: make(map[string][]string),
+	}
+}
+
+// WithException additionally allows the users of the given domains in the
+// source group with the given ID.
+func (p *DomainPolicy) WithException(sourceGroupID string, allowed ...string) *DomainPolicy {
+	p.exceptions[sourceGroupID] = append(p.exceptions[sourceGroupID], lowerAll(allowed)...)
+	return p
+}
+
+// Allowed reports whether the user with the given ID is allowed in the source
+// group with the given ID.
+func (p *DomainPolicy) Allowed(sourceGroupID, userID string) bool {
+	_, domain, ok := strings.Cut(userID, "@")
+	if !ok {
+		return false
+	}
+	domain = strings.ToLower(domain)
+	return slices.Contains(p.allowed, domain) || slices.Contains(p.exceptions[sourceGroupID], domain)
+}
+
+// filter returns the given users of the source group with the given ID
+// without the users the policy excludes.
+func (p *DomainPolicy) filter(ctx context.Context, sourceGroupID string, users []*User) []*User {
+	logger := logging.FromContext(ctx)
+	kept := make([]*User, 0, len(users))
+	for _, u := range users {
+		if p.Allowed(sourceGroupID, u.ID) {
+			kept = append(kept, u)
+			continue
+		}
+		logger.WarnContext(ctx, "source user is outside of the allowed domains",
+			"source_group_id", sourceGroupID,
+			"user_id", u.ID,
+			"action", p.action,
+		)
+		if p.action != DomainActionExclude {
+			kept = append(kept, u)
+		}
+	}
+	return kept
+}
+
+func lowerAll(ss []string) []string {
+	lower := make([]string, 0, len(ss))
+	for _, s := range ss {
+		lower = append(lower, strings.ToLower(strings.TrimPrefix(s, "@")))
+	}
+	return lower
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSync_DomainPolicy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		policy *DomainPolicy
+		want   []string
+	}{
+		{
+			name: "no_policy",
+			want: []string{"alice", "carol", "dave"},
+		},
+		{
+			name:   "flag",
+			policy: NewDomainPolicy([]string{"example.com"}, DomainActionFlag),
+			want:   []string{"alice", "carol", "dave"},
+		},
+		{
+			name:   "exclude",
+			policy: NewDomainPolicy([]string{"example.com"}, DomainActionExclude),
+			want:   []string{"alice"},
+		},
+		{
+			name: "exception",
+			policy: NewDomainPolicy([]string{"Example.com"}, DomainActionExclude).
+				WithException("2", "@vendor.com"),
+			want: []string{"alice", "dave"},
+		},
+		{
+			name: "exception_of_other_group",
+			policy: NewDomainPolicy([]string{"example.com"}, DomainActionExclude).
+				WithException("1", "vendor.com"),
+			want: []string{"alice"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sourceGroupClient := &MemoryGroupReadWriter{
+				Members: map[string][]Member{
+					"1": {
+						&UserMember{Usr: &User{ID: "alice@EXAMPLE.com"}},
+						&UserMember{Usr: &User{ID: "carol@contractor.com"}},
+					},
+					"2": {
+						&UserMember{Usr: &User{ID: "dave@vendor.com"}},
+					},
+				},
+			}
+			targetGroupClient := &MemoryGroupReadWriter{
+				Members: map[string][]Member{"99": {}},
+			}
+			var opts []Opt
+			if tc.policy != nil {
+				opts = append(opts, WithDomainPolicy(tc.policy))
+			}
+			syncer := NewManyToManySyncer(
+				"source",
+				"target",
+				sourceGroupClient,
+				targetGroupClient,
+				&testGroupMapper{m: map[string][]string{"1": {"99"}, "2": {"99"}}},
+				&testGroupMapper{m: map[string][]string{"99": {"1", "2"}}},
+				&testUserMapper{m: map[string]string{
+					"alice@EXAMPLE.com":    "alice",
+					"carol@contractor.com": "carol",
+					"dave@vendor.com":      "dave",
+				}},
+				opts...,
+			)
+
+			if err := syncer.Sync(ctx, "1"); err != nil {
+				t.Fatalf("Sync failed: %v", err)
+			}
+			if diff := cmp.Diff(targetIDs(t, targetGroupClient), tc.want); diff != "" {
+				t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const appliedKeyPrefix = "applied"
+
+// AppliedMember is a member of an AppliedState.
+type AppliedMember struct {
+	ID    string `json:"id"`
+	Group bool   `json:"group,omitempty"`
+	Role  string `json:"role,omitempty"`
+}
+
+// AppliedState is the members team-link last applied to a target group. It
+// also marks the group as managed by team-link.
+type AppliedState struct {
+	GroupID   string           `json:"group_id"`
+	Members   []*AppliedMember `json:"members"`
+	AppliedAt time.Time        `json:"applied_at"`
+}
+
+func newAppliedState(groupID string, members []Member, now time.Time) *AppliedState {
+	s := &AppliedState{GroupID: groupID, Members: make([]*AppliedMember, 0, len(members)), AppliedAt: now}
+	for _, m := range members {
+		s.Members = append(s.Members, &AppliedMember{ID: m.ID(), Group: m.IsGroup(), Role: MemberRole(m)})
+	}
+	return s
+}
+
+func (s *AppliedState) members() []Member {
+	members := make([]Member, 0, len(s.Members))
+	for _, m := range s.Members {
+		if m.Group {
+			members = append(members, &GroupMember{Grp: &Group{ID: m.ID}})
+		} else {
+			members = append(members, &UserMember{Usr: &User{ID: m.ID}, Role: m.Role})
+		}
+	}
+	return members
+}
+
+// DriftWriter wraps a GroupReadWriter and records the members applied to
+// each target group by SetMembers in a state store. When the current members
+// of a group differ from the members applied last, the group was changed
+// outside of team-link, e.g. by hand in the UI of the target system, and
+// each such change which SetMembers reverts is logged as a "manual change
+// overwritten" alert. Changes which the write makes anyway are not drift and
+// are not logged. Role changes are only detected for members whose current
+// role is reported by the target system. Reads are passed through.
+type DriftWriter struct {
+	WrappedWriter
+
+	store     state.Store
+	normalize IDNormalizer
+	now       func() time.Time
+}
+
+// NewDriftWriter creates a DriftWriter wrapping rw which records the applied
+// members in store. Member IDs are compared by their form normalized by
+// normalize, unless it is nil, like a NormalizingWriter.
+func NewDriftWriter(rw GroupReadWriter, store state.Store, normalize IDNormalizer) *DriftWriter {
+	return &DriftWriter{
+		WrappedWriter: WrappedWriter{GroupReadWriter: rw},
+		store:         store,
+		normalize:     normalize,
+		now:           time.Now,
+	}
+}
+
+// SetMembers replaces the members of the group with the given ID with the
+// given members, logs the changes made outside of team-link since the last
+// write which it overwrites, and records the applied members. If the write
+// fails, the members are read again and recorded as applied instead.
+func (w *DriftWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	logger := logging.FromContext(ctx)
+	key := state.Key(appliedKeyPrefix, groupID)
+	current, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	var last AppliedState
+	switch err := state.GetJSON(ctx, w.store, key, &last); {
+	case err == nil:
+		w.logOverwritten(ctx, groupID, last.members(), current, members)
+	case errors.Is(err, state.ErrNotFound):
+		// first write of the group.
+	default:
+		logger.WarnContext(ctx, "failed to read applied members, not checking for manual changes",
+			"group_id", groupID,
+			"error", err,
+		)
+	}
+
+	writeErr := w.GroupReadWriter.SetMembers(ctx, groupID, members)
+	applied := members
+	if writeErr != nil {
+		if applied, err = w.GetMembers(ctx, groupID); err != nil {
+			// the group may be partially written, so the record is unknown.
+			if err := w.store.Delete(ctx, key); err != nil {
+				logger.WarnContext(ctx, "failed to delete applied members", "group_id", groupID, "error", err)
+			}
+			return writeErr //nolint:wrapcheck // Want passthrough
+		}
+	}
+	if err := state.PutJSON(ctx, w.store, key, newAppliedState(groupID, applied, w.now().UTC())); err != nil {
+		logger.WarnContext(ctx, "failed to save applied members",
+			"group_id", groupID,
+			"error", err,
+		)
+	}
+	return writeErr //nolint:wrapcheck // Want passthrough
+}
+
+// logOverwritten logs the changes from the last applied to the current
+// members which the write of the desired members reverts.
+func (w *DriftWriter) logOverwritten(ctx context.Context, groupID string, last, current, desired []Member) {
+	var diffOpts []DiffOpt
+	if w.normalize != nil {
+		diffOpts = append(diffOpts, DiffNormalizeIDs(w.normalize))
+	}
+	drift := ComputeDiff(last, current, diffOpts...)
+	if drift.IsEmpty() {
+		return
+	}
+	update := ComputeDiff(current, desired, diffOpts...)
+	removed := w.keys(update.Remove)
+	added := w.keys(update.Add)
+	updated := make(map[string]struct{}, len(update.Update))
+	for _, u := range update.Update {
+		updated[w.key(u.ID())] = struct{}{}
+	}
+	lastRoles := make(map[string]string, len(last))
+	for _, m := range last {
+		lastRoles[w.key(m.ID())] = MemberRole(m)
+	}
+
+	logger := logging.FromContext(ctx)
+	overwritten := func(change string, m Member, role, previousRole string) {
+		memberType := "user"
+		if m.IsGroup() {
+			memberType = "group"
+		}
+		logger.WarnContext(ctx, "manual change overwritten",
+			"alert", true,
+			"group_id", groupID,
+			"member_type", memberType,
+			"member_id", m.ID(),
+			"change", change,
+			"role", role,
+			"previous_role", previousRole,
+		)
+	}
+	// added outside of team-link and removed again.
+	for _, m := range drift.Add {
+		if _, ok := removed[w.key(m.ID())]; ok {
+			overwritten(string(ChangeAdd), m, MemberRole(m), "")
+		}
+	}
+	// removed outside of team-link and added again.
+	for _, m := range drift.Remove {
+		if _, ok := added[w.key(m.ID())]; ok {
+			overwritten(string(ChangeRemove), m, "", MemberRole(m))
+		}
+	}
+	// role changed outside of team-link and changed again.
+	for _, u := range drift.Update {
+		if _, ok := updated[w.key(u.ID())]; ok && u.Role != "" {
+			overwritten(string(ChangeRole), u, u.Role, lastRoles[w.key(u.ID())])
+		}
+	}
+}
+
+func (w *DriftWriter) keys(members []Member) map[string]struct{} {
+	keys := make(map[string]struct{}, len(members))
+	for _, m := range members {
+		keys[w.key(m.ID())] = struct{}{}
+	}
+	return keys
+}
+
+func (w *DriftWriter) key(id string) string {
+	if w.normalize != nil {
+		return w.normalize(id)
+	}
+	return id
+}
+
+// ArchiveGroup archives the group with the wrapped writer and forgets its
+// applied members.
+func (w *DriftWriter) ArchiveGroup(ctx context.Context, groupID string) error {
+	archiver, ok := w.GroupReadWriter.(GroupArchiver)
+	if !ok {
+		return fmt.Errorf("group writer cannot archive group %s", groupID)
+	}
+	if err := archiver.ArchiveGroup(ctx, groupID); err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+	if err := w.store.Delete(ctx, state.Key(appliedKeyPrefix, groupID)); err != nil {
+		return fmt.Errorf("failed to delete applied members of group %s: %w", groupID, err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestDriftWriter_SetMembers(t *testing.T) {
+	t.Parallel()
+
+	var logs bytes.Buffer
+	ctx := logging.WithLogger(context.Background(), logging.New(&logs, slog.LevelWarn, logging.FormatJSON, false))
+	target := NewMemoryGroupReadWriter()
+	target.AddGroup(&Group{ID: "team"})
+	store := state.NewMemoryStore()
+	w := NewDriftWriter(target, store, CaseInsensitiveIDs)
+
+	// the first write has nothing to compare with.
+	if err := w.SetMembers(ctx, "team", []Member{
+		&UserMember{Usr: &User{ID: "alice"}},
+		&UserMember{Usr: &User{ID: "bob"}, Role: "maintainer"},
+		&UserMember{Usr: &User{ID: "carol"}},
+	}); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	if logs.Len() > 0 {
+		t.Errorf("first write logged %s, want nothing", logs.String())
+	}
+
+	// changes outside of team-link.
+	target.Members["team"] = []Member{
+		&UserMember{Usr: &User{ID: "Alice"}, Role: "maintainer"},
+		&UserMember{Usr: &User{ID: "carol"}},
+		&UserMember{Usr: &User{ID: "mallory"}},
+		&UserMember{Usr: &User{ID: "dave"}},
+	}
+
+	// bob is added back, alice's role and mallory are reverted, and dave,
+	// who is desired now, is kept.
+	if err := w.SetMembers(ctx, "team", []Member{
+		&UserMember{Usr: &User{ID: "alice"}},
+		&UserMember{Usr: &User{ID: "bob"}, Role: "maintainer"},
+		&UserMember{Usr: &User{ID: "carol"}},
+		&UserMember{Usr: &User{ID: "dave"}},
+	}); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+
+	var got []string
+	scanner := bufio.NewScanner(&logs)
+	for scanner.Scan() {
+		var entry map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("failed to decode log entry: %v", err)
+		}
+		if entry["alert"] != true || entry["group_id"] != "team" {
+			t.Errorf("unexpected log entry %v", entry)
+		}
+		got = append(got, entry["change"].(string)+":"+entry["member_id"].(string))
+	}
+	want := []string{"add:mallory", "remove:bob", "role:Alice"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected overwritten changes (-got, +want):\n%s", diff)
+	}
+
+	var applied AppliedState
+	if err := state.GetJSON(ctx, store, state.Key(appliedKeyPrefix, "team"), &applied); err != nil {
+		t.Fatalf("failed to read applied members: %v", err)
+	}
+	if diff := cmp.Diff(memberIDs(applied.members()), []string{"alice", "bob", "carol", "dave"}); diff != "" {
+		t.Errorf("unexpected applied members (-got, +want):\n%s", diff)
+	}
+}
@@ -14,6 +14,13 @@
 
 package groupsync
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
 type Error string
 
 func (e Error) Error() string {
@@ -22,3 +29,164 @@ func (e Error) Error() string {
 
 // ErrTargetUserIDNotFound denotes when the user ID for the target system cannot be found.
 const ErrTargetUserIDNotFound = Error("target user ID not found")
+
+// ErrGroupNotFound denotes that a group does not exist in a group system.
+const ErrGroupNotFound = Error("group not found")
+
+// ErrInvalidGroupID denotes that a group ID is not of the form a group system
+// expects, e.g. 'orgID:teamID' for GitHub teams. Connectors wrap their group ID
+// parsing errors with this error.
+const ErrInvalidGroupID = Error("invalid group ID")
+
+// ErrGroupMappingNotFound denotes that a group ID has no mapping to groups of
+// the other group system.
+const ErrGroupMappingNotFound = Error("group mapping not found")
+
+// ErrUnsupportedSystem denotes that a group system, or syncing between a pair
+// of group systems, is not supported, e.g. when no user mapper exists for
+// them.
+const ErrUnsupportedSystem = Error("unsupported group system")
+
+// ErrMemberLimitExceeded denotes that a target group would get more members
+// than its configured limit allows, e.g. because a company-wide source group
+// was mapped by mistake.
+const ErrMemberLimitExceeded = Error("member limit exceeded")
+
+// UserMappingError is the failure to map a source user to target users for a
+// reason other than the user having no mapping, which is not an error.
+type UserMappingError struct {
+	// SourceUserID is the ID of the source user that failed to map.
+	SourceUserID string
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *UserMappingError) Error() string {
+	return fmt.Sprintf("error mapping source user id %s to target user id: %v", e.SourceUserID, e.Err)
+}
+
+func (e *UserMappingError) Unwrap() error {
+	return e.Err
+}
+
+// ErrRateLimited denotes that a group system rejected a request due to rate limiting.
+// Connectors wrap provider specific rate limit errors with this error so that
+// syncers can categorize them without knowing about the provider.
+const ErrRateLimited = Error("rate limited")
+
+// ErrTransient denotes a failure of a group system that is expected to succeed
+// when retried, e.g. an HTTP 5xx response. Connectors wrap such errors with
+// this error so that syncers can retry them.
+const ErrTransient = Error("transient error")
+
+// ErrorCategory classifies why syncing a group failed.
+type ErrorCategory string
+
+const (
+	// ErrorCategoryMapping denotes a failure mapping source users to target users.
+	ErrorCategoryMapping ErrorCategory = "mapping"
+	// ErrorCategoryConfig denotes a failure resolving the configured group mappings.
+	ErrorCategoryConfig ErrorCategory = "config"
+	// ErrorCategoryAPI denotes a failure calling a source or target group system.
+	ErrorCategoryAPI ErrorCategory = "api"
+	// ErrorCategoryRateLimit denotes a source or target group system rate limited the sync.
+	ErrorCategoryRateLimit ErrorCategory = "rate_limit"
+	// ErrorCategoryUnknown denotes a failure that could not be categorized.
+	ErrorCategoryUnknown ErrorCategory = "unknown"
+)
+
+// categorize returns ErrorCategoryRateLimit if err was caused by rate limiting
+// and the given fallback category otherwise.
+func categorize(err error, fallback ErrorCategory) ErrorCategory {
+	if errors.Is(err, ErrRateLimited) {
+		return ErrorCategoryRateLimit
+	}
+	return fallback
+}
+
+// retryable returns whether the given failure is worth retrying within the
+// same run.
+func retryable(ge *GroupError) bool {
+	return ge.Category == ErrorCategoryRateLimit || errors.Is(ge.Err, ErrTransient)
+}
+
+// GroupError is the failure to sync a single source group, or a single target
+// group on behalf of a source group.
+type GroupError struct {
+	// SourceGroupID is the ID of the source group being synced.
+	SourceGroupID string
+	// TargetGroupID is the ID of the target group that failed to sync. It is
+	// empty if the failure happened before any target group was known.
+	TargetGroupID string
+	// Category classifies the failure.
+	Category ErrorCategory
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *GroupError) Error() string {
+	if e.TargetGroupID == "" {
+		return fmt.Sprintf("failed to sync id %s: %v", e.SourceGroupID, e.Err)
+	}
+	return fmt.Sprintf("failed to sync id %s to target group %s: %v", e.SourceGroupID, e.TargetGroupID, e.Err)
+}
+
+func (e *GroupError) Unwrap() error {
+	return e.Err
+}
+
+// SyncError aggregates the per group failures of a sync. Syncers return it
+// so that callers can handle failures by group and category using errors.As
+// rather than by inspecting error strings.
+type SyncError struct {
+	// Errors are the individual group failures, ordered by source group ID
+	// and then target group ID.
+	Errors []*GroupError
+}
+
+func (e *SyncError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, ge := range e.Errors {
+		msgs = append(msgs, ge.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e *SyncError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, ge := range e.Errors {
+		errs = append(errs, ge)
+	}
+	return errs
+}
+
+// ByCategory returns the group failures with the given category.
+func (e *SyncError) ByCategory(category ErrorCategory) []*GroupError {
+	var res []*GroupError
+	for _, ge := range e.Errors {
+		if ge.Category == category {
+			res = append(res, ge)
+		}
+	}
+	return res
+}
+
+// add records a group failure.
+func (e *SyncError) add(ge *GroupError) {
+	e.Errors = append(e.Errors, ge)
+}
+
+// errOrNil returns e if it contains any failures and nil otherwise. This avoids
+// returning a non-nil error interface holding an empty SyncError.
+func (e *SyncError) errOrNil() error {
+	if e == nil || len(e.Errors) == 0 {
+		return nil
+	}
+	slices.SortStableFunc(e.Errors, func(a, b *GroupError) int {
+		if c := strings.Compare(a.SourceGroupID, b.SourceGroupID); c != 0 {
+			return c
+		}
+		return strings.Compare(a.TargetGroupID, b.TargetGroupID)
+	})
+	return e
+}
@@ -0,0 +1,280 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const escalationKeyPrefix = "escalation"
+
+// EscalationPolicy decides how role escalations are applied to target groups.
+// A role escalation gives a user a privileged role, see PrivilegedRole, which
+// is higher than the role the user has in the group, or adds the user with a
+// privileged role.
+type EscalationPolicy string
+
+const (
+	// EscalationAllow applies escalations like other changes. It is the
+	// default.
+	EscalationAllow EscalationPolicy = "allow"
+	// EscalationAudit applies escalations and logs each with a distinct,
+	// high severity event.
+	EscalationAudit EscalationPolicy = "audit"
+	// EscalationRequireApproval holds escalations back until they are
+	// approved, see ApproveEscalation. Until then, added users get the default
+	// role and existing members keep their role. Pending escalations are
+	// recorded in a state store.
+	EscalationRequireApproval EscalationPolicy = "require-approval"
+)
+
+// EscalationPolicies are the supported policies.
+var EscalationPolicies = []EscalationPolicy{EscalationAllow, EscalationAudit, EscalationRequireApproval}
+
+// PrivilegedRole is the lowest privileged role of RoleRanks. Roles ranking
+// as high or higher are privileged.
+const PrivilegedRole = "maintainer"
+
+// ParseEscalationPolicy parses the name of an EscalationPolicy.
+func ParseEscalationPolicy(s string) (EscalationPolicy, error) {
+	if p := EscalationPolicy(s); slices.Contains(EscalationPolicies, p) {
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown escalation policy %q, must be one of: %v", s, EscalationPolicies)
+}
+
+// EscalationRecord is a role escalation held back by
+// EscalationRequireApproval.
+type EscalationRecord struct {
+	GroupID string `json:"group_id"`
+	UserID  string `json:"user_id"`
+	// FromRole is the role of the user in the group when the escalation was
+	// first held back, "" if it was not a member.
+	FromRole    string    `json:"from_role"`
+	ToRole      string    `json:"to_role"`
+	RequestedAt time.Time `json:"requested_at"`
+	Approved    bool      `json:"approved"`
+	ApprovedBy  string    `json:"approved_by,omitempty"`
+}
+
+func escalationKey(groupID, userID string) string {
+	return state.Key(escalationKeyPrefix, groupID, userID)
+}
+
+// PendingEscalations returns the escalations recorded in the store which are
+// held back or approved but not applied yet.
+func PendingEscalations(ctx context.Context, store state.Store) ([]*EscalationRecord, error) {
+	keys, err := store.List(ctx, escalationKeyPrefix+"/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list escalations: %w", err)
+	}
+	records := make([]*EscalationRecord, 0, len(keys))
+	for _, key := range keys {
+		var record EscalationRecord
+		if err := state.GetJSON(ctx, store, key, &record); err != nil {
+			if errors.Is(err, state.ErrNotFound) {
+				// applied since listing
+				continue
+			}
+			return nil, fmt.Errorf("failed to read escalation: %w", err)
+		}
+		records = append(records, &record)
+	}
+	return records, nil
+}
+
+// ApproveEscalation approves the pending escalation of the user with the
+// given ID in the group with the given ID to the given role. The next sync
+// applies it. The role must be the one the escalation is pending for, so
+// that an approval does not carry over to a different escalation.
+func ApproveEscalation(ctx context.Context, store state.Store, groupID, userID, role, approver string) error {
+	key := escalationKey(groupID, userID)
+	var record EscalationRecord
+	if err := state.GetJSON(ctx, store, key, &record); err != nil {
+		return fmt.Errorf("failed to read escalation of %s in group %s: %w", userID, groupID, err)
+	}
+	if record.ToRole != role {
+		return fmt.Errorf("escalation of %s in group %s is pending for role %q, not %q", userID, groupID, record.ToRole, role)
+	}
+	record.Approved = true
+	record.ApprovedBy = approver
+	if err := state.PutJSON(ctx, store, key, &record); err != nil {
+		return fmt.Errorf("failed to save escalation of %s in group %s: %w", userID, groupID, err)
+	}
+	return nil
+}
+
+// EscalationGuard wraps a GroupReadWriter and applies an EscalationPolicy to
+// the role escalations of SetMembers. Reads are passed through.
+type EscalationGuard struct {
+	WrappedWriter
+
+	policy    EscalationPolicy
+	store     state.Store
+	normalize IDNormalizer
+	now       func() time.Time
+}
+
+// NewEscalationGuard creates an EscalationGuard wrapping rw, which records
+// pending escalations in store. Member IDs are compared by their form
+// normalized by normalize, unless it is nil, like a NormalizingWriter.
+func NewEscalationGuard(rw GroupReadWriter, policy EscalationPolicy, store state.Store, normalize IDNormalizer) *EscalationGuard {
+	return &EscalationGuard{
+		WrappedWriter: WrappedWriter{GroupReadWriter: rw},
+		policy:        policy,
+		store:         store,
+		normalize:     normalize,
+		now:           time.Now,
+	}
+}
+
+// SetMembers replaces the members of the group with the given ID with the
+// given members, with their role escalations applied according to the
+// policy.
+func (w *EscalationGuard) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	if w.policy == EscalationAllow {
+		return w.GroupReadWriter.SetMembers(ctx, groupID, members) //nolint:wrapcheck // Want passthrough
+	}
+	current, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentRoles := make(map[string]string, len(current))
+	for _, m := range current {
+		if u, ok := m.(*UserMember); ok {
+			currentRoles[w.key(u.ID())] = u.Role
+		}
+	}
+	var diffOpts []DiffOpt
+	if w.normalize != nil {
+		diffOpts = append(diffOpts, DiffNormalizeIDs(w.normalize))
+	}
+	diff := ComputeDiff(current, members, diffOpts...)
+
+	escalations := make(map[string]string)
+	for _, m := range diff.Add {
+		if u, ok := m.(*UserMember); ok && isPrivileged(u.Role) {
+			escalations[w.key(u.ID())] = ""
+		}
+	}
+	for _, u := range diff.Update {
+		from := currentRoles[w.key(u.ID())]
+		if isPrivileged(u.Role) && slices.Index(RoleRanks, u.Role) > slices.Index(RoleRanks, from) {
+			escalations[w.key(u.ID())] = from
+		}
+	}
+	if len(escalations) == 0 {
+		return w.GroupReadWriter.SetMembers(ctx, groupID, members) //nolint:wrapcheck // Want passthrough
+	}
+
+	logger := logging.FromContext(ctx)
+	var merr error
+	applied := make([]Member, 0, len(members))
+	var approvedKeys []string
+	for _, m := range members {
+		u, ok := m.(*UserMember)
+		from, escalated := "", false
+		if ok {
+			from, escalated = escalations[w.key(u.ID())]
+		}
+		if !escalated {
+			applied = append(applied, m)
+			continue
+		}
+		approved := true
+		if w.policy == EscalationRequireApproval {
+			key, ok, err := w.approval(ctx, groupID, u, from)
+			if err != nil {
+				merr = errors.Join(merr, err)
+			}
+			approved = ok
+			if ok {
+				approvedKeys = append(approvedKeys, key)
+			}
+		}
+		logger.WarnContext(ctx, "role escalation",
+			"severity", "HIGH",
+			"group_id", groupID,
+			"user_id", u.ID(),
+			"from_role", from,
+			"to_role", u.Role,
+			"policy", w.policy,
+			"applied", approved,
+		)
+		if approved {
+			applied = append(applied, m)
+			continue
+		}
+		// hold the escalation back, without holding back the membership.
+		held := *u
+		held.Role = from
+		applied = append(applied, &held)
+	}
+	if err := w.GroupReadWriter.SetMembers(ctx, groupID, applied); err != nil {
+		return errors.Join(merr, err) //nolint:wrapcheck // Want passthrough
+	}
+	for _, key := range approvedKeys {
+		if err := w.store.Delete(ctx, key); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to delete applied escalation: %w", err))
+		}
+	}
+	return merr
+}
+
+// approval reports whether the escalation of the given user in the group with
+// the given ID is approved, along with the key of its record. Escalations
+// without a record for the same role are recorded as pending.
+func (w *EscalationGuard) approval(ctx context.Context, groupID string, u *UserMember, from string) (string, bool, error) {
+	key := escalationKey(groupID, w.key(u.ID()))
+	var record EscalationRecord
+	err := state.GetJSON(ctx, w.store, key, &record)
+	if err != nil && !errors.Is(err, state.ErrNotFound) {
+		return key, false, fmt.Errorf("failed to read escalation of %s in group %s: %w", u.ID(), groupID, err)
+	}
+	if err == nil && record.ToRole == u.Role {
+		return key, record.Approved, nil
+	}
+	record = EscalationRecord{
+		GroupID:     groupID,
+		UserID:      w.key(u.ID()),
+		FromRole:    from,
+		ToRole:      u.Role,
+		RequestedAt: w.now().UTC(),
+	}
+	if err := state.PutJSON(ctx, w.store, key, &record); err != nil {
+		return key, false, fmt.Errorf("failed to save escalation of %s in group %s: %w", u.ID(), groupID, err)
+	}
+	return key, false, nil
+}
+
+func (w *EscalationGuard) key(id string) string {
+	if w.normalize != nil {
+		return w.normalize(id)
+	}
+	return id
+}
+
+// isPrivileged reports whether the role ranks as high as PrivilegedRole or
+// higher. Unknown roles are not privileged.
+func isPrivileged(role string) bool {
+	return slices.Index(RoleRanks, role) >= slices.Index(RoleRanks, PrivilegedRole)
+}
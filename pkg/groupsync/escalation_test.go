@@ -0,0 +1,172 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestEscalationGuard(t *testing.T) {
+	t.Parallel()
+
+	user := func(id, role string) Member {
+		return &UserMember{Usr: &User{ID: id}, Role: role}
+	}
+	desired := []Member{
+		user("alice", "maintainer"),
+		user("bob", "member"),
+		user("carol", "admin"),
+		user("dave", "member"),
+	}
+
+	cases := []struct {
+		name    string
+		policy  EscalationPolicy
+		want    []string
+		pending []string
+	}{
+		{
+			name:   "allow",
+			policy: EscalationAllow,
+			want:   []string{"alice:maintainer", "bob:member", "carol:admin", "dave:member"},
+		},
+		{
+			name:   "audit",
+			policy: EscalationAudit,
+			want:   []string{"alice:maintainer", "bob:member", "carol:admin", "dave:member"},
+		},
+		{
+			name:    "require_approval",
+			policy:  EscalationRequireApproval,
+			want:    []string{"alice:member", "bob:member", "carol:", "dave:member"},
+			pending: []string{"99/alice:member->maintainer", "99/carol:->admin"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			store := state.NewMemoryStore()
+			target := &MemoryGroupReadWriter{Members: map[string][]Member{"99": {
+				user("Alice", "member"),
+				user("bob", "admin"),
+				user("dave", "member"),
+			}}}
+			guard := NewEscalationGuard(target, tc.policy, store, CaseInsensitiveIDs)
+
+			if err := guard.SetMembers(ctx, "99", desired); err != nil {
+				t.Fatalf("SetMembers failed: %v", err)
+			}
+			if diff := cmp.Diff(memberRoles(t, target), tc.want); diff != "" {
+				t.Errorf("unexpected members (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(pendingEscalations(t, store), tc.pending); diff != "" {
+				t.Errorf("unexpected pending escalations (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEscalationGuard_Approval(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := state.NewMemoryStore()
+	target := &MemoryGroupReadWriter{Members: map[string][]Member{"99": {
+		&UserMember{Usr: &User{ID: "alice"}, Role: "member"},
+	}}}
+	guard := NewEscalationGuard(target, EscalationRequireApproval, store, nil)
+	guard.now = func() time.Time { return time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC) }
+	desired := []Member{&UserMember{Usr: &User{ID: "alice"}, Role: "owner"}}
+
+	if err := guard.SetMembers(ctx, "99", desired); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(memberRoles(t, target), []string{"alice:member"}); diff != "" {
+		t.Errorf("unexpected members before approval (-got, +want):\n%s", diff)
+	}
+
+	err := ApproveEscalation(ctx, store, "99", "alice", "admin", "bob")
+	if diff := testutil.DiffErrString(err, `pending for role "owner", not "admin"`); diff != "" {
+		t.Errorf("unexpected err: %s", diff)
+	}
+	if err := ApproveEscalation(ctx, store, "99", "alice", "owner", "bob"); err != nil {
+		t.Fatalf("ApproveEscalation failed: %v", err)
+	}
+	records, err := PendingEscalations(ctx, store)
+	if err != nil {
+		t.Fatalf("PendingEscalations failed: %v", err)
+	}
+	want := []*EscalationRecord{{
+		GroupID:     "99",
+		UserID:      "alice",
+		FromRole:    "member",
+		ToRole:      "owner",
+		RequestedAt: time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC),
+		Approved:    true,
+		ApprovedBy:  "bob",
+	}}
+	if diff := cmp.Diff(records, want); diff != "" {
+		t.Errorf("unexpected escalations (-got, +want):\n%s", diff)
+	}
+
+	if err := guard.SetMembers(ctx, "99", desired); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(memberRoles(t, target), []string{"alice:owner"}); diff != "" {
+		t.Errorf("unexpected members after approval (-got, +want):\n%s", diff)
+	}
+	if got := pendingEscalations(t, store); len(got) > 0 {
+		t.Errorf("applied escalations are still pending: %v", got)
+	}
+}
+
+func memberRoles(tb testing.TB, client *MemoryGroupReadWriter) []string {
+	tb.Helper()
+
+	members, err := client.GetMembers(context.Background(), "99")
+	if err != nil {
+		tb.Fatalf("failed to get target group members: %v", err)
+	}
+	got := make([]string, 0, len(members))
+	for _, m := range members {
+		got = append(got, fmt.Sprintf("%s:%s", m.ID(), m.(*UserMember).Role))
+	}
+	return got
+}
+
+func pendingEscalations(tb testing.TB, store state.Store) []string {
+	tb.Helper()
+
+	records, err := PendingEscalations(context.Background(), store)
+	if err != nil {
+		tb.Fatalf("failed to list escalations: %v", err)
+	}
+	var got []string
+	for _, r := range records {
+		got = append(got, fmt.Sprintf("%s/%s:%s->%s", r.GroupID, r.UserID, r.FromRole, r.ToRole))
+	}
+	return got
+}
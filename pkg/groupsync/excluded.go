@@ -0,0 +1,108 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+// Ensure we conform to the interfaces.
+var (
+	_ ChangeLogReader     = (*ExcludingGroupReader)(nil)
+	_ SuspendedUserReader = (*ExcludingGroupReader)(nil)
+)
+
+// ExcludingGroupReader wraps a GroupReader so that some groups are never
+// expanded when they are nested in other groups, e.g. an "all-employees"
+// group nested in a team group, which would otherwise grant the whole
+// organization access to the target groups. Excluded groups are dropped from
+// the members of the groups they are nested in, and descendants are expanded
+// level by level from those members instead of by the wrapped reader. Groups
+// which are read directly, e.g. because they are mapped, are still expanded.
+type ExcludingGroupReader struct {
+	GroupReader
+	excluded map[string]struct{}
+}
+
+// NewExcludingGroupReader creates an ExcludingGroupReader which excludes the
+// groups with the given IDs.
+func NewExcludingGroupReader(reader GroupReader, excluded []string) *ExcludingGroupReader {
+	ids := make(map[string]struct{}, len(excluded))
+	for _, id := range excluded {
+		ids[id] = struct{}{}
+	}
+	return &ExcludingGroupReader{GroupReader: reader, excluded: ids}
+}
+
+// IsExcluded reports whether the group with the given ID is excluded.
+func (r *ExcludingGroupReader) IsExcluded(groupID string) bool {
+	_, ok := r.excluded[groupID]
+	return ok
+}
+
+// GetMembers returns the members of the group with the given ID, without the
+// excluded groups.
+func (r *ExcludingGroupReader) GetMembers(ctx context.Context, groupID string) ([]Member, error) {
+	members, err := r.GroupReader.GetMembers(ctx, groupID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	kept := make([]Member, 0, len(members))
+	for _, m := range members {
+		if m.IsGroup() && r.IsExcluded(m.ID()) {
+			logging.FromContext(ctx).InfoContext(ctx, "skipping excluded nested group",
+				"group_id", groupID,
+				"excluded_group_id", m.ID(),
+			)
+			continue
+		}
+		kept = append(kept, m)
+	}
+	return kept, nil
+}
+
+// Descendants retrieves all users of the group with the given ID, without
+// expanding the excluded groups nested in it.
+func (r *ExcludingGroupReader) Descendants(ctx context.Context, groupID string) ([]*User, error) {
+	users, err := Descendants(ctx, groupID, r.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// ChangedGroupIDs returns the groups of the wrapped reader which changed
+// since the given time, if it is a ChangeLogReader.
+func (r *ExcludingGroupReader) ChangedGroupIDs(ctx context.Context, since time.Time) ([]string, error) {
+	changeLog, ok := r.GroupReader.(ChangeLogReader)
+	if !ok {
+		return nil, fmt.Errorf("group reader does not support listing changed groups")
+	}
+	return changeLog.ChangedGroupIDs(ctx, since) //nolint:wrapcheck // Want passthrough
+}
+
+// IsSuspended reports whether the user with the given ID is suspended, if
+// the wrapped reader is a SuspendedUserReader.
+func (r *ExcludingGroupReader) IsSuspended(ctx context.Context, userID string) (bool, error) {
+	reader, ok := r.GroupReader.(SuspendedUserReader)
+	if !ok {
+		return false, fmt.Errorf("group reader does not report suspended users")
+	}
+	return reader.IsSuspended(ctx, userID) //nolint:wrapcheck // Want passthrough
+}
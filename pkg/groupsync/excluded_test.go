@@ -0,0 +1,90 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExcludingGroupReader(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	source := NewMemoryGroupReadWriter()
+	source.AddGroup(&Group{ID: "all-employees"},
+		&UserMember{Usr: &User{ID: "alice"}},
+		&UserMember{Usr: &User{ID: "bob"}},
+		&UserMember{Usr: &User{ID: "carol"}},
+		&UserMember{Usr: &User{ID: "dave"}},
+	)
+	source.AddGroup(&Group{ID: "sre"},
+		&UserMember{Usr: &User{ID: "bob"}},
+		&GroupMember{Grp: &Group{ID: "all-employees"}},
+	)
+	source.AddGroup(&Group{ID: "team"},
+		&UserMember{Usr: &User{ID: "alice"}},
+		&GroupMember{Grp: &Group{ID: "sre"}},
+		&GroupMember{Grp: &Group{ID: "all-employees"}},
+	)
+
+	r := NewExcludingGroupReader(source, []string{"all-employees"})
+
+	cases := []struct {
+		name  string
+		group string
+		want  []string
+	}{
+		{
+			name:  "prunes_nested_group",
+			group: "team",
+			want:  []string{"alice", "bob"},
+		},
+		{
+			name:  "prunes_deeply_nested_group",
+			group: "sre",
+			want:  []string{"bob"},
+		},
+		{
+			name:  "expands_excluded_group_read_directly",
+			group: "all-employees",
+			want:  []string{"alice", "bob", "carol", "dave"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			users, err := r.Descendants(ctx, tc.group)
+			if err != nil {
+				t.Fatalf("Descendants failed: %v", err)
+			}
+			if diff := cmp.Diff(userIDsOf(users), tc.want); diff != "" {
+				t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+			}
+		})
+	}
+
+	members, err := r.GetMembers(ctx, "team")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(memberIDs(members), []string{"alice", "sre"}); diff != "" {
+		t.Errorf("unexpected members (-got, +want):\n%s", diff)
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// GroupExpr is a set expression over the users of groups, e.g.
+// "eng-all minus interns". Operands are group IDs, quoted if they contain
+// spaces or parentheses, and operators are "union", "intersect" and "minus".
+// Intersect binds tighter than union and minus, which are evaluated from left
+// to right, and parentheses group sub-expressions.
+type GroupExpr interface {
+	// GroupIDs returns the IDs of the groups the expression refers to, in
+	// order of appearance.
+	GroupIDs() []string
+
+	// String returns the expression in its canonical form.
+	String() string
+
+	eval(ctx context.Context, users func(ctx context.Context, groupID string) ([]*User, error)) ([]*User, error)
+}
+
+// Operators of group expressions.
+const (
+	GroupExprUnion     = "union"
+	GroupExprIntersect = "intersect"
+	GroupExprMinus     = "minus"
+)
+
+// EvalGroupExpr returns the users of the expression, given the users of each
+// group it refers to. Users are compared by ID and returned in order of first
+// appearance.
+func EvalGroupExpr(ctx context.Context, expr GroupExpr, users func(ctx context.Context, groupID string) ([]*User, error)) ([]*User, error) {
+	return expr.eval(ctx, users)
+}
+
+// groupRef is a group operand of an expression.
+type groupRef string
+
+func (g groupRef) GroupIDs() []string {
+	return []string{string(g)}
+}
+
+func (g groupRef) String() string {
+	s := string(g)
+	if s == "" || strings.ContainsFunc(s, func(r rune) bool { return unicode.IsSpace(r) || r == '(' || r == ')' || r == '"' }) ||
+		isGroupExprOperator(s) {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+func (g groupRef) eval(ctx context.Context, users func(ctx context.Context, groupID string) ([]*User, error)) ([]*User, error) {
+	return users(ctx, string(g))
+}
+
+// groupOp is an operator applied to two expressions.
+type groupOp struct {
+	op          string
+	left, right GroupExpr
+}
+
+func (o *groupOp) GroupIDs() []string {
+	return append(o.left.GroupIDs(), o.right.GroupIDs()...)
+}
+
+func (o *groupOp) String() string {
+	// operands which would bind differently without parentheses are
+	// parenthesized, e.g. the right operand of a left-associative operator.
+	operand := func(e GroupExpr, right bool) string {
+		op, ok := e.(*groupOp)
+		if !ok {
+			return e.String()
+		}
+		if o.op == GroupExprIntersect && (op.op != GroupExprIntersect || right) ||
+			o.op != GroupExprIntersect && right && op.op != GroupExprIntersect {
+			return "(" + op.String() + ")"
+		}
+		return op.String()
+	}
+	return operand(o.left, false) + " " + o.op + " " + operand(o.right, true)
+}
+
+func (o *groupOp) eval(ctx context.Context, users func(ctx context.Context, groupID string) ([]*User, error)) ([]*User, error) {
+	left, err := o.left.eval(ctx, users)
+	if err != nil {
+		return nil, err
+	}
+	right, err := o.right.eval(ctx, users)
+	if err != nil {
+		return nil, err
+	}
+	rightIDs := make(map[string]struct{}, len(right))
+	for _, u := range right {
+		rightIDs[u.ID] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(left))
+	var result []*User
+	add := func(u *User) {
+		if _, ok := seen[u.ID]; !ok {
+			seen[u.ID] = struct{}{}
+			result = append(result, u)
+		}
+	}
+	for _, u := range left {
+		_, inRight := rightIDs[u.ID]
+		switch {
+		case o.op == GroupExprUnion,
+			o.op == GroupExprIntersect && inRight,
+			o.op == GroupExprMinus && !inRight:
+			add(u)
+		}
+	}
+	if o.op == GroupExprUnion {
+		for _, u := range right {
+			add(u)
+		}
+	}
+	return result, nil
+}
+
+func isGroupExprOperator(s string) bool {
+	switch strings.ToLower(s) {
+	case GroupExprUnion, GroupExprIntersect, GroupExprMinus:
+		return true
+	}
+	return false
+}
+
+// ParseGroupExpr parses a group expression, see GroupExpr.
+func ParseGroupExpr(s string) (GroupExpr, error) {
+	tokens, err := tokenizeGroupExpr(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid group expression %q: %w", s, err)
+	}
+	p := &groupExprParser{tokens: tokens}
+	expr, err := p.parseExpr()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %s", p.tokens[p.pos])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid group expression %q: %w", s, err)
+	}
+	return expr, nil
+}
+
+// groupExprToken is a token of a group expression. Quoted group IDs are never
+// operators or parentheses.
+type groupExprToken struct {
+	text   string
+	quoted bool
+}
+
+func (t groupExprToken) String() string {
+	return strconv.Quote(t.text)
+}
+
+func (t groupExprToken) is(s string) bool {
+	return !t.quoted && strings.EqualFold(t.text, s)
+}
+
+func tokenizeGroupExpr(s string) ([]groupExprToken, error) {
+	var tokens []groupExprToken
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, groupExprToken{text: string(c)})
+			i++
+		case c == '"':
+			prefix, err := strconv.QuotedPrefix(s[i:])
+			if err != nil {
+				return nil, fmt.Errorf("unterminated quoted group ID at offset %d", i)
+			}
+			id, _ := strconv.Unquote(prefix)
+			tokens = append(tokens, groupExprToken{text: id, quoted: true})
+			i += len(prefix)
+		default:
+			end := strings.IndexAny(s[i:], " \t\n()\"")
+			if end < 0 {
+				end = len(s) - i
+			}
+			tokens = append(tokens, groupExprToken{text: s[i : i+end]})
+			i += end
+		}
+	}
+	return tokens, nil
+}
+
+type groupExprParser struct {
+	tokens []groupExprToken
+	pos    int
+}
+
+func (p *groupExprParser) peek(s string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].is(s)
+}
+
+// parseExpr parses operands joined by union and minus.
+func (p *groupExprParser) parseExpr() (GroupExpr, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek(GroupExprUnion) || p.peek(GroupExprMinus) {
+		op := strings.ToLower(p.tokens[p.pos].text)
+		p.pos++
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = &groupOp{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseTerm parses operands joined by intersect.
+func (p *groupExprParser) parseTerm() (GroupExpr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek(GroupExprIntersect) {
+		p.pos++
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		left = &groupOp{op: GroupExprIntersect, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseOperand parses a group ID or a parenthesized expression.
+func (p *groupExprParser) parseOperand() (GroupExpr, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("missing group ID at end of expression")
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	switch {
+	case t.is("("):
+		expr, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.peek(")") {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return expr, nil
+	case t.is(")"), !t.quoted && isGroupExprOperator(t.text):
+		return nil, fmt.Errorf("expected group ID, got %s", t)
+	case t.text == "":
+		return nil, fmt.Errorf("empty group ID")
+	}
+	return groupRef(t.text), nil
+}
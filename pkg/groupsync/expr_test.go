@@ -0,0 +1,150 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestParseGroupExpr(t *testing.T) {
+	t.Parallel()
+
+	groups := map[string][]string{
+		"eng-all":      {"alice", "bob", "carol", "dave"},
+		"interns":      {"carol"},
+		"contractors":  {"dave"},
+		"sre":          {"bob", "erin"},
+		"on call (eu)": {"erin"},
+	}
+
+	cases := []struct {
+		name         string
+		expr         string
+		wantString   string
+		wantGroupIDs []string
+		wantUsers    []string
+		wantErr      string
+	}{
+		{
+			name:         "minus",
+			expr:         "eng-all minus interns",
+			wantString:   "eng-all minus interns",
+			wantGroupIDs: []string{"eng-all", "interns"},
+			wantUsers:    []string{"alice", "bob", "dave"},
+		},
+		{
+			name:         "left_associative",
+			expr:         "eng-all MINUS interns minus contractors",
+			wantString:   "eng-all minus interns minus contractors",
+			wantGroupIDs: []string{"eng-all", "interns", "contractors"},
+			wantUsers:    []string{"alice", "bob"},
+		},
+		{
+			name:         "intersect_binds_tighter",
+			expr:         "interns union eng-all intersect sre",
+			wantString:   "interns union eng-all intersect sre",
+			wantGroupIDs: []string{"interns", "eng-all", "sre"},
+			wantUsers:    []string{"carol", "bob"},
+		},
+		{
+			name:         "parentheses",
+			expr:         `(interns union eng-all) intersect (sre union "on call (eu)")`,
+			wantString:   `(interns union eng-all) intersect (sre union "on call (eu)")`,
+			wantGroupIDs: []string{"interns", "eng-all", "sre", "on call (eu)"},
+			wantUsers:    []string{"bob"},
+		},
+		{
+			name:         "right_operand_parenthesized",
+			expr:         "eng-all minus (interns union contractors)",
+			wantString:   "eng-all minus (interns union contractors)",
+			wantGroupIDs: []string{"eng-all", "interns", "contractors"},
+			wantUsers:    []string{"alice", "bob"},
+		},
+		{
+			name:         "single_group",
+			expr:         "sre",
+			wantString:   "sre",
+			wantGroupIDs: []string{"sre"},
+			wantUsers:    []string{"bob", "erin"},
+		},
+		{
+			name:    "missing_operand",
+			expr:    "eng-all minus",
+			wantErr: `invalid group expression "eng-all minus": missing group ID at end of expression`,
+		},
+		{
+			name:    "missing_operator",
+			expr:    "eng-all interns",
+			wantErr: `unexpected "interns"`,
+		},
+		{
+			name:    "unbalanced",
+			expr:    "(eng-all minus interns",
+			wantErr: "missing closing parenthesis",
+		},
+		{
+			name:    "operator_as_operand",
+			expr:    "union minus interns",
+			wantErr: `expected group ID, got "union"`,
+		},
+		{
+			name:    "unterminated_quote",
+			expr:    `"eng-all minus interns`,
+			wantErr: "unterminated quoted group ID at offset 0",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := ParseGroupExpr(tc.expr)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Fatal(diff)
+			}
+			if err != nil {
+				return
+			}
+			if got := expr.String(); got != tc.wantString {
+				t.Errorf("String got %q, want %q", got, tc.wantString)
+			}
+			if diff := cmp.Diff(expr.GroupIDs(), tc.wantGroupIDs); diff != "" {
+				t.Errorf("unexpected group IDs (-got, +want):\n%s", diff)
+			}
+			users, err := EvalGroupExpr(context.Background(), expr, func(ctx context.Context, groupID string) ([]*User, error) {
+				var users []*User
+				for _, id := range groups[groupID] {
+					users = append(users, &User{ID: id})
+				}
+				return users, nil
+			})
+			if err != nil {
+				t.Fatalf("EvalGroupExpr failed: %v", err)
+			}
+			var got []string
+			for _, u := range users {
+				got = append(got, u.ID)
+			}
+			if diff := cmp.Diff(got, tc.wantUsers); diff != "" {
+				t.Errorf("unexpected users (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
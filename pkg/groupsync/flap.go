@@ -0,0 +1,220 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const flapKeyPrefix = "flap"
+
+// FlapRecord tracks the recent additions to and removals from a target group
+// of a single member. A member which changed too often is flapping, usually
+// because of conflicting mappings, and changes to it are suppressed until
+// the record is cleared.
+type FlapRecord struct {
+	GroupID  string `json:"group_id"`
+	MemberID string `json:"member_id"`
+	// Changes are the times the member was added or removed within the
+	// window, oldest first.
+	Changes      []time.Time `json:"changes"`
+	Suppressed   bool        `json:"suppressed"`
+	SuppressedAt time.Time   `json:"suppressed_at,omitempty"`
+}
+
+// FlapDetector persists the membership changes of target groups in a
+// state.Store and suppresses changes of members which flap.
+type FlapDetector struct {
+	store     state.Store
+	threshold int
+	window    time.Duration
+	now       func() time.Time
+}
+
+// NewFlapDetector creates a FlapDetector which suppresses changes of members
+// that were added or removed more than threshold times within window.
+func NewFlapDetector(store state.Store, threshold int, window time.Duration) *FlapDetector {
+	return &FlapDetector{
+		store:     store,
+		threshold: threshold,
+		window:    window,
+		now:       time.Now,
+	}
+}
+
+// Records returns the flap records of all members which are suppressed.
+func (d *FlapDetector) Records(ctx context.Context) ([]*FlapRecord, error) {
+	keys, err := d.store.List(ctx, flapKeyPrefix+"/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flap records: %w", err)
+	}
+	var records []*FlapRecord
+	for _, key := range keys {
+		var record FlapRecord
+		if err := state.GetJSON(ctx, d.store, key, &record); err != nil {
+			if errors.Is(err, state.ErrNotFound) {
+				// deleted since listing
+				continue
+			}
+			return nil, fmt.Errorf("failed to read flap record: %w", err)
+		}
+		if record.Suppressed {
+			records = append(records, &record)
+		}
+	}
+	return records, nil
+}
+
+// Clear removes the record of the given member of the given target group so
+// that changes to it are applied again.
+func (d *FlapDetector) Clear(ctx context.Context, groupID, memberID string) error {
+	if err := d.store.Delete(ctx, flapKey(groupID, memberID)); err != nil {
+		return fmt.Errorf("failed to clear flap record: %w", err)
+	}
+	return nil
+}
+
+// flapResult is the outcome of filtering the changes to a target group.
+type flapResult struct {
+	// members are the members to set, without the suppressed changes.
+	members []Member
+	// changed are the IDs of the members which are added or removed.
+	changed []string
+	// suppressed are the IDs of the members whose change was suppressed.
+	suppressed []string
+	// newlySuppressed are the records of the members which started flapping.
+	newlySuppressed []*FlapRecord
+}
+
+// filter returns the given desired members of the target group without the
+// additions and removals of members which flap, given its current members.
+func (d *FlapDetector) filter(ctx context.Context, groupID string, current, desired []Member) (*flapResult, error) {
+	currentByID := make(map[string]Member, len(current))
+	for _, m := range current {
+		currentByID[m.ID()] = m
+	}
+	desiredByID := make(map[string]Member, len(desired))
+	for _, m := range desired {
+		desiredByID[m.ID()] = m
+	}
+
+	result := &flapResult{}
+	since := d.now().Add(-d.window)
+	for _, m := range desired {
+		if _, ok := currentByID[m.ID()]; ok {
+			result.members = append(result.members, m)
+			continue
+		}
+		suppress, err := d.suppress(ctx, groupID, m.ID(), since, result)
+		if err != nil {
+			return nil, err
+		}
+		if !suppress {
+			result.members = append(result.members, m)
+		}
+	}
+	for _, m := range current {
+		if _, ok := desiredByID[m.ID()]; ok {
+			continue
+		}
+		suppress, err := d.suppress(ctx, groupID, m.ID(), since, result)
+		if err != nil {
+			return nil, err
+		}
+		if suppress {
+			result.members = append(result.members, m)
+		}
+	}
+	return result, nil
+}
+
+// suppress reports whether the change of the given member must be
+// suppressed, and records the outcome in result.
+func (d *FlapDetector) suppress(ctx context.Context, groupID, memberID string, since time.Time, result *flapResult) (bool, error) {
+	record, err := d.record(ctx, groupID, memberID)
+	if err != nil {
+		return false, err
+	}
+	if record.Suppressed {
+		result.suppressed = append(result.suppressed, memberID)
+		return true, nil
+	}
+	if d.threshold > 0 && len(recentChanges(record.Changes, since))+1 > d.threshold {
+		record.Suppressed = true
+		record.SuppressedAt = d.now().UTC()
+		if err := state.PutJSON(ctx, d.store, flapKey(groupID, memberID), record); err != nil {
+			return false, fmt.Errorf("failed to update flap record: %w", err)
+		}
+		result.suppressed = append(result.suppressed, memberID)
+		result.newlySuppressed = append(result.newlySuppressed, record)
+		return true, nil
+	}
+	result.changed = append(result.changed, memberID)
+	return false, nil
+}
+
+// recordChanges records that the given members of the target group were
+// added or removed.
+func (d *FlapDetector) recordChanges(ctx context.Context, groupID string, memberIDs []string) error {
+	now := d.now().UTC()
+	since := now.Add(-d.window)
+	var merr error
+	for _, id := range memberIDs {
+		record, err := d.record(ctx, groupID, id)
+		if err != nil {
+			merr = errors.Join(merr, err)
+			continue
+		}
+		record.Changes = append(recentChanges(record.Changes, since), now)
+		merr = errors.Join(merr, state.PutJSON(ctx, d.store, flapKey(groupID, id), record))
+	}
+	if merr != nil {
+		return fmt.Errorf("failed to update flap records: %w", merr)
+	}
+	return nil
+}
+
+// record returns the record of the given member, or an empty record if it
+// has none.
+func (d *FlapDetector) record(ctx context.Context, groupID, memberID string) (*FlapRecord, error) {
+	var record FlapRecord
+	if err := state.GetJSON(ctx, d.store, flapKey(groupID, memberID), &record); err != nil {
+		if errors.Is(err, state.ErrNotFound) {
+			return &FlapRecord{GroupID: groupID, MemberID: memberID}, nil
+		}
+		return nil, fmt.Errorf("failed to read flap record: %w", err)
+	}
+	return &record, nil
+}
+
+// recentChanges returns the changes after since.
+func recentChanges(changes []time.Time, since time.Time) []time.Time {
+	recent := make([]time.Time, 0, len(changes))
+	for _, t := range changes {
+		if t.After(since) {
+			recent = append(recent, t)
+		}
+	}
+	return recent
+}
+
+func flapKey(groupID, memberID string) string {
+	return state.Key(flapKeyPrefix, groupID, memberID)
+}
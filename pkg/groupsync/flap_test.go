@@ -0,0 +1,118 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestSync_FlapDetector(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	alice := &UserMember{Usr: &User{ID: "alice@example.com"}}
+	bob := &UserMember{Usr: &User{ID: "bob@example.com"}}
+
+	sourceGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{"1": {alice}},
+	}
+	targetGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{"99": {}},
+	}
+	detector := NewFlapDetector(state.NewMemoryStore(), 2, time.Hour)
+	detector.now = func() time.Time { return now }
+	syncer := NewManyToManySyncer(
+		"source",
+		"target",
+		sourceGroupClient,
+		targetGroupClient,
+		&testGroupMapper{m: map[string][]string{"1": {"99"}}},
+		&testGroupMapper{m: map[string][]string{"99": {"1"}}},
+		&testUserMapper{m: map[string]string{
+			"alice@example.com": "alice",
+			"bob@example.com":   "bob",
+		}},
+		WithFlapDetector(detector),
+	)
+
+	// bob is added, removed and added again within the window. The third
+	// change exceeds the threshold and is suppressed.
+	runs := []struct {
+		source []Member
+		want   []string
+	}{
+		{source: []Member{alice, bob}, want: []string{"alice", "bob"}},
+		{source: []Member{alice}, want: []string{"alice"}},
+		{source: []Member{alice, bob}, want: []string{"alice"}},
+		{source: []Member{alice}, want: []string{"alice"}},
+	}
+	for i, run := range runs {
+		sourceGroupClient.Members["1"] = run.source
+		now = now.Add(10 * time.Minute)
+		if err := syncer.Sync(ctx, "1"); err != nil {
+			t.Fatalf("Sync %d failed: %v", i, err)
+		}
+		if diff := cmp.Diff(targetIDs(t, targetGroupClient), run.want); diff != "" {
+			t.Errorf("unexpected target group members after run %d (-got, +want):\n%s", i, diff)
+		}
+	}
+
+	records, err := detector.Records(ctx)
+	if err != nil {
+		t.Fatalf("Records failed: %v", err)
+	}
+	if diff := cmp.Diff(records, []*FlapRecord{{
+		GroupID:      "99",
+		MemberID:     "bob",
+		Changes:      []time.Time{now.Add(-30 * time.Minute), now.Add(-20 * time.Minute)},
+		Suppressed:   true,
+		SuppressedAt: now.Add(-10 * time.Minute),
+	}}); diff != "" {
+		t.Errorf("unexpected flap records (-got, +want):\n%s", diff)
+	}
+
+	// once cleared, bob's changes are applied again.
+	if err := detector.Clear(ctx, "99", "bob"); err != nil {
+		t.Fatalf("Clear failed: %v", err)
+	}
+	sourceGroupClient.Members["1"] = []Member{alice, bob}
+	if err := syncer.Sync(ctx, "1"); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	if diff := cmp.Diff(targetIDs(t, targetGroupClient), []string{"alice", "bob"}); diff != "" {
+		t.Errorf("unexpected target group members after clearing (-got, +want):\n%s", diff)
+	}
+}
+
+func targetIDs(tb testing.TB, client *MemoryGroupReadWriter) []string {
+	tb.Helper()
+
+	members, err := client.GetMembers(context.Background(), "99")
+	if err != nil {
+		tb.Fatalf("failed to get target group members: %v", err)
+	}
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.ID())
+	}
+	return ids
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import "sync"
+
+// flight deduplicates concurrent calls with the same key: calls which start
+// while a call with their key is in flight wait for it and share its result,
+// instead of repeating it.
+type flight[T any] struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall[T]
+	// joined is called when a call joins the call with its key in flight,
+	// if it is set, so that tests can wait for the calls to join.
+	joined func(key string)
+}
+
+type flightCall[T any] struct {
+	done chan struct{}
+	val  T
+	err  error
+}
+
+// do calls fn, unless a call with the same key is in flight, in which case it
+// returns the result of that call.
+func (f *flight[T]) do(key string, fn func() (T, error)) (T, error) {
+	f.mu.Lock()
+	if f.calls == nil {
+		f.calls = make(map[string]*flightCall[T])
+	}
+	if c, ok := f.calls[key]; ok {
+		f.mu.Unlock()
+		if f.joined != nil {
+			f.joined(key)
+		}
+		<-c.done
+		return c.val, c.err
+	}
+	c := &flightCall[T]{done: make(chan struct{})}
+	f.calls[key] = c
+	f.mu.Unlock()
+
+	c.val, c.err = fn()
+	close(c.done)
+
+	f.mu.Lock()
+	delete(f.calls, key)
+	f.mu.Unlock()
+	return c.val, c.err
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFlight(t *testing.T) {
+	t.Parallel()
+
+	var f flight[int]
+	var joined sync.WaitGroup
+	f.joined = func(string) { joined.Done() }
+	var calls atomic.Int32
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	var wg sync.WaitGroup
+	results := make([]int, 5)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[0], _ = f.do("a", func() (int, error) {
+			close(started)
+			<-release
+			return int(calls.Add(1)), nil
+		})
+	}()
+	<-started
+	for i := 1; i < len(results); i++ {
+		wg.Add(1)
+		joined.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = f.do("a", func() (int, error) {
+				return int(calls.Add(1)), nil
+			})
+		}()
+	}
+	// the call in flight lands once all other calls joined it.
+	joined.Wait()
+	close(release)
+	wg.Wait()
+
+	if got := int(calls.Load()); got != 1 {
+		t.Errorf("got %d calls, want 1", got)
+	}
+	for i, got := range results {
+		if got != 1 {
+			t.Errorf("call %d got %d, want 1", i, got)
+		}
+	}
+
+	// calls after the flight landed are made again.
+	got, err := f.do("a", func() (int, error) { return 42, nil })
+	if err != nil || got != 42 {
+		t.Errorf("do after landing got (%d, %v), want (42, nil)", got, err)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FreezeWindow is a period during which syncers defer removals from target
+// groups, e.g. an on-call handover or a change freeze.
+type FreezeWindow interface {
+	// Contains reports whether the given time is within the window.
+	Contains(t time.Time) bool
+}
+
+// WeeklyFreezeWindow is a freeze window recurring every week, e.g. from
+// Friday 17:00 to Monday 09:00. A window ending at or before its start on the
+// same day spans the whole week.
+type WeeklyFreezeWindow struct {
+	StartDay time.Weekday
+	// Start is the start of the window as the time since midnight of
+	// StartDay.
+	Start  time.Duration
+	EndDay time.Weekday
+	// End is the end of the window as the time since midnight of EndDay.
+	End time.Duration
+	// Location is the time zone of the window, UTC if nil.
+	Location *time.Location
+}
+
+// Contains reports whether the given time is within the window.
+func (w *WeeklyFreezeWindow) Contains(t time.Time) bool {
+	if w.Location != nil {
+		t = t.In(w.Location)
+	} else {
+		t = t.UTC()
+	}
+	const week = 7 * 24 * time.Hour
+	sinceMidnight := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	offset := func(day time.Weekday, d time.Duration) time.Duration {
+		return time.Duration(day)*24*time.Hour + d
+	}
+	now := offset(t.Weekday(), sinceMidnight)
+	start, end := offset(w.StartDay, w.Start), offset(w.EndDay, w.End)
+	// the length of the window and the time since it started, both modulo a
+	// week.
+	length := ((end-start)%week + week) % week
+	if length == 0 {
+		return true
+	}
+	return ((now-start)%week+week)%week < length
+}
+
+func (w *WeeklyFreezeWindow) String() string {
+	return fmt.Sprintf("%s %s-%s %s", w.StartDay.String()[:3], clock(w.Start), w.EndDay.String()[:3], clock(w.End))
+}
+
+// DateFreezeWindow is a freeze window between two points in time, e.g. a
+// change freeze over the holidays.
+type DateFreezeWindow struct {
+	Start time.Time
+	// End is exclusive.
+	End time.Time
+}
+
+// Contains reports whether the given time is within the window.
+func (w *DateFreezeWindow) Contains(t time.Time) bool {
+	return !t.Before(w.Start) && t.Before(w.End)
+}
+
+func (w *DateFreezeWindow) String() string {
+	return w.Start.Format(time.RFC3339) + "/" + w.End.Format(time.RFC3339)
+}
+
+// ParseFreezeWindow parses a freeze window. Weekly windows have the form
+// "Fri 17:00-Mon 09:00". Date windows have the form "2026-12-20/2027-01-04",
+// where both dates are included, or "2026-12-20T00:00:00Z/2027-01-05T00:00:00Z"
+// for RFC 3339 times, where the end is excluded. Weekly windows and dates are
+// in the given time zone, UTC if nil.
+func ParseFreezeWindow(spec string, loc *time.Location) (FreezeWindow, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	spec = strings.TrimSpace(spec)
+	if startSpec, endSpec, ok := strings.Cut(spec, "/"); ok {
+		if start, err := time.ParseInLocation(time.DateOnly, startSpec, loc); err == nil {
+			end, err := time.ParseInLocation(time.DateOnly, endSpec, loc)
+			if err != nil {
+				return nil, fmt.Errorf("invalid end date of freeze window %q: %w", spec, err)
+			}
+			w := &DateFreezeWindow{Start: start, End: end.AddDate(0, 0, 1)}
+			if !w.Start.Before(w.End) {
+				return nil, fmt.Errorf("freeze window %q ends before it starts", spec)
+			}
+			return w, nil
+		}
+		start, err := time.Parse(time.RFC3339, startSpec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start of freeze window %q: %w", spec, err)
+		}
+		end, err := time.Parse(time.RFC3339, endSpec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end of freeze window %q: %w", spec, err)
+		}
+		if !start.Before(end) {
+			return nil, fmt.Errorf("freeze window %q ends before it starts", spec)
+		}
+		return &DateFreezeWindow{Start: start, End: end}, nil
+	}
+
+	startSpec, endSpec, ok := strings.Cut(spec, "-")
+	if !ok {
+		return nil, fmt.Errorf("freeze window %q must be of the form \"Fri 17:00-Mon 09:00\" or \"2026-12-20/2027-01-04\"", spec)
+	}
+	startDay, start, err := parseWeekTime(startSpec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start of freeze window %q: %w", spec, err)
+	}
+	endDay, end, err := parseWeekTime(endSpec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end of freeze window %q: %w", spec, err)
+	}
+	return &WeeklyFreezeWindow{StartDay: startDay, Start: start, EndDay: endDay, End: end, Location: loc}, nil
+}
+
+// parseWeekTime parses a day of the week and a time of the day, e.g.
+// "Fri 17:00".
+func parseWeekTime(s string) (time.Weekday, time.Duration, error) {
+	daySpec, clockSpec, ok := strings.Cut(strings.TrimSpace(s), " ")
+	if !ok {
+		return 0, 0, fmt.Errorf("%q must be a day and a time, e.g. \"Fri 17:00\"", s)
+	}
+	day := -1
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(daySpec, d.String()) || strings.EqualFold(daySpec, d.String()[:3]) {
+			day = int(d)
+		}
+	}
+	if day < 0 {
+		return 0, 0, fmt.Errorf("unknown day %q", daySpec)
+	}
+	t, err := time.Parse("15:04", strings.TrimSpace(clockSpec))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid time %q: %w", clockSpec, err)
+	}
+	return time.Weekday(day), time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// clock formats the time since midnight as HH:MM.
+func clock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
+
+// activeFreezeWindow returns the first of the given windows which contains
+// the given time, or nil if none does.
+func activeFreezeWindow(windows []FreezeWindow, t time.Time) FreezeWindow {
+	for _, w := range windows {
+		if w.Contains(t) {
+			return w
+		}
+	}
+	return nil
+}
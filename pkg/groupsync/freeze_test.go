@@ -0,0 +1,118 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestParseFreezeWindow(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("failed to load time zone: %v", err)
+	}
+	// 2026-06-05 is a Friday.
+	friday := func(hour, minute int) time.Time {
+		return time.Date(2026, 6, 5, hour, minute, 0, 0, time.UTC)
+	}
+
+	cases := []struct {
+		name    string
+		spec    string
+		loc     *time.Location
+		in      []time.Time
+		out     []time.Time
+		wantErr string
+	}{
+		{
+			name: "weekly_over_weekend",
+			spec: "Fri 17:00-Mon 09:00",
+			in:   []time.Time{friday(17, 0), friday(23, 59), friday(0, 0).AddDate(0, 0, 2), friday(8, 59).AddDate(0, 0, 3)},
+			out:  []time.Time{friday(16, 59), friday(9, 0).AddDate(0, 0, 3), friday(12, 0).AddDate(0, 0, 5)},
+		},
+		{
+			name: "weekly_within_day",
+			spec: "friday 09:00 - friday 12:00",
+			in:   []time.Time{friday(9, 0), friday(11, 59)},
+			out:  []time.Time{friday(12, 0), friday(10, 0).AddDate(0, 0, 1)},
+		},
+		{
+			name: "weekly_time_zone",
+			spec: "Fri 17:00-Mon 09:00",
+			loc:  berlin,
+			// 17:00 in Berlin is 15:00 UTC in summer.
+			in:  []time.Time{friday(15, 0)},
+			out: []time.Time{friday(14, 59)},
+		},
+		{
+			name: "dates",
+			spec: "2026-12-20/2027-01-04",
+			in:   []time.Time{time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 4, 23, 59, 0, 0, time.UTC)},
+			out:  []time.Time{time.Date(2026, 12, 19, 23, 59, 0, 0, time.UTC), time.Date(2027, 1, 5, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "times",
+			spec: "2026-12-20T18:00:00Z/2027-01-04T09:00:00+01:00",
+			in:   []time.Time{time.Date(2026, 12, 20, 18, 0, 0, 0, time.UTC)},
+			out:  []time.Time{time.Date(2027, 1, 4, 8, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:    "unknown_day",
+			spec:    "Fry 17:00-Mon 09:00",
+			wantErr: `unknown day "Fry"`,
+		},
+		{
+			name:    "missing_time",
+			spec:    "Fri-Mon",
+			wantErr: "must be a day and a time",
+		},
+		{
+			name:    "dates_reversed",
+			spec:    "2027-01-04/2026-12-20",
+			wantErr: "ends before it starts",
+		},
+		{
+			name:    "invalid",
+			spec:    "tomorrow",
+			wantErr: "must be of the form",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			w, err := ParseFreezeWindow(tc.spec, tc.loc)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Fatalf("unexpected error: %s", diff)
+			}
+			for _, in := range tc.in {
+				if !w.Contains(in) {
+					t.Errorf("window %s does not contain %s", w, in)
+				}
+			}
+			for _, out := range tc.out {
+				if w.Contains(out) {
+					t.Errorf("window %s contains %s", w, out)
+				}
+			}
+		})
+	}
+}
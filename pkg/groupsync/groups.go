@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 )
 
 // GroupReader provides read operations for a group system.
@@ -35,12 +37,37 @@ type GroupReader interface {
 	GetUser(ctx context.Context, userID string) (*User, error)
 }
 
+// ChangeLogReader is a GroupReader which can tell which groups changed since
+// a point in time, e.g. from the audit log of the group system.
+type ChangeLogReader interface {
+	GroupReader
+
+	// ChangedGroupIDs returns the IDs of the groups whose members changed
+	// since the given time, along with the groups containing them.
+	ChangedGroupIDs(ctx context.Context, since time.Time) ([]string, error)
+}
+
 // GroupWriter provides write operations for a group system.
 type GroupWriter interface {
 	// SetMembers replaces the members of the group with the given ID with the given members.
+	//
+	// Implementations must be idempotent and independent of the order of the
+	// given members: setting the members a group already has, in any order
+	// and with duplicates, must not write to the group system, and changes
+	// are applied in the order of the sorted member IDs, so that runs with
+	// the same input issue the same calls.
 	SetMembers(ctx context.Context, groupID string, members []Member) error
 }
 
+// PermissionChecker is a GroupWriter which can verify that its credentials
+// allow writing the given groups, so that a sync with missing permissions
+// fails before it starts instead of failing its writes one by one.
+type PermissionChecker interface {
+	// CheckWritePermissions returns an error describing the missing
+	// permissions, if any.
+	CheckWritePermissions(ctx context.Context, groupIDs []string) error
+}
+
 // GroupReadWriter provides both read and write operations for a group system.
 type GroupReadWriter interface {
 	GroupReader
@@ -59,16 +86,108 @@ type OneToManyGroupMapper interface {
 	MappedGroupIDs(ctx context.Context, groupID string) ([]string, error)
 }
 
-// UserMapper maps a user ID to another user ID.
+// UserMapper maps a user ID to another user ID. Several user IDs may map to
+// the same user ID, e.g. the alias accounts of a person; syncers add such a
+// user only once.
 type UserMapper interface {
 	// MappedUserID returns the user ID mapped to the given user ID.
 	MappedUserID(ctx context.Context, userID string) (string, error)
 }
 
+// MappedUser is a user that a user ID maps to, along with the role that the
+// mapping grants the user in target groups.
+type MappedUser struct {
+	ID   string
+	Role string
+}
+
+// MemberRole returns the role of the given member, or "" if the member has
+// no role.
+func MemberRole(m Member) string {
+	if um, ok := m.(*UserMember); ok {
+		return um.Role
+	}
+	return ""
+}
+
+// MultiUserMapper is implemented by UserMappers which may map a user ID to
+// several user IDs, e.g. a person's own account and their admin account.
+// Syncers prefer MappedUsers over MappedUserID when it is implemented.
+type MultiUserMapper interface {
+	UserMapper
+
+	// MappedUsers returns the users mapped to the given user ID. It returns
+	// ErrTargetUserIDNotFound if there are none.
+	MappedUsers(ctx context.Context, userID string) ([]*MappedUser, error)
+}
+
+// MappedUsers returns the users mapped to the given user ID, using the
+// MultiUserMapper interface if the mapper implements it.
+func MappedUsers(ctx context.Context, m UserMapper, userID string) ([]*MappedUser, error) {
+	if mm, ok := m.(MultiUserMapper); ok {
+		users, err := mm.MappedUsers(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map user: %w", err)
+		}
+		return users, nil
+	}
+	targetUserID, err := m.MappedUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map user: %w", err)
+	}
+	return []*MappedUser{{ID: targetUserID}}, nil
+}
+
+// BatchUserMapper is implemented by UserMappers which can map many user IDs
+// at once, e.g. mappers backed by a database or an HTTP service, which would
+// otherwise make a round trip per user. Syncers prefer MappedUserIDs over
+// MappedUserID when it is implemented, unless the mapper is also a
+// MultiUserMapper.
+type BatchUserMapper interface {
+	UserMapper
+
+	// MappedUserIDs returns the user IDs mapped to the given user IDs, keyed
+	// by the given user IDs. User IDs without a mapping are left out.
+	MappedUserIDs(ctx context.Context, userIDs []string) (map[string]string, error)
+}
+
+// MappedUserIDs returns the user IDs mapped to the given user IDs, keyed by
+// the given user IDs, using the BatchUserMapper interface if the mapper
+// implements it and calling MappedUserID for each user ID otherwise. User IDs
+// for which the mapper returns ErrTargetUserIDNotFound are left out.
+func MappedUserIDs(ctx context.Context, m UserMapper, userIDs []string) (map[string]string, error) {
+	if bm, ok := m.(BatchUserMapper); ok {
+		mapped, err := bm.MappedUserIDs(ctx, userIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map users: %w", err)
+		}
+		return mapped, nil
+	}
+	var merr error
+	mapped := make(map[string]string, len(userIDs))
+	for _, userID := range userIDs {
+		targetUserID, err := m.MappedUserID(ctx, userID)
+		if errors.Is(err, ErrTargetUserIDNotFound) {
+			continue
+		}
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to map user %s: %w", userID, err))
+			continue
+		}
+		mapped[userID] = targetUserID
+	}
+	return mapped, merr
+}
+
 // User represents a user in a group system.
 type User struct {
 	// ID is the user's ID in the group system.
 	ID string `json:"id,omitempty"`
+	// StableID is the immutable ID of the user in the group system, e.g. the
+	// numeric ID of a GitHub user, if the system has one and the reader sets
+	// it. Unlike ID, which may be a login or an email that can change, it
+	// identifies the user across renames.
+	StableID string `json:"stable_id,omitempty"`
 	// Attributes represent arbitrary attributes about the user
 	// in the given group system. This field is typically set by
 	// the corresponding GroupReader when retrieving the user.
@@ -120,6 +239,10 @@ type Member interface {
 // UserMember represents a user membership of a group.
 type UserMember struct {
 	Usr *User
+	// Role is the role of the user in the group, e.g. "maintainer". It is
+	// interpreted by the group system when adding the membership. An empty
+	// role is the group system's default role.
+	Role string
 }
 
 // ID is the user's ID in the group system.
@@ -177,49 +300,71 @@ func (g *GroupMember) User() (*User, error) {
 	return nil, fmt.Errorf("group is not a user")
 }
 
+// descendantsConcurrency bounds the number of groups whose members
+// Descendants fetches concurrently.
+const descendantsConcurrency = 8
+
 // Descendants retrieve all users (children, recursively) of the given
 // group ID using the given memberFunc. This function serves mostly as
 // a utility function when implementing ReadGroupClients for when there
 // is no special logic for fetching descendants.
+//
+// The groups of each level of the hierarchy are fetched concurrently, and
+// every group is fetched once, even if it is included in several groups.
+// Users are returned in the same order as a sequential breadth first
+// traversal would return them.
 func Descendants(ctx context.Context, groupID string, memberFunc func(context.Context, string) ([]Member, error)) ([]*User, error) {
-	// Need to do a BFS traversal of the group structure
-	var queue []string
-	queue = append(queue, groupID)
-
-	// we want to maintain the invariant that every ID in the queue
+	// we want to maintain the invariant that every ID in a level
 	// has been marked as 'seen'
-	seenBefore := make(map[string]struct{})
-	seenBefore[groupID] = struct{}{}
+	seenBefore := map[string]struct{}{groupID: {}}
+	level := []string{groupID}
 
 	var merr error
 	var users []*User
-	for len(queue) > 0 {
-		groupID, queue = queue[0], queue[1:]
-		members, err := memberFunc(ctx, groupID)
-		if err != nil {
-			merr = errors.Join(merr, fmt.Errorf("error fetching group members: %s, %w", groupID, err))
-			continue
+	for len(level) > 0 {
+		members := make([][]Member, len(level))
+		errs := make([]error, len(level))
+		sem := make(chan struct{}, descendantsConcurrency)
+		var wg sync.WaitGroup
+		for i, id := range level {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+				members[i], errs[i] = memberFunc(ctx, id)
+			}()
 		}
-		for _, member := range members {
-			if member.IsUser() {
-				user, _ := member.User()
-				if user != nil {
-					users = append(users, user)
-				}
-			} else {
-				group, _ := member.Group()
-				if group != nil {
-					// only add the group ID if we haven't seen it before.
-					// this avoids infinite looping if the underlying group
-					// system allows membership cycles.
-					if _, ok := seenBefore[group.ID]; !ok {
-						// maintain invariant
-						seenBefore[group.ID] = struct{}{}
-						queue = append(queue, group.ID)
+		wg.Wait()
+
+		var next []string
+		for i, id := range level {
+			if errs[i] != nil {
+				merr = errors.Join(merr, fmt.Errorf("error fetching group members: %s, %w", id, errs[i]))
+				continue
+			}
+			for _, member := range members[i] {
+				if member.IsUser() {
+					user, _ := member.User()
+					if user != nil {
+						users = append(users, user)
+					}
+				} else {
+					group, _ := member.Group()
+					if group != nil {
+						// only add the group ID if we haven't seen it before.
+						// this avoids infinite looping if the underlying group
+						// system allows membership cycles.
+						if _, ok := seenBefore[group.ID]; !ok {
+							// maintain invariant
+							seenBefore[group.ID] = struct{}{}
+							next = append(next, group.ID)
+						}
 					}
 				}
 			}
 		}
+		level = next
 	}
 	return users, merr
 }
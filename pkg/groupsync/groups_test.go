@@ -0,0 +1,157 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestDescendants(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rw := NewMemoryGroupReadWriter()
+	// shared is included by both a and b, and b includes root back.
+	rw.AddGroup(&Group{ID: "root"},
+		&UserMember{Usr: &User{ID: "u1"}},
+		&GroupMember{Grp: &Group{ID: "a"}},
+		&GroupMember{Grp: &Group{ID: "b"}},
+		&GroupMember{Grp: &Group{ID: "missing"}},
+	)
+	rw.AddGroup(&Group{ID: "a"}, &UserMember{Usr: &User{ID: "u2"}}, &GroupMember{Grp: &Group{ID: "shared"}})
+	rw.AddGroup(&Group{ID: "b"}, &GroupMember{Grp: &Group{ID: "shared"}}, &GroupMember{Grp: &Group{ID: "root"}}, &UserMember{Usr: &User{ID: "u3"}})
+	rw.AddGroup(&Group{ID: "shared"}, &UserMember{Usr: &User{ID: "u4"}})
+
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	users, err := Descendants(ctx, "root", func(ctx context.Context, groupID string) ([]Member, error) {
+		mu.Lock()
+		calls[groupID]++
+		mu.Unlock()
+		return rw.GetMembers(ctx, groupID)
+	})
+	if diff := testutil.DiffErrString(err, "error fetching group members: missing"); diff != "" {
+		t.Errorf("unexpected err: %s", diff)
+	}
+	var got []string
+	for _, u := range users {
+		got = append(got, u.ID)
+	}
+	// in breadth first order.
+	if diff := cmp.Diff(got, []string{"u1", "u2", "u3", "u4"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+	want := map[string]int{"root": 1, "a": 1, "b": 1, "missing": 1, "shared": 1}
+	if diff := cmp.Diff(calls, want); diff != "" {
+		t.Errorf("unexpected member fetches (-got, +want):\n%s", diff)
+	}
+}
+
+// benchmarkSizes are the numbers of members of the groups of benchmarks,
+// those of large orgs.
+var benchmarkSizes = []int{10_000, 100_000}
+
+// benchmarkGroup creates a MemoryGroupReadWriter with a group "root" of n
+// users spread over 100 nested subgroups.
+func benchmarkGroup(n int) *MemoryGroupReadWriter {
+	rw := NewMemoryGroupReadWriter()
+	var subgroups []Member
+	for g := range 100 {
+		id := fmt.Sprintf("sub%d", g)
+		users := make([]Member, 0, n/100)
+		for u := range n / 100 {
+			users = append(users, &UserMember{Usr: &User{ID: fmt.Sprintf("user%d-%d@example.com", g, u)}})
+		}
+		rw.AddGroup(&Group{ID: id}, users...)
+		subgroups = append(subgroups, &GroupMember{Grp: &Group{ID: id}})
+	}
+	rw.AddGroup(&Group{ID: "root"}, subgroups...)
+	return rw
+}
+
+func BenchmarkDescendants(b *testing.B) {
+	ctx := context.Background()
+	for _, n := range benchmarkSizes {
+		b.Run(fmt.Sprintf("members=%d", n), func(b *testing.B) {
+			rw := benchmarkGroup(n)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				users, err := Descendants(ctx, "root", rw.GetMembers)
+				if err != nil {
+					b.Fatalf("Descendants failed: %v", err)
+				}
+				if len(users) != n {
+					b.Fatalf("Descendants got %d users, want %d", len(users), n)
+				}
+			}
+		})
+	}
+}
+
+func TestMappedUserIDs(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		mapper  UserMapper
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name:   "batch",
+			mapper: &testBatchUserMapper{m: map[string]string{"a": "x", "b": "y"}},
+			want:   map[string]string{"a": "x", "b": "y"},
+		},
+		{
+			name: "adapter",
+			mapper: &testUserMapper{
+				m:                map[string]string{"a": "x", "b": "y"},
+				mappedUserIDErrs: map[string]error{"c": ErrTargetUserIDNotFound},
+			},
+			want: map[string]string{"a": "x", "b": "y"},
+		},
+		{
+			name: "adapter_error",
+			mapper: &testUserMapper{
+				m:                map[string]string{"a": "x"},
+				mappedUserIDErrs: map[string]error{"b": fmt.Errorf("injected")},
+			},
+			want:    map[string]string{"a": "x"},
+			wantErr: "failed to map user b: injected",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := MappedUserIDs(context.Background(), tc.mapper, []string{"a", "b", "c"})
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected mapped user IDs (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
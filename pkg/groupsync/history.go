@@ -0,0 +1,429 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const (
+	historyKeyPrefix = "history"
+	// runIDLayout formats the start time of a run as its ID, so that IDs sort
+	// chronologically.
+	runIDLayout = "20060102T150405Z"
+)
+
+// RunReport is the outcome of a SyncAll run.
+type RunReport struct {
+	// ID identifies the run, it is the UTC start time of the run.
+	ID           string    `json:"id"`
+	SourceSystem string    `json:"source_system"`
+	TargetSystem string    `json:"target_system"`
+	StartedAt    time.Time `json:"started_at"`
+	FinishedAt   time.Time `json:"finished_at"`
+	// Groups are the outcomes of the synced groups, ordered by target group
+	// ID. Failures which happened before a target group was known have an
+	// empty target group ID and come first.
+	Groups []*GroupReport `json:"groups"`
+	// Writes is the number of write calls made to the target system, which
+	// is 0 once the target groups have converged. It is only counted by
+	// syncers whose target writer is a WriteCounter.
+	Writes int `json:"writes"`
+	// Error is the error of the run, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// Failed returns the number of groups which failed to sync.
+func (r *RunReport) Failed() int {
+	var n int
+	for _, g := range r.Groups {
+		if g.Error != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// GroupReport is the outcome of syncing a target group in a run.
+type GroupReport struct {
+	TargetGroupID  string   `json:"target_group_id,omitempty"`
+	SourceGroupIDs []string `json:"source_group_ids"`
+	// Members are the IDs of the members the target group was set to. It is
+	// empty if the group failed to sync.
+	Members []string `json:"members,omitempty"`
+	// PendingRemovals are the IDs of the members which were kept because
+	// their removal was deferred by a freeze window.
+	PendingRemovals []string `json:"pending_removals,omitempty"`
+	// Deferred is whether the group was not synced because the API budget of
+	// the run was exhausted.
+	Deferred bool `json:"deferred,omitempty"`
+	// Declined is whether the group was not synced because the removal of
+	// its members was not confirmed, see ConfirmingWriter.
+	Declined bool          `json:"declined,omitempty"`
+	Category ErrorCategory `json:"category,omitempty"`
+	Error    string        `json:"error,omitempty"`
+	// Writes is the number of write calls made to the target group.
+	Writes int `json:"writes,omitempty"`
+}
+
+// RunHistory persists the reports of the last runs in a state.Store.
+type RunHistory struct {
+	store   state.Store
+	maxRuns int
+	maxAge  time.Duration
+	now     func() time.Time
+}
+
+// NewRunHistory creates a RunHistory which keeps the reports of the last
+// maxRuns runs that started within maxAge. A maxAge of 0 keeps reports of any
+// age.
+func NewRunHistory(store state.Store, maxRuns int, maxAge time.Duration) *RunHistory {
+	return &RunHistory{
+		store:   store,
+		maxRuns: maxRuns,
+		maxAge:  maxAge,
+		now:     time.Now,
+	}
+}
+
+// RunIDs returns the IDs of the stored runs, oldest first.
+func (h *RunHistory) RunIDs(ctx context.Context) ([]string, error) {
+	keys, err := h.store.List(ctx, historyKeyPrefix+"/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list run reports: %w", err)
+	}
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, state.KeyBase(key))
+	}
+	return ids, nil
+}
+
+// Reports returns the stored run reports, newest first.
+func (h *RunHistory) Reports(ctx context.Context) ([]*RunReport, error) {
+	ids, err := h.RunIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*RunReport, 0, len(ids))
+	for _, id := range slices.Backward(ids) {
+		report, err := h.Report(ctx, id)
+		if errors.Is(err, state.ErrNotFound) {
+			// pruned since listing
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// Report returns the report of the run with the given ID. It returns an error
+// wrapping state.ErrNotFound if there is no such run.
+func (h *RunHistory) Report(ctx context.Context, id string) (*RunReport, error) {
+	var report RunReport
+	if err := state.GetJSON(ctx, h.store, state.Key(historyKeyPrefix, id), &report); err != nil {
+		return nil, fmt.Errorf("failed to read run report %s: %w", id, err)
+	}
+	return &report, nil
+}
+
+// save stores the report and prunes the reports beyond the retention.
+func (h *RunHistory) save(ctx context.Context, report *RunReport) error {
+	if err := state.PutJSON(ctx, h.store, state.Key(historyKeyPrefix, report.ID), report); err != nil {
+		return fmt.Errorf("failed to save run report: %w", err)
+	}
+	return h.Prune(ctx)
+}
+
+// Prune deletes the reports beyond the retention of the history.
+func (h *RunHistory) Prune(ctx context.Context) error {
+	ids, err := h.RunIDs(ctx)
+	if err != nil {
+		return err
+	}
+	var prune []string
+	if h.maxRuns > 0 && len(ids) > h.maxRuns {
+		prune, ids = ids[:len(ids)-h.maxRuns], ids[len(ids)-h.maxRuns:]
+	}
+	if h.maxAge > 0 {
+		cutoff := h.now().Add(-h.maxAge)
+		for _, id := range ids {
+			startedAt, err := time.Parse(runIDLayout, id)
+			if err != nil || !startedAt.Before(cutoff) {
+				continue
+			}
+			prune = append(prune, id)
+		}
+	}
+	var merr error
+	for _, id := range prune {
+		if err := h.store.Delete(ctx, state.Key(historyKeyPrefix, id)); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to prune run report %s: %w", id, err))
+		}
+	}
+	return merr
+}
+
+// runRecorder collects the outcome of each group synced during a run.
+type runRecorder struct {
+	mu     sync.Mutex
+	groups map[string]*GroupReport
+	// writes are the write calls per target group.
+	writes map[string]int
+}
+
+type runRecorderKey struct{}
+
+// withRunRecorder returns a context carrying a new runRecorder.
+func withRunRecorder(ctx context.Context) (context.Context, *runRecorder) {
+	r := &runRecorder{groups: make(map[string]*GroupReport), writes: make(map[string]int)}
+	return context.WithValue(ctx, runRecorderKey{}, r), r
+}
+
+// runRecorderFromContext returns the runRecorder of the context, or nil if no
+// run is being recorded. The methods of a nil runRecorder do nothing.
+func runRecorderFromContext(ctx context.Context) *runRecorder {
+	r, _ := ctx.Value(runRecorderKey{}).(*runRecorder)
+	return r
+}
+
+// recordMembers records that the target group was set to the given members,
+// keeping the members whose removal was deferred.
+func (r *runRecorder) recordMembers(targetGroupID string, sourceGroupIDs, members, pendingRemovals []string) {
+	if r == nil {
+		return
+	}
+	r.record(&GroupReport{
+		TargetGroupID:   targetGroupID,
+		SourceGroupIDs:  slices.Sorted(slices.Values(sourceGroupIDs)),
+		Members:         slices.Sorted(slices.Values(members)),
+		PendingRemovals: slices.Sorted(slices.Values(pendingRemovals)),
+	})
+}
+
+// recordError records a failed group. A later successful sync of the same
+// group, e.g. when it is retried, replaces the failure.
+func (r *runRecorder) recordError(ge *GroupError) {
+	if r == nil {
+		return
+	}
+	r.record(&GroupReport{
+		TargetGroupID:  ge.TargetGroupID,
+		SourceGroupIDs: []string{ge.SourceGroupID},
+		Category:       ge.Category,
+		Error:          ge.Err.Error(),
+	})
+}
+
+// recordDeferred records a target group deferred to the next run, unless it
+// was synced by the run already.
+func (r *runRecorder) recordDeferred(targetGroupID, sourceGroupID string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := recorderKey(targetGroupID, nil)
+	if _, ok := r.groups[key]; !ok {
+		r.groups[key] = &GroupReport{
+			TargetGroupID:  targetGroupID,
+			SourceGroupIDs: []string{sourceGroupID},
+			Deferred:       true,
+		}
+	}
+}
+
+// recordDeclined records a target group whose sync was declined, unless it
+// was synced by the run already.
+func (r *runRecorder) recordDeclined(targetGroupID, sourceGroupID string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := recorderKey(targetGroupID, nil)
+	if _, ok := r.groups[key]; !ok {
+		r.groups[key] = &GroupReport{
+			TargetGroupID:  targetGroupID,
+			SourceGroupIDs: []string{sourceGroupID},
+			Declined:       true,
+		}
+	}
+}
+
+// recordWrites records that n write calls were made to the target group.
+func (r *runRecorder) recordWrites(targetGroupID string, n int) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.writes[targetGroupID] += n
+}
+
+// totalWrites returns the number of write calls made to all target groups.
+func (r *runRecorder) totalWrites() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var n int
+	for _, writes := range r.writes {
+		n += writes
+	}
+	return n
+}
+
+// clearSourceError removes the failure recorded for the source group before
+// its target groups were known.
+func (r *runRecorder) clearSourceError(sourceGroupID string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.groups, recorderKey("", []string{sourceGroupID}))
+}
+
+func (r *runRecorder) record(g *GroupReport) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.groups[recorderKey(g.TargetGroupID, g.SourceGroupIDs)] = g
+}
+
+// reports returns the recorded group reports ordered by target group ID.
+func (r *runRecorder) reports() []*GroupReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	keys := make([]string, 0, len(r.groups))
+	for key := range r.groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	reports := make([]*GroupReport, 0, len(keys))
+	for _, key := range keys {
+		g := r.groups[key]
+		if g.TargetGroupID != "" {
+			g.Writes = r.writes[g.TargetGroupID]
+		}
+		reports = append(reports, g)
+	}
+	return reports
+}
+
+// recorderKey identifies a group report. Failures without a target group are
+// keyed by their source group, sorting before all target groups.
+func recorderKey(targetGroupID string, sourceGroupIDs []string) string {
+	if targetGroupID != "" {
+		return "target/" + targetGroupID
+	}
+	return "\x00source/" + strings.Join(sourceGroupIDs, ",")
+}
+
+// GroupDiff is the change of a target group's members between two runs.
+type GroupDiff struct {
+	TargetGroupID string
+	// Added are the members of the group in the later run only.
+	Added []string
+	// Removed are the members of the group in the earlier run only.
+	Removed []string
+	// Note explains why the group cannot be compared, e.g. because it failed
+	// to sync, or was only synced by one of the runs.
+	Note string
+}
+
+// DiffRuns compares the members of each target group in the given runs. Only
+// groups whose members changed, or which cannot be compared, are returned,
+// ordered by target group ID. The members of a group that failed to sync in
+// either run are unknown, so it is only returned with a note.
+func DiffRuns(from, to *RunReport) []*GroupDiff {
+	fromGroups, toGroups := targetGroupReports(from), targetGroupReports(to)
+	ids := make([]string, 0, len(fromGroups)+len(toGroups))
+	for id := range fromGroups {
+		ids = append(ids, id)
+	}
+	for id := range toGroups {
+		if _, ok := fromGroups[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	var diffs []*GroupDiff
+	for _, id := range ids {
+		f, t := fromGroups[id], toGroups[id]
+		diff := &GroupDiff{
+			TargetGroupID: id,
+			Added:         subtract(reportMembers(t), reportMembers(f)),
+			Removed:       subtract(reportMembers(f), reportMembers(t)),
+		}
+		switch {
+		case f != nil && f.Error != "":
+			diff = &GroupDiff{TargetGroupID: id, Note: fmt.Sprintf("failed in run %s: %s", from.ID, f.Error)}
+		case t != nil && t.Error != "":
+			diff = &GroupDiff{TargetGroupID: id, Note: fmt.Sprintf("failed in run %s: %s", to.ID, t.Error)}
+		case f == nil:
+			diff.Note = fmt.Sprintf("not synced by run %s", from.ID)
+		case t == nil:
+			diff.Note = fmt.Sprintf("not synced by run %s", to.ID)
+		}
+		if len(diff.Added) > 0 || len(diff.Removed) > 0 || diff.Note != "" {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs
+}
+
+// reportMembers returns the members of the group report, or nil if the
+// report is nil.
+func reportMembers(g *GroupReport) []string {
+	if g == nil {
+		return nil
+	}
+	return g.Members
+}
+
+// targetGroupReports returns the group reports of the run by target group ID.
+func targetGroupReports(r *RunReport) map[string]*GroupReport {
+	groups := make(map[string]*GroupReport, len(r.Groups))
+	for _, g := range r.Groups {
+		// deferred and declined groups were not synced, like missing ones.
+		if g.TargetGroupID != "" && !g.Deferred && !g.Declined {
+			groups[g.TargetGroupID] = g
+		}
+	}
+	return groups
+}
+
+// subtract returns the elements of a which are not in b, in the order of a.
+func subtract(a, b []string) []string {
+	var out []string
+	for _, s := range a {
+		if !slices.Contains(b, s) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
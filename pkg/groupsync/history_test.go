@@ -0,0 +1,168 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestSyncAll_RunHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	start := time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC)
+	clock := start
+	history := NewRunHistory(state.NewMemoryStore(), 2, 0)
+	history.now = func() time.Time {
+		defer func() { clock = clock.Add(time.Minute) }()
+		return clock
+	}
+
+	sourceGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{
+			"1": {&UserMember{Usr: &User{ID: "a"}}, &UserMember{Usr: &User{ID: "b"}}},
+			"2": {&UserMember{Usr: &User{ID: "b"}}},
+		},
+	}
+	syncer := NewManyToManySyncer(
+		"source",
+		"target",
+		sourceGroupClient,
+		&flakyGroupWriter{
+			MemoryGroupReadWriter: &MemoryGroupReadWriter{
+				Members: map[string][]Member{"99": {}, "98": {}},
+			},
+			failures: map[string][]error{"98": {nil, fmt.Errorf("forbidden")}},
+			calls:    make(map[string]int),
+		},
+		&testGroupMapper{m: map[string][]string{"1": {"99"}, "2": {"98"}}},
+		&testGroupMapper{m: map[string][]string{"99": {"1"}, "98": {"2"}}},
+		&testUserMapper{m: map[string]string{"a": "xy", "b": "zw"}},
+		WithRunHistory(history),
+	)
+
+	for i := range 3 {
+		if i == 2 {
+			sourceGroupClient.Members["1"] = []Member{&UserMember{Usr: &User{ID: "b"}}}
+		}
+		// failures are reported by the history, not the error.
+		_ = syncer.SyncAll(ctx)
+	}
+
+	got, err := history.Reports(ctx)
+	if err != nil {
+		t.Fatalf("failed to list reports: %v", err)
+	}
+	want := []*RunReport{
+		{
+			ID:           "20260303T100400Z",
+			SourceSystem: "source",
+			TargetSystem: "target",
+			StartedAt:    start.Add(4 * time.Minute),
+			FinishedAt:   start.Add(5 * time.Minute),
+			Groups: []*GroupReport{
+				{TargetGroupID: "98", SourceGroupIDs: []string{"2"}, Members: []string{"zw"}},
+				{TargetGroupID: "99", SourceGroupIDs: []string{"1"}, Members: []string{"zw"}},
+			},
+		},
+		{
+			ID:           "20260303T100200Z",
+			SourceSystem: "source",
+			TargetSystem: "target",
+			StartedAt:    start.Add(2 * time.Minute),
+			FinishedAt:   start.Add(3 * time.Minute),
+			Groups: []*GroupReport{
+				{
+					TargetGroupID:  "98",
+					SourceGroupIDs: []string{"2"},
+					Category:       ErrorCategoryAPI,
+					Error:          "error setting members to target group 98: forbidden",
+				},
+				{TargetGroupID: "99", SourceGroupIDs: []string{"1"}, Members: []string{"xy", "zw"}},
+			},
+		},
+	}
+	if diff := cmp.Diff(got, want, cmpopts.IgnoreFields(RunReport{}, "Error")); diff != "" {
+		t.Errorf("unexpected reports (-got, +want):\n%s", diff)
+	}
+	if got[1].Error == "" {
+		t.Errorf("report of failed run has no error")
+	}
+}
+
+func TestRunHistory_Prune(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	history := NewRunHistory(state.NewMemoryStore(), 0, 7*24*time.Hour)
+	history.now = func() time.Time { return now }
+
+	for _, startedAt := range []time.Time{now.Add(-8 * 24 * time.Hour), now.Add(-24 * time.Hour), now} {
+		if err := history.save(ctx, &RunReport{ID: startedAt.Format(runIDLayout), StartedAt: startedAt}); err != nil {
+			t.Fatalf("failed to save report: %v", err)
+		}
+	}
+
+	got, err := history.RunIDs(ctx)
+	if err != nil {
+		t.Fatalf("failed to list runs: %v", err)
+	}
+	want := []string{"20260309T000000Z", "20260310T000000Z"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected runs (-got, +want):\n%s", diff)
+	}
+}
+
+func TestDiffRuns(t *testing.T) {
+	t.Parallel()
+
+	from := &RunReport{
+		ID: "20260303T100000Z",
+		Groups: []*GroupReport{
+			{SourceGroupIDs: []string{"3"}, Error: "error fetching target group IDs"},
+			{TargetGroupID: "97", Members: []string{"a"}},
+			{TargetGroupID: "98", Members: []string{"a", "b"}},
+			{TargetGroupID: "99", Members: []string{"a", "b", "c"}},
+		},
+	}
+	to := &RunReport{
+		ID: "20260304T100000Z",
+		Groups: []*GroupReport{
+			{TargetGroupID: "96", Members: []string{"d"}},
+			{TargetGroupID: "97", Members: []string{"a"}},
+			{TargetGroupID: "98", Error: "forbidden"},
+			{TargetGroupID: "99", Members: []string{"a", "c", "d"}},
+		},
+	}
+
+	got := DiffRuns(from, to)
+	want := []*GroupDiff{
+		{TargetGroupID: "96", Added: []string{"d"}, Note: "not synced by run 20260303T100000Z"},
+		{TargetGroupID: "98", Note: "failed in run 20260304T100000Z: forbidden"},
+		{TargetGroupID: "99", Added: []string{"d"}, Removed: []string{"b"}},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (-got, +want):\n%s", diff)
+	}
+}
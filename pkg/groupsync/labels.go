@@ -0,0 +1,60 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// ManagedByMarker marks the descriptions of target groups synced by
+// team-link, so that they identify themselves as managed in the target
+// system.
+const ManagedByMarker = "managed by team-link"
+
+// GroupLabeler is a GroupWriter which can attach labels to target groups,
+// e.g. by appending them to their descriptions.
+type GroupLabeler interface {
+	// SetGroupLabels replaces the labels of the group with the given ID with
+	// the given labels, and marks the group as managed by team-link.
+	SetGroupLabels(ctx context.Context, groupID string, labels map[string]string) error
+}
+
+// LabeledDescription returns the given description of a target group with a
+// suffix marking it as managed by team-link and carrying the given labels,
+// e.g. "Infra team [managed by team-link; env=prod, owner=sre]". A suffix
+// added before is replaced, so the result is the same for the same labels.
+func LabeledDescription(description string, labels map[string]string) string {
+	if i := strings.Index(description, "["+ManagedByMarker); i >= 0 {
+		description = description[:i]
+	}
+	description = strings.TrimSpace(description)
+
+	suffix := ManagedByMarker
+	if len(labels) > 0 {
+		pairs := make([]string, 0, len(labels))
+		for _, k := range slices.Sorted(maps.Keys(labels)) {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", k, labels[k]))
+		}
+		suffix += "; " + strings.Join(pairs, ", ")
+	}
+	if description == "" {
+		return "[" + suffix + "]"
+	}
+	return description + " [" + suffix + "]"
+}
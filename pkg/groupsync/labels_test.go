@@ -0,0 +1,60 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import "testing"
+
+func TestLabeledDescription(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		description string
+		labels      map[string]string
+		want        string
+	}{
+		{
+			name: "empty",
+			want: "[managed by team-link]",
+		},
+		{
+			name:        "labels_sorted",
+			description: "Infra team",
+			labels:      map[string]string{"owner": "sre", "env": "prod"},
+			want:        "Infra team [managed by team-link; env=prod, owner=sre]",
+		},
+		{
+			name:        "replaces_previous_suffix",
+			description: "Infra team [managed by team-link; env=dev]",
+			labels:      map[string]string{"env": "prod"},
+			want:        "Infra team [managed by team-link; env=prod]",
+		},
+		{
+			name:        "removes_previous_labels",
+			description: "[managed by team-link; env=dev]",
+			want:        "[managed by team-link]",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := LabeledDescription(tc.description, tc.labels); got != tc.want {
+				t.Errorf("LabeledDescription got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
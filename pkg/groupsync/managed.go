@@ -0,0 +1,265 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const managedKeyPrefix = "managed"
+
+// GroupArchiver is a GroupWriter which can archive a target group that is no
+// longer mapped instead of abandoning it, without deleting it.
+type GroupArchiver interface {
+	// ArchiveGroup marks the group with the given ID as archived and removes
+	// its members.
+	ArchiveGroup(ctx context.Context, groupID string) error
+}
+
+// UnmappedGroupPolicy decides what happens to a target group which was synced
+// by a previous run and is no longer mapped.
+type UnmappedGroupPolicy string
+
+const (
+	// UnmappedGroupNone leaves unmapped target groups as they are. It is the
+	// default.
+	UnmappedGroupNone UnmappedGroupPolicy = "none"
+	// UnmappedGroupFlag leaves unmapped target groups as they are and alerts
+	// on them.
+	UnmappedGroupFlag UnmappedGroupPolicy = "flag"
+	// UnmappedGroupArchive archives unmapped target groups with the
+	// GroupArchiver of the target system, e.g. renames GitHub teams with an
+	// "archived-" prefix and removes their members.
+	UnmappedGroupArchive UnmappedGroupPolicy = "archive"
+)
+
+// UnmappedGroupPolicies are the supported policies.
+var UnmappedGroupPolicies = []UnmappedGroupPolicy{UnmappedGroupNone, UnmappedGroupFlag, UnmappedGroupArchive}
+
+// ParseUnmappedGroupPolicy parses the name of an UnmappedGroupPolicy.
+func ParseUnmappedGroupPolicy(s string) (UnmappedGroupPolicy, error) {
+	for _, p := range UnmappedGroupPolicies {
+		if string(p) == s {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("unknown unmapped group policy %q, must be one of %q", s, UnmappedGroupPolicies)
+}
+
+// ManagedGroupRecord tracks a target group synced by team-link.
+type ManagedGroupRecord struct {
+	GroupID      string    `json:"group_id"`
+	LastMappedAt time.Time `json:"last_mapped_at"`
+	// UnmappedAt is when the group was first found unmapped. It is zero while
+	// the group is mapped.
+	UnmappedAt time.Time `json:"unmapped_at"`
+	Archived   bool      `json:"archived"`
+}
+
+// Unmapped reports whether the group is no longer mapped.
+func (r *ManagedGroupRecord) Unmapped() bool {
+	return !r.UnmappedAt.IsZero()
+}
+
+// ManagedGroups keeps the target groups synced by team-link in a state.Store,
+// so that target groups whose mappings were removed can be found, and applies
+// an UnmappedGroupPolicy to them.
+type ManagedGroups struct {
+	store  state.Store
+	policy UnmappedGroupPolicy
+	now    func() time.Time
+}
+
+// NewManagedGroups creates a ManagedGroups applying the given policy to
+// unmapped target groups.
+func NewManagedGroups(store state.Store, policy UnmappedGroupPolicy) *ManagedGroups {
+	return &ManagedGroups{
+		store:  store,
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+// Records returns the records of all target groups synced by team-link,
+// including the unmapped ones.
+func (m *ManagedGroups) Records(ctx context.Context) ([]*ManagedGroupRecord, error) {
+	keys, err := m.store.List(ctx, managedKeyPrefix+"/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list managed group records: %w", err)
+	}
+	var records []*ManagedGroupRecord
+	for _, key := range keys {
+		var record ManagedGroupRecord
+		if err := state.GetJSON(ctx, m.store, key, &record); err != nil {
+			if errors.Is(err, state.ErrNotFound) {
+				// deleted since listing
+				continue
+			}
+			return nil, fmt.Errorf("failed to read managed group record: %w", err)
+		}
+		records = append(records, &record)
+	}
+	return records, nil
+}
+
+// Track records the given target groups as synced by team-link, e.g. groups
+// taken over from another tool. Groups which already have a record are left
+// as they are.
+func (m *ManagedGroups) Track(ctx context.Context, groupIDs []string) error {
+	now := m.now().UTC()
+	var merr error
+	for _, id := range groupIDs {
+		key := state.Key(managedKeyPrefix, id)
+		var existing ManagedGroupRecord
+		err := state.GetJSON(ctx, m.store, key, &existing)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, state.ErrNotFound) {
+			merr = errors.Join(merr, fmt.Errorf("failed to read managed group record: %w", err))
+			continue
+		}
+		if err := state.PutJSON(ctx, m.store, key, &ManagedGroupRecord{
+			GroupID:      id,
+			LastMappedAt: now,
+		}); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to save managed group record: %w", err))
+		}
+	}
+	return merr
+}
+
+// Forget removes the record of the given target group, e.g. once it was
+// deleted.
+func (m *ManagedGroups) Forget(ctx context.Context, groupID string) error {
+	if err := m.store.Delete(ctx, state.Key(managedKeyPrefix, groupID)); err != nil {
+		return fmt.Errorf("failed to forget managed group: %w", err)
+	}
+	return nil
+}
+
+// reconcile records the given mapped target groups and applies the policy to
+// the recorded groups which are no longer mapped. Groups are only archived
+// once. If no groups are mapped at all, which is more likely a broken config
+// than the removal of every mapping, the recorded groups are left alone.
+func (m *ManagedGroups) reconcile(ctx context.Context, mappedGroupIDs []string, writer GroupWriter) error {
+	existing, err := m.Records(ctx)
+	if err != nil {
+		return err
+	}
+	logger := logging.FromContext(ctx)
+	if len(mappedGroupIDs) == 0 && len(existing) > 0 {
+		logger.WarnContext(ctx, "no target groups are mapped, not treating managed groups as unmapped",
+			"managed_groups", len(existing),
+		)
+		return nil
+	}
+
+	now := m.now().UTC()
+	var merr error
+	mapped := make(map[string]struct{}, len(mappedGroupIDs))
+	for _, id := range mappedGroupIDs {
+		mapped[id] = struct{}{}
+		if err := state.PutJSON(ctx, m.store, state.Key(managedKeyPrefix, id), &ManagedGroupRecord{
+			GroupID:      id,
+			LastMappedAt: now,
+		}); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to save managed group record: %w", err))
+		}
+	}
+
+	archiver, canArchive := writer.(GroupArchiver)
+	for _, r := range existing {
+		if _, ok := mapped[r.GroupID]; ok {
+			continue
+		}
+		changed := false
+		if !r.Unmapped() {
+			r.UnmappedAt = now
+			changed = true
+			if m.policy == UnmappedGroupFlag {
+				logger.WarnContext(ctx, "target group is no longer mapped",
+					"alert", true,
+					"target_group_id", r.GroupID,
+					"last_mapped_at", r.LastMappedAt,
+				)
+			} else {
+				logger.InfoContext(ctx, "target group is no longer mapped",
+					"target_group_id", r.GroupID,
+					"last_mapped_at", r.LastMappedAt,
+				)
+			}
+		}
+		if m.policy == UnmappedGroupArchive && !r.Archived {
+			if !canArchive {
+				merr = errors.Join(merr, fmt.Errorf("target group %s cannot be archived, the target system does not support archiving", r.GroupID))
+			} else if err := archiver.ArchiveGroup(ctx, r.GroupID); err != nil {
+				merr = errors.Join(merr, fmt.Errorf("failed to archive target group %s: %w", r.GroupID, err))
+			} else {
+				logger.WarnContext(ctx, "archived unmapped target group",
+					"target_group_id", r.GroupID,
+				)
+				r.Archived = true
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		if err := state.PutJSON(ctx, m.store, state.Key(managedKeyPrefix, r.GroupID), r); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to save managed group record: %w", err))
+		}
+	}
+	return merr
+}
+
+// Orphans returns the records of the target groups synced by team-link which
+// are not among the given mapped target groups, sorted by group ID, whether or
+// not a sync run found them unmapped already.
+func (m *ManagedGroups) Orphans(ctx context.Context, mappedGroupIDs []string) ([]*ManagedGroupRecord, error) {
+	records, err := m.Records(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orphans := slices.DeleteFunc(records, func(r *ManagedGroupRecord) bool {
+		return slices.Contains(mappedGroupIDs, r.GroupID)
+	})
+	slices.SortFunc(orphans, func(a, b *ManagedGroupRecord) int {
+		return strings.Compare(a.GroupID, b.GroupID)
+	})
+	return orphans, nil
+}
+
+// CleanUp cleans up an orphaned target group and forgets it: it is archived
+// if the writer is a GroupArchiver, and otherwise all its members are removed.
+// The group itself is never deleted.
+func (m *ManagedGroups) CleanUp(ctx context.Context, groupID string, writer GroupWriter) error {
+	if archiver, ok := writer.(GroupArchiver); ok {
+		if err := archiver.ArchiveGroup(ctx, groupID); err != nil {
+			return fmt.Errorf("failed to archive target group %s: %w", groupID, err)
+		}
+	} else if err := writer.SetMembers(ctx, groupID, nil); err != nil {
+		return fmt.Errorf("failed to remove members of target group %s: %w", groupID, err)
+	}
+	return m.Forget(ctx, groupID)
+}
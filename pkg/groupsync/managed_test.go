@@ -0,0 +1,270 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestSyncAll_ManagedGroups(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name   string
+		policy UnmappedGroupPolicy
+		// secondMapping is the source group mapping of the second run. The
+		// first run maps source group 1 to target groups 98 and 99.
+		secondMapping map[string][]string
+		noArchiver    bool
+		wantErr       string
+		wantArchived  []string
+		wantRecords   []*ManagedGroupRecord
+	}{
+		{
+			name:          "none",
+			policy:        UnmappedGroupNone,
+			secondMapping: map[string][]string{"1": {"99"}},
+			wantRecords: []*ManagedGroupRecord{
+				{GroupID: "98", LastMappedAt: now, UnmappedAt: now.Add(time.Hour)},
+				{GroupID: "99", LastMappedAt: now.Add(time.Hour)},
+			},
+		},
+		{
+			name:          "flag",
+			policy:        UnmappedGroupFlag,
+			secondMapping: map[string][]string{"1": {"99"}},
+			wantRecords: []*ManagedGroupRecord{
+				{GroupID: "98", LastMappedAt: now, UnmappedAt: now.Add(time.Hour)},
+				{GroupID: "99", LastMappedAt: now.Add(time.Hour)},
+			},
+		},
+		{
+			name:          "archive",
+			policy:        UnmappedGroupArchive,
+			secondMapping: map[string][]string{"1": {"99"}},
+			wantArchived:  []string{"98"},
+			wantRecords: []*ManagedGroupRecord{
+				{GroupID: "98", LastMappedAt: now, UnmappedAt: now.Add(time.Hour), Archived: true},
+				{GroupID: "99", LastMappedAt: now.Add(time.Hour)},
+			},
+		},
+		{
+			name:          "archive_nothing_mapped",
+			policy:        UnmappedGroupArchive,
+			secondMapping: map[string][]string{},
+			wantRecords: []*ManagedGroupRecord{
+				{GroupID: "98", LastMappedAt: now},
+				{GroupID: "99", LastMappedAt: now},
+			},
+		},
+		{
+			name:          "archive_not_supported",
+			policy:        UnmappedGroupArchive,
+			secondMapping: map[string][]string{"1": {"99"}},
+			noArchiver:    true,
+			wantErr:       "target system does not support archiving",
+			wantRecords: []*ManagedGroupRecord{
+				{GroupID: "98", LastMappedAt: now, UnmappedAt: now.Add(time.Hour)},
+				{GroupID: "99", LastMappedAt: now.Add(time.Hour)},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sourceGroupClient := &MemoryGroupReadWriter{
+				Members: map[string][]Member{"1": {
+					&UserMember{Usr: &User{ID: "alice@example.com"}},
+				}},
+			}
+			targetGroupClient := &MemoryGroupReadWriter{
+				Members: map[string][]Member{"98": {}, "99": {}},
+			}
+			var targetClient GroupWriter = &testArchiverClient{MemoryGroupReadWriter: targetGroupClient}
+			if tc.noArchiver {
+				targetClient = targetGroupClient
+			}
+			sourceMapper := &testGroupMapper{m: map[string][]string{"1": {"98", "99"}}}
+			targetMapper := &testGroupMapper{m: map[string][]string{"98": {"1"}, "99": {"1"}}}
+			managed := NewManagedGroups(state.NewMemoryStore(), tc.policy)
+			managed.now = func() time.Time { return now }
+			syncer := NewManyToManySyncer(
+				"source",
+				"target",
+				sourceGroupClient,
+				targetClient,
+				sourceMapper,
+				targetMapper,
+				&testUserMapper{m: map[string]string{"alice@example.com": "alice"}},
+				WithManagedGroups(managed),
+			)
+
+			if err := syncer.SyncAll(ctx); err != nil {
+				t.Fatalf("first SyncAll failed: %v", err)
+			}
+
+			sourceMapper.m = tc.secondMapping
+			targetMapper.m = make(map[string][]string)
+			for source, targets := range tc.secondMapping {
+				for _, target := range targets {
+					targetMapper.m[target] = append(targetMapper.m[target], source)
+				}
+			}
+			managed.now = func() time.Time { return now.Add(time.Hour) }
+			err := syncer.SyncAll(ctx)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+
+			if archiver, ok := targetClient.(*testArchiverClient); ok {
+				if diff := cmp.Diff(archiver.archived, tc.wantArchived); diff != "" {
+					t.Errorf("unexpected archived groups (-got, +want):\n%s", diff)
+				}
+			}
+			records, err := managed.Records(ctx)
+			if err != nil {
+				t.Fatalf("Records failed: %v", err)
+			}
+			slices.SortFunc(records, func(a, b *ManagedGroupRecord) int {
+				return strings.Compare(a.GroupID, b.GroupID)
+			})
+			if diff := cmp.Diff(records, tc.wantRecords); diff != "" {
+				t.Errorf("unexpected records (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+type testArchiverClient struct {
+	*MemoryGroupReadWriter
+	archived []string
+}
+
+func (tc *testArchiverClient) ArchiveGroup(ctx context.Context, groupID string) error {
+	tc.archived = append(tc.archived, groupID)
+	return tc.SetMembers(ctx, groupID, nil)
+}
+
+func TestManagedGroups_CleanUp(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	managed := NewManagedGroups(state.NewMemoryStore(), UnmappedGroupNone)
+	writer := &MemoryGroupReadWriter{}
+	if err := managed.reconcile(ctx, []string{"97", "98", "99"}, writer); err != nil {
+		t.Fatalf("reconcile failed: %v", err)
+	}
+
+	orphans, err := managed.Orphans(ctx, []string{"99"})
+	if err != nil {
+		t.Fatalf("Orphans failed: %v", err)
+	}
+	var got []string
+	for _, r := range orphans {
+		got = append(got, r.GroupID)
+	}
+	if diff := cmp.Diff(got, []string{"97", "98"}); diff != "" {
+		t.Errorf("unexpected orphans (-got, +want):\n%s", diff)
+	}
+
+	cases := []struct {
+		name         string
+		groupID      string
+		writer       GroupWriter
+		wantArchived []string
+	}{
+		{
+			name:    "archive",
+			groupID: "97",
+			writer: &testArchiverClient{MemoryGroupReadWriter: &MemoryGroupReadWriter{
+				Members: map[string][]Member{"97": {}},
+			}},
+			wantArchived: []string{"97"},
+		},
+		{
+			name:    "remove_members",
+			groupID: "98",
+			writer: &MemoryGroupReadWriter{Members: map[string][]Member{
+				"98": {&UserMember{Usr: &User{ID: "alice"}}},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		if err := managed.CleanUp(ctx, tc.groupID, tc.writer); err != nil {
+			t.Fatalf("%s: CleanUp failed: %v", tc.name, err)
+		}
+		switch w := tc.writer.(type) {
+		case *testArchiverClient:
+			if diff := cmp.Diff(w.archived, tc.wantArchived); diff != "" {
+				t.Errorf("%s: unexpected archived groups (-got, +want):\n%s", tc.name, diff)
+			}
+		case *MemoryGroupReadWriter:
+			if got := w.Members[tc.groupID]; len(got) != 0 {
+				t.Errorf("%s: cleaned up group has members %v, want none", tc.name, got)
+			}
+		}
+	}
+
+	orphans, err = managed.Orphans(ctx, []string{"99"})
+	if err != nil {
+		t.Fatalf("Orphans failed: %v", err)
+	}
+	if len(orphans) != 0 {
+		t.Errorf("got orphans %v after clean up, want none", orphans)
+	}
+}
+
+func TestManagedGroups_Track(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	managed := NewManagedGroups(state.NewMemoryStore(), UnmappedGroupNone)
+	managed.now = func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) }
+	if err := managed.reconcile(ctx, []string{"97"}, &MemoryGroupReadWriter{}); err != nil {
+		t.Fatalf("reconcile failed: %v", err)
+	}
+
+	managed.now = func() time.Time { return time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC) }
+	if err := managed.Track(ctx, []string{"97", "98"}); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+
+	got, err := managed.Records(ctx)
+	if err != nil {
+		t.Fatalf("Records failed: %v", err)
+	}
+	want := []*ManagedGroupRecord{
+		{GroupID: "97", LastMappedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{GroupID: "98", LastMappedAt: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected records (-got, +want):\n%s", diff)
+	}
+}
@@ -18,10 +18,195 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/abcxyz/pkg/logging"
 )
 
+const (
+	// DefaultRetryBackoff is the default delay before the first retry round.
+	// Each subsequent round doubles the delay.
+	DefaultRetryBackoff = 5 * time.Second
+)
+
+// Config holds the optional behavior of a ManyToManySyncer.
+type Config struct {
+	retryAttempts int
+	retryBackoff  time.Duration
+	deadLetters   *DeadLetterQueue
+	history       *RunHistory
+	freezeWindows []FreezeWindow
+	groupFreeze   map[string][]FreezeWindow
+	flaps         *FlapDetector
+	roleConflicts RoleConflictPolicy
+	suspended     *SuspendedUsers
+	deleted       *DeletedGroups
+	managed       *ManagedGroups
+	budget        *APIBudget
+	normalizeIDs  IDNormalizer
+	domains       *DomainPolicy
+	concurrency   int
+	static        map[string][]Member
+	memberLimits  map[string]int
+}
+
+// Opt configures a ManyToManySyncer.
+type Opt func(config *Config)
+
+// WithRetry enables an in-run retry queue. Target groups that fail with a
+// retryable error (rate limiting or a transient failure of the group system)
+// are queued and retried after all other groups have been synced, in up to
+// maxAttempts-1 additional rounds. Before each round the syncer waits for
+// backoff, doubling the delay every round. A zero backoff uses
+// DefaultRetryBackoff. By default failed groups are not retried.
+func WithRetry(maxAttempts int, backoff time.Duration) Opt {
+	return func(config *Config) {
+		config.retryAttempts = maxAttempts
+		if backoff <= 0 {
+			backoff = DefaultRetryBackoff
+		}
+		config.retryBackoff = backoff
+	}
+}
+
+// WithDeadLetterQueue records groups that fail SyncAll runs in the given
+// queue. Groups that have been dead-lettered are skipped until their record
+// is cleared, so that chronic misconfigurations stop consuming API quota.
+func WithDeadLetterQueue(q *DeadLetterQueue) Opt {
+	return func(config *Config) {
+		config.deadLetters = q
+	}
+}
+
+// WithRunHistory saves a report of each SyncAll run, including the members
+// each target group was set to, to the given history.
+func WithRunHistory(h *RunHistory) Opt {
+	return func(config *Config) {
+		config.history = h
+	}
+}
+
+// WithFreezeWindows defers removals from target groups while any of the given
+// windows is active. Members that would be removed are kept and logged as
+// pending removals, which happen on the first run after the window. Additions
+// are not deferred.
+func WithFreezeWindows(windows ...FreezeWindow) Opt {
+	return func(config *Config) {
+		config.freezeWindows = append(config.freezeWindows, windows...)
+	}
+}
+
+// WithGroupFreezeWindows defers removals from the target groups, keyed by ID,
+// while any of their windows is active, like WithFreezeWindows does for every
+// target group.
+func WithGroupFreezeWindows(windows map[string][]FreezeWindow) Opt {
+	return func(config *Config) {
+		config.groupFreeze = windows
+	}
+}
+
+// WithFlapDetector suppresses the additions to and removals from target groups
+// of members which flap, as decided by the given detector. Flapping members
+// are logged with "alert": true and stay as they are until their record is
+// cleared.
+func WithFlapDetector(d *FlapDetector) Opt {
+	return func(config *Config) {
+		config.flaps = d
+	}
+}
+
+// WithRoleConflictPolicy sets how the role of a target user is decided when
+// several source users of a target group map to it with different roles.
+// By default the role of the source user whose ID sorts first wins.
+func WithRoleConflictPolicy(policy RoleConflictPolicy) Opt {
+	return func(config *Config) {
+		config.roleConflicts = policy
+	}
+}
+
+// WithSuspendedUsers applies the policy of s to the suspended users of source
+// groups, if the source group reader is a SuspendedUserReader. By default
+// suspended users are synced like active users.
+func WithSuspendedUsers(s *SuspendedUsers) Opt {
+	return func(config *Config) {
+		config.suspended = s
+	}
+}
+
+// WithDeletedGroups applies the policy of d to the target groups of source
+// groups which no longer exist. By default their sync fails.
+func WithDeletedGroups(d *DeletedGroups) Opt {
+	return func(config *Config) {
+		config.deleted = d
+	}
+}
+
+// WithManagedGroups records the target groups synced by SyncAll in m, and
+// applies its UnmappedGroupPolicy to the recorded target groups which are no
+// longer mapped.
+func WithManagedGroups(m *ManagedGroups) Opt {
+	return func(config *Config) {
+		config.managed = m
+	}
+}
+
+// WithAPIBudget defers the target groups reached once the given budget is
+// exhausted to the next run. The budget is spent by the target writer, e.g.
+// a BudgetedWriter. Deferred groups are logged at the end of the run and
+// recorded in its report, and do not count as failures.
+func WithAPIBudget(b *APIBudget) Opt {
+	return func(config *Config) {
+		config.budget = b
+	}
+}
+
+// WithSourceIDNormalizer looks up the mappings of source users by their IDs
+// normalized by normalize, e.g. CaseInsensitiveIDs, so that source IDs
+// spelled differently than in the user mappings still map. The user mapper's
+// source IDs must be normalized the same way.
+func WithSourceIDNormalizer(normalize IDNormalizer) Opt {
+	return func(config *Config) {
+		config.normalizeIDs = normalize
+	}
+}
+
+// WithDomainPolicy applies p to the users of each source group, before they
+// are mapped to target users. By default users of every domain are synced.
+func WithDomainPolicy(p *DomainPolicy) Opt {
+	return func(config *Config) {
+		config.domains = p
+	}
+}
+
+// WithConcurrency syncs at most n source groups concurrently. By default, or
+// if n is not positive, as many as runtime.NumCPU.
+func WithConcurrency(n int) Opt {
+	return func(config *Config) {
+		config.concurrency = n
+	}
+}
+
+// WithStaticMembers keeps the given members, keyed by target group ID, in
+// their target groups along with the members mapped from source groups, e.g.
+// bot accounts which are in no source group. Static members already mapped
+// from a source group are not added again.
+func WithStaticMembers(members map[string][]Member) Opt {
+	return func(config *Config) {
+		config.static = members
+	}
+}
+
+// WithMemberLimits fails the sync of the target groups, keyed by ID, which
+// would get more members than the given limits, instead of writing them.
+// Limits that are not positive are ignored.
+func WithMemberLimits(limits map[string]int) Opt {
+	return func(config *Config) {
+		config.memberLimits = limits
+	}
+}
+
 // ManyToManySyncer adheres to the v1alpha3.GroupSyncer interface.
 // This syncer allows for syncing many source groups to many target groups.
 // It adheres to the following policy when syncing a source group ID:
@@ -40,6 +225,28 @@ type ManyToManySyncer struct {
 	sourceGroupMapper     OneToManyGroupMapper
 	targetGroupMapper     OneToManyGroupMapper
 	userMapper            UserMapper
+	retryAttempts         int
+	retryBackoff          time.Duration
+	deadLetters           *DeadLetterQueue
+	history               *RunHistory
+	freezeWindows         []FreezeWindow
+	groupFreezeWindows    map[string][]FreezeWindow
+	flaps                 *FlapDetector
+	roleConflictPolicy    RoleConflictPolicy
+	suspendedUsers        *SuspendedUsers
+	deletedGroups         *DeletedGroups
+	managedGroups         *ManagedGroups
+	budget                *APIBudget
+	normalizeIDs          IDNormalizer
+	domains               *DomainPolicy
+	concurrency           int
+	staticMembers         map[string][]Member
+	memberLimits          map[string]int
+	now                   func() time.Time
+
+	// descendants shares the expansion of a source group between the target
+	// groups which are synced concurrently from it.
+	descendants flight[[]*User]
 }
 
 // NewManyToManySyncer creates a new ManyToManySyncer.
@@ -50,7 +257,16 @@ func NewManyToManySyncer(
 	sourceGroupMapper OneToManyGroupMapper,
 	targetGroupMapper OneToManyGroupMapper,
 	userMapper UserMapper,
+	opts ...Opt,
 ) *ManyToManySyncer {
+	config := &Config{
+		retryAttempts: 1,
+		retryBackoff:  DefaultRetryBackoff,
+		roleConflicts: RoleConflictFirstWins,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
 	return &ManyToManySyncer{
 		sourceSystem:          sourceSystem,
 		targetSystem:          targetSystem,
@@ -59,6 +275,24 @@ func NewManyToManySyncer(
 		sourceGroupMapper:     sourceGroupMapper,
 		targetGroupMapper:     targetGroupMapper,
 		userMapper:            userMapper,
+		retryAttempts:         config.retryAttempts,
+		retryBackoff:          config.retryBackoff,
+		deadLetters:           config.deadLetters,
+		history:               config.history,
+		freezeWindows:         config.freezeWindows,
+		groupFreezeWindows:    config.groupFreeze,
+		flaps:                 config.flaps,
+		roleConflictPolicy:    config.roleConflicts,
+		suspendedUsers:        config.suspended,
+		deletedGroups:         config.deleted,
+		managedGroups:         config.managed,
+		budget:                config.budget,
+		normalizeIDs:          config.normalizeIDs,
+		concurrency:           config.concurrency,
+		staticMembers:         config.static,
+		memberLimits:          config.memberLimits,
+		domains:               config.domains,
+		now:                   time.Now,
 	}
 }
 
@@ -73,9 +307,16 @@ func (f *ManyToManySyncer) TargetSystem() string {
 }
 
 // Sync syncs the source group with the given ID to the target group system.
+// If one or more target groups fail to sync, the returned error is a *SyncError
+// describing each failure.
 func (f *ManyToManySyncer) Sync(ctx context.Context, sourceGroupID string) error {
 	logger := logging.FromContext(ctx)
 	logger.InfoContext(ctx, "starting sync", "source_group_id", sourceGroupID)
+	if f.skipDeadLettered(ctx, GroupKindSource, sourceGroupID) {
+		return nil
+	}
+	recorder := runRecorderFromContext(ctx)
+	syncErr := &SyncError{}
 	// get target group IDs for this source group ID
 	targetGroupIDs, err := f.sourceGroupMapper.MappedGroupIDs(ctx, sourceGroupID)
 	if err != nil {
@@ -83,116 +324,542 @@ func (f *ManyToManySyncer) Sync(ctx context.Context, sourceGroupID string) error
 			"source_group_id", sourceGroupID,
 			"error", err,
 		)
-		return fmt.Errorf("error fetching target group IDs: %s, %w", sourceGroupID, err)
+		ge := &GroupError{
+			SourceGroupID: sourceGroupID,
+			Category:      categorize(err, ErrorCategoryConfig),
+			Err:           fmt.Errorf("error fetching target group IDs: %s, %w", sourceGroupID, err),
+		}
+		syncErr.add(ge)
+		recorder.recordError(ge)
+		return syncErr.errOrNil()
 	}
+	recorder.clearSourceError(sourceGroupID)
 	logger.InfoContext(ctx, "found the following target group IDs to sync",
 		"source_group_id", sourceGroupID,
 		"target_group_ids", targetGroupIDs,
 	)
 
-	var merr error
 	for _, targetGroupID := range targetGroupIDs {
-		logger.InfoContext(ctx, "syncing target group ID",
-			"target_group_id", targetGroupID,
-		)
-		// get all source group IDs associated with the current target GroupID
-		sourceGroupIDs, err := f.targetGroupMapper.MappedGroupIDs(ctx, targetGroupID)
-		if err != nil {
-			logger.ErrorContext(ctx, "failed getting one ore more source group IDs for target group ID",
+		if ge := f.syncTargetGroup(ctx, sourceGroupID, targetGroupID); ge != nil {
+			syncErr.add(ge)
+			recorder.recordError(ge)
+		}
+	}
+
+	return syncErr.errOrNil()
+}
+
+// syncTargetGroup syncs a single target group on behalf of the given source
+// group. It returns nil on success.
+func (f *ManyToManySyncer) syncTargetGroup(ctx context.Context, sourceGroupID, targetGroupID string) *GroupError {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "syncing target group ID",
+		"target_group_id", targetGroupID,
+	)
+	if f.skipDeadLettered(ctx, GroupKindTarget, targetGroupID) {
+		return nil
+	}
+	if f.budget != nil {
+		if f.budget.exhausted(ctx, targetGroupID) {
+			logger.WarnContext(ctx, "api budget exhausted, deferring target group to the next run",
 				"target_group_id", targetGroupID,
-				"source_group_ids", sourceGroupIDs,
-				"error", err,
 			)
-			merr = errors.Join(merr, fmt.Errorf("error getting associated source group ids: %w", err))
-			// cannot map this targetGroupID successfully so abort and move on to the next one
-			continue
+			runRecorderFromContext(ctx).recordDeferred(targetGroupID, sourceGroupID)
+			return nil
+		}
+		defer func() {
+			if err := f.budget.save(ctx); err != nil {
+				logger.WarnContext(ctx, "failed to save api budget usage", "error", err)
+			}
+		}()
+	}
+	groupErr := func(category ErrorCategory, err error) *GroupError {
+		return &GroupError{
+			SourceGroupID: sourceGroupID,
+			TargetGroupID: targetGroupID,
+			Category:      categorize(err, category),
+			Err:           err,
 		}
-		logger.InfoContext(ctx, "found source group ID(s) for target Group ID",
+	}
+
+	// get all source group IDs associated with the current target GroupID
+	sourceGroupIDs, err := f.targetGroupMapper.MappedGroupIDs(ctx, targetGroupID)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed getting one ore more source group IDs for target group ID",
 			"target_group_id", targetGroupID,
 			"source_group_ids", sourceGroupIDs,
+			"error", err,
 		)
+		// cannot map this targetGroupID successfully so abort and move on to the next one
+		return groupErr(ErrorCategoryConfig, fmt.Errorf("error getting associated source group ids: %w", err))
+	}
+	logger.InfoContext(ctx, "found source group ID(s) for target Group ID",
+		"target_group_id", targetGroupID,
+		"source_group_ids", sourceGroupIDs,
+	)
 
-		// get the union of all users that are members of each source group
-		sourceUsers, err := f.sourceUsers(ctx, sourceGroupIDs)
-		sourceUserIds := userIDs(sourceUsers)
-		if err != nil {
-			logger.ErrorContext(ctx, "failed getting one or more source users for source group IDs",
-				"source_group_ids", sourceGroupIDs,
-				"source_user_ids", sourceUserIds,
-				"error", err,
+	// get the union of all users that are members of each source group
+	sourceUsers, deletedGroupIDs, err := f.sourceUsers(ctx, sourceGroupIDs)
+	sourceUserIds := userIDs(sourceUsers)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed getting one or more source users for source group IDs",
+			"source_group_ids", sourceGroupIDs,
+			"source_user_ids", sourceUserIds,
+			"error", err,
+		)
+		// cannot map this targetGroupID successfully so abort and move on to the next one
+		return groupErr(ErrorCategoryAPI, fmt.Errorf("error getting one or more source users: %w", err))
+	}
+	logger.InfoContext(ctx, "found descendant(s) for source group ID(s)",
+		"source_group_ids", sourceGroupIDs,
+		"source_user_ids", sourceUserIds,
+	)
+	if len(deletedGroupIDs) > 0 {
+		switch f.deletedGroups.policy {
+		case DeletedGroupSkip:
+			logger.WarnContext(ctx, "skipping target group of deleted source groups",
+				"target_group_id", targetGroupID,
+				"deleted_source_group_ids", deletedGroupIDs,
+			)
+			return nil
+		case DeletedGroupEmpty:
+			current, err := f.currentMembers(ctx, targetGroupID)
+			if err == nil {
+				err = f.deletedGroups.snapshot(ctx, targetGroupID, deletedGroupIDs, current)
+			}
+			if err != nil {
+				logger.ErrorContext(ctx, "failed to snapshot target group of deleted source groups",
+					"target_group_id", targetGroupID,
+					"error", err,
+				)
+				return groupErr(ErrorCategoryAPI, fmt.Errorf("error snapshotting target group %s: %w", targetGroupID, err))
+			}
+			logger.WarnContext(ctx, "syncing target group without deleted source groups",
+				"target_group_id", targetGroupID,
+				"deleted_source_group_ids", deletedGroupIDs,
 			)
-			merr = errors.Join(merr, fmt.Errorf("error getting one or more source users: %w", err))
-			// cannot map this targetGroupID successfully so abort and move on to the next one
-			continue
 		}
-		logger.InfoContext(ctx, "found descendant(s) for source group ID(s)",
-			"source_group_ids", sourceGroupIDs,
+	}
+
+	// map each source user to their corresponding target users
+	targetUsers, err := f.targetUsers(ctx, sourceUsers)
+	targetUserIds := memberIDs(targetUsers)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed mapping one or more source users to their target user",
 			"source_user_ids", sourceUserIds,
+			"target_user_ids", targetUserIds,
+			"error", err,
+		)
+		// cannot map this targetGroupID successfully so abort and move on to the next one
+		return groupErr(ErrorCategoryMapping, fmt.Errorf("error getting one or more target users: %w", err))
+	}
+	logger.InfoContext(ctx, "mapped source users to target users",
+		"source_user_ids", sourceUserIds,
+		"target_user_ids", targetUserIds,
+	)
+
+	// map each targetUser to Member type
+	targetMembers := make([]Member, 0, len(targetUsers))
+	for _, member := range targetUsers {
+		targetMembers = append(targetMembers, member)
+	}
+	for _, member := range f.staticMembers[targetGroupID] {
+		if !slices.ContainsFunc(targetMembers, func(m Member) bool { return m.ID() == member.ID() }) {
+			targetMembers = append(targetMembers, member)
+		}
+	}
+	if limit := f.memberLimits[targetGroupID]; limit > 0 && len(targetMembers) > limit {
+		logger.ErrorContext(ctx, "target group would exceed its member limit",
+			"target_group_id", targetGroupID,
+			"source_group_ids", sourceGroupIDs,
+			"member_count", len(targetMembers),
+			"member_limit", limit,
 		)
+		return groupErr(ErrorCategoryConfig, fmt.Errorf("%w: target group %s would have %d members from source groups %v, more than its limit of %d",
+			ErrMemberLimitExceeded, targetGroupID, len(targetMembers), sourceGroupIDs, limit))
+	}
 
-		// map each source user to their corresponding target user
-		targetUsers, err := f.targetUsers(ctx, sourceUsers)
-		targetUserIds := userIDs(targetUsers)
+	// freeze windows and flap detection need the current members.
+	window := activeFreezeWindow(slices.Concat(f.freezeWindows, f.groupFreezeWindows[targetGroupID]), f.now())
+	var currentMembers []Member
+	if window != nil || f.flaps != nil {
+		currentMembers, err = f.currentMembers(ctx, targetGroupID)
 		if err != nil {
-			logger.ErrorContext(ctx, "failed mapping one or more source users to their target user",
-				"source_user_ids", sourceUserIds,
-				"target_user_ids", targetUserIds,
+			logger.ErrorContext(ctx, "failed getting current target group members",
+				"target_group_id", targetGroupID,
 				"error", err,
 			)
-			merr = errors.Join(merr, fmt.Errorf("error getting one or more target users: %w", err))
-			// cannot map this targetGroupID successfully so abort and move on to the next one
-			continue
+			return groupErr(ErrorCategoryAPI, fmt.Errorf("error getting current members of target group %s: %w", targetGroupID, err))
 		}
-		logger.InfoContext(ctx, "mapped source users to target users",
-			"source_user_ids", sourceUserIds,
-			"target_user_ids", targetUserIds,
-		)
+	}
 
-		// map each targetUser to Member type
-		targetMembers := make([]Member, 0, len(targetUsers))
-		for _, user := range targetUsers {
-			targetMembers = append(targetMembers, &UserMember{Usr: user})
+	// while frozen, members that would be removed are kept until the window ends.
+	var pendingRemovals []Member
+	if window != nil {
+		pendingRemovals = ComputeDiff(currentMembers, targetMembers).Remove
+		if len(pendingRemovals) > 0 {
+			logger.WarnContext(ctx, "deferring removals during freeze window",
+				"target_group_id", targetGroupID,
+				"freeze_window", fmt.Sprint(window),
+				"pending_removal_ids", memberIDs(pendingRemovals),
+			)
 		}
+		targetMembers = append(targetMembers, pendingRemovals...)
+	}
 
-		// targetMembers is now the canonical set of members for the target group ID.
-		// Set the target group's members to targetMembers.
-		logger.InfoContext(ctx, "setting target group ID members to target users",
+	// changes of members which flap are suppressed until a human looks at them.
+	var flaps *flapResult
+	if f.flaps != nil {
+		if flaps, err = f.flaps.filter(ctx, targetGroupID, currentMembers, targetMembers); err != nil {
+			logger.ErrorContext(ctx, "failed to check target group members for flapping",
+				"target_group_id", targetGroupID,
+				"error", err,
+			)
+			return groupErr(ErrorCategoryAPI, fmt.Errorf("error checking members of target group %s for flapping: %w", targetGroupID, err))
+		}
+		for _, r := range flaps.newlySuppressed {
+			logger.ErrorContext(ctx, "member is flapping, its changes are suppressed until cleared",
+				"alert", true,
+				"target_group_id", targetGroupID,
+				"member_id", r.MemberID,
+				"changes", len(r.Changes),
+			)
+		}
+		if len(flaps.suppressed) > 0 {
+			logger.WarnContext(ctx, "suppressing changes of flapping members",
+				"target_group_id", targetGroupID,
+				"suppressed_member_ids", flaps.suppressed,
+			)
+		}
+		targetMembers = flaps.members
+	}
+
+	// targetMembers is now the canonical set of members for the target group ID.
+	// Set the target group's members to targetMembers.
+	logger.InfoContext(ctx, "setting target group ID members to target users",
+		"target_group_id", targetGroupID,
+		"target_user_ids", targetUserIds,
+	)
+	if err := f.targetGroupReadWriter.SetMembers(ctx, targetGroupID, targetMembers); err != nil {
+		if errors.Is(err, ErrNotConfirmed) {
+			// a declined removal is an operator decision, not a failure, so the
+			// group is neither retried nor dead-lettered.
+			logger.WarnContext(ctx, "removal of members was not confirmed, skipping target group",
+				"target_group_id", targetGroupID,
+				"error", err,
+			)
+			runRecorderFromContext(ctx).recordDeclined(targetGroupID, sourceGroupID)
+			return nil
+		}
+		logger.ErrorContext(ctx, "failed setting target group members",
 			"target_group_id", targetGroupID,
-			"target_user_ids", targetUserIds,
+			"error", err,
 		)
-		if err := f.targetGroupReadWriter.SetMembers(ctx, targetGroupID, targetMembers); err != nil {
-			logger.ErrorContext(ctx, "failed setting target group members",
+		return groupErr(ErrorCategoryAPI, fmt.Errorf("error setting members to target group %s: %w", targetGroupID, err))
+	}
+	if flaps != nil {
+		if err := f.flaps.recordChanges(ctx, targetGroupID, flaps.changed); err != nil {
+			// the members are set, only flap detection of this run is affected.
+			logger.WarnContext(ctx, "failed to record target group member changes",
 				"target_group_id", targetGroupID,
 				"error", err,
 			)
-			merr = fmt.Errorf("error setting members to target group %s: %w", targetGroupID, err)
 		}
 	}
+	runRecorderFromContext(ctx).recordMembers(targetGroupID, sourceGroupIDs, targetUserIds, memberIDs(pendingRemovals))
+	return nil
+}
 
-	return merr
+// currentMembers returns the current members of the target group.
+func (f *ManyToManySyncer) currentMembers(ctx context.Context, targetGroupID string) ([]Member, error) {
+	reader, ok := f.targetGroupReadWriter.(GroupReader)
+	if !ok {
+		return nil, fmt.Errorf("target system %s cannot read current members", f.targetSystem)
+	}
+	current, err := reader.GetMembers(ctx, targetGroupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current members: %w", err)
+	}
+	return current, nil
 }
 
 // SyncAll syncs all source groups that this GroupSyncer is aware of to the target system.
+// If one or more groups fail to sync, the returned error wraps a *SyncError.
+// When retries are enabled, retryable failures are retried after all groups
+// have been synced once. See WithRetry. When a run history is configured, the
+// report of the run is saved to it. See WithRunHistory.
 func (f *ManyToManySyncer) SyncAll(ctx context.Context) error {
+	return f.recordRun(ctx, f.syncAll)
+}
+
+// SyncSince syncs the source groups which changed since the given time, e.g.
+// to replay the changes missed by an event-driven deployment, instead of all
+// source groups. The source group reader must be a ChangeLogReader. Failures
+// are retried, dead-lettered and recorded like those of SyncAll.
+func (f *ManyToManySyncer) SyncSince(ctx context.Context, since time.Time) error {
+	changeLog, ok := f.sourceGroupReader.(ChangeLogReader)
+	if !ok {
+		return fmt.Errorf("source system %s does not support listing changed groups", f.sourceSystem)
+	}
+	return f.recordRun(ctx, func(ctx context.Context) error {
+		changedIDs, err := changeLog.ChangedGroupIDs(ctx, since)
+		if err != nil {
+			return fmt.Errorf("error fetching changed source group IDs: %w", err)
+		}
+		var sourceGroupIDs, targetGroupIDs []string
+		for _, id := range changedIDs {
+			mapped, err := f.sourceGroupMapper.ContainsGroupID(ctx, id)
+			if err != nil {
+				return fmt.Errorf("error checking source group ID %s: %w", id, err)
+			}
+			if !mapped || slices.Contains(sourceGroupIDs, id) {
+				continue
+			}
+			sourceGroupIDs = append(sourceGroupIDs, id)
+			// a source group that fails to map is reported by its sync.
+			ids, _ := f.sourceGroupMapper.MappedGroupIDs(ctx, id)
+			targetGroupIDs = append(targetGroupIDs, ids...)
+		}
+		logging.FromContext(ctx).InfoContext(ctx, "syncing changed source groups",
+			"since", since,
+			"changed_group_ids", changedIDs,
+			"source_group_ids", sourceGroupIDs,
+		)
+		slices.Sort(targetGroupIDs)
+		return f.syncGroups(ctx, sourceGroupIDs, slices.Compact(targetGroupIDs))
+	})
+}
+
+// recordRun runs a sync of several groups and saves its report to the run
+// history, if any.
+func (f *ManyToManySyncer) recordRun(ctx context.Context, run func(ctx context.Context) error) error {
+	if f.history == nil {
+		return run(ctx)
+	}
+	startedAt := f.history.now()
+	ctx, recorder := withRunRecorder(ctx)
+	err := run(ctx)
+	report := &RunReport{
+		ID:           startedAt.UTC().Format(runIDLayout),
+		SourceSystem: f.sourceSystem,
+		TargetSystem: f.targetSystem,
+		StartedAt:    startedAt,
+		FinishedAt:   f.history.now(),
+		Groups:       recorder.reports(),
+		Writes:       recorder.totalWrites(),
+	}
+	if err != nil {
+		report.Error = err.Error()
+	}
+	if hErr := f.history.save(ctx, report); hErr != nil {
+		err = errors.Join(err, hErr)
+	}
+	return err
+}
+
+func (f *ManyToManySyncer) syncAll(ctx context.Context) error {
 	sourceGroupIDs, err := f.sourceGroupMapper.AllGroupIDs(ctx)
 	if err != nil {
 		return fmt.Errorf("error fetching source group IDs: %w", err)
 	}
-	if err := ConcurrentSync(ctx, f, sourceGroupIDs); err != nil {
+	var targetGroupIDs []string
+	if f.deadLetters != nil || f.managedGroups != nil {
+		if targetGroupIDs, err = f.targetGroupMapper.AllGroupIDs(ctx); err != nil {
+			return fmt.Errorf("error fetching target group IDs: %w", err)
+		}
+	}
+	err = f.syncGroups(ctx, sourceGroupIDs, targetGroupIDs)
+	if f.managedGroups != nil {
+		if mErr := f.managedGroups.reconcile(ctx, targetGroupIDs, f.targetGroupReadWriter); mErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to handle unmapped target groups: %w", mErr))
+		}
+	}
+	return err
+}
+
+// syncGroups syncs the given source groups, retries the failures and records
+// the dead letters of the given source and target groups.
+func (f *ManyToManySyncer) syncGroups(ctx context.Context, sourceGroupIDs, targetGroupIDs []string) error {
+	err := ConcurrentSyncN(ctx, f, sourceGroupIDs, f.concurrency)
+	var syncErr *SyncError
+	if errors.As(err, &syncErr) {
+		err = f.retry(ctx, syncErr)
+	}
+	if f.budget != nil {
+		if deferred := f.budget.Deferred(); len(deferred) > 0 {
+			logging.FromContext(ctx).WarnContext(ctx, "api budget exhausted, target groups deferred to the next run",
+				"target_system", f.targetSystem,
+				"deferred_target_group_ids", deferred,
+			)
+			// deferred groups were not attempted, so their failure records stay.
+			targetGroupIDs = slices.DeleteFunc(slices.Clone(targetGroupIDs), func(id string) bool {
+				_, ok := slices.BinarySearch(deferred, id)
+				return ok
+			})
+		}
+	}
+	if dlErr := f.recordDeadLetters(ctx, sourceGroupIDs, targetGroupIDs, err); dlErr != nil {
+		err = errors.Join(err, dlErr)
+	}
+	if err != nil {
 		return fmt.Errorf("failed to sync one or more IDs: %w", err)
 	}
 	return nil
 }
 
-func (f *ManyToManySyncer) sourceUsers(ctx context.Context, sourceGroupIDs []string) ([]*User, error) {
+// skipDeadLettered reports whether the given group is dead-lettered and
+// should not be synced. Failing to read the record does not prevent a sync.
+func (f *ManyToManySyncer) skipDeadLettered(ctx context.Context, kind GroupKind, groupID string) bool {
+	if f.deadLetters == nil {
+		return false
+	}
+	logger := logging.FromContext(ctx)
+	skip, err := f.deadLetters.DeadLettered(ctx, kind, groupID)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to check dead-letter record, syncing anyway",
+			"group_kind", kind,
+			"group_id", groupID,
+			"error", err,
+		)
+		return false
+	}
+	if skip {
+		logger.WarnContext(ctx, "skipping dead-lettered group",
+			"group_kind", kind,
+			"group_id", groupID,
+		)
+	}
+	return skip
+}
+
+// recordDeadLetters updates the dead-letter records of the groups attempted by
+// a run with its outcome and alerts on groups that were dead-lettered by it.
+func (f *ManyToManySyncer) recordDeadLetters(ctx context.Context, sourceGroupIDs, targetGroupIDs []string, runErr error) error {
+	if f.deadLetters == nil {
+		return nil
+	}
+	var failures []*GroupError
+	var syncErr *SyncError
+	if errors.As(runErr, &syncErr) {
+		failures = syncErr.Errors
+	}
+	deadLettered, err := f.deadLetters.recordRun(ctx, map[GroupKind][]string{
+		GroupKindSource: sourceGroupIDs,
+		GroupKindTarget: targetGroupIDs,
+	}, failures)
+	logger := logging.FromContext(ctx)
+	for _, r := range deadLettered {
+		logger.ErrorContext(ctx, "group dead-lettered, it will be skipped until cleared",
+			"alert", true,
+			"group_kind", r.Kind,
+			"group_id", r.GroupID,
+			"consecutive_failures", r.ConsecutiveFailures,
+			"category", r.Category,
+			"last_error", r.LastError,
+		)
+	}
+	return err
+}
+
+// retry processes the retryable failures of syncErr as a queue, retrying
+// them in rounds until they succeed, fail with a non-retryable error, or the
+// configured attempts are exhausted. It returns the remaining failures.
+func (f *ManyToManySyncer) retry(ctx context.Context, syncErr *SyncError) error {
+	logger := logging.FromContext(ctx)
+	remaining := &SyncError{}
+	var queue []*GroupError
+	for _, ge := range syncErr.Errors {
+		if retryable(ge) {
+			queue = append(queue, ge)
+		} else {
+			remaining.add(ge)
+		}
+	}
+
+	backoff := f.retryBackoff
+	for attempt := 2; attempt <= f.retryAttempts && len(queue) > 0; attempt++ {
+		logger.InfoContext(ctx, "retrying failed groups",
+			"attempt", attempt,
+			"backoff", backoff,
+			"queued_groups", len(queue),
+		)
+		select {
+		case <-ctx.Done():
+			for _, ge := range queue {
+				remaining.add(ge)
+			}
+			return remaining.errOrNil()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+
+		var next []*GroupError
+		// several source groups may report the same failed target group, but
+		// syncing a target group always considers all of its source groups so
+		// each target group only needs to be retried once per round.
+		retriedSources := make(map[string]struct{}, len(queue))
+		retriedTargets := make(map[string]struct{}, len(queue))
+		for _, ge := range queue {
+			retried, key := retriedTargets, ge.TargetGroupID
+			if ge.TargetGroupID == "" {
+				retried, key = retriedSources, ge.SourceGroupID
+			}
+			if _, ok := retried[key]; ok {
+				continue
+			}
+			retried[key] = struct{}{}
+
+			var failures []*GroupError
+			if ge.TargetGroupID == "" {
+				if err := f.Sync(ctx, ge.SourceGroupID); err != nil {
+					var retryErr *SyncError
+					if errors.As(err, &retryErr) {
+						failures = retryErr.Errors
+					}
+				}
+			} else if retryGE := f.syncTargetGroup(ctx, ge.SourceGroupID, ge.TargetGroupID); retryGE != nil {
+				failures = []*GroupError{retryGE}
+				runRecorderFromContext(ctx).recordError(retryGE)
+			}
+			for _, failure := range failures {
+				if retryable(failure) {
+					next = append(next, failure)
+				} else {
+					remaining.add(failure)
+				}
+			}
+		}
+		queue = next
+	}
+
+	for _, ge := range queue {
+		remaining.add(ge)
+	}
+	return remaining.errOrNil()
+}
+
+// sourceUsers returns the union of the descendants of the given source groups,
+// and the source groups which no longer exist if a deleted group policy other
+// than DeletedGroupError applies to them.
+func (f *ManyToManySyncer) sourceUsers(ctx context.Context, sourceGroupIDs []string) ([]*User, []string, error) {
 	var merr error
+	var deleted []string
 	userMap := make(map[string]*User)
 	for _, sourceGroupID := range sourceGroupIDs {
-		sourceUsers, err := f.sourceGroupReader.Descendants(ctx, sourceGroupID)
+		sourceUsers, err := f.descendants.do(sourceGroupID, func() ([]*User, error) {
+			return f.sourceGroupReader.Descendants(ctx, sourceGroupID) //nolint:wrapcheck // Want passthrough
+		})
+		if errors.Is(err, ErrGroupNotFound) && f.deletedGroups != nil && f.deletedGroups.policy != DeletedGroupError {
+			deleted = append(deleted, sourceGroupID)
+			continue
+		}
 		if err != nil {
 			merr = errors.Join(merr, fmt.Errorf("error fetching source group users: %s, %w", sourceGroupID, err))
 			continue
 		}
+		if f.domains != nil {
+			sourceUsers = f.domains.filter(ctx, sourceGroupID, sourceUsers)
+		}
 		for _, sourceUser := range sourceUsers {
 			userMap[sourceUser.ID] = sourceUser
 		}
@@ -201,25 +868,104 @@ func (f *ManyToManySyncer) sourceUsers(ctx context.Context, sourceGroupIDs []str
 	for _, user := range userMap {
 		users = append(users, user)
 	}
-	return users, merr
+	// sorted so that conflicting mappings resolve the same way every run.
+	slices.SortFunc(users, func(a, b *User) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	if reader, ok := f.sourceGroupReader.(SuspendedUserReader); ok && f.suspendedUsers != nil && merr == nil {
+		filtered, err := f.suspendedUsers.filter(ctx, reader, users)
+		if err != nil {
+			return users, deleted, fmt.Errorf("error applying suspended user policy: %w", err)
+		}
+		users = filtered
+	}
+	return users, deleted, merr
 }
 
-func (f *ManyToManySyncer) targetUsers(ctx context.Context, sourceUsers []*User) ([]*User, error) {
+func (f *ManyToManySyncer) targetUsers(ctx context.Context, sourceUsers []*User) ([]*UserMember, error) {
 	var merr error
-	targetUsers := make([]*User, 0, len(sourceUsers))
+	targetMembers := make([]*UserMember, 0, len(sourceUsers))
+	// several source users may map to the same target user.
+	type mappedMember struct {
+		sourceUserID string
+		member       *UserMember
+	}
+	mappedFrom := make(map[string]*mappedMember, len(sourceUsers))
+	if f.normalizeIDs != nil {
+		sourceUsers = normalizeUsers(sourceUsers, f.normalizeIDs)
+	}
+	mapUser, err := f.userMapFunc(ctx, sourceUsers)
+	if err != nil {
+		return nil, fmt.Errorf("error mapping source users to target users: %w", err)
+	}
 	for _, sourceUser := range sourceUsers {
-		targetUserID, err := f.userMapper.MappedUserID(ctx, sourceUser.ID)
+		mappedUsers, err := mapUser(sourceUser.ID)
 		if errors.Is(err, ErrTargetUserIDNotFound) {
 			// if there is no mapping for the target user we will just skip them.
+			logging.FromContext(ctx).DebugContext(ctx, "skipping source user without a mapping",
+				"source_user_id", sourceUser.ID,
+			)
 			continue
 		}
 		if err != nil {
-			merr = fmt.Errorf("error mapping source user id %s to target user id: %w", sourceUser.ID, err)
+			merr = errors.Join(merr, &UserMappingError{SourceUserID: sourceUser.ID, Err: err})
 			continue
 		}
-		targetUsers = append(targetUsers, &User{ID: targetUserID})
+		for _, mapped := range mappedUsers {
+			if first, ok := mappedFrom[mapped.ID]; ok {
+				logger := logging.FromContext(ctx)
+				logger.DebugContext(ctx, "source users map to the same target user",
+					"source_user_ids", []string{first.sourceUserID, sourceUser.ID},
+					"target_user_id", mapped.ID,
+				)
+				role, err := f.roleConflictPolicy.resolve(first.member.Role, mapped.Role)
+				if err != nil {
+					merr = errors.Join(merr, fmt.Errorf("source users %s and %s map to target user %s: %w",
+						first.sourceUserID, sourceUser.ID, mapped.ID, err))
+					continue
+				}
+				if mapped.Role != first.member.Role {
+					logger.WarnContext(ctx, "source users map to the same target user with different roles",
+						"source_user_ids", []string{first.sourceUserID, sourceUser.ID},
+						"target_user_id", mapped.ID,
+						"roles", []string{first.member.Role, mapped.Role},
+						"role", role,
+						"policy", f.roleConflictPolicy,
+					)
+				}
+				first.member.Role = role
+				continue
+			}
+			member := &UserMember{Usr: &User{ID: mapped.ID}, Role: mapped.Role}
+			mappedFrom[mapped.ID] = &mappedMember{sourceUserID: sourceUser.ID, member: member}
+			targetMembers = append(targetMembers, member)
+		}
+	}
+	return targetMembers, merr
+}
+
+// userMapFunc returns a function which maps a source user ID to its target
+// users. For a BatchUserMapper, all the given source users are mapped up
+// front in a single call.
+func (f *ManyToManySyncer) userMapFunc(ctx context.Context, sourceUsers []*User) (func(userID string) ([]*MappedUser, error), error) {
+	_, multi := f.userMapper.(MultiUserMapper)
+	bm, batch := f.userMapper.(BatchUserMapper)
+	if multi || !batch {
+		return func(userID string) ([]*MappedUser, error) {
+			return MappedUsers(ctx, f.userMapper, userID)
+		}, nil
 	}
-	return targetUsers, merr
+	mapped, err := bm.MappedUserIDs(ctx, userIDs(sourceUsers))
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return func(userID string) ([]*MappedUser, error) {
+		targetUserID, ok := mapped[userID]
+		if !ok {
+			return nil, ErrTargetUserIDNotFound
+		}
+		return []*MappedUser{{ID: targetUserID}}, nil
+	}, nil
 }
 
 func userIDs(users []*User) []string {
@@ -229,3 +975,11 @@ func userIDs(users []*User) []string {
 	}
 	return ids
 }
+
+func memberIDs[M Member](members []M) []string {
+	ids := make([]string, 0, len(members))
+	for _, member := range members {
+		ids = append(ids, member.ID())
+	}
+	return ids
+}
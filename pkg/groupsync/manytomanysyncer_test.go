@@ -16,10 +16,11 @@ package groupsync
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"sort"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -46,8 +47,8 @@ func TestSync(t *testing.T) {
 			name:         "simple_mapping",
 			sourceSystem: "source",
 			targetSystem: "target",
-			sourceGroupClient: &testReadWriteGroupClient{
-				groupMembers: map[string][]Member{
+			sourceGroupClient: &MemoryGroupReadWriter{
+				Members: map[string][]Member{
 					"1": {
 						&UserMember{Usr: &User{ID: "a"}},
 						&UserMember{Usr: &User{ID: "b"}},
@@ -69,7 +70,7 @@ func TestSync(t *testing.T) {
 						&UserMember{Usr: &User{ID: "c"}},
 					},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"a": {ID: "a"},
 					"b": {ID: "b"},
 					"c": {ID: "c"},
@@ -77,21 +78,21 @@ func TestSync(t *testing.T) {
 					"e": {ID: "e"},
 				},
 			},
-			targetGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			targetGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"99": {ID: "99"},
 					"98": {ID: "98"},
 					"97": {ID: "97"},
 					"96": {ID: "96"},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"xy": {ID: "xy"},
 					"zw": {ID: "zw"},
 					"qr": {ID: "qr"},
 					"uv": {ID: "uv"},
 					"st": {ID: "st"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"99": {},
 					"98": {},
 					"97": {},
@@ -139,14 +140,14 @@ func TestSync(t *testing.T) {
 			name:         "many_to_many_mapping",
 			sourceSystem: "source",
 			targetSystem: "target",
-			sourceGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			sourceGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"1": {ID: "1"},
 					"2": {ID: "3"},
 					"3": {ID: "4"},
 					"4": {ID: "5"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"1": {
 						&UserMember{Usr: &User{ID: "a"}},
 						&UserMember{Usr: &User{ID: "b"}},
@@ -168,7 +169,7 @@ func TestSync(t *testing.T) {
 						&UserMember{Usr: &User{ID: "c"}},
 					},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"a": {ID: "a"},
 					"b": {ID: "b"},
 					"c": {ID: "c"},
@@ -176,21 +177,21 @@ func TestSync(t *testing.T) {
 					"e": {ID: "e"},
 				},
 			},
-			targetGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			targetGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"99": {ID: "99"},
 					"98": {ID: "98"},
 					"97": {ID: "97"},
 					"96": {ID: "96"},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"xy": {ID: "xy"},
 					"zw": {ID: "zw"},
 					"qr": {ID: "qr"},
 					"uv": {ID: "uv"},
 					"st": {ID: "st"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"99": {},
 					"98": {},
 					"97": {},
@@ -245,8 +246,8 @@ func TestSync(t *testing.T) {
 			name:              "no_target_ids_found",
 			sourceSystem:      "source",
 			targetSystem:      "target",
-			sourceGroupClient: &testReadWriteGroupClient{},
-			targetGroupClient: &testReadWriteGroupClient{},
+			sourceGroupClient: &MemoryGroupReadWriter{},
+			targetGroupClient: &MemoryGroupReadWriter{},
 			sourceGroupMapper: &testGroupMapper{},
 			targetGroupMapper: &testGroupMapper{},
 			userMapper:        &testUserMapper{},
@@ -257,14 +258,14 @@ func TestSync(t *testing.T) {
 			name:         "error_getting_associated_source_ids",
 			sourceSystem: "source",
 			targetSystem: "target",
-			sourceGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			sourceGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"1": {ID: "1"},
 					"2": {ID: "3"},
 					"3": {ID: "4"},
 					"4": {ID: "5"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"1": {
 						&UserMember{Usr: &User{ID: "a"}},
 						&UserMember{Usr: &User{ID: "b"}},
@@ -286,7 +287,7 @@ func TestSync(t *testing.T) {
 						&UserMember{Usr: &User{ID: "c"}},
 					},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"a": {ID: "a"},
 					"b": {ID: "b"},
 					"c": {ID: "c"},
@@ -294,21 +295,21 @@ func TestSync(t *testing.T) {
 					"e": {ID: "e"},
 				},
 			},
-			targetGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			targetGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"99": {ID: "99"},
 					"98": {ID: "98"},
 					"97": {ID: "97"},
 					"96": {ID: "96"},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"xy": {ID: "xy"},
 					"zw": {ID: "zw"},
 					"qr": {ID: "qr"},
 					"uv": {ID: "uv"},
 					"st": {ID: "st"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"99": {},
 					"98": {},
 					"97": {},
@@ -358,14 +359,14 @@ func TestSync(t *testing.T) {
 			name:         "error_getting_source_users_partial",
 			sourceSystem: "source",
 			targetSystem: "target",
-			sourceGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			sourceGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"1": {ID: "1"},
 					"2": {ID: "3"},
 					"3": {ID: "4"},
 					"4": {ID: "5"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"1": {
 						&UserMember{Usr: &User{ID: "a"}},
 						&UserMember{Usr: &User{ID: "b"}},
@@ -383,7 +384,7 @@ func TestSync(t *testing.T) {
 						&GroupMember{Grp: &Group{ID: "2"}},
 					},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"a": {ID: "a"},
 					"b": {ID: "b"},
 					"c": {ID: "c"},
@@ -391,21 +392,21 @@ func TestSync(t *testing.T) {
 					"e": {ID: "e"},
 				},
 			},
-			targetGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			targetGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"99": {ID: "99"},
 					"98": {ID: "98"},
 					"97": {ID: "97"},
 					"96": {ID: "96"},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"xy": {ID: "xy"},
 					"zw": {ID: "zw"},
 					"qr": {ID: "qr"},
 					"uv": {ID: "uv"},
 					"st": {ID: "st"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"99": {},
 					"98": {},
 					"97": {},
@@ -456,14 +457,14 @@ func TestSync(t *testing.T) {
 			name:         "error_getting_source_users_total",
 			sourceSystem: "source",
 			targetSystem: "target",
-			sourceGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			sourceGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"1": {ID: "1"},
 					"2": {ID: "3"},
 					"3": {ID: "4"},
 					"4": {ID: "5"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"2": {
 						&UserMember{Usr: &User{ID: "c"}},
 					},
@@ -476,7 +477,7 @@ func TestSync(t *testing.T) {
 						&GroupMember{Grp: &Group{ID: "2"}},
 					},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"a": {ID: "a"},
 					"b": {ID: "b"},
 					"c": {ID: "c"},
@@ -484,21 +485,21 @@ func TestSync(t *testing.T) {
 					"e": {ID: "e"},
 				},
 			},
-			targetGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			targetGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"99": {ID: "99"},
 					"98": {ID: "98"},
 					"97": {ID: "97"},
 					"96": {ID: "96"},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"xy": {ID: "xy"},
 					"zw": {ID: "zw"},
 					"qr": {ID: "qr"},
 					"uv": {ID: "uv"},
 					"st": {ID: "st"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"99": {},
 					"98": {},
 					"97": {},
@@ -542,14 +543,14 @@ func TestSync(t *testing.T) {
 			name:         "error_mapping_source_users_partial",
 			sourceSystem: "source",
 			targetSystem: "target",
-			sourceGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			sourceGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"1": {ID: "1"},
 					"2": {ID: "3"},
 					"3": {ID: "4"},
 					"4": {ID: "5"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"1": {
 						&UserMember{Usr: &User{ID: "a"}},
 						&UserMember{Usr: &User{ID: "b"}},
@@ -571,7 +572,7 @@ func TestSync(t *testing.T) {
 						&UserMember{Usr: &User{ID: "c"}},
 					},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"a": {ID: "a"},
 					"b": {ID: "b"},
 					"c": {ID: "c"},
@@ -579,21 +580,21 @@ func TestSync(t *testing.T) {
 					"e": {ID: "e"},
 				},
 			},
-			targetGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			targetGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"99": {ID: "99"},
 					"98": {ID: "98"},
 					"97": {ID: "97"},
 					"96": {ID: "96"},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"xy": {ID: "xy"},
 					"zw": {ID: "zw"},
 					"qr": {ID: "qr"},
 					"uv": {ID: "uv"},
 					"st": {ID: "st"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"99": {},
 					"98": {},
 					"97": {},
@@ -636,14 +637,14 @@ func TestSync(t *testing.T) {
 			name:         "error_mapping_source_users_total",
 			sourceSystem: "source",
 			targetSystem: "target",
-			sourceGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			sourceGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"1": {ID: "1"},
 					"2": {ID: "3"},
 					"3": {ID: "4"},
 					"4": {ID: "5"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"1": {
 						&UserMember{Usr: &User{ID: "a"}},
 						&UserMember{Usr: &User{ID: "b"}},
@@ -665,7 +666,7 @@ func TestSync(t *testing.T) {
 						&UserMember{Usr: &User{ID: "c"}},
 					},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"a": {ID: "a"},
 					"b": {ID: "b"},
 					"c": {ID: "c"},
@@ -673,21 +674,21 @@ func TestSync(t *testing.T) {
 					"e": {ID: "e"},
 				},
 			},
-			targetGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			targetGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"99": {ID: "99"},
 					"98": {ID: "98"},
 					"97": {ID: "97"},
 					"96": {ID: "96"},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"xy": {ID: "xy"},
 					"zw": {ID: "zw"},
 					"qr": {ID: "qr"},
 					"uv": {ID: "uv"},
 					"st": {ID: "st"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"99": {},
 					"98": {},
 					"97": {},
@@ -725,14 +726,14 @@ func TestSync(t *testing.T) {
 			name:         "error_setting_members_partial",
 			sourceSystem: "source",
 			targetSystem: "target",
-			sourceGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			sourceGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"1": {ID: "1"},
 					"2": {ID: "3"},
 					"3": {ID: "4"},
 					"4": {ID: "5"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"1": {
 						&UserMember{Usr: &User{ID: "a"}},
 						&UserMember{Usr: &User{ID: "b"}},
@@ -754,7 +755,7 @@ func TestSync(t *testing.T) {
 						&UserMember{Usr: &User{ID: "c"}},
 					},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"a": {ID: "a"},
 					"b": {ID: "b"},
 					"c": {ID: "c"},
@@ -762,29 +763,32 @@ func TestSync(t *testing.T) {
 					"e": {ID: "e"},
 				},
 			},
-			targetGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
-					"99": {ID: "99"},
-					"98": {ID: "98"},
-					"97": {ID: "97"},
-					"96": {ID: "96"},
-				},
-				users: map[string]*User{
-					"xy": {ID: "xy"},
-					"zw": {ID: "zw"},
-					"qr": {ID: "qr"},
-					"uv": {ID: "uv"},
-					"st": {ID: "st"},
-				},
-				groupMembers: map[string][]Member{
-					"99": {},
-					"98": {},
-					"97": {},
-					"96": {},
+			targetGroupClient: &flakyGroupWriter{
+				MemoryGroupReadWriter: &MemoryGroupReadWriter{
+					Groups: map[string]*Group{
+						"99": {ID: "99"},
+						"98": {ID: "98"},
+						"97": {ID: "97"},
+						"96": {ID: "96"},
+					},
+					Users: map[string]*User{
+						"xy": {ID: "xy"},
+						"zw": {ID: "zw"},
+						"qr": {ID: "qr"},
+						"uv": {ID: "uv"},
+						"st": {ID: "st"},
+					},
+					Members: map[string][]Member{
+						"99": {},
+						"98": {},
+						"97": {},
+						"96": {},
+					},
 				},
-				setMembersErrs: map[string]error{
-					"99": fmt.Errorf("error setting members for group 99"),
+				failures: map[string][]error{
+					"99": {fmt.Errorf("error setting members for group 99")},
 				},
+				calls: map[string]int{},
 			},
 			sourceGroupMapper: &testGroupMapper{
 				m: map[string][]string{
@@ -831,14 +835,14 @@ func TestSync(t *testing.T) {
 			name:         "error_setting_members_total",
 			sourceSystem: "source",
 			targetSystem: "target",
-			sourceGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			sourceGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"1": {ID: "1"},
 					"2": {ID: "3"},
 					"3": {ID: "4"},
 					"4": {ID: "5"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"1": {
 						&UserMember{Usr: &User{ID: "a"}},
 						&UserMember{Usr: &User{ID: "b"}},
@@ -860,7 +864,7 @@ func TestSync(t *testing.T) {
 						&UserMember{Usr: &User{ID: "c"}},
 					},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"a": {ID: "a"},
 					"b": {ID: "b"},
 					"c": {ID: "c"},
@@ -868,30 +872,33 @@ func TestSync(t *testing.T) {
 					"e": {ID: "e"},
 				},
 			},
-			targetGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
-					"99": {ID: "99"},
-					"98": {ID: "98"},
-					"97": {ID: "97"},
-					"96": {ID: "96"},
-				},
-				users: map[string]*User{
-					"xy": {ID: "xy"},
-					"zw": {ID: "zw"},
-					"qr": {ID: "qr"},
-					"uv": {ID: "uv"},
-					"st": {ID: "st"},
-				},
-				groupMembers: map[string][]Member{
-					"99": {},
-					"98": {},
-					"97": {},
-					"96": {},
+			targetGroupClient: &flakyGroupWriter{
+				MemoryGroupReadWriter: &MemoryGroupReadWriter{
+					Groups: map[string]*Group{
+						"99": {ID: "99"},
+						"98": {ID: "98"},
+						"97": {ID: "97"},
+						"96": {ID: "96"},
+					},
+					Users: map[string]*User{
+						"xy": {ID: "xy"},
+						"zw": {ID: "zw"},
+						"qr": {ID: "qr"},
+						"uv": {ID: "uv"},
+						"st": {ID: "st"},
+					},
+					Members: map[string][]Member{
+						"99": {},
+						"98": {},
+						"97": {},
+						"96": {},
+					},
 				},
-				setMembersErrs: map[string]error{
-					"98": fmt.Errorf("error setting members for group 98"),
-					"99": fmt.Errorf("error setting members for group 99"),
+				failures: map[string][]error{
+					"98": {fmt.Errorf("error setting members for group 98")},
+					"99": {fmt.Errorf("error setting members for group 99")},
 				},
+				calls: map[string]int{},
 			},
 			sourceGroupMapper: &testGroupMapper{
 				m: map[string][]string{
@@ -983,8 +990,8 @@ func TestSyncAll(t *testing.T) {
 			name:         "sync_all_success",
 			sourceSystem: "source",
 			targetSystem: "target",
-			sourceGroupClient: &testReadWriteGroupClient{
-				groupMembers: map[string][]Member{
+			sourceGroupClient: &MemoryGroupReadWriter{
+				Members: map[string][]Member{
 					"1": {
 						&UserMember{Usr: &User{ID: "a"}},
 						&UserMember{Usr: &User{ID: "b"}},
@@ -1006,7 +1013,7 @@ func TestSyncAll(t *testing.T) {
 						&UserMember{Usr: &User{ID: "c"}},
 					},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"a": {ID: "a"},
 					"b": {ID: "b"},
 					"c": {ID: "c"},
@@ -1014,21 +1021,21 @@ func TestSyncAll(t *testing.T) {
 					"e": {ID: "e"},
 				},
 			},
-			targetGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			targetGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"99": {ID: "99"},
 					"98": {ID: "98"},
 					"97": {ID: "97"},
 					"96": {ID: "96"},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"xy": {ID: "xy"},
 					"zw": {ID: "zw"},
 					"qr": {ID: "qr"},
 					"uv": {ID: "uv"},
 					"st": {ID: "st"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"99": {},
 					"98": {},
 					"97": {},
@@ -1092,8 +1099,8 @@ func TestSyncAll(t *testing.T) {
 			name:              "no_source_ids_found",
 			sourceSystem:      "source",
 			targetSystem:      "target",
-			sourceGroupClient: &testReadWriteGroupClient{},
-			targetGroupClient: &testReadWriteGroupClient{},
+			sourceGroupClient: &MemoryGroupReadWriter{},
+			targetGroupClient: &MemoryGroupReadWriter{},
 			sourceGroupMapper: &testGroupMapper{
 				allGroupIDsErr: fmt.Errorf("allGroupIDsErr"),
 			},
@@ -1105,8 +1112,8 @@ func TestSyncAll(t *testing.T) {
 			name:         "sync_all_partial_failure",
 			sourceSystem: "source",
 			targetSystem: "target",
-			sourceGroupClient: &testReadWriteGroupClient{
-				groupMembers: map[string][]Member{
+			sourceGroupClient: &MemoryGroupReadWriter{
+				Members: map[string][]Member{
 					"1": {
 						&UserMember{Usr: &User{ID: "a"}},
 						&UserMember{Usr: &User{ID: "b"}},
@@ -1128,7 +1135,7 @@ func TestSyncAll(t *testing.T) {
 						&UserMember{Usr: &User{ID: "c"}},
 					},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"a": {ID: "a"},
 					"b": {ID: "b"},
 					"c": {ID: "c"},
@@ -1136,21 +1143,21 @@ func TestSyncAll(t *testing.T) {
 					"e": {ID: "e"},
 				},
 			},
-			targetGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			targetGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"99": {ID: "99"},
 					"98": {ID: "98"},
 					"97": {ID: "97"},
 					"96": {ID: "96"},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"xy": {ID: "xy"},
 					"zw": {ID: "zw"},
 					"qr": {ID: "qr"},
 					"uv": {ID: "uv"},
 					"st": {ID: "st"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"99": {},
 					"98": {},
 					"97": {},
@@ -1213,8 +1220,8 @@ func TestSyncAll(t *testing.T) {
 			name:         "sync_all_total_failure",
 			sourceSystem: "source",
 			targetSystem: "target",
-			sourceGroupClient: &testReadWriteGroupClient{
-				groupMembers: map[string][]Member{
+			sourceGroupClient: &MemoryGroupReadWriter{
+				Members: map[string][]Member{
 					"1": {
 						&UserMember{Usr: &User{ID: "a"}},
 						&UserMember{Usr: &User{ID: "b"}},
@@ -1236,7 +1243,7 @@ func TestSyncAll(t *testing.T) {
 						&UserMember{Usr: &User{ID: "c"}},
 					},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"a": {ID: "a"},
 					"b": {ID: "b"},
 					"c": {ID: "c"},
@@ -1244,21 +1251,21 @@ func TestSyncAll(t *testing.T) {
 					"e": {ID: "e"},
 				},
 			},
-			targetGroupClient: &testReadWriteGroupClient{
-				groups: map[string]*Group{
+			targetGroupClient: &MemoryGroupReadWriter{
+				Groups: map[string]*Group{
 					"99": {ID: "99"},
 					"98": {ID: "98"},
 					"97": {ID: "97"},
 					"96": {ID: "96"},
 				},
-				users: map[string]*User{
+				Users: map[string]*User{
 					"xy": {ID: "xy"},
 					"zw": {ID: "zw"},
 					"qr": {ID: "qr"},
 					"uv": {ID: "uv"},
 					"st": {ID: "st"},
 				},
-				groupMembers: map[string][]Member{
+				Members: map[string][]Member{
 					"99": {},
 					"98": {},
 					"97": {},
@@ -1342,92 +1349,402 @@ func TestSyncAll(t *testing.T) {
 	}
 }
 
-type testReadWriteGroupClient struct {
-	groups          map[string]*Group
-	groupMembers    map[string][]Member
-	users           map[string]*User
-	descendantsErrs map[string]error
-	getGroupErrs    map[string]error
-	getMembersErrs  map[string]error
-	getUserErrs     map[string]error
-	setMembersErrs  map[string]error
-	mutex           sync.RWMutex
+func TestSync_ManyToOneUsers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sourceGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{
+			"1": {
+				&UserMember{Usr: &User{ID: "alice@example.com"}},
+				&UserMember{Usr: &User{ID: "alice.contractor@example.com"}},
+				&UserMember{Usr: &User{ID: "bob@example.com"}},
+			},
+		},
+	}
+	targetGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{"99": {}},
+	}
+	syncer := NewManyToManySyncer(
+		"source",
+		"target",
+		sourceGroupClient,
+		targetGroupClient,
+		&testGroupMapper{m: map[string][]string{"1": {"99"}}},
+		&testGroupMapper{m: map[string][]string{"99": {"1"}}},
+		&testUserMapper{m: map[string]string{
+			"alice@example.com":            "alice",
+			"alice.contractor@example.com": "alice",
+			"bob@example.com":              "bob",
+		}},
+	)
+
+	if err := syncer.Sync(ctx, "1"); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	got, err := targetGroupClient.GetMembers(ctx, "99")
+	if err != nil {
+		t.Fatalf("failed to get target group members: %v", err)
+	}
+	want := []Member{
+		&UserMember{Usr: &User{ID: "alice"}},
+		&UserMember{Usr: &User{ID: "bob"}},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
+	}
 }
 
-func (tc *testReadWriteGroupClient) Descendants(ctx context.Context, groupID string) ([]*User, error) {
-	tc.mutex.RLock()
-	defer tc.mutex.RUnlock()
-	if err, ok := tc.descendantsErrs[groupID]; ok {
-		return nil, err
+func TestSync_StaticMembers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sourceGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{
+			"1": {
+				&UserMember{Usr: &User{ID: "alice@example.com"}},
+				&UserMember{Usr: &User{ID: "bob@example.com"}},
+			},
+		},
+	}
+	targetGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{"99": {
+			&UserMember{Usr: &User{ID: "release-bot"}},
+			&UserMember{Usr: &User{ID: "carol"}},
+		}},
+	}
+	syncer := NewManyToManySyncer(
+		"source",
+		"target",
+		sourceGroupClient,
+		targetGroupClient,
+		&testGroupMapper{m: map[string][]string{"1": {"99"}}},
+		&testGroupMapper{m: map[string][]string{"99": {"1"}}},
+		&testUserMapper{m: map[string]string{
+			"alice@example.com": "alice",
+			"bob@example.com":   "bob",
+		}},
+		WithStaticMembers(map[string][]Member{"99": {
+			&UserMember{Usr: &User{ID: "release-bot"}, Role: "maintainer"},
+			&UserMember{Usr: &User{ID: "bob"}, Role: "maintainer"},
+		}}),
+	)
+
+	if err := syncer.Sync(ctx, "1"); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	got, err := targetGroupClient.GetMembers(ctx, "99")
+	if err != nil {
+		t.Fatalf("failed to get target group members: %v", err)
+	}
+	// bob is mapped from the source group, so keeps the role of the mapping.
+	want := []Member{
+		&UserMember{Usr: &User{ID: "alice"}},
+		&UserMember{Usr: &User{ID: "bob"}},
+		&UserMember{Usr: &User{ID: "release-bot"}, Role: "maintainer"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
 	}
-	return Descendants(ctx, groupID, tc.GetMembers)
 }
 
-func (tc *testReadWriteGroupClient) GetGroup(ctx context.Context, groupID string) (*Group, error) {
-	tc.mutex.RLock()
-	defer tc.mutex.RUnlock()
-	if err, ok := tc.getGroupErrs[groupID]; ok {
-		return nil, err
+func TestSync_MemberLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sourceGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{
+			"1": {
+				&UserMember{Usr: &User{ID: "alice@example.com"}},
+				&UserMember{Usr: &User{ID: "bob@example.com"}},
+			},
+		},
 	}
-	group, ok := tc.groups[groupID]
-	if !ok {
-		return nil, fmt.Errorf("group %s not found", groupID)
+	targetGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{
+			"98": {},
+			"99": {&UserMember{Usr: &User{ID: "carol"}}},
+		},
+	}
+	syncer := NewManyToManySyncer(
+		"source",
+		"target",
+		sourceGroupClient,
+		targetGroupClient,
+		&testGroupMapper{m: map[string][]string{"1": {"98", "99"}}},
+		&testGroupMapper{m: map[string][]string{"98": {"1"}, "99": {"1"}}},
+		&testUserMapper{m: map[string]string{
+			"alice@example.com": "alice",
+			"bob@example.com":   "bob",
+		}},
+		WithMemberLimits(map[string]int{"98": 2, "99": 1}),
+	)
+
+	err := syncer.Sync(ctx, "1")
+	if !errors.Is(err, ErrMemberLimitExceeded) {
+		t.Fatalf("Sync got err %v, want %v", err, ErrMemberLimitExceeded)
+	}
+	if diff := testutil.DiffErrString(err, "target group 99 would have 2 members from source groups [1], more than its limit of 1"); diff != "" {
+		t.Errorf("unexpected err: %s", diff)
+	}
+
+	// the group within its limit is synced, the other one is left alone.
+	want := map[string][]Member{
+		"98": {
+			&UserMember{Usr: &User{ID: "alice"}},
+			&UserMember{Usr: &User{ID: "bob"}},
+		},
+		"99": {&UserMember{Usr: &User{ID: "carol"}}},
+	}
+	if diff := cmp.Diff(targetGroupClient.Members, want); diff != "" {
+		t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
 	}
-	return group, nil
 }
 
-func (tc *testReadWriteGroupClient) GetMembers(ctx context.Context, groupID string) ([]Member, error) {
-	tc.mutex.RLock()
-	defer tc.mutex.RUnlock()
-	if err, ok := tc.getMembersErrs[groupID]; ok {
-		return nil, err
+func TestSync_OneToManyUsers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sourceGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{
+			"1": {
+				&UserMember{Usr: &User{ID: "alice@example.com"}},
+				&UserMember{Usr: &User{ID: "bob@example.com"}},
+			},
+		},
 	}
-	members, ok := tc.groupMembers[groupID]
-	if !ok {
-		return nil, fmt.Errorf("group %s not found", groupID)
+	targetGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{"99": {}},
+	}
+	syncer := NewManyToManySyncer(
+		"source",
+		"target",
+		sourceGroupClient,
+		targetGroupClient,
+		&testGroupMapper{m: map[string][]string{"1": {"99"}}},
+		&testGroupMapper{m: map[string][]string{"99": {"1"}}},
+		&testMultiUserMapper{m: map[string][]*MappedUser{
+			"alice@example.com": {{ID: "alice"}, {ID: "alice-admin", Role: "maintainer"}},
+			// bob's admin account is also mapped from alice, the first mapping wins.
+			"bob@example.com": {{ID: "bob"}, {ID: "alice-admin", Role: "member"}},
+		}},
+	)
+
+	if err := syncer.Sync(ctx, "1"); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	got, err := targetGroupClient.GetMembers(ctx, "99")
+	if err != nil {
+		t.Fatalf("failed to get target group members: %v", err)
+	}
+	want := []Member{
+		&UserMember{Usr: &User{ID: "alice"}},
+		&UserMember{Usr: &User{ID: "alice-admin"}, Role: "maintainer"},
+		&UserMember{Usr: &User{ID: "bob"}},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
 	}
-	return members, nil
 }
 
-func (tc *testReadWriteGroupClient) GetUser(ctx context.Context, userID string) (*User, error) {
-	tc.mutex.RLock()
-	defer tc.mutex.RUnlock()
-	if err, ok := tc.getUserErrs[userID]; ok {
-		return nil, err
+func TestSync_BatchUserMapper(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sourceGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{
+			"1": {
+				&UserMember{Usr: &User{ID: "alice@example.com"}},
+				&UserMember{Usr: &User{ID: "bob@example.com"}},
+				&UserMember{Usr: &User{ID: "carol@example.com"}},
+			},
+		},
 	}
-	user, ok := tc.users[userID]
-	if !ok {
-		return nil, fmt.Errorf("user %s not found", userID)
+	targetGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{"99": {}},
+	}
+	userMapper := &testBatchUserMapper{m: map[string]string{
+		"alice@example.com": "alice",
+		"bob@example.com":   "bob",
+	}}
+	syncer := NewManyToManySyncer(
+		"source",
+		"target",
+		sourceGroupClient,
+		targetGroupClient,
+		&testGroupMapper{m: map[string][]string{"1": {"99"}}},
+		&testGroupMapper{m: map[string][]string{"99": {"1"}}},
+		userMapper,
+	)
+
+	if err := syncer.Sync(ctx, "1"); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	got, err := targetGroupClient.GetMembers(ctx, "99")
+	if err != nil {
+		t.Fatalf("failed to get target group members: %v", err)
+	}
+	want := []Member{
+		&UserMember{Usr: &User{ID: "alice"}},
+		&UserMember{Usr: &User{ID: "bob"}},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
+	}
+	if got, want := userMapper.batchCalls, 1; got != want {
+		t.Errorf("got %d batch calls, want %d", got, want)
 	}
-	return user, nil
 }
 
-func (tc *testReadWriteGroupClient) SetMembers(ctx context.Context, groupID string, members []Member) error {
-	tc.mutex.Lock()
-	defer tc.mutex.Unlock()
-	if err, ok := tc.setMembersErrs[groupID]; ok {
-		return err
+func TestSync_UserMappingError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sourceGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{
+			"1": {
+				&UserMember{Usr: &User{ID: "alice@example.com"}},
+				&UserMember{Usr: &User{ID: "bob@example.com"}},
+				&UserMember{Usr: &User{ID: "carol@example.com"}},
+			},
+		},
 	}
-	_, ok := tc.groupMembers[groupID]
-	if !ok {
-		return fmt.Errorf("group %s not found", groupID)
+	targetGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{"99": {}},
+	}
+	injected := fmt.Errorf("injected")
+	syncer := NewManyToManySyncer(
+		"source",
+		"target",
+		sourceGroupClient,
+		targetGroupClient,
+		&testGroupMapper{m: map[string][]string{"1": {"99"}}},
+		&testGroupMapper{m: map[string][]string{"99": {"1"}}},
+		&testUserMapper{
+			m: map[string]string{"alice@example.com": "alice"},
+			mappedUserIDErrs: map[string]error{
+				"bob@example.com":   injected,
+				"carol@example.com": ErrTargetUserIDNotFound,
+			},
+		},
+	)
+
+	err := syncer.Sync(ctx, "1")
+	var merr *UserMappingError
+	if !errors.As(err, &merr) {
+		t.Fatalf("Sync got err %v, want a UserMappingError", err)
+	}
+	if got, want := merr.SourceUserID, "bob@example.com"; got != want {
+		t.Errorf("UserMappingError got source user %q, want %q", got, want)
+	}
+	if !errors.Is(err, injected) {
+		t.Errorf("Sync got err %v, want %v", err, injected)
+	}
+	var serr *SyncError
+	if !errors.As(err, &serr) || len(serr.ByCategory(ErrorCategoryMapping)) != 1 {
+		t.Errorf("Sync got err %v, want a single mapping failure", err)
+	}
+}
+
+func TestSync_FreezeWindows(t *testing.T) {
+	t.Parallel()
+
+	// 2026-06-06 is a Saturday.
+	saturday := time.Date(2026, 6, 6, 12, 0, 0, 0, time.UTC)
+	weekend := &WeeklyFreezeWindow{StartDay: time.Friday, Start: 17 * time.Hour, EndDay: time.Monday, End: 9 * time.Hour}
+
+	cases := []struct {
+		name string
+		now  time.Time
+		opt  Opt
+		want []Member
+	}{
+		{
+			name: "frozen",
+			now:  saturday,
+			opt:  WithFreezeWindows(weekend),
+			want: []Member{
+				&UserMember{Usr: &User{ID: "alice"}},
+				&UserMember{Usr: &User{ID: "bob"}},
+				&UserMember{Usr: &User{ID: "carol"}},
+			},
+		},
+		{
+			name: "not_frozen",
+			now:  saturday.AddDate(0, 0, 3),
+			opt:  WithFreezeWindows(weekend),
+			want: []Member{
+				&UserMember{Usr: &User{ID: "alice"}},
+				&UserMember{Usr: &User{ID: "bob"}},
+			},
+		},
+		{
+			name: "group_frozen",
+			now:  saturday,
+			opt:  WithGroupFreezeWindows(map[string][]FreezeWindow{"99": {weekend}}),
+			want: []Member{
+				&UserMember{Usr: &User{ID: "alice"}},
+				&UserMember{Usr: &User{ID: "bob"}},
+				&UserMember{Usr: &User{ID: "carol"}},
+			},
+		},
+		{
+			name: "other_group_frozen",
+			now:  saturday,
+			opt:  WithGroupFreezeWindows(map[string][]FreezeWindow{"98": {weekend}}),
+			want: []Member{
+				&UserMember{Usr: &User{ID: "alice"}},
+				&UserMember{Usr: &User{ID: "bob"}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sourceGroupClient := &MemoryGroupReadWriter{
+				Members: map[string][]Member{
+					"1": {
+						&UserMember{Usr: &User{ID: "alice@example.com"}},
+						&UserMember{Usr: &User{ID: "bob@example.com"}},
+					},
+				},
+			}
+			targetGroupClient := &MemoryGroupReadWriter{
+				Members: map[string][]Member{"99": {
+					&UserMember{Usr: &User{ID: "alice"}},
+					&UserMember{Usr: &User{ID: "carol"}},
+				}},
+			}
+			syncer := NewManyToManySyncer(
+				"source",
+				"target",
+				sourceGroupClient,
+				targetGroupClient,
+				&testGroupMapper{m: map[string][]string{"1": {"99"}}},
+				&testGroupMapper{m: map[string][]string{"99": {"1"}}},
+				&testUserMapper{m: map[string]string{
+					"alice@example.com": "alice",
+					"bob@example.com":   "bob",
+				}},
+				tc.opt,
+			)
+			syncer.now = func() time.Time { return tc.now }
+
+			if err := syncer.Sync(ctx, "1"); err != nil {
+				t.Fatalf("Sync failed: %v", err)
+			}
+			got, err := targetGroupClient.GetMembers(ctx, "99")
+			if err != nil {
+				t.Fatalf("failed to get target group members: %v", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
+			}
+		})
 	}
-	// sort members so we have deterministic ordering for comparisons
-	sort.Slice(members, func(i, j int) bool {
-		u1, err := members[i].User()
-		if err != nil {
-			// test is broken if this happens
-			panic(err)
-		}
-		u2, err := members[j].User()
-		if err != nil {
-			// test is broken if this happens
-			panic(err)
-		}
-		return u1.ID < u2.ID
-	})
-	tc.groupMembers[groupID] = members
-	return nil
 }
 
 type testGroupMapper struct {
@@ -1482,3 +1799,248 @@ func (tum *testUserMapper) MappedUserID(ctx context.Context, userID string) (str
 	}
 	return id, nil
 }
+
+func TestSyncAll_Retry(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		failures      map[string][]error
+		retryAttempts int
+		want          map[string][]Member
+		wantCalls     map[string]int
+		wantErr       string
+	}{
+		{
+			name: "retry_succeeds",
+			failures: map[string][]error{
+				"99": {fmt.Errorf("slow down: %w", ErrRateLimited)},
+				"98": {fmt.Errorf("bad gateway: %w", ErrTransient), fmt.Errorf("bad gateway: %w", ErrTransient)},
+			},
+			retryAttempts: 3,
+			want: map[string][]Member{
+				"99": {&UserMember{Usr: &User{ID: "xy"}}},
+				"98": {&UserMember{Usr: &User{ID: "zw"}}},
+			},
+			wantCalls: map[string]int{"99": 2, "98": 3},
+		},
+		{
+			name: "attempts_exhausted",
+			failures: map[string][]error{
+				"99": {
+					fmt.Errorf("slow down: %w", ErrRateLimited),
+					fmt.Errorf("slow down: %w", ErrRateLimited),
+				},
+			},
+			retryAttempts: 2,
+			want: map[string][]Member{
+				"99": {},
+				"98": {&UserMember{Usr: &User{ID: "zw"}}},
+			},
+			wantCalls: map[string]int{"99": 2, "98": 1},
+			wantErr:   "slow down",
+		},
+		{
+			name: "not_retryable",
+			failures: map[string][]error{
+				"99": {fmt.Errorf("forbidden")},
+			},
+			retryAttempts: 3,
+			want: map[string][]Member{
+				"99": {},
+				"98": {&UserMember{Usr: &User{ID: "zw"}}},
+			},
+			wantCalls: map[string]int{"99": 1, "98": 1},
+			wantErr:   "forbidden",
+		},
+		{
+			name: "retry_disabled",
+			failures: map[string][]error{
+				"99": {fmt.Errorf("slow down: %w", ErrRateLimited)},
+			},
+			retryAttempts: 1,
+			want: map[string][]Member{
+				"99": {},
+				"98": {&UserMember{Usr: &User{ID: "zw"}}},
+			},
+			wantCalls: map[string]int{"99": 1, "98": 1},
+			wantErr:   "slow down",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			sourceGroupClient := &MemoryGroupReadWriter{
+				Members: map[string][]Member{
+					"1": {&UserMember{Usr: &User{ID: "a"}}},
+					"2": {&UserMember{Usr: &User{ID: "b"}}},
+				},
+			}
+			targetGroupClient := &flakyGroupWriter{
+				MemoryGroupReadWriter: &MemoryGroupReadWriter{
+					Members: map[string][]Member{
+						"99": {},
+						"98": {},
+					},
+				},
+				failures: tc.failures,
+				calls:    make(map[string]int),
+			}
+			syncer := NewManyToManySyncer(
+				"source",
+				"target",
+				sourceGroupClient,
+				targetGroupClient,
+				&testGroupMapper{m: map[string][]string{"1": {"99"}, "2": {"98"}}},
+				&testGroupMapper{m: map[string][]string{"99": {"1"}, "98": {"2"}}},
+				&testUserMapper{m: map[string]string{"a": "xy", "b": "zw"}},
+				WithRetry(tc.retryAttempts, time.Millisecond),
+			)
+
+			err := syncer.SyncAll(ctx)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected error (-want, +got):\n%s", diff)
+			}
+			for targetGroupID, want := range tc.want {
+				got, err := targetGroupClient.GetMembers(ctx, targetGroupID)
+				if err != nil {
+					t.Fatalf("test data misconfigured. failed to get target group members: %v", err)
+				}
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Errorf("unexpected result for targetGroupID %s (-got, +want): \n%s", targetGroupID, diff)
+				}
+			}
+			if diff := cmp.Diff(targetGroupClient.calls, tc.wantCalls); diff != "" {
+				t.Errorf("unexpected SetMembers calls (-got, +want): \n%s", diff)
+			}
+		})
+	}
+}
+
+// flakyGroupWriter fails SetMembers with the configured errors, in order,
+// before delegating to the wrapped client.
+type flakyGroupWriter struct {
+	*MemoryGroupReadWriter
+	failures map[string][]error
+	calls    map[string]int
+	mu       sync.Mutex
+}
+
+func (f *flakyGroupWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	f.mu.Lock()
+	f.calls[groupID]++
+	if errs := f.failures[groupID]; len(errs) > 0 {
+		f.failures[groupID] = errs[1:]
+		f.mu.Unlock()
+		return errs[0]
+	}
+	f.mu.Unlock()
+	return f.MemoryGroupReadWriter.SetMembers(ctx, groupID, members)
+}
+
+type testMultiUserMapper struct {
+	m map[string][]*MappedUser
+}
+
+func (tum *testMultiUserMapper) MappedUserID(ctx context.Context, userID string) (string, error) {
+	users, err := tum.MappedUsers(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return users[0].ID, nil
+}
+
+func (tum *testMultiUserMapper) MappedUsers(ctx context.Context, userID string) ([]*MappedUser, error) {
+	users, ok := tum.m[userID]
+	if !ok {
+		return nil, ErrTargetUserIDNotFound
+	}
+	return users, nil
+}
+
+type testBatchUserMapper struct {
+	m          map[string]string
+	batchCalls int
+}
+
+func (tum *testBatchUserMapper) MappedUserID(ctx context.Context, userID string) (string, error) {
+	return "", fmt.Errorf("MappedUserID called for %s", userID)
+}
+
+func (tum *testBatchUserMapper) MappedUserIDs(ctx context.Context, userIDs []string) (map[string]string, error) {
+	tum.batchCalls++
+	mapped := make(map[string]string, len(userIDs))
+	for _, id := range userIDs {
+		if target, ok := tum.m[id]; ok {
+			mapped[id] = target
+		}
+	}
+	return mapped, nil
+}
+
+func TestSyncSince(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	since := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	alice := &UserMember{Usr: &User{ID: "alice@example.com"}}
+	bob := &UserMember{Usr: &User{ID: "bob@example.com"}}
+
+	sourceGroupClient := &testChangeLogClient{
+		MemoryGroupReadWriter: &MemoryGroupReadWriter{
+			Members: map[string][]Member{"1": {alice}, "2": {bob}},
+		},
+		// group 3 changed but is not mapped.
+		changed: []string{"2", "3"},
+	}
+	targetGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{"98": {}, "99": {}},
+	}
+	syncer := NewManyToManySyncer(
+		"source",
+		"target",
+		sourceGroupClient,
+		targetGroupClient,
+		&testGroupMapper{m: map[string][]string{"1": {"98"}, "2": {"99"}}},
+		&testGroupMapper{m: map[string][]string{"98": {"1"}, "99": {"2"}}},
+		&testUserMapper{m: map[string]string{
+			"alice@example.com": "alice",
+			"bob@example.com":   "bob",
+		}},
+	)
+
+	if err := syncer.SyncSince(ctx, since); err != nil {
+		t.Fatalf("SyncSince failed: %v", err)
+	}
+	if got := sourceGroupClient.since; !got.Equal(since) {
+		t.Errorf("ChangedGroupIDs got since %v, want %v", got, since)
+	}
+	// only the target group of the changed source group is synced.
+	want := map[string][]Member{"98": {}, "99": {&UserMember{Usr: &User{ID: "bob"}}}}
+	if diff := cmp.Diff(targetGroupClient.Members, want); diff != "" {
+		t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
+	}
+
+	unsupported := NewManyToManySyncer("source", "target",
+		sourceGroupClient.MemoryGroupReadWriter, targetGroupClient,
+		&testGroupMapper{}, &testGroupMapper{}, &testUserMapper{})
+	err := unsupported.SyncSince(ctx, since)
+	if diff := testutil.DiffErrString(err, "does not support listing changed groups"); diff != "" {
+		t.Errorf("unexpected err: %s", diff)
+	}
+}
+
+type testChangeLogClient struct {
+	*MemoryGroupReadWriter
+	changed []string
+	since   time.Time
+}
+
+func (tc *testChangeLogClient) ChangedGroupIDs(ctx context.Context, since time.Time) ([]string, error) {
+	tc.since = since
+	return tc.changed, nil
+}
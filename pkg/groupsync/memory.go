@@ -0,0 +1,143 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Ensure we conform to the interface.
+var _ GroupReadWriter = (*MemoryGroupReadWriter)(nil)
+
+// MemoryGroupReadWriter is a GroupReadWriter keeping groups, their members
+// and users in memory. It is safe for concurrent use, and is meant as a test
+// double and as a template for implementing connectors.
+//
+// A group exists if it has an entry in Members, and Groups optionally holds
+// its attributes. The fields may be set before the MemoryGroupReadWriter is
+// used and inspected after, but not accessed concurrently with its methods.
+type MemoryGroupReadWriter struct {
+	Groups  map[string]*Group
+	Members map[string][]Member
+	Users   map[string]*User
+
+	mu sync.RWMutex
+}
+
+// NewMemoryGroupReadWriter creates a MemoryGroupReadWriter without groups or
+// users.
+func NewMemoryGroupReadWriter() *MemoryGroupReadWriter {
+	return &MemoryGroupReadWriter{
+		Groups:  make(map[string]*Group),
+		Members: make(map[string][]Member),
+		Users:   make(map[string]*User),
+	}
+}
+
+// AddGroup adds a group with the given members, and the users among them.
+func (rw *MemoryGroupReadWriter) AddGroup(group *Group, members ...Member) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	if rw.Groups == nil {
+		rw.Groups = make(map[string]*Group)
+	}
+	rw.Groups[group.ID] = group
+	rw.setMembers(group.ID, members)
+	if rw.Users == nil {
+		rw.Users = make(map[string]*User)
+	}
+	for _, m := range members {
+		if u, ok := m.(*UserMember); ok {
+			rw.Users[u.Usr.ID] = u.Usr
+		}
+	}
+}
+
+// AddUser adds a user.
+func (rw *MemoryGroupReadWriter) AddUser(user *User) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	if rw.Users == nil {
+		rw.Users = make(map[string]*User)
+	}
+	rw.Users[user.ID] = user
+}
+
+// Descendants retrieves all users (children, recursively) of a group.
+func (rw *MemoryGroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*User, error) {
+	return Descendants(ctx, groupID, rw.GetMembers)
+}
+
+// GetGroup retrieves the group with the given ID.
+func (rw *MemoryGroupReadWriter) GetGroup(ctx context.Context, groupID string) (*Group, error) {
+	rw.mu.RLock()
+	defer rw.mu.RUnlock()
+	if group, ok := rw.Groups[groupID]; ok {
+		return group, nil
+	}
+	if _, ok := rw.Members[groupID]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrGroupNotFound, groupID)
+	}
+	return &Group{ID: groupID}, nil
+}
+
+// GetMembers retrieves the direct members of the group with the given ID.
+func (rw *MemoryGroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]Member, error) {
+	rw.mu.RLock()
+	defer rw.mu.RUnlock()
+	members, ok := rw.Members[groupID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrGroupNotFound, groupID)
+	}
+	return slices.Clone(members), nil
+}
+
+// GetUser retrieves the user with the given ID.
+func (rw *MemoryGroupReadWriter) GetUser(ctx context.Context, userID string) (*User, error) {
+	rw.mu.RLock()
+	defer rw.mu.RUnlock()
+	user, ok := rw.Users[userID]
+	if !ok {
+		return nil, fmt.Errorf("user %s not found", userID)
+	}
+	return user, nil
+}
+
+// SetMembers replaces the members of the group with the given ID, which must
+// exist. Members are kept sorted by ID, so that they can be compared.
+func (rw *MemoryGroupReadWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	if _, ok := rw.Members[groupID]; !ok {
+		return fmt.Errorf("%w: %s", ErrGroupNotFound, groupID)
+	}
+	rw.setMembers(groupID, members)
+	return nil
+}
+
+func (rw *MemoryGroupReadWriter) setMembers(groupID string, members []Member) {
+	if rw.Members == nil {
+		rw.Members = make(map[string][]Member)
+	}
+	members = slices.Clone(members)
+	slices.SortStableFunc(members, func(a, b Member) int {
+		return strings.Compare(a.ID(), b.ID())
+	})
+	rw.Members[groupID] = members
+}
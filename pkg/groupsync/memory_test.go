@@ -0,0 +1,79 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMemoryGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rw := NewMemoryGroupReadWriter()
+	rw.AddGroup(&Group{ID: "eng"},
+		&UserMember{Usr: &User{ID: "carol"}},
+		&GroupMember{Grp: &Group{ID: "sre"}},
+		&UserMember{Usr: &User{ID: "alice"}},
+	)
+	rw.AddGroup(&Group{ID: "sre"}, &UserMember{Usr: &User{ID: "bob"}})
+
+	members, err := rw.GetMembers(ctx, "eng")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(memberIDs(members), []string{"alice", "carol", "sre"}); diff != "" {
+		t.Errorf("unexpected members (-got, +want):\n%s", diff)
+	}
+	users, err := rw.Descendants(ctx, "eng")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if got, want := len(users), 3; got != want {
+		t.Errorf("Descendants got %d users, want %d", got, want)
+	}
+	if _, err := rw.GetUser(ctx, "bob"); err != nil {
+		t.Errorf("GetUser failed: %v", err)
+	}
+
+	// concurrent writes are safe.
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := rw.SetMembers(ctx, "sre", []Member{&UserMember{Usr: &User{ID: fmt.Sprintf("user%d", i)}}}); err != nil {
+				t.Errorf("SetMembers failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := len(rw.Members["sre"]); got != 1 {
+		t.Errorf("got %d members of sre, want 1", got)
+	}
+
+	if err := rw.SetMembers(ctx, "missing", nil); !errors.Is(err, ErrGroupNotFound) {
+		t.Errorf("SetMembers(missing) got err %v, want %v", err, ErrGroupNotFound)
+	}
+	if _, err := rw.GetGroup(ctx, "missing"); !errors.Is(err, ErrGroupNotFound) {
+		t.Errorf("GetGroup(missing) got err %v, want %v", err, ErrGroupNotFound)
+	}
+}
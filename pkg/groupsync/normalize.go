@@ -0,0 +1,246 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+// stableIDsKeyPrefix prefixes the keys of the stable IDs of the members of
+// groups, by system and group ID.
+const stableIDsKeyPrefix = "stableids"
+
+// IDNormalizer returns the normalized form of an ID of a system, under which
+// IDs the system treats as the same user or group are equal.
+type IDNormalizer func(id string) string
+
+// UnicodeIDs normalizes IDs to Unicode NFC, and the internationalized
+// domains of emails to their ASCII (punycode) form, so that IDs which are
+// encoded differently by different systems, e.g. accented names in Google
+// Workspace, are equal. ASCII IDs are returned as they are.
+func UnicodeIDs(id string) string {
+	if isASCII(id) {
+		return id
+	}
+	id = norm.NFC.String(id)
+	if i := strings.LastIndex(id, "@"); i > 0 && !isASCII(id[i+1:]) {
+		// an invalid domain is left as it is, it won't match anyway.
+		if domain, err := idna.Lookup.ToASCII(id[i+1:]); err == nil {
+			id = id[:i+1] + domain
+		}
+	}
+	return id
+}
+
+// CaseInsensitiveIDs normalizes the IDs of systems which compare IDs, e.g.
+// logins or emails, case-insensitively. IDs are also normalized like
+// UnicodeIDs.
+func CaseInsensitiveIDs(id string) string {
+	return strings.ToLower(UnicodeIDs(id))
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeUsers returns copies of the given users with normalized IDs,
+// leaving the given users, which may be shared, as they are.
+func normalizeUsers(users []*User, normalize IDNormalizer) []*User {
+	normalized := make([]*User, 0, len(users))
+	for _, user := range users {
+		u := *user
+		u.ID = normalize(u.ID)
+		normalized = append(normalized, &u)
+	}
+	return normalized
+}
+
+// NormalizingWriter wraps a GroupReadWriter of a system whose IDs differ in
+// spelling, e.g. case, from the IDs in the mappings, and writes the members of
+// a group with the IDs the group already uses. Without it, a member whose ID
+// is configured as "Alice" in a group listing "alice" would be removed and
+// added on every run by writers comparing IDs exactly. Reads are passed
+// through.
+//
+// With TrackStableIDs, users who were renamed in the group system, e.g. a
+// GitHub user who changed their login, are also written as the current member
+// with their StableID, rather than removed and invited again under the ID in
+// the mappings.
+type NormalizingWriter struct {
+	WrappedWriter
+
+	normalize IDNormalizer
+	system    string
+	store     state.Store
+}
+
+// NormalizingWriterOpt configures a NormalizingWriter.
+type NormalizingWriterOpt func(w *NormalizingWriter)
+
+// TrackStableIDs remembers the StableIDs of the users of each group of the
+// given system in store, so that users who were renamed since are recognized
+// by their old ID. Only the users of readers which set StableID are tracked.
+func TrackStableIDs(system string, store state.Store) NormalizingWriterOpt {
+	return func(w *NormalizingWriter) {
+		w.system = system
+		w.store = store
+	}
+}
+
+// NewNormalizingWriter creates a NormalizingWriter wrapping rw, which compares
+// IDs by their form normalized by normalize.
+func NewNormalizingWriter(rw GroupReadWriter, normalize IDNormalizer, opts ...NormalizingWriterOpt) *NormalizingWriter {
+	w := &NormalizingWriter{WrappedWriter: WrappedWriter{GroupReadWriter: rw}, normalize: normalize}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// SetMembers replaces the members of the group with the given ID with the
+// given members. Members equal to a current member once normalized are
+// written as that member, and members equal to an earlier given member are
+// dropped. This costs an extra read of the current members.
+func (w *NormalizingWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	current, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	known, err := w.stableIDs(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	canonical := w.canonicalMembers(ctx, groupID, current, members, known)
+	if err := w.GroupReadWriter.SetMembers(ctx, groupID, canonical); err != nil {
+		return err //nolint:wrapcheck // Want passthrough
+	}
+	return w.saveStableIDs(ctx, groupID, current, members, known)
+}
+
+// stableIDs returns the StableIDs of the users of the group with the given
+// ID by their normalized IDs, as of the last write, if they are tracked.
+func (w *NormalizingWriter) stableIDs(ctx context.Context, groupID string) (map[string]string, error) {
+	known := make(map[string]string)
+	if w.store == nil {
+		return known, nil
+	}
+	if err := state.GetJSON(ctx, w.store, state.Key(stableIDsKeyPrefix, w.system, groupID), &known); err != nil && !errors.Is(err, state.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get stable IDs of group %s: %w", groupID, err)
+	}
+	return known, nil
+}
+
+// saveStableIDs saves the StableIDs of the current users of the group with
+// the given ID, and keeps the known StableIDs of the given members, whose
+// IDs may be the old IDs of renamed users.
+func (w *NormalizingWriter) saveStableIDs(ctx context.Context, groupID string, current, members []Member, known map[string]string) error {
+	if w.store == nil {
+		return nil
+	}
+	learned := make(map[string]string, len(current))
+	for _, m := range current {
+		if id := stableID(m); id != "" {
+			learned[w.normalize(m.ID())] = id
+		}
+	}
+	for _, m := range members {
+		id := w.normalize(m.ID())
+		if _, ok := learned[id]; !ok && known[id] != "" && m.IsUser() {
+			learned[id] = known[id]
+		}
+	}
+	if err := state.PutJSON(ctx, w.store, state.Key(stableIDsKeyPrefix, w.system, groupID), learned); err != nil {
+		return fmt.Errorf("failed to save stable IDs of group %s: %w", groupID, err)
+	}
+	return nil
+}
+
+// canonicalMembers returns the given members with the IDs of the current
+// members they normalize to, or which have their known StableID, without
+// duplicates. Users and groups are normalized separately.
+func (w *NormalizingWriter) canonicalMembers(ctx context.Context, groupID string, current, members []Member, known map[string]string) []Member {
+	key := func(m Member) string {
+		return fmt.Sprintf("%t/%s", m.IsGroup(), w.normalize(m.ID()))
+	}
+	currentByKey := make(map[string]Member, len(current))
+	currentByStableID := make(map[string]Member, len(current))
+	for _, m := range current {
+		currentByKey[key(m)] = m
+		if id := stableID(m); id != "" {
+			currentByStableID[id] = m
+		}
+	}
+	logger := logging.FromContext(ctx)
+	seen := make(map[string]struct{}, len(members))
+	canonical := make([]Member, 0, len(members))
+	for _, m := range members {
+		k := key(m)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		c, ok := currentByKey[k]
+		if !ok && m.IsUser() && known[w.normalize(m.ID())] != "" {
+			if c, ok = currentByStableID[known[w.normalize(m.ID())]]; ok {
+				logger.WarnContext(ctx, "target user was renamed, keeping the member under its new ID",
+					"group_id", groupID,
+					"mapped_user_id", m.ID(),
+					"current_user_id", c.ID(),
+				)
+				k = key(c)
+				if _, ok := seen[k]; ok {
+					continue
+				}
+				seen[k] = struct{}{}
+			}
+		}
+		if ok && c.ID() != m.ID() {
+			m = withID(m, c.ID())
+		}
+		canonical = append(canonical, m)
+	}
+	return canonical
+}
+
+// withID returns a copy of the given member with the given ID, keeping its
+// role and attributes.
+func withID(m Member, id string) Member {
+	switch m := m.(type) {
+	case *UserMember:
+		u := *m.Usr
+		u.ID = id
+		return &UserMember{Usr: &u, Role: m.Role}
+	case *GroupMember:
+		g := *m.Grp
+		g.ID = id
+		return &GroupMember{Grp: &g}
+	}
+	return m
+}
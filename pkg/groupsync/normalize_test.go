@@ -0,0 +1,231 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestUnicodeIDs(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		id   string
+		want string
+		// wantCaseInsensitive is the ID normalized by CaseInsensitiveIDs.
+		wantCaseInsensitive string
+	}{
+		{
+			name:                "ascii",
+			id:                  "Alice@Example.com",
+			want:                "Alice@Example.com",
+			wantCaseInsensitive: "alice@example.com",
+		},
+		{
+			name:                "decomposed",
+			id:                  "Jose\u0301@example.com",
+			want:                "Jos\u00e9@example.com",
+			wantCaseInsensitive: "jos\u00e9@example.com",
+		},
+		{
+			name:                "composed",
+			id:                  "Jos\u00e9",
+			want:                "Jos\u00e9",
+			wantCaseInsensitive: "jos\u00e9",
+		},
+		{
+			name:                "internationalized_domain",
+			id:                  "Jos\u00e9@B\u00fccher.example",
+			want:                "Jos\u00e9@xn--bcher-kva.example",
+			wantCaseInsensitive: "jos\u00e9@xn--bcher-kva.example",
+		},
+		{
+			name:                "decomposed_domain",
+			id:                  "jose@bu\u0308cher.example",
+			want:                "jose@xn--bcher-kva.example",
+			wantCaseInsensitive: "jose@xn--bcher-kva.example",
+		},
+		{
+			name:                "invalid_domain",
+			id:                  "jos\u00e9@\u00e9 x.example",
+			want:                "jos\u00e9@\u00e9 x.example",
+			wantCaseInsensitive: "jos\u00e9@\u00e9 x.example",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := UnicodeIDs(tc.id); got != tc.want {
+				t.Errorf("UnicodeIDs(%q) got %q, want %q", tc.id, got, tc.want)
+			}
+			if got := CaseInsensitiveIDs(tc.id); got != tc.wantCaseInsensitive {
+				t.Errorf("CaseInsensitiveIDs(%q) got %q, want %q", tc.id, got, tc.wantCaseInsensitive)
+			}
+		})
+	}
+}
+
+func TestNormalizingWriter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		members []Member
+		want    []string
+	}{
+		{
+			name: "unchanged_but_case",
+			members: []Member{
+				&UserMember{Usr: &User{ID: "Alice"}},
+				&UserMember{Usr: &User{ID: "bob"}},
+			},
+			want: []string{"Bob", "alice"},
+		},
+		{
+			name: "duplicates",
+			members: []Member{
+				&UserMember{Usr: &User{ID: "carol"}},
+				&UserMember{Usr: &User{ID: "Carol"}},
+				&UserMember{Usr: &User{ID: "ALICE"}},
+			},
+			want: []string{"alice", "carol"},
+		},
+		{
+			name: "groups_and_users_apart",
+			members: []Member{
+				&UserMember{Usr: &User{ID: "alice"}},
+				&GroupMember{Grp: &Group{ID: "ALICE"}},
+			},
+			want: []string{"ALICE", "alice"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			client := &MemoryGroupReadWriter{
+				Members: map[string][]Member{"99": {
+					&UserMember{Usr: &User{ID: "alice"}},
+					&UserMember{Usr: &User{ID: "Bob"}},
+				}},
+			}
+			w := NewNormalizingWriter(client, CaseInsensitiveIDs)
+
+			if err := w.SetMembers(ctx, "99", tc.members); err != nil {
+				t.Fatalf("SetMembers failed: %v", err)
+			}
+			if diff := cmp.Diff(targetIDs(t, client), tc.want); diff != "" {
+				t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizingWriter_StableIDs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := state.NewMemoryStore()
+	client := &MemoryGroupReadWriter{
+		Members: map[string][]Member{"99": {
+			&UserMember{Usr: &User{ID: "alice", StableID: "1"}},
+			&UserMember{Usr: &User{ID: "bob", StableID: "2"}},
+		}},
+	}
+	w := NewNormalizingWriter(client, CaseInsensitiveIDs, TrackStableIDs("github", store))
+	members := []Member{
+		&UserMember{Usr: &User{ID: "alice"}},
+		&UserMember{Usr: &User{ID: "bob"}},
+	}
+	if err := w.SetMembers(ctx, "99", members); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+
+	// alice changes her login, while the mappings still use the old one.
+	client.Members["99"] = []Member{
+		&UserMember{Usr: &User{ID: "alice-renamed", StableID: "1"}},
+		&UserMember{Usr: &User{ID: "bob", StableID: "2"}},
+	}
+	if err := w.SetMembers(ctx, "99", members); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(targetIDs(t, client), []string{"alice-renamed", "bob"}); diff != "" {
+		t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
+	}
+
+	// without tracking, the renamed user is replaced.
+	if err := NewNormalizingWriter(client, CaseInsensitiveIDs).SetMembers(ctx, "99", members); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(targetIDs(t, client), []string{"alice", "bob"}); diff != "" {
+		t.Errorf("unexpected target group members without tracking (-got, +want):\n%s", diff)
+	}
+}
+
+func TestSync_SourceIDNormalizer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sourceGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{
+			"1": {
+				&UserMember{Usr: &User{ID: "Alice@Example.com"}},
+				&UserMember{Usr: &User{ID: "bob@example.com"}},
+				&UserMember{Usr: &User{ID: "Jose\u0301@example.com"}},
+			},
+		},
+	}
+	targetGroupClient := &MemoryGroupReadWriter{
+		Members: map[string][]Member{"99": {
+			&UserMember{Usr: &User{ID: "Alice"}},
+		}},
+	}
+	syncer := NewManyToManySyncer(
+		"source",
+		"target",
+		sourceGroupClient,
+		NewNormalizingWriter(targetGroupClient, CaseInsensitiveIDs),
+		&testGroupMapper{m: map[string][]string{"1": {"99"}}},
+		&testGroupMapper{m: map[string][]string{"99": {"1"}}},
+		&testUserMapper{m: map[string]string{
+			"alice@example.com":     "alice",
+			"bob@example.com":       "bob",
+			"jos\u00e9@example.com": "jose",
+		}},
+		WithSourceIDNormalizer(CaseInsensitiveIDs),
+	)
+
+	if err := syncer.Sync(ctx, "1"); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	// alice keeps the spelling of the target group.
+	if diff := cmp.Diff(targetIDs(t, targetGroupClient), []string{"Alice", "bob", "jose"}); diff != "" {
+		t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
+	}
+	// the shared source users are not changed.
+	if got, want := sourceGroupClient.Members["1"][0].ID(), "Alice@Example.com"; got != want {
+		t.Errorf("source user ID got %q, want %q", got, want)
+	}
+}
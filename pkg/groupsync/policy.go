@@ -0,0 +1,184 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+// ErrPolicyViolation denotes that a PolicyWriter refused a write which
+// violates the sync policy of a group.
+const ErrPolicyViolation = Error("sync policy violation")
+
+// Policy restricts the changes made to a target group.
+type Policy struct {
+	// MaxRemovals is the most members a write may remove. 0 means no limit.
+	MaxRemovals int
+	// AdditiveOnly keeps every current member.
+	AdditiveOnly bool
+	// ProtectedUsers are the IDs of users which are never removed.
+	ProtectedUsers []string
+}
+
+// Merge returns the policy combining p and other, in which the most
+// restrictive setting of either wins. Either may be nil.
+func (p *Policy) Merge(other *Policy) *Policy {
+	if p == nil {
+		return other
+	}
+	if other == nil {
+		return p
+	}
+	maxRemovals := p.MaxRemovals
+	if maxRemovals == 0 || (other.MaxRemovals > 0 && other.MaxRemovals < maxRemovals) {
+		maxRemovals = other.MaxRemovals
+	}
+	return &Policy{
+		MaxRemovals:    maxRemovals,
+		AdditiveOnly:   p.AdditiveOnly || other.AdditiveOnly,
+		ProtectedUsers: append(slices.Clip(p.ProtectedUsers), other.ProtectedUsers...),
+	}
+}
+
+// PolicyWriter wraps a GroupReadWriter and applies the Policy of each target
+// group to its writes: members which must be kept are added back to the
+// written members, and writes which remove too many members are refused
+// with ErrPolicyViolation.
+type PolicyWriter struct {
+	WrappedWriter
+	defaultPolicy *Policy
+	policies      map[string]*Policy
+	normalize     IDNormalizer
+}
+
+// NewPolicyWriter creates a PolicyWriter wrapping rw. Groups are written
+// with their policy in policies, or defaultPolicy if they have none; either
+// may be nil. Member IDs are compared by their form normalized by
+// normalize, unless it is nil.
+func NewPolicyWriter(rw GroupReadWriter, defaultPolicy *Policy, policies map[string]*Policy, normalize IDNormalizer) *PolicyWriter {
+	return &PolicyWriter{
+		WrappedWriter: WrappedWriter{GroupReadWriter: rw},
+		defaultPolicy: defaultPolicy,
+		policies:      policies,
+		normalize:     normalize,
+	}
+}
+
+func (w *PolicyWriter) policy(groupID string) *Policy {
+	if p, ok := w.policies[groupID]; ok {
+		return p
+	}
+	return w.defaultPolicy
+}
+
+func (w *PolicyWriter) key(id string) string {
+	if w.normalize != nil {
+		return w.normalize(id)
+	}
+	return id
+}
+
+// SetMembers sets the members of the group, along with the current members
+// which its policy keeps, unless more members would be removed than the
+// policy allows.
+func (w *PolicyWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	policy := w.policy(groupID)
+	if policy == nil {
+		return w.GroupReadWriter.SetMembers(ctx, groupID, members) //nolint:wrapcheck // Want passthrough
+	}
+	current, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	var diffOpts []DiffOpt
+	if w.normalize != nil {
+		diffOpts = append(diffOpts, DiffNormalizeIDs(w.normalize))
+	}
+	removals := ComputeDiff(current, members, diffOpts...).Remove
+	if len(removals) == 0 {
+		return w.GroupReadWriter.SetMembers(ctx, groupID, members) //nolint:wrapcheck // Want passthrough
+	}
+
+	kept, removed := w.split(ctx, groupID, policy, removals)
+	if err := checkMaxRemovals(groupID, policy, removed); err != nil {
+		return err
+	}
+	return w.GroupReadWriter.SetMembers(ctx, groupID, append(slices.Clip(members), kept...)) //nolint:wrapcheck // Want passthrough
+}
+
+// ArchiveGroup archives the group with the wrapped writer, unless its policy
+// keeps some of its members or allows fewer removals than it has members.
+func (w *PolicyWriter) ArchiveGroup(ctx context.Context, groupID string) error {
+	archiver, ok := w.GroupReadWriter.(GroupArchiver)
+	if !ok {
+		return fmt.Errorf("group writer cannot archive group %s", groupID)
+	}
+	if policy := w.policy(groupID); policy != nil {
+		current, err := w.GetMembers(ctx, groupID)
+		if err != nil {
+			return fmt.Errorf("could not get current members: %w", err)
+		}
+		kept, removed := w.split(ctx, groupID, policy, current)
+		if len(kept) > 0 {
+			return fmt.Errorf("%w: group %s cannot be archived, its sync policy keeps %q", ErrPolicyViolation, groupID, memberIDs(kept))
+		}
+		if err := checkMaxRemovals(groupID, policy, removed); err != nil {
+			return err
+		}
+	}
+	return archiver.ArchiveGroup(ctx, groupID) //nolint:wrapcheck // Want passthrough
+}
+
+// split splits the members which would be removed from a group into those
+// which the policy keeps and those which may be removed.
+func (w *PolicyWriter) split(ctx context.Context, groupID string, policy *Policy, removals []Member) ([]Member, []Member) {
+	logger := logging.FromContext(ctx)
+	if policy.AdditiveOnly {
+		return removals, nil
+	}
+	protected := make(map[string]struct{}, len(policy.ProtectedUsers))
+	for _, id := range policy.ProtectedUsers {
+		protected[w.key(id)] = struct{}{}
+	}
+	var kept, removed []Member
+	for _, m := range removals {
+		if _, ok := protected[w.key(m.ID())]; ok && !m.IsGroup() {
+			kept = append(kept, m)
+		} else {
+			removed = append(removed, m)
+		}
+	}
+	if len(kept) > 0 {
+		logger.InfoContext(ctx, "keeping protected users",
+			"target_group_id", groupID,
+			"protected_user_ids", memberIDs(kept),
+		)
+	}
+	return kept, removed
+}
+
+// checkMaxRemovals returns ErrPolicyViolation if more members would be
+// removed than the policy allows.
+func checkMaxRemovals(groupID string, policy *Policy, removed []Member) error {
+	if policy.MaxRemovals > 0 && len(removed) > policy.MaxRemovals {
+		return fmt.Errorf("%w: group %s would remove %d members, more than the %d allowed: %q",
+			ErrPolicyViolation, groupID, len(removed), policy.MaxRemovals, memberIDs(removed))
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestPolicyWriter_SetMembers(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		defaultPolicy *Policy
+		policies      map[string]*Policy
+		members       []Member
+		wantIDs       []string
+		wantErr       string
+	}{
+		{
+			name:    "no_policy",
+			members: []Member{&UserMember{Usr: &User{ID: "alice"}}},
+			wantIDs: []string{"alice"},
+		},
+		{
+			name:          "additive_only",
+			defaultPolicy: &Policy{AdditiveOnly: true},
+			members:       []Member{&UserMember{Usr: &User{ID: "dave"}}},
+			wantIDs:       []string{"alice", "bob", "carol", "dave"},
+		},
+		{
+			name:          "protected_users",
+			defaultPolicy: &Policy{ProtectedUsers: []string{"BOB"}},
+			members:       []Member{&UserMember{Usr: &User{ID: "alice"}}},
+			wantIDs:       []string{"alice", "bob"},
+		},
+		{
+			name:          "max_removals",
+			defaultPolicy: &Policy{MaxRemovals: 2},
+			members:       []Member{&UserMember{Usr: &User{ID: "alice"}}},
+			wantIDs:       []string{"alice"},
+		},
+		{
+			name:          "max_removals_exceeded",
+			defaultPolicy: &Policy{MaxRemovals: 1},
+			members:       []Member{&UserMember{Usr: &User{ID: "alice"}}},
+			wantIDs:       []string{"alice", "bob", "carol"},
+			wantErr:       `sync policy violation: group 99 would remove 2 members, more than the 1 allowed: ["bob" "carol"]`,
+		},
+		{
+			name:          "protected_users_not_counted",
+			defaultPolicy: &Policy{MaxRemovals: 1, ProtectedUsers: []string{"bob"}},
+			members:       []Member{&UserMember{Usr: &User{ID: "alice"}}},
+			wantIDs:       []string{"alice", "bob"},
+		},
+		{
+			name:          "group_policy_overrides_default",
+			defaultPolicy: &Policy{AdditiveOnly: true},
+			policies:      map[string]*Policy{"99": {MaxRemovals: 5}},
+			members:       []Member{&UserMember{Usr: &User{ID: "alice"}}},
+			wantIDs:       []string{"alice"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &MemoryGroupReadWriter{
+				Members: map[string][]Member{"99": {
+					&UserMember{Usr: &User{ID: "alice"}},
+					&UserMember{Usr: &User{ID: "bob"}},
+					&UserMember{Usr: &User{ID: "carol"}},
+				}},
+			}
+			w := NewPolicyWriter(client, tc.defaultPolicy, tc.policies, CaseInsensitiveIDs)
+
+			err := w.SetMembers(context.Background(), "99", tc.members)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			if tc.wantErr != "" && !errors.Is(err, ErrPolicyViolation) {
+				t.Errorf("SetMembers got err %v, want %v", err, ErrPolicyViolation)
+			}
+			if diff := cmp.Diff(targetIDs(t, client), tc.wantIDs); diff != "" {
+				t.Errorf("unexpected target members (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicy_Merge(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name  string
+		p     *Policy
+		other *Policy
+		want  *Policy
+	}{
+		{
+			name: "both_nil",
+		},
+		{
+			name:  "nil_receiver",
+			other: &Policy{MaxRemovals: 3},
+			want:  &Policy{MaxRemovals: 3},
+		},
+		{
+			name:  "most_restrictive_wins",
+			p:     &Policy{MaxRemovals: 3, ProtectedUsers: []string{"alice"}},
+			other: &Policy{MaxRemovals: 5, AdditiveOnly: true, ProtectedUsers: []string{"bob"}},
+			want:  &Policy{MaxRemovals: 3, AdditiveOnly: true, ProtectedUsers: []string{"alice", "bob"}},
+		},
+		{
+			name:  "unlimited_removals",
+			p:     &Policy{},
+			other: &Policy{MaxRemovals: 5},
+			want:  &Policy{MaxRemovals: 5},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(tc.p.Merge(tc.other), tc.want); diff != "" {
+				t.Errorf("Merge got unexpected policy (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+)
+
+// ErrReadOnly denotes that a ReadOnlyWriter refused to change a group.
+const ErrReadOnly = Error("refusing to write in read-only mode")
+
+// ReadOnlyWriter wraps a GroupReadWriter and refuses every mutation, so that
+// audit and diff runs with production credentials cannot change anything,
+// even if there is a bug in the sync. Reads are passed through.
+type ReadOnlyWriter struct {
+	GroupReadWriter
+}
+
+// NewReadOnlyWriter creates a ReadOnlyWriter wrapping rw.
+func NewReadOnlyWriter(rw GroupReadWriter) *ReadOnlyWriter {
+	return &ReadOnlyWriter{GroupReadWriter: rw}
+}
+
+// SetMembers succeeds if the group already has the given members, compared
+// by ID and role, and otherwise returns ErrReadOnly along with the members
+// that would have been added, removed and had their role changed.
+func (w *ReadOnlyWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	current, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	diff := ComputeDiff(current, members)
+	if diff.IsEmpty() {
+		return nil
+	}
+	if len(diff.Update) > 0 {
+		return fmt.Errorf("%w: group %s would add %q, remove %q and change the role of %q", ErrReadOnly, groupID,
+			memberIDs(diff.Add), memberIDs(diff.Remove), memberIDs(diff.Update))
+	}
+	return fmt.Errorf("%w: group %s would add %q and remove %q", ErrReadOnly, groupID, memberIDs(diff.Add), memberIDs(diff.Remove))
+}
+
+// ArchiveGroup always returns ErrReadOnly.
+func (w *ReadOnlyWriter) ArchiveGroup(ctx context.Context, groupID string) error {
+	return fmt.Errorf("%w: group %s would be archived", ErrReadOnly, groupID)
+}
+
+// CheckWritePermissions always succeeds. A read-only run makes no writes, so
+// it must not fail for credentials which are missing write permissions, e.g.
+// the read-only credentials of an audit.
+func (w *ReadOnlyWriter) CheckWritePermissions(ctx context.Context, groupIDs []string) error {
+	return nil
+}
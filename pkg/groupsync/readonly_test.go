@@ -0,0 +1,130 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestReadOnlyWriter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		members []Member
+		wantErr string
+	}{
+		{
+			name: "unchanged",
+			members: []Member{
+				&UserMember{Usr: &User{ID: "bob"}},
+				&UserMember{Usr: &User{ID: "alice"}},
+			},
+		},
+		{
+			name: "changed",
+			members: []Member{
+				&UserMember{Usr: &User{ID: "alice"}},
+				&UserMember{Usr: &User{ID: "carol"}},
+			},
+			wantErr: `refusing to write in read-only mode: group 99 would add ["carol"] and remove ["bob"]`,
+		},
+		{
+			name: "role_changed",
+			members: []Member{
+				&UserMember{Usr: &User{ID: "alice"}, Role: "maintainer"},
+				&UserMember{Usr: &User{ID: "bob"}},
+			},
+			wantErr: `group 99 would add [], remove [] and change the role of ["alice"]`,
+		},
+		{
+			name:    "emptied",
+			wantErr: `group 99 would add [] and remove ["alice" "bob"]`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			client := &MemoryGroupReadWriter{
+				Members: map[string][]Member{"99": {
+					&UserMember{Usr: &User{ID: "alice"}},
+					&UserMember{Usr: &User{ID: "bob"}},
+				}},
+			}
+			w := NewReadOnlyWriter(client)
+
+			err := w.SetMembers(ctx, "99", tc.members)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Error(diff)
+			}
+			if err != nil && !errors.Is(err, ErrReadOnly) {
+				t.Errorf("SetMembers got err %v, want %v", err, ErrReadOnly)
+			}
+			if diff := cmp.Diff(targetIDs(t, client), []string{"alice", "bob"}); diff != "" {
+				t.Errorf("target group changed (-got, +want):\n%s", diff)
+			}
+			if err := w.ArchiveGroup(ctx, "99"); !errors.Is(err, ErrReadOnly) {
+				t.Errorf("ArchiveGroup got err %v, want %v", err, ErrReadOnly)
+			}
+		})
+	}
+}
+
+func TestReadOnlyWriter_CheckWritePermissions(t *testing.T) {
+	t.Parallel()
+
+	// the wrapped credentials cannot write, which a read-only run ignores.
+	w := NewReadOnlyWriter(&testPermissionChecker{MemoryGroupReadWriter: &MemoryGroupReadWriter{}, err: ErrTransient})
+	if err := w.CheckWritePermissions(context.Background(), []string{"99"}); err != nil {
+		t.Errorf("CheckWritePermissions got err %v, want nil", err)
+	}
+}
+
+func BenchmarkReadOnlyWriter_SetMembers(b *testing.B) {
+	ctx := context.Background()
+	for _, n := range benchmarkSizes {
+		b.Run(fmt.Sprintf("members=%d", n), func(b *testing.B) {
+			rw := benchmarkGroup(n)
+			// diff a flat group of n members against itself.
+			users, err := rw.Descendants(ctx, "root")
+			if err != nil {
+				b.Fatalf("Descendants failed: %v", err)
+			}
+			members := make([]Member, 0, len(users))
+			for _, u := range users {
+				members = append(members, &UserMember{Usr: u})
+			}
+			rw.AddGroup(&Group{ID: "flat"}, members...)
+			w := NewReadOnlyWriter(rw)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				if err := w.SetMembers(ctx, "flat", members); err != nil {
+					b.Fatalf("SetMembers failed: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"fmt"
+	"slices"
+)
+
+// RoleConflictPolicy decides the role of a target user which several source
+// users of the same target group map to with different roles.
+type RoleConflictPolicy string
+
+const (
+	// RoleConflictFirstWins keeps the role of the first source user, in the
+	// order of the source group members. It is the default.
+	RoleConflictFirstWins RoleConflictPolicy = "first-wins"
+	// RoleConflictHighestWins keeps the highest role, see RoleRanks.
+	RoleConflictHighestWins RoleConflictPolicy = "highest-wins"
+	// RoleConflictError fails the sync of the target group.
+	RoleConflictError RoleConflictPolicy = "error"
+)
+
+// RoleConflictPolicies are the supported policies.
+var RoleConflictPolicies = []RoleConflictPolicy{RoleConflictFirstWins, RoleConflictHighestWins, RoleConflictError}
+
+// RoleRanks orders the roles of the supported target systems, lowest first.
+// The empty role, the default role of the target system, ranks lowest.
+var RoleRanks = []string{"", "guest", "reporter", "member", "developer", "maintainer", "manager", "admin", "owner"}
+
+// ParseRoleConflictPolicy parses the name of a RoleConflictPolicy.
+func ParseRoleConflictPolicy(s string) (RoleConflictPolicy, error) {
+	if p := RoleConflictPolicy(s); slices.Contains(RoleConflictPolicies, p) {
+		return p, nil
+	}
+	return "", fmt.Errorf("unknown role conflict policy %q, must be one of: %v", s, RoleConflictPolicies)
+}
+
+// resolve returns the role of a target user mapped with the role first, and
+// then with the role second.
+func (p RoleConflictPolicy) resolve(first, second string) (string, error) {
+	if first == second {
+		return first, nil
+	}
+	switch p {
+	case RoleConflictHighestWins:
+		firstRank, secondRank := slices.Index(RoleRanks, first), slices.Index(RoleRanks, second)
+		if firstRank < 0 || secondRank < 0 {
+			return "", fmt.Errorf("cannot rank roles %q and %q, known roles are %q", first, second, RoleRanks)
+		}
+		if secondRank > firstRank {
+			return second, nil
+		}
+		return first, nil
+	case RoleConflictError:
+		return "", fmt.Errorf("conflicting roles %q and %q", first, second)
+	}
+	return first, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestSync_RoleConflictPolicy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		policy  RoleConflictPolicy
+		mapping map[string][]*MappedUser
+		want    []Member
+		wantErr string
+	}{
+		{
+			name:   "first_wins",
+			policy: RoleConflictFirstWins,
+			mapping: map[string][]*MappedUser{
+				"alice@example.com": {{ID: "alice"}, {ID: "admin", Role: "member"}},
+				"bob@example.com":   {{ID: "bob"}, {ID: "admin", Role: "maintainer"}},
+			},
+			want: []Member{
+				&UserMember{Usr: &User{ID: "admin"}, Role: "member"},
+				&UserMember{Usr: &User{ID: "alice"}},
+				&UserMember{Usr: &User{ID: "bob"}},
+			},
+		},
+		{
+			name:   "highest_wins",
+			policy: RoleConflictHighestWins,
+			mapping: map[string][]*MappedUser{
+				"alice@example.com": {{ID: "alice"}, {ID: "admin", Role: "member"}},
+				"bob@example.com":   {{ID: "bob"}, {ID: "admin", Role: "maintainer"}},
+			},
+			want: []Member{
+				&UserMember{Usr: &User{ID: "admin"}, Role: "maintainer"},
+				&UserMember{Usr: &User{ID: "alice"}},
+				&UserMember{Usr: &User{ID: "bob"}},
+			},
+		},
+		{
+			name:   "highest_wins_default_role",
+			policy: RoleConflictHighestWins,
+			mapping: map[string][]*MappedUser{
+				"alice@example.com": {{ID: "shared", Role: "owner"}},
+				"bob@example.com":   {{ID: "shared"}},
+			},
+			want: []Member{
+				&UserMember{Usr: &User{ID: "shared"}, Role: "owner"},
+			},
+		},
+		{
+			name:   "highest_wins_unknown_role",
+			policy: RoleConflictHighestWins,
+			mapping: map[string][]*MappedUser{
+				"alice@example.com": {{ID: "shared", Role: "superuser"}},
+				"bob@example.com":   {{ID: "shared", Role: "member"}},
+			},
+			want:    []Member{},
+			wantErr: `cannot rank roles "superuser" and "member"`,
+		},
+		{
+			name:   "error",
+			policy: RoleConflictError,
+			mapping: map[string][]*MappedUser{
+				"alice@example.com": {{ID: "alice"}, {ID: "admin", Role: "member"}},
+				"bob@example.com":   {{ID: "bob"}, {ID: "admin", Role: "maintainer"}},
+			},
+			want:    []Member{},
+			wantErr: `source users alice@example.com and bob@example.com map to target user admin: conflicting roles "member" and "maintainer"`,
+		},
+		{
+			name:   "error_same_role",
+			policy: RoleConflictError,
+			mapping: map[string][]*MappedUser{
+				"alice@example.com": {{ID: "shared", Role: "member"}},
+				"bob@example.com":   {{ID: "shared", Role: "member"}},
+			},
+			want: []Member{
+				&UserMember{Usr: &User{ID: "shared"}, Role: "member"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			sourceGroupClient := &MemoryGroupReadWriter{
+				Members: map[string][]Member{
+					"1": {&UserMember{Usr: &User{ID: "bob@example.com"}}},
+					"2": {&UserMember{Usr: &User{ID: "alice@example.com"}}},
+				},
+			}
+			targetGroupClient := &MemoryGroupReadWriter{
+				Members: map[string][]Member{"99": {}},
+			}
+			syncer := NewManyToManySyncer(
+				"source",
+				"target",
+				sourceGroupClient,
+				targetGroupClient,
+				&testGroupMapper{m: map[string][]string{"1": {"99"}, "2": {"99"}}},
+				&testGroupMapper{m: map[string][]string{"99": {"1", "2"}}},
+				&testMultiUserMapper{m: tc.mapping},
+				WithRoleConflictPolicy(tc.policy),
+			)
+
+			err := syncer.Sync(ctx, "1")
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected error: %s", diff)
+			}
+			got, err := targetGroupClient.GetMembers(ctx, "99")
+			if err != nil {
+				t.Fatalf("failed to get target group members: %v", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("unexpected target group members (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
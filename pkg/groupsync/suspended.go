@@ -0,0 +1,170 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+const suspendedKeyPrefix = "suspended"
+
+// SuspendedUserReader is a GroupReader which can tell whether a user is
+// suspended, blocked or deactivated in the group system.
+type SuspendedUserReader interface {
+	GroupReader
+
+	// IsSuspended reports whether the user with the given ID is suspended.
+	IsSuspended(ctx context.Context, userID string) (bool, error)
+}
+
+// SuspendedUserPolicy decides whether suspended source users are synced to
+// target groups.
+type SuspendedUserPolicy string
+
+const (
+	// SuspendedUserKeep syncs suspended users like active users.
+	SuspendedUserKeep SuspendedUserPolicy = "keep"
+	// SuspendedUserRemove removes suspended users from target groups.
+	SuspendedUserRemove SuspendedUserPolicy = "remove"
+	// SuspendedUserGracePeriod removes suspended users from target groups
+	// once they have been suspended for a grace period, e.g. to bridge a
+	// short leave.
+	SuspendedUserGracePeriod SuspendedUserPolicy = "grace-period"
+)
+
+// SuspendedUserPolicies are the supported policies.
+var SuspendedUserPolicies = []SuspendedUserPolicy{SuspendedUserKeep, SuspendedUserRemove, SuspendedUserGracePeriod}
+
+// ParseSuspendedUserPolicy parses the name of a SuspendedUserPolicy.
+func ParseSuspendedUserPolicy(s string) (SuspendedUserPolicy, error) {
+	for _, p := range SuspendedUserPolicies {
+		if string(p) == s {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("unknown suspended user policy %q, must be one of %q", s, SuspendedUserPolicies)
+}
+
+// SuspensionRecord tracks since when a source user is suspended.
+type SuspensionRecord struct {
+	UserID         string    `json:"user_id"`
+	SuspendedSince time.Time `json:"suspended_since"`
+}
+
+// SuspendedUsers applies a SuspendedUserPolicy to the users of source groups.
+// With SuspendedUserGracePeriod, it persists since when users are suspended
+// in a state.Store.
+type SuspendedUsers struct {
+	policy SuspendedUserPolicy
+	store  state.Store
+	grace  time.Duration
+	now    func() time.Time
+}
+
+// NewSuspendedUsers creates a SuspendedUsers applying the given policy. The
+// store and grace period are only used by SuspendedUserGracePeriod.
+func NewSuspendedUsers(policy SuspendedUserPolicy, store state.Store, grace time.Duration) *SuspendedUsers {
+	return &SuspendedUsers{
+		policy: policy,
+		store:  store,
+		grace:  grace,
+		now:    time.Now,
+	}
+}
+
+// filter returns the given source users without the suspended users which
+// the policy removes. Users whose status cannot be read are kept.
+func (s *SuspendedUsers) filter(ctx context.Context, reader SuspendedUserReader, users []*User) ([]*User, error) {
+	recorded := make(map[string]bool)
+	if s.policy == SuspendedUserGracePeriod {
+		keys, err := s.store.List(ctx, suspendedKeyPrefix+"/")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list suspension records: %w", err)
+		}
+		for _, k := range keys {
+			recorded[k] = true
+		}
+	}
+
+	logger := logging.FromContext(ctx)
+	var merr error
+	kept := make([]*User, 0, len(users))
+	for _, u := range users {
+		suspended, err := reader.IsSuspended(ctx, u.ID)
+		if err != nil {
+			logger.WarnContext(ctx, "failed to check whether user is suspended, keeping it",
+				"user_id", u.ID,
+				"error", err,
+			)
+			kept = append(kept, u)
+			continue
+		}
+		remove, err := s.remove(ctx, u.ID, suspended, recorded)
+		if err != nil {
+			merr = errors.Join(merr, err)
+		}
+		if !remove {
+			kept = append(kept, u)
+			continue
+		}
+		logger.InfoContext(ctx, "removing suspended user from target groups",
+			"user_id", u.ID,
+			"policy", s.policy,
+		)
+	}
+	return kept, merr
+}
+
+// remove reports whether the user with the given ID is removed by the policy,
+// and updates its suspension record given the keys of the existing records.
+func (s *SuspendedUsers) remove(ctx context.Context, userID string, suspended bool, recorded map[string]bool) (bool, error) {
+	switch s.policy {
+	case SuspendedUserRemove:
+		return suspended, nil
+	case SuspendedUserGracePeriod:
+	default:
+		return false, nil
+	}
+
+	key := state.Key(suspendedKeyPrefix, userID)
+	exists := recorded[key]
+	if !suspended {
+		if exists {
+			// the user was reactivated.
+			if err := s.store.Delete(ctx, key); err != nil {
+				return false, fmt.Errorf("failed to delete suspension record of %s: %w", userID, err)
+			}
+		}
+		return false, nil
+	}
+	var record SuspensionRecord
+	if exists {
+		if err := state.GetJSON(ctx, s.store, key, &record); err != nil {
+			return false, fmt.Errorf("failed to read suspension record of %s: %w", userID, err)
+		}
+	} else {
+		record = SuspensionRecord{UserID: userID, SuspendedSince: s.now().UTC()}
+		if err := state.PutJSON(ctx, s.store, key, &record); err != nil {
+			return false, fmt.Errorf("failed to save suspension record of %s: %w", userID, err)
+		}
+	}
+	return !s.now().Before(record.SuspendedSince.Add(s.grace)), nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+func TestSync_SuspendedUsers(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		policy SuspendedUserPolicy
+		// want are the target group members after each run. bob is suspended
+		// before the first run and reactivated before the last one.
+		want [][]string
+	}{
+		{
+			name:   "keep",
+			policy: SuspendedUserKeep,
+			want:   [][]string{{"alice", "bob"}, {"alice", "bob"}, {"alice", "bob"}},
+		},
+		{
+			name:   "remove",
+			policy: SuspendedUserRemove,
+			want:   [][]string{{"alice"}, {"alice"}, {"alice", "bob"}},
+		},
+		{
+			name:   "grace_period",
+			policy: SuspendedUserGracePeriod,
+			want:   [][]string{{"alice", "bob"}, {"alice"}, {"alice", "bob"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+			sourceGroupClient := &testSuspendedUserClient{
+				MemoryGroupReadWriter: &MemoryGroupReadWriter{
+					Members: map[string][]Member{"1": {
+						&UserMember{Usr: &User{ID: "alice@example.com"}},
+						&UserMember{Usr: &User{ID: "bob@example.com"}},
+					}},
+				},
+				suspended: map[string]bool{"bob@example.com": true},
+			}
+			targetGroupClient := &MemoryGroupReadWriter{
+				Members: map[string][]Member{"99": {}},
+			}
+			suspended := NewSuspendedUsers(tc.policy, state.NewMemoryStore(), 24*time.Hour)
+			suspended.now = func() time.Time { return now }
+			syncer := NewManyToManySyncer(
+				"source",
+				"target",
+				sourceGroupClient,
+				targetGroupClient,
+				&testGroupMapper{m: map[string][]string{"1": {"99"}}},
+				&testGroupMapper{m: map[string][]string{"99": {"1"}}},
+				&testUserMapper{m: map[string]string{
+					"alice@example.com": "alice",
+					"bob@example.com":   "bob",
+				}},
+				WithSuspendedUsers(suspended),
+			)
+
+			for i, want := range tc.want {
+				if i == len(tc.want)-1 {
+					sourceGroupClient.suspended["bob@example.com"] = false
+				}
+				if err := syncer.Sync(ctx, "1"); err != nil {
+					t.Fatalf("Sync %d failed: %v", i, err)
+				}
+				if diff := cmp.Diff(targetIDs(t, targetGroupClient), want); diff != "" {
+					t.Errorf("unexpected target group members after run %d (-got, +want):\n%s", i, diff)
+				}
+				now = now.Add(24 * time.Hour)
+			}
+		})
+	}
+}
+
+type testSuspendedUserClient struct {
+	*MemoryGroupReadWriter
+	suspended map[string]bool
+}
+
+func (tc *testSuspendedUserClient) IsSuspended(ctx context.Context, userID string) (bool, error) {
+	return tc.suspended[userID], nil
+}
@@ -17,7 +17,6 @@ package groupsync
 import (
 	"context"
 	"errors"
-	"fmt"
 	"runtime"
 	"sync"
 
@@ -25,31 +24,54 @@ import (
 )
 
 // ConcurrentSync syncs the given source groups concurrently using the given syncer.
-// The level of concurrency is based of the value of runtime.NumCPU.
+// The level of concurrency is based of the value of runtime.NumCPU. If any
+// group fails to sync, the returned error is a *SyncError. Failures reported by
+// the syncer as a *SyncError are merged as is, any other error is recorded as
+// a failure of the whole source group.
 func ConcurrentSync(ctx context.Context, syncer v1alpha3.GroupSyncer, sourceGroupIDs []string) error {
+	return ConcurrentSyncN(ctx, syncer, sourceGroupIDs, runtime.NumCPU())
+}
+
+// ConcurrentSyncN is like ConcurrentSync, but syncs at most n source groups
+// concurrently, or as many as runtime.NumCPU if n is not positive.
+func ConcurrentSyncN(ctx context.Context, syncer v1alpha3.GroupSyncer, sourceGroupIDs []string, n int) error {
+	if n <= 0 {
+		n = runtime.NumCPU()
+	}
 	groupIDs := make(chan string, len(sourceGroupIDs))
-	errs := make(chan error, len(sourceGroupIDs))
+	syncErr := &SyncError{}
+	var mu sync.Mutex
 	for _, sourceGroupID := range sourceGroupIDs {
 		groupIDs <- sourceGroupID
 	}
 	close(groupIDs)
 	waitGroup := sync.WaitGroup{}
-	for i := 0; i < runtime.NumCPU(); i++ {
+	for i := 0; i < n; i++ {
 		waitGroup.Add(1)
 		go func() {
 			defer waitGroup.Done()
 			for id := range groupIDs {
-				if err := syncer.Sync(ctx, id); err != nil {
-					errs <- fmt.Errorf("failed to sync id %s: %w", id, err)
+				err := syncer.Sync(ctx, id)
+				if err == nil {
+					continue
 				}
+				mu.Lock()
+				var groupSyncErr *SyncError
+				if errors.As(err, &groupSyncErr) {
+					for _, ge := range groupSyncErr.Errors {
+						syncErr.add(ge)
+					}
+				} else {
+					syncErr.add(&GroupError{
+						SourceGroupID: id,
+						Category:      categorize(err, ErrorCategoryUnknown),
+						Err:           err,
+					})
+				}
+				mu.Unlock()
 			}
 		}()
 	}
 	waitGroup.Wait()
-	close(errs)
-	var merr error
-	for e := range errs {
-		merr = errors.Join(merr, e)
-	}
-	return merr
+	return syncErr.errOrNil()
 }
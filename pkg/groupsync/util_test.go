@@ -16,10 +16,13 @@ package groupsync
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -111,3 +114,89 @@ func (f *fakeSyncer) Sync(_ context.Context, sourceGroupID string) error {
 func (f *fakeSyncer) SyncAll(ctx context.Context) error {
 	panic("should not be called")
 }
+
+func TestConcurrentSync_SyncError(t *testing.T) {
+	t.Parallel()
+
+	syncer := &fakeSyncer{
+		idErrs: map[string]error{
+			"1": &SyncError{
+				Errors: []*GroupError{
+					{SourceGroupID: "1", TargetGroupID: "98", Category: ErrorCategoryAPI, Err: fmt.Errorf("boom")},
+					{SourceGroupID: "1", TargetGroupID: "97", Category: ErrorCategoryMapping, Err: fmt.Errorf("unmapped")},
+				},
+			},
+			"3": fmt.Errorf("wrapped: %w", ErrRateLimited),
+		},
+	}
+
+	err := ConcurrentSync(context.Background(), syncer, []string{"1", "2", "3"})
+
+	var syncErr *SyncError
+	if !errors.As(err, &syncErr) {
+		t.Fatalf("expected a *SyncError, got %T: %v", err, err)
+	}
+	type result struct {
+		Source, Target string
+		Category       ErrorCategory
+	}
+	got := make([]result, 0, len(syncErr.Errors))
+	for _, ge := range syncErr.Errors {
+		got = append(got, result{ge.SourceGroupID, ge.TargetGroupID, ge.Category})
+	}
+	want := []result{
+		{"1", "97", ErrorCategoryMapping},
+		{"1", "98", ErrorCategoryAPI},
+		{"3", "", ErrorCategoryRateLimit},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected group errors (-got, +want) = %v", diff)
+	}
+	if got, want := len(syncErr.ByCategory(ErrorCategoryRateLimit)), 1; got != want {
+		t.Errorf("got %d rate limit errors, want %d", got, want)
+	}
+	if !errors.Is(err, ErrRateLimited) {
+		t.Errorf("expected error to wrap ErrRateLimited")
+	}
+	if diff := testutil.DiffErrString(err, "failed to sync id 1 to target group 97: unmapped"); diff != "" {
+		t.Errorf("unexpected error message: %v", diff)
+	}
+}
+
+func TestConcurrentSyncN(t *testing.T) {
+	t.Parallel()
+
+	syncer := &concurrencySyncer{}
+	ids := []string{"1", "2", "3", "4", "5", "6", "7", "8"}
+	if err := ConcurrentSyncN(context.Background(), syncer, ids, 2); err != nil {
+		t.Fatalf("ConcurrentSyncN failed: %v", err)
+	}
+	if got := syncer.maxInFlight.Load(); got > 2 {
+		t.Errorf("synced %d groups concurrently, want at most 2", got)
+	}
+	if got := syncer.synced.Load(); got != int64(len(ids)) {
+		t.Errorf("synced %d groups, want %d", got, len(ids))
+	}
+}
+
+// concurrencySyncer records how many groups it syncs concurrently.
+type concurrencySyncer struct {
+	fakeSyncer
+	inFlight    atomic.Int64
+	maxInFlight atomic.Int64
+	synced      atomic.Int64
+}
+
+func (s *concurrencySyncer) Sync(_ context.Context, _ string) error {
+	n := s.inFlight.Add(1)
+	defer s.inFlight.Add(-1)
+	for {
+		m := s.maxInFlight.Load()
+		if n <= m || s.maxInFlight.CompareAndSwap(m, n) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+	s.synced.Add(1)
+	return nil
+}
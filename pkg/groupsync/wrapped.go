@@ -0,0 +1,48 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+)
+
+// WrappedWriter is embedded by the writers which wrap a GroupReadWriter. It
+// passes reads and writes through to the wrapped writer, along with archival
+// if it is a GroupArchiver and permission checks if it is a
+// PermissionChecker, so that a wrapper only implements the methods whose
+// behavior it changes.
+type WrappedWriter struct {
+	GroupReadWriter
+}
+
+// ArchiveGroup archives the group with the wrapped writer, if it is a
+// GroupArchiver.
+func (w WrappedWriter) ArchiveGroup(ctx context.Context, groupID string) error {
+	archiver, ok := w.GroupReadWriter.(GroupArchiver)
+	if !ok {
+		return fmt.Errorf("group writer cannot archive group %s", groupID)
+	}
+	return archiver.ArchiveGroup(ctx, groupID) //nolint:wrapcheck // Want passthrough
+}
+
+// CheckWritePermissions checks the permissions of the wrapped writer, if it
+// is a PermissionChecker.
+func (w WrappedWriter) CheckWritePermissions(ctx context.Context, groupIDs []string) error {
+	if checker, ok := w.GroupReadWriter.(PermissionChecker); ok {
+		return checker.CheckWritePermissions(ctx, groupIDs) //nolint:wrapcheck // Want passthrough
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+// testPermissionChecker is a writer whose permission check fails with err.
+type testPermissionChecker struct {
+	*MemoryGroupReadWriter
+	err error
+}
+
+func (c *testPermissionChecker) CheckWritePermissions(ctx context.Context, groupIDs []string) error {
+	return c.err
+}
+
+func TestWrappedWriter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name               string
+		rw                 GroupReadWriter
+		wantArchiveErr     string
+		wantPermissionsErr string
+		wantArchived       []string
+	}{
+		{
+			name:           "plain_writer",
+			rw:             &MemoryGroupReadWriter{},
+			wantArchiveErr: "group writer cannot archive group 99",
+		},
+		{
+			name:         "archiver",
+			rw:           &testArchiverClient{MemoryGroupReadWriter: &MemoryGroupReadWriter{Members: map[string][]Member{"99": nil}}},
+			wantArchived: []string{"99"},
+		},
+		{
+			name:               "permission_checker",
+			rw:                 &testPermissionChecker{MemoryGroupReadWriter: &MemoryGroupReadWriter{}, err: ErrTransient},
+			wantArchiveErr:     "group writer cannot archive group 99",
+			wantPermissionsErr: ErrTransient.Error(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			w := WrappedWriter{GroupReadWriter: tc.rw}
+
+			err := w.ArchiveGroup(ctx, "99")
+			if diff := testutil.DiffErrString(err, tc.wantArchiveErr); diff != "" {
+				t.Error(diff)
+			}
+			err = w.CheckWritePermissions(ctx, []string{"99"})
+			if diff := testutil.DiffErrString(err, tc.wantPermissionsErr); diff != "" {
+				t.Error(diff)
+			}
+			if archiver, ok := tc.rw.(*testArchiverClient); ok {
+				if diff := cmp.Diff(archiver.archived, tc.wantArchived); diff != "" {
+					t.Errorf("unexpected archived groups (-got, +want):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+// WriteCounter wraps a GroupReadWriter and counts the write calls made to
+// the target system. A call is counted per member added, removed or given
+// another role by SetMembers, and per archived group. Once the target groups
+// have converged, a run makes no writes; writes in consecutive runs without
+// source changes point at a normalization bug which makes the groups flap.
+// The writes of each target group are recorded in the report of the run, see
+// WithRunHistory. Reads are passed through.
+type WriteCounter struct {
+	WrappedWriter
+
+	normalize IDNormalizer
+
+	mu     sync.Mutex
+	writes map[string]int
+}
+
+// NewWriteCounter creates a WriteCounter wrapping rw. Member IDs are compared
+// by their form normalized by normalize, unless it is nil, like a
+// NormalizingWriter.
+func NewWriteCounter(rw GroupReadWriter, normalize IDNormalizer) *WriteCounter {
+	return &WriteCounter{
+		WrappedWriter: WrappedWriter{GroupReadWriter: rw},
+		normalize:     normalize,
+		writes:        make(map[string]int),
+	}
+}
+
+// SetMembers replaces the members of the group with the given members, with
+// the wrapped writer, and counts the write calls it needs. Failed writes are
+// counted too, since they may have partially applied.
+func (w *WriteCounter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	current, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	var diffOpts []DiffOpt
+	if w.normalize != nil {
+		diffOpts = append(diffOpts, DiffNormalizeIDs(w.normalize))
+	}
+	diff := ComputeDiff(current, members, diffOpts...)
+	w.count(ctx, groupID, len(diff.Add)+len(diff.Remove)+len(diff.Update))
+	return w.GroupReadWriter.SetMembers(ctx, groupID, members) //nolint:wrapcheck // Want passthrough
+}
+
+// ArchiveGroup archives the group with the wrapped writer, if it is a
+// GroupArchiver.
+func (w *WriteCounter) ArchiveGroup(ctx context.Context, groupID string) error {
+	archiver, ok := w.GroupReadWriter.(GroupArchiver)
+	if !ok {
+		return fmt.Errorf("group writer cannot archive group %s", groupID)
+	}
+	w.count(ctx, groupID, 1)
+	return archiver.ArchiveGroup(ctx, groupID) //nolint:wrapcheck // Want passthrough
+}
+
+// Writes returns the number of write calls counted so far.
+func (w *WriteCounter) Writes() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var n int
+	for _, writes := range w.writes {
+		n += writes
+	}
+	return n
+}
+
+// LogStats logs the number of write calls and of the groups written to.
+func (w *WriteCounter) LogStats(ctx context.Context) {
+	w.mu.Lock()
+	groups := len(w.writes)
+	w.mu.Unlock()
+	logging.FromContext(ctx).InfoContext(ctx, "target write stats",
+		"write_calls", w.Writes(),
+		"written_groups", groups,
+	)
+}
+
+func (w *WriteCounter) count(ctx context.Context, groupID string, n int) {
+	if n == 0 {
+		return
+	}
+	w.mu.Lock()
+	w.writes[groupID] += n
+	w.mu.Unlock()
+	runRecorderFromContext(ctx).recordWrites(groupID, n)
+}
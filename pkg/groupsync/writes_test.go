@@ -0,0 +1,116 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupsync
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/state"
+)
+
+// lowerCaseWriter stores member IDs in lower case, like target systems with
+// case-insensitive IDs.
+type lowerCaseWriter struct {
+	*MemoryGroupReadWriter
+}
+
+func (w *lowerCaseWriter) SetMembers(ctx context.Context, groupID string, members []Member) error {
+	lowered := make([]Member, 0, len(members))
+	for _, m := range members {
+		lowered = append(lowered, &UserMember{Usr: &User{ID: strings.ToLower(m.ID())}})
+	}
+	return w.MemoryGroupReadWriter.SetMembers(ctx, groupID, lowered)
+}
+
+func TestSyncAll_WriteCounter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		normalize IDNormalizer
+		// wantWrites are the writes of each run.
+		wantWrites []int
+	}{
+		{
+			name:       "normalized",
+			normalize:  strings.ToLower,
+			wantWrites: []int{1, 0, 0},
+		},
+		{
+			name:       "not_normalized_flaps",
+			wantWrites: []int{3, 2, 2},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			source := &MemoryGroupReadWriter{
+				Members: map[string][]Member{
+					"1": {
+						&UserMember{Usr: &User{ID: "alice@example.com"}},
+						&UserMember{Usr: &User{ID: "bob@example.com"}},
+					},
+				},
+			}
+			target := &lowerCaseWriter{&MemoryGroupReadWriter{
+				Members: map[string][]Member{"a": {&UserMember{Usr: &User{ID: "alice"}}}},
+			}}
+			history := NewRunHistory(state.NewMemoryStore(), 10, 0)
+			writes := NewWriteCounter(target, tc.normalize)
+			syncer := NewManyToManySyncer(
+				"source",
+				"target",
+				source,
+				writes,
+				&testGroupMapper{m: map[string][]string{"1": {"a"}}},
+				&testGroupMapper{m: map[string][]string{"a": {"1"}}},
+				&testUserMapper{m: map[string]string{"alice@example.com": "Alice", "bob@example.com": "bob"}},
+				WithRunHistory(history),
+			)
+
+			var total int
+			for i, want := range tc.wantWrites {
+				if err := syncer.SyncAll(ctx); err != nil {
+					t.Fatalf("SyncAll failed: %v", err)
+				}
+				reports, err := history.Reports(ctx)
+				if err != nil {
+					t.Fatalf("Reports failed: %v", err)
+				}
+				report := reports[0]
+				if got := report.Writes; got != want {
+					t.Errorf("run %d got %d writes, want %d", i, got, want)
+				}
+				if got := report.Groups[0].Writes; got != want {
+					t.Errorf("run %d got %d writes to group a, want %d", i, got, want)
+				}
+				total += want
+			}
+			if got := writes.Writes(); got != total {
+				t.Errorf("Writes got %d, want %d", got, total)
+			}
+			if diff := cmp.Diff(memberIDs(target.Members["a"]), []string{"alice", "bob"}); diff != "" {
+				t.Errorf("unexpected members (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package identity joins the identities of people across group systems, e.g.
+// a Google email, a GitHub login, a GitLab username and a Slack ID, from
+// signals such as SAML identities and verified emails, so that users can be
+// mapped without a user mapping for each of them.
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// SystemEmail is the pseudo system of emails. Links between the users of a
+// system and their emails join the users of different systems with the same
+// email.
+const SystemEmail = "email"
+
+// Ref refers to a user of a system.
+type Ref struct {
+	System string
+	ID     string
+}
+
+// Link says that two users of different systems are the same person.
+type Link struct {
+	From Ref
+	To   Ref
+}
+
+// Source provides links between users from a signal, e.g. the SAML identities
+// of the members of a GitHub org.
+type Source interface {
+	// Name names the signal in logs, e.g. "github_saml".
+	Name() string
+	// Links returns the links of the signal.
+	Links(ctx context.Context) ([]*Link, error)
+}
+
+// Config holds the optional settings of a Resolver.
+type Config struct {
+	normalizers map[string]groupsync.IDNormalizer
+	emailIDs    map[string]struct{}
+}
+
+// Opt configures a Resolver.
+type Opt func(config *Config)
+
+// WithIDNormalizer compares the IDs of the given system in the form normalized
+// by normalize, e.g. case-insensitively. IDs are compared exactly by default,
+// except for emails, which are compared case-insensitively.
+func WithIDNormalizer(system string, normalize groupsync.IDNormalizer) Opt {
+	return func(config *Config) {
+		config.normalizers[system] = normalize
+	}
+}
+
+// WithEmailIDs says that the user IDs of the given system are emails, e.g. for
+// Google Groups, so that its users are the same as the users of other systems
+// with the same email.
+func WithEmailIDs(system string) Opt {
+	return func(config *Config) {
+		config.emailIDs[system] = struct{}{}
+	}
+}
+
+// Resolver joins the users of the links of its sources into identities. A
+// link which would give an identity two users of the same system is ambiguous
+// and ignored, so that a signal cannot map one person to several users. The
+// links are read from the sources once, on the first lookup.
+type Resolver struct {
+	sources []Source
+	config  *Config
+
+	once    sync.Once
+	loadErr error
+	// parent is the union-find forest of refs, keyed by normalized ref.
+	parent map[Ref]Ref
+	// users are the users of each identity by system, keyed by root ref.
+	users map[Ref]map[string]string
+}
+
+// NewResolver creates a Resolver joining the users of the links of the given
+// sources.
+func NewResolver(sources []Source, opts ...Opt) *Resolver {
+	config := &Config{
+		normalizers: map[string]groupsync.IDNormalizer{SystemEmail: groupsync.CaseInsensitiveIDs},
+		emailIDs:    make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &Resolver{
+		sources: sources,
+		config:  config,
+		parent:  make(map[Ref]Ref),
+		users:   make(map[Ref]map[string]string),
+	}
+}
+
+// Resolve returns the ID of the user of the target system who is the same
+// person as the given user, or false if there is none. IDs are returned in
+// normalized form.
+func (r *Resolver) Resolve(ctx context.Context, user Ref, target string) (string, bool, error) {
+	r.once.Do(func() {
+		r.loadErr = r.load(ctx)
+	})
+	if r.loadErr != nil {
+		return "", false, r.loadErr
+	}
+	ref := r.normalize(user)
+	if _, ok := r.config.emailIDs[target]; ok {
+		target = SystemEmail
+	}
+	if ref.System == target {
+		// e.g. the same email in two systems with email IDs.
+		return ref.ID, true, nil
+	}
+	if _, ok := r.parent[ref]; !ok {
+		return "", false, nil
+	}
+	id, ok := r.users[r.find(ref)][target]
+	return id, ok, nil
+}
+
+// load joins the links of the sources.
+func (r *Resolver) load(ctx context.Context) error {
+	logger := logging.FromContext(ctx)
+	var merr error
+	for _, src := range r.sources {
+		links, err := src.Links(ctx)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to get identity links of %s: %w", src.Name(), err))
+			continue
+		}
+		var ambiguous int
+		for _, l := range links {
+			if !r.join(l.From, l.To) {
+				ambiguous++
+				logger.WarnContext(ctx, "ignoring ambiguous identity link",
+					"signal", src.Name(),
+					"from", l.From,
+					"to", l.To,
+				)
+			}
+		}
+		logger.InfoContext(ctx, "loaded identity links",
+			"signal", src.Name(),
+			"links", len(links),
+			"ambiguous_links", ambiguous,
+		)
+	}
+	return merr
+}
+
+// join joins the identities of the given users, unless that would give the
+// identity two users of the same system. It reports whether the identities
+// are joined.
+func (r *Resolver) join(a, b Ref) bool {
+	a, b = r.add(r.normalize(a)), r.add(r.normalize(b))
+	if a == b {
+		return true
+	}
+	ua, ub := r.users[a], r.users[b]
+	for system, id := range ub {
+		if other, ok := ua[system]; ok && other != id {
+			return false
+		}
+	}
+	// the smaller identity joins the larger one.
+	if len(ua) < len(ub) {
+		a, b, ua, ub = b, a, ub, ua
+	}
+	for system, id := range ub {
+		ua[system] = id
+	}
+	r.parent[b] = a
+	delete(r.users, b)
+	return true
+}
+
+// add adds the given normalized ref as an identity of its own, unless it is
+// known, and returns the root of its identity.
+func (r *Resolver) add(ref Ref) Ref {
+	if _, ok := r.parent[ref]; !ok {
+		r.parent[ref] = ref
+		r.users[ref] = map[string]string{ref.System: ref.ID}
+	}
+	return r.find(ref)
+}
+
+// find returns the root of the identity of the given known ref.
+func (r *Resolver) find(ref Ref) Ref {
+	for r.parent[ref] != ref {
+		r.parent[ref] = r.parent[r.parent[ref]]
+		ref = r.parent[ref]
+	}
+	return ref
+}
+
+// normalize returns the ref with its ID in normalized form. The users of
+// systems with email IDs are refs of emails.
+func (r *Resolver) normalize(ref Ref) Ref {
+	if _, ok := r.config.emailIDs[ref.System]; ok {
+		ref.System = SystemEmail
+	}
+	if n := r.config.normalizers[ref.System]; n != nil {
+		ref.ID = n(ref.ID)
+	}
+	return ref
+}
+
+// Mapper is a groupsync.MultiUserMapper from the users of a source system to
+// the users of a target system. Users are mapped by the overrides, typically
+// the user mappings, and users the overrides do not map are mapped by a
+// Resolver.
+type Mapper struct {
+	overrides groupsync.UserMapper
+	resolver  *Resolver
+	source    string
+	target    string
+}
+
+// Ensure we conform to the interface.
+var _ groupsync.MultiUserMapper = (*Mapper)(nil)
+
+// NewMapper creates a Mapper from the users of the source system to the users
+// of the target system.
+func NewMapper(overrides groupsync.UserMapper, resolver *Resolver, source, target string) *Mapper {
+	return &Mapper{
+		overrides: overrides,
+		resolver:  resolver,
+		source:    source,
+		target:    target,
+	}
+}
+
+// MappedUserID returns the ID of the first user mapped to the given user ID.
+func (m *Mapper) MappedUserID(ctx context.Context, userID string) (string, error) {
+	users, err := m.MappedUsers(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return users[0].ID, nil
+}
+
+// MappedUsers returns the users the overrides map the given user ID to, or
+// else the user the resolver resolves it to. It returns
+// groupsync.ErrTargetUserIDNotFound if there are none.
+func (m *Mapper) MappedUsers(ctx context.Context, userID string) ([]*groupsync.MappedUser, error) {
+	users, err := groupsync.MappedUsers(ctx, m.overrides, userID)
+	if err == nil {
+		return users, nil
+	}
+	if !errors.Is(err, groupsync.ErrTargetUserIDNotFound) {
+		return nil, fmt.Errorf("failed to map user %s by overrides: %w", userID, err)
+	}
+	id, ok, err := m.resolver.Resolve(ctx, Ref{System: m.source, ID: userID}, m.target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve identity of user %s: %w", userID, err)
+	}
+	if !ok {
+		return nil, groupsync.ErrTargetUserIDNotFound
+	}
+	return []*groupsync.MappedUser{{ID: id}}, nil
+}
+
+// StaticSource is a Source of fixed links, e.g. for tests or links kept in a
+// file.
+type StaticSource struct {
+	name  string
+	links []*Link
+}
+
+// NewStaticSource creates a StaticSource with the given name and links.
+func NewStaticSource(name string, links ...*Link) *StaticSource {
+	return &StaticSource{name: name, links: slices.Clone(links)}
+}
+
+func (s *StaticSource) Name() string {
+	return s.name
+}
+
+func (s *StaticSource) Links(ctx context.Context) ([]*Link, error) {
+	return s.links, nil
+}
+
+// MapSource is a Source linking the users of one system to the users of
+// another system by a map, e.g. the SAML identities of GitHub users, which
+// link emails to GitHub logins.
+type MapSource struct {
+	name string
+	from string
+	to   string
+	list func(ctx context.Context) (map[string]string, error)
+}
+
+// NewMapSource creates a MapSource with the given name linking the users of
+// the from system to the users of the to system, as listed by list, which
+// returns the IDs of the users of the to system keyed by the IDs of the users
+// of the from system.
+func NewMapSource(name, from, to string, list func(ctx context.Context) (map[string]string, error)) *MapSource {
+	return &MapSource{name: name, from: from, to: to, list: list}
+}
+
+// NewEmailSource creates a MapSource with the given name linking emails to
+// the users of the given system, as listed by list, which returns the user
+// IDs keyed by email.
+func NewEmailSource(name, system string, list func(ctx context.Context) (map[string]string, error)) *MapSource {
+	return NewMapSource(name, SystemEmail, system, list)
+}
+
+func (s *MapSource) Name() string {
+	return s.name
+}
+
+// Links returns the links of the listed users, sorted by the IDs of the users
+// of the from system.
+func (s *MapSource) Links(ctx context.Context) ([]*Link, error) {
+	ids, err := s.list(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	links := make([]*Link, 0, len(ids))
+	for from, to := range ids {
+		links = append(links, &Link{
+			From: Ref{System: s.from, ID: from},
+			To:   Ref{System: s.to, ID: to},
+		})
+	}
+	slices.SortFunc(links, func(a, b *Link) int {
+		return strings.Compare(a.From.ID, b.From.ID)
+	})
+	return links, nil
+}
@@ -0,0 +1,188 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestResolver(t *testing.T) {
+	t.Parallel()
+
+	email := func(id string) Ref { return Ref{System: SystemEmail, ID: id} }
+	gh := func(id string) Ref { return Ref{System: "github", ID: id} }
+	gl := func(id string) Ref { return Ref{System: "gitlab", ID: id} }
+
+	cases := []struct {
+		name    string
+		sources []Source
+		opts    []Opt
+		user    Ref
+		target  string
+		want    string
+		wantOK  bool
+		wantErr string
+	}{
+		{
+			name:    "email_to_login",
+			sources: []Source{NewStaticSource("saml", &Link{From: email("alice@example.com"), To: gh("alice")})},
+			user:    email("Alice@Example.com"),
+			target:  "github",
+			want:    "alice",
+			wantOK:  true,
+		},
+		{
+			name: "joined_by_email",
+			sources: []Source{
+				NewStaticSource("saml", &Link{From: email("alice@example.com"), To: gh("alice")}),
+				NewStaticSource("emails", &Link{From: email("alice@example.com"), To: gl("alice.gl")}),
+			},
+			user:   gl("alice.gl"),
+			target: "github",
+			want:   "alice",
+			wantOK: true,
+		},
+		{
+			name:    "email_ids",
+			sources: []Source{NewStaticSource("saml", &Link{From: email("alice@example.com"), To: gh("alice")})},
+			opts:    []Opt{WithEmailIDs("googlegroups")},
+			user:    gh("alice"),
+			target:  "googlegroups",
+			want:    "alice@example.com",
+			wantOK:  true,
+		},
+		{
+			name:   "same_email",
+			opts:   []Opt{WithEmailIDs("googlegroups"), WithEmailIDs("looker")},
+			user:   Ref{System: "googlegroups", ID: "alice@example.com"},
+			target: "looker",
+			want:   "alice@example.com",
+			wantOK: true,
+		},
+		{
+			name: "normalized_ids",
+			sources: []Source{
+				NewStaticSource("saml", &Link{From: email("alice@example.com"), To: gh("Alice")}),
+			},
+			opts:   []Opt{WithIDNormalizer("github", groupsync.CaseInsensitiveIDs)},
+			user:   gh("ALICE"),
+			target: SystemEmail,
+			want:   "alice@example.com",
+			wantOK: true,
+		},
+		{
+			name: "ambiguous_link_ignored",
+			sources: []Source{
+				NewStaticSource("saml",
+					&Link{From: email("alice@example.com"), To: gh("alice")},
+					&Link{From: email("alice@example.com"), To: gh("mallory")},
+				),
+			},
+			user:   gh("mallory"),
+			target: SystemEmail,
+		},
+		{
+			name: "first_link_kept",
+			sources: []Source{
+				NewStaticSource("saml",
+					&Link{From: email("alice@example.com"), To: gh("alice")},
+					&Link{From: email("alice@example.com"), To: gh("mallory")},
+				),
+			},
+			user:   email("alice@example.com"),
+			target: "github",
+			want:   "alice",
+			wantOK: true,
+		},
+		{
+			name:    "unknown_user",
+			sources: []Source{NewStaticSource("saml", &Link{From: email("alice@example.com"), To: gh("alice")})},
+			user:    email("bob@example.com"),
+			target:  "github",
+		},
+		{
+			name: "source_error",
+			sources: []Source{NewEmailSource("saml", "github", func(ctx context.Context) (map[string]string, error) {
+				return nil, errors.New("boom")
+			})},
+			user:    email("alice@example.com"),
+			target:  "github",
+			wantErr: "failed to get identity links of saml: failed to list users: boom",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewResolver(tc.sources, tc.opts...)
+			got, ok, err := r.Resolve(context.Background(), tc.user, tc.target)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if got != tc.want || ok != tc.wantOK {
+				t.Errorf("Resolve got (%q, %t), want (%q, %t)", got, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestMapper(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	overrides := mapMapper{"alice@example.com": "alice-override"}
+	resolver := NewResolver([]Source{NewEmailSource("saml", "github", func(ctx context.Context) (map[string]string, error) {
+		return map[string]string{"alice@example.com": "alice", "bob@example.com": "bob"}, nil
+	})}, WithEmailIDs("googlegroups"))
+	m := NewMapper(overrides, resolver, "googlegroups", "github")
+
+	cases := []struct {
+		userID  string
+		want    []*groupsync.MappedUser
+		wantErr error
+	}{
+		{userID: "alice@example.com", want: []*groupsync.MappedUser{{ID: "alice-override"}}},
+		{userID: "bob@example.com", want: []*groupsync.MappedUser{{ID: "bob"}}},
+		{userID: "carol@example.com", wantErr: groupsync.ErrTargetUserIDNotFound},
+	}
+	for _, tc := range cases {
+		got, err := m.MappedUsers(ctx, tc.userID)
+		if !errors.Is(err, tc.wantErr) {
+			t.Errorf("MappedUsers(%s) got err %v, want %v", tc.userID, err, tc.wantErr)
+		}
+		if diff := cmp.Diff(got, tc.want); diff != "" {
+			t.Errorf("MappedUsers(%s) unexpected users (-got, +want):\n%s", tc.userID, diff)
+		}
+	}
+}
+
+// mapMapper maps the user IDs of its keys to their values.
+type mapMapper map[string]string
+
+func (m mapMapper) MappedUserID(ctx context.Context, userID string) (string, error) {
+	id, ok := m[userID]
+	if !ok {
+		return "", groupsync.ErrTargetUserIDNotFound
+	}
+	return id, nil
+}
@@ -0,0 +1,311 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jumpcloud provides a GroupReadWriter for JumpCloud user groups, for
+// organizations whose device and LDAP directory is JumpCloud.
+package jumpcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultEndpoint is the URL of the JumpCloud API.
+	DefaultEndpoint = "https://console.jumpcloud.com"
+
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of group members requested per page, the
+	// maximum of the API.
+	pageSize = 100
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Group is a JumpCloud user group.
+type Group struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// User is a JumpCloud system user.
+type User struct {
+	ID        string `json:"_id"`
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+	Suspended bool   `json:"suspended,omitempty"`
+}
+
+// association is an entry of the members of a user group.
+type association struct {
+	To struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"to"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+	orgID         string
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call JumpCloud.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// WithOrgID sets the ID of the organization, required for API keys of
+// multi-tenant portal administrators.
+func WithOrgID(orgID string) Opt {
+	return func(config *Config) {
+		config.orgID = orgID
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of JumpCloud user groups. Group IDs are user group
+// IDs and user IDs are emails.
+type GroupReadWriter struct {
+	client       *rest.Client
+	usersByID    *cache.Cache[*User]
+	usersByEmail *cache.Cache[*User]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the JumpCloud API at the
+// given URL, usually DefaultEndpoint, authenticating with the API key of an
+// administrator.
+func NewGroupReadWriter(endpoint, apiKey string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	restOpts := []rest.Opt{
+		rest.WithHTTPClient(config.httpClient),
+		rest.WithHeader("x-api-key", apiKey),
+	}
+	if config.orgID != "" {
+		restOpts = append(restOpts, rest.WithHeader("x-org-id", config.orgID))
+	}
+	return &GroupReadWriter{
+		client:       rest.New(endpoint+"/api", restOpts...),
+		usersByID:    cache.New[*User](config.cacheDuration),
+		usersByEmail: cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the JumpCloud user group with the given ID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	var group Group
+	if err := rw.client.Do(ctx, http.MethodGet, "/v2/usergroups/"+url.PathEscape(groupID), nil, &group); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: group.ID, Attributes: &group}, nil
+}
+
+// GetMembers retrieves the users of the JumpCloud user group with the given
+// ID.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	var members []groupsync.Member
+	for skip := 0; ; skip += pageSize {
+		q := url.Values{
+			"limit": {strconv.Itoa(pageSize)},
+			"skip":  {strconv.Itoa(skip)},
+		}
+		var associations []*association
+		if err := rw.client.Do(ctx, http.MethodGet, "/v2/usergroups/"+url.PathEscape(groupID)+"/members?"+q.Encode(), nil, &associations); err != nil {
+			return nil, fmt.Errorf("failed to get members of group %s: %w", groupID, notFound(err))
+		}
+		for _, a := range associations {
+			if a.To.Type != "user" {
+				continue
+			}
+			user, err := rw.userByID(ctx, a.To.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get member %s of group %s: %w", a.To.ID, groupID, err)
+			}
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: user.Email, Attributes: user}})
+		}
+		if len(associations) < pageSize {
+			return members, nil
+		}
+	}
+}
+
+// Descendants retrieve all users of the JumpCloud user group with the given
+// ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the JumpCloud user with the given email.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.userByEmail(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Email, Attributes: user}, nil
+}
+
+// SetMembers replaces the users of the JumpCloud user group with the given ID
+// with the given members. Users which are not in JumpCloud are reported in
+// the returned error after all other changes are made.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	currentMembers, err := rw.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	path := "/v2/usergroups/" + url.PathEscape(groupID) + "/members"
+	var merr error
+	for _, email := range utils.MapKeys(addMembers) {
+		user, err := rw.userByEmail(ctx, email)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to group %s: %w", email, groupID, err))
+			continue
+		}
+		body := map[string]string{"op": "add", "type": "user", "id": user.ID}
+		if err := rw.client.Do(ctx, http.MethodPost, path, body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add %s to group %s: %w", email, groupID, err))
+		}
+	}
+	for _, email := range utils.MapKeys(removeMembers) {
+		user, ok := jumpCloudUser(removeMembers[email])
+		if !ok {
+			continue
+		}
+		body := map[string]string{"op": "remove", "type": "user", "id": user.ID}
+		if err := rw.client.Do(ctx, http.MethodPost, path, body, nil); err != nil && !rest.IsNotFound(err) {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove %s from group %s: %w", email, groupID, err))
+		}
+	}
+	return merr
+}
+
+// userByID returns the JumpCloud user with the given ID.
+func (rw *GroupReadWriter) userByID(ctx context.Context, id string) (*User, error) {
+	user, err := rw.usersByID.WriteThruLookup(id, func() (*User, error) {
+		var user User
+		if err := rw.client.Do(ctx, http.MethodGet, "/systemusers/"+url.PathEscape(id), nil, &user); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", id, err)
+		}
+		return &user, nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// userByEmail returns the JumpCloud user with the given email.
+func (rw *GroupReadWriter) userByEmail(ctx context.Context, email string) (*User, error) {
+	user, err := rw.usersByEmail.WriteThruLookup(strings.ToLower(email), func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", email)
+		var resp struct {
+			Results []*User `json:"results"`
+		}
+		if err := rw.client.Do(ctx, http.MethodGet, "/systemusers?filter="+url.QueryEscape("email:$eq:"+email), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", email, err)
+		}
+		if len(resp.Results) == 0 {
+			return nil, fmt.Errorf("no jumpcloud user with email %s", email)
+		}
+		return resp.Results[0], nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// jumpCloudUser returns the JumpCloud user of a current member.
+func jumpCloudUser(m groupsync.Member) (*User, bool) {
+	u, ok := m.(*groupsync.UserMember)
+	if !ok {
+		return nil, false
+	}
+	user, ok := u.Usr.Attributes.(*User)
+	return user, ok
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+// toIDMap returns the given members by lowercased ID, as emails are
+// case-insensitive.
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[strings.ToLower(m.ID())] = m
+	}
+	return memberIDs
+}
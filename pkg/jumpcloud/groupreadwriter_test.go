@@ -0,0 +1,189 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jumpcloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeJumpCloud{
+		users: []*User{
+			{ID: "u1", Email: "alice@example.com"},
+			{ID: "u2", Email: "bob@example.com"},
+			{ID: "u3", Email: "carol@example.com"},
+		},
+		members: map[string][]string{"g1": {"u1", "u2"}},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(srv.URL, "key", WithOrgID("org"))
+
+	users, err := rw.Descendants(ctx, "g1")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice@example.com", "bob@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "g1", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "Bob@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "dave@example.com"}},
+	})
+	if diff := testutil.DiffErrString(err, "no jumpcloud user with email dave@example.com"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+	if diff := cmp.Diff(fake.members["g1"], []string{"u2", "u3"}); diff != "" {
+		t.Errorf("unexpected members (-got, +want):\n%s", diff)
+	}
+
+	user, err := rw.GetUser(ctx, "carol@example.com")
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if diff := cmp.Diff(user.Attributes, fake.users[2]); diff != "" {
+		t.Errorf("unexpected user (-got, +want):\n%s", diff)
+	}
+
+	if _, err := rw.GetGroup(ctx, "missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func TestGroupReadWriter_GetMembersPages(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeJumpCloud{members: map[string][]string{"g1": nil}}
+	for i := range pageSize + 1 {
+		id := "u" + strconv.Itoa(i)
+		fake.users = append(fake.users, &User{ID: id, Email: id + "@example.com"})
+		fake.members["g1"] = append(fake.members["g1"], id)
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(srv.URL, "key", WithOrgID("org"))
+
+	members, err := rw.GetMembers(context.Background(), "g1")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if got, want := len(members), pageSize+1; got != want {
+		t.Errorf("GetMembers got %d members, want %d", got, want)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeJumpCloud implements the parts of the JumpCloud API used by
+// GroupReadWriter, with groups mapped to the IDs of their users.
+type fakeJumpCloud struct {
+	mu      sync.Mutex
+	users   []*User
+	members map[string][]string
+}
+
+func (f *fakeJumpCloud) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("x-api-key") != "key" || r.Header.Get("x-org-id") != "org" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/api")
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	switch {
+	case path == "/systemusers":
+		email, _ := strings.CutPrefix(r.URL.Query().Get("filter"), "email:$eq:")
+		var found []*User
+		for _, u := range f.users {
+			if strings.EqualFold(u.Email, email) {
+				found = append(found, u)
+			}
+		}
+		write(map[string]any{"results": found, "totalCount": len(found)})
+	case len(parts) == 2 && parts[0] == "systemusers":
+		i := slices.IndexFunc(f.users, func(u *User) bool { return u.ID == parts[1] })
+		if i < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		write(f.users[i])
+	case len(parts) >= 3 && parts[0] == "v2" && parts[1] == "usergroups":
+		group := parts[2]
+		if _, ok := f.members[group]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if len(parts) == 3 {
+			write(&Group{ID: group, Name: group})
+			return
+		}
+		if r.Method == http.MethodPost {
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["type"] != "user" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f.members[group] = slices.DeleteFunc(f.members[group], func(id string) bool { return id == body["id"] })
+			if body["op"] == "add" {
+				f.members[group] = append(f.members[group], body["id"])
+				slices.Sort(f.members[group])
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		ids := f.members[group][min(skip, len(f.members[group])):min(skip+limit, len(f.members[group]))]
+		associations := []map[string]any{}
+		for _, id := range ids {
+			associations = append(associations, map[string]any{"to": map[string]string{"id": id, "type": "user"}})
+		}
+		write(associations)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubernetes provides a GroupReadWriter which renders group
+// memberships into Kubernetes RBAC bindings, so cluster access can follow
+// team membership.
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+const (
+	// rbacAPIGroup is the API group of RBAC objects and of their User and
+	// Group subjects.
+	rbacAPIGroup = "rbac.authorization.k8s.io"
+
+	// ManagedByLabel marks the bindings rendered by team-link.
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*RoleBindingWriter)(nil)
+
+// RoleRef is the role a binding grants, see
+// https://kubernetes.io/docs/reference/access-authn-authz/rbac/#rolebinding-and-clusterrolebinding.
+type RoleRef struct {
+	APIGroup string `json:"apiGroup"`
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+}
+
+// Subject is a user or group a binding grants its role to.
+type Subject struct {
+	APIGroup string `json:"apiGroup"`
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+}
+
+// ObjectMeta is the metadata of a binding.
+type ObjectMeta struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+// RoleBinding is a RoleBinding or ClusterRoleBinding manifest.
+type RoleBinding struct {
+	APIVersion string     `json:"apiVersion"`
+	Kind       string     `json:"kind"`
+	Metadata   ObjectMeta `json:"metadata"`
+	RoleRef    RoleRef    `json:"roleRef"`
+	Subjects   []Subject  `json:"subjects"`
+}
+
+// Encode returns the group ID of the binding with the given name in the given
+// namespace, or of the ClusterRoleBinding with the given name if namespace is
+// empty.
+func Encode(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+// Decode returns the namespace and name of the binding with the given group
+// ID. The namespace is empty for ClusterRoleBindings.
+func Decode(groupID string) (string, string) {
+	if namespace, name, ok := strings.Cut(groupID, "/"); ok {
+		return namespace, name
+	}
+	return "", groupID
+}
+
+// NewRoleRef returns the RoleRef of the role with the given kind, Role or
+// ClusterRole, and name. The kind defaults to ClusterRole.
+func NewRoleRef(kind, name string) (*RoleRef, error) {
+	switch kind {
+	case "":
+		kind = "ClusterRole"
+	case "Role", "ClusterRole":
+	default:
+		return nil, fmt.Errorf("role kind must be Role or ClusterRole, got %q", kind)
+	}
+	if name == "" {
+		return nil, fmt.Errorf("role name is required")
+	}
+	return &RoleRef{APIGroup: rbacAPIGroup, Kind: kind, Name: name}, nil
+}
+
+// RoleBindingWriter adheres to the groupsync.GroupReadWriter interface and
+// renders the members of each group into a RoleBinding, or ClusterRoleBinding,
+// manifest in a directory. The manifests are meant to be applied with
+// kubectl apply or a GitOps tool. Group IDs are of the form NAMESPACE/NAME for
+// RoleBindings and NAME for ClusterRoleBindings. User members are rendered as
+// User subjects and group members as Group subjects, using their IDs as
+// names, which must match the names asserted by the cluster's authenticator.
+type RoleBindingWriter struct {
+	dir      string
+	roleRefs map[string]*RoleRef
+	mu       sync.Mutex
+}
+
+// NewRoleBindingWriter creates a RoleBindingWriter writing manifests to the
+// given directory. roleRefs holds the role bound by each binding, by group
+// ID.
+func NewRoleBindingWriter(dir string, roleRefs map[string]*RoleRef) (*RoleBindingWriter, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("manifest directory must not be empty")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create manifest directory %s: %w", dir, err)
+	}
+	return &RoleBindingWriter{dir: dir, roleRefs: roleRefs}, nil
+}
+
+// GetGroup returns the binding with the given group ID. Bindings with a
+// configured role exist even if they have not been rendered yet.
+func (w *RoleBindingWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	binding, err := w.read(groupID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupsync.Group{ID: groupID, Attributes: binding}, nil
+}
+
+// GetMembers returns the subjects of the rendered binding with the given
+// group ID.
+func (w *RoleBindingWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	binding, err := w.read(groupID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]groupsync.Member, 0, len(binding.Subjects))
+	for _, s := range binding.Subjects {
+		switch s.Kind {
+		case "User":
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: s.Name}})
+		case "Group":
+			members = append(members, &groupsync.GroupMember{Grp: &groupsync.Group{ID: s.Name}})
+		}
+	}
+	return members, nil
+}
+
+// Descendants returns the User subjects of the rendered binding with the given
+// group ID. Group subjects are resolved by the cluster, not by team-link.
+func (w *RoleBindingWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	members, err := w.GetMembers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	var users []*groupsync.User
+	for _, m := range members {
+		if m.IsUser() {
+			u, _ := m.User()
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+// GetUser returns a user with the given name. Kubernetes has no user
+// objects, so any name is valid.
+func (w *RoleBindingWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	return &groupsync.User{ID: userID}, nil
+}
+
+// SetMembers renders the binding with the given group ID with the given
+// members as its subjects, sorted so that unchanged memberships render
+// identical manifests.
+func (w *RoleBindingWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	roleRef, ok := w.roleRefs[groupID]
+	if !ok {
+		return fmt.Errorf("no role configured for binding %s: %w", groupID, groupsync.ErrGroupNotFound)
+	}
+	namespace, name := Decode(groupID)
+	kind := "ClusterRoleBinding"
+	if namespace != "" {
+		kind = "RoleBinding"
+	} else if roleRef.Kind != "ClusterRole" {
+		return fmt.Errorf("ClusterRoleBinding %s must bind a ClusterRole, got %s", groupID, roleRef.Kind)
+	}
+
+	subjects := make([]Subject, 0, len(members))
+	for _, m := range members {
+		subjectKind := "User"
+		if m.IsGroup() {
+			subjectKind = "Group"
+		}
+		subjects = append(subjects, Subject{APIGroup: rbacAPIGroup, Kind: subjectKind, Name: m.ID()})
+	}
+	slices.SortFunc(subjects, func(a, b Subject) int {
+		return strings.Compare(a.Kind+"/"+a.Name, b.Kind+"/"+b.Name)
+	})
+	subjects = slices.Compact(subjects)
+
+	binding := &RoleBinding{
+		APIVersion: rbacAPIGroup + "/v1",
+		Kind:       kind,
+		Metadata: ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{ManagedByLabel: "team-link"},
+		},
+		RoleRef:  *roleRef,
+		Subjects: subjects,
+	}
+	b, err := json.MarshalIndent(binding, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal binding %s: %w", groupID, err)
+	}
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "rendering binding",
+		"group_id", groupID,
+		"path", w.path(groupID),
+		"subjects", len(subjects),
+	)
+	return w.write(groupID, append(b, '\n'))
+}
+
+// read returns the rendered binding with the given group ID, or an empty
+// binding if it has not been rendered yet.
+func (w *RoleBindingWriter) read(groupID string) (*RoleBinding, error) {
+	if _, ok := w.roleRefs[groupID]; !ok {
+		return nil, fmt.Errorf("no role configured for binding %s: %w", groupID, groupsync.ErrGroupNotFound)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	b, err := os.ReadFile(w.path(groupID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return &RoleBinding{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read binding %s: %w", groupID, err)
+	}
+	var binding RoleBinding
+	if err := json.Unmarshal(b, &binding); err != nil {
+		return nil, fmt.Errorf("failed to parse binding %s: %w", groupID, err)
+	}
+	return &binding, nil
+}
+
+// write replaces the manifest of the binding with the given group ID. It is
+// written to a temporary file first so that readers never see partial
+// writes.
+func (w *RoleBindingWriter) write(groupID string, b []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	// unchanged manifests are not rewritten, so that their files keep their
+	// modification time.
+	if current, err := os.ReadFile(w.path(groupID)); err == nil && bytes.Equal(current, b) {
+		return nil
+	}
+	tmp, err := os.CreateTemp(w.dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write binding %s: %w", groupID, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write binding %s: %w", groupID, err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("failed to write binding %s: %w", groupID, err)
+	}
+	if err := os.Rename(tmp.Name(), w.path(groupID)); err != nil {
+		return fmt.Errorf("failed to write binding %s: %w", groupID, err)
+	}
+	return nil
+}
+
+// path returns the manifest file of the binding with the given group ID,
+// e.g. rolebinding.NAMESPACE.NAME.json. Namespaces cannot contain dots, so
+// file names are unique.
+func (w *RoleBindingWriter) path(groupID string) string {
+	namespace, name := Decode(groupID)
+	if namespace == "" {
+		return filepath.Join(w.dir, "clusterrolebinding."+name+".json")
+	}
+	return filepath.Join(w.dir, "rolebinding."+namespace+"."+name+".json")
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestRoleBindingWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	view, err := NewRoleRef("", "view")
+	if err != nil {
+		t.Fatalf("NewRoleRef failed: %v", err)
+	}
+	w, err := NewRoleBindingWriter(dir, map[string]*RoleRef{
+		"payments/payments-devs": {APIGroup: rbacAPIGroup, Kind: "Role", Name: "deployer"},
+		"cluster-viewers":        view,
+	})
+	if err != nil {
+		t.Fatalf("NewRoleBindingWriter failed: %v", err)
+	}
+
+	members, err := w.GetMembers(ctx, "payments/payments-devs")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if len(members) != 0 {
+		t.Errorf("GetMembers of unrendered binding got %d members, want 0", len(members))
+	}
+
+	if err := w.SetMembers(ctx, "payments/payments-devs", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob@example.com"}},
+		&groupsync.GroupMember{Grp: &groupsync.Group{ID: "oncall"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "alice@example.com"}},
+	}); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	got, err := os.ReadFile(filepath.Join(dir, "rolebinding.payments.payments-devs.json"))
+	if err != nil {
+		t.Fatalf("failed to read manifest: %v", err)
+	}
+	want := `{
+  "apiVersion": "rbac.authorization.k8s.io/v1",
+  "kind": "RoleBinding",
+  "metadata": {
+    "name": "payments-devs",
+    "namespace": "payments",
+    "labels": {
+      "app.kubernetes.io/managed-by": "team-link"
+    }
+  },
+  "roleRef": {
+    "apiGroup": "rbac.authorization.k8s.io",
+    "kind": "Role",
+    "name": "deployer"
+  },
+  "subjects": [
+    {
+      "apiGroup": "rbac.authorization.k8s.io",
+      "kind": "Group",
+      "name": "oncall"
+    },
+    {
+      "apiGroup": "rbac.authorization.k8s.io",
+      "kind": "User",
+      "name": "alice@example.com"
+    },
+    {
+      "apiGroup": "rbac.authorization.k8s.io",
+      "kind": "User",
+      "name": "bob@example.com"
+    }
+  ]
+}
+`
+	if diff := cmp.Diff(string(got), want); diff != "" {
+		t.Errorf("unexpected manifest (-got, +want):\n%s", diff)
+	}
+
+	// the same members in another order leave the manifest file untouched.
+	path := filepath.Join(dir, "rolebinding.payments.payments-devs.json")
+	before, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("failed to stat manifest: %v", err)
+	}
+	if err := w.SetMembers(ctx, "payments/payments-devs", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "alice@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob@example.com"}},
+		&groupsync.GroupMember{Grp: &groupsync.Group{ID: "oncall"}},
+	}); err != nil {
+		t.Fatalf("SetMembers of unchanged members failed: %v", err)
+	}
+	after, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("failed to stat manifest: %v", err)
+	}
+	if !os.SameFile(before, after) {
+		t.Errorf("SetMembers of unchanged members rewrote the manifest")
+	}
+
+	users, err := w.Descendants(ctx, "payments/payments-devs")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(users, []*groupsync.User{{ID: "alice@example.com"}, {ID: "bob@example.com"}}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	if err := w.SetMembers(ctx, "cluster-viewers", nil); err != nil {
+		t.Fatalf("SetMembers of ClusterRoleBinding failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "clusterrolebinding.cluster-viewers.json")); err != nil {
+		t.Errorf("ClusterRoleBinding was not rendered: %v", err)
+	}
+
+	if _, err := w.GetGroup(ctx, "unknown"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(unknown) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func TestNewRoleRef(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		kind    string
+		role    string
+		want    *RoleRef
+		wantErr string
+	}{
+		{
+			name: "default_cluster_role",
+			role: "view",
+			want: &RoleRef{APIGroup: rbacAPIGroup, Kind: "ClusterRole", Name: "view"},
+		},
+		{
+			name: "role",
+			kind: "Role",
+			role: "deployer",
+			want: &RoleRef{APIGroup: rbacAPIGroup, Kind: "Role", Name: "deployer"},
+		},
+		{
+			name:    "invalid_kind",
+			kind:    "Group",
+			role:    "view",
+			wantErr: "role kind must be Role or ClusterRole",
+		},
+		{
+			name:    "missing_name",
+			wantErr: "role name is required",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewRoleRef(tc.kind, tc.role)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("NewRoleRef got unexpected result (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
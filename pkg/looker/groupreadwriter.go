@@ -0,0 +1,312 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package looker provides a GroupReadWriter for Looker groups, which grant
+// access to folders, models and other BI content.
+package looker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of group users requested per page.
+	pageSize = 500
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Group is a Looker group, see
+// https://cloud.google.com/looker/docs/reference/looker-api/latest/types/Group.
+type Group struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	UserCount         int    `json:"user_count,omitempty"`
+	ExternallyManaged bool   `json:"externally_managed,omitempty"`
+}
+
+// User is a Looker user, see
+// https://cloud.google.com/looker/docs/reference/looker-api/latest/types/User.
+type User struct {
+	ID          string `json:"id"`
+	Email       string `json:"email"`
+	DisplayName string `json:"display_name,omitempty"`
+	IsDisabled  bool   `json:"is_disabled,omitempty"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Looker. It is wrapped to
+// add the API access token.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Looker groups: their users and the groups
+// included in them. Group IDs are the numeric IDs of Looker groups and user
+// IDs are emails. Externally managed groups, e.g. synced from SAML or LDAP,
+// cannot be written.
+type GroupReadWriter struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Looker instance at the
+// given URL, e.g. https://example.cloud.looker.com. It logs in with the API
+// client ID and secret of a user with the manage_groups and see_users
+// permissions.
+func NewGroupReadWriter(ctx context.Context, endpoint, clientID, clientSecret string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	credentials := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     endpoint + "/api/4.0/login",
+		AuthStyle:    oauth2.AuthStyleInParams,
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, config.httpClient)
+	return &GroupReadWriter{
+		client:    rest.New(endpoint+"/api/4.0", rest.WithHTTPClient(credentials.Client(ctx))),
+		userCache: cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Looker group with the given ID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	group, err := rw.group(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupsync.Group{ID: group.ID, Attributes: group}, nil
+}
+
+// GetMembers retrieves the members of the Looker group with the given ID: its
+// users and the groups included in it.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	base := "/groups/" + url.PathEscape(groupID)
+	var members []groupsync.Member
+	for offset := 0; ; offset += pageSize {
+		q := url.Values{
+			"fields": {"id,email,display_name,is_disabled"},
+			"limit":  {strconv.Itoa(pageSize)},
+			"offset": {strconv.Itoa(offset)},
+		}
+		var users []*User
+		if err := rw.client.Do(ctx, http.MethodGet, base+"/users?"+q.Encode(), nil, &users); err != nil {
+			return nil, fmt.Errorf("failed to get users of group %s: %w", groupID, notFound(err))
+		}
+		for _, u := range users {
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: u.Email, Attributes: u}})
+		}
+		if len(users) < pageSize {
+			break
+		}
+	}
+	var groups []*Group
+	if err := rw.client.Do(ctx, http.MethodGet, base+"/groups", nil, &groups); err != nil {
+		return nil, fmt.Errorf("failed to get groups of group %s: %w", groupID, notFound(err))
+	}
+	for _, g := range groups {
+		members = append(members, &groupsync.GroupMember{Grp: &groupsync.Group{ID: g.ID, Attributes: g}})
+	}
+	return members, nil
+}
+
+// Descendants retrieve all users (children, recursively) of the Looker group
+// with the given ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Looker user with the given email.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.user(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Email, Attributes: user}, nil
+}
+
+// user returns the Looker user with the given email.
+func (rw *GroupReadWriter) user(ctx context.Context, email string) (*User, error) {
+	user, err := rw.userCache.WriteThruLookup(strings.ToLower(email), func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", email)
+		q := url.Values{
+			"email":  {email},
+			"fields": {"id,email,display_name,is_disabled"},
+		}
+		var users []*User
+		if err := rw.client.Do(ctx, http.MethodGet, "/users/search?"+q.Encode(), nil, &users); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", email, err)
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("no looker user with email %s", email)
+		}
+		return users[0], nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// SetMembers replaces the members of the Looker group with the given ID with
+// the given members. Users which are not in Looker are reported in the
+// returned error after all other changes are made.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	group, err := rw.group(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if group.ExternallyManaged {
+		return fmt.Errorf("group %s is externally managed and cannot be written", groupID)
+	}
+	currentMembers, err := rw.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	base := "/groups/" + url.PathEscape(groupID)
+	var merr error
+	for _, id := range utils.MapKeys(addMembers) {
+		if addMembers[id].IsGroup() {
+			if err := rw.client.Do(ctx, http.MethodPost, base+"/groups", map[string]string{"group_id": id}, nil); err != nil {
+				merr = errors.Join(merr, fmt.Errorf("failed to include group %s in group %s: %w", id, groupID, err))
+			}
+			continue
+		}
+		user, err := rw.user(ctx, id)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to group %s: %w", id, groupID, err))
+			continue
+		}
+		if err := rw.client.Do(ctx, http.MethodPost, base+"/users", map[string]string{"user_id": user.ID}, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add %s to group %s: %w", id, groupID, err))
+		}
+	}
+	for _, id := range utils.MapKeys(removeMembers) {
+		path := base + "/groups/" + url.PathEscape(id)
+		if user, ok := lookerUser(removeMembers[id]); ok {
+			path = base + "/users/" + url.PathEscape(user.ID)
+		}
+		if err := rw.client.Do(ctx, http.MethodDelete, path, nil, nil); err != nil && !rest.IsNotFound(err) {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove %s from group %s: %w", id, groupID, err))
+		}
+	}
+	return merr
+}
+
+// group returns the Looker group with the given ID.
+func (rw *GroupReadWriter) group(ctx context.Context, groupID string) (*Group, error) {
+	var group Group
+	if err := rw.client.Do(ctx, http.MethodGet, "/groups/"+url.PathEscape(groupID), nil, &group); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", groupID, notFound(err))
+	}
+	return &group, nil
+}
+
+// lookerUser returns the Looker user of a current user member.
+func lookerUser(m groupsync.Member) (*User, bool) {
+	u, ok := m.(*groupsync.UserMember)
+	if !ok {
+		return nil, false
+	}
+	user, ok := u.Usr.Attributes.(*User)
+	return user, ok
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+// toIDMap returns the given members by ID, lowercased for users as emails are
+// case-insensitive.
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		if m.IsGroup() {
+			memberIDs[m.ID()] = m
+			continue
+		}
+		memberIDs[strings.ToLower(m.ID())] = m
+	}
+	return memberIDs
+}
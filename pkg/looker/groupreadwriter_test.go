@@ -0,0 +1,189 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package looker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeLooker{
+		users: []*User{
+			{ID: "1", Email: "alice@example.com"},
+			{ID: "2", Email: "bob@example.com"},
+			{ID: "3", Email: "carol@example.com"},
+		},
+		groups: map[string]*fakeGroup{
+			"10": {users: []string{"1", "2"}, groups: []string{"11"}},
+			"11": {users: []string{"3"}},
+			"12": {},
+			"13": {externallyManaged: true},
+		},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(ctx, srv.URL, "client", "secret")
+
+	users, err := rw.Descendants(ctx, "10")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice@example.com", "bob@example.com", "carol@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "10", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "Bob@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "dave@example.com"}},
+		&groupsync.GroupMember{Grp: &groupsync.Group{ID: "12"}},
+	})
+	if diff := testutil.DiffErrString(err, "no looker user with email dave@example.com"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+	want := &fakeGroup{users: []string{"2", "3"}, groups: []string{"12"}}
+	if diff := cmp.Diff(fake.groups["10"], want, cmp.AllowUnexported(fakeGroup{})); diff != "" {
+		t.Errorf("unexpected group (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "13", nil)
+	if diff := testutil.DiffErrString(err, "externally managed"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+
+	if _, err := rw.GetGroup(ctx, "99"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(99) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+type fakeGroup struct {
+	externallyManaged bool
+	users             []string
+	groups            []string
+}
+
+// fakeLooker implements the login endpoint and the parts of the Looker API
+// used by GroupReadWriter.
+type fakeLooker struct {
+	mu     sync.Mutex
+	users  []*User
+	groups map[string]*fakeGroup
+}
+
+func (f *fakeLooker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/4.0")
+	if path == "/login" {
+		if r.FormValue("client_id") != "client" || r.FormValue("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`)
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+
+	if path == "/users/search" {
+		var found []*User
+		for _, u := range f.users {
+			if strings.EqualFold(u.Email, r.URL.Query().Get("email")) {
+				found = append(found, u)
+			}
+		}
+		write(found)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(path, "/groups/"), "/")
+	g, ok := f.groups[parts[0]]
+	if !strings.HasPrefix(path, "/groups/") || !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	switch {
+	case len(parts) == 1:
+		write(&Group{ID: parts[0], ExternallyManaged: g.externallyManaged})
+	case parts[1] == "users" && r.Method == http.MethodGet:
+		var users []*User
+		for _, u := range f.users {
+			if slices.Contains(g.users, u.ID) {
+				users = append(users, u)
+			}
+		}
+		write(users)
+	case parts[1] == "groups" && r.Method == http.MethodGet:
+		var groups []*Group
+		for _, id := range g.groups {
+			groups = append(groups, &Group{ID: id})
+		}
+		write(groups)
+	case r.Method == http.MethodPost:
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if parts[1] == "users" {
+			g.users = append(g.users, body["user_id"])
+		} else {
+			g.groups = append(g.groups, body["group_id"])
+		}
+	case r.Method == http.MethodDelete && len(parts) == 3:
+		del := func(s string) bool { return s == parts[2] }
+		if parts[1] == "users" {
+			g.users = slices.DeleteFunc(g.users, del)
+		} else {
+			g.groups = slices.DeleteFunc(g.groups, del)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
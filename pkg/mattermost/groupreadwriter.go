@@ -0,0 +1,324 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mattermost provides a GroupReadWriter for the members of
+// Mattermost teams and channels.
+package mattermost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of members requested per page, the maximum of
+	// the API.
+	pageSize = 200
+
+	// Team and Channel are the kinds of groups.
+	Team    = "teams"
+	Channel = "channels"
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// User is a Mattermost user, see
+// https://api.mattermost.com/#tag/users.
+type User struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email,omitempty"`
+	DeleteAt int64  `json:"delete_at,omitempty"`
+}
+
+// membership is a member of a team or channel.
+type membership struct {
+	UserID string `json:"user_id"`
+}
+
+// Encode returns the group ID of the team or channel with the given ID.
+func Encode(kind, id string) string {
+	return kind + "/" + id
+}
+
+// Decode returns the kind, Team or Channel, and ID of a group ID.
+func Decode(groupID string) (string, string, error) {
+	kind, id, _ := strings.Cut(groupID, "/")
+	if (kind != Team && kind != Channel) || id == "" {
+		return "", "", fmt.Errorf("%w: group ID %q must be of the form teams/ID or channels/ID", groupsync.ErrInvalidGroupID, groupID)
+	}
+	return kind, id, nil
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Mattermost.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Mattermost teams and channels. Group IDs are of
+// the form teams/ID or channels/ID and user IDs are usernames. Users must be
+// members of a channel's team to be added to the channel.
+type GroupReadWriter struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Mattermost server at
+// the given URL, authenticating with the given personal access or bot token.
+func NewGroupReadWriter(endpoint, token string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &GroupReadWriter{
+		client: rest.New(endpoint+"/api/v4",
+			rest.WithHTTPClient(config.httpClient),
+			rest.WithHeader("Authorization", "Bearer "+token),
+		),
+		userCache: cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Mattermost team or channel with the given group ID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	kind, id, err := Decode(groupID)
+	if err != nil {
+		return nil, err
+	}
+	var attrs map[string]any
+	if err := rw.client.Do(ctx, http.MethodGet, "/"+kind+"/"+url.PathEscape(id), nil, &attrs); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: groupID, Attributes: attrs}, nil
+}
+
+// GetMembers retrieves the members of the Mattermost team or channel with the
+// given group ID. Deactivated users are skipped.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	kind, id, err := Decode(groupID)
+	if err != nil {
+		return nil, err
+	}
+	var userIDs []string
+	for page := 0; ; page++ {
+		var batch []*membership
+		path := fmt.Sprintf("/%s/%s/members?page=%d&per_page=%d", kind, url.PathEscape(id), page, pageSize)
+		if err := rw.client.Do(ctx, http.MethodGet, path, nil, &batch); err != nil {
+			return nil, fmt.Errorf("failed to get members of group %s: %w", groupID, notFound(err))
+		}
+		for _, m := range batch {
+			userIDs = append(userIDs, m.UserID)
+		}
+		if len(batch) < pageSize {
+			break
+		}
+	}
+
+	users, err := rw.usersByID(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("could not get members of group %s: %w", groupID, err)
+	}
+	members := make([]groupsync.Member, 0, len(users))
+	for _, u := range users {
+		if u.DeleteAt == 0 {
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: u.Username, Attributes: u}})
+		}
+	}
+	return members, nil
+}
+
+// Descendants retrieve all users of the Mattermost team or channel with the
+// given group ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Mattermost user with the given username.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.user(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Username, Attributes: user}, nil
+}
+
+// user returns the Mattermost user with the given username.
+func (rw *GroupReadWriter) user(ctx context.Context, userID string) (*User, error) {
+	user, err := rw.userCache.WriteThruLookup("username/"+userID, func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userID)
+		var user User
+		if err := rw.client.Do(ctx, http.MethodGet, "/users/username/"+url.PathEscape(userID), nil, &user); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", userID, err)
+		}
+		return &user, nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// SetMembers replaces the members of the Mattermost team or channel with the
+// given group ID with the given members. Members which cannot be added, e.g.
+// unknown users, are reported in the returned error after all other changes
+// are made.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	kind, id, err := Decode(groupID)
+	if err != nil {
+		return err
+	}
+	currentMembers, err := rw.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	membersPath := "/" + kind + "/" + url.PathEscape(id) + "/members"
+	var merr error
+	for _, username := range utils.MapKeys(addMembers) {
+		user, err := rw.user(ctx, username)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to group %s: %w", username, groupID, err))
+			continue
+		}
+		body := map[string]string{"user_id": user.ID}
+		if kind == Team {
+			body["team_id"] = id
+		}
+		if err := rw.client.Do(ctx, http.MethodPost, membersPath, body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add %s to group %s: %w", username, groupID, err))
+		}
+	}
+	for _, username := range utils.MapKeys(removeMembers) {
+		user, ok := mattermostUser(removeMembers[username])
+		if !ok {
+			merr = errors.Join(merr, fmt.Errorf("cannot remove %s from group %s: unknown member", username, groupID))
+			continue
+		}
+		if err := rw.client.Do(ctx, http.MethodDelete, membersPath+"/"+url.PathEscape(user.ID), nil, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove %s from group %s: %w", username, groupID, err))
+		}
+	}
+	return merr
+}
+
+// usersByID returns the users with the given IDs, fetching the ones which
+// are not cached in a single request.
+func (rw *GroupReadWriter) usersByID(ctx context.Context, ids []string) ([]*User, error) {
+	users := make([]*User, 0, len(ids))
+	var missing []string
+	for _, id := range ids {
+		if u, ok := rw.userCache.Lookup("id/" + id); ok {
+			users = append(users, u)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return users, nil
+	}
+	var fetched []*User
+	if err := rw.client.Do(ctx, http.MethodPost, "/users/ids", missing, &fetched); err != nil {
+		return nil, fmt.Errorf("failed to fetch users: %w", err)
+	}
+	for _, u := range fetched {
+		rw.userCache.Set("id/"+u.ID, u)
+		users = append(users, u)
+	}
+	return users, nil
+}
+
+// mattermostUser returns the User of a member returned by GetMembers.
+func mattermostUser(m groupsync.Member) (*User, bool) {
+	user, err := m.User()
+	if err != nil {
+		return nil, false
+	}
+	u, ok := user.Attributes.(*User)
+	return u, ok
+}
+
+// notFound wraps errors of missing teams and channels with
+// groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[m.ID()] = m
+	}
+	return memberIDs
+}
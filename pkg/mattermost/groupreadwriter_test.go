@@ -0,0 +1,173 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mattermost
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeMattermost{
+		users: []*User{
+			{ID: "u1", Username: "alice"},
+			{ID: "u2", Username: "bob"},
+			{ID: "u3", Username: "carol"},
+			{ID: "u4", Username: "dave", DeleteAt: 1700000000000},
+		},
+		members: map[string][]string{
+			"teams/t1":    {"u1", "u2", "u4"},
+			"channels/c1": {"u1"},
+		},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(srv.URL, "token")
+
+	users, err := rw.Descendants(ctx, "teams/t1")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice", "bob"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "channels/c1", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "erin"}},
+	})
+	if diff := testutil.DiffErrString(err, "cannot add erin to group channels/c1"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+	if diff := cmp.Diff(fake.members["channels/c1"], []string{"u2", "u3"}); diff != "" {
+		t.Errorf("unexpected channel members (-got, +want):\n%s", diff)
+	}
+
+	if _, err := rw.GetGroup(ctx, "teams/missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(teams/missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+	if _, err := rw.GetGroup(ctx, "t1"); err == nil {
+		t.Errorf("GetGroup(t1) got no error for a group ID without kind")
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeMattermost implements the parts of the Mattermost API used by
+// GroupReadWriter. members holds the user IDs of each team and channel by
+// group ID.
+type fakeMattermost struct {
+	mu      sync.Mutex
+	users   []*User
+	members map[string][]string
+}
+
+func (f *fakeMattermost) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v4/"), "/")
+
+	if parts[0] == "users" {
+		switch {
+		case len(parts) == 2 && parts[1] == "ids":
+			var ids []string
+			if err := json.NewDecoder(r.Body).Decode(&ids); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			var users []*User
+			for _, u := range f.users {
+				if slices.Contains(ids, u.ID) {
+					users = append(users, u)
+				}
+			}
+			write(users)
+			return
+		case len(parts) == 3 && parts[1] == "username":
+			for _, u := range f.users {
+				if u.Username == parts[2] {
+					write(u)
+					return
+				}
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	groupID := parts[0] + "/" + parts[1]
+	members, ok := f.members[groupID]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	switch {
+	case len(parts) == 2:
+		write(map[string]string{"id": parts[1]})
+	case len(parts) == 3 && r.Method == http.MethodGet:
+		var page []*membership
+		if r.URL.Query().Get("page") == "0" {
+			for _, id := range members {
+				page = append(page, &membership{UserID: id})
+			}
+		}
+		write(page)
+	case len(parts) == 3 && r.Method == http.MethodPost:
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		members = append(members, body["user_id"])
+		slices.Sort(members)
+		f.members[groupID] = members
+		w.WriteHeader(http.StatusCreated)
+	case len(parts) == 4 && r.Method == http.MethodDelete:
+		f.members[groupID] = slices.DeleteFunc(members, func(id string) bool { return id == parts[3] })
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package microsoft365 provides a GroupReadWriter for Microsoft Entra ID
+// groups through Microsoft Graph, including Microsoft 365 groups and the
+// teams of Microsoft Teams, whose members are the members of their group.
+package microsoft365
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultGraphEndpoint is the URL of Microsoft Graph in the global cloud.
+	DefaultGraphEndpoint = "https://graph.microsoft.com"
+
+	// DefaultLoginEndpoint is the URL of Microsoft Entra ID in the global
+	// cloud, which issues the access tokens.
+	DefaultLoginEndpoint = "https://login.microsoftonline.com"
+
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// Members is the kind of the groups of the members of an Entra group.
+	Members = "members"
+	// Owners is the kind of the groups of the owners of an Entra group, e.g.
+	// the owners of a team.
+	Owners = "owners"
+
+	// pageSize is the number of members requested per page, the maximum of
+	// the API.
+	pageSize = 999
+
+	// userSelect are the properties of users requested from Graph.
+	userSelect = "id,userPrincipalName,mail,accountEnabled"
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Group is an Entra group, see
+// https://learn.microsoft.com/en-us/graph/api/resources/group.
+type Group struct {
+	ID          string   `json:"id"`
+	DisplayName string   `json:"displayName"`
+	GroupTypes  []string `json:"groupTypes,omitempty"`
+}
+
+// User is an Entra user. Team-link identifies users by their user principal
+// name, which is usually their email.
+type User struct {
+	ID                string `json:"id"`
+	UserPrincipalName string `json:"userPrincipalName"`
+	Mail              string `json:"mail,omitempty"`
+	AccountEnabled    bool   `json:"accountEnabled,omitempty"`
+}
+
+// Encode returns the group ID of the members or owners of the Entra group
+// with the given object ID.
+func Encode(kind, id string) string {
+	return kind + "/" + id
+}
+
+// Decode returns the kind, Members or Owners, and object ID of a group ID.
+func Decode(groupID string) (string, string, error) {
+	kind, id, _ := strings.Cut(groupID, "/")
+	if (kind != Members && kind != Owners) || id == "" {
+		return "", "", fmt.Errorf("%w: group ID %q must be of the form members/ID or owners/ID", groupsync.ErrInvalidGroupID, groupID)
+	}
+	return kind, id, nil
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+	graphEndpoint string
+	loginEndpoint string
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Microsoft Graph and Entra
+// ID. It is wrapped to add the OAuth access token.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// WithEndpoints sets the URLs of Microsoft Graph and Entra ID, e.g.
+// https://graph.microsoft.us and https://login.microsoftonline.us in the US
+// Government cloud. DefaultGraphEndpoint and DefaultLoginEndpoint by default.
+func WithEndpoints(graph, login string) Opt {
+	return func(config *Config) {
+		config.graphEndpoint = strings.TrimSuffix(graph, "/")
+		config.loginEndpoint = strings.TrimSuffix(login, "/")
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members or owners of Entra groups, see Encode. User IDs are
+// user principal names. Members which are not users, e.g. nested groups,
+// devices or service principals, are left alone.
+type GroupReadWriter struct {
+	client        *rest.Client
+	graphEndpoint string
+	userCache     *cache.Cache[*User]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Entra tenant with the
+// given ID. It authenticates with the client ID and secret of an app
+// registration granted the GroupMember.ReadWrite.All and User.Read.All
+// application permissions, and Group.ReadWrite.All to manage owners.
+func NewGroupReadWriter(ctx context.Context, tenantID, clientID, clientSecret string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+		graphEndpoint: DefaultGraphEndpoint,
+		loginEndpoint: DefaultLoginEndpoint,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	credentials := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     config.loginEndpoint + "/" + url.PathEscape(tenantID) + "/oauth2/v2.0/token",
+		Scopes:       []string{config.graphEndpoint + "/.default"},
+		AuthStyle:    oauth2.AuthStyleInParams,
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, config.httpClient)
+	return &GroupReadWriter{
+		client:        rest.New(config.graphEndpoint+"/v1.0", rest.WithHTTPClient(credentials.Client(ctx))),
+		graphEndpoint: config.graphEndpoint,
+		userCache:     cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Entra group of the given group ID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	_, id, err := Decode(groupID)
+	if err != nil {
+		return nil, err
+	}
+	var group Group
+	if err := rw.client.Do(ctx, http.MethodGet, "/groups/"+url.PathEscape(id)+"?$select=id,displayName,groupTypes", nil, &group); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", id, notFound(err))
+	}
+	return &groupsync.Group{ID: groupID, Attributes: &group}, nil
+}
+
+// GetMembers retrieves the users which are members or owners, depending on
+// the given group ID, of its Entra group.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	kind, id, err := Decode(groupID)
+	if err != nil {
+		return nil, err
+	}
+	var members []groupsync.Member
+	q := url.Values{"$select": {userSelect}, "$top": {strconv.Itoa(pageSize)}}
+	path := "/groups/" + url.PathEscape(id) + "/" + kind + "/microsoft.graph.user?" + q.Encode()
+	for path != "" {
+		var resp struct {
+			Value    []*User `json:"value"`
+			NextLink string  `json:"@odata.nextLink"`
+		}
+		if err := rw.client.Do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get %s of group %s: %w", kind, id, notFound(err))
+		}
+		for _, u := range resp.Value {
+			rw.userCache.Set(strings.ToLower(u.UserPrincipalName), u)
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: u.UserPrincipalName, StableID: u.ID, Attributes: u}})
+		}
+		path = resp.NextLink
+	}
+	return members, nil
+}
+
+// Descendants retrieve all users of the given group ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Entra user with the given user principal name.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.user(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.UserPrincipalName, StableID: user.ID, Attributes: user}, nil
+}
+
+// IsSuspended reports whether the Entra user with the given user principal
+// name is disabled.
+func (rw *GroupReadWriter) IsSuspended(ctx context.Context, userID string) (bool, error) {
+	user, err := rw.user(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("could not get user: %w", err)
+	}
+	return !user.AccountEnabled, nil
+}
+
+// user returns the Entra user with the given user principal name.
+func (rw *GroupReadWriter) user(ctx context.Context, upn string) (*User, error) {
+	user, err := rw.userCache.WriteThruLookup(strings.ToLower(upn), func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", upn)
+		var user User
+		if err := rw.client.Do(ctx, http.MethodGet, "/users/"+url.PathEscape(upn)+"?$select="+userSelect, nil, &user); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", upn, err)
+		}
+		return &user, nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// SetMembers replaces the users which are members or owners, depending on
+// the given group ID, of its Entra group with the given members. Users which
+// are not in the tenant are reported in the returned error after all other
+// changes are made. Entra refuses to remove the last owner of a Microsoft 365
+// group.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	kind, id, err := Decode(groupID)
+	if err != nil {
+		return err
+	}
+	currentMembers, err := rw.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	base := "/groups/" + url.PathEscape(id) + "/" + kind
+	var merr error
+	for _, upn := range utils.MapKeys(addMembers) {
+		user, err := rw.user(ctx, upn)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to %s of group %s: %w", upn, kind, id, err))
+			continue
+		}
+		body := map[string]string{"@odata.id": rw.graphEndpoint + "/v1.0/directoryObjects/" + user.ID}
+		if err := rw.client.Do(ctx, http.MethodPost, base+"/$ref", body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add %s to %s of group %s: %w", upn, kind, id, err))
+		}
+	}
+	for _, upn := range utils.MapKeys(removeMembers) {
+		user, err := removeMembers[upn].User()
+		if err != nil || user.StableID == "" {
+			merr = errors.Join(merr, fmt.Errorf("cannot remove %s from %s of group %s: unknown member", upn, kind, id))
+			continue
+		}
+		if err := rw.client.Do(ctx, http.MethodDelete, base+"/"+url.PathEscape(user.StableID)+"/$ref", nil, nil); err != nil && !rest.IsNotFound(err) {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove %s from %s of group %s: %w", upn, kind, id, err))
+		}
+	}
+	return merr
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+// toIDMap returns the given members by lowercased ID, as user principal
+// names are case-insensitive.
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[strings.ToLower(m.ID())] = m
+	}
+	return memberIDs
+}
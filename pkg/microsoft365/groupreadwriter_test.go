@@ -0,0 +1,241 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package microsoft365
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		groupID  string
+		wantKind string
+		wantID   string
+		wantErr  string
+	}{
+		{
+			name:     "members",
+			groupID:  "members/0d1e2f",
+			wantKind: Members,
+			wantID:   "0d1e2f",
+		},
+		{
+			name:     "owners",
+			groupID:  "owners/0d1e2f",
+			wantKind: Owners,
+			wantID:   "0d1e2f",
+		},
+		{
+			name:    "unknown_kind",
+			groupID: "guests/0d1e2f",
+			wantErr: "must be of the form",
+		},
+		{
+			name:    "missing_id",
+			groupID: "members/",
+			wantErr: "must be of the form",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			kind, id, err := Decode(tc.groupID)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if kind != tc.wantKind || id != tc.wantID {
+				t.Errorf("Decode got (%q, %q), want (%q, %q)", kind, id, tc.wantKind, tc.wantID)
+			}
+		})
+	}
+}
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeGraph{
+		users: map[string]*User{
+			"1": {ID: "1", UserPrincipalName: "alice@example.com", AccountEnabled: true},
+			"2": {ID: "2", UserPrincipalName: "bob@example.com", AccountEnabled: true},
+			"3": {ID: "3", UserPrincipalName: "carol@example.com", AccountEnabled: true},
+			"4": {ID: "4", UserPrincipalName: "dave@example.com"},
+		},
+		members: map[string][]string{"team": {"1", "2", "4"}},
+		owners:  map[string][]string{"team": {"1"}},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(ctx, "tenant", "client", "secret", WithEndpoints(srv.URL, srv.URL))
+
+	users, err := rw.Descendants(ctx, Encode(Members, "team"))
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice@example.com", "bob@example.com", "dave@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	if err := rw.SetMembers(ctx, Encode(Members, "team"), []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "Bob@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+	}); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(fake.members["team"], []string{"2", "3"}); diff != "" {
+		t.Errorf("unexpected members (-got, +want):\n%s", diff)
+	}
+
+	if err := rw.SetMembers(ctx, Encode(Owners, "team"), []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "erin@example.com"}},
+	}); err == nil || !strings.Contains(err.Error(), "cannot add erin@example.com") {
+		t.Errorf("SetMembers got err %v, want missing user erin", err)
+	}
+	if diff := cmp.Diff(fake.owners["team"], []string{"3"}); diff != "" {
+		t.Errorf("unexpected owners (-got, +want):\n%s", diff)
+	}
+
+	suspended, err := rw.IsSuspended(ctx, "dave@example.com")
+	if err != nil {
+		t.Fatalf("IsSuspended failed: %v", err)
+	}
+	if !suspended {
+		t.Errorf("IsSuspended(dave) got false, want true")
+	}
+
+	if _, err := rw.GetGroup(ctx, Encode(Members, "missing")); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeGraph implements the token endpoint of Entra ID and the parts of
+// Microsoft Graph used by GroupReadWriter. Member lists are returned two
+// users per page.
+type fakeGraph struct {
+	mu      sync.Mutex
+	users   map[string]*User
+	members map[string][]string
+	owners  map[string][]string
+}
+
+func (f *fakeGraph) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/tenant/oauth2/v2.0/token" {
+		if r.FormValue("client_id") != "client" || r.FormValue("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}) //nolint:errcheck // test server
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1.0/"), "/")
+	switch {
+	case parts[0] == "users" && len(parts) == 2:
+		for _, u := range f.users {
+			if strings.EqualFold(u.UserPrincipalName, parts[1]) {
+				write(u)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case parts[0] == "groups" && len(parts) >= 2:
+		group := parts[1]
+		if _, ok := f.members[group]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if len(parts) == 2 {
+			write(&Group{ID: group, DisplayName: group})
+			return
+		}
+		target := f.members
+		if parts[2] == "owners" {
+			target = f.owners
+		}
+		switch {
+		case r.Method == http.MethodGet && len(parts) == 4 && parts[3] == "microsoft.graph.user":
+			skip, _ := strconv.Atoi(r.URL.Query().Get("$skiptoken"))
+			ids := target[group][skip:]
+			resp := map[string]any{}
+			if len(ids) > 2 {
+				ids = ids[:2]
+				resp["@odata.nextLink"] = "http://" + r.Host + r.URL.Path + "?$skiptoken=" + strconv.Itoa(skip+2)
+			}
+			users := make([]*User, 0, len(ids))
+			for _, id := range ids {
+				users = append(users, f.users[id])
+			}
+			resp["value"] = users
+			write(resp)
+		case r.Method == http.MethodPost && len(parts) == 4 && parts[3] == "$ref":
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			id := body["@odata.id"][strings.LastIndex(body["@odata.id"], "/")+1:]
+			target[group] = append(target[group], id)
+			slices.Sort(target[group])
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && len(parts) == 5 && parts[4] == "$ref":
+			target[group] = slices.DeleteFunc(target[group], func(s string) bool { return s == parts[3] })
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
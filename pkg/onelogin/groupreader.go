@@ -0,0 +1,183 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package onelogin provides a GroupReader for OneLogin roles, so that
+// OneLogin can be the source of a sync.
+package onelogin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+const (
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of role users requested per page.
+	pageSize = 100
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReader = (*GroupReader)(nil)
+
+// Role is a OneLogin role.
+type Role struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// User is a OneLogin user.
+type User struct {
+	ID        int64  `json:"id"`
+	Email     string `json:"email"`
+	Username  string `json:"username,omitempty"`
+	Firstname string `json:"firstname,omitempty"`
+	Lastname  string `json:"lastname,omitempty"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call OneLogin. It is wrapped to
+// add the API access token.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReader provides read operations for OneLogin roles and their users.
+// Group IDs are role IDs and user IDs are emails, like the users of Google
+// Groups. Roles have no nested roles.
+type GroupReader struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+}
+
+// NewGroupReader creates a GroupReader for the OneLogin account at the given
+// URL, e.g. https://example.onelogin.com. It gets access tokens with API
+// credentials with the "Read users" scope.
+func NewGroupReader(ctx context.Context, endpoint, clientID, clientSecret string, opts ...Opt) *GroupReader {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	credentials := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     endpoint + "/auth/oauth2/v2/token",
+		AuthStyle:    oauth2.AuthStyleInHeader,
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, config.httpClient)
+	return &GroupReader{
+		client:    rest.New(endpoint+"/api/2", rest.WithHTTPClient(credentials.Client(ctx))),
+		userCache: cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the OneLogin role with the given ID.
+func (r *GroupReader) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	var role Role
+	if err := r.client.Do(ctx, http.MethodGet, "/roles/"+url.PathEscape(groupID), nil, &role); err != nil {
+		return nil, fmt.Errorf("failed to get role %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: groupID, Attributes: &role}, nil
+}
+
+// GetMembers retrieves the users of the OneLogin role with the given ID.
+func (r *GroupReader) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	var members []groupsync.Member
+	for page := 1; ; page++ {
+		q := url.Values{
+			"limit": {strconv.Itoa(pageSize)},
+			"page":  {strconv.Itoa(page)},
+		}
+		var users []*User
+		if err := r.client.Do(ctx, http.MethodGet, "/roles/"+url.PathEscape(groupID)+"/users?"+q.Encode(), nil, &users); err != nil {
+			return nil, fmt.Errorf("failed to get users of role %s: %w", groupID, notFound(err))
+		}
+		for _, u := range users {
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: u.Email, Attributes: u}})
+		}
+		if len(users) < pageSize {
+			return members, nil
+		}
+	}
+}
+
+// Descendants retrieve all users of the OneLogin role with the given ID.
+func (r *GroupReader) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, r.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the OneLogin user with the given email.
+func (r *GroupReader) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := r.userCache.WriteThruLookup(userID, func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userID)
+		var users []*User
+		if err := r.client.Do(ctx, http.MethodGet, "/users?email="+url.QueryEscape(userID), nil, &users); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", userID, err)
+		}
+		if len(users) == 0 {
+			return nil, fmt.Errorf("no onelogin user with email %s", userID)
+		}
+		return users[0], nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Email, Attributes: user}, nil
+}
+
+// notFound wraps errors of missing roles with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
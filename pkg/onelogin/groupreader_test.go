@@ -0,0 +1,150 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package onelogin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReader(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeOneLogin{
+		users: []*User{
+			{ID: 1, Email: "alice@example.com"},
+			{ID: 2, Email: "bob@example.com"},
+		},
+		roles: map[string][]int64{"10": {1, 2}, "11": {}},
+	}
+	for i := range pageSize {
+		fake.users = append(fake.users, &User{ID: int64(100 + i), Email: fmt.Sprintf("user%d@example.com", i)})
+		fake.roles["11"] = append(fake.roles["11"], int64(100+i))
+	}
+	fake.roles["11"] = append(fake.roles["11"], 1)
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	r := NewGroupReader(ctx, srv.URL, "client", "secret")
+
+	users, err := r.Descendants(ctx, "10")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice@example.com", "bob@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	members, err := r.GetMembers(ctx, "11")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if got, want := len(members), pageSize+1; got != want {
+		t.Errorf("GetMembers got %d members, want %d", got, want)
+	}
+
+	user, err := r.GetUser(ctx, "bob@example.com")
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if diff := cmp.Diff(user.Attributes, fake.users[1]); diff != "" {
+		t.Errorf("unexpected user (-got, +want):\n%s", diff)
+	}
+
+	if _, err := r.GetGroup(ctx, "99"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(99) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeOneLogin implements the token endpoint and the parts of the OneLogin
+// API used by GroupReader, with roles mapped to the IDs of their users.
+type fakeOneLogin struct {
+	users []*User
+	roles map[string][]int64
+}
+
+func (f *fakeOneLogin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/auth/oauth2/v2/token" {
+		if id, secret, _ := r.BasicAuth(); id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "token", "token_type": "Bearer", "expires_in": 36000}`)
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/api/2")
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	switch {
+	case path == "/users":
+		var found []*User
+		for _, u := range f.users {
+			if u.Email == r.URL.Query().Get("email") {
+				found = append(found, u)
+			}
+		}
+		write(found)
+	case parts[0] == "roles":
+		ids, ok := f.roles[parts[1]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if len(parts) == 2 {
+			id, _ := strconv.ParseInt(parts[1], 10, 64)
+			write(&Role{ID: id, Name: "role"})
+			return
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		start, end := min((page-1)*limit, len(ids)), min(page*limit, len(ids))
+		users := []*User{}
+		for _, id := range ids[start:end] {
+			users = append(users, f.users[slices.IndexFunc(f.users, func(u *User) bool { return u.ID == id })])
+		}
+		write(users)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
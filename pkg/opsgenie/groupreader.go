@@ -0,0 +1,255 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package opsgenie provides a GroupReader for Opsgenie schedules, so that the
+// users currently on call can be the source of a sync.
+package opsgenie
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+const (
+	// DefaultURL is the URL of the Opsgenie REST API. Accounts in the EU
+	// instance use https://api.eu.opsgenie.com.
+	DefaultURL = "https://api.opsgenie.com"
+
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReader = (*GroupReader)(nil)
+
+// Schedule is an Opsgenie schedule.
+type Schedule struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Timezone    string `json:"timezone,omitempty"`
+	Enabled     bool   `json:"enabled,omitempty"`
+}
+
+// User is an Opsgenie user. The username is the user's email.
+type User struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"fullName,omitempty"`
+}
+
+// Period is a period of a rotation in the final timeline of a schedule,
+// including overrides.
+type Period struct {
+	StartDate time.Time  `json:"startDate"`
+	EndDate   time.Time  `json:"endDate"`
+	Recipient *Recipient `json:"recipient"`
+}
+
+// Recipient is who is on call during a Period.
+type Recipient struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// timeline is the timeline of a schedule.
+type timeline struct {
+	FinalTimeline struct {
+		Rotations []struct {
+			Periods []*Period `json:"periods"`
+		} `json:"rotations"`
+	} `json:"finalTimeline"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+	url           string
+	lookahead     time.Duration
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Opsgenie.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// WithURL sets the URL of the REST API, DefaultURL by default.
+func WithURL(url string) Opt {
+	return func(config *Config) {
+		config.url = url
+	}
+}
+
+// WithLookahead also makes the users on call within the given duration from
+// now members of a schedule, so they get access before their shift starts.
+func WithLookahead(lookahead time.Duration) Opt {
+	return func(config *Config) {
+		config.lookahead = lookahead
+	}
+}
+
+// GroupReader provides read operations for Opsgenie schedules. Group IDs are
+// schedule IDs and user IDs are emails, like the users of Google Groups. The
+// members of a schedule are the users on call in any of its rotations, now or
+// within the lookahead, taking overrides into account. Schedules have no
+// nested groups.
+type GroupReader struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+	lookahead time.Duration
+	now       func() time.Time
+}
+
+// NewGroupReader creates a GroupReader authenticating with the given API key,
+// which needs the Read access right.
+func NewGroupReader(apiKey string, opts ...Opt) *GroupReader {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+		url:           DefaultURL,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &GroupReader{
+		client: rest.New(config.url+"/v2",
+			rest.WithHTTPClient(config.httpClient),
+			rest.WithHeader("Authorization", "GenieKey "+apiKey),
+		),
+		userCache: cache.New[*User](config.cacheDuration),
+		lookahead: config.lookahead,
+		now:       time.Now,
+	}
+}
+
+// GetGroup retrieves the Opsgenie schedule with the given ID.
+func (r *GroupReader) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	var resp struct {
+		Data *Schedule `json:"data"`
+	}
+	if err := r.client.Do(ctx, http.MethodGet, "/schedules/"+url.PathEscape(groupID)+"?identifierType=id", nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get schedule %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: resp.Data.ID, Attributes: resp.Data}, nil
+}
+
+// GetMembers retrieves the users on call for the Opsgenie schedule with the
+// given ID.
+func (r *GroupReader) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	users, err := r.onCallUsers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]groupsync.Member, 0, len(users))
+	for _, u := range users {
+		members = append(members, &groupsync.UserMember{Usr: u})
+	}
+	return members, nil
+}
+
+// Descendants retrieve the users on call for the Opsgenie schedule with the
+// given ID.
+func (r *GroupReader) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := r.onCallUsers(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Opsgenie user with the given email.
+func (r *GroupReader) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := r.userCache.WriteThruLookup(userID, func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userID)
+		var resp struct {
+			Data *User `json:"data"`
+		}
+		if err := r.client.Do(ctx, http.MethodGet, "/users/"+url.PathEscape(userID), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", userID, err)
+		}
+		return resp.Data, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Username, Attributes: user}, nil
+}
+
+// onCallUsers returns the users on call for the schedule with the given ID,
+// now or within the lookahead.
+func (r *GroupReader) onCallUsers(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	now := r.now().UTC()
+	until := now.Add(r.lookahead)
+	q := url.Values{
+		"identifierType": {"id"},
+		"date":           {now.Format(time.RFC3339)},
+		"intervalUnit":   {"days"},
+		"interval":       {fmt.Sprint(max(1, int(math.Ceil(r.lookahead.Hours()/24))))},
+	}
+	var resp struct {
+		Data *timeline `json:"data"`
+	}
+	if err := r.client.Do(ctx, http.MethodGet, "/schedules/"+url.PathEscape(groupID)+"/timeline?"+q.Encode(), nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get timeline of schedule %s: %w", groupID, notFound(err))
+	}
+
+	var users []*groupsync.User
+	seen := make(map[string]bool)
+	for _, rotation := range resp.Data.FinalTimeline.Rotations {
+		for _, p := range rotation.Periods {
+			// recipients of periods without anyone on call have type none.
+			if p.Recipient == nil || p.Recipient.Type != "user" || seen[p.Recipient.Name] {
+				continue
+			}
+			if p.StartDate.After(until) || !p.EndDate.After(now) {
+				continue
+			}
+			seen[p.Recipient.Name] = true
+			users = append(users, &groupsync.User{ID: p.Recipient.Name, Attributes: p.Recipient})
+		}
+	}
+	return users, nil
+}
+
+// notFound wraps errors of missing schedules with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
@@ -0,0 +1,228 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opsgenie
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReader(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	period := func(name string, start, end time.Duration) *Period {
+		typ := "user"
+		if name == "" {
+			typ = "none"
+		}
+		return &Period{
+			StartDate: now.Add(start),
+			EndDate:   now.Add(end),
+			Recipient: &Recipient{Type: typ, Name: name},
+		}
+	}
+	fake := &fakeOpsgenie{
+		timelines: map[string][][]*Period{
+			"primary": {
+				{
+					period("alice@example.com", -12*time.Hour, time.Hour),
+					period("bob@example.com", time.Hour, 13*time.Hour),
+				},
+				{
+					period("", -12*time.Hour, 2*time.Hour),
+					period("carol@example.com", 2*time.Hour, 14*time.Hour),
+					period("dave@example.com", 30*time.Hour, 42*time.Hour),
+				},
+			},
+			"empty": {},
+		},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	cases := []struct {
+		name      string
+		schedule  string
+		lookahead time.Duration
+		want      []string
+		wantErr   error
+	}{
+		{
+			name:     "current",
+			schedule: "primary",
+			want:     []string{"alice@example.com"},
+		},
+		{
+			name:      "lookahead",
+			schedule:  "primary",
+			lookahead: 2 * time.Hour,
+			want:      []string{"alice@example.com", "bob@example.com", "carol@example.com"},
+		},
+		{
+			name:      "lookahead_days",
+			schedule:  "primary",
+			lookahead: 36 * time.Hour,
+			want:      []string{"alice@example.com", "bob@example.com", "carol@example.com", "dave@example.com"},
+		},
+		{
+			name:     "nobody_on_call",
+			schedule: "empty",
+			want:     []string{},
+		},
+		{
+			name:     "unknown_schedule",
+			schedule: "missing",
+			wantErr:  groupsync.ErrGroupNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := NewGroupReader("key", WithURL(srv.URL), WithLookahead(tc.lookahead))
+			r.now = func() time.Time { return now }
+
+			users, err := r.Descendants(ctx, tc.schedule)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("Descendants got err %v, want %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(userIDs(users), tc.want); diff != "" {
+				t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGroupReader_GetUser(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeOpsgenie{
+		users: []*User{{ID: "u1", Username: "alice@example.com", FullName: "Alice"}},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	r := NewGroupReader("key", WithURL(srv.URL))
+
+	user, err := r.GetUser(ctx, "alice@example.com")
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if diff := cmp.Diff(user.Attributes, fake.users[0]); diff != "" {
+		t.Errorf("unexpected user (-got, +want):\n%s", diff)
+	}
+	if _, err := r.GetUser(ctx, "dave@example.com"); err == nil {
+		t.Errorf("GetUser(dave@example.com) got no error")
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeOpsgenie implements the parts of the Opsgenie REST API used by
+// GroupReader. The timeline of a schedule is the periods of its rotations
+// within the requested number of days.
+type fakeOpsgenie struct {
+	users     []*User
+	timelines map[string][][]*Period
+}
+
+func (f *fakeOpsgenie) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "GenieKey key" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(map[string]any{"data": v}) //nolint:errcheck // test server
+	}
+	path, ok := strings.CutPrefix(r.URL.Path, "/v2")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	q := r.URL.Query()
+
+	switch {
+	case strings.HasPrefix(path, "/schedules/"):
+		id, op, _ := strings.Cut(strings.TrimPrefix(path, "/schedules/"), "/")
+		rotations, ok := f.timelines[id]
+		if !ok || q.Get("identifierType") != "id" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if op != "timeline" {
+			write(&Schedule{ID: id, Name: id})
+			return
+		}
+		date, err := time.Parse(time.RFC3339, q.Get("date"))
+		if err != nil || q.Get("intervalUnit") != "days" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var days int
+		if err := json.Unmarshal([]byte(q.Get("interval")), &days); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		end := date.AddDate(0, 0, days)
+		var tl timeline
+		for _, periods := range rotations {
+			var inTimeline []*Period
+			for _, p := range periods {
+				if p.StartDate.Before(end) && p.EndDate.After(date) {
+					inTimeline = append(inTimeline, p)
+				}
+			}
+			tl.FinalTimeline.Rotations = append(tl.FinalTimeline.Rotations, struct {
+				Periods []*Period `json:"periods"`
+			}{Periods: inTimeline})
+		}
+		write(&tl)
+	case strings.HasPrefix(path, "/users/"):
+		id := strings.TrimPrefix(path, "/users/")
+		for _, u := range f.users {
+			if u.Username == id {
+				write(u)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
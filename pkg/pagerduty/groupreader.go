@@ -0,0 +1,255 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pagerduty provides a GroupReader for PagerDuty schedules, so that
+// the users currently on call can be the source of a sync.
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+const (
+	// DefaultURL is the URL of the PagerDuty REST API. Accounts in the EU
+	// service region use https://api.eu.pagerduty.com.
+	DefaultURL = "https://api.pagerduty.com"
+
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of on-call entries requested per page.
+	pageSize = 100
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReader = (*GroupReader)(nil)
+
+// Schedule is a PagerDuty schedule.
+type Schedule struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Summary     string `json:"summary,omitempty"`
+	Description string `json:"description,omitempty"`
+	TimeZone    string `json:"time_zone,omitempty"`
+}
+
+// User is a PagerDuty user.
+type User struct {
+	ID    string `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email"`
+}
+
+// OnCall is an on-call entry of a schedule.
+type OnCall struct {
+	User  *User     `json:"user"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// onCallsPage is a page of on-call entries.
+type onCallsPage struct {
+	OnCalls []*OnCall `json:"oncalls"`
+	More    bool      `json:"more"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+	url           string
+	lookahead     time.Duration
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call PagerDuty.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// WithURL sets the URL of the REST API, DefaultURL by default.
+func WithURL(url string) Opt {
+	return func(config *Config) {
+		config.url = url
+	}
+}
+
+// WithLookahead also makes the users on call within the given duration from
+// now members of a schedule, so they get access before their shift starts.
+func WithLookahead(lookahead time.Duration) Opt {
+	return func(config *Config) {
+		config.lookahead = lookahead
+	}
+}
+
+// GroupReader provides read operations for PagerDuty schedules. Group IDs are
+// schedule IDs and user IDs are emails, like the users of Google Groups. The
+// members of a schedule are the users on call, at any escalation level, now or
+// within the lookahead. Schedules have no nested groups.
+type GroupReader struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+	lookahead time.Duration
+	now       func() time.Time
+}
+
+// NewGroupReader creates a GroupReader authenticating with the given REST API
+// key. A read-only key is sufficient.
+func NewGroupReader(apiKey string, opts ...Opt) *GroupReader {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+		url:           DefaultURL,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &GroupReader{
+		client: rest.New(config.url,
+			rest.WithHTTPClient(config.httpClient),
+			rest.WithHeader("Authorization", "Token token="+apiKey),
+		),
+		userCache: cache.New[*User](config.cacheDuration),
+		lookahead: config.lookahead,
+		now:       time.Now,
+	}
+}
+
+// GetGroup retrieves the PagerDuty schedule with the given ID.
+func (r *GroupReader) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	var resp struct {
+		Schedule *Schedule `json:"schedule"`
+	}
+	if err := r.client.Do(ctx, http.MethodGet, "/schedules/"+url.PathEscape(groupID), nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get schedule %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: resp.Schedule.ID, Attributes: resp.Schedule}, nil
+}
+
+// GetMembers retrieves the users on call for the PagerDuty schedule with the
+// given ID.
+func (r *GroupReader) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	users, err := r.onCallUsers(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]groupsync.Member, 0, len(users))
+	for _, u := range users {
+		members = append(members, &groupsync.UserMember{Usr: u})
+	}
+	return members, nil
+}
+
+// Descendants retrieve the users on call for the PagerDuty schedule with the
+// given ID.
+func (r *GroupReader) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := r.onCallUsers(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the PagerDuty user with the given email.
+func (r *GroupReader) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := r.userCache.WriteThruLookup(userID, func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userID)
+		var resp struct {
+			Users []*User `json:"users"`
+		}
+		q := url.Values{"query": {userID}}
+		if err := r.client.Do(ctx, http.MethodGet, "/users?"+q.Encode(), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", userID, err)
+		}
+		// the query also matches names and parts of emails.
+		for _, u := range resp.Users {
+			if strings.EqualFold(u.Email, userID) {
+				return u, nil
+			}
+		}
+		return nil, fmt.Errorf("no pagerduty user with email %s", userID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Email, Attributes: user}, nil
+}
+
+// onCallUsers returns the users on call for the schedule with the given ID,
+// now or within the lookahead.
+func (r *GroupReader) onCallUsers(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	// the on-calls of an unknown schedule are empty.
+	if _, err := r.GetGroup(ctx, groupID); err != nil {
+		return nil, err
+	}
+	now := r.now().UTC()
+	q := url.Values{
+		"schedule_ids[]": {groupID},
+		"include[]":      {"users"},
+		"since":          {now.Format(time.RFC3339)},
+		"until":          {now.Add(r.lookahead).Format(time.RFC3339)},
+		"limit":          {fmt.Sprint(pageSize)},
+	}
+
+	var users []*groupsync.User
+	seen := make(map[string]bool)
+	for offset, more := 0, true; more; {
+		q.Set("offset", fmt.Sprint(offset))
+		var page onCallsPage
+		if err := r.client.Do(ctx, http.MethodGet, "/oncalls?"+q.Encode(), nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to get on-calls of schedule %s: %w", groupID, err)
+		}
+		for _, oc := range page.OnCalls {
+			if oc.User == nil || oc.User.Email == "" || seen[oc.User.Email] {
+				continue
+			}
+			seen[oc.User.Email] = true
+			users = append(users, &groupsync.User{ID: oc.User.Email, Attributes: oc.User})
+		}
+		// the API may return fewer entries than requested per page.
+		offset += len(page.OnCalls)
+		more = page.More && len(page.OnCalls) > 0
+	}
+	return users, nil
+}
+
+// notFound wraps errors of missing schedules with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
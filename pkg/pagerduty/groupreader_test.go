@@ -0,0 +1,206 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReader(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	alice := &User{ID: "P1", Name: "Alice", Email: "alice@example.com"}
+	bob := &User{ID: "P2", Name: "Bob", Email: "bob@example.com"}
+	carol := &User{ID: "P3", Name: "Carol", Email: "carol@example.com"}
+	fake := &fakePagerDuty{
+		users: []*User{alice, bob, carol},
+		onCalls: map[string][]*OnCall{
+			"SCHED1": {
+				{User: alice, Start: now.Add(-time.Hour), End: now.Add(time.Hour)},
+				{User: bob, Start: now.Add(-time.Hour), End: now.Add(time.Hour)},
+				// alice again at the next escalation level.
+				{User: alice, Start: now.Add(-time.Hour), End: now.Add(2 * time.Hour)},
+				{User: carol, Start: now.Add(90 * time.Minute), End: now.Add(3 * time.Hour)},
+			},
+			"SCHED2": {},
+		},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	cases := []struct {
+		name      string
+		schedule  string
+		lookahead time.Duration
+		want      []string
+		wantErr   error
+	}{
+		{
+			name:     "current",
+			schedule: "SCHED1",
+			want:     []string{"alice@example.com", "bob@example.com"},
+		},
+		{
+			name:      "lookahead",
+			schedule:  "SCHED1",
+			lookahead: 2 * time.Hour,
+			want:      []string{"alice@example.com", "bob@example.com", "carol@example.com"},
+		},
+		{
+			name:     "nobody_on_call",
+			schedule: "SCHED2",
+			want:     []string{},
+		},
+		{
+			name:     "unknown_schedule",
+			schedule: "MISSING",
+			wantErr:  groupsync.ErrGroupNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := NewGroupReader("key", WithURL(srv.URL), WithLookahead(tc.lookahead))
+			r.now = func() time.Time { return now }
+
+			users, err := r.Descendants(ctx, tc.schedule)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("Descendants got err %v, want %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(userIDs(users), tc.want); diff != "" {
+				t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+			}
+			members, err := r.GetMembers(ctx, tc.schedule)
+			if err != nil {
+				t.Fatalf("GetMembers failed: %v", err)
+			}
+			if got, want := len(members), len(tc.want); got != want {
+				t.Errorf("GetMembers got %d members, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestGroupReader_GetUser(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakePagerDuty{
+		users: []*User{
+			{ID: "P1", Name: "Alice", Email: "alice@example.com"},
+			{ID: "P2", Name: "Alice Jr", Email: "alice.jr@example.com"},
+		},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	r := NewGroupReader("key", WithURL(srv.URL))
+
+	user, err := r.GetUser(ctx, "alice@example.com")
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if diff := cmp.Diff(user.Attributes, fake.users[0]); diff != "" {
+		t.Errorf("unexpected user (-got, +want):\n%s", diff)
+	}
+	if _, err := r.GetUser(ctx, "dave@example.com"); err == nil {
+		t.Errorf("GetUser(dave@example.com) got no error")
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakePagerDuty implements the parts of the PagerDuty REST API used by
+// GroupReader. It returns one on-call entry per page.
+type fakePagerDuty struct {
+	users   []*User
+	onCalls map[string][]*OnCall
+}
+
+func (f *fakePagerDuty) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Token token=key" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	q := r.URL.Query()
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/schedules/"):
+		id := strings.TrimPrefix(r.URL.Path, "/schedules/")
+		if _, ok := f.onCalls[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		write(map[string]any{"schedule": &Schedule{ID: id, Name: id}})
+	case r.URL.Path == "/oncalls":
+		since, err1 := time.Parse(time.RFC3339, q.Get("since"))
+		until, err2 := time.Parse(time.RFC3339, q.Get("until"))
+		offset, err3 := strconv.Atoi(q.Get("offset"))
+		if err := errors.Join(err1, err2, err3); err != nil || q.Get("include[]") != "users" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var matched []*OnCall
+		for _, oc := range f.onCalls[q.Get("schedule_ids[]")] {
+			if !oc.Start.After(until) && oc.End.After(since) {
+				matched = append(matched, oc)
+			}
+		}
+		page := &onCallsPage{More: offset+1 < len(matched)}
+		if offset < len(matched) {
+			page.OnCalls = matched[offset : offset+1]
+		}
+		write(page)
+	case r.URL.Path == "/users":
+		var users []*User
+		for _, u := range f.users {
+			if strings.Contains(u.Email, q.Get("query")) {
+				users = append(users, u)
+			}
+		}
+		write(map[string]any{"users": users})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
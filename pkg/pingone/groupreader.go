@@ -0,0 +1,233 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pingone provides a GroupReader for PingOne groups, so that PingOne
+// can be the source of a sync.
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+const (
+	// DefaultDomain is the domain of the PingOne North America region. Other
+	// regions use e.g. pingone.eu or pingone.asia.
+	DefaultDomain = "pingone.com"
+
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of users requested per page.
+	pageSize = 100
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReader = (*GroupReader)(nil)
+
+// Group is a PingOne group.
+type Group struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// User is a PingOne user.
+type User struct {
+	ID       string `json:"id"`
+	Email    string `json:"email"`
+	Username string `json:"username,omitempty"`
+	Enabled  bool   `json:"enabled,omitempty"`
+}
+
+// usersPage is a page of a users search.
+type usersPage struct {
+	Embedded struct {
+		Users []*User `json:"users"`
+	} `json:"_embedded"`
+	Links struct {
+		Next struct {
+			Href string `json:"href"`
+		} `json:"next"`
+	} `json:"_links"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+	domain        string
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call PingOne. It is wrapped to
+// add the API access token.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// WithDomain sets the domain of the region of the environment, DefaultDomain
+// by default. The API is at api.DOMAIN and tokens are issued by auth.DOMAIN.
+func WithDomain(domain string) Opt {
+	return func(config *Config) {
+		config.domain = domain
+	}
+}
+
+// GroupReader provides read operations for PingOne groups and their users.
+// Group IDs are group IDs and user IDs are emails, like the users of Google
+// Groups. The members of a group are its direct users, and its descendants
+// include the users of its nested groups.
+type GroupReader struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+}
+
+// NewGroupReader creates a GroupReader for the PingOne environment with the
+// given ID. It gets access tokens with the client credentials of a worker
+// application with the Identity Data Read Only role.
+func NewGroupReader(ctx context.Context, environmentID, clientID, clientSecret string, opts ...Opt) *GroupReader {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+		domain:        DefaultDomain,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return newGroupReader(ctx, "https://auth."+config.domain+"/"+environmentID, "https://api."+config.domain+"/v1/environments/"+environmentID, clientID, clientSecret, config)
+}
+
+func newGroupReader(ctx context.Context, authURL, apiURL, clientID, clientSecret string, config *Config) *GroupReader {
+	credentials := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     authURL + "/as/token",
+		AuthStyle:    oauth2.AuthStyleInHeader,
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, config.httpClient)
+	return &GroupReader{
+		client:    rest.New(apiURL, rest.WithHTTPClient(credentials.Client(ctx))),
+		userCache: cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the PingOne group with the given ID.
+func (r *GroupReader) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	var group Group
+	if err := r.client.Do(ctx, http.MethodGet, "/groups/"+url.PathEscape(groupID), nil, &group); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: group.ID, Attributes: &group}, nil
+}
+
+// GetMembers retrieves the direct users of the PingOne group with the given
+// ID.
+func (r *GroupReader) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	users, err := r.search(ctx, groupID, fmt.Sprintf("memberOfGroups[id eq %q and type eq \"DIRECT\"]", groupID))
+	if err != nil {
+		return nil, err
+	}
+	members := make([]groupsync.Member, 0, len(users))
+	for _, u := range users {
+		members = append(members, &groupsync.UserMember{Usr: u})
+	}
+	return members, nil
+}
+
+// Descendants retrieve all users of the PingOne group with the given ID,
+// including the users of its nested groups.
+func (r *GroupReader) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := r.search(ctx, groupID, fmt.Sprintf("memberOfGroups[id eq %q]", groupID))
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the PingOne user with the given email.
+func (r *GroupReader) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := r.userCache.WriteThruLookup(userID, func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userID)
+		q := url.Values{"filter": {fmt.Sprintf("email eq %q", userID)}}
+		var page usersPage
+		if err := r.client.Do(ctx, http.MethodGet, "/users?"+q.Encode(), nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", userID, err)
+		}
+		if len(page.Embedded.Users) == 0 {
+			return nil, fmt.Errorf("no pingone user with email %s", userID)
+		}
+		return page.Embedded.Users[0], nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Email, Attributes: user}, nil
+}
+
+// search returns the users matching the given filter on the memberships of
+// the group with the given ID.
+func (r *GroupReader) search(ctx context.Context, groupID, filter string) ([]*groupsync.User, error) {
+	// searching users of an unknown group returns no users.
+	if _, err := r.GetGroup(ctx, groupID); err != nil {
+		return nil, err
+	}
+	q := url.Values{
+		"filter": {filter},
+		"limit":  {fmt.Sprint(pageSize)},
+	}
+	var users []*groupsync.User
+	for path := "/users?" + q.Encode(); path != ""; {
+		var page usersPage
+		if err := r.client.Do(ctx, http.MethodGet, path, nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to get users of group %s: %w", groupID, err)
+		}
+		for _, u := range page.Embedded.Users {
+			users = append(users, &groupsync.User{ID: u.Email, Attributes: u})
+		}
+		path = page.Links.Next.Href
+	}
+	return users, nil
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
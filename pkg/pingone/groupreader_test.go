@@ -0,0 +1,170 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pingone
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReader(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakePingOne{
+		users: []*User{
+			{ID: "u1", Email: "alice@example.com"},
+			{ID: "u2", Email: "bob@example.com"},
+			{ID: "u3", Email: "carol@example.com"},
+		},
+		direct:   map[string][]string{"g1": {"u1", "u2"}, "g2": {"u3"}},
+		indirect: map[string][]string{"g1": {"u3"}},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	fake.url = srv.URL
+	r := newGroupReader(ctx, srv.URL+"/env", srv.URL+"/v1/environments/env", "client", "secret", &Config{cacheDuration: DefaultCacheDuration, httpClient: http.DefaultClient})
+
+	users, err := r.Descendants(ctx, "g1")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice@example.com", "bob@example.com", "carol@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	members, err := r.GetMembers(ctx, "g1")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	var got []string
+	for _, m := range members {
+		got = append(got, m.ID())
+	}
+	if diff := cmp.Diff(got, []string{"alice@example.com", "bob@example.com"}); diff != "" {
+		t.Errorf("unexpected members (-got, +want):\n%s", diff)
+	}
+
+	user, err := r.GetUser(ctx, "carol@example.com")
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if diff := cmp.Diff(user.Attributes, fake.users[2]); diff != "" {
+		t.Errorf("unexpected user (-got, +want):\n%s", diff)
+	}
+
+	if _, err := r.Descendants(ctx, "missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("Descendants(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+var (
+	groupFilter = regexp.MustCompile(`^memberOfGroups\[id eq "([^"]+)"( and type eq "DIRECT")?\]$`)
+	emailFilter = regexp.MustCompile(`^email eq "([^"]+)"$`)
+)
+
+// fakePingOne implements the token endpoint and the parts of the PingOne API
+// used by GroupReader for the environment "env". It returns one user per
+// page.
+type fakePingOne struct {
+	url      string
+	users    []*User
+	direct   map[string][]string
+	indirect map[string][]string
+}
+
+func (f *fakePingOne) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/env/as/token" {
+		if id, secret, _ := r.BasicAuth(); id != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`)
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	path, ok := strings.CutPrefix(r.URL.Path, "/v1/environments/env")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(path, "/groups/"):
+		id := strings.TrimPrefix(path, "/groups/")
+		if _, ok := f.direct[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		write(&Group{ID: id, Name: id})
+	case path == "/users":
+		filter := r.URL.Query().Get("filter")
+		var ids []string
+		if m := groupFilter.FindStringSubmatch(filter); m != nil {
+			ids = append(ids, f.direct[m[1]]...)
+			if m[2] == "" {
+				ids = append(ids, f.indirect[m[1]]...)
+			}
+		}
+		var users []*User
+		for _, u := range f.users {
+			if slices.Contains(ids, u.ID) {
+				users = append(users, u)
+			}
+			if m := emailFilter.FindStringSubmatch(filter); m != nil && m[1] == u.Email {
+				users = append(users, u)
+			}
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		page := map[string]any{"_embedded": map[string]any{"users": users[min(offset, len(users)):min(offset+1, len(users))]}}
+		if offset+1 < len(users) {
+			q := r.URL.Query()
+			q.Set("offset", strconv.Itoa(offset+1))
+			page["_links"] = map[string]any{"next": map[string]string{"href": f.url + r.URL.Path + "?" + q.Encode()}}
+		}
+		write(page)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rocketchat provides a GroupReadWriter for the members of
+// Rocket.Chat channels and private groups.
+package rocketchat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of members requested per page.
+	pageSize = 100
+
+	// Channel and PrivateGroup are the kinds of rooms, named after their
+	// API methods.
+	Channel      = "channels"
+	PrivateGroup = "groups"
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// User is a Rocket.Chat user, see
+// https://developer.rocket.chat/apidocs/get-users-info.
+type User struct {
+	ID       string `json:"_id"`
+	Username string `json:"username"`
+	Name     string `json:"name,omitempty"`
+	Active   *bool  `json:"active,omitempty"`
+}
+
+// Encode returns the group ID of the room with the given ID, a private group
+// if private is true and a public channel otherwise.
+func Encode(roomID string, private bool) string {
+	if private {
+		return PrivateGroup + "/" + roomID
+	}
+	return Channel + "/" + roomID
+}
+
+// Decode returns the kind, Channel or PrivateGroup, and room ID of a group
+// ID.
+func Decode(groupID string) (string, string, error) {
+	kind, id, _ := strings.Cut(groupID, "/")
+	if (kind != Channel && kind != PrivateGroup) || id == "" {
+		return "", "", fmt.Errorf("%w: group ID %q must be of the form channels/ROOM_ID or groups/ROOM_ID", groupsync.ErrInvalidGroupID, groupID)
+	}
+	return kind, id, nil
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Rocket.Chat.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Rocket.Chat channels and private groups. Group
+// IDs are of the form channels/ROOM_ID or groups/ROOM_ID and user IDs are
+// usernames.
+type GroupReadWriter struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Rocket.Chat server at
+// the given URL, authenticating with the personal access token of the user
+// with the given ID.
+func NewGroupReadWriter(endpoint, userID, authToken string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &GroupReadWriter{
+		client: rest.New(endpoint+"/api/v1",
+			rest.WithHTTPClient(config.httpClient),
+			rest.WithHeader("X-User-Id", userID),
+			rest.WithHeader("X-Auth-Token", authToken),
+		),
+		userCache: cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Rocket.Chat room with the given group ID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	kind, id, err := Decode(groupID)
+	if err != nil {
+		return nil, err
+	}
+	var resp map[string]any
+	if err := rw.client.Do(ctx, http.MethodGet, "/"+kind+".info?roomId="+url.QueryEscape(id), nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get room %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: groupID, Attributes: resp}, nil
+}
+
+// GetMembers retrieves the members of the Rocket.Chat room with the given
+// group ID.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	kind, id, err := Decode(groupID)
+	if err != nil {
+		return nil, err
+	}
+	var members []groupsync.Member
+	for offset := 0; ; {
+		var resp struct {
+			Members []*User `json:"members"`
+			Total   int     `json:"total"`
+		}
+		path := fmt.Sprintf("/%s.members?roomId=%s&count=%d&offset=%d", kind, url.QueryEscape(id), pageSize, offset)
+		if err := rw.client.Do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get members of room %s: %w", groupID, notFound(err))
+		}
+		for _, u := range resp.Members {
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: u.Username, Attributes: u}})
+		}
+		// the server may return fewer members than requested.
+		offset += len(resp.Members)
+		if len(resp.Members) == 0 || offset >= resp.Total {
+			return members, nil
+		}
+	}
+}
+
+// Descendants retrieve all users of the Rocket.Chat room with the given
+// group ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Rocket.Chat user with the given username.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.user(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Username, Attributes: user}, nil
+}
+
+// user returns the Rocket.Chat user with the given username.
+func (rw *GroupReadWriter) user(ctx context.Context, userID string) (*User, error) {
+	user, err := rw.userCache.WriteThruLookup(userID, func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userID)
+		var resp struct {
+			User *User `json:"user"`
+		}
+		if err := rw.client.Do(ctx, http.MethodGet, "/users.info?username="+url.QueryEscape(userID), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", userID, err)
+		}
+		if resp.User == nil {
+			return nil, fmt.Errorf("user %s not found", userID)
+		}
+		return resp.User, nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// SetMembers replaces the members of the Rocket.Chat room with the given
+// group ID with the given members, inviting and kicking users. Members which
+// cannot be added, e.g. unknown users, are reported in the returned error
+// after all other changes are made.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	kind, id, err := Decode(groupID)
+	if err != nil {
+		return err
+	}
+	currentMembers, err := rw.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	var merr error
+	for _, username := range utils.MapKeys(addMembers) {
+		user, err := rw.user(ctx, username)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to room %s: %w", username, groupID, err))
+			continue
+		}
+		body := map[string]string{"roomId": id, "userId": user.ID}
+		if err := rw.client.Do(ctx, http.MethodPost, "/"+kind+".invite", body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add %s to room %s: %w", username, groupID, err))
+		}
+	}
+	for _, username := range utils.MapKeys(removeMembers) {
+		user, ok := rocketChatUser(removeMembers[username])
+		if !ok {
+			merr = errors.Join(merr, fmt.Errorf("cannot remove %s from room %s: unknown member", username, groupID))
+			continue
+		}
+		body := map[string]string{"roomId": id, "userId": user.ID}
+		if err := rw.client.Do(ctx, http.MethodPost, "/"+kind+".kick", body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove %s from room %s: %w", username, groupID, err))
+		}
+	}
+	return merr
+}
+
+// rocketChatUser returns the User of a member returned by GetMembers.
+func rocketChatUser(m groupsync.Member) (*User, bool) {
+	user, err := m.User()
+	if err != nil {
+		return nil, false
+	}
+	u, ok := user.Attributes.(*User)
+	return u, ok
+}
+
+// notFound wraps errors of missing rooms with groupsync.ErrGroupNotFound.
+// Rocket.Chat reports them as bad requests.
+func notFound(err error) error {
+	var rerr *rest.Error
+	if rest.IsNotFound(err) || (errors.As(err, &rerr) && strings.Contains(rerr.Body, "error-room-not-found")) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[m.ID()] = m
+	}
+	return memberIDs
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rocketchat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeRocketChat{
+		users: []*User{
+			{ID: "u1", Username: "alice"},
+			{ID: "u2", Username: "bob"},
+			{ID: "u3", Username: "carol"},
+		},
+		rooms: map[string][]string{
+			"groups/r1":   {"u1", "u2"},
+			"channels/r2": {},
+		},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(srv.URL, "bot", "token")
+
+	users, err := rw.Descendants(ctx, Encode("r1", true))
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice", "bob"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "groups/r1", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "erin"}},
+	})
+	if diff := testutil.DiffErrString(err, "cannot add erin to room groups/r1"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+	if diff := cmp.Diff(fake.rooms["groups/r1"], []string{"u2", "u3"}); diff != "" {
+		t.Errorf("unexpected room members (-got, +want):\n%s", diff)
+	}
+
+	if _, err := rw.GetGroup(ctx, "channels/missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(channels/missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeRocketChat implements the parts of the Rocket.Chat API used by
+// GroupReadWriter. rooms holds the user IDs of each room by group ID. Member
+// lists return one member per page.
+type fakeRocketChat struct {
+	mu    sync.Mutex
+	users []*User
+	rooms map[string][]string
+}
+
+func (f *fakeRocketChat) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("X-User-Id") != "bot" || r.Header.Get("X-Auth-Token") != "token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	kind, method, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/v1/"), ".")
+	if kind == "users" {
+		for _, u := range f.users {
+			if u.Username == r.URL.Query().Get("username") {
+				write(map[string]any{"user": u, "success": true})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"success": false, "error": "User not found."}`)
+		return
+	}
+
+	var body map[string]string
+	if r.Method == http.MethodPost {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+	roomID := r.URL.Query().Get("roomId") + body["roomId"]
+	groupID := kind + "/" + roomID
+	members, ok := f.rooms[groupID]
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"success": false, "errorType": "error-room-not-found"}`)
+		return
+	}
+	switch method {
+	case "info":
+		write(map[string]any{"success": true})
+	case "members":
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var page []*User
+		if offset < len(members) {
+			for _, u := range f.users {
+				if u.ID == members[offset] {
+					page = append(page, u)
+				}
+			}
+		}
+		write(map[string]any{"members": page, "total": len(members), "success": true})
+	case "invite":
+		members = append(members, body["userId"])
+		slices.Sort(members)
+		f.rooms[groupID] = members
+		write(map[string]any{"success": true})
+	case "kick":
+		f.rooms[groupID] = slices.DeleteFunc(members, func(id string) bool { return id == body["userId"] })
+		write(map[string]any{"success": true})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
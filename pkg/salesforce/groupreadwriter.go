@@ -0,0 +1,385 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package salesforce provides a GroupReadWriter for Salesforce public groups
+// and permission set assignments.
+package salesforce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// Group is the kind of the groups of the members of a public group.
+	Group = "group"
+	// PermissionSet is the kind of the groups of the users assigned a
+	// permission set.
+	PermissionSet = "permissionset"
+
+	// apiPath is the path of the version of the REST API used.
+	apiPath = "/services/data/v62.0"
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// recordID matches the 15 and 18 character IDs of Salesforce records.
+var recordID = regexp.MustCompile(`^[a-zA-Z0-9]{15}([a-zA-Z0-9]{3})?$`)
+
+// User is a Salesforce user. Team-link identifies users by their username.
+type User struct {
+	ID       string `json:"Id"`
+	Username string `json:"Username"`
+	Email    string `json:"Email,omitempty"`
+	IsActive bool   `json:"IsActive"`
+}
+
+// Record is a public group or a permission set.
+type Record struct {
+	ID            string `json:"Id"`
+	Name          string `json:"Name"`
+	DeveloperName string `json:"DeveloperName,omitempty"`
+	Label         string `json:"Label,omitempty"`
+}
+
+// assignment is a GroupMember or PermissionSetAssignment record, which grants
+// a group or permission set to a user.
+type assignment struct {
+	ID   string
+	User *User
+}
+
+// kinds are the sObjects of the groups of each kind and of their assignments,
+// and the fields of the assignments referencing the group and the user.
+var kinds = map[string]struct {
+	object, assignment, groupField, userField string
+}{
+	Group:         {"Group", "GroupMember", "GroupId", "UserOrGroupId"},
+	PermissionSet: {"PermissionSet", "PermissionSetAssignment", "PermissionSetId", "AssigneeId"},
+}
+
+// Encode returns the group ID of the members of the public group, or the
+// users assigned the permission set, with the given record ID.
+func Encode(kind, id string) string {
+	return kind + "/" + id
+}
+
+// Decode returns the kind, Group or PermissionSet, and record ID of a group
+// ID.
+func Decode(groupID string) (string, string, error) {
+	kind, id, _ := strings.Cut(groupID, "/")
+	if _, ok := kinds[kind]; !ok || !recordID.MatchString(id) {
+		return "", "", fmt.Errorf("%w: group ID %q must be of the form group/ID or permissionset/ID", groupsync.ErrInvalidGroupID, groupID)
+	}
+	return kind, id, nil
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Salesforce. It is wrapped
+// to add the OAuth access token.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Salesforce public groups, or the assignments of
+// permission sets, see Encode. User IDs are usernames. Groups and roles in a
+// public group are left alone.
+type GroupReadWriter struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Salesforce org with
+// the given My Domain URL, e.g. https://example.my.salesforce.com. It
+// authenticates with the consumer key and secret of an external client app
+// using the client credentials flow, whose run-as user can manage the users
+// of the org.
+func NewGroupReadWriter(ctx context.Context, endpoint, clientID, clientSecret string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	credentials := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     endpoint + "/services/oauth2/token",
+		AuthStyle:    oauth2.AuthStyleInParams,
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, config.httpClient)
+	return &GroupReadWriter{
+		client:    rest.New(endpoint+apiPath, rest.WithHTTPClient(credentials.Client(ctx))),
+		userCache: cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the public group or permission set of the given group
+// ID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	kind, id, err := Decode(groupID)
+	if err != nil {
+		return nil, err
+	}
+	var record Record
+	if err := rw.do(ctx, http.MethodGet, "/sobjects/"+kinds[kind].object+"/"+id, nil, &record); err != nil {
+		return nil, fmt.Errorf("failed to get %s %s: %w", kind, id, notFound(err))
+	}
+	return &groupsync.Group{ID: groupID, Attributes: &record}, nil
+}
+
+// GetMembers retrieves the users which are members of the public group, or
+// are assigned the permission set, of the given group ID.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	assignments, err := rw.assignments(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]groupsync.Member, 0, len(assignments))
+	for _, a := range assignments {
+		members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: a.User.Username, StableID: a.User.ID, Attributes: a.User}})
+	}
+	return members, nil
+}
+
+// assignments returns the assignments of the users of the given group ID.
+func (rw *GroupReadWriter) assignments(ctx context.Context, groupID string) ([]*assignment, error) {
+	kind, id, err := Decode(groupID)
+	if err != nil {
+		return nil, err
+	}
+	// GetGroup reports missing groups, which queries return no records for.
+	if _, err := rw.GetGroup(ctx, groupID); err != nil {
+		return nil, err
+	}
+	k := kinds[kind]
+
+	records, err := query[struct {
+		ID     string `json:"Id"`
+		UserID string `json:"UserID"`
+	}](ctx, rw, fmt.Sprintf("SELECT Id, %s UserID FROM %s WHERE %s = '%s'", k.userField, k.assignment, k.groupField, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members of %s %s: %w", kind, id, err)
+	}
+	// public groups may contain groups and roles too, which are not users.
+	users, err := query[*User](ctx, rw, fmt.Sprintf("SELECT Id, Username, Email, IsActive FROM User WHERE Id IN (SELECT %s FROM %s WHERE %s = '%s')", k.userField, k.assignment, k.groupField, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users of %s %s: %w", kind, id, err)
+	}
+	usersByID := make(map[string]*User, len(users))
+	for _, u := range users {
+		usersByID[u.ID] = u
+		rw.userCache.Set(strings.ToLower(u.Username), u)
+	}
+
+	assignments := make([]*assignment, 0, len(users))
+	for _, r := range records {
+		if u, ok := usersByID[r.UserID]; ok {
+			assignments = append(assignments, &assignment{ID: r.ID, User: u})
+		}
+	}
+	return assignments, nil
+}
+
+// Descendants retrieve all users of the given group ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Salesforce user with the given username.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.user(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Username, StableID: user.ID, Attributes: user}, nil
+}
+
+// user returns the Salesforce user with the given username.
+func (rw *GroupReadWriter) user(ctx context.Context, username string) (*User, error) {
+	user, err := rw.userCache.WriteThruLookup(strings.ToLower(username), func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", username)
+		users, err := query[*User](ctx, rw, fmt.Sprintf("SELECT Id, Username, Email, IsActive FROM User WHERE Username = '%s'", escape(username)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", username, err)
+		}
+		if len(users) != 1 {
+			return nil, fmt.Errorf("no user exists with username %s", username)
+		}
+		return users[0], nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// SetMembers replaces the users of the given group ID with the given
+// members. Users which are not in the org are reported in the returned error
+// after all other changes are made.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	kind, id, err := Decode(groupID)
+	if err != nil {
+		return err
+	}
+	assignments, err := rw.assignments(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	assignmentIDs := make(map[string]string, len(assignments))
+	currentMemberIDs := make(map[string]groupsync.Member, len(assignments))
+	for _, a := range assignments {
+		username := strings.ToLower(a.User.Username)
+		assignmentIDs[username] = a.ID
+		currentMemberIDs[username] = &groupsync.UserMember{Usr: &groupsync.User{ID: a.User.Username}}
+	}
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	k := kinds[kind]
+	var merr error
+	for _, username := range utils.MapKeys(addMembers) {
+		user, err := rw.user(ctx, username)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to %s %s: %w", username, kind, id, err))
+			continue
+		}
+		body := map[string]string{k.groupField: id, k.userField: user.ID}
+		if err := rw.do(ctx, http.MethodPost, "/sobjects/"+k.assignment, body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add %s to %s %s: %w", username, kind, id, err))
+		}
+	}
+	for _, username := range utils.MapKeys(removeMembers) {
+		if err := rw.do(ctx, http.MethodDelete, "/sobjects/"+k.assignment+"/"+assignmentIDs[username], nil, nil); err != nil && !rest.IsNotFound(err) {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove %s from %s %s: %w", username, kind, id, err))
+		}
+	}
+	return merr
+}
+
+// query runs the given SOQL query and returns the records of all pages of
+// its result.
+func query[T any](ctx context.Context, rw *GroupReadWriter, soql string) ([]T, error) {
+	var records []T
+	path := "/query?" + url.Values{"q": {soql}}.Encode()
+	for {
+		var resp struct {
+			Records        []T    `json:"records"`
+			NextRecordsURL string `json:"nextRecordsUrl"`
+		}
+		if err := rw.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return nil, err
+		}
+		records = append(records, resp.Records...)
+		if resp.NextRecordsURL == "" {
+			return records, nil
+		}
+		// the next page is linked relative to the endpoint, not the API.
+		path = strings.TrimPrefix(resp.NextRecordsURL, apiPath)
+	}
+}
+
+// do sends a request to the REST API, see rest.Client.Do.
+func (rw *GroupReadWriter) do(ctx context.Context, method, path string, body, out any) error {
+	return classifyErr(rw.client.Do(ctx, method, path, body, out))
+}
+
+// classifyErr wraps errors of exceeded API request limits, which Salesforce
+// returns with status 403, with groupsync.ErrRateLimited.
+func classifyErr(err error) error {
+	var rerr *rest.Error
+	if errors.As(err, &rerr) && rerr.StatusCode == http.StatusForbidden && strings.Contains(rerr.Body, "REQUEST_LIMIT_EXCEEDED") {
+		return fmt.Errorf("%w: %w", groupsync.ErrRateLimited, err)
+	}
+	return err
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+// escape escapes a string literal of a SOQL query.
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s)
+}
+
+// toIDMap returns the given members by lowercased ID, as usernames are
+// case-insensitive.
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[strings.ToLower(m.ID())] = m
+	}
+	return memberIDs
+}
@@ -0,0 +1,297 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package salesforce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+const (
+	testGroupID         = "00G000000000001"
+	testPermissionSetID = "0PS000000000001"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		groupID  string
+		wantKind string
+		wantID   string
+		wantErr  string
+	}{
+		{
+			name:     "group",
+			groupID:  "group/00G5e000001AbCd",
+			wantKind: Group,
+			wantID:   "00G5e000001AbCd",
+		},
+		{
+			name:     "permission_set",
+			groupID:  "permissionset/0PS5e000001AbCdEAF",
+			wantKind: PermissionSet,
+			wantID:   "0PS5e000001AbCdEAF",
+		},
+		{
+			name:    "unknown_kind",
+			groupID: "role/00E5e000001AbCd",
+			wantErr: "must be of the form",
+		},
+		{
+			name:    "invalid_id",
+			groupID: "group/00G' OR Id != '",
+			wantErr: "must be of the form",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			kind, id, err := Decode(tc.groupID)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if kind != tc.wantKind || id != tc.wantID {
+				t.Errorf("Decode got (%q, %q), want (%q, %q)", kind, id, tc.wantKind, tc.wantID)
+			}
+		})
+	}
+}
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeSalesforce{
+		users: []*User{
+			{ID: "005000000000001", Username: "alice@example.com", IsActive: true},
+			{ID: "005000000000002", Username: "bob@example.com", IsActive: true},
+			{ID: "005000000000003", Username: "carol@example.com", IsActive: true},
+		},
+		assignments: map[string]map[string]string{
+			testGroupID: {
+				"011000000000001": "005000000000001",
+				"011000000000002": "005000000000002",
+				// a nested group, which is left alone.
+				"011000000000003": "00G000000000002",
+			},
+			testPermissionSetID: {"0Pa000000000001": "005000000000001"},
+		},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(ctx, srv.URL, "client", "secret")
+
+	cases := []struct {
+		name    string
+		groupID string
+		want    []string
+	}{
+		{
+			name:    "group",
+			groupID: Encode(Group, testGroupID),
+			want:    []string{"005000000000002", "005000000000003", "00G000000000002"},
+		},
+		{
+			name:    "permission_set",
+			groupID: Encode(PermissionSet, testPermissionSetID),
+			want:    []string{"005000000000002", "005000000000003"},
+		},
+	}
+
+	// the cases share the fake, and run in order.
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			users, err := rw.Descendants(ctx, tc.groupID)
+			if err != nil {
+				t.Fatalf("Descendants failed: %v", err)
+			}
+			if len(users) == 0 || users[0].ID != "alice@example.com" {
+				t.Errorf("Descendants got %v, want alice@example.com", users)
+			}
+			if err := rw.SetMembers(ctx, tc.groupID, []groupsync.Member{
+				&groupsync.UserMember{Usr: &groupsync.User{ID: "Bob@example.com"}},
+				&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+			}); err != nil {
+				t.Fatalf("SetMembers failed: %v", err)
+			}
+			_, id, _ := Decode(tc.groupID)
+			if diff := cmp.Diff(fake.assigned(id), tc.want); diff != "" {
+				t.Errorf("unexpected assignments (-got, +want):\n%s", diff)
+			}
+		})
+	}
+
+	if err := rw.SetMembers(ctx, Encode(Group, testGroupID), []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "dave@example.com"}},
+	}); err == nil || !strings.Contains(err.Error(), "cannot add dave@example.com") {
+		t.Errorf("SetMembers got err %v, want missing user dave", err)
+	}
+	if _, err := rw.GetMembers(ctx, Encode(Group, "00G000000000009")); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetMembers(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+var (
+	assignmentQuery = regexp.MustCompile(`^SELECT Id, \w+ UserID FROM \w+ WHERE \w+ = '(\w+)'$`)
+	usersQuery      = regexp.MustCompile(`^SELECT Id, Username, Email, IsActive FROM User WHERE Id IN \(SELECT \w+ FROM \w+ WHERE \w+ = '(\w+)'\)$`)
+	userQuery       = regexp.MustCompile(`^SELECT Id, Username, Email, IsActive FROM User WHERE Username = '(.*)'$`)
+)
+
+// fakeSalesforce implements the token endpoint and the parts of the REST API
+// used by GroupReadWriter, the assignments of the public groups and
+// permission sets by ID. Query results are returned one record per page.
+type fakeSalesforce struct {
+	mu          sync.Mutex
+	users       []*User
+	assignments map[string]map[string]string
+	nextID      int
+}
+
+// assigned returns the sorted IDs of the users and groups assigned the group
+// or permission set with the given ID.
+func (f *fakeSalesforce) assigned(id string) []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var ids []string
+	for _, userID := range f.assignments[id] {
+		ids = append(ids, userID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func (f *fakeSalesforce) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/services/oauth2/token" {
+		if r.FormValue("client_id") != "client" || r.FormValue("client_secret") != "secret" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"access_token": "token", "token_type": "Bearer"}) //nolint:errcheck // test server
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	notFound := func() {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `[{"errorCode": "NOT_FOUND", "message": "The requested resource does not exist"}]`)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, apiPath+"/"), "/")
+	switch {
+	case parts[0] == "query":
+		records := f.query(r.URL.Query().Get("q"))
+		offset := 0
+		if len(parts) == 2 {
+			offset, _ = strconv.Atoi(parts[1])
+		}
+		resp := map[string]any{"records": records[min(offset, len(records)):min(offset+1, len(records))]}
+		if offset+1 < len(records) {
+			resp["nextRecordsUrl"] = apiPath + "/query/" + strconv.Itoa(offset+1) + "?" + url.Values{"q": {r.URL.Query().Get("q")}}.Encode()
+		}
+		write(resp)
+	case parts[0] == "sobjects" && len(parts) == 3 && r.Method == http.MethodGet:
+		if _, ok := f.assignments[parts[2]]; !ok {
+			notFound()
+			return
+		}
+		write(&Record{ID: parts[2], Name: "Engineering"})
+	case parts[0] == "sobjects" && len(parts) == 2 && r.Method == http.MethodPost:
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		k := kinds[Group]
+		if parts[1] == "PermissionSetAssignment" {
+			k = kinds[PermissionSet]
+		}
+		f.nextID++
+		id := fmt.Sprintf("0Pa%012d", 100+f.nextID)
+		f.assignments[body[k.groupField]][id] = body[k.userField]
+		w.WriteHeader(http.StatusCreated)
+		write(map[string]any{"id": id, "success": true})
+	case parts[0] == "sobjects" && len(parts) == 3 && r.Method == http.MethodDelete:
+		for _, a := range f.assignments {
+			if _, ok := a[parts[2]]; ok {
+				delete(a, parts[2])
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		notFound()
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// query returns the records of the given SOQL query, which must be one of
+// those made by GroupReadWriter.
+func (f *fakeSalesforce) query(soql string) []any {
+	var records []any
+	switch {
+	case assignmentQuery.MatchString(soql):
+		a := f.assignments[assignmentQuery.FindStringSubmatch(soql)[1]]
+		for _, id := range slices.Sorted(maps.Keys(a)) {
+			records = append(records, map[string]string{"Id": id, "UserID": a[id]})
+		}
+	case usersQuery.MatchString(soql):
+		a := f.assignments[usersQuery.FindStringSubmatch(soql)[1]]
+		for _, u := range f.users {
+			for _, userID := range a {
+				if userID == u.ID {
+					records = append(records, u)
+				}
+			}
+		}
+	case userQuery.MatchString(soql):
+		for _, u := range f.users {
+			if strings.EqualFold(u.Username, userQuery.FindStringSubmatch(soql)[1]) {
+				records = append(records, u)
+			}
+		}
+	}
+	return records
+}
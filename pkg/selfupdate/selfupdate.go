@@ -0,0 +1,419 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package selfupdate updates tlctl to a release published on GitHub, and
+// checks whether a newer release is available.
+package selfupdate
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v61/github"
+)
+
+const (
+	// DefaultOwner and DefaultRepo are the repository tlctl is released from.
+	DefaultOwner = "abcxyz"
+	DefaultRepo  = "team-link"
+
+	// ChecksumsAsset is the asset of a release with the SHA-256 checksums of
+	// its archives, one "CHECKSUM  NAME" line per archive.
+	ChecksumsAsset = "checksums.txt"
+
+	// maxBinarySize bounds the size of the binary extracted from an archive.
+	maxBinarySize = 512 << 20
+)
+
+// Config holds the optional settings of an Updater.
+type Config struct {
+	httpClient *http.Client
+	apiURL     string
+	token      string
+	owner      string
+	repo       string
+}
+
+// Opt configures an Updater.
+type Opt func(config *Config)
+
+// WithHTTPClient sets the HTTP client, http.DefaultClient by default.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// WithAPIURL sets the URL of the GitHub API, https://api.github.com by
+// default.
+func WithAPIURL(apiURL string) Opt {
+	return func(config *Config) {
+		config.apiURL = apiURL
+	}
+}
+
+// WithToken authenticates the requests to the GitHub API with the given
+// token, which raises their rate limit.
+func WithToken(token string) Opt {
+	return func(config *Config) {
+		config.token = token
+	}
+}
+
+// WithRepository sets the repository the releases are read from,
+// abcxyz/team-link by default.
+func WithRepository(owner, repo string) Opt {
+	return func(config *Config) {
+		config.owner = owner
+		config.repo = repo
+	}
+}
+
+// Release is a release of tlctl.
+type Release struct {
+	// Version is the version of the release without a "v" prefix.
+	Version string
+	// Assets are the download URLs of the assets of the release, by name.
+	Assets map[string]string
+}
+
+// Updater reads releases from GitHub and replaces the running binary with
+// them.
+type Updater struct {
+	client     *github.Client
+	httpClient *http.Client
+	owner      string
+	repo       string
+}
+
+// NewUpdater creates an Updater.
+func NewUpdater(opts ...Opt) (*Updater, error) {
+	config := &Config{
+		httpClient: http.DefaultClient,
+		owner:      DefaultOwner,
+		repo:       DefaultRepo,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	client := github.NewClient(config.httpClient)
+	if config.token != "" {
+		client = client.WithAuthToken(config.token)
+	}
+	if config.apiURL != "" {
+		var err error
+		if client, err = client.WithEnterpriseURLs(config.apiURL, config.apiURL); err != nil {
+			return nil, fmt.Errorf("failed to create github client with endpoint %s: %w", config.apiURL, err)
+		}
+	}
+	return &Updater{
+		client:     client,
+		httpClient: config.httpClient,
+		owner:      config.owner,
+		repo:       config.repo,
+	}, nil
+}
+
+// Latest returns the latest release.
+func (u *Updater) Latest(ctx context.Context) (*Release, error) {
+	release, _, err := u.client.Repositories.GetLatestRelease(ctx, u.owner, u.repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest release of %s/%s: %w", u.owner, u.repo, err)
+	}
+	return toRelease(release), nil
+}
+
+// Get returns the release of the given version, with or without a "v"
+// prefix.
+func (u *Updater) Get(ctx context.Context, version string) (*Release, error) {
+	tag := "v" + strings.TrimPrefix(version, "v")
+	release, _, err := u.client.Repositories.GetReleaseByTag(ctx, u.owner, u.repo, tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release %s of %s/%s: %w", tag, u.owner, u.repo, err)
+	}
+	return toRelease(release), nil
+}
+
+func toRelease(release *github.RepositoryRelease) *Release {
+	r := &Release{
+		Version: strings.TrimPrefix(release.GetTagName(), "v"),
+		Assets:  make(map[string]string, len(release.Assets)),
+	}
+	for _, asset := range release.Assets {
+		r.Assets[asset.GetName()] = asset.GetBrowserDownloadURL()
+	}
+	return r
+}
+
+// ArchiveName returns the name of the release archive of the given version
+// for the given operating system and architecture: a zip file for Windows
+// and a gzipped tarball otherwise.
+func ArchiveName(version, goos, goarch string) string {
+	ext := "tar.gz"
+	if goos == "windows" {
+		ext = "zip"
+	}
+	return fmt.Sprintf("tlctl_%s_%s_%s.%s", version, goos, goarch, ext)
+}
+
+// Install downloads the archive of the release for the given operating
+// system and architecture, verifies its checksum, and replaces the binary at
+// exePath with the binary in the archive.
+func (u *Updater) Install(ctx context.Context, release *Release, goos, goarch, exePath string) error {
+	name := ArchiveName(release.Version, goos, goarch)
+	archiveURL, ok := release.Assets[name]
+	if !ok {
+		return fmt.Errorf("release %s has no archive %s for %s/%s", release.Version, name, goos, goarch)
+	}
+	checksumsURL, ok := release.Assets[ChecksumsAsset]
+	if !ok {
+		return fmt.Errorf("release %s has no %s", release.Version, ChecksumsAsset)
+	}
+
+	checksums, err := u.download(ctx, checksumsURL)
+	if err != nil {
+		return err
+	}
+	want, err := checksum(checksums, name)
+	if err != nil {
+		return err
+	}
+	archive, err := u.download(ctx, archiveURL)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(archive)
+	if got := hex.EncodeToString(sum[:]); got != want {
+		return fmt.Errorf("checksum of %s is %s, want %s", name, got, want)
+	}
+
+	binaryName := "tlctl"
+	if goos == "windows" {
+		binaryName += ".exe"
+	}
+	var binary []byte
+	if goos == "windows" {
+		binary, err = extractZip(archive, binaryName)
+	} else {
+		binary, err = extractTarGz(archive, binaryName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to extract %s from %s: %w", binaryName, name, err)
+	}
+	return replaceExecutable(exePath, binary)
+}
+
+func (u *Updater) download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: unexpected status %d", url, resp.StatusCode)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxBinarySize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", url, err)
+	}
+	return b, nil
+}
+
+// checksum returns the checksum of the named file in a checksums file.
+func checksum(checksums []byte, name string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[1] == name {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+	return "", fmt.Errorf("%s has no checksum for %s", ChecksumsAsset, name)
+}
+
+func extractTarGz(archive []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress archive: %w", err)
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("archive has no %s", name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeReg && path.Base(hdr.Name) == name {
+			b, err := io.ReadAll(io.LimitReader(tr, maxBinarySize))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", name, err)
+			}
+			return b, nil
+		}
+	}
+}
+
+func extractZip(archive []byte, name string) ([]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive: %w", err)
+	}
+	for _, f := range zr.File {
+		if path.Base(f.Name) != name || f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", name, err)
+		}
+		defer rc.Close()
+		b, err := io.ReadAll(io.LimitReader(rc, maxBinarySize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("archive has no %s", name)
+}
+
+// replaceExecutable replaces the binary at path. The binary is written next
+// to it and renamed into place, after moving the current binary aside,
+// since a running binary can be renamed but not overwritten on Windows.
+func replaceExecutable(exePath string, binary []byte) error {
+	dir := filepath.Dir(exePath)
+	tmp, err := os.CreateTemp(dir, ".tlctl-update-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(binary); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write update: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write update: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o755); err != nil { //nolint:gosec // executable
+		return fmt.Errorf("failed to make update executable: %w", err)
+	}
+
+	old := exePath + ".old"
+	_ = os.Remove(old)
+	if err := os.Rename(exePath, old); err != nil {
+		return fmt.Errorf("failed to move current binary aside: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), exePath); err != nil {
+		// restore the current binary.
+		if rerr := os.Rename(old, exePath); rerr != nil {
+			err = errors.Join(err, rerr)
+		}
+		return fmt.Errorf("failed to install update: %w", err)
+	}
+	// the running binary cannot be removed on Windows, it is removed by the
+	// next update instead.
+	_ = os.Remove(old)
+	return nil
+}
+
+// IsNewer reports whether version a is newer than version b. Versions are
+// of the form MAJOR.MINOR.PATCH with an optional "v" prefix and an optional
+// "-PRERELEASE" suffix; a prerelease is older than its release.
+func IsNewer(a, b string) bool {
+	va, pa := parseVersion(a)
+	vb, pb := parseVersion(b)
+	for i := range va {
+		if va[i] != vb[i] {
+			return va[i] > vb[i]
+		}
+	}
+	switch {
+	case pa == pb:
+		return false
+	case pa == "":
+		return true
+	case pb == "":
+		return false
+	}
+	return pa > pb
+}
+
+func parseVersion(v string) ([3]int, string) {
+	v = strings.TrimPrefix(v, "v")
+	v, pre, _ := strings.Cut(v, "-")
+	var parts [3]int
+	for i, s := range strings.SplitN(v, ".", 3) {
+		parts[i], _ = strconv.Atoi(s)
+	}
+	return parts, pre
+}
+
+// versionCheck is the cached result of CheckForUpdate.
+type versionCheck struct {
+	CheckedAt time.Time `json:"checked_at"`
+	Latest    string    `json:"latest"`
+}
+
+// CheckForUpdate returns the version of the latest release if it is newer
+// than current, or "" otherwise. The latest version is cached in cacheFile,
+// unless it is empty, for maxAge, so that frequent runs don't call the
+// GitHub API each time.
+func (u *Updater) CheckForUpdate(ctx context.Context, current, cacheFile string, maxAge time.Duration) (string, error) {
+	var check versionCheck
+	if cacheFile != "" {
+		if b, err := os.ReadFile(cacheFile); err == nil {
+			_ = json.Unmarshal(b, &check)
+		}
+	}
+	if check.Latest == "" || time.Since(check.CheckedAt) > maxAge {
+		release, err := u.Latest(ctx)
+		if err != nil {
+			return "", err
+		}
+		check = versionCheck{CheckedAt: time.Now().UTC(), Latest: release.Version}
+		if cacheFile != "" {
+			if b, err := json.Marshal(&check); err == nil {
+				if err := os.MkdirAll(filepath.Dir(cacheFile), 0o755); err == nil {
+					_ = os.WriteFile(cacheFile, b, 0o600)
+				}
+			}
+		}
+	}
+	if IsNewer(check.Latest, current) {
+		return check.Latest, nil
+	}
+	return "", nil
+}
@@ -0,0 +1,260 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package selfupdate
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestIsNewer(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "1.2.3", b: "1.2.3", want: false},
+		{a: "1.2.4", b: "1.2.3", want: true},
+		{a: "v1.10.0", b: "1.9.9", want: true},
+		{a: "1.9.9", b: "2.0.0", want: false},
+		{a: "1.2.0", b: "1.2.0-rc.1", want: true},
+		{a: "1.2.0-rc.1", b: "1.2.0", want: false},
+		{a: "1.2.0-rc.2", b: "1.2.0-rc.1", want: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s_%s", tc.a, tc.b), func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsNewer(tc.a, tc.b); got != tc.want {
+				t.Errorf("IsNewer(%q, %q) got %t, want %t", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUpdater_Install(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		goos     string
+		checksum string
+		wantErr  string
+	}{
+		{
+			name: "tarball",
+			goos: "linux",
+		},
+		{
+			name: "zip",
+			goos: "windows",
+		},
+		{
+			name:     "checksum_mismatch",
+			goos:     "linux",
+			checksum: "0000",
+			wantErr:  "checksum of tlctl_1.2.0_linux_amd64.tar.gz is",
+		},
+		{
+			name:    "missing_archive",
+			goos:    "plan9",
+			wantErr: "release 1.2.0 has no archive tlctl_1.2.0_plan9_amd64.tar.gz",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := newFakeReleases(t, tc.checksum)
+			u := srv.updater(t)
+			release, err := u.Get(context.Background(), "v1.2.0")
+			if err != nil {
+				t.Fatalf("Get failed: %v", err)
+			}
+
+			exePath := filepath.Join(t.TempDir(), "tlctl")
+			if err := os.WriteFile(exePath, []byte("old"), 0o755); err != nil { //nolint:gosec // executable
+				t.Fatal(err)
+			}
+			err = u.Install(context.Background(), release, tc.goos, "amd64", exePath)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+
+			want := "old"
+			if tc.wantErr == "" {
+				want = "new " + tc.goos
+			}
+			got, err := os.ReadFile(exePath)
+			if err != nil {
+				t.Fatalf("failed to read binary: %v", err)
+			}
+			if string(got) != want {
+				t.Errorf("binary is %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestUpdater_CheckForUpdate(t *testing.T) {
+	t.Parallel()
+
+	srv := newFakeReleases(t, "")
+	u := srv.updater(t)
+	cacheFile := filepath.Join(t.TempDir(), "team-link", "version-check.json")
+
+	for _, current := range []string{"1.1.0", "1.1.0", "1.2.0"} {
+		want := "1.2.0"
+		if current == "1.2.0" {
+			want = ""
+		}
+		got, err := u.CheckForUpdate(context.Background(), current, cacheFile, time.Hour)
+		if err != nil {
+			t.Fatalf("CheckForUpdate failed: %v", err)
+		}
+		if got != want {
+			t.Errorf("CheckForUpdate(%q) got %q, want %q", current, got, want)
+		}
+	}
+	if got := srv.latestCalls.Load(); got != 1 {
+		t.Errorf("latest release read %d times, want once", got)
+	}
+}
+
+// fakeReleases serves the release v1.2.0 with archives for linux/amd64 and
+// windows/amd64, whose binaries are "new linux" and "new windows".
+type fakeReleases struct {
+	*httptest.Server
+	latestCalls atomic.Int64
+}
+
+func newFakeReleases(tb testing.TB, checksumOverride string) *fakeReleases {
+	tb.Helper()
+
+	assets := map[string][]byte{
+		"tlctl_1.2.0_linux_amd64.tar.gz": tarGz(tb, "tlctl", "new linux"),
+		"tlctl_1.2.0_windows_amd64.zip":  zipFile(tb, "tlctl.exe", "new windows"),
+	}
+	var checksums bytes.Buffer
+	for name, b := range assets {
+		sum := sha256.Sum256(b)
+		checksum := hex.EncodeToString(sum[:])
+		if checksumOverride != "" {
+			checksum = checksumOverride
+		}
+		fmt.Fprintf(&checksums, "%s  %s\n", checksum, name)
+	}
+	assets[ChecksumsAsset] = checksums.Bytes()
+
+	f := &fakeReleases{}
+	mux := http.NewServeMux()
+	release := func(w http.ResponseWriter, r *http.Request) {
+		var list []map[string]any
+		for name := range assets {
+			list = append(list, map[string]any{"name": name, "browser_download_url": f.URL + "/download/" + name})
+		}
+		json.NewEncoder(w).Encode(map[string]any{"tag_name": "v1.2.0", "assets": list}) //nolint:errcheck // test server
+	}
+	mux.HandleFunc("GET /api/v3/repos/abcxyz/team-link/releases/latest", func(w http.ResponseWriter, r *http.Request) {
+		f.latestCalls.Add(1)
+		release(w, r)
+	})
+	mux.HandleFunc("GET /api/v3/repos/abcxyz/team-link/releases/tags/v1.2.0", release)
+	mux.HandleFunc("GET /download/{name}", func(w http.ResponseWriter, r *http.Request) {
+		b, ok := assets[r.PathValue("name")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(b) //nolint:errcheck // test server
+	})
+	f.Server = httptest.NewServer(mux)
+	tb.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeReleases) updater(tb testing.TB) *Updater {
+	tb.Helper()
+
+	u, err := NewUpdater(WithAPIURL(f.URL))
+	if err != nil {
+		tb.Fatalf("NewUpdater failed: %v", err)
+	}
+	return u
+}
+
+func tarGz(tb testing.TB, name, content string) []byte {
+	tb.Helper()
+
+	var b bytes.Buffer
+	gz := gzip.NewWriter(&b)
+	tw := tar.NewWriter(gz)
+	if err := tw.WriteHeader(&tar.Header{Name: "README.md", Mode: 0o644, Size: 1, Typeflag: tar.TypeReg}); err != nil {
+		tb.Fatal(err)
+	}
+	if _, err := tw.Write([]byte("#")); err != nil {
+		tb.Fatal(err)
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o755, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+		tb.Fatal(err)
+	}
+	if _, err := tw.Write([]byte(content)); err != nil {
+		tb.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		tb.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		tb.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+func zipFile(tb testing.TB, name, content string) []byte {
+	tb.Helper()
+
+	var b bytes.Buffer
+	zw := zip.NewWriter(&b)
+	w, err := zw.Create(name)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if _, err := w.Write([]byte(content)); err != nil {
+		tb.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		tb.Fatal(err)
+	}
+	return b.Bytes()
+}
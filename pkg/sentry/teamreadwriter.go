@@ -0,0 +1,324 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sentry provides a GroupReadWriter for the teams of Sentry
+// organizations, e.g. the teams issues are routed to.
+package sentry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultEndpoint is the URL of Sentry SaaS.
+	DefaultEndpoint = "https://sentry.io"
+
+	// DefaultCacheDuration is the default time to live for the member cache.
+	DefaultCacheDuration = time.Hour
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*TeamReadWriter)(nil)
+
+// TeamInfo is a Sentry team, see
+// https://docs.sentry.io/api/teams/retrieve-a-team/.
+type TeamInfo struct {
+	ID   string `json:"id"`
+	Slug string `json:"slug"`
+	Name string `json:"name,omitempty"`
+}
+
+// MemberInfo is a member of a Sentry organization, see
+// https://docs.sentry.io/api/organizations/list-an-organizations-members/.
+type MemberInfo struct {
+	ID      string `json:"id"`
+	Email   string `json:"email"`
+	Name    string `json:"name,omitempty"`
+	OrgRole string `json:"orgRole,omitempty"`
+	Pending bool   `json:"pending,omitempty"`
+}
+
+// organization is a Sentry organization the token can access.
+type organization struct {
+	Slug string `json:"slug"`
+}
+
+// Encode returns the group ID of the team with the given slug in the
+// organization with the given slug.
+func Encode(org, team string) string {
+	return org + "/" + team
+}
+
+// Decode returns the organization and team slugs of a group ID.
+func Decode(groupID string) (string, string, error) {
+	org, team, ok := strings.Cut(groupID, "/")
+	if !ok || org == "" || team == "" {
+		return "", "", fmt.Errorf("%w: group ID %q must be of the form ORGANIZATION/TEAM", groupsync.ErrInvalidGroupID, groupID)
+	}
+	return org, team, nil
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the member cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Sentry.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// TeamReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Sentry teams. Group IDs are of the form
+// ORGANIZATION/TEAM, using slugs, and user IDs are the emails of
+// organization members. Users must already be members of the organization to
+// be added to its teams.
+type TeamReadWriter struct {
+	client      *rest.Client
+	memberCache *cache.Cache[map[string]*MemberInfo]
+}
+
+// NewTeamReadWriter creates a TeamReadWriter for the Sentry server at the
+// given URL, e.g. DefaultEndpoint, authenticating with the given auth token.
+func NewTeamReadWriter(endpoint, authToken string, opts ...Opt) *TeamReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &TeamReadWriter{
+		client: rest.New(endpoint+"/api/0",
+			rest.WithHTTPClient(config.httpClient),
+			rest.WithHeader("Authorization", "Bearer "+authToken),
+		),
+		memberCache: cache.New[map[string]*MemberInfo](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Sentry team with the given ID.
+func (rw *TeamReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	org, team, err := Decode(groupID)
+	if err != nil {
+		return nil, err
+	}
+	var info TeamInfo
+	if err := rw.client.Do(ctx, http.MethodGet, teamPath(org, team), nil, &info); err != nil {
+		return nil, fmt.Errorf("failed to get team %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: groupID, Attributes: &info}, nil
+}
+
+// GetMembers retrieves the members of the Sentry team with the given ID.
+// Sentry teams cannot contain teams, so all members are users.
+func (rw *TeamReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	org, team, err := Decode(groupID)
+	if err != nil {
+		return nil, err
+	}
+	var members []*MemberInfo
+	if err := listAll(ctx, rw.client, teamPath(org, team)+"members/", &members); err != nil {
+		return nil, fmt.Errorf("failed to get members of team %s: %w", groupID, notFound(err))
+	}
+	res := make([]groupsync.Member, 0, len(members))
+	for _, m := range members {
+		res = append(res, &groupsync.UserMember{Usr: &groupsync.User{ID: m.Email, Attributes: m}})
+	}
+	return res, nil
+}
+
+// Descendants retrieve all users of the Sentry team with the given ID.
+func (rw *TeamReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the member with the given email from the organizations
+// the auth token can access.
+func (rw *TeamReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	var orgs []*organization
+	if err := listAll(ctx, rw.client, "/organizations/", &orgs); err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	for _, org := range orgs {
+		members, err := rw.orgMembers(ctx, org.Slug)
+		if err != nil {
+			return nil, fmt.Errorf("could not get user: %w", err)
+		}
+		if m, ok := members[strings.ToLower(userID)]; ok {
+			return &groupsync.User{ID: m.Email, Attributes: m}, nil
+		}
+	}
+	return nil, fmt.Errorf("user %s is not a member of any sentry organization", userID)
+}
+
+// SetMembers replaces the members of the Sentry team with the given ID with
+// the given members. Team members which are not in the given members are
+// removed, and given members which are not in the team are added. Members
+// which are not in the organization cannot be added and are reported in the
+// returned error, after all other changes are made.
+func (rw *TeamReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	org, team, err := Decode(groupID)
+	if err != nil {
+		return err
+	}
+	currentMembers, err := rw.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	orgMembers, err := rw.orgMembers(ctx, org)
+	if err != nil {
+		return err
+	}
+	var merr error
+	for _, id := range utils.MapKeys(addMembers) {
+		m, ok := orgMembers[strings.ToLower(id)]
+		if !ok {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to team %s: not a member of organization %s", id, groupID, org))
+			continue
+		}
+		if err := rw.client.Do(ctx, http.MethodPost, memberTeamPath(org, m.ID, team), nil, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add member %s to team %s: %w", id, groupID, err))
+		}
+	}
+	for _, id := range utils.MapKeys(removeMembers) {
+		m, ok := memberInfo(removeMembers[id])
+		if !ok {
+			merr = errors.Join(merr, fmt.Errorf("cannot remove %s from team %s: unknown member", id, groupID))
+			continue
+		}
+		if err := rw.client.Do(ctx, http.MethodDelete, memberTeamPath(org, m.ID, team), nil, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove member %s from team %s: %w", id, groupID, err))
+		}
+	}
+	return merr
+}
+
+// orgMembers returns the members of the given organization by lowercased
+// email.
+func (rw *TeamReadWriter) orgMembers(ctx context.Context, org string) (map[string]*MemberInfo, error) {
+	members, err := rw.memberCache.WriteThruLookup(org, func() (map[string]*MemberInfo, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching organization members", "organization", org)
+		var list []*MemberInfo
+		if err := listAll(ctx, rw.client, "/organizations/"+url.PathEscape(org)+"/members/", &list); err != nil {
+			return nil, fmt.Errorf("failed to list members of organization %s: %w", org, err)
+		}
+		members := make(map[string]*MemberInfo, len(list))
+		for _, m := range list {
+			members[strings.ToLower(m.Email)] = m
+		}
+		return members, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get organization members: %w", err)
+	}
+	return members, nil
+}
+
+// listAll appends the items of all pages of the list at the given path to out.
+func listAll[T any](ctx context.Context, client *rest.Client, path string, out *[]T) error {
+	for path != "" {
+		var page []T
+		header, err := client.DoWithHeader(ctx, http.MethodGet, path, nil, &page)
+		if err != nil {
+			return err //nolint:wrapcheck // Want passthrough
+		}
+		*out = append(*out, page...)
+		path = rest.NextLink(header)
+	}
+	return nil
+}
+
+// memberInfo returns the MemberInfo of a member returned by GetMembers.
+func memberInfo(m groupsync.Member) (*MemberInfo, bool) {
+	user, err := m.User()
+	if err != nil {
+		return nil, false
+	}
+	info, ok := user.Attributes.(*MemberInfo)
+	return info, ok
+}
+
+func teamPath(org, team string) string {
+	return "/teams/" + url.PathEscape(org) + "/" + url.PathEscape(team) + "/"
+}
+
+func memberTeamPath(org, memberID, team string) string {
+	return "/organizations/" + url.PathEscape(org) + "/members/" + url.PathEscape(memberID) + "/teams/" + url.PathEscape(team) + "/"
+}
+
+// notFound wraps errors of missing teams with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+// toIDMap returns the given members by lowercased ID, as Sentry emails are
+// case-insensitive.
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[strings.ToLower(m.ID())] = m
+	}
+	return memberIDs
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sentry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestTeamReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeSentry{
+		members: []*MemberInfo{
+			{ID: "1", Email: "alice@example.com"},
+			{ID: "2", Email: "Bob@example.com"},
+			{ID: "3", Email: "carol@example.com"},
+		},
+		teams: map[string][]string{"backend": {"1", "2"}},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	fake.url = srv.URL
+	rw := NewTeamReadWriter(srv.URL, "token")
+
+	users, err := rw.Descendants(ctx, "acme/backend")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"Bob@example.com", "alice@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "acme/backend", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "dave@example.com"}},
+	})
+	if diff := testutil.DiffErrString(err, "cannot add dave@example.com to team acme/backend: not a member of organization acme"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+	if diff := cmp.Diff(fake.teams["backend"], []string{"2", "3"}); diff != "" {
+		t.Errorf("unexpected team members (-got, +want):\n%s", diff)
+	}
+
+	user, err := rw.GetUser(ctx, "carol@example.com")
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if got, want := user.ID, "carol@example.com"; got != want {
+		t.Errorf("GetUser got %q, want %q", got, want)
+	}
+
+	if _, err := rw.GetGroup(ctx, "acme/missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(acme/missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+	if _, err := rw.GetGroup(ctx, "backend"); err == nil {
+		t.Errorf("GetGroup(backend) got no error for a group ID without organization")
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeSentry implements the parts of the Sentry API used by TeamReadWriter
+// for the single organization acme. Lists return one item per page.
+type fakeSentry struct {
+	mu      sync.Mutex
+	url     string
+	members []*MemberInfo
+	teams   map[string][]string
+}
+
+func (f *fakeSentry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/0/"), "/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "organizations":
+		writePage(w, r, f.url, []*organization{{Slug: "acme"}})
+	case len(parts) == 3 && parts[0] == "organizations" && parts[2] == "members":
+		writePage(w, r, f.url, f.members)
+	case len(parts) == 6 && parts[0] == "organizations" && parts[4] == "teams":
+		team, ok := f.teams[parts[5]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		team = slices.DeleteFunc(team, func(id string) bool { return id == parts[3] })
+		if r.Method == http.MethodPost {
+			team = append(team, parts[3])
+		}
+		slices.Sort(team)
+		f.teams[parts[5]] = team
+		w.WriteHeader(http.StatusNoContent)
+	case len(parts) >= 3 && parts[0] == "teams":
+		team, ok := f.teams[parts[2]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if len(parts) == 3 {
+			json.NewEncoder(w).Encode(&TeamInfo{ID: "10", Slug: parts[2]}) //nolint:errcheck // test server
+			return
+		}
+		var members []*MemberInfo
+		for _, m := range f.members {
+			if slices.Contains(team, m.ID) {
+				members = append(members, m)
+			}
+		}
+		writePage(w, r, f.url, members)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// writePage writes the item at the cursor of the request, linking to the
+// next page like Sentry does.
+func writePage[T any](w http.ResponseWriter, r *http.Request, base string, items []T) {
+	cursor, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+	hasNext := cursor+1 < len(items)
+	w.Header().Set("Link", fmt.Sprintf(`<%s%s?cursor=%d>; rel="next"; results="%t"; cursor="%d"`, base, r.URL.Path, cursor+1, hasNext, cursor+1))
+	var page []T
+	if cursor < len(items) {
+		page = items[cursor : cursor+1]
+	}
+	json.NewEncoder(w).Encode(page) //nolint:errcheck // test server
+}
@@ -0,0 +1,284 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package servicenow provides a GroupReadWriter for ServiceNow user groups,
+// so that ITSM assignment groups stay consistent with team groups.
+package servicenow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of records requested per page.
+	pageSize = 1000
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Group is a sys_user_group record.
+type Group struct {
+	SysID string `json:"sys_id"`
+	Name  string `json:"name"`
+}
+
+// User is a sys_user record.
+type User struct {
+	SysID    string `json:"sys_id"`
+	UserName string `json:"user_name"`
+	Email    string `json:"email,omitempty"`
+	Active   string `json:"active,omitempty"`
+}
+
+// membership is a sys_user_grmember record with the fields of its user.
+type membership struct {
+	SysID        string `json:"sys_id"`
+	UserSysID    string `json:"user.sys_id"`
+	UserUserName string `json:"user.user_name"`
+	UserEmail    string `json:"user.email"`
+}
+
+// result is the envelope of Table API responses.
+type result[T any] struct {
+	Result T `json:"result"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call ServiceNow.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the sys_user_grmember records of ServiceNow groups through the
+// Table API. Group IDs are the sys_ids of sys_user_group records and user IDs
+// are the user_name of sys_user records.
+type GroupReadWriter struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the ServiceNow instance at
+// the given URL, authenticating with the user name and password of an
+// integration user.
+func NewGroupReadWriter(endpoint, username, password string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &GroupReadWriter{
+		client: rest.New(endpoint+"/api/now/table",
+			rest.WithHTTPClient(config.httpClient),
+			rest.WithBasicAuth(username, password),
+		),
+		userCache: cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the ServiceNow group with the given sys_id.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	var resp result[*Group]
+	if err := rw.client.Do(ctx, http.MethodGet, "/sys_user_group/"+url.PathEscape(groupID)+"?sysparm_fields=sys_id,name", nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: groupID, Attributes: resp.Result}, nil
+}
+
+// GetMembers retrieves the members of the ServiceNow group with the given
+// sys_id.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	memberships, err := rw.memberships(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return toMembers(memberships), nil
+}
+
+// Descendants retrieve all users of the ServiceNow group with the given
+// sys_id.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the ServiceNow user with the given user name.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.user(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.UserName, Attributes: user}, nil
+}
+
+// user returns the sys_user record with the given user name.
+func (rw *GroupReadWriter) user(ctx context.Context, userName string) (*User, error) {
+	user, err := rw.userCache.WriteThruLookup(userName, func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userName)
+		q := url.Values{
+			"sysparm_query":  {"user_name=" + userName},
+			"sysparm_fields": {"sys_id,user_name,email,active"},
+			"sysparm_limit":  {"1"},
+		}
+		var resp result[[]*User]
+		if err := rw.client.Do(ctx, http.MethodGet, "/sys_user?"+q.Encode(), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", userName, err)
+		}
+		if len(resp.Result) == 0 {
+			return nil, fmt.Errorf("user %s not found", userName)
+		}
+		return resp.Result[0], nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// SetMembers replaces the members of the ServiceNow group with the given
+// sys_id with the given members, inserting and deleting sys_user_grmember
+// records. Members which cannot be added, e.g. unknown users, are reported in
+// the returned error after all other changes are made.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	memberships, err := rw.memberships(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	membershipIDs := make(map[string]string, len(memberships))
+	currentMemberIDs := make(map[string]groupsync.Member, len(memberships))
+	for i, m := range toMembers(memberships) {
+		currentMemberIDs[m.ID()] = m
+		membershipIDs[m.ID()] = memberships[i].SysID
+	}
+	newMemberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		newMemberIDs[m.ID()] = m
+	}
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	var merr error
+	for _, userName := range utils.MapKeys(addMembers) {
+		user, err := rw.user(ctx, userName)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to group %s: %w", userName, groupID, err))
+			continue
+		}
+		body := map[string]string{"group": groupID, "user": user.SysID}
+		if err := rw.client.Do(ctx, http.MethodPost, "/sys_user_grmember", body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add %s to group %s: %w", userName, groupID, err))
+		}
+	}
+	for _, userName := range utils.MapKeys(removeMembers) {
+		path := "/sys_user_grmember/" + url.PathEscape(membershipIDs[userName])
+		if err := rw.client.Do(ctx, http.MethodDelete, path, nil, nil); err != nil && !rest.IsNotFound(err) {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove %s from group %s: %w", userName, groupID, err))
+		}
+	}
+	return merr
+}
+
+// memberships returns the sys_user_grmember records of the group with the
+// given sys_id.
+func (rw *GroupReadWriter) memberships(ctx context.Context, groupID string) ([]*membership, error) {
+	// the Table API returns an empty list for unknown groups.
+	if _, err := rw.GetGroup(ctx, groupID); err != nil {
+		return nil, err
+	}
+	var memberships []*membership
+	for offset := 0; ; offset += pageSize {
+		q := url.Values{
+			"sysparm_query":  {"group=" + groupID + "^ORDERBYsys_id"},
+			"sysparm_fields": {"sys_id,user.sys_id,user.user_name,user.email"},
+			"sysparm_limit":  {strconv.Itoa(pageSize)},
+			"sysparm_offset": {strconv.Itoa(offset)},
+		}
+		var resp result[[]*membership]
+		if err := rw.client.Do(ctx, http.MethodGet, "/sys_user_grmember?"+q.Encode(), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get members of group %s: %w", groupID, err)
+		}
+		memberships = append(memberships, resp.Result...)
+		if len(resp.Result) < pageSize {
+			return memberships, nil
+		}
+	}
+}
+
+// toMembers returns the users of the given memberships as members.
+func toMembers(memberships []*membership) []groupsync.Member {
+	members := make([]groupsync.Member, 0, len(memberships))
+	for _, m := range memberships {
+		members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: m.UserUserName, Attributes: m}})
+	}
+	return members
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
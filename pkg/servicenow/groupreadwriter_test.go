@@ -0,0 +1,152 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package servicenow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeServiceNow{
+		users: []*User{
+			{SysID: "u1", UserName: "alice"},
+			{SysID: "u2", UserName: "bob"},
+			{SysID: "u3", UserName: "carol"},
+		},
+		members: map[string]string{"m1": "u1", "m2": "u2"},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(srv.URL, "team-link", "secret")
+
+	users, err := rw.Descendants(ctx, "g1")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice", "bob"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "g1", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "erin"}},
+	})
+	if diff := testutil.DiffErrString(err, "cannot add erin to group g1: user erin not found"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+	users, err = rw.Descendants(ctx, "g1")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"bob", "carol"}); diff != "" {
+		t.Errorf("unexpected descendants after SetMembers (-got, +want):\n%s", diff)
+	}
+
+	if _, err := rw.GetMembers(ctx, "g2"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetMembers(g2) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeServiceNow implements the parts of the Table API used by
+// GroupReadWriter for the single group g1. members maps the sys_ids of
+// sys_user_grmember records to the sys_ids of their users.
+type fakeServiceNow struct {
+	mu      sync.Mutex
+	users   []*User
+	members map[string]string
+	nextID  int
+}
+
+func (f *fakeServiceNow) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if user, pass, _ := r.BasicAuth(); user != "team-link" || pass != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(map[string]any{"result": v}) //nolint:errcheck // test server
+	}
+	table, id, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/api/now/table/"), "/")
+	query := r.URL.Query().Get("sysparm_query")
+
+	switch {
+	case table == "sys_user_group" && id == "g1":
+		write(&Group{SysID: "g1", Name: "Network Operations"})
+	case table == "sys_user":
+		var users []*User
+		for _, u := range f.users {
+			if query == "user_name="+u.UserName {
+				users = append(users, u)
+			}
+		}
+		write(users)
+	case table == "sys_user_grmember" && r.Method == http.MethodGet:
+		var records []map[string]string
+		if strings.HasPrefix(query, "group=g1^") && r.URL.Query().Get("sysparm_offset") == "0" {
+			for _, memberID := range slices.Sorted(maps.Keys(f.members)) {
+				for _, u := range f.users {
+					if u.SysID == f.members[memberID] {
+						records = append(records, map[string]string{"sys_id": memberID, "user.sys_id": u.SysID, "user.user_name": u.UserName})
+					}
+				}
+			}
+		}
+		write(records)
+	case table == "sys_user_grmember" && r.Method == http.MethodPost:
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["group"] != "g1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.nextID++
+		f.members[fmt.Sprintf("new%d", f.nextID)] = body["user"]
+		w.WriteHeader(http.StatusCreated)
+	case table == "sys_user_grmember" && r.Method == http.MethodDelete:
+		delete(f.members, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package siem
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	cefVendor  = "abcxyz"
+	cefProduct = "team-link"
+	cefVersion = "1"
+)
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`)
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
+
+// formatCEF formats an event in the ArcSight Common Event Format. The target
+// group is the custom string cs1, the system cs2, and the role and previous
+// role cs3 and cs4.
+func formatCEF(e *Event) string {
+	var ext []string
+	add := func(key, value string) {
+		if value != "" {
+			ext = append(ext, key+"="+cefExtensionEscaper.Replace(value))
+		}
+	}
+	add("rt", fmt.Sprint(e.Time.UnixMilli()))
+	add("externalId", e.ID)
+	add("act", string(e.Action))
+	add("duser", e.MemberID)
+	if e.MemberType != "" {
+		add("cs5Label", "memberType")
+		add("cs5", e.MemberType)
+	}
+	if e.GroupID != "" {
+		add("cs1Label", "group")
+		add("cs1", e.GroupID)
+	}
+	if e.System != "" {
+		add("cs2Label", "system")
+		add("cs2", e.System)
+	}
+	if e.Role != "" {
+		add("cs3Label", "role")
+		add("cs3", e.Role)
+	}
+	if e.PreviousRole != "" {
+		add("cs4Label", "previousRole")
+		add("cs4", e.PreviousRole)
+	}
+	msg := e.Message
+	if fields := e.sortedFields(); len(fields) > 0 {
+		msg += " " + strings.Join(fields, " ")
+	}
+	add("msg", msg)
+	if e.Kind == KindMembershipChange {
+		add("outcome", "success")
+	}
+
+	name := e.Message
+	if e.Kind == KindMembershipChange {
+		name = "Group membership " + string(e.Action)
+	}
+	return fmt.Sprintf("CEF:0|%s|%s|%s|%s|%s|%d|%s",
+		cefVendor, cefProduct, cefVersion,
+		cefHeaderEscaper.Replace(e.signature()),
+		cefHeaderEscaper.Replace(name),
+		e.Severity,
+		strings.Join(ext, " "))
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package siem
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ecsVersion is the version of the Elastic Common Schema of the events.
+const ecsVersion = "8.11.0"
+
+type ecsEvent struct {
+	Timestamp time.Time         `json:"@timestamp"`
+	ECS       ecsVersionField   `json:"ecs"`
+	Message   string            `json:"message,omitempty"`
+	Event     ecsEventField     `json:"event"`
+	Group     *ecsGroup         `json:"group,omitempty"`
+	User      *ecsUser          `json:"user,omitempty"`
+	Observer  ecsObserver       `json:"observer"`
+	Labels    map[string]string `json:"labels,omitempty"`
+}
+
+type ecsVersionField struct {
+	Version string `json:"version"`
+}
+
+type ecsEventField struct {
+	ID       string   `json:"id,omitempty"`
+	Kind     string   `json:"kind"`
+	Category []string `json:"category"`
+	Type     []string `json:"type"`
+	Action   string   `json:"action"`
+	Outcome  string   `json:"outcome,omitempty"`
+	Severity int      `json:"severity"`
+	Provider string   `json:"provider"`
+	Dataset  string   `json:"dataset"`
+}
+
+type ecsGroup struct {
+	ID string `json:"id"`
+}
+
+type ecsUser struct {
+	Target ecsTargetUser `json:"target"`
+}
+
+type ecsTargetUser struct {
+	Name  string   `json:"name"`
+	Roles []string `json:"roles,omitempty"`
+}
+
+type ecsObserver struct {
+	Vendor  string `json:"vendor"`
+	Product string `json:"product"`
+}
+
+// ecsActions are the event.action values of membership changes.
+var ecsActions = map[string]string{
+	"add":    "group-member-added",
+	"remove": "group-member-removed",
+	"role":   "group-member-role-changed",
+}
+
+// formatECS formats an event as JSON following the Elastic Common Schema.
+// The member of a membership change is the target user; a member group, the
+// previous role and the other fields of a policy violation are labels.
+func formatECS(e *Event) ([]byte, error) {
+	out := &ecsEvent{
+		Timestamp: e.Time.UTC(),
+		ECS:       ecsVersionField{Version: ecsVersion},
+		Message:   e.Message,
+		Event: ecsEventField{
+			ID:       e.ID,
+			Kind:     "event",
+			Category: []string{"iam"},
+			Type:     []string{"group", "change"},
+			Action:   ecsActions[string(e.Action)],
+			Outcome:  "success",
+			Severity: e.Severity,
+			Provider: cefProduct,
+			Dataset:  "team_link.membership",
+		},
+		Observer: ecsObserver{Vendor: cefVendor, Product: cefProduct},
+		Labels:   make(map[string]string),
+	}
+	if e.Kind == KindPolicyViolation {
+		out.Event.Kind = "alert"
+		out.Event.Type = []string{"info"}
+		out.Event.Action = "policy-violation"
+		out.Event.Outcome = ""
+		out.Event.Dataset = "team_link.policy"
+	}
+	if e.GroupID != "" {
+		out.Group = &ecsGroup{ID: e.GroupID}
+	}
+	switch {
+	case e.MemberType == "group":
+		out.Labels["member_group_id"] = e.MemberID
+	case e.MemberID != "":
+		out.User = &ecsUser{Target: ecsTargetUser{Name: e.MemberID}}
+		if e.Role != "" {
+			out.User.Target.Roles = []string{e.Role}
+		}
+	}
+	if e.System != "" {
+		out.Labels["system"] = e.System
+	}
+	if e.PreviousRole != "" {
+		out.Labels["previous_role"] = e.PreviousRole
+	}
+	for k, v := range e.Fields {
+		out.Labels[k] = v
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal security event: %w", err)
+	}
+	return b, nil
+}
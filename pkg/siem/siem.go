@@ -0,0 +1,288 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package siem writes the membership changes and policy violations of
+// team-link as security events which SIEMs ingest without transformation:
+// CEF for Splunk, ArcSight or Chronicle, and ECS JSON for Elastic.
+package siem
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// Format is the format of security events.
+type Format string
+
+const (
+	// FormatCEF is the ArcSight Common Event Format.
+	FormatCEF Format = "cef"
+	// FormatECS is JSON following the Elastic Common Schema.
+	FormatECS Format = "ecs"
+)
+
+// Formats are the supported formats.
+var Formats = []Format{FormatCEF, FormatECS}
+
+// ParseFormat parses the name of a Format.
+func ParseFormat(s string) (Format, error) {
+	for _, f := range Formats {
+		if string(f) == s {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unknown security event format %q, must be one of %q", s, Formats)
+}
+
+// EventKind is the kind of a security event.
+type EventKind string
+
+const (
+	// KindMembershipChange is a membership change applied to a target group.
+	KindMembershipChange EventKind = "membership_change"
+	// KindPolicyViolation is a policy violation or another condition logged
+	// as an alert, e.g. a role escalation.
+	KindPolicyViolation EventKind = "policy_violation"
+)
+
+// Severities of events, on the 0-10 scale of CEF.
+const (
+	severityChange     = 3
+	severityRoleChange = 5
+	severityAlert      = 7
+	severityHigh       = 8
+)
+
+// Event is a security event.
+type Event struct {
+	Kind EventKind
+	// ID identifies the event, empty if it has no ID.
+	ID       string
+	Time     time.Time
+	Severity int
+	Message  string
+	// Action is the groupsync.ChangeAction of a membership change, empty for
+	// policy violations.
+	Action       groupsync.ChangeAction
+	System       string
+	GroupID      string
+	MemberType   string
+	MemberID     string
+	Role         string
+	PreviousRole string
+	// Fields are the other attributes of a policy violation.
+	Fields map[string]string
+}
+
+// Writer writes security events to an io.Writer, one per line. It is a
+// groupsync.ChangeSink for membership changes, and its Handler turns alerts
+// logged by team-link into policy violation events.
+type Writer struct {
+	mu     sync.Mutex
+	w      io.Writer
+	format Format
+}
+
+// Ensure we conform to the interface.
+var _ groupsync.ChangeSink = (*Writer)(nil)
+
+// NewWriter creates a Writer writing events in the given format to w.
+func NewWriter(w io.Writer, format Format) *Writer {
+	return &Writer{w: w, format: format}
+}
+
+// Write writes the given event.
+func (w *Writer) Write(e *Event) error {
+	var line []byte
+	switch w.format {
+	case FormatCEF:
+		line = []byte(formatCEF(e))
+	case FormatECS:
+		b, err := formatECS(e)
+		if err != nil {
+			return err
+		}
+		line = b
+	default:
+		return fmt.Errorf("unknown security event format %q", w.format)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, err := w.w.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write security event: %w", err)
+	}
+	return nil
+}
+
+// Publish writes a membership change event for each of the given changes.
+func (w *Writer) Publish(ctx context.Context, events []*groupsync.ChangeEvent) error {
+	for _, e := range events {
+		if err := w.Write(changeEvent(e)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// changeEvent returns the security event of a membership change.
+func changeEvent(e *groupsync.ChangeEvent) *Event {
+	event := &Event{
+		Kind:         KindMembershipChange,
+		ID:           e.ID,
+		Time:         e.Time,
+		Severity:     severityChange,
+		Action:       e.Action,
+		System:       e.System,
+		GroupID:      e.GroupID,
+		MemberType:   e.MemberType,
+		MemberID:     e.MemberID,
+		Role:         e.Role,
+		PreviousRole: e.PreviousRole,
+	}
+	switch e.Action {
+	case groupsync.ChangeAdd:
+		event.Message = fmt.Sprintf("%s %s added to %s group %s", e.MemberType, e.MemberID, e.System, e.GroupID)
+	case groupsync.ChangeRemove:
+		event.Message = fmt.Sprintf("%s %s removed from %s group %s", e.MemberType, e.MemberID, e.System, e.GroupID)
+	case groupsync.ChangeRole:
+		event.Severity = severityRoleChange
+		event.Message = fmt.Sprintf("role of %s %s in %s group %s changed from %q to %q",
+			e.MemberType, e.MemberID, e.System, e.GroupID, e.PreviousRole, e.Role)
+	}
+	if e.Role != "" && e.Action == groupsync.ChangeAdd {
+		event.Message += " as " + e.Role
+	}
+	return event
+}
+
+// Handler returns a slog.Handler which passes records to next and also
+// writes the warnings and errors logged as alerts, with an "alert" attribute set to true
+// or a "severity" attribute of HIGH, as policy violation events. Failing to
+// write an event does not fail logging.
+func (w *Writer) Handler(next slog.Handler) slog.Handler {
+	return &alertHandler{next: next, w: w}
+}
+
+type alertHandler struct {
+	next  slog.Handler
+	w     *Writer
+	attrs []slog.Attr
+}
+
+func (h *alertHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	// alerts are written regardless of the log level.
+	return level >= slog.LevelWarn || h.next.Enabled(ctx, level)
+}
+
+func (h *alertHandler) Handle(ctx context.Context, r slog.Record) error {
+	attrs := make([]slog.Attr, 0, len(h.attrs)+r.NumAttrs())
+	attrs = append(attrs, h.attrs...)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	if e := violationEvent(r, attrs); e != nil {
+		h.w.Write(e) //nolint:errcheck // must not fail logging
+	}
+	if !h.next.Enabled(ctx, r.Level) {
+		return nil
+	}
+	return h.next.Handle(ctx, r) //nolint:wrapcheck // Want passthrough
+}
+
+func (h *alertHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	c := *h
+	c.attrs = append(append([]slog.Attr(nil), h.attrs...), attrs...)
+	c.next = h.next.WithAttrs(attrs)
+	return &c
+}
+
+func (h *alertHandler) WithGroup(name string) slog.Handler {
+	c := *h
+	c.next = h.next.WithGroup(name)
+	return &c
+}
+
+// violationEvent returns the policy violation event of a record, or nil if
+// the record is not an alert.
+func violationEvent(r slog.Record, attrs []slog.Attr) *Event {
+	e := &Event{
+		Kind:    KindPolicyViolation,
+		Time:    r.Time,
+		Message: r.Message,
+		Fields:  make(map[string]string),
+	}
+	alert := false
+	for _, a := range attrs {
+		v := a.Value.Resolve()
+		switch a.Key {
+		case "alert":
+			alert = alert || (v.Kind() == slog.KindBool && v.Bool())
+			continue
+		case "severity":
+			if strings.EqualFold(v.String(), "HIGH") {
+				alert = true
+				e.Severity = severityHigh
+			}
+			continue
+		case "group_id", "target_group_id":
+			e.GroupID = v.String()
+			continue
+		case "user_id":
+			e.MemberType, e.MemberID = "user", v.String()
+			continue
+		case "to_role":
+			e.Role = v.String()
+			continue
+		case "from_role":
+			e.PreviousRole = v.String()
+			continue
+		}
+		e.Fields[a.Key] = v.String()
+	}
+	if !alert {
+		return nil
+	}
+	if e.Severity == 0 {
+		e.Severity = severityAlert
+	}
+	return e
+}
+
+// signature returns the ID of the type of an event.
+func (e *Event) signature() string {
+	if e.Kind == KindPolicyViolation {
+		return "policy-violation"
+	}
+	return "membership-" + string(e.Action)
+}
+
+// sortedFields returns the fields of an event as sorted key=value pairs.
+func (e *Event) sortedFields() []string {
+	fields := make([]string, 0, len(e.Fields))
+	for k, v := range e.Fields {
+		fields = append(fields, k+"="+strconv.Quote(v))
+	}
+	slices.Sort(fields)
+	return fields
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package siem
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+var testTime = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+var testChanges = []*groupsync.ChangeEvent{
+	{
+		SchemaVersion: groupsync.ChangeEventSchemaVersion,
+		ID:            "e1",
+		Time:          testTime,
+		System:        "GITHUB",
+		GroupID:       "1:2",
+		Action:        groupsync.ChangeAdd,
+		MemberType:    "user",
+		MemberID:      "alice",
+		Role:          "maintainer",
+	},
+	{
+		SchemaVersion: groupsync.ChangeEventSchemaVersion,
+		ID:            "e2",
+		Time:          testTime,
+		System:        "GITHUB",
+		GroupID:       "1:2",
+		Action:        groupsync.ChangeRole,
+		MemberType:    "user",
+		MemberID:      "bob",
+		Role:          "maintainer",
+		PreviousRole:  "member",
+	},
+	{
+		SchemaVersion: groupsync.ChangeEventSchemaVersion,
+		ID:            "e3",
+		Time:          testTime,
+		System:        "GITHUB",
+		GroupID:       "1:2",
+		Action:        groupsync.ChangeRemove,
+		MemberType:    "group",
+		MemberID:      "1:3",
+	},
+}
+
+func TestWriter_Publish(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		format Format
+		want   string
+	}{
+		{
+			name:   "cef",
+			format: FormatCEF,
+			want: `CEF:0|abcxyz|team-link|1|membership-add|Group membership add|3|rt=1767225600000 externalId=e1 act=add duser=alice cs5Label=memberType cs5=user cs1Label=group cs1=1:2 cs2Label=system cs2=GITHUB cs3Label=role cs3=maintainer msg=user alice added to GITHUB group 1:2 as maintainer outcome=success
+CEF:0|abcxyz|team-link|1|membership-role|Group membership role|5|rt=1767225600000 externalId=e2 act=role duser=bob cs5Label=memberType cs5=user cs1Label=group cs1=1:2 cs2Label=system cs2=GITHUB cs3Label=role cs3=maintainer cs4Label=previousRole cs4=member msg=role of user bob in GITHUB group 1:2 changed from "member" to "maintainer" outcome=success
+CEF:0|abcxyz|team-link|1|membership-remove|Group membership remove|3|rt=1767225600000 externalId=e3 act=remove duser=1:3 cs5Label=memberType cs5=group cs1Label=group cs1=1:2 cs2Label=system cs2=GITHUB msg=group 1:3 removed from GITHUB group 1:2 outcome=success
+`,
+		},
+		{
+			name:   "ecs",
+			format: FormatECS,
+			want: `{"@timestamp":"2026-01-01T00:00:00Z","ecs":{"version":"8.11.0"},"message":"user alice added to GITHUB group 1:2 as maintainer","event":{"id":"e1","kind":"event","category":["iam"],"type":["group","change"],"action":"group-member-added","outcome":"success","severity":3,"provider":"team-link","dataset":"team_link.membership"},"group":{"id":"1:2"},"user":{"target":{"name":"alice","roles":["maintainer"]}},"observer":{"vendor":"abcxyz","product":"team-link"},"labels":{"system":"GITHUB"}}
+{"@timestamp":"2026-01-01T00:00:00Z","ecs":{"version":"8.11.0"},"message":"role of user bob in GITHUB group 1:2 changed from \"member\" to \"maintainer\"","event":{"id":"e2","kind":"event","category":["iam"],"type":["group","change"],"action":"group-member-role-changed","outcome":"success","severity":5,"provider":"team-link","dataset":"team_link.membership"},"group":{"id":"1:2"},"user":{"target":{"name":"bob","roles":["maintainer"]}},"observer":{"vendor":"abcxyz","product":"team-link"},"labels":{"previous_role":"member","system":"GITHUB"}}
+{"@timestamp":"2026-01-01T00:00:00Z","ecs":{"version":"8.11.0"},"message":"group 1:3 removed from GITHUB group 1:2","event":{"id":"e3","kind":"event","category":["iam"],"type":["group","change"],"action":"group-member-removed","outcome":"success","severity":3,"provider":"team-link","dataset":"team_link.membership"},"group":{"id":"1:2"},"observer":{"vendor":"abcxyz","product":"team-link"},"labels":{"member_group_id":"1:3","system":"GITHUB"}}
+`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b bytes.Buffer
+			if err := NewWriter(&b, tc.format).Publish(context.Background(), testChanges); err != nil {
+				t.Fatalf("Publish failed: %v", err)
+			}
+			if diff := cmp.Diff(b.String(), tc.want); diff != "" {
+				t.Errorf("unexpected events (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWriter_Handler(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		format Format
+		want   string
+	}{
+		{
+			name:   "cef",
+			format: FormatCEF,
+			want: `CEF:0|abcxyz|team-link|1|policy-violation|role escalation|8|rt=1767225600000 duser=alice cs5Label=memberType cs5=user cs1Label=group cs1=1:2 cs3Label=role cs3=admin cs4Label=previousRole cs4=member msg=role escalation applied\="false" policy\="require_approval"
+CEF:0|abcxyz|team-link|1|policy-violation|target group is no longer mapped|7|rt=1767225600000 cs1Label=group cs1=1:9 msg=target group is no longer mapped run\="nightly"
+`,
+		},
+		{
+			name:   "ecs",
+			format: FormatECS,
+			want: `{"@timestamp":"2026-01-01T00:00:00Z","ecs":{"version":"8.11.0"},"message":"role escalation","event":{"kind":"alert","category":["iam"],"type":["info"],"action":"policy-violation","severity":8,"provider":"team-link","dataset":"team_link.policy"},"group":{"id":"1:2"},"user":{"target":{"name":"alice","roles":["admin"]}},"observer":{"vendor":"abcxyz","product":"team-link"},"labels":{"applied":"false","policy":"require_approval","previous_role":"member"}}
+{"@timestamp":"2026-01-01T00:00:00Z","ecs":{"version":"8.11.0"},"message":"target group is no longer mapped","event":{"kind":"alert","category":["iam"],"type":["info"],"action":"policy-violation","severity":7,"provider":"team-link","dataset":"team_link.policy"},"group":{"id":"1:9"},"observer":{"vendor":"abcxyz","product":"team-link"},"labels":{"run":"nightly"}}
+`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var b bytes.Buffer
+			handler := NewWriter(&b, tc.format).Handler(slog.NewTextHandler(io.Discard, nil))
+			log := func(logger *slog.Logger, level slog.Level, msg string, args ...any) {
+				r := slog.NewRecord(testTime, level, msg, 0)
+				r.Add(args...)
+				if err := logger.Handler().Handle(ctx, r); err != nil {
+					t.Fatalf("Handle failed: %v", err)
+				}
+			}
+			logger := slog.New(handler)
+			log(logger, slog.LevelWarn, "role escalation",
+				"severity", "HIGH", "group_id", "1:2", "user_id", "alice",
+				"from_role", "member", "to_role", "admin", "policy", "require_approval", "applied", false)
+			log(logger.With("run", "nightly"), slog.LevelWarn, "target group is no longer mapped",
+				"alert", true, "target_group_id", "1:9")
+			log(logger, slog.LevelWarn, "members to remove", "group_id", "1:2")
+
+			if diff := cmp.Diff(b.String(), tc.want); diff != "" {
+				t.Errorf("unexpected events (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	if got, err := ParseFormat("ecs"); err != nil || got != FormatECS {
+		t.Errorf("ParseFormat(ecs) got (%q, %v), want (%q, nil)", got, err, FormatECS)
+	}
+	_, err := ParseFormat("leef")
+	if diff := testutil.DiffErrString(err, `unknown security event format "leef"`); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulation records what a sync run reads from its group systems into
+// a fixture, and replays fixtures offline, e.g. to see what a mapping change
+// would have done against last night's data without calling any API.
+package simulation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// ErrNotRecorded denotes that a replayed read was not recorded.
+var ErrNotRecorded = errors.New("no recorded response")
+
+// Fixture holds the recordings of the source and target systems of a run.
+type Fixture struct {
+	RecordedAt time.Time  `json:"recorded_at"`
+	Source     *Recording `json:"source"`
+	Target     *Recording `json:"target"`
+}
+
+// NewFixture creates an empty Fixture recorded at the given time.
+func NewFixture(recordedAt time.Time) *Fixture {
+	return &Fixture{
+		RecordedAt: recordedAt.UTC(),
+		Source:     NewRecording(),
+		Target:     NewRecording(),
+	}
+}
+
+// ReadFixture reads a Fixture written by Fixture.Write.
+func ReadFixture(path string) (*Fixture, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+	fixture := NewFixture(time.Time{})
+	if err := json.Unmarshal(b, fixture); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture %s: %w", path, err)
+	}
+	return fixture, nil
+}
+
+// Write writes the fixture as JSON to the given file.
+func (f *Fixture) Write(path string) error {
+	f.Source.mu.Lock()
+	defer f.Source.mu.Unlock()
+	f.Target.mu.Lock()
+	defer f.Target.mu.Unlock()
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal fixture: %w", err)
+	}
+	if err := os.WriteFile(path, append(b, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+	return nil
+}
+
+// Recording holds the responses of the reads of a group system, keyed by
+// group or user ID. Only IDs and roles are recorded, not the attributes of
+// users and groups.
+type Recording struct {
+	mu          sync.Mutex
+	Descendants map[string]*Response `json:"descendants"`
+	Members     map[string]*Response `json:"members"`
+	Groups      map[string]*Response `json:"groups"`
+	Users       map[string]*Response `json:"users"`
+}
+
+// NewRecording creates an empty Recording.
+func NewRecording() *Recording {
+	return &Recording{
+		Descendants: make(map[string]*Response),
+		Members:     make(map[string]*Response),
+		Groups:      make(map[string]*Response),
+		Users:       make(map[string]*Response),
+	}
+}
+
+// Response is a recorded response: the returned members, if any, or the
+// returned error.
+type Response struct {
+	Members  []*RecordedMember `json:"members,omitempty"`
+	Err      string            `json:"error,omitempty"`
+	NotFound bool              `json:"not_found,omitempty"`
+}
+
+// RecordedMember is a recorded user or group member.
+type RecordedMember struct {
+	ID    string `json:"id"`
+	Group bool   `json:"group,omitempty"`
+	Role  string `json:"role,omitempty"`
+}
+
+// put records the response of a read.
+func (r *Recording) put(responses map[string]*Response, id string, members []groupsync.Member, err error) {
+	resp := &Response{}
+	if err != nil {
+		resp.Err = err.Error()
+		resp.NotFound = errors.Is(err, groupsync.ErrGroupNotFound)
+	}
+	for _, m := range members {
+		resp.Members = append(resp.Members, &RecordedMember{
+			ID:    m.ID(),
+			Group: m.IsGroup(),
+			Role:  groupsync.MemberRole(m),
+		})
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	responses[id] = resp
+}
+
+// get returns the recorded members of a read, or the recorded error.
+func (r *Recording) get(responses map[string]*Response, method, id string) ([]groupsync.Member, error) {
+	r.mu.Lock()
+	resp, ok := responses[id]
+	r.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("%w for %s(%s)", ErrNotRecorded, method, id)
+	}
+	if resp.NotFound {
+		return nil, fmt.Errorf("%w: %s", groupsync.ErrGroupNotFound, resp.Err)
+	}
+	if resp.Err != "" {
+		return nil, fmt.Errorf("recorded error: %s", resp.Err)
+	}
+	members := make([]groupsync.Member, 0, len(resp.Members))
+	for _, m := range resp.Members {
+		if m.Group {
+			members = append(members, &groupsync.GroupMember{Grp: &groupsync.Group{ID: m.ID}})
+		} else {
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: m.ID}, Role: m.Role})
+		}
+	}
+	return members, nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*Recorder)(nil)
+
+// Recorder wraps a GroupReader and records the responses of its reads into
+// a Recording. Writes are passed through to the wrapped reader if it is a
+// groupsync.GroupWriter.
+type Recorder struct {
+	reader    groupsync.GroupReader
+	recording *Recording
+}
+
+// NewRecorder creates a Recorder recording the reads of reader into
+// recording.
+func NewRecorder(reader groupsync.GroupReader, recording *Recording) *Recorder {
+	return &Recorder{reader: reader, recording: recording}
+}
+
+// Descendants retrieves and records the descendants of the given group.
+func (r *Recorder) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	users, err := r.reader.Descendants(ctx, groupID)
+	members := make([]groupsync.Member, 0, len(users))
+	for _, u := range users {
+		members = append(members, &groupsync.UserMember{Usr: u})
+	}
+	r.recording.put(r.recording.Descendants, groupID, members, err)
+	return users, err //nolint:wrapcheck // Want passthrough
+}
+
+// GetGroup retrieves and records the given group.
+func (r *Recorder) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	group, err := r.reader.GetGroup(ctx, groupID)
+	var members []groupsync.Member
+	if group != nil {
+		members = append(members, &groupsync.GroupMember{Grp: group})
+	}
+	r.recording.put(r.recording.Groups, groupID, members, err)
+	return group, err //nolint:wrapcheck // Want passthrough
+}
+
+// GetMembers retrieves and records the members of the given group.
+func (r *Recorder) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	members, err := r.reader.GetMembers(ctx, groupID)
+	r.recording.put(r.recording.Members, groupID, members, err)
+	return members, err //nolint:wrapcheck // Want passthrough
+}
+
+// GetUser retrieves and records the given user.
+func (r *Recorder) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := r.reader.GetUser(ctx, userID)
+	var members []groupsync.Member
+	if user != nil {
+		members = append(members, &groupsync.UserMember{Usr: user})
+	}
+	r.recording.put(r.recording.Users, userID, members, err)
+	return user, err //nolint:wrapcheck // Want passthrough
+}
+
+// SetMembers sets the members of the given group with the wrapped reader, if
+// it is a groupsync.GroupWriter.
+func (r *Recorder) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	writer, ok := r.reader.(groupsync.GroupWriter)
+	if !ok {
+		return fmt.Errorf("recorded group reader cannot set members of group %s", groupID)
+	}
+	return writer.SetMembers(ctx, groupID, members) //nolint:wrapcheck // Want passthrough
+}
+
+// CheckWritePermissions checks the permissions of the wrapped reader, if it
+// is a groupsync.PermissionChecker.
+func (r *Recorder) CheckWritePermissions(ctx context.Context, groupIDs []string) error {
+	if checker, ok := r.reader.(groupsync.PermissionChecker); ok {
+		return checker.CheckWritePermissions(ctx, groupIDs) //nolint:wrapcheck // Want passthrough
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*Replayer)(nil)
+
+// Change is a change of the members of a group made during a replay.
+type Change struct {
+	GroupID string
+	Add     []string
+	Remove  []string
+}
+
+// Replayer adheres to the groupsync.GroupReadWriter interface and serves
+// reads from a Recording. Reads which were not recorded fail with
+// ErrNotRecorded. Writes are applied to the members served by later reads
+// and collected as Changes, never sent anywhere.
+type Replayer struct {
+	recording *Recording
+
+	mu      sync.Mutex
+	members map[string][]groupsync.Member
+	changes []*Change
+}
+
+// NewReplayer creates a Replayer serving reads from recording.
+func NewReplayer(recording *Recording) *Replayer {
+	return &Replayer{
+		recording: recording,
+		members:   make(map[string][]groupsync.Member),
+	}
+}
+
+// Descendants returns the recorded descendants of the given group.
+func (r *Replayer) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	members, err := r.recording.get(r.recording.Descendants, "Descendants", groupID)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*groupsync.User, 0, len(members))
+	for _, m := range members {
+		user, err := m.User()
+		if err != nil {
+			return nil, fmt.Errorf("invalid recorded descendant: %w", err)
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+// GetGroup returns the recorded group.
+func (r *Replayer) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	if _, err := r.recording.get(r.recording.Groups, "GetGroup", groupID); err != nil {
+		return nil, err
+	}
+	return &groupsync.Group{ID: groupID}, nil
+}
+
+// GetMembers returns the recorded members of the given group, or the members
+// it was last set to during the replay.
+func (r *Replayer) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	r.mu.Lock()
+	members, ok := r.members[groupID]
+	r.mu.Unlock()
+	if ok {
+		return slices.Clone(members), nil
+	}
+	return r.recording.get(r.recording.Members, "GetMembers", groupID)
+}
+
+// GetUser returns the recorded user.
+func (r *Replayer) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	if _, err := r.recording.get(r.recording.Users, "GetUser", userID); err != nil {
+		return nil, err
+	}
+	return &groupsync.User{ID: userID}, nil
+}
+
+// SetMembers records the change of the members of the given group from its
+// current members, and serves the given members to later reads.
+func (r *Replayer) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	current, err := r.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentIDs := toIDMap(current)
+	newIDs := toIDMap(members)
+	change := &Change{
+		GroupID: groupID,
+		Add:     utils.MapKeys(sets.SubtractMapKeys(newIDs, currentIDs)),
+		Remove:  utils.MapKeys(sets.SubtractMapKeys(currentIDs, newIDs)),
+	}
+	slices.Sort(change.Add)
+	slices.Sort(change.Remove)
+
+	logging.FromContext(ctx).InfoContext(ctx, "simulated setting members",
+		"group_id", groupID,
+		"add_member_ids", change.Add,
+		"remove_member_ids", change.Remove,
+	)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.members[groupID] = slices.Clone(members)
+	if len(change.Add) > 0 || len(change.Remove) > 0 {
+		r.changes = append(r.changes, change)
+	}
+	return nil
+}
+
+// Changes returns the changes made during the replay, sorted by group ID.
+func (r *Replayer) Changes() []*Change {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	changes := slices.Clone(r.changes)
+	slices.SortStableFunc(changes, func(a, b *Change) int {
+		return strings.Compare(a.GroupID, b.GroupID)
+	})
+	return changes
+}
+
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[m.ID()] = m
+	}
+	return memberIDs
+}
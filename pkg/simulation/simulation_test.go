@@ -0,0 +1,142 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	reader := groupsync.NewMemoryGroupReadWriter()
+	reader.AddGroup(&groupsync.Group{ID: "eng"},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "alice"}, Role: "maintainer"},
+		&groupsync.GroupMember{Grp: &groupsync.Group{ID: "sre"}},
+	)
+	reader.AddGroup(&groupsync.Group{ID: "sre"}, &groupsync.UserMember{Usr: &groupsync.User{ID: "bob"}})
+
+	fixture := NewFixture(time.Date(2026, 10, 1, 2, 0, 0, 0, time.UTC))
+	recorder := NewRecorder(reader, fixture.Source)
+	if _, err := recorder.Descendants(ctx, "eng"); err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if _, err := recorder.GetMembers(ctx, "eng"); err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if _, err := recorder.GetUser(ctx, "alice"); err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if _, err := recorder.GetGroup(ctx, "missing"); err == nil {
+		t.Fatalf("GetGroup(missing) got no error")
+	}
+
+	path := filepath.Join(t.TempDir(), "fixture.json")
+	if err := fixture.Write(path); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+	fixture, err := ReadFixture(path)
+	if err != nil {
+		t.Fatalf("ReadFixture failed: %v", err)
+	}
+	replayer := NewReplayer(fixture.Source)
+
+	users, err := replayer.Descendants(ctx, "eng")
+	if err != nil {
+		t.Fatalf("replayed Descendants failed: %v", err)
+	}
+	var got []string
+	for _, u := range users {
+		got = append(got, u.ID)
+	}
+	if diff := cmp.Diff(got, []string{"alice", "bob"}); diff != "" {
+		t.Errorf("unexpected replayed descendants (-got, +want):\n%s", diff)
+	}
+
+	members, err := replayer.GetMembers(ctx, "eng")
+	if err != nil {
+		t.Fatalf("replayed GetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(memberStrings(members), []string{"alice:false:maintainer", "sre:true:"}); diff != "" {
+		t.Errorf("unexpected replayed members (-got, +want):\n%s", diff)
+	}
+
+	if _, err := replayer.GetUser(ctx, "alice"); err != nil {
+		t.Errorf("replayed GetUser failed: %v", err)
+	}
+	if _, err := replayer.GetGroup(ctx, "missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("replayed GetGroup(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+	_, err = replayer.GetMembers(ctx, "sre")
+	if diff := testutil.DiffErrString(err, "no recorded response for GetMembers(sre)"); diff != "" {
+		t.Errorf("unexpected err: %s", diff)
+	}
+}
+
+func TestReplayer_SetMembers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	recording := NewRecording()
+	recording.Members["team"] = &Response{Members: []*RecordedMember{{ID: "alice"}, {ID: "bob"}}}
+	recording.Members["other"] = &Response{Members: []*RecordedMember{{ID: "carol"}}}
+	replayer := NewReplayer(recording)
+
+	if err := replayer.SetMembers(ctx, "team", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "dave"}},
+	}); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	if err := replayer.SetMembers(ctx, "other", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol"}},
+	}); err != nil {
+		t.Fatalf("SetMembers failed: %v", err)
+	}
+	if err := replayer.SetMembers(ctx, "missing", nil); err == nil {
+		t.Errorf("SetMembers(missing) got no error")
+	}
+
+	want := []*Change{{GroupID: "team", Add: []string{"dave"}, Remove: []string{"alice"}}}
+	if diff := cmp.Diff(replayer.Changes(), want); diff != "" {
+		t.Errorf("unexpected changes (-got, +want):\n%s", diff)
+	}
+	members, err := replayer.GetMembers(ctx, "team")
+	if err != nil {
+		t.Fatalf("GetMembers failed: %v", err)
+	}
+	if diff := cmp.Diff(memberStrings(members), []string{"bob:false:", "dave:false:"}); diff != "" {
+		t.Errorf("unexpected members after SetMembers (-got, +want):\n%s", diff)
+	}
+}
+
+func memberStrings(members []groupsync.Member) []string {
+	got := make([]string, 0, len(members))
+	for _, m := range members {
+		got = append(got, fmt.Sprintf("%s:%t:%s", m.ID(), m.IsGroup(), groupsync.MemberRole(m)))
+	}
+	return got
+}
@@ -0,0 +1,290 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package splunk provides a GroupReadWriter for Splunk roles, which assigns
+// the roles of Splunk users based on the groups they are in.
+package splunk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+// DefaultCacheDuration is the default time to live for the user cache.
+const DefaultCacheDuration = time.Hour * 24
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Role is the content of a Splunk role entry.
+type Role struct {
+	Name          string   `json:"-"`
+	ImportedRoles []string `json:"imported_roles,omitempty"`
+	Capabilities  []string `json:"capabilities,omitempty"`
+}
+
+// User is the content of a Splunk user entry.
+type User struct {
+	Name     string   `json:"-"`
+	RealName string   `json:"realname,omitempty"`
+	Email    string   `json:"email,omitempty"`
+	Roles    []string `json:"roles"`
+}
+
+// entry is an entry of a Splunk REST API feed.
+type entry[T any] struct {
+	Name    string `json:"name"`
+	Content T      `json:"content"`
+}
+
+// feed is the JSON envelope of Splunk REST API responses.
+type feed[T any] struct {
+	Entry []*entry[T] `json:"entry"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Splunk.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the roles assigned to Splunk users. Group IDs are role names and
+// the members of a role are the users it is assigned to. User IDs are Splunk
+// usernames.
+type GroupReadWriter struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Splunk management
+// endpoint at the given URL, e.g. https://splunk.example.com:8089,
+// authenticating with a Splunk authentication token of a user with the
+// edit_user capability.
+func NewGroupReadWriter(endpoint, token string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &GroupReadWriter{
+		client: rest.New(endpoint+"/services",
+			rest.WithHTTPClient(config.httpClient),
+			rest.WithHeader("Authorization", "Bearer "+token),
+		),
+		userCache: cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Splunk role with the given name.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	var resp feed[*Role]
+	if err := rw.client.Do(ctx, http.MethodGet, "/authorization/roles/"+url.PathEscape(groupID)+"?output_mode=json", nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get role %s: %w", groupID, notFound(err))
+	}
+	if len(resp.Entry) == 0 {
+		return nil, fmt.Errorf("%w: role %s", groupsync.ErrGroupNotFound, groupID)
+	}
+	role := resp.Entry[0].Content
+	role.Name = resp.Entry[0].Name
+	return &groupsync.Group{ID: role.Name, Attributes: role}, nil
+}
+
+// GetMembers retrieves the users the Splunk role with the given name is
+// assigned to. Users inheriting the role through an imported role are not
+// members.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	if _, err := rw.GetGroup(ctx, groupID); err != nil {
+		return nil, err
+	}
+	users, err := rw.users(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var members []groupsync.Member
+	for _, u := range users {
+		if slices.Contains(u.Roles, groupID) {
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: u.Name, Attributes: u}})
+		}
+	}
+	return members, nil
+}
+
+// Descendants retrieve all users the Splunk role with the given name is
+// assigned to.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Splunk user with the given username.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.userCache.WriteThruLookup(userID, func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", userID)
+		return rw.user(ctx, userID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Name, Attributes: user}, nil
+}
+
+// SetMembers replaces the users the Splunk role with the given name is
+// assigned to with the given members. Since Splunk replaces all roles of a
+// user at once, the current roles of each changed user are read before they
+// are updated. Users whose only role is the given one keep it, as Splunk
+// requires every user to have a role; they are reported in the returned error
+// after all other changes are made.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	currentMembers, err := rw.GetMembers(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	var merr error
+	for _, userName := range utils.MapKeys(addMembers) {
+		if err := rw.updateRoles(ctx, userName, func(roles []string) ([]string, error) {
+			return append(roles, groupID), nil
+		}); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to assign role %s to %s: %w", groupID, userName, err))
+		}
+	}
+	for _, userName := range utils.MapKeys(removeMembers) {
+		if err := rw.updateRoles(ctx, userName, func(roles []string) ([]string, error) {
+			roles = slices.DeleteFunc(roles, func(r string) bool { return r == groupID })
+			if len(roles) == 0 {
+				return nil, fmt.Errorf("role %s is the only role of the user", groupID)
+			}
+			return roles, nil
+		}); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to unassign role %s from %s: %w", groupID, userName, err))
+		}
+	}
+	return merr
+}
+
+// updateRoles replaces the roles of the user with the given username with the
+// result of update applied to its current roles.
+func (rw *GroupReadWriter) updateRoles(ctx context.Context, userName string, update func([]string) ([]string, error)) error {
+	user, err := rw.user(ctx, userName)
+	if err != nil {
+		return err
+	}
+	roles, err := update(slices.Clone(user.Roles))
+	if err != nil {
+		return err
+	}
+	path := "/authentication/users/" + url.PathEscape(userName) + "?output_mode=json"
+	if err := rw.client.Do(ctx, http.MethodPost, path, url.Values{"roles": roles}, nil); err != nil {
+		return fmt.Errorf("failed to update roles: %w", err)
+	}
+	user.Roles = roles
+	rw.userCache.Set(userName, user)
+	return nil
+}
+
+// user fetches the Splunk user with the given username, bypassing the cache
+// so that its current roles are returned.
+func (rw *GroupReadWriter) user(ctx context.Context, userName string) (*User, error) {
+	var resp feed[*User]
+	if err := rw.client.Do(ctx, http.MethodGet, "/authentication/users/"+url.PathEscape(userName)+"?output_mode=json", nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to fetch user %s: %w", userName, err)
+	}
+	if len(resp.Entry) == 0 {
+		return nil, fmt.Errorf("user %s not found", userName)
+	}
+	user := resp.Entry[0].Content
+	user.Name = resp.Entry[0].Name
+	return user, nil
+}
+
+// users returns all Splunk users.
+func (rw *GroupReadWriter) users(ctx context.Context) ([]*User, error) {
+	var resp feed[*User]
+	if err := rw.client.Do(ctx, http.MethodGet, "/authentication/users?output_mode=json&count=0", nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	users := make([]*User, 0, len(resp.Entry))
+	for _, e := range resp.Entry {
+		e.Content.Name = e.Name
+		users = append(users, e.Content)
+	}
+	return users, nil
+}
+
+// notFound wraps errors of missing roles with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[m.ID()] = m
+	}
+	return memberIDs
+}
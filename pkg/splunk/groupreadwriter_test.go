@@ -0,0 +1,155 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package splunk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeSplunk{
+		roles: []string{"user", "power", "admin"},
+		users: map[string][]string{
+			"alice": {"user", "power"},
+			"bob":   {"power"},
+			"carol": {"user"},
+		},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(srv.URL, "token")
+
+	users, err := rw.Descendants(ctx, "power")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice", "bob"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	// bob only has the power role, so it cannot be removed.
+	err = rw.SetMembers(ctx, "power", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol"}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "only role") {
+		t.Errorf("SetMembers got err %v, want only role error", err)
+	}
+	want := map[string][]string{
+		"alice": {"user"},
+		"bob":   {"power"},
+		"carol": {"user", "power"},
+	}
+	if diff := cmp.Diff(fake.users, want); diff != "" {
+		t.Errorf("unexpected user roles (-got, +want):\n%s", diff)
+	}
+
+	user, err := rw.GetUser(ctx, "carol")
+	if err != nil {
+		t.Fatalf("GetUser failed: %v", err)
+	}
+	if diff := cmp.Diff(user.Attributes, &User{Name: "carol", Roles: []string{"user", "power"}}); diff != "" {
+		t.Errorf("unexpected user (-got, +want):\n%s", diff)
+	}
+
+	if _, err := rw.GetGroup(ctx, "missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeSplunk implements the parts of the Splunk REST API used by
+// GroupReadWriter.
+type fakeSplunk struct {
+	mu    sync.Mutex
+	roles []string
+	users map[string][]string
+}
+
+func (f *fakeSplunk) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.URL.Query().Get("output_mode") != "json" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	userEntry := func(name string) map[string]any {
+		return map[string]any{"name": name, "content": map[string]any{"roles": f.users[name]}}
+	}
+	write := func(entries ...map[string]any) {
+		json.NewEncoder(w).Encode(map[string]any{"entry": entries}) //nolint:errcheck // test server
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/services")
+	switch {
+	case strings.HasPrefix(path, "/authorization/roles/"):
+		role := strings.TrimPrefix(path, "/authorization/roles/")
+		if !slices.Contains(f.roles, role) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		write(map[string]any{"name": role, "content": map[string]any{}})
+	case path == "/authentication/users":
+		var entries []map[string]any
+		for _, name := range slices.Sorted(maps.Keys(f.users)) {
+			entries = append(entries, userEntry(name))
+		}
+		write(entries...)
+	case strings.HasPrefix(path, "/authentication/users/"):
+		name := strings.TrimPrefix(path, "/authentication/users/")
+		if _, ok := f.users[name]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPost {
+			if err := r.ParseForm(); err != nil || len(r.PostForm["roles"]) == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f.users[name] = r.PostForm["roles"]
+		}
+		write(userEntry(name))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
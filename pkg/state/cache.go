@@ -0,0 +1,96 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/abcxyz/pkg/logging"
+)
+
+// Cache caches values in a Store, so that they are shared by the team-link
+// processes using the same store, e.g. a RedisStore. Values are stored as
+// JSON under the given key prefix, created by Key, and expire after the TTL.
+type Cache[T any] struct {
+	store  Store
+	prefix string
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+type cacheEntry[T any] struct {
+	Value     T         `json:"value"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// NewCache creates a Cache keeping values under the given prefix of the store
+// for ttl.
+func NewCache[T any](store Store, prefix string, ttl time.Duration) *Cache[T] {
+	return &Cache[T]{
+		store:  store,
+		prefix: prefix,
+		ttl:    ttl,
+		now:    time.Now,
+	}
+}
+
+// Lookup returns the cached value of key, if it exists and has not expired.
+// A cache that cannot be read is treated as empty, so lookups fall back to
+// their source.
+func (c *Cache[T]) Lookup(ctx context.Context, key string) (T, bool) {
+	var entry cacheEntry[T]
+	if err := GetJSON(ctx, c.store, c.key(key), &entry); err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			logging.FromContext(ctx).WarnContext(ctx, "failed to read shared cache",
+				"key", key,
+				"error", err,
+			)
+		}
+		var zero T
+		return zero, false
+	}
+	if !c.now().Before(entry.ExpiresAt) {
+		var zero T
+		return zero, false
+	}
+	return entry.Value, true
+}
+
+// Set caches the value of key. Stores which implement Expirer delete the
+// entry once it expires.
+func (c *Cache[T]) Set(ctx context.Context, key string, value T) error {
+	b, err := json.Marshal(&cacheEntry[T]{Value: value, ExpiresAt: c.now().Add(c.ttl)})
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache entry %s: %w", key, err)
+	}
+	if e, ok := c.store.(Expirer); ok {
+		err = e.PutWithTTL(ctx, c.key(key), b, c.ttl)
+	} else {
+		err = c.store.Put(ctx, c.key(key), b)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write cache entry %s: %w", key, err)
+	}
+	return nil
+}
+
+// key returns the store key of the cache key.
+func (c *Cache[T]) key(key string) string {
+	return c.prefix + "/" + Key(key)
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	cache := NewCache[int](store, Key("cache", "test"), time.Hour)
+	cache.now = func() time.Time { return now }
+
+	if _, ok := cache.Lookup(ctx, "a/b"); ok {
+		t.Errorf("Lookup of missing key found a value")
+	}
+	if err := cache.Set(ctx, "a/b", 42); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if got, ok := cache.Lookup(ctx, "a/b"); !ok || got != 42 {
+		t.Errorf("Lookup got (%d, %t), want (42, true)", got, ok)
+	}
+	if keys, _ := store.List(ctx, ""); len(keys) != 1 || keys[0] != "cache/test/a%2Fb" {
+		t.Errorf("store keys got %q, want [cache/test/a%%2Fb]", keys)
+	}
+
+	// a corrupt entry is a miss.
+	if err := store.Put(ctx, "cache/test/c", []byte("{")); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if _, ok := cache.Lookup(ctx, "c"); ok {
+		t.Errorf("Lookup of corrupt entry found a value")
+	}
+
+	now = now.Add(time.Hour)
+	if _, ok := cache.Lookup(ctx, "a/b"); ok {
+		t.Errorf("Lookup of expired key found a value")
+	}
+}
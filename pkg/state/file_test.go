@@ -0,0 +1,75 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFileStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+
+	if _, err := store.Get(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(missing) got err %v, want %v", err, ErrNotFound)
+	}
+
+	keys := []string{Key("deadletter", "target", "groups/99"), Key("deadletter", "source", "1"), "history/1"}
+	for _, key := range keys {
+		if err := store.Put(ctx, key, []byte(key)); err != nil {
+			t.Fatalf("Put(%s) failed: %v", key, err)
+		}
+	}
+	for _, key := range keys {
+		got, err := store.Get(ctx, key)
+		if err != nil {
+			t.Fatalf("Get(%s) failed: %v", key, err)
+		}
+		if string(got) != key {
+			t.Errorf("Get(%s) got %q, want %q", key, got, key)
+		}
+	}
+
+	got, err := store.List(ctx, "deadletter/")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	want := []string{"deadletter/source/1", "deadletter/target/groups%2F99"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected keys (-got, +want):\n%s", diff)
+	}
+	if got, want := KeyBase(got[1]), "groups/99"; got != want {
+		t.Errorf("KeyBase got %q, want %q", got, want)
+	}
+
+	if err := store.Delete(ctx, "history/1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := store.Delete(ctx, "history/1"); err != nil {
+		t.Errorf("Delete of missing key failed: %v", err)
+	}
+	if _, err := store.Get(ctx, "history/1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete got err %v, want %v", err, ErrNotFound)
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/api/firestore/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+// Ensure we conform to the interfaces.
+var (
+	_ Store  = (*FirestoreStore)(nil)
+	_ Locker = (*FirestoreStore)(nil)
+)
+
+const (
+	// DefaultFirestoreDatabase is the database used unless configured otherwise.
+	DefaultFirestoreDatabase = "(default)"
+
+	// Fields of the documents of a FirestoreStore. A TTL policy on the
+	// expireAtField deletes expired documents.
+	keyField      = "key"
+	valueField    = "value"
+	expireAtField = "expire_at"
+
+	lockKeyPrefix = "locks"
+)
+
+// FirestoreConfig holds the optional settings of a FirestoreStore.
+type FirestoreConfig struct {
+	database      string
+	ttl           time.Duration
+	clientOptions []option.ClientOption
+}
+
+// FirestoreOpt configures a FirestoreStore.
+type FirestoreOpt func(config *FirestoreConfig)
+
+// WithFirestoreDatabase sets the Firestore database, DefaultFirestoreDatabase
+// by default.
+func WithFirestoreDatabase(database string) FirestoreOpt {
+	return func(config *FirestoreConfig) {
+		config.database = database
+	}
+}
+
+// WithFirestoreTTL makes entries expire the given duration after they were
+// last written. Expired entries are treated as missing, and are deleted by
+// Firestore if a TTL policy is configured on the "expire_at" field of the
+// collection.
+func WithFirestoreTTL(ttl time.Duration) FirestoreOpt {
+	return func(config *FirestoreConfig) {
+		config.ttl = ttl
+	}
+}
+
+// WithFirestoreClientOptions sets the options of the Firestore API client,
+// e.g. the credentials or the endpoint.
+func WithFirestoreClientOptions(opts ...option.ClientOption) FirestoreOpt {
+	return func(config *FirestoreConfig) {
+		config.clientOptions = append(config.clientOptions, opts...)
+	}
+}
+
+// FirestoreStore is a Store that keeps state in a Firestore collection, for
+// deployments where several team-link processes share state. Each key is a
+// document holding the key, the value and, optionally, its expiry.
+type FirestoreStore struct {
+	documents  *firestore.ProjectsDatabasesDocumentsService
+	parent     string
+	collection string
+	ttl        time.Duration
+	now        func() time.Time
+}
+
+// NewFirestoreStore creates a FirestoreStore using the given collection of
+// the given project.
+func NewFirestoreStore(ctx context.Context, project, collection string, opts ...FirestoreOpt) (*FirestoreStore, error) {
+	config := &FirestoreConfig{database: DefaultFirestoreDatabase}
+	for _, opt := range opts {
+		opt(config)
+	}
+	svc, err := firestore.NewService(ctx, config.clientOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create firestore client: %w", err)
+	}
+	return &FirestoreStore{
+		documents:  svc.Projects.Databases.Documents,
+		parent:     fmt.Sprintf("projects/%s/databases/%s/documents", project, config.database),
+		collection: collection,
+		ttl:        config.ttl,
+		now:        time.Now,
+	}, nil
+}
+
+// Get returns the value stored under key, or ErrNotFound.
+func (s *FirestoreStore) Get(ctx context.Context, key string) ([]byte, error) {
+	doc, err := s.documents.Get(s.docName(key)).Context(ctx).Do()
+	if err != nil {
+		if isNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get firestore document: %w", err)
+	}
+	if s.expired(doc) {
+		return nil, ErrNotFound
+	}
+	value, err := base64.StdEncoding.DecodeString(doc.Fields[valueField].BytesValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode firestore document value: %w", err)
+	}
+	return value, nil
+}
+
+// Put stores value under key, replacing any existing value.
+func (s *FirestoreStore) Put(ctx context.Context, key string, value []byte) error {
+	doc := &firestore.Document{
+		Fields: map[string]firestore.Value{
+			keyField:   {StringValue: key},
+			valueField: {BytesValue: base64.StdEncoding.EncodeToString(value)},
+		},
+	}
+	if s.ttl > 0 {
+		doc.Fields[expireAtField] = timestampValue(s.now().Add(s.ttl))
+	}
+	if _, err := s.documents.Patch(s.docName(key), doc).Context(ctx).Do(); err != nil {
+		return fmt.Errorf("failed to write firestore document: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the value stored under key.
+func (s *FirestoreStore) Delete(ctx context.Context, key string) error {
+	if _, err := s.documents.Delete(s.docName(key)).Context(ctx).Do(); err != nil && !isNotFound(err) {
+		return fmt.Errorf("failed to delete firestore document: %w", err)
+	}
+	return nil
+}
+
+// List returns the keys with the given prefix in lexical order. Only the keys
+// and expiries of the documents are read, but the whole collection is listed,
+// so collections should not be shared with other data.
+func (s *FirestoreStore) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	err := s.documents.List(s.parent, s.collection).
+		MaskFieldPaths(keyField, expireAtField).
+		Pages(ctx, func(resp *firestore.ListDocumentsResponse) error {
+			for _, doc := range resp.Documents {
+				key := doc.Fields[keyField].StringValue
+				if strings.HasPrefix(key, prefix) && !strings.HasPrefix(key, lockKeyPrefix+"/") && !s.expired(doc) {
+					keys = append(keys, key)
+				}
+			}
+			return nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list firestore documents: %w", err)
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+// Lock acquires the named lock, or returns ErrLocked if it is held. A lock is
+// a document which is created only if it does not exist, or replaced only if
+// it is unchanged since it was read and expired.
+func (s *FirestoreStore) Lock(ctx context.Context, name string, ttl time.Duration) (func(ctx context.Context) error, error) {
+	lockKey := Key(lockKeyPrefix, name)
+	docName := s.docName(lockKey)
+	lock := &firestore.Document{
+		Fields: map[string]firestore.Value{
+			keyField:      {StringValue: lockKey},
+			expireAtField: timestampValue(s.now().Add(ttl)),
+		},
+	}
+
+	call := s.documents.Patch(docName, lock).CurrentDocumentExists(false)
+	existing, err := s.documents.Get(docName).Context(ctx).Do()
+	switch {
+	case err == nil && !s.expired(existing):
+		return nil, ErrLocked
+	case err == nil:
+		call = s.documents.Patch(docName, lock).CurrentDocumentUpdateTime(existing.UpdateTime)
+	case !isNotFound(err):
+		return nil, fmt.Errorf("failed to read lock: %w", err)
+	}
+	acquired, err := call.Context(ctx).Do()
+	if err != nil {
+		if isPreconditionFailed(err) {
+			// acquired by someone else since it was read
+			return nil, ErrLocked
+		}
+		return nil, fmt.Errorf("failed to write lock: %w", err)
+	}
+
+	return func(ctx context.Context) error {
+		_, err := s.documents.Delete(docName).CurrentDocumentUpdateTime(acquired.UpdateTime).Context(ctx).Do()
+		if err != nil && !isNotFound(err) && !isPreconditionFailed(err) {
+			return fmt.Errorf("failed to release lock: %w", err)
+		}
+		return nil
+	}, nil
+}
+
+// docName returns the name of the document holding the given key. Keys may
+// contain slashes, which document IDs may not, so keys are encoded.
+func (s *FirestoreStore) docName(key string) string {
+	return fmt.Sprintf("%s/%s/%s", s.parent, s.collection, base64.RawURLEncoding.EncodeToString([]byte(key)))
+}
+
+// expired reports whether the document has expired but may not be deleted
+// by Firestore yet.
+func (s *FirestoreStore) expired(doc *firestore.Document) bool {
+	v, ok := doc.Fields[expireAtField]
+	if !ok || v.TimestampValue == "" {
+		return false
+	}
+	expireAt, err := time.Parse(time.RFC3339Nano, v.TimestampValue)
+	if err != nil {
+		return false
+	}
+	return !s.now().Before(expireAt)
+}
+
+func timestampValue(t time.Time) firestore.Value {
+	return firestore.Value{TimestampValue: t.UTC().Format(time.RFC3339Nano)}
+}
+
+func isNotFound(err error) bool {
+	var gerr *googleapi.Error
+	return errors.As(err, &gerr) && gerr.Code == http.StatusNotFound
+}
+
+// isPreconditionFailed reports whether a write failed because its
+// precondition on the current document did not hold: the document already
+// exists, or was updated since it was read.
+func isPreconditionFailed(err error) bool {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) {
+		return false
+	}
+	switch gerr.Code {
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return true
+	case http.StatusBadRequest:
+		return strings.Contains(gerr.Body, "FAILED_PRECONDITION")
+	}
+	return false
+}
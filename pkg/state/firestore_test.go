@@ -0,0 +1,207 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/firestore/v1"
+	"google.golang.org/api/option"
+)
+
+func TestFirestoreStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC)
+	store := newTestFirestoreStore(t, WithFirestoreTTL(time.Hour))
+	store.now = func() time.Time { return now }
+
+	if _, err := store.Get(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(missing) got err %v, want %v", err, ErrNotFound)
+	}
+
+	keys := []string{Key("deadletter", "target", "groups/99"), Key("deadletter", "source", "1"), "history/1"}
+	for _, key := range keys {
+		if err := store.Put(ctx, key, []byte(key)); err != nil {
+			t.Fatalf("Put(%s) failed: %v", key, err)
+		}
+	}
+	for _, key := range keys {
+		got, err := store.Get(ctx, key)
+		if err != nil {
+			t.Fatalf("Get(%s) failed: %v", key, err)
+		}
+		if string(got) != key {
+			t.Errorf("Get(%s) got %q, want %q", key, got, key)
+		}
+	}
+
+	got, err := store.List(ctx, "deadletter/")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	want := []string{"deadletter/source/1", "deadletter/target/groups%2F99"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected keys (-got, +want):\n%s", diff)
+	}
+
+	if err := store.Delete(ctx, "history/1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := store.Delete(ctx, "history/1"); err != nil {
+		t.Errorf("Delete of missing key failed: %v", err)
+	}
+	if _, err := store.Get(ctx, "history/1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete got err %v, want %v", err, ErrNotFound)
+	}
+
+	// entries expire after the TTL even if firestore did not delete them yet.
+	now = now.Add(time.Hour)
+	if _, err := store.Get(ctx, "deadletter/source/1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get of expired key got err %v, want %v", err, ErrNotFound)
+	}
+	if got, err := store.List(ctx, ""); err != nil || len(got) != 0 {
+		t.Errorf("List of expired keys got (%q, %v), want no keys", got, err)
+	}
+}
+
+func TestFirestoreStore_Lock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC)
+	store := newTestFirestoreStore(t)
+	store.now = func() time.Time { return now }
+
+	unlock, err := store.Lock(ctx, "sync", time.Hour)
+	if err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+	if _, err := store.Lock(ctx, "sync", time.Hour); !errors.Is(err, ErrLocked) {
+		t.Errorf("Lock of held lock got err %v, want %v", err, ErrLocked)
+	}
+	if keys, err := store.List(ctx, ""); err != nil || len(keys) != 0 {
+		t.Errorf("List got (%q, %v), want locks to be hidden", keys, err)
+	}
+	if err := unlock(ctx); err != nil {
+		t.Fatalf("unlock failed: %v", err)
+	}
+
+	if _, err := store.Lock(ctx, "sync", time.Hour); err != nil {
+		t.Fatalf("Lock after unlock failed: %v", err)
+	}
+	// an expired lock is taken over.
+	now = now.Add(2 * time.Hour)
+	if _, err := store.Lock(ctx, "sync", time.Hour); err != nil {
+		t.Errorf("Lock of expired lock failed: %v", err)
+	}
+}
+
+func newTestFirestoreStore(tb testing.TB, opts ...FirestoreOpt) *FirestoreStore {
+	tb.Helper()
+
+	srv := httptest.NewServer(&fakeFirestore{docs: make(map[string]*firestore.Document)})
+	tb.Cleanup(srv.Close)
+
+	opts = append(opts, WithFirestoreClientOptions(option.WithEndpoint(srv.URL), option.WithoutAuthentication()))
+	store, err := NewFirestoreStore(context.Background(), "test-project", "team-link", opts...)
+	if err != nil {
+		tb.Fatalf("failed to create store: %v", err)
+	}
+	return store
+}
+
+// fakeFirestore implements the parts of the Firestore REST API used by
+// FirestoreStore: getting, patching, deleting and listing documents.
+type fakeFirestore struct {
+	docs    map[string]*firestore.Document
+	updates int
+	mu      sync.Mutex
+}
+
+func (f *fakeFirestore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	name := strings.TrimPrefix(r.URL.Path, "/v1/")
+	// documents are named projects/p/databases/d/documents/collection/id.
+	isDoc := len(strings.Split(name, "/")) == 7
+	doc, exists := f.docs[name]
+
+	q := r.URL.Query()
+	if v := q.Get("currentDocument.exists"); v != "" && (v == "true") != exists {
+		writeFirestoreError(w, http.StatusConflict, "ALREADY_EXISTS")
+		return
+	}
+	if v := q.Get("currentDocument.updateTime"); v != "" && (!exists || doc.UpdateTime != v) {
+		writeFirestoreError(w, http.StatusBadRequest, "FAILED_PRECONDITION")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && isDoc:
+		if !exists {
+			writeFirestoreError(w, http.StatusNotFound, "NOT_FOUND")
+			return
+		}
+		json.NewEncoder(w).Encode(doc) //nolint:errcheck // test server
+	case r.Method == http.MethodGet:
+		resp := &firestore.ListDocumentsResponse{}
+		names := make([]string, 0, len(f.docs))
+		for n := range f.docs {
+			if strings.HasPrefix(n, name+"/") {
+				names = append(names, n)
+			}
+		}
+		sort.Strings(names)
+		for _, n := range names {
+			resp.Documents = append(resp.Documents, f.docs[n])
+		}
+		json.NewEncoder(w).Encode(resp) //nolint:errcheck // test server
+	case r.Method == http.MethodPatch:
+		var patch firestore.Document
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			writeFirestoreError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		f.updates++
+		patch.Name = name
+		patch.UpdateTime = fmt.Sprintf("2026-01-01T00:00:%02dZ", f.updates)
+		f.docs[name] = &patch
+		json.NewEncoder(w).Encode(&patch) //nolint:errcheck // test server
+	case r.Method == http.MethodDelete:
+		delete(f.docs, name)
+		fmt.Fprint(w, "{}")
+	default:
+		writeFirestoreError(w, http.StatusMethodNotAllowed, "UNIMPLEMENTED")
+	}
+}
+
+func writeFirestoreError(w http.ResponseWriter, code int, status string) {
+	w.WriteHeader(code)
+	fmt.Fprintf(w, `{"error": {"code": %d, "message": "%s", "status": "%s"}}`, code, status, status)
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Ensure we conform to the interfaces.
+var (
+	_ Store  = (*MemoryStore)(nil)
+	_ Locker = (*MemoryStore)(nil)
+)
+
+// MemoryStore is a Store that keeps state in memory.
+type MemoryStore struct {
+	data  map[string][]byte
+	locks map[string]time.Time
+	mu    sync.RWMutex
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		data:  make(map[string][]byte),
+		locks: make(map[string]time.Time),
+	}
+}
+
+// Get returns the value stored under key, or ErrNotFound.
+func (s *MemoryStore) Get(ctx context.Context, key string) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.data[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return slices.Clone(v), nil
+}
+
+// Put stores value under key, replacing any existing value.
+func (s *MemoryStore) Put(ctx context.Context, key string, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data[key] = slices.Clone(value)
+	return nil
+}
+
+// Delete removes the value stored under key.
+func (s *MemoryStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.data, key)
+	return nil
+}
+
+// List returns the keys with the given prefix in lexical order.
+func (s *MemoryStore) List(ctx context.Context, prefix string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var keys []string
+	for k := range s.data {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+// Lock acquires the named lock, or returns ErrLocked if it is held.
+func (s *MemoryStore) Lock(ctx context.Context, name string, ttl time.Duration) (func(ctx context.Context) error, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if expiry, ok := s.locks[name]; ok && now.Before(expiry) {
+		return nil, ErrLocked
+	}
+	expiry := now.Add(ttl)
+	s.locks[name] = expiry
+	return func(ctx context.Context) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		// the lock may have expired and been acquired by someone else.
+		if s.locks[name] == expiry {
+			delete(s.locks, name)
+		}
+		return nil
+	}, nil
+}
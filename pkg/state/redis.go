@@ -0,0 +1,219 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Ensure we conform to the interfaces.
+var (
+	_ Store   = (*RedisStore)(nil)
+	_ Locker  = (*RedisStore)(nil)
+	_ Expirer = (*RedisStore)(nil)
+)
+
+// DefaultRedisDialTimeout bounds connecting to Redis.
+const DefaultRedisDialTimeout = 10 * time.Second
+
+// releaseLock deletes a lock only if it still holds the token it was acquired
+// with, as it may have expired and been acquired by someone else.
+var releaseLock = redis.NewScript(`if redis.call("GET", KEYS[1]) == ARGV[1] then return redis.call("DEL", KEYS[1]) else return 0 end`)
+
+// RedisConfig holds the optional settings of a RedisStore.
+type RedisConfig struct {
+	username    string
+	password    string
+	db          int
+	ttl         time.Duration
+	tlsConfig   *tls.Config
+	dialTimeout time.Duration
+}
+
+// RedisOpt configures a RedisStore.
+type RedisOpt func(config *RedisConfig)
+
+// WithRedisAuth sets the credentials used to authenticate to Redis. The
+// username may be empty when Redis has no ACL users, e.g. Memorystore with
+// AUTH enabled.
+func WithRedisAuth(username, password string) RedisOpt {
+	return func(config *RedisConfig) {
+		config.username = username
+		config.password = password
+	}
+}
+
+// WithRedisDB sets the Redis database, 0 by default.
+func WithRedisDB(db int) RedisOpt {
+	return func(config *RedisConfig) {
+		config.db = db
+	}
+}
+
+// WithRedisTTL makes entries expire the given duration after they were last
+// written.
+func WithRedisTTL(ttl time.Duration) RedisOpt {
+	return func(config *RedisConfig) {
+		config.ttl = ttl
+	}
+}
+
+// WithRedisTLS connects to Redis over TLS, e.g. for Memorystore with in-transit
+// encryption enabled.
+func WithRedisTLS(tlsConfig *tls.Config) RedisOpt {
+	return func(config *RedisConfig) {
+		config.tlsConfig = tlsConfig
+	}
+}
+
+// RedisStore is a Store that keeps state in Redis or Memorystore, for
+// deployments where several team-link processes share state or caches.
+type RedisStore struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewRedisStore creates a RedisStore using the Redis server at the given
+// host:port address. Connections are opened when needed.
+func NewRedisStore(addr string, opts ...RedisOpt) *RedisStore {
+	config := &RedisConfig{dialTimeout: DefaultRedisDialTimeout}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &RedisStore{
+		client: redis.NewClient(&redis.Options{
+			Addr:        addr,
+			Username:    config.username,
+			Password:    config.password,
+			DB:          config.db,
+			TLSConfig:   config.tlsConfig,
+			DialTimeout: config.dialTimeout,
+		}),
+		ttl: config.ttl,
+	}
+}
+
+// Get returns the value stored under key, or ErrNotFound.
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := s.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get redis key: %w", err)
+	}
+	return value, nil
+}
+
+// Put stores value under key, replacing any existing value.
+func (s *RedisStore) Put(ctx context.Context, key string, value []byte) error {
+	return s.PutWithTTL(ctx, key, value, s.ttl)
+}
+
+// PutWithTTL stores value under key, replacing any existing value. The entry
+// expires after ttl, or never if ttl is 0.
+func (s *RedisStore) PutWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := s.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set redis key: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the value stored under key.
+func (s *RedisStore) Delete(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("failed to delete redis key: %w", err)
+	}
+	return nil
+}
+
+// List returns the keys with the given prefix in lexical order. Keys are
+// scanned, so the database should not be shared with lots of other data.
+func (s *RedisStore) List(ctx context.Context, prefix string) ([]string, error) {
+	var keys []string
+	iter := s.client.Scan(ctx, 0, globEscape(prefix)+"*", 1000).Iterator()
+	for iter.Next(ctx) {
+		if key := iter.Val(); !strings.HasPrefix(key, lockKeyPrefix+"/") {
+			keys = append(keys, key)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan redis keys: %w", err)
+	}
+	// SCAN may return a key more than once.
+	slices.Sort(keys)
+	return slices.Compact(keys), nil
+}
+
+// Lock acquires the named lock, or returns ErrLocked if it is held. A lock is
+// a key set only if it does not exist, holding a random token so that only
+// its holder releases it. Redis expires keys with millisecond precision, so
+// ttls under a millisecond are rounded up.
+func (s *RedisStore) Lock(ctx context.Context, name string, ttl time.Duration) (func(ctx context.Context) error, error) {
+	if ttl <= 0 {
+		return nil, fmt.Errorf("lock ttl must be positive, got %s", ttl)
+	}
+	lockKey := Key(lockKeyPrefix, name)
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("failed to generate lock token: %w", err)
+	}
+	token := hex.EncodeToString(b)
+
+	acquired, err := s.client.SetNX(ctx, lockKey, token, max(ttl, time.Millisecond)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to write lock: %w", err)
+	}
+	if !acquired {
+		return nil, ErrLocked
+	}
+	return func(ctx context.Context) error {
+		if err := releaseLock.Run(ctx, s.client, []string{lockKey}, token).Err(); err != nil {
+			return fmt.Errorf("failed to release lock: %w", err)
+		}
+		return nil
+	}, nil
+}
+
+// Close closes the connections of the store.
+func (s *RedisStore) Close() error {
+	if err := s.client.Close(); err != nil {
+		return fmt.Errorf("failed to close redis client: %w", err)
+	}
+	return nil
+}
+
+// globEscape escapes the characters of s which are special in Redis glob
+// patterns.
+func globEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(`*?[]\`, r) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
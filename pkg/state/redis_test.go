@@ -0,0 +1,181 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+)
+
+func TestRedisStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := miniredis.RunT(t)
+	fake.RequireAuth("secret")
+	store, err := Open(ctx, fmt.Sprintf("redis://:secret@%s/2?ttl=1h", fake.Addr()))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	t.Cleanup(func() { store.(*RedisStore).Close() })
+
+	if _, err := store.Get(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(missing) got err %v, want %v", err, ErrNotFound)
+	}
+
+	keys := []string{Key("deadletter", "target", "groups/99"), Key("deadletter", "source", "1"), "history/1", "dead*"}
+	for _, key := range keys {
+		if err := store.Put(ctx, key, []byte(key)); err != nil {
+			t.Fatalf("Put(%s) failed: %v", key, err)
+		}
+	}
+	for _, key := range keys {
+		got, err := store.Get(ctx, key)
+		if err != nil {
+			t.Fatalf("Get(%s) failed: %v", key, err)
+		}
+		if string(got) != key {
+			t.Errorf("Get(%s) got %q, want %q", key, got, key)
+		}
+	}
+	fake.Select(2)
+	if got, want := fake.TTL("history/1"), time.Hour; got != want {
+		t.Errorf("ttl of history/1 in database 2 got %s, want %s", got, want)
+	}
+
+	got, err := store.List(ctx, "deadletter/")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	want := []string{"deadletter/source/1", "deadletter/target/groups%2F99"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected keys (-got, +want):\n%s", diff)
+	}
+
+	if err := store.Delete(ctx, "history/1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := store.Delete(ctx, "history/1"); err != nil {
+		t.Errorf("Delete of missing key failed: %v", err)
+	}
+	if _, err := store.Get(ctx, "history/1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get after Delete got err %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestRedisStore_Auth(t *testing.T) {
+	t.Parallel()
+
+	fake := miniredis.RunT(t)
+	fake.RequireAuth("secret")
+	store := NewRedisStore(fake.Addr(), WithRedisAuth("", "wrong"))
+	t.Cleanup(func() { store.Close() })
+
+	if _, err := store.Get(context.Background(), "key"); err == nil || !strings.Contains(err.Error(), "WRONGPASS") {
+		t.Errorf("Get with wrong password got err %v, want WRONGPASS", err)
+	}
+}
+
+func TestRedisStore_Lock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := miniredis.RunT(t)
+	store := NewRedisStore(fake.Addr())
+	t.Cleanup(func() { store.Close() })
+
+	unlock, err := store.Lock(ctx, "sync", time.Hour)
+	if err != nil {
+		t.Fatalf("Lock failed: %v", err)
+	}
+	if _, err := store.Lock(ctx, "sync", time.Hour); !errors.Is(err, ErrLocked) {
+		t.Errorf("Lock of held lock got err %v, want %v", err, ErrLocked)
+	}
+	if keys, err := store.List(ctx, ""); err != nil || len(keys) != 0 {
+		t.Errorf("List got (%q, %v), want locks to be hidden", keys, err)
+	}
+	if err := unlock(ctx); err != nil {
+		t.Fatalf("unlock failed: %v", err)
+	}
+
+	unlock, err = store.Lock(ctx, "sync", time.Hour)
+	if err != nil {
+		t.Fatalf("Lock after unlock failed: %v", err)
+	}
+	// the lock expired and was acquired by someone else, which is not released.
+	if err := fake.Set("locks/sync", "other"); err != nil {
+		t.Fatalf("failed to set lock: %v", err)
+	}
+	if err := unlock(ctx); err != nil {
+		t.Fatalf("unlock failed: %v", err)
+	}
+	if _, err := store.Lock(ctx, "sync", time.Hour); !errors.Is(err, ErrLocked) {
+		t.Errorf("Lock of lock held by someone else got err %v, want %v", err, ErrLocked)
+	}
+}
+
+func TestRedisStore_LockTTL(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		ttl     time.Duration
+		wantTTL time.Duration
+		wantErr string
+	}{
+		{
+			name:    "milliseconds",
+			ttl:     1500 * time.Millisecond,
+			wantTTL: 1500 * time.Millisecond,
+		},
+		{
+			name:    "under_a_millisecond",
+			ttl:     time.Microsecond,
+			wantTTL: time.Millisecond,
+		},
+		{
+			name:    "zero",
+			wantErr: "lock ttl must be positive",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			fake := miniredis.RunT(t)
+			store := NewRedisStore(fake.Addr())
+			t.Cleanup(func() { store.Close() })
+
+			_, err := store.Lock(ctx, "sync", tc.ttl)
+			if diff := testutil.DiffErrString(err, tc.wantErr); diff != "" {
+				t.Errorf("unexpected err: %s", diff)
+			}
+			if got := fake.TTL("locks/sync"); got != tc.wantTTL {
+				t.Errorf("ttl of lock got %s, want %s", got, tc.wantTTL)
+			}
+		})
+	}
+}
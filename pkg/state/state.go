@@ -0,0 +1,193 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package state provides storage for state that team-link keeps between sync runs.
+package state
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned by a Store when the requested key does not exist.
+	ErrNotFound = errors.New("state not found")
+
+	// ErrLocked is returned by a Locker when the lock is held by someone else.
+	ErrLocked = errors.New("state locked")
+)
+
+// Store is a key value store for state kept between sync runs. Keys are
+// slash separated paths, e.g. "deadletter/target/123:456", and values are
+// opaque bytes. Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the value stored under key, or ErrNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Put stores value under key, replacing any existing value.
+	Put(ctx context.Context, key string, value []byte) error
+
+	// Delete removes the value stored under key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+
+	// List returns the keys with the given prefix in lexical order.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+// Locker is implemented by stores which can be shared by several team-link
+// processes, so that they do not run concurrently.
+type Locker interface {
+	// Lock acquires the named lock, or returns ErrLocked if it is held. The
+	// lock expires after ttl in case it is never released, e.g. because the
+	// process crashed. The returned function releases the lock.
+	Lock(ctx context.Context, name string, ttl time.Duration) (func(ctx context.Context) error, error)
+}
+
+// Expirer is implemented by stores which can expire individual entries.
+type Expirer interface {
+	// PutWithTTL stores value under key, replacing any existing value. The
+	// entry expires after ttl.
+	PutWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// GetJSON reads the value stored under key and unmarshals it into v.
+func GetJSON(ctx context.Context, s Store, key string, v any) error {
+	b, err := s.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to get %s: %w", key, err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %w", key, err)
+	}
+	return nil
+}
+
+// PutJSON marshals v as JSON and stores it under key.
+func PutJSON(ctx context.Context, s Store, key string, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", key, err)
+	}
+	if err := s.Put(ctx, key, b); err != nil {
+		return fmt.Errorf("failed to put %s: %w", key, err)
+	}
+	return nil
+}
+
+// Key joins the given parts into a key. Each part is escaped so that IDs
+// containing slashes, e.g. "groups/123", form a single key segment.
+func Key(parts ...string) string {
+	escaped := make([]string, 0, len(parts))
+	for _, p := range parts {
+		escaped = append(escaped, url.PathEscape(p))
+	}
+	return strings.Join(escaped, "/")
+}
+
+// KeyBase returns the unescaped last segment of a key created by Key.
+func KeyBase(key string) string {
+	base := key[strings.LastIndex(key, "/")+1:]
+	if unescaped, err := url.PathUnescape(base); err == nil {
+		return unescaped
+	}
+	return base
+}
+
+// Open opens the store at the given location. Supported locations are:
+//
+//   - file:///path/to/dir or a plain directory path: a FileStore.
+//   - firestore://project/collection: a FirestoreStore. The query
+//     parameters "database" and "ttl" set the Firestore database and the
+//     time after which entries expire, e.g. "?ttl=2160h".
+//   - redis://[user:password@]host:port/db or rediss:// for TLS: a
+//     RedisStore, e.g. a Memorystore instance. The query parameter "ttl" sets
+//     the time after which entries expire.
+//   - mem://: a MemoryStore, mostly useful for dry runs and testing.
+func Open(ctx context.Context, location string) (Store, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse state store location %q: %w", location, err)
+	}
+	switch u.Scheme {
+	case "", "file":
+		dir := location
+		if u.Scheme == "file" {
+			dir = u.Path
+		}
+		store, err := NewFileStore(dir)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	case "firestore":
+		collection := strings.Trim(u.Path, "/")
+		if u.Host == "" || collection == "" {
+			return nil, fmt.Errorf("firestore state store location %q must be firestore://project/collection", location)
+		}
+		var opts []FirestoreOpt
+		if db := u.Query().Get("database"); db != "" {
+			opts = append(opts, WithFirestoreDatabase(db))
+		}
+		if ttl := u.Query().Get("ttl"); ttl != "" {
+			d, err := time.ParseDuration(ttl)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse ttl of state store location %q: %w", location, err)
+			}
+			opts = append(opts, WithFirestoreTTL(d))
+		}
+		store, err := NewFirestoreStore(ctx, u.Host, collection, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	case "redis", "rediss":
+		if u.Host == "" {
+			return nil, fmt.Errorf("redis state store location %q must be redis://host:port/db", location)
+		}
+		var opts []RedisOpt
+		if u.User != nil {
+			password, _ := u.User.Password()
+			opts = append(opts, WithRedisAuth(u.User.Username(), password))
+		}
+		if db := strings.Trim(u.Path, "/"); db != "" {
+			n, err := strconv.Atoi(db)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse database of state store location %q: %w", location, err)
+			}
+			opts = append(opts, WithRedisDB(n))
+		}
+		if ttl := u.Query().Get("ttl"); ttl != "" {
+			d, err := time.ParseDuration(ttl)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse ttl of state store location %q: %w", location, err)
+			}
+			opts = append(opts, WithRedisTTL(d))
+		}
+		if u.Scheme == "rediss" {
+			opts = append(opts, WithRedisTLS(&tls.Config{MinVersion: tls.VersionTLS12, ServerName: u.Hostname()}))
+		}
+		return NewRedisStore(u.Host, opts...), nil
+	case "mem":
+		return NewMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("unsupported state store scheme %q", u.Scheme)
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tableau provides a GroupReadWriter for Tableau Cloud and Tableau
+// Server groups, which grant permissions to projects, workbooks and data
+// sources.
+package tableau
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// APIVersion is the version of the Tableau REST API used, supported by
+	// Tableau Cloud and Tableau Server 2024.1 and later.
+	APIVersion = "3.22"
+
+	// sessionDuration is how long a sign-in is reused, shorter than the
+	// default session timeout of Tableau of 240 minutes.
+	sessionDuration = time.Hour * 2
+
+	// pageSize is the number of users requested per page.
+	pageSize = 1000
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Group is a Tableau group.
+type Group struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Domain *Domain `json:"domain,omitempty"`
+}
+
+// Domain is the domain of a Tableau group, "local" unless the group is
+// imported from Active Directory.
+type Domain struct {
+	Name string `json:"name"`
+}
+
+// User is a Tableau user. On Tableau Cloud, the name of a user is their
+// email.
+type User struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Email    string `json:"email,omitempty"`
+	SiteRole string `json:"siteRole,omitempty"`
+}
+
+type groupsResponse struct {
+	Groups struct {
+		Group []*Group `json:"group"`
+	} `json:"groups"`
+}
+
+type usersResponse struct {
+	Users struct {
+		User []*User `json:"user"`
+	} `json:"users"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Tableau.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the users of the local groups of a Tableau site. Group IDs are
+// group names and user IDs are user names, i.e. emails on Tableau Cloud.
+// Users must already be on the site to be added to a group.
+type GroupReadWriter struct {
+	endpoint    string
+	site        string
+	tokenName   string
+	tokenSecret string
+	httpClient  *http.Client
+	userCache   *cache.Cache[*User]
+
+	mu       sync.Mutex
+	client   *rest.Client
+	signedIn time.Time
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the site with the given
+// content URL of the Tableau server at the given URL, e.g.
+// https://prod-useast-a.online.tableau.com. It signs in with a personal access
+// token of a site administrator.
+func NewGroupReadWriter(endpoint, site, tokenName, tokenSecret string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &GroupReadWriter{
+		endpoint:    endpoint + "/api/" + APIVersion,
+		site:        site,
+		tokenName:   tokenName,
+		tokenSecret: tokenSecret,
+		httpClient:  config.httpClient,
+		userCache:   cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Tableau group with the given name.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	group, err := rw.group(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupsync.Group{ID: group.Name, Attributes: group}, nil
+}
+
+// GetMembers retrieves the users of the Tableau group with the given name.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	group, err := rw.group(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return rw.members(ctx, group)
+}
+
+// Descendants retrieve all users of the Tableau group with the given name.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Tableau user with the given name.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.user(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Name, Attributes: user}, nil
+}
+
+// user returns the Tableau user of the site with the given name.
+func (rw *GroupReadWriter) user(ctx context.Context, name string) (*User, error) {
+	user, err := rw.userCache.WriteThruLookup(strings.ToLower(name), func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", name)
+		client, err := rw.session(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var resp usersResponse
+		if err := client.Do(ctx, http.MethodGet, "/users?filter="+url.QueryEscape("name:eq:"+name), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", name, err)
+		}
+		if len(resp.Users.User) == 0 {
+			return nil, fmt.Errorf("user %s is not on the tableau site", name)
+		}
+		return resp.Users.User[0], nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// SetMembers replaces the users of the Tableau group with the given name with
+// the given members. Users which are not on the site are reported in the
+// returned error after all other changes are made.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	group, err := rw.group(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if group.Domain != nil && group.Domain.Name != "local" {
+		return fmt.Errorf("group %s is imported from %s and cannot be written", groupID, group.Domain.Name)
+	}
+	currentMembers, err := rw.members(ctx, group)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	client, err := rw.session(ctx)
+	if err != nil {
+		return err
+	}
+	base := "/groups/" + url.PathEscape(group.ID) + "/users"
+	var merr error
+	for _, name := range utils.MapKeys(addMembers) {
+		user, err := rw.user(ctx, name)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to group %s: %w", name, groupID, err))
+			continue
+		}
+		body := map[string]any{"user": map[string]string{"id": user.ID}}
+		if err := client.Do(ctx, http.MethodPost, base, body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add %s to group %s: %w", name, groupID, err))
+		}
+	}
+	for _, name := range utils.MapKeys(removeMembers) {
+		user, ok := tableauUser(removeMembers[name])
+		if !ok {
+			continue
+		}
+		if err := client.Do(ctx, http.MethodDelete, base+"/"+url.PathEscape(user.ID), nil, nil); err != nil && !rest.IsNotFound(err) {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove %s from group %s: %w", name, groupID, err))
+		}
+	}
+	return merr
+}
+
+// group returns the Tableau group of the site with the given name.
+func (rw *GroupReadWriter) group(ctx context.Context, name string) (*Group, error) {
+	client, err := rw.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp groupsResponse
+	if err := client.Do(ctx, http.MethodGet, "/groups?filter="+url.QueryEscape("name:eq:"+name), nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", name, err)
+	}
+	if len(resp.Groups.Group) == 0 {
+		return nil, fmt.Errorf("%w: tableau group %s", groupsync.ErrGroupNotFound, name)
+	}
+	return resp.Groups.Group[0], nil
+}
+
+// members returns the users of the given group.
+func (rw *GroupReadWriter) members(ctx context.Context, group *Group) ([]groupsync.Member, error) {
+	client, err := rw.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var members []groupsync.Member
+	for page := 1; ; page++ {
+		q := url.Values{
+			"pageSize":   {strconv.Itoa(pageSize)},
+			"pageNumber": {strconv.Itoa(page)},
+		}
+		var resp usersResponse
+		if err := client.Do(ctx, http.MethodGet, "/groups/"+url.PathEscape(group.ID)+"/users?"+q.Encode(), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get users of group %s: %w", group.Name, err)
+		}
+		for _, u := range resp.Users.User {
+			members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: u.Name, Attributes: u}})
+		}
+		if len(resp.Users.User) < pageSize {
+			return members, nil
+		}
+	}
+}
+
+// session returns a client for the API of the site, signing in if there is
+// no recent session.
+func (rw *GroupReadWriter) session(ctx context.Context) (*rest.Client, error) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+
+	if rw.client != nil && time.Since(rw.signedIn) < sessionDuration {
+		return rw.client, nil
+	}
+	body := map[string]any{
+		"credentials": map[string]any{
+			"personalAccessTokenName":   rw.tokenName,
+			"personalAccessTokenSecret": rw.tokenSecret,
+			"site":                      map[string]string{"contentUrl": rw.site},
+		},
+	}
+	var resp struct {
+		Credentials struct {
+			Token string `json:"token"`
+			Site  struct {
+				ID string `json:"id"`
+			} `json:"site"`
+		} `json:"credentials"`
+	}
+	client := rest.New(rw.endpoint, rest.WithHTTPClient(rw.httpClient))
+	if err := client.Do(ctx, http.MethodPost, "/auth/signin", body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to sign in to tableau site %q: %w", rw.site, err)
+	}
+	rw.client = rest.New(rw.endpoint+"/sites/"+url.PathEscape(resp.Credentials.Site.ID),
+		rest.WithHTTPClient(rw.httpClient),
+		rest.WithHeader("X-Tableau-Auth", resp.Credentials.Token),
+	)
+	rw.signedIn = time.Now()
+	return rw.client, nil
+}
+
+// tableauUser returns the Tableau user of a current member.
+func tableauUser(m groupsync.Member) (*User, bool) {
+	u, ok := m.(*groupsync.UserMember)
+	if !ok {
+		return nil, false
+	}
+	user, ok := u.Usr.Attributes.(*User)
+	return user, ok
+}
+
+// toIDMap returns the given members by lowercased ID, as emails are
+// case-insensitive.
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[strings.ToLower(m.ID())] = m
+	}
+	return memberIDs
+}
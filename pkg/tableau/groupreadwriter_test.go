@@ -0,0 +1,192 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tableau
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeTableau{
+		users: []*User{
+			{ID: "u1", Name: "alice@example.com"},
+			{ID: "u2", Name: "bob@example.com"},
+			{ID: "u3", Name: "carol@example.com"},
+		},
+		groups: []*Group{
+			{ID: "g1", Name: "Analysts", Domain: &Domain{Name: "local"}},
+			{ID: "g2", Name: "Imported", Domain: &Domain{Name: "example.com"}},
+		},
+		members: map[string][]string{"g1": {"u1", "u2"}},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(srv.URL, "analytics", "team-link", "secret")
+
+	users, err := rw.Descendants(ctx, "Analysts")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice@example.com", "bob@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "Analysts", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "Bob@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "dave@example.com"}},
+	})
+	if diff := testutil.DiffErrString(err, "user dave@example.com is not on the tableau site"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+	if diff := cmp.Diff(fake.members["g1"], []string{"u2", "u3"}); diff != "" {
+		t.Errorf("unexpected group users (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "Imported", nil)
+	if diff := testutil.DiffErrString(err, "imported from example.com"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+
+	if _, err := rw.GetGroup(ctx, "Missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(Missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+	if got, want := fake.signIns, 1; got != want {
+		t.Errorf("signed in %d times, want %d", got, want)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeTableau implements the parts of the Tableau REST API used by
+// GroupReadWriter for the site "analytics" with ID s1, whose group members
+// are mapped from group IDs to user IDs.
+type fakeTableau struct {
+	mu      sync.Mutex
+	signIns int
+	users   []*User
+	groups  []*Group
+	members map[string][]string
+}
+
+func (f *fakeTableau) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/api/"+APIVersion)
+	if path == "/auth/signin" {
+		var body struct {
+			Credentials struct {
+				Name   string `json:"personalAccessTokenName"`
+				Secret string `json:"personalAccessTokenSecret"`
+				Site   struct {
+					ContentURL string `json:"contentUrl"`
+				} `json:"site"`
+			} `json:"credentials"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Credentials.Secret != "secret" || body.Credentials.Site.ContentURL != "analytics" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.signIns++
+		write(map[string]any{"credentials": map[string]any{"token": "token", "site": map[string]string{"id": "s1"}}})
+		return
+	}
+	if r.Header.Get("X-Tableau-Auth") != "token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	path, ok := strings.CutPrefix(path, "/sites/s1")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	name, _ := strings.CutPrefix(r.URL.Query().Get("filter"), "name:eq:")
+
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case path == "/users":
+		var found []*User
+		for _, u := range f.users {
+			if strings.EqualFold(u.Name, name) {
+				found = append(found, u)
+			}
+		}
+		write(map[string]any{"users": map[string]any{"user": found}})
+	case path == "/groups":
+		var found []*Group
+		for _, g := range f.groups {
+			if g.Name == name {
+				found = append(found, g)
+			}
+		}
+		write(map[string]any{"groups": map[string]any{"group": found}})
+	case len(parts) >= 3 && parts[0] == "groups" && parts[2] == "users":
+		group := parts[1]
+		switch r.Method {
+		case http.MethodGet:
+			var users []*User
+			for _, u := range f.users {
+				if slices.Contains(f.members[group], u.ID) {
+					users = append(users, u)
+				}
+			}
+			write(map[string]any{"users": map[string]any{"user": users}})
+		case http.MethodPost:
+			var body struct {
+				User struct {
+					ID string `json:"id"`
+				} `json:"user"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f.members[group] = append(f.members[group], body.User.ID)
+			slices.Sort(f.members[group])
+		case http.MethodDelete:
+			f.members[group] = slices.DeleteFunc(f.members[group], func(id string) bool { return id == parts[3] })
+			w.WriteHeader(http.StatusNoContent)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
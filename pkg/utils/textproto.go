@@ -58,6 +58,20 @@ func GetSrcTargetSystemType(tlConfig *api.TeamLinkConfig) (string, string, error
 	switch tlConfig.GetSourceConfig().GetConfig().(type) {
 	case *api.SourceConfig_GoogleGroupsConfig:
 		sourceType = tltypes.SystemTypeGoogleGroups
+	case *api.SourceConfig_GithubConfig:
+		sourceType = tltypes.SystemTypeGitHub
+	case *api.SourceConfig_GitlabConfig:
+		sourceType = tltypes.SystemTypeGitLab
+	case *api.SourceConfig_JumpcloudConfig:
+		sourceType = tltypes.SystemTypeJumpCloud
+	case *api.SourceConfig_OneloginConfig:
+		sourceType = tltypes.SystemTypeOneLogin
+	case *api.SourceConfig_PingoneConfig:
+		sourceType = tltypes.SystemTypePingOne
+	case *api.SourceConfig_PagerdutyConfig:
+		sourceType = tltypes.SystemTypePagerDuty
+	case *api.SourceConfig_OpsgenieConfig:
+		sourceType = tltypes.SystemTypeOpsgenie
 	default:
 		sourceType = ""
 	}
@@ -68,6 +82,50 @@ func GetSrcTargetSystemType(tlConfig *api.TeamLinkConfig) (string, string, error
 		targetType = tltypes.SystemTypeGitHub
 	case *api.TargetConfig_GitlabConfig:
 		targetType = tltypes.SystemTypeGitLab
+	case *api.TargetConfig_GerritConfig:
+		targetType = tltypes.SystemTypeGerrit
+	case *api.TargetConfig_SentryConfig:
+		targetType = tltypes.SystemTypeSentry
+	case *api.TargetConfig_KubernetesConfig:
+		targetType = tltypes.SystemTypeKubernetes
+	case *api.TargetConfig_VaultConfig:
+		targetType = tltypes.SystemTypeVault
+	case *api.TargetConfig_Auth0Config:
+		targetType = tltypes.SystemTypeAuth0
+	case *api.TargetConfig_MattermostConfig:
+		targetType = tltypes.SystemTypeMattermost
+	case *api.TargetConfig_RocketChatConfig:
+		targetType = tltypes.SystemTypeRocketChat
+	case *api.TargetConfig_ZendeskConfig:
+		targetType = tltypes.SystemTypeZendesk
+	case *api.TargetConfig_ServiceNowConfig:
+		targetType = tltypes.SystemTypeServiceNow
+	case *api.TargetConfig_SplunkConfig:
+		targetType = tltypes.SystemTypeSplunk
+	case *api.TargetConfig_LookerConfig:
+		targetType = tltypes.SystemTypeLooker
+	case *api.TargetConfig_TableauConfig:
+		targetType = tltypes.SystemTypeTableau
+	case *api.TargetConfig_ConfluenceConfig:
+		targetType = tltypes.SystemTypeConfluence
+	case *api.TargetConfig_JumpcloudConfig:
+		targetType = tltypes.SystemTypeJumpCloud
+	case *api.TargetConfig_DatabricksConfig:
+		targetType = tltypes.SystemTypeDatabricks
+	case *api.TargetConfig_GoogleGroupsConfig:
+		targetType = tltypes.SystemTypeGoogleGroups
+	case *api.TargetConfig_GoogleChatConfig:
+		targetType = tltypes.SystemTypeGoogleChat
+	case *api.TargetConfig_GoogleCalendarConfig:
+		targetType = tltypes.SystemTypeGoogleCalendar
+	case *api.TargetConfig_Microsoft365Config:
+		targetType = tltypes.SystemTypeMicrosoft365
+	case *api.TargetConfig_BoxConfig:
+		targetType = tltypes.SystemTypeBox
+	case *api.TargetConfig_DropboxConfig:
+		targetType = tltypes.SystemTypeDropbox
+	case *api.TargetConfig_SalesforceConfig:
+		targetType = tltypes.SystemTypeSalesforce
 	default:
 		targetType = ""
 	}
@@ -0,0 +1,312 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vault provides a GroupReadWriter for HashiCorp Vault internal
+// identity groups, so that the policies granted to a group, e.g. for
+// dynamic secrets, follow team membership.
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+// DefaultCacheDuration is the default time to live for the entity and group
+// name caches.
+const DefaultCacheDuration = time.Hour
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Group is a Vault identity group, see
+// https://developer.hashicorp.com/vault/api-docs/secret/identity/group.
+type Group struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	Type            string   `json:"type"`
+	Policies        []string `json:"policies,omitempty"`
+	MemberEntityIDs []string `json:"member_entity_ids"`
+	MemberGroupIDs  []string `json:"member_group_ids"`
+}
+
+// Entity is a Vault identity entity, see
+// https://developer.hashicorp.com/vault/api-docs/secret/identity/entity.
+type Entity struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Disabled bool   `json:"disabled,omitempty"`
+}
+
+// response is the envelope of Vault responses.
+type response[T any] struct {
+	Data T `json:"data"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+	namespace     string
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the entity and group name cache
+// entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Vault.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// WithNamespace sets the Vault Enterprise namespace of the groups.
+func WithNamespace(namespace string) Opt {
+	return func(config *Config) {
+		config.namespace = namespace
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the members of Vault internal identity groups. Group IDs are
+// group names and user IDs are entity names, which are resolved to the
+// member entity and group IDs stored by Vault. Groups in a group are its
+// group members. External groups get their members from an auth method and
+// cannot be written.
+type GroupReadWriter struct {
+	client      *rest.Client
+	entityCache *cache.Cache[*Entity]
+	groupCache  *cache.Cache[*Group]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Vault server at the
+// given address, authenticating with the given token.
+func NewGroupReadWriter(address, token string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	restOpts := []rest.Opt{
+		rest.WithHTTPClient(config.httpClient),
+		rest.WithHeader("X-Vault-Token", token),
+	}
+	if config.namespace != "" {
+		restOpts = append(restOpts, rest.WithHeader("X-Vault-Namespace", config.namespace))
+	}
+	return &GroupReadWriter{
+		client:      rest.New(address+"/v1", restOpts...),
+		entityCache: cache.New[*Entity](config.cacheDuration),
+		groupCache:  cache.New[*Group](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Vault identity group with the given name.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	group, err := rw.group(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return &groupsync.Group{ID: group.Name, Attributes: group}, nil
+}
+
+// GetMembers retrieves the member entities and member groups of the Vault
+// identity group with the given name.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	group, err := rw.group(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]groupsync.Member, 0, len(group.MemberEntityIDs)+len(group.MemberGroupIDs))
+	for _, id := range group.MemberEntityIDs {
+		entity, err := rw.entity(ctx, "id", id)
+		if err != nil {
+			return nil, fmt.Errorf("could not get member entity %s of group %s: %w", id, groupID, err)
+		}
+		members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: entity.Name, Attributes: entity}})
+	}
+	for _, id := range group.MemberGroupIDs {
+		g, err := rw.groupByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("could not get member group %s of group %s: %w", id, groupID, err)
+		}
+		members = append(members, &groupsync.GroupMember{Grp: &groupsync.Group{ID: g.Name, Attributes: g}})
+	}
+	return members, nil
+}
+
+// Descendants retrieve all users (children, recursively) of the Vault
+// identity group with the given name.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Vault identity entity with the given name.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	entity, err := rw.entity(ctx, "name", userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: entity.Name, Attributes: entity}, nil
+}
+
+// SetMembers replaces the members of the Vault identity group with the given
+// name with the given members, in a single update of its member entity and
+// group IDs. Members without an entity or group in Vault are skipped and
+// reported in the returned error.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	group, err := rw.group(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if group.Type != "internal" {
+		return fmt.Errorf("cannot set members of %s group %s, only internal groups can be written", group.Type, groupID)
+	}
+
+	var merr error
+	entityIDs := make([]string, 0, len(members))
+	groupIDs := make([]string, 0)
+	for _, m := range members {
+		if m.IsGroup() {
+			g, err := rw.group(ctx, m.ID())
+			if err != nil {
+				merr = errors.Join(merr, fmt.Errorf("cannot add group %s to group %s: %w", m.ID(), groupID, err))
+				continue
+			}
+			groupIDs = append(groupIDs, g.ID)
+			continue
+		}
+		entity, err := rw.entity(ctx, "name", m.ID())
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add user %s to group %s: %w", m.ID(), groupID, err))
+			continue
+		}
+		entityIDs = append(entityIDs, entity.ID)
+	}
+	slices.Sort(entityIDs)
+	entityIDs = slices.Compact(entityIDs)
+	slices.Sort(groupIDs)
+	groupIDs = slices.Compact(groupIDs)
+
+	logger := logging.FromContext(ctx)
+	if slices.Equal(sortedIDs(group.MemberEntityIDs), entityIDs) && slices.Equal(sortedIDs(group.MemberGroupIDs), groupIDs) {
+		logger.InfoContext(ctx, "members unchanged", "group_id", groupID)
+		return merr
+	}
+	logger.InfoContext(ctx, "setting members",
+		"group_id", groupID,
+		"member_entity_ids", entityIDs,
+		"member_group_ids", groupIDs,
+	)
+	// Vault replaces the given member lists and keeps the other fields.
+	body := map[string][]string{
+		"member_entity_ids": entityIDs,
+		"member_group_ids":  groupIDs,
+	}
+	if err := rw.client.Do(ctx, http.MethodPost, "/identity/group/id/"+url.PathEscape(group.ID), body, nil); err != nil {
+		return errors.Join(merr, fmt.Errorf("failed to update members of group %s: %w", groupID, err))
+	}
+	return merr
+}
+
+// group returns the identity group with the given name. It is always read
+// from Vault, as its members may have changed.
+func (rw *GroupReadWriter) group(ctx context.Context, name string) (*Group, error) {
+	var resp response[*Group]
+	if err := rw.client.Do(ctx, http.MethodGet, "/identity/group/name/"+url.PathEscape(name), nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", name, notFound(err))
+	}
+	if resp.Data == nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", name, groupsync.ErrGroupNotFound)
+	}
+	return resp.Data, nil
+}
+
+// groupByID returns the identity group with the given ID, e.g. to resolve
+// the name of a member group. Only its name is used, so it may be cached.
+func (rw *GroupReadWriter) groupByID(ctx context.Context, id string) (*Group, error) {
+	group, err := rw.groupCache.WriteThruLookup("id/"+id, func() (*Group, error) {
+		var resp response[*Group]
+		if err := rw.client.Do(ctx, http.MethodGet, "/identity/group/id/"+url.PathEscape(id), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get group %s: %w", id, notFound(err))
+		}
+		if resp.Data == nil {
+			return nil, fmt.Errorf("failed to get group %s: %w", id, groupsync.ErrGroupNotFound)
+		}
+		return resp.Data, nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return group, nil
+}
+
+// entity returns the identity entity with the given id or name, depending
+// on by.
+func (rw *GroupReadWriter) entity(ctx context.Context, by, key string) (*Entity, error) {
+	entity, err := rw.entityCache.WriteThruLookup(by+"/"+key, func() (*Entity, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching entity", by, key)
+		var resp response[*Entity]
+		if err := rw.client.Do(ctx, http.MethodGet, "/identity/entity/"+by+"/"+url.PathEscape(key), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get entity %s: %w", key, err)
+		}
+		if resp.Data == nil {
+			return nil, fmt.Errorf("entity %s not found", key)
+		}
+		return resp.Data, nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return entity, nil
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+// sortedIDs returns the sorted, distinct IDs of the given IDs.
+func sortedIDs(ids []string) []string {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
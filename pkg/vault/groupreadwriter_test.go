@@ -0,0 +1,174 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeVault{
+		entities: []*Entity{
+			{ID: "e1", Name: "alice"},
+			{ID: "e2", Name: "bob"},
+			{ID: "e3", Name: "carol"},
+		},
+		groups: []*Group{
+			{ID: "g1", Name: "payments", Type: "internal", Policies: []string{"payments-db"}, MemberEntityIDs: []string{"e1", "e2"}, MemberGroupIDs: []string{}},
+			{ID: "g2", Name: "payments-leads", Type: "internal", MemberEntityIDs: []string{"e3"}},
+			{ID: "g3", Name: "okta-admins", Type: "external"},
+		},
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	rw := NewGroupReadWriter(srv.URL, "token", WithNamespace("eng"))
+
+	users, err := rw.Descendants(ctx, "payments")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice", "bob"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "payments", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "dave"}},
+		&groupsync.GroupMember{Grp: &groupsync.Group{ID: "payments-leads"}},
+	})
+	if diff := testutil.DiffErrString(err, "cannot add user dave to group payments"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+	got := fake.groups[0]
+	if diff := cmp.Diff(got.MemberEntityIDs, []string{"e2"}); diff != "" {
+		t.Errorf("unexpected member entity IDs (-got, +want):\n%s", diff)
+	}
+	if diff := cmp.Diff(got.MemberGroupIDs, []string{"g2"}); diff != "" {
+		t.Errorf("unexpected member group IDs (-got, +want):\n%s", diff)
+	}
+	if diff := cmp.Diff(got.Policies, []string{"payments-db"}); diff != "" {
+		t.Errorf("policies were not kept (-got, +want):\n%s", diff)
+	}
+
+	users, err = rw.Descendants(ctx, "payments")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"bob", "carol"}); diff != "" {
+		t.Errorf("unexpected descendants after SetMembers (-got, +want):\n%s", diff)
+	}
+
+	// setting the same members in another order writes nothing.
+	writes := fake.writes
+	if err := rw.SetMembers(ctx, "payments", []groupsync.Member{
+		&groupsync.GroupMember{Grp: &groupsync.Group{ID: "payments-leads"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "bob"}},
+	}); err != nil {
+		t.Errorf("SetMembers of unchanged members failed: %v", err)
+	}
+	if got := fake.writes - writes; got != 0 {
+		t.Errorf("SetMembers of unchanged members issued %d writes, want 0", got)
+	}
+
+	if err := rw.SetMembers(ctx, "okta-admins", nil); err == nil {
+		t.Errorf("SetMembers of an external group got no error")
+	}
+	if _, err := rw.GetGroup(ctx, "missing"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(missing) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeVault implements the parts of the Vault identity API used by
+// GroupReadWriter.
+type fakeVault struct {
+	mu       sync.Mutex
+	entities []*Entity
+	groups   []*Group
+	writes   int
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("X-Vault-Token") != "token" || r.Header.Get("X-Vault-Namespace") != "eng" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/identity/"), "/")
+	if len(parts) != 3 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	kind, by, key := parts[0], parts[1], parts[2]
+	write := func(v any) {
+		json.NewEncoder(w).Encode(map[string]any{"data": v}) //nolint:errcheck // test server
+	}
+
+	switch kind {
+	case "entity":
+		for _, e := range f.entities {
+			if (by == "id" && e.ID == key) || (by == "name" && e.Name == key) {
+				write(e)
+				return
+			}
+		}
+	case "group":
+		for _, g := range f.groups {
+			if (by == "id" && g.ID == key) || (by == "name" && g.Name == key) {
+				if r.Method == http.MethodPost {
+					f.writes++
+					var body map[string][]string
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					g.MemberEntityIDs, g.MemberGroupIDs = body["member_entity_ids"], body["member_group_ids"]
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				write(g)
+				return
+			}
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
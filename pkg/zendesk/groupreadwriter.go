@@ -0,0 +1,348 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package zendesk provides a GroupReadWriter for Zendesk agent groups, so
+// that support routing groups follow the organization's directory groups.
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/abcxyz/pkg/cache"
+	"github.com/abcxyz/pkg/logging"
+	"github.com/abcxyz/pkg/sets"
+	"github.com/abcxyz/team-link/internal/rest"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+	"github.com/abcxyz/team-link/pkg/utils"
+)
+
+const (
+	// DefaultCacheDuration is the default time to live for the user cache.
+	DefaultCacheDuration = time.Hour * 24
+
+	// pageSize is the number of memberships requested per page, the maximum
+	// of the API.
+	pageSize = 100
+)
+
+// Ensure we conform to the interface.
+var _ groupsync.GroupReadWriter = (*GroupReadWriter)(nil)
+
+// Group is a Zendesk group, see
+// https://developer.zendesk.com/api-reference/ticketing/groups/groups/.
+type Group struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// User is a Zendesk user.
+type User struct {
+	ID    int64  `json:"id"`
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+	Role  string `json:"role,omitempty"`
+}
+
+// Membership is the membership of an agent in a group, see
+// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/.
+type Membership struct {
+	ID      int64 `json:"id,omitempty"`
+	UserID  int64 `json:"user_id"`
+	GroupID int64 `json:"group_id"`
+}
+
+type Config struct {
+	cacheDuration time.Duration
+	httpClient    *http.Client
+}
+
+type Opt func(config *Config)
+
+// WithCacheDuration set the time to live for the user cache entries.
+func WithCacheDuration(duration time.Duration) Opt {
+	return func(config *Config) {
+		config.cacheDuration = duration
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to call Zendesk.
+func WithHTTPClient(client *http.Client) Opt {
+	return func(config *Config) {
+		config.httpClient = client
+	}
+}
+
+// GroupReadWriter adheres to the groupsync.GroupReadWriter interface and
+// manipulates the memberships of Zendesk groups. Group IDs are numeric group
+// IDs and user IDs are emails. Only agents and admins can be group members;
+// other users are reported as errors.
+type GroupReadWriter struct {
+	client    *rest.Client
+	userCache *cache.Cache[*User]
+}
+
+// NewGroupReadWriter creates a GroupReadWriter for the Zendesk account at
+// the given URL, e.g. https://example.zendesk.com, authenticating with an API
+// token as the admin with the given email.
+func NewGroupReadWriter(endpoint, email, apiToken string, opts ...Opt) *GroupReadWriter {
+	config := &Config{
+		cacheDuration: DefaultCacheDuration,
+		httpClient:    http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return &GroupReadWriter{
+		client: rest.New(endpoint+"/api/v2",
+			rest.WithHTTPClient(config.httpClient),
+			rest.WithBasicAuth(email+"/token", apiToken),
+		),
+		userCache: cache.New[*User](config.cacheDuration),
+	}
+}
+
+// GetGroup retrieves the Zendesk group with the given ID.
+func (rw *GroupReadWriter) GetGroup(ctx context.Context, groupID string) (*groupsync.Group, error) {
+	var resp struct {
+		Group *Group `json:"group"`
+	}
+	if err := rw.client.Do(ctx, http.MethodGet, "/groups/"+url.PathEscape(groupID)+".json", nil, &resp); err != nil {
+		return nil, fmt.Errorf("failed to get group %s: %w", groupID, notFound(err))
+	}
+	return &groupsync.Group{ID: groupID, Attributes: resp.Group}, nil
+}
+
+// GetMembers retrieves the agents of the Zendesk group with the given ID.
+func (rw *GroupReadWriter) GetMembers(ctx context.Context, groupID string) ([]groupsync.Member, error) {
+	_, members, err := rw.members(ctx, groupID)
+	return members, err
+}
+
+// Descendants retrieve all agents of the Zendesk group with the given ID.
+func (rw *GroupReadWriter) Descendants(ctx context.Context, groupID string) ([]*groupsync.User, error) {
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "fetching descendants for group", "group_id", groupID)
+	users, err := groupsync.Descendants(ctx, groupID, rw.GetMembers)
+	if err != nil {
+		return nil, fmt.Errorf("could not get descendants: %w", err)
+	}
+	return users, nil
+}
+
+// GetUser retrieves the Zendesk user with the given email.
+func (rw *GroupReadWriter) GetUser(ctx context.Context, userID string) (*groupsync.User, error) {
+	user, err := rw.user(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get user: %w", err)
+	}
+	return &groupsync.User{ID: user.Email, Attributes: user}, nil
+}
+
+// user returns the Zendesk user with the given email.
+func (rw *GroupReadWriter) user(ctx context.Context, email string) (*User, error) {
+	user, err := rw.userCache.WriteThruLookup("email/"+strings.ToLower(email), func() (*User, error) {
+		logger := logging.FromContext(ctx)
+		logger.InfoContext(ctx, "fetching user", "user_id", email)
+		var resp struct {
+			Users []*User `json:"users"`
+		}
+		if err := rw.client.Do(ctx, http.MethodGet, "/users/search.json?query="+url.QueryEscape("email:"+email), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to fetch user %s: %w", email, err)
+		}
+		for _, u := range resp.Users {
+			if strings.EqualFold(u.Email, email) {
+				return u, nil
+			}
+		}
+		return nil, fmt.Errorf("user %s not found", email)
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // Want passthrough
+	}
+	return user, nil
+}
+
+// SetMembers replaces the agents of the Zendesk group with the given ID with
+// the given members. Members which cannot be added, e.g. end users, are
+// reported in the returned error after all other changes are made.
+func (rw *GroupReadWriter) SetMembers(ctx context.Context, groupID string, members []groupsync.Member) error {
+	gid, err := strconv.ParseInt(groupID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: zendesk group ID %q: %w", groupsync.ErrInvalidGroupID, groupID, err)
+	}
+	memberships, currentMembers, err := rw.members(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("could not get current members: %w", err)
+	}
+	currentMemberIDs := toIDMap(currentMembers)
+	newMemberIDs := toIDMap(members)
+
+	addMembers := sets.SubtractMapKeys(newMemberIDs, currentMemberIDs)
+	removeMembers := sets.SubtractMapKeys(currentMemberIDs, newMemberIDs)
+
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "members to add",
+		"group_id", groupID,
+		"add_member_ids", utils.MapKeys(addMembers),
+	)
+	logger.InfoContext(ctx, "members to remove",
+		"group_id", groupID,
+		"remove_member_ids", utils.MapKeys(removeMembers),
+	)
+
+	var merr error
+	for _, email := range utils.MapKeys(addMembers) {
+		user, err := rw.user(ctx, email)
+		if err != nil {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to group %s: %w", email, groupID, err))
+			continue
+		}
+		if user.Role == "end-user" {
+			merr = errors.Join(merr, fmt.Errorf("cannot add %s to group %s: end users cannot be group members", email, groupID))
+			continue
+		}
+		body := map[string]*Membership{"group_membership": {UserID: user.ID, GroupID: gid}}
+		if err := rw.client.Do(ctx, http.MethodPost, "/group_memberships.json", body, nil); err != nil {
+			merr = errors.Join(merr, fmt.Errorf("failed to add %s to group %s: %w", email, groupID, err))
+		}
+	}
+
+	membershipIDs := make(map[int64]int64, len(memberships))
+	for _, m := range memberships {
+		membershipIDs[m.UserID] = m.ID
+	}
+	for _, email := range utils.MapKeys(removeMembers) {
+		user, ok := zendeskUser(removeMembers[email])
+		if !ok {
+			merr = errors.Join(merr, fmt.Errorf("cannot remove %s from group %s: unknown member", email, groupID))
+			continue
+		}
+		path := "/group_memberships/" + strconv.FormatInt(membershipIDs[user.ID], 10) + ".json"
+		if err := rw.client.Do(ctx, http.MethodDelete, path, nil, nil); err != nil && !rest.IsNotFound(err) {
+			merr = errors.Join(merr, fmt.Errorf("failed to remove %s from group %s: %w", email, groupID, err))
+		}
+	}
+	return merr
+}
+
+// members returns the memberships of the group with the given ID and their
+// users as members.
+func (rw *GroupReadWriter) members(ctx context.Context, groupID string) ([]*Membership, []groupsync.Member, error) {
+	memberships, err := rw.memberships(ctx, groupID)
+	if err != nil {
+		return nil, nil, err
+	}
+	ids := make([]string, 0, len(memberships))
+	for _, m := range memberships {
+		ids = append(ids, strconv.FormatInt(m.UserID, 10))
+	}
+	users, err := rw.usersByID(ctx, ids)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get members of group %s: %w", groupID, err)
+	}
+	members := make([]groupsync.Member, 0, len(users))
+	for _, u := range users {
+		members = append(members, &groupsync.UserMember{Usr: &groupsync.User{ID: u.Email, Attributes: u}})
+	}
+	return memberships, members, nil
+}
+
+// memberships returns all memberships of the group with the given ID.
+func (rw *GroupReadWriter) memberships(ctx context.Context, groupID string) ([]*Membership, error) {
+	var memberships []*Membership
+	path := "/groups/" + url.PathEscape(groupID) + "/memberships.json?" + url.Values{"page[size]": {strconv.Itoa(pageSize)}}.Encode()
+	for path != "" {
+		var resp struct {
+			Memberships []*Membership `json:"group_memberships"`
+			Meta        struct {
+				HasMore bool `json:"has_more"`
+			} `json:"meta"`
+			Links struct {
+				Next string `json:"next"`
+			} `json:"links"`
+		}
+		if err := rw.client.Do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to get memberships of group %s: %w", groupID, notFound(err))
+		}
+		memberships = append(memberships, resp.Memberships...)
+		path = ""
+		if resp.Meta.HasMore {
+			path = resp.Links.Next
+		}
+	}
+	return memberships, nil
+}
+
+// usersByID returns the users with the given IDs, fetching the ones which
+// are not cached in batches of pageSize.
+func (rw *GroupReadWriter) usersByID(ctx context.Context, ids []string) ([]*User, error) {
+	users := make([]*User, 0, len(ids))
+	var missing []string
+	for _, id := range ids {
+		if u, ok := rw.userCache.Lookup("id/" + id); ok {
+			users = append(users, u)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	for start := 0; start < len(missing); start += pageSize {
+		batch := missing[start:min(start+pageSize, len(missing))]
+		var resp struct {
+			Users []*User `json:"users"`
+		}
+		if err := rw.client.Do(ctx, http.MethodGet, "/users/show_many.json?ids="+strings.Join(batch, ","), nil, &resp); err != nil {
+			return nil, fmt.Errorf("failed to fetch users: %w", err)
+		}
+		for _, u := range resp.Users {
+			rw.userCache.Set("id/"+strconv.FormatInt(u.ID, 10), u)
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+// zendeskUser returns the User of a member returned by GetMembers.
+func zendeskUser(m groupsync.Member) (*User, bool) {
+	user, err := m.User()
+	if err != nil {
+		return nil, false
+	}
+	u, ok := user.Attributes.(*User)
+	return u, ok
+}
+
+// notFound wraps errors of missing groups with groupsync.ErrGroupNotFound.
+func notFound(err error) error {
+	if rest.IsNotFound(err) {
+		return fmt.Errorf("%w: %w", groupsync.ErrGroupNotFound, err)
+	}
+	return err
+}
+
+// toIDMap returns the given members by lowercased ID, as emails are
+// case-insensitive.
+func toIDMap(members []groupsync.Member) map[string]groupsync.Member {
+	memberIDs := make(map[string]groupsync.Member, len(members))
+	for _, m := range members {
+		memberIDs[strings.ToLower(m.ID())] = m
+	}
+	return memberIDs
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 The Authors (see AUTHORS file)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/abcxyz/pkg/testutil"
+	"github.com/abcxyz/team-link/pkg/groupsync"
+)
+
+func TestGroupReadWriter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	fake := &fakeZendesk{
+		users: []*User{
+			{ID: 1, Email: "alice@example.com", Role: "agent"},
+			{ID: 2, Email: "bob@example.com", Role: "admin"},
+			{ID: 3, Email: "carol@example.com", Role: "agent"},
+			{ID: 4, Email: "customer@example.org", Role: "end-user"},
+		},
+		memberships: []*Membership{
+			{ID: 100, UserID: 1, GroupID: 7},
+			{ID: 101, UserID: 2, GroupID: 7},
+		},
+		nextID: 200,
+	}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	fake.url = srv.URL
+	rw := NewGroupReadWriter(srv.URL, "admin@example.com", "token")
+
+	users, err := rw.Descendants(ctx, "7")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"alice@example.com", "bob@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants (-got, +want):\n%s", diff)
+	}
+
+	err = rw.SetMembers(ctx, "7", []groupsync.Member{
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "Bob@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "carol@example.com"}},
+		&groupsync.UserMember{Usr: &groupsync.User{ID: "customer@example.org"}},
+	})
+	if diff := testutil.DiffErrString(err, "end users cannot be group members"); diff != "" {
+		t.Errorf("unexpected SetMembers err: %s", diff)
+	}
+	users, err = rw.Descendants(ctx, "7")
+	if err != nil {
+		t.Fatalf("Descendants failed: %v", err)
+	}
+	if diff := cmp.Diff(userIDs(users), []string{"bob@example.com", "carol@example.com"}); diff != "" {
+		t.Errorf("unexpected descendants after SetMembers (-got, +want):\n%s", diff)
+	}
+
+	if _, err := rw.GetGroup(ctx, "8"); !errors.Is(err, groupsync.ErrGroupNotFound) {
+		t.Errorf("GetGroup(8) got err %v, want %v", err, groupsync.ErrGroupNotFound)
+	}
+}
+
+func userIDs(users []*groupsync.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// fakeZendesk implements the parts of the Zendesk API used by
+// GroupReadWriter for the single group 7. Memberships are listed one per
+// page.
+type fakeZendesk struct {
+	mu          sync.Mutex
+	url         string
+	users       []*User
+	memberships []*Membership
+	nextID      int64
+}
+
+func (f *fakeZendesk) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if user, pass, _ := r.BasicAuth(); user != "admin@example.com/token" || pass != "token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	write := func(v any) {
+		json.NewEncoder(w).Encode(v) //nolint:errcheck // test server
+	}
+	path := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v2/"), ".json")
+	switch {
+	case path == "groups/7":
+		write(map[string]any{"group": &Group{ID: 7, Name: "Tier 1"}})
+	case path == "groups/7/memberships":
+		after, _ := strconv.Atoi(r.URL.Query().Get("page[after]"))
+		resp := map[string]any{"group_memberships": f.memberships[after:min(after+1, len(f.memberships))]}
+		if after+1 < len(f.memberships) {
+			resp["meta"] = map[string]any{"has_more": true}
+			resp["links"] = map[string]any{"next": fmt.Sprintf("%s/api/v2/groups/7/memberships.json?page[size]=1&page[after]=%d", f.url, after+1)}
+		}
+		write(resp)
+	case path == "users/show_many":
+		var users []*User
+		for _, id := range strings.Split(r.URL.Query().Get("ids"), ",") {
+			for _, u := range f.users {
+				if strconv.FormatInt(u.ID, 10) == id {
+					users = append(users, u)
+				}
+			}
+		}
+		write(map[string]any{"users": users})
+	case path == "users/search":
+		var users []*User
+		for _, u := range f.users {
+			if "email:"+u.Email == r.URL.Query().Get("query") {
+				users = append(users, u)
+			}
+		}
+		write(map[string]any{"users": users})
+	case path == "group_memberships" && r.Method == http.MethodPost:
+		var body map[string]*Membership
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m := body["group_membership"]
+		m.ID = f.nextID
+		f.nextID++
+		f.memberships = append(f.memberships, m)
+		w.WriteHeader(http.StatusCreated)
+	case strings.HasPrefix(path, "group_memberships/") && r.Method == http.MethodDelete:
+		id, _ := strconv.ParseInt(strings.TrimPrefix(path, "group_memberships/"), 10, 64)
+		f.memberships = slices.DeleteFunc(f.memberships, func(m *Membership) bool { return m.ID == id })
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}